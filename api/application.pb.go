@@ -6,7 +6,9 @@ package api
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
 import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 
 import (
@@ -45,7 +47,7 @@ func (x IntegrationKind) String() string {
 	return proto.EnumName(IntegrationKind_name, int32(x))
 }
 func (IntegrationKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{0}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{0}
 }
 
 type InfluxDBPrecision int32
@@ -80,7 +82,7 @@ func (x InfluxDBPrecision) String() string {
 	return proto.EnumName(InfluxDBPrecision_name, int32(x))
 }
 func (InfluxDBPrecision) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{1}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{1}
 }
 
 type Application struct {
@@ -100,7 +102,34 @@ type Application struct {
 	// Payload encoder script.
 	PayloadEncoderScript string `protobuf:"bytes,7,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
 	// Payload decoder script.
-	PayloadDecoderScript string   `protobuf:"bytes,8,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	PayloadDecoderScript string `protobuf:"bytes,8,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	// Master key (HEX encoded, optional, write-only).
+	// When set, the keys of devices created under this application are
+	// derived from this key (see the documentation for the derivation).
+	// The master key is never returned, use the GetMasterKey endpoint to
+	// retrieve it. On update, an empty value keeps the current master key
+	// (see remove_master_key).
+	MasterKey string `protobuf:"bytes,9,opt,name=master_key,json=masterKey,proto3" json:"master_key,omitempty"`
+	// Archive the raw uplinks of this application.
+	// This requires the archive to be configured (see the
+	// application_server.archive configuration section).
+	ArchiveUplinks bool `protobuf:"varint,10,opt,name=archive_uplinks,json=archiveUplinks,proto3" json:"archive_uplinks,omitempty"`
+	// Fields to drop or redact from the uplink events before they are stored
+	// or published to the integrations. Supported fields are:
+	// rxInfo:          drops the rx-info of the receiving gateways
+	// rxInfo.location: drops the location of the receiving gateways
+	// rxInfo.gateway:  redacts the ID, name and tags of the receiving gateways
+	// rxInfo.time:     drops the (fine-)timestamps of the receiving gateways
+	// txInfo:          drops the tx-info (frequency and data-rate)
+	// data:            drops the raw payload
+	// object:          drops the decoded payload
+	RedactFields []string `protobuf:"bytes,11,rep,name=redact_fields,json=redactFields,proto3" json:"redact_fields,omitempty"`
+	// Heartbeat interval (optional, min. 1m).
+	// When set, a synthetic heartbeat uplink is published in this interval
+	// to the integrations of the application.
+	HeartbeatInterval *duration.Duration `protobuf:"bytes,12,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
+	// A master key is set (read-only).
+	HasMasterKey         bool     `protobuf:"varint,13,opt,name=has_master_key,json=hasMasterKey,proto3" json:"has_master_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -110,7 +139,7 @@ func (m *Application) Reset()         { *m = Application{} }
 func (m *Application) String() string { return proto.CompactTextString(m) }
 func (*Application) ProtoMessage()    {}
 func (*Application) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{0}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{0}
 }
 func (m *Application) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Application.Unmarshal(m, b)
@@ -186,6 +215,41 @@ func (m *Application) GetPayloadDecoderScript() string {
 	return ""
 }
 
+func (m *Application) GetMasterKey() string {
+	if m != nil {
+		return m.MasterKey
+	}
+	return ""
+}
+
+func (m *Application) GetArchiveUplinks() bool {
+	if m != nil {
+		return m.ArchiveUplinks
+	}
+	return false
+}
+
+func (m *Application) GetRedactFields() []string {
+	if m != nil {
+		return m.RedactFields
+	}
+	return nil
+}
+
+func (m *Application) GetHeartbeatInterval() *duration.Duration {
+	if m != nil {
+		return m.HeartbeatInterval
+	}
+	return nil
+}
+
+func (m *Application) GetHasMasterKey() bool {
+	if m != nil {
+		return m.HasMasterKey
+	}
+	return false
+}
+
 type ApplicationListItem struct {
 	// Application ID.
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -208,7 +272,7 @@ func (m *ApplicationListItem) Reset()         { *m = ApplicationListItem{} }
 func (m *ApplicationListItem) String() string { return proto.CompactTextString(m) }
 func (*ApplicationListItem) ProtoMessage()    {}
 func (*ApplicationListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{1}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{1}
 }
 func (m *ApplicationListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApplicationListItem.Unmarshal(m, b)
@@ -282,7 +346,7 @@ func (m *CreateApplicationRequest) Reset()         { *m = CreateApplicationReque
 func (m *CreateApplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateApplicationRequest) ProtoMessage()    {}
 func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{2}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{2}
 }
 func (m *CreateApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateApplicationRequest.Unmarshal(m, b)
@@ -321,7 +385,7 @@ func (m *CreateApplicationResponse) Reset()         { *m = CreateApplicationResp
 func (m *CreateApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateApplicationResponse) ProtoMessage()    {}
 func (*CreateApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{3}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{3}
 }
 func (m *CreateApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateApplicationResponse.Unmarshal(m, b)
@@ -360,7 +424,7 @@ func (m *GetApplicationRequest) Reset()         { *m = GetApplicationRequest{} }
 func (m *GetApplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetApplicationRequest) ProtoMessage()    {}
 func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{4}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{4}
 }
 func (m *GetApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetApplicationRequest.Unmarshal(m, b)
@@ -399,7 +463,7 @@ func (m *GetApplicationResponse) Reset()         { *m = GetApplicationResponse{}
 func (m *GetApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetApplicationResponse) ProtoMessage()    {}
 func (*GetApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{5}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{5}
 }
 func (m *GetApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetApplicationResponse.Unmarshal(m, b)
@@ -428,17 +492,19 @@ func (m *GetApplicationResponse) GetApplication() *Application {
 
 type UpdateApplicationRequest struct {
 	// Application object to update.
-	Application          *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Application *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	// Remove the master key of the application.
+	RemoveMasterKey      bool     `protobuf:"varint,2,opt,name=remove_master_key,json=removeMasterKey,proto3" json:"remove_master_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpdateApplicationRequest) Reset()         { *m = UpdateApplicationRequest{} }
 func (m *UpdateApplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateApplicationRequest) ProtoMessage()    {}
 func (*UpdateApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{6}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{6}
 }
 func (m *UpdateApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateApplicationRequest.Unmarshal(m, b)
@@ -465,6 +531,91 @@ func (m *UpdateApplicationRequest) GetApplication() *Application {
 	return nil
 }
 
+func (m *UpdateApplicationRequest) GetRemoveMasterKey() bool {
+	if m != nil {
+		return m.RemoveMasterKey
+	}
+	return false
+}
+
+type GetApplicationMasterKeyRequest struct {
+	// Application ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetApplicationMasterKeyRequest) Reset()         { *m = GetApplicationMasterKeyRequest{} }
+func (m *GetApplicationMasterKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetApplicationMasterKeyRequest) ProtoMessage()    {}
+func (*GetApplicationMasterKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{7}
+}
+func (m *GetApplicationMasterKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApplicationMasterKeyRequest.Unmarshal(m, b)
+}
+func (m *GetApplicationMasterKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApplicationMasterKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetApplicationMasterKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationMasterKeyRequest.Merge(dst, src)
+}
+func (m *GetApplicationMasterKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetApplicationMasterKeyRequest.Size(m)
+}
+func (m *GetApplicationMasterKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationMasterKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApplicationMasterKeyRequest proto.InternalMessageInfo
+
+func (m *GetApplicationMasterKeyRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetApplicationMasterKeyResponse struct {
+	// Master key (HEX encoded).
+	MasterKey            string   `protobuf:"bytes,1,opt,name=master_key,json=masterKey,proto3" json:"master_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetApplicationMasterKeyResponse) Reset()         { *m = GetApplicationMasterKeyResponse{} }
+func (m *GetApplicationMasterKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetApplicationMasterKeyResponse) ProtoMessage()    {}
+func (*GetApplicationMasterKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{8}
+}
+func (m *GetApplicationMasterKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApplicationMasterKeyResponse.Unmarshal(m, b)
+}
+func (m *GetApplicationMasterKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApplicationMasterKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetApplicationMasterKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationMasterKeyResponse.Merge(dst, src)
+}
+func (m *GetApplicationMasterKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetApplicationMasterKeyResponse.Size(m)
+}
+func (m *GetApplicationMasterKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationMasterKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApplicationMasterKeyResponse proto.InternalMessageInfo
+
+func (m *GetApplicationMasterKeyResponse) GetMasterKey() string {
+	if m != nil {
+		return m.MasterKey
+	}
+	return ""
+}
+
 type DeleteApplicationRequest struct {
 	// Application ID.
 	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -477,7 +628,7 @@ func (m *DeleteApplicationRequest) Reset()         { *m = DeleteApplicationReque
 func (m *DeleteApplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteApplicationRequest) ProtoMessage()    {}
 func (*DeleteApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{7}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{9}
 }
 func (m *DeleteApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteApplicationRequest.Unmarshal(m, b)
@@ -504,6 +655,123 @@ func (m *DeleteApplicationRequest) GetId() int64 {
 	return 0
 }
 
+type CloneApplicationRequest struct {
+	// ID of the application to clone.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the organization to create the copy in.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// ID of the service-profile (of the given organization) to use for the copy.
+	ServiceProfileId string `protobuf:"bytes,3,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
+	// Name of the copy.
+	// When empty, the name of the application is used.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Move the devices to the copy.
+	// This requires the service-profile to use the same network-server as
+	// the application. The devices are removed from their multicast-groups.
+	MoveDevices          bool     `protobuf:"varint,5,opt,name=move_devices,json=moveDevices,proto3" json:"move_devices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloneApplicationRequest) Reset()         { *m = CloneApplicationRequest{} }
+func (m *CloneApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*CloneApplicationRequest) ProtoMessage()    {}
+func (*CloneApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{10}
+}
+func (m *CloneApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneApplicationRequest.Unmarshal(m, b)
+}
+func (m *CloneApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneApplicationRequest.Marshal(b, m, deterministic)
+}
+func (dst *CloneApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneApplicationRequest.Merge(dst, src)
+}
+func (m *CloneApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_CloneApplicationRequest.Size(m)
+}
+func (m *CloneApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneApplicationRequest proto.InternalMessageInfo
+
+func (m *CloneApplicationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CloneApplicationRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *CloneApplicationRequest) GetServiceProfileId() string {
+	if m != nil {
+		return m.ServiceProfileId
+	}
+	return ""
+}
+
+func (m *CloneApplicationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CloneApplicationRequest) GetMoveDevices() bool {
+	if m != nil {
+		return m.MoveDevices
+	}
+	return false
+}
+
+type CloneApplicationResponse struct {
+	// ID of the copy.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloneApplicationResponse) Reset()         { *m = CloneApplicationResponse{} }
+func (m *CloneApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*CloneApplicationResponse) ProtoMessage()    {}
+func (*CloneApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{11}
+}
+func (m *CloneApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneApplicationResponse.Unmarshal(m, b)
+}
+func (m *CloneApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneApplicationResponse.Marshal(b, m, deterministic)
+}
+func (dst *CloneApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneApplicationResponse.Merge(dst, src)
+}
+func (m *CloneApplicationResponse) XXX_Size() int {
+	return xxx_messageInfo_CloneApplicationResponse.Size(m)
+}
+func (m *CloneApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneApplicationResponse proto.InternalMessageInfo
+
+func (m *CloneApplicationResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 type ListApplicationRequest struct {
 	// Max number of applications to return in the result-test.
 	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -522,7 +790,7 @@ func (m *ListApplicationRequest) Reset()         { *m = ListApplicationRequest{}
 func (m *ListApplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*ListApplicationRequest) ProtoMessage()    {}
 func (*ListApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{8}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{12}
 }
 func (m *ListApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListApplicationRequest.Unmarshal(m, b)
@@ -584,7 +852,7 @@ func (m *ListApplicationResponse) Reset()         { *m = ListApplicationResponse
 func (m *ListApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*ListApplicationResponse) ProtoMessage()    {}
 func (*ListApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{9}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{13}
 }
 func (m *ListApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListApplicationResponse.Unmarshal(m, b)
@@ -632,7 +900,7 @@ func (m *HTTPIntegrationHeader) Reset()         { *m = HTTPIntegrationHeader{} }
 func (m *HTTPIntegrationHeader) String() string { return proto.CompactTextString(m) }
 func (*HTTPIntegrationHeader) ProtoMessage()    {}
 func (*HTTPIntegrationHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{10}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{14}
 }
 func (m *HTTPIntegrationHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPIntegrationHeader.Unmarshal(m, b)
@@ -682,17 +950,33 @@ type HTTPIntegration struct {
 	// The URL to call for device-status notifications.
 	StatusNotificationUrl string `protobuf:"bytes,7,opt,name=status_notification_url,json=statusNotificationURL,proto3" json:"status_notification_url,omitempty"`
 	// The URL to call for location notifications.
-	LocationNotificationUrl string   `protobuf:"bytes,8,opt,name=location_notification_url,json=locationNotificationURL,proto3" json:"location_notification_url,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	LocationNotificationUrl string `protobuf:"bytes,8,opt,name=location_notification_url,json=locationNotificationURL,proto3" json:"location_notification_url,omitempty"`
+	// Additional endpoints, optionally filtered by a filter expression.
+	Endpoints []*HTTPIntegrationEndpoint `protobuf:"bytes,9,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// Secret for signing the events and the downlink callbacks (optional).
+	// When set, the posted events are signed (HMAC-SHA256) and contain a
+	// signed callback URL to which downlink payloads for the device can be
+	// posted.
+	CallbackSecret string `protobuf:"bytes,10,opt,name=callback_secret,json=callbackSecret,proto3" json:"callback_secret,omitempty"`
+	// Compression of the posted events (optional).
+	// Valid values are: "" (no compression) and gzip.
+	Compression string `protobuf:"bytes,11,opt,name=compression,proto3" json:"compression,omitempty"`
+	// Max. number of events per request (optional).
+	// When greater than 1, the events are posted in batches.
+	BatchSize uint32 `protobuf:"varint,12,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Max. time (in milliseconds) an event waits for its batch to be posted.
+	// This must be set when batching is enabled.
+	BatchIntervalMs      uint32   `protobuf:"varint,13,opt,name=batch_interval_ms,json=batchIntervalMS,proto3" json:"batch_interval_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *HTTPIntegration) Reset()         { *m = HTTPIntegration{} }
 func (m *HTTPIntegration) String() string { return proto.CompactTextString(m) }
 func (*HTTPIntegration) ProtoMessage()    {}
 func (*HTTPIntegration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{11}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{15}
 }
 func (m *HTTPIntegration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPIntegration.Unmarshal(m, b)
@@ -768,6 +1052,103 @@ func (m *HTTPIntegration) GetLocationNotificationUrl() string {
 	return ""
 }
 
+func (m *HTTPIntegration) GetEndpoints() []*HTTPIntegrationEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *HTTPIntegration) GetCallbackSecret() string {
+	if m != nil {
+		return m.CallbackSecret
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *HTTPIntegration) GetBatchIntervalMs() uint32 {
+	if m != nil {
+		return m.BatchIntervalMs
+	}
+	return 0
+}
+
+type HTTPIntegrationEndpoint struct {
+	// Event type to post to this endpoint.
+	// Valid values are: uplink, join, ack, error, status and location.
+	Event string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// The URL to call.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// JavaScript filter expression (optional).
+	// The event is available as the event variable, using the same structure
+	// as the posted JSON payload, e.g.: event.object.temperature > 20.
+	// When set, only the events for which the expression evaluates to true
+	// are posted.
+	Filter               string   `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HTTPIntegrationEndpoint) Reset()         { *m = HTTPIntegrationEndpoint{} }
+func (m *HTTPIntegrationEndpoint) String() string { return proto.CompactTextString(m) }
+func (*HTTPIntegrationEndpoint) ProtoMessage()    {}
+func (*HTTPIntegrationEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{16}
+}
+func (m *HTTPIntegrationEndpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HTTPIntegrationEndpoint.Unmarshal(m, b)
+}
+func (m *HTTPIntegrationEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HTTPIntegrationEndpoint.Marshal(b, m, deterministic)
+}
+func (dst *HTTPIntegrationEndpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPIntegrationEndpoint.Merge(dst, src)
+}
+func (m *HTTPIntegrationEndpoint) XXX_Size() int {
+	return xxx_messageInfo_HTTPIntegrationEndpoint.Size(m)
+}
+func (m *HTTPIntegrationEndpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPIntegrationEndpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPIntegrationEndpoint proto.InternalMessageInfo
+
+func (m *HTTPIntegrationEndpoint) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *HTTPIntegrationEndpoint) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *HTTPIntegrationEndpoint) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
 type CreateHTTPIntegrationRequest struct {
 	// Integration object to create.
 	Integration          *HTTPIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
@@ -780,7 +1161,7 @@ func (m *CreateHTTPIntegrationRequest) Reset()         { *m = CreateHTTPIntegrat
 func (m *CreateHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateHTTPIntegrationRequest) ProtoMessage()    {}
 func (*CreateHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{12}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{17}
 }
 func (m *CreateHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateHTTPIntegrationRequest.Unmarshal(m, b)
@@ -819,7 +1200,7 @@ func (m *GetHTTPIntegrationRequest) Reset()         { *m = GetHTTPIntegrationReq
 func (m *GetHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetHTTPIntegrationRequest) ProtoMessage()    {}
 func (*GetHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{13}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{18}
 }
 func (m *GetHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHTTPIntegrationRequest.Unmarshal(m, b)
@@ -858,7 +1239,7 @@ func (m *GetHTTPIntegrationResponse) Reset()         { *m = GetHTTPIntegrationRe
 func (m *GetHTTPIntegrationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHTTPIntegrationResponse) ProtoMessage()    {}
 func (*GetHTTPIntegrationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{14}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{19}
 }
 func (m *GetHTTPIntegrationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHTTPIntegrationResponse.Unmarshal(m, b)
@@ -897,7 +1278,7 @@ func (m *UpdateHTTPIntegrationRequest) Reset()         { *m = UpdateHTTPIntegrat
 func (m *UpdateHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateHTTPIntegrationRequest) ProtoMessage()    {}
 func (*UpdateHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{15}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{20}
 }
 func (m *UpdateHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateHTTPIntegrationRequest.Unmarshal(m, b)
@@ -936,7 +1317,7 @@ func (m *DeleteHTTPIntegrationRequest) Reset()         { *m = DeleteHTTPIntegrat
 func (m *DeleteHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteHTTPIntegrationRequest) ProtoMessage()    {}
 func (*DeleteHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{16}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{21}
 }
 func (m *DeleteHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteHTTPIntegrationRequest.Unmarshal(m, b)
@@ -975,7 +1356,7 @@ func (m *ListIntegrationRequest) Reset()         { *m = ListIntegrationRequest{}
 func (m *ListIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*ListIntegrationRequest) ProtoMessage()    {}
 func (*ListIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{17}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{22}
 }
 func (m *ListIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListIntegrationRequest.Unmarshal(m, b)
@@ -1014,7 +1395,7 @@ func (m *IntegrationListItem) Reset()         { *m = IntegrationListItem{} }
 func (m *IntegrationListItem) String() string { return proto.CompactTextString(m) }
 func (*IntegrationListItem) ProtoMessage()    {}
 func (*IntegrationListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{18}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{23}
 }
 func (m *IntegrationListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IntegrationListItem.Unmarshal(m, b)
@@ -1055,7 +1436,7 @@ func (m *ListIntegrationResponse) Reset()         { *m = ListIntegrationResponse
 func (m *ListIntegrationResponse) String() string { return proto.CompactTextString(m) }
 func (*ListIntegrationResponse) ProtoMessage()    {}
 func (*ListIntegrationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{19}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{24}
 }
 func (m *ListIntegrationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListIntegrationResponse.Unmarshal(m, b)
@@ -1113,7 +1494,7 @@ func (m *InfluxDBIntegration) Reset()         { *m = InfluxDBIntegration{} }
 func (m *InfluxDBIntegration) String() string { return proto.CompactTextString(m) }
 func (*InfluxDBIntegration) ProtoMessage()    {}
 func (*InfluxDBIntegration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{20}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{25}
 }
 func (m *InfluxDBIntegration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InfluxDBIntegration.Unmarshal(m, b)
@@ -1194,7 +1575,7 @@ func (m *CreateInfluxDBIntegrationRequest) Reset()         { *m = CreateInfluxDB
 func (m *CreateInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateInfluxDBIntegrationRequest) ProtoMessage()    {}
 func (*CreateInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{21}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{26}
 }
 func (m *CreateInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateInfluxDBIntegrationRequest.Unmarshal(m, b)
@@ -1233,7 +1614,7 @@ func (m *GetInfluxDBIntegrationRequest) Reset()         { *m = GetInfluxDBIntegr
 func (m *GetInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInfluxDBIntegrationRequest) ProtoMessage()    {}
 func (*GetInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{22}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{27}
 }
 func (m *GetInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfluxDBIntegrationRequest.Unmarshal(m, b)
@@ -1272,7 +1653,7 @@ func (m *GetInfluxDBIntegrationResponse) Reset()         { *m = GetInfluxDBInteg
 func (m *GetInfluxDBIntegrationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetInfluxDBIntegrationResponse) ProtoMessage()    {}
 func (*GetInfluxDBIntegrationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{23}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{28}
 }
 func (m *GetInfluxDBIntegrationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfluxDBIntegrationResponse.Unmarshal(m, b)
@@ -1311,7 +1692,7 @@ func (m *UpdateInfluxDBIntegrationRequest) Reset()         { *m = UpdateInfluxDB
 func (m *UpdateInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateInfluxDBIntegrationRequest) ProtoMessage()    {}
 func (*UpdateInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{24}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{29}
 }
 func (m *UpdateInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateInfluxDBIntegrationRequest.Unmarshal(m, b)
@@ -1350,7 +1731,7 @@ func (m *DeleteInfluxDBIntegrationRequest) Reset()         { *m = DeleteInfluxDB
 func (m *DeleteInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteInfluxDBIntegrationRequest) ProtoMessage()    {}
 func (*DeleteInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_ca969d90c31e88a7, []int{25}
+	return fileDescriptor_application_d5e3178db65a73fb, []int{30}
 }
 func (m *DeleteInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteInfluxDBIntegrationRequest.Unmarshal(m, b)
@@ -1377,64 +1758,642 @@ func (m *DeleteInfluxDBIntegrationRequest) GetApplicationId() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Application)(nil), "api.Application")
-	proto.RegisterType((*ApplicationListItem)(nil), "api.ApplicationListItem")
-	proto.RegisterType((*CreateApplicationRequest)(nil), "api.CreateApplicationRequest")
-	proto.RegisterType((*CreateApplicationResponse)(nil), "api.CreateApplicationResponse")
-	proto.RegisterType((*GetApplicationRequest)(nil), "api.GetApplicationRequest")
-	proto.RegisterType((*GetApplicationResponse)(nil), "api.GetApplicationResponse")
-	proto.RegisterType((*UpdateApplicationRequest)(nil), "api.UpdateApplicationRequest")
-	proto.RegisterType((*DeleteApplicationRequest)(nil), "api.DeleteApplicationRequest")
-	proto.RegisterType((*ListApplicationRequest)(nil), "api.ListApplicationRequest")
-	proto.RegisterType((*ListApplicationResponse)(nil), "api.ListApplicationResponse")
-	proto.RegisterType((*HTTPIntegrationHeader)(nil), "api.HTTPIntegrationHeader")
-	proto.RegisterType((*HTTPIntegration)(nil), "api.HTTPIntegration")
-	proto.RegisterType((*CreateHTTPIntegrationRequest)(nil), "api.CreateHTTPIntegrationRequest")
-	proto.RegisterType((*GetHTTPIntegrationRequest)(nil), "api.GetHTTPIntegrationRequest")
-	proto.RegisterType((*GetHTTPIntegrationResponse)(nil), "api.GetHTTPIntegrationResponse")
-	proto.RegisterType((*UpdateHTTPIntegrationRequest)(nil), "api.UpdateHTTPIntegrationRequest")
-	proto.RegisterType((*DeleteHTTPIntegrationRequest)(nil), "api.DeleteHTTPIntegrationRequest")
-	proto.RegisterType((*ListIntegrationRequest)(nil), "api.ListIntegrationRequest")
-	proto.RegisterType((*IntegrationListItem)(nil), "api.IntegrationListItem")
-	proto.RegisterType((*ListIntegrationResponse)(nil), "api.ListIntegrationResponse")
-	proto.RegisterType((*InfluxDBIntegration)(nil), "api.InfluxDBIntegration")
-	proto.RegisterType((*CreateInfluxDBIntegrationRequest)(nil), "api.CreateInfluxDBIntegrationRequest")
-	proto.RegisterType((*GetInfluxDBIntegrationRequest)(nil), "api.GetInfluxDBIntegrationRequest")
-	proto.RegisterType((*GetInfluxDBIntegrationResponse)(nil), "api.GetInfluxDBIntegrationResponse")
-	proto.RegisterType((*UpdateInfluxDBIntegrationRequest)(nil), "api.UpdateInfluxDBIntegrationRequest")
-	proto.RegisterType((*DeleteInfluxDBIntegrationRequest)(nil), "api.DeleteInfluxDBIntegrationRequest")
-	proto.RegisterEnum("api.IntegrationKind", IntegrationKind_name, IntegrationKind_value)
-	proto.RegisterEnum("api.InfluxDBPrecision", InfluxDBPrecision_name, InfluxDBPrecision_value)
+type TestIntegrationRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Kind of the integration to test.
+	Kind IntegrationKind `protobuf:"varint,2,opt,name=kind,proto3,enum=api.IntegrationKind" json:"kind,omitempty"`
+	// Events to replay (uplink, join, ack, error, status or location).
+	// When empty, all events are replayed.
+	Events []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// When set, the requests are recorded but not sent.
+	DryRun               bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *TestIntegrationRequest) Reset()         { *m = TestIntegrationRequest{} }
+func (m *TestIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*TestIntegrationRequest) ProtoMessage()    {}
+func (*TestIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{31}
+}
+func (m *TestIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestIntegrationRequest.Unmarshal(m, b)
+}
+func (m *TestIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *TestIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestIntegrationRequest.Merge(dst, src)
+}
+func (m *TestIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_TestIntegrationRequest.Size(m)
+}
+func (m *TestIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestIntegrationRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_TestIntegrationRequest proto.InternalMessageInfo
 
-// ApplicationServiceClient is the client API for ApplicationService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ApplicationServiceClient interface {
-	// Create creates the given application.
-	Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error)
-	// Get returns the requested application.
-	Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
-	// Update updates the given application.
-	Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Delete deletes the given application.
-	Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// List lists the available applications.
-	List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error)
-	// CreateHTTPIntegration creates a HTTP application-integration.
-	CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// GetHTTPIntegration returns the HTTP application-integration.
-	GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error)
-	// UpdateHTTPIntegration updates the HTTP application-integration.
+func (m *TestIntegrationRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *TestIntegrationRequest) GetKind() IntegrationKind {
+	if m != nil {
+		return m.Kind
+	}
+	return IntegrationKind_HTTP
+}
+
+func (m *TestIntegrationRequest) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *TestIntegrationRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type TestIntegrationResponse struct {
+	// Results per replayed event.
+	Result               []*IntegrationTestResult `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TestIntegrationResponse) Reset()         { *m = TestIntegrationResponse{} }
+func (m *TestIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*TestIntegrationResponse) ProtoMessage()    {}
+func (*TestIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{32}
+}
+func (m *TestIntegrationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestIntegrationResponse.Unmarshal(m, b)
+}
+func (m *TestIntegrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestIntegrationResponse.Marshal(b, m, deterministic)
+}
+func (dst *TestIntegrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestIntegrationResponse.Merge(dst, src)
+}
+func (m *TestIntegrationResponse) XXX_Size() int {
+	return xxx_messageInfo_TestIntegrationResponse.Size(m)
+}
+func (m *TestIntegrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestIntegrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestIntegrationResponse proto.InternalMessageInfo
+
+func (m *TestIntegrationResponse) GetResult() []*IntegrationTestResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type IntegrationTestResult struct {
+	// Event type.
+	Event string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Outbound requests made by the integration for this event.
+	Requests []*IntegrationTestRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// Error returned by the integration.
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IntegrationTestResult) Reset()         { *m = IntegrationTestResult{} }
+func (m *IntegrationTestResult) String() string { return proto.CompactTextString(m) }
+func (*IntegrationTestResult) ProtoMessage()    {}
+func (*IntegrationTestResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{33}
+}
+func (m *IntegrationTestResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IntegrationTestResult.Unmarshal(m, b)
+}
+func (m *IntegrationTestResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IntegrationTestResult.Marshal(b, m, deterministic)
+}
+func (dst *IntegrationTestResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegrationTestResult.Merge(dst, src)
+}
+func (m *IntegrationTestResult) XXX_Size() int {
+	return xxx_messageInfo_IntegrationTestResult.Size(m)
+}
+func (m *IntegrationTestResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegrationTestResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegrationTestResult proto.InternalMessageInfo
+
+func (m *IntegrationTestResult) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *IntegrationTestResult) GetRequests() []*IntegrationTestRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *IntegrationTestResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type IntegrationTestRequest struct {
+	// HTTP method.
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Request URL.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Request headers.
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Request body.
+	Body []byte `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// Response status code (not set on error).
+	StatusCode uint32 `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Request error.
+	Error                string   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IntegrationTestRequest) Reset()         { *m = IntegrationTestRequest{} }
+func (m *IntegrationTestRequest) String() string { return proto.CompactTextString(m) }
+func (*IntegrationTestRequest) ProtoMessage()    {}
+func (*IntegrationTestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{34}
+}
+func (m *IntegrationTestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IntegrationTestRequest.Unmarshal(m, b)
+}
+func (m *IntegrationTestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IntegrationTestRequest.Marshal(b, m, deterministic)
+}
+func (dst *IntegrationTestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegrationTestRequest.Merge(dst, src)
+}
+func (m *IntegrationTestRequest) XXX_Size() int {
+	return xxx_messageInfo_IntegrationTestRequest.Size(m)
+}
+func (m *IntegrationTestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegrationTestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegrationTestRequest proto.InternalMessageInfo
+
+func (m *IntegrationTestRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *IntegrationTestRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *IntegrationTestRequest) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *IntegrationTestRequest) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *IntegrationTestRequest) GetStatusCode() uint32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *IntegrationTestRequest) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ReprocessUplinksRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Start of the time range (inclusive).
+	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// End of the time range (exclusive).
+	EndTimestamp         *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReprocessUplinksRequest) Reset()         { *m = ReprocessUplinksRequest{} }
+func (m *ReprocessUplinksRequest) String() string { return proto.CompactTextString(m) }
+func (*ReprocessUplinksRequest) ProtoMessage()    {}
+func (*ReprocessUplinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{35}
+}
+func (m *ReprocessUplinksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReprocessUplinksRequest.Unmarshal(m, b)
+}
+func (m *ReprocessUplinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReprocessUplinksRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReprocessUplinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprocessUplinksRequest.Merge(dst, src)
+}
+func (m *ReprocessUplinksRequest) XXX_Size() int {
+	return xxx_messageInfo_ReprocessUplinksRequest.Size(m)
+}
+func (m *ReprocessUplinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprocessUplinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReprocessUplinksRequest proto.InternalMessageInfo
+
+func (m *ReprocessUplinksRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ReprocessUplinksRequest) GetStartTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *ReprocessUplinksRequest) GetEndTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return nil
+}
+
+type ReprocessUplinksResponse struct {
+	// ID of the created job.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReprocessUplinksResponse) Reset()         { *m = ReprocessUplinksResponse{} }
+func (m *ReprocessUplinksResponse) String() string { return proto.CompactTextString(m) }
+func (*ReprocessUplinksResponse) ProtoMessage()    {}
+func (*ReprocessUplinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{36}
+}
+func (m *ReprocessUplinksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReprocessUplinksResponse.Unmarshal(m, b)
+}
+func (m *ReprocessUplinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReprocessUplinksResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReprocessUplinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprocessUplinksResponse.Merge(dst, src)
+}
+func (m *ReprocessUplinksResponse) XXX_Size() int {
+	return xxx_messageInfo_ReprocessUplinksResponse.Size(m)
+}
+func (m *ReprocessUplinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprocessUplinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReprocessUplinksResponse proto.InternalMessageInfo
+
+func (m *ReprocessUplinksResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ReprocessUplinksJob struct {
+	// Job ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Start of the time range (inclusive).
+	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// End of the time range (exclusive).
+	EndTimestamp *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// State of the job (PENDING, RUNNING, DONE or ERROR).
+	State string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	// Number of re-processed uplinks.
+	UplinkCount uint32 `protobuf:"varint,7,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Number of uplinks which could not be re-processed.
+	ErrorCount uint32 `protobuf:"varint,8,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	// Error in case the job failed.
+	Error                string   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReprocessUplinksJob) Reset()         { *m = ReprocessUplinksJob{} }
+func (m *ReprocessUplinksJob) String() string { return proto.CompactTextString(m) }
+func (*ReprocessUplinksJob) ProtoMessage()    {}
+func (*ReprocessUplinksJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{37}
+}
+func (m *ReprocessUplinksJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReprocessUplinksJob.Unmarshal(m, b)
+}
+func (m *ReprocessUplinksJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReprocessUplinksJob.Marshal(b, m, deterministic)
+}
+func (dst *ReprocessUplinksJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprocessUplinksJob.Merge(dst, src)
+}
+func (m *ReprocessUplinksJob) XXX_Size() int {
+	return xxx_messageInfo_ReprocessUplinksJob.Size(m)
+}
+func (m *ReprocessUplinksJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprocessUplinksJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReprocessUplinksJob proto.InternalMessageInfo
+
+func (m *ReprocessUplinksJob) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReprocessUplinksJob) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *ReprocessUplinksJob) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *ReprocessUplinksJob) GetStartTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *ReprocessUplinksJob) GetEndTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return nil
+}
+
+func (m *ReprocessUplinksJob) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ReprocessUplinksJob) GetUplinkCount() uint32 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
+func (m *ReprocessUplinksJob) GetErrorCount() uint32 {
+	if m != nil {
+		return m.ErrorCount
+	}
+	return 0
+}
+
+func (m *ReprocessUplinksJob) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ListReprocessUplinksJobsRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Max number of jobs to return in the result-set.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReprocessUplinksJobsRequest) Reset()         { *m = ListReprocessUplinksJobsRequest{} }
+func (m *ListReprocessUplinksJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReprocessUplinksJobsRequest) ProtoMessage()    {}
+func (*ListReprocessUplinksJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{38}
+}
+func (m *ListReprocessUplinksJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReprocessUplinksJobsRequest.Unmarshal(m, b)
+}
+func (m *ListReprocessUplinksJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReprocessUplinksJobsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListReprocessUplinksJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReprocessUplinksJobsRequest.Merge(dst, src)
+}
+func (m *ListReprocessUplinksJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReprocessUplinksJobsRequest.Size(m)
+}
+func (m *ListReprocessUplinksJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReprocessUplinksJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReprocessUplinksJobsRequest proto.InternalMessageInfo
+
+func (m *ListReprocessUplinksJobsRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ListReprocessUplinksJobsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListReprocessUplinksJobsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListReprocessUplinksJobsResponse struct {
+	// Total number of jobs available within the result-set.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Jobs within this result-set.
+	Result               []*ReprocessUplinksJob `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ListReprocessUplinksJobsResponse) Reset()         { *m = ListReprocessUplinksJobsResponse{} }
+func (m *ListReprocessUplinksJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReprocessUplinksJobsResponse) ProtoMessage()    {}
+func (*ListReprocessUplinksJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_d5e3178db65a73fb, []int{39}
+}
+func (m *ListReprocessUplinksJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReprocessUplinksJobsResponse.Unmarshal(m, b)
+}
+func (m *ListReprocessUplinksJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReprocessUplinksJobsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListReprocessUplinksJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReprocessUplinksJobsResponse.Merge(dst, src)
+}
+func (m *ListReprocessUplinksJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReprocessUplinksJobsResponse.Size(m)
+}
+func (m *ListReprocessUplinksJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReprocessUplinksJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReprocessUplinksJobsResponse proto.InternalMessageInfo
+
+func (m *ListReprocessUplinksJobsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListReprocessUplinksJobsResponse) GetResult() []*ReprocessUplinksJob {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Application)(nil), "api.Application")
+	proto.RegisterType((*ApplicationListItem)(nil), "api.ApplicationListItem")
+	proto.RegisterType((*CreateApplicationRequest)(nil), "api.CreateApplicationRequest")
+	proto.RegisterType((*CreateApplicationResponse)(nil), "api.CreateApplicationResponse")
+	proto.RegisterType((*GetApplicationRequest)(nil), "api.GetApplicationRequest")
+	proto.RegisterType((*GetApplicationResponse)(nil), "api.GetApplicationResponse")
+	proto.RegisterType((*UpdateApplicationRequest)(nil), "api.UpdateApplicationRequest")
+	proto.RegisterType((*GetApplicationMasterKeyRequest)(nil), "api.GetApplicationMasterKeyRequest")
+	proto.RegisterType((*GetApplicationMasterKeyResponse)(nil), "api.GetApplicationMasterKeyResponse")
+	proto.RegisterType((*DeleteApplicationRequest)(nil), "api.DeleteApplicationRequest")
+	proto.RegisterType((*CloneApplicationRequest)(nil), "api.CloneApplicationRequest")
+	proto.RegisterType((*CloneApplicationResponse)(nil), "api.CloneApplicationResponse")
+	proto.RegisterType((*ListApplicationRequest)(nil), "api.ListApplicationRequest")
+	proto.RegisterType((*ListApplicationResponse)(nil), "api.ListApplicationResponse")
+	proto.RegisterType((*HTTPIntegrationHeader)(nil), "api.HTTPIntegrationHeader")
+	proto.RegisterType((*HTTPIntegration)(nil), "api.HTTPIntegration")
+	proto.RegisterType((*HTTPIntegrationEndpoint)(nil), "api.HTTPIntegrationEndpoint")
+	proto.RegisterType((*CreateHTTPIntegrationRequest)(nil), "api.CreateHTTPIntegrationRequest")
+	proto.RegisterType((*GetHTTPIntegrationRequest)(nil), "api.GetHTTPIntegrationRequest")
+	proto.RegisterType((*GetHTTPIntegrationResponse)(nil), "api.GetHTTPIntegrationResponse")
+	proto.RegisterType((*UpdateHTTPIntegrationRequest)(nil), "api.UpdateHTTPIntegrationRequest")
+	proto.RegisterType((*DeleteHTTPIntegrationRequest)(nil), "api.DeleteHTTPIntegrationRequest")
+	proto.RegisterType((*ListIntegrationRequest)(nil), "api.ListIntegrationRequest")
+	proto.RegisterType((*IntegrationListItem)(nil), "api.IntegrationListItem")
+	proto.RegisterType((*ListIntegrationResponse)(nil), "api.ListIntegrationResponse")
+	proto.RegisterType((*InfluxDBIntegration)(nil), "api.InfluxDBIntegration")
+	proto.RegisterType((*CreateInfluxDBIntegrationRequest)(nil), "api.CreateInfluxDBIntegrationRequest")
+	proto.RegisterType((*GetInfluxDBIntegrationRequest)(nil), "api.GetInfluxDBIntegrationRequest")
+	proto.RegisterType((*GetInfluxDBIntegrationResponse)(nil), "api.GetInfluxDBIntegrationResponse")
+	proto.RegisterType((*UpdateInfluxDBIntegrationRequest)(nil), "api.UpdateInfluxDBIntegrationRequest")
+	proto.RegisterType((*DeleteInfluxDBIntegrationRequest)(nil), "api.DeleteInfluxDBIntegrationRequest")
+	proto.RegisterType((*TestIntegrationRequest)(nil), "api.TestIntegrationRequest")
+	proto.RegisterType((*TestIntegrationResponse)(nil), "api.TestIntegrationResponse")
+	proto.RegisterType((*IntegrationTestResult)(nil), "api.IntegrationTestResult")
+	proto.RegisterType((*IntegrationTestRequest)(nil), "api.IntegrationTestRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.IntegrationTestRequest.HeadersEntry")
+	proto.RegisterType((*ReprocessUplinksRequest)(nil), "api.ReprocessUplinksRequest")
+	proto.RegisterType((*ReprocessUplinksResponse)(nil), "api.ReprocessUplinksResponse")
+	proto.RegisterType((*ReprocessUplinksJob)(nil), "api.ReprocessUplinksJob")
+	proto.RegisterType((*ListReprocessUplinksJobsRequest)(nil), "api.ListReprocessUplinksJobsRequest")
+	proto.RegisterType((*ListReprocessUplinksJobsResponse)(nil), "api.ListReprocessUplinksJobsResponse")
+	proto.RegisterEnum("api.IntegrationKind", IntegrationKind_name, IntegrationKind_value)
+	proto.RegisterEnum("api.InfluxDBPrecision", InfluxDBPrecision_name, InfluxDBPrecision_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ApplicationServiceClient is the client API for ApplicationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationServiceClient interface {
+	// Create creates the given application.
+	Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error)
+	// Get returns the requested application.
+	Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
+	// Update updates the given application.
+	Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete deletes the given application.
+	Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List lists the available applications.
+	List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error)
+	// Clone creates a copy of the application within the given organization.
+	// The device-profiles used by the devices are re-created and the
+	// integrations are copied. Optionally, the devices are moved to the copy.
+	Clone(ctx context.Context, in *CloneApplicationRequest, opts ...grpc.CallOption) (*CloneApplicationResponse, error)
+	// CreateHTTPIntegration creates a HTTP application-integration.
+	CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetHTTPIntegration returns the HTTP application-integration.
+	GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error)
+	// UpdateHTTPIntegration updates the HTTP application-integration.
 	UpdateHTTPIntegration(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// DeleteIntegration deletes the HTTP application-integration.
 	DeleteHTTPIntegration(ctx context.Context, in *DeleteHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
@@ -1448,6 +2407,18 @@ type ApplicationServiceClient interface {
 	DeleteInfluxDBIntegration(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// ListIntegrations lists all configured integrations.
 	ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error)
+	// TestIntegration replays canned events against the configured
+	// integration of the given kind and returns the outbound requests made
+	// by the integration.
+	TestIntegration(ctx context.Context, in *TestIntegrationRequest, opts ...grpc.CallOption) (*TestIntegrationResponse, error)
+	// ReprocessUplinks creates a job re-processing the archived uplinks of
+	// the application within the given time range, using the current codec.
+	ReprocessUplinks(ctx context.Context, in *ReprocessUplinksRequest, opts ...grpc.CallOption) (*ReprocessUplinksResponse, error)
+	// ListReprocessUplinksJobs lists the reprocess uplinks jobs of the application.
+	ListReprocessUplinksJobs(ctx context.Context, in *ListReprocessUplinksJobsRequest, opts ...grpc.CallOption) (*ListReprocessUplinksJobsResponse, error)
+	// GetMasterKey returns the master key of the application.
+	// This is restricted to organization and global admin users.
+	GetMasterKey(ctx context.Context, in *GetApplicationMasterKeyRequest, opts ...grpc.CallOption) (*GetApplicationMasterKeyResponse, error)
 }
 
 type applicationServiceClient struct {
@@ -1503,6 +2474,15 @@ func (c *applicationServiceClient) List(ctx context.Context, in *ListApplication
 	return out, nil
 }
 
+func (c *applicationServiceClient) Clone(ctx context.Context, in *CloneApplicationRequest, opts ...grpc.CallOption) (*CloneApplicationResponse, error) {
+	out := new(CloneApplicationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/Clone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateHTTPIntegration", in, out, opts...)
@@ -1584,6 +2564,42 @@ func (c *applicationServiceClient) ListIntegrations(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *applicationServiceClient) TestIntegration(ctx context.Context, in *TestIntegrationRequest, opts ...grpc.CallOption) (*TestIntegrationResponse, error) {
+	out := new(TestIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/TestIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ReprocessUplinks(ctx context.Context, in *ReprocessUplinksRequest, opts ...grpc.CallOption) (*ReprocessUplinksResponse, error) {
+	out := new(ReprocessUplinksResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/ReprocessUplinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListReprocessUplinksJobs(ctx context.Context, in *ListReprocessUplinksJobsRequest, opts ...grpc.CallOption) (*ListReprocessUplinksJobsResponse, error) {
+	out := new(ListReprocessUplinksJobsResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/ListReprocessUplinksJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetMasterKey(ctx context.Context, in *GetApplicationMasterKeyRequest, opts ...grpc.CallOption) (*GetApplicationMasterKeyResponse, error) {
+	out := new(GetApplicationMasterKeyResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetMasterKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationServiceServer is the server API for ApplicationService service.
 type ApplicationServiceServer interface {
 	// Create creates the given application.
@@ -1596,6 +2612,10 @@ type ApplicationServiceServer interface {
 	Delete(context.Context, *DeleteApplicationRequest) (*empty.Empty, error)
 	// List lists the available applications.
 	List(context.Context, *ListApplicationRequest) (*ListApplicationResponse, error)
+	// Clone creates a copy of the application within the given organization.
+	// The device-profiles used by the devices are re-created and the
+	// integrations are copied. Optionally, the devices are moved to the copy.
+	Clone(context.Context, *CloneApplicationRequest) (*CloneApplicationResponse, error)
 	// CreateHTTPIntegration creates a HTTP application-integration.
 	CreateHTTPIntegration(context.Context, *CreateHTTPIntegrationRequest) (*empty.Empty, error)
 	// GetHTTPIntegration returns the HTTP application-integration.
@@ -1614,6 +2634,18 @@ type ApplicationServiceServer interface {
 	DeleteInfluxDBIntegration(context.Context, *DeleteInfluxDBIntegrationRequest) (*empty.Empty, error)
 	// ListIntegrations lists all configured integrations.
 	ListIntegrations(context.Context, *ListIntegrationRequest) (*ListIntegrationResponse, error)
+	// TestIntegration replays canned events against the configured
+	// integration of the given kind and returns the outbound requests made
+	// by the integration.
+	TestIntegration(context.Context, *TestIntegrationRequest) (*TestIntegrationResponse, error)
+	// ReprocessUplinks creates a job re-processing the archived uplinks of
+	// the application within the given time range, using the current codec.
+	ReprocessUplinks(context.Context, *ReprocessUplinksRequest) (*ReprocessUplinksResponse, error)
+	// ListReprocessUplinksJobs lists the reprocess uplinks jobs of the application.
+	ListReprocessUplinksJobs(context.Context, *ListReprocessUplinksJobsRequest) (*ListReprocessUplinksJobsResponse, error)
+	// GetMasterKey returns the master key of the application.
+	// This is restricted to organization and global admin users.
+	GetMasterKey(context.Context, *GetApplicationMasterKeyRequest) (*GetApplicationMasterKeyResponse, error)
 }
 
 func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
@@ -1710,6 +2742,24 @@ func _ApplicationService_List_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_Clone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Clone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/Clone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Clone(ctx, req.(*CloneApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_CreateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateHTTPIntegrationRequest)
 	if err := dec(in); err != nil {
@@ -1872,6 +2922,78 @@ func _ApplicationService_ListIntegrations_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_TestIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).TestIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/TestIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).TestIntegration(ctx, req.(*TestIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ReprocessUplinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReprocessUplinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ReprocessUplinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/ReprocessUplinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ReprocessUplinks(ctx, req.(*ReprocessUplinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ListReprocessUplinksJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReprocessUplinksJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListReprocessUplinksJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/ListReprocessUplinksJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListReprocessUplinksJobs(ctx, req.(*ListReprocessUplinksJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetMasterKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationMasterKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetMasterKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/GetMasterKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetMasterKey(ctx, req.(*GetApplicationMasterKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.ApplicationService",
 	HandlerType: (*ApplicationServiceServer)(nil),
@@ -1896,6 +3018,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _ApplicationService_List_Handler,
 		},
+		{
+			MethodName: "Clone",
+			Handler:    _ApplicationService_Clone_Handler,
+		},
 		{
 			MethodName: "CreateHTTPIntegration",
 			Handler:    _ApplicationService_CreateHTTPIntegration_Handler,
@@ -1932,100 +3058,170 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListIntegrations",
 			Handler:    _ApplicationService_ListIntegrations_Handler,
 		},
+		{
+			MethodName: "TestIntegration",
+			Handler:    _ApplicationService_TestIntegration_Handler,
+		},
+		{
+			MethodName: "ReprocessUplinks",
+			Handler:    _ApplicationService_ReprocessUplinks_Handler,
+		},
+		{
+			MethodName: "ListReprocessUplinksJobs",
+			Handler:    _ApplicationService_ListReprocessUplinksJobs_Handler,
+		},
+		{
+			MethodName: "GetMasterKey",
+			Handler:    _ApplicationService_GetMasterKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "application.proto",
 }
 
-func init() { proto.RegisterFile("application.proto", fileDescriptor_application_ca969d90c31e88a7) }
-
-var fileDescriptor_application_ca969d90c31e88a7 = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xee, 0xda, 0x89, 0x9b, 0x1c, 0xb7, 0x89, 0x3b, 0x49, 0xdc, 0xcd, 0xd6, 0x4d, 0xf3, 0xdb,
-	0xea, 0x47, 0x83, 0x01, 0xbb, 0x84, 0xa8, 0xa0, 0x08, 0xa9, 0xa5, 0x75, 0x9a, 0x5a, 0x4d, 0x43,
-	0xb4, 0x69, 0x2a, 0x2e, 0xaa, 0x5a, 0x13, 0xef, 0x24, 0x1d, 0xb2, 0xd9, 0x5d, 0x76, 0xc7, 0x85,
-	0x80, 0x7a, 0xc3, 0x05, 0x48, 0x5c, 0x21, 0xf5, 0x16, 0x09, 0x21, 0x24, 0x6e, 0x78, 0x04, 0x1e,
-	0x83, 0x57, 0xe8, 0x83, 0xa0, 0xf9, 0xb3, 0xce, 0x66, 0x3d, 0x9b, 0xb4, 0x4e, 0x90, 0xb8, 0xb2,
-	0x67, 0xce, 0x37, 0x67, 0xbe, 0xf3, 0xcd, 0x99, 0x73, 0x66, 0xe1, 0x12, 0x0e, 0x43, 0x8f, 0x76,
-	0x31, 0xa3, 0x81, 0xdf, 0x08, 0xa3, 0x80, 0x05, 0xa8, 0x88, 0x43, 0x6a, 0xd5, 0x76, 0x83, 0x60,
-	0xd7, 0x23, 0x4d, 0x1c, 0xd2, 0x26, 0xf6, 0xfd, 0x80, 0x09, 0x44, 0x2c, 0x21, 0xd6, 0x15, 0x65,
-	0x15, 0xa3, 0xed, 0xde, 0x4e, 0x93, 0xec, 0x87, 0xec, 0x40, 0x1a, 0xed, 0xbf, 0x0a, 0x50, 0xfe,
-	0xec, 0xd0, 0x2b, 0x9a, 0x80, 0x02, 0x75, 0x4d, 0x63, 0xde, 0x58, 0x28, 0x3a, 0x05, 0xea, 0x22,
-	0x04, 0x23, 0x3e, 0xde, 0x27, 0x66, 0x61, 0xde, 0x58, 0x18, 0x77, 0xc4, 0x7f, 0x34, 0x0f, 0x65,
-	0x97, 0xc4, 0xdd, 0x88, 0x86, 0x7c, 0x89, 0x59, 0x14, 0xa6, 0xf4, 0x14, 0xba, 0x01, 0x93, 0x41,
-	0xb4, 0x8b, 0x7d, 0xfa, 0xad, 0xf0, 0xda, 0xa1, 0xae, 0x39, 0x22, 0x5c, 0x4e, 0xa4, 0xa7, 0xdb,
-	0x2d, 0xf4, 0x3e, 0xa0, 0x98, 0x44, 0x2f, 0x68, 0x97, 0x74, 0xc2, 0x28, 0xd8, 0xa1, 0x1e, 0xe1,
-	0xd8, 0x51, 0xe1, 0xb1, 0xa2, 0x2c, 0x1b, 0xd2, 0xd0, 0x6e, 0xa1, 0xeb, 0x70, 0x31, 0xc4, 0x07,
-	0x5e, 0x80, 0xdd, 0x4e, 0x37, 0x70, 0x49, 0xd7, 0x2c, 0x09, 0xe0, 0x05, 0x35, 0x79, 0x8f, 0xcf,
-	0xa1, 0x25, 0xa8, 0x26, 0x20, 0xe2, 0x73, 0x58, 0xd4, 0x91, 0xc4, 0xcc, 0xf3, 0x02, 0x3d, 0xad,
-	0xac, 0x2b, 0xd2, 0xb8, 0x29, 0x6c, 0xe9, 0x55, 0x2e, 0x39, 0xb2, 0x6a, 0xec, 0xc8, 0xaa, 0x16,
-	0x49, 0xad, 0xb2, 0x5f, 0x1b, 0x30, 0x95, 0x52, 0x6f, 0x8d, 0xc6, 0xac, 0xcd, 0xc8, 0xfe, 0x7f,
-	0x5b, 0xc5, 0x9b, 0x30, 0x9d, 0x45, 0x0b, 0x72, 0x52, 0x4c, 0x74, 0x14, 0xbf, 0x8e, 0xf7, 0x89,
-	0xbd, 0x0e, 0xe6, 0xbd, 0x88, 0x60, 0x46, 0x52, 0xb1, 0x3a, 0xe4, 0xab, 0x1e, 0x89, 0x19, 0x5a,
-	0x84, 0x72, 0x2a, 0x2b, 0x45, 0xcc, 0xe5, 0xc5, 0x4a, 0x03, 0x87, 0xb4, 0x91, 0x46, 0xa7, 0x41,
-	0xf6, 0x7b, 0x30, 0xab, 0xf1, 0x17, 0x87, 0x81, 0x1f, 0x93, 0xac, 0x76, 0xf6, 0x0d, 0x98, 0x59,
-	0x25, 0x4c, 0xb3, 0x73, 0x16, 0xb8, 0x06, 0xd5, 0x2c, 0x50, 0xb9, 0x1c, 0x86, 0xe3, 0x3a, 0x98,
-	0x5b, 0xa1, 0x7b, 0x76, 0x31, 0xd7, 0xc1, 0x6c, 0x11, 0x8f, 0x68, 0xfd, 0x65, 0x23, 0xf9, 0xd1,
-	0x80, 0x2a, 0xcf, 0x25, 0x0d, 0x74, 0x1a, 0x46, 0x3d, 0xba, 0x4f, 0x99, 0x42, 0xcb, 0x01, 0xaa,
-	0x42, 0x29, 0xd8, 0xd9, 0x89, 0x09, 0x13, 0x19, 0x56, 0x74, 0xd4, 0x48, 0x97, 0x41, 0x45, 0x6d,
-	0x06, 0x55, 0xa1, 0x14, 0x13, 0x1c, 0x75, 0x9f, 0x8b, 0x0c, 0x1b, 0x77, 0xd4, 0xc8, 0xf6, 0xe0,
-	0xf2, 0x00, 0x11, 0x25, 0xea, 0x35, 0x28, 0xb3, 0x80, 0x61, 0xaf, 0xd3, 0x0d, 0x7a, 0x7e, 0xc2,
-	0x07, 0xc4, 0xd4, 0x3d, 0x3e, 0x83, 0x6e, 0x42, 0x29, 0x22, 0x71, 0xcf, 0xe3, 0xa4, 0x8a, 0x0b,
-	0xe5, 0x45, 0x33, 0x2b, 0x50, 0x72, 0x5d, 0x1c, 0x85, 0xb3, 0x6f, 0xc3, 0xcc, 0x83, 0xc7, 0x8f,
-	0x37, 0xda, 0x3e, 0x23, 0xbb, 0x91, 0x80, 0x3c, 0x20, 0xd8, 0x25, 0x11, 0xaa, 0x40, 0x71, 0x8f,
-	0x1c, 0x88, 0x3d, 0xc6, 0x1d, 0xfe, 0x97, 0xeb, 0xf0, 0x02, 0x7b, 0xbd, 0xe4, 0x4a, 0xc9, 0x81,
-	0xfd, 0x47, 0x11, 0x26, 0x33, 0x1e, 0xd0, 0xff, 0x61, 0x22, 0x75, 0x0e, 0x9d, 0xbe, 0xd0, 0x17,
-	0x53, 0xb3, 0xed, 0x16, 0x5a, 0x82, 0xf3, 0xcf, 0xc5, 0x66, 0xb1, 0xa2, 0x6b, 0x09, 0xba, 0x5a,
-	0x3e, 0x4e, 0x02, 0x45, 0xef, 0xc0, 0x64, 0x2f, 0xf4, 0xa8, 0xbf, 0xd7, 0x71, 0x31, 0xc3, 0x9d,
-	0x5e, 0xe4, 0xa9, 0x8b, 0x7c, 0x51, 0x4e, 0xb7, 0x30, 0xc3, 0x5b, 0xce, 0x1a, 0x5a, 0x84, 0x99,
-	0x2f, 0x03, 0xea, 0x77, 0xfc, 0x80, 0xd1, 0x9d, 0x84, 0x0a, 0x47, 0x4b, 0xb9, 0xa7, 0xb8, 0x71,
-	0x3d, 0x65, 0xe3, 0x6b, 0x6e, 0xc2, 0x34, 0xee, 0xee, 0x0d, 0x2e, 0x91, 0xf7, 0x1a, 0xe1, 0xee,
-	0x5e, 0x76, 0xc5, 0x12, 0x54, 0x49, 0x14, 0x05, 0xd1, 0xe0, 0x1a, 0x79, 0xb7, 0xa7, 0x85, 0x35,
-	0xbb, 0xea, 0x16, 0x5c, 0x8e, 0x19, 0x66, 0xbd, 0x78, 0x70, 0x99, 0xac, 0x98, 0x33, 0xd2, 0x9c,
-	0x5d, 0xb7, 0x0c, 0xb3, 0x5e, 0xa0, 0xc0, 0x03, 0x2b, 0x65, 0xd5, 0xbc, 0x9c, 0x00, 0x32, 0x6b,
-	0xed, 0x27, 0x50, 0x93, 0x15, 0x20, 0xa3, 0x6f, 0x92, 0xe6, 0xb7, 0xa0, 0x4c, 0x0f, 0x67, 0xd5,
-	0x0d, 0x9b, 0xd6, 0x9d, 0x88, 0x93, 0x06, 0xda, 0x77, 0x61, 0x76, 0x95, 0xb0, 0x1c, 0xa7, 0x6f,
-	0x96, 0x09, 0xf6, 0x63, 0xb0, 0x74, 0x3e, 0x54, 0xda, 0x0f, 0xcb, 0xec, 0x09, 0xd4, 0x64, 0x3d,
-	0x39, 0xe3, 0x88, 0x57, 0xa0, 0x26, 0xeb, 0xca, 0xe9, 0x82, 0xbe, 0x2d, 0x2b, 0xce, 0x69, 0x1c,
-	0x4c, 0xa5, 0x16, 0xf7, 0x3b, 0xe1, 0x02, 0x8c, 0xec, 0x51, 0x5f, 0xae, 0x99, 0x50, 0xf1, 0xa4,
-	0x70, 0x0f, 0xa9, 0xef, 0x3a, 0x02, 0x91, 0x94, 0x1a, 0x9d, 0xe6, 0x43, 0x96, 0x1a, 0x0d, 0x9f,
-	0x7e, 0xa9, 0xf9, 0xa9, 0xc0, 0xf9, 0xee, 0x78, 0xbd, 0x6f, 0x5a, 0x77, 0x87, 0xa8, 0x16, 0x16,
-	0x8c, 0x11, 0xdf, 0x0d, 0x03, 0xea, 0x33, 0x55, 0x81, 0xfa, 0x63, 0x5e, 0xcd, 0xdd, 0x6d, 0x55,
-	0x06, 0x0a, 0xee, 0x36, 0xc7, 0xf6, 0x62, 0x12, 0x89, 0x1e, 0x2b, 0xaf, 0x7b, 0x7f, 0xcc, 0x6d,
-	0x21, 0x8e, 0xe3, 0xaf, 0x83, 0x28, 0xe9, 0xd7, 0xfd, 0x31, 0xaf, 0x19, 0x11, 0x61, 0xc4, 0x17,
-	0x44, 0xc2, 0xc0, 0xa3, 0xdd, 0x83, 0x74, 0xa3, 0x9e, 0xea, 0x1b, 0x37, 0x84, 0x8d, 0x77, 0x6a,
-	0xb4, 0x04, 0xe3, 0x61, 0x44, 0xba, 0x34, 0xe6, 0x39, 0x74, 0x5e, 0x68, 0x5e, 0x55, 0x5a, 0xc8,
-	0x58, 0x37, 0x12, 0xab, 0x73, 0x08, 0xb4, 0x9f, 0xc1, 0xbc, 0xbc, 0x8d, 0x1a, 0x45, 0x92, 0x34,
-	0x58, 0xd6, 0xe5, 0xa7, 0x79, 0xc4, 0x77, 0x6e, 0x8e, 0xde, 0x87, 0xab, 0xab, 0x84, 0x1d, 0xe3,
-	0xfc, 0x0d, 0x73, 0xec, 0x29, 0xcc, 0xe5, 0xf9, 0x51, 0x99, 0x72, 0x1a, 0x96, 0xcf, 0x60, 0x5e,
-	0xde, 0xd0, 0x7f, 0x49, 0x85, 0x36, 0xcc, 0xcb, 0x9b, 0x7a, 0x6a, 0x21, 0xea, 0xef, 0xc2, 0x64,
-	0xe6, 0x12, 0xa1, 0x31, 0x18, 0xe1, 0x15, 0xa0, 0x72, 0x0e, 0x5d, 0x80, 0xb1, 0xf6, 0xfa, 0xfd,
-	0xb5, 0xad, 0x2f, 0x5a, 0x77, 0x2b, 0x46, 0xfd, 0x36, 0x5c, 0x1a, 0x38, 0x7b, 0x54, 0x82, 0xc2,
-	0xfa, 0x66, 0xe5, 0x1c, 0x1a, 0x05, 0x63, 0xab, 0x62, 0xf0, 0xe1, 0xa3, 0xcd, 0x4a, 0x81, 0x0f,
-	0x37, 0x2b, 0x45, 0xfe, 0xf3, 0xa8, 0x32, 0xc2, 0x7f, 0x1e, 0x54, 0x46, 0x17, 0x7f, 0x9b, 0x04,
-	0x94, 0x6a, 0xda, 0x9b, 0xf2, 0x79, 0x88, 0x08, 0x94, 0x64, 0xce, 0xa0, 0xab, 0x22, 0xfc, 0xbc,
-	0x07, 0xa2, 0x35, 0x97, 0x67, 0x96, 0x47, 0x66, 0xd7, 0xbe, 0xff, 0xfb, 0xf5, 0xab, 0x42, 0xd5,
-	0xbe, 0x24, 0x3f, 0x5f, 0x0e, 0x11, 0xf1, 0xb2, 0x51, 0x47, 0xcf, 0xa0, 0xb8, 0x4a, 0x18, 0x92,
-	0xcd, 0x58, 0xfb, 0x0e, 0xb4, 0xae, 0x68, 0x6d, 0xca, 0xfb, 0x9c, 0xf0, 0x6e, 0xa2, 0xea, 0x80,
-	0xf7, 0xe6, 0x77, 0xd4, 0x7d, 0x89, 0x7c, 0x28, 0xc9, 0x43, 0x57, 0x61, 0xe4, 0xbd, 0xf9, 0xac,
-	0x6a, 0x43, 0x7e, 0x46, 0x35, 0x92, 0xcf, 0xa8, 0xc6, 0x0a, 0xff, 0x8c, 0xb2, 0x3f, 0x10, 0x1b,
-	0xdc, 0xb0, 0x6c, 0xcd, 0x06, 0xe9, 0xcf, 0x35, 0xea, 0xbe, 0xe4, 0xf1, 0x74, 0xa0, 0x24, 0x93,
-	0x40, 0xed, 0x97, 0xf7, 0x26, 0xcc, 0xdd, 0x4f, 0x05, 0x54, 0xcf, 0x0b, 0xe8, 0x29, 0x8c, 0xf0,
-	0x62, 0x87, 0xa4, 0x2a, 0xfa, 0x57, 0xa4, 0x55, 0xd3, 0x1b, 0x95, 0x66, 0xb3, 0x62, 0x8b, 0x29,
-	0x34, 0x78, 0x22, 0xe8, 0x57, 0x03, 0x66, 0xb4, 0x8d, 0x1b, 0xfd, 0x2f, 0x75, 0xcc, 0xfa, 0x56,
-	0x94, 0x1b, 0xd2, 0x43, 0xb1, 0xdf, 0x8a, 0x7d, 0x47, 0x17, 0xd2, 0xa1, 0x9b, 0xc6, 0xd1, 0x9b,
-	0xf1, 0xb2, 0x99, 0xb2, 0xc5, 0xcd, 0xe7, 0x8c, 0x85, 0x5c, 0xe0, 0x57, 0x06, 0xa0, 0xc1, 0xf6,
-	0x8d, 0xe6, 0x92, 0x24, 0xc9, 0xe1, 0x76, 0x2d, 0xd7, 0xae, 0x44, 0xf9, 0x54, 0x90, 0xbc, 0x85,
-	0x96, 0x8e, 0x3f, 0x67, 0x3d, 0x31, 0xa1, 0x9b, 0xb6, 0xfd, 0x2b, 0xdd, 0x8e, 0x7b, 0x1a, 0x9c,
-	0xa4, 0x9b, 0x75, 0x26, 0xba, 0xfd, 0x6c, 0xc0, 0x8c, 0xf6, 0x21, 0xa1, 0x18, 0x1e, 0xf7, 0xc8,
-	0xc8, 0x65, 0xa8, 0x44, 0xab, 0x0f, 0x27, 0xda, 0x9f, 0x46, 0xf2, 0x9d, 0xa8, 0xed, 0xd4, 0xa9,
-	0x84, 0xcb, 0xaf, 0xa8, 0xb9, 0xd4, 0x3e, 0x17, 0xd4, 0xda, 0x76, 0xeb, 0x34, 0xe2, 0x51, 0xb1,
-	0xaf, 0xbb, 0xcd, 0x05, 0xfc, 0xdd, 0x10, 0xdf, 0x9f, 0x3a, 0xaa, 0x76, 0x92, 0x5c, 0xc7, 0xf0,
-	0xbc, 0x7e, 0x2c, 0x46, 0x25, 0xe1, 0x1d, 0x41, 0x7a, 0x19, 0x7d, 0xf2, 0xb6, 0x7a, 0x26, 0x44,
-	0x85, 0xa6, 0xb9, 0x5d, 0x4e, 0x69, 0x7a, 0x52, 0x17, 0x3c, 0x49, 0x53, 0xeb, 0xcc, 0x34, 0xfd,
-	0xc5, 0x80, 0xd9, 0xdc, 0x9e, 0xa9, 0xd8, 0x9e, 0xd4, 0x53, 0x73, 0xd9, 0x2a, 0x31, 0xeb, 0xc3,
-	0x8b, 0xf9, 0x83, 0x01, 0x95, 0xcc, 0x9b, 0x35, 0x4e, 0x15, 0x5e, 0x0d, 0x97, 0x9a, 0xde, 0xa8,
-	0x8e, 0xf7, 0x63, 0xc1, 0xe8, 0x43, 0xd4, 0x7c, 0x4b, 0x46, 0xdb, 0x25, 0x11, 0xda, 0x47, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x15, 0x7f, 0x76, 0x21, 0x14, 0x00, 0x00,
+func init() { proto.RegisterFile("application.proto", fileDescriptor_application_d5e3178db65a73fb) }
+
+var fileDescriptor_application_d5e3178db65a73fb = []byte{
+	// 2256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdd, 0x6e, 0xdb, 0xc8,
+	0x15, 0x5e, 0x4a, 0xb2, 0x6c, 0x1d, 0xf9, 0x47, 0x1e, 0xdb, 0xb2, 0xac, 0xf8, 0x47, 0x61, 0xb2,
+	0x8d, 0xd7, 0x6d, 0xec, 0xd4, 0x35, 0xb2, 0xbb, 0x46, 0x01, 0x27, 0xb1, 0x1c, 0xc7, 0x4d, 0xec,
+	0x06, 0xb4, 0xbd, 0x68, 0x81, 0x45, 0x08, 0x8a, 0x1c, 0xc7, 0xac, 0x29, 0x92, 0x25, 0x47, 0x6e,
+	0x95, 0x36, 0x37, 0x45, 0xd1, 0x02, 0x05, 0x16, 0x68, 0xb1, 0x37, 0x45, 0x51, 0xa0, 0x17, 0x45,
+	0xaf, 0xfa, 0x00, 0x7d, 0x83, 0xbe, 0x40, 0xdf, 0xa0, 0xd8, 0x87, 0xe8, 0x65, 0x31, 0x3f, 0xa4,
+	0x68, 0x6a, 0x28, 0xff, 0xa5, 0x40, 0xaf, 0xec, 0x99, 0x73, 0xce, 0xcc, 0x77, 0x3e, 0x9e, 0x39,
+	0x67, 0xce, 0x08, 0x26, 0x0d, 0xdf, 0x77, 0x6c, 0xd3, 0x20, 0xb6, 0xe7, 0xae, 0xfa, 0x81, 0x47,
+	0x3c, 0x94, 0x37, 0x7c, 0xbb, 0x3e, 0xff, 0xd6, 0xf3, 0xde, 0x3a, 0x78, 0xcd, 0xf0, 0xed, 0x35,
+	0xc3, 0x75, 0x3d, 0xc2, 0x34, 0x42, 0xae, 0x52, 0xbf, 0x23, 0xa4, 0x6c, 0xd4, 0xea, 0x9c, 0xac,
+	0xe1, 0xb6, 0x4f, 0xba, 0x42, 0xb8, 0x94, 0x16, 0x12, 0xbb, 0x8d, 0x43, 0x62, 0xb4, 0x7d, 0xa1,
+	0xb0, 0x98, 0x56, 0xb0, 0x3a, 0x41, 0x02, 0x80, 0xfa, 0xc7, 0x02, 0x94, 0x9f, 0xf6, 0x60, 0xa1,
+	0x71, 0xc8, 0xd9, 0x56, 0x4d, 0x69, 0x28, 0xcb, 0x79, 0x2d, 0x67, 0x5b, 0x08, 0x41, 0xc1, 0x35,
+	0xda, 0xb8, 0x96, 0x6b, 0x28, 0xcb, 0x25, 0x8d, 0xfd, 0x8f, 0x1a, 0x50, 0xb6, 0x70, 0x68, 0x06,
+	0xb6, 0x4f, 0x4d, 0x6a, 0x79, 0x26, 0x4a, 0x4e, 0xa1, 0x07, 0x30, 0xe1, 0x05, 0x6f, 0x0d, 0xd7,
+	0x7e, 0xc7, 0x56, 0xd5, 0x6d, 0xab, 0x56, 0x60, 0x4b, 0x8e, 0x27, 0xa7, 0xf7, 0x9a, 0xe8, 0x3b,
+	0x80, 0x42, 0x1c, 0x9c, 0xdb, 0x26, 0xd6, 0xfd, 0xc0, 0x3b, 0xb1, 0x1d, 0x4c, 0x75, 0x87, 0xd8,
+	0x8a, 0x15, 0x21, 0x79, 0xcd, 0x05, 0x7b, 0x4d, 0x74, 0x0f, 0xc6, 0x7c, 0xa3, 0xeb, 0x78, 0x86,
+	0xa5, 0x9b, 0x9e, 0x85, 0xcd, 0x5a, 0x91, 0x29, 0x8e, 0x8a, 0xc9, 0x6d, 0x3a, 0x87, 0x36, 0xa0,
+	0x1a, 0x29, 0x61, 0x97, 0xaa, 0x05, 0x3a, 0x07, 0x56, 0x1b, 0x66, 0xda, 0xd3, 0x42, 0xba, 0xc3,
+	0x85, 0x87, 0x4c, 0x96, 0xb4, 0xb2, 0xf0, 0x05, 0xab, 0x91, 0x0b, 0x56, 0x4d, 0x9c, 0xb4, 0x5a,
+	0x00, 0x68, 0x1b, 0x21, 0xc1, 0x81, 0x7e, 0x86, 0xbb, 0xb5, 0x12, 0xd3, 0x2c, 0xf1, 0x99, 0x97,
+	0xb8, 0x4b, 0x69, 0x30, 0x02, 0xf3, 0xd4, 0x3e, 0xc7, 0x7a, 0xc7, 0x77, 0x6c, 0xf7, 0x2c, 0xac,
+	0x41, 0x43, 0x59, 0x1e, 0xd1, 0xc6, 0xc5, 0xf4, 0x31, 0x9f, 0xa5, 0x8e, 0x05, 0xd8, 0x32, 0x4c,
+	0xa2, 0x9f, 0xd8, 0xd8, 0xb1, 0xc2, 0x5a, 0xb9, 0x91, 0xa7, 0x8e, 0xf1, 0xc9, 0xe7, 0x6c, 0x0e,
+	0xbd, 0x00, 0x74, 0x8a, 0x8d, 0x80, 0xb4, 0xb0, 0x41, 0x74, 0xdb, 0x25, 0x38, 0x38, 0x37, 0x9c,
+	0xda, 0x68, 0x43, 0x59, 0x2e, 0xaf, 0xcf, 0xad, 0xf2, 0xef, 0xbc, 0x1a, 0x7d, 0xe7, 0xd5, 0xa6,
+	0xf8, 0xce, 0xda, 0x64, 0x6c, 0xb4, 0x27, 0x6c, 0xd0, 0x7d, 0x18, 0x3f, 0x35, 0x42, 0x3d, 0x01,
+	0x7d, 0x8c, 0xc1, 0x1a, 0x3d, 0x35, 0xc2, 0xfd, 0x08, 0xbd, 0xfa, 0x8d, 0x02, 0x53, 0x89, 0xd0,
+	0x78, 0x65, 0x87, 0x64, 0x8f, 0xe0, 0xf6, 0xff, 0x77, 0x88, 0x3c, 0x82, 0xe9, 0xb4, 0x36, 0x03,
+	0xc7, 0x23, 0x05, 0x5d, 0xd4, 0x3f, 0x30, 0xda, 0x58, 0x3d, 0x80, 0xda, 0x76, 0x80, 0x0d, 0x82,
+	0x13, 0xbe, 0x6a, 0xf8, 0xa7, 0x1d, 0x1c, 0x12, 0xb4, 0x0e, 0xe5, 0xc4, 0x99, 0x65, 0x3e, 0x97,
+	0xd7, 0x2b, 0xab, 0x86, 0x6f, 0xaf, 0x26, 0xb5, 0x93, 0x4a, 0xea, 0xb7, 0x61, 0x4e, 0xb2, 0x5e,
+	0xe8, 0x7b, 0x6e, 0x88, 0xd3, 0xdc, 0xa9, 0x0f, 0x60, 0x66, 0x17, 0x13, 0xc9, 0xce, 0x69, 0xc5,
+	0x57, 0x50, 0x4d, 0x2b, 0x8a, 0x25, 0x6f, 0x82, 0xf1, 0x1d, 0xd4, 0x8e, 0x7d, 0xeb, 0x83, 0xf9,
+	0x8c, 0x56, 0x60, 0x32, 0xc0, 0x6d, 0xef, 0x1c, 0x27, 0x63, 0x2a, 0xc7, 0x62, 0x6a, 0x82, 0x0b,
+	0x7a, 0x61, 0xf5, 0x08, 0x16, 0x2f, 0x7a, 0x12, 0x8b, 0xb2, 0x7c, 0x7f, 0x02, 0x4b, 0x99, 0x16,
+	0x82, 0x84, 0x8b, 0x07, 0x51, 0x49, 0x1d, 0x44, 0x75, 0x05, 0x6a, 0x4d, 0xec, 0x60, 0xa9, 0xbf,
+	0xe9, 0xdd, 0xfe, 0xa1, 0xc0, 0xec, 0xb6, 0xe3, 0xb9, 0x57, 0xd0, 0x95, 0x05, 0x71, 0xee, 0x1a,
+	0x41, 0x9c, 0xcf, 0x08, 0xe2, 0xe8, 0x44, 0x15, 0x12, 0x27, 0xea, 0x2e, 0x8c, 0x32, 0x82, 0x2d,
+	0x4c, 0x75, 0x43, 0x76, 0x00, 0x46, 0xb4, 0x32, 0x9d, 0x6b, 0xf2, 0x29, 0xea, 0x65, 0x3f, 0xf0,
+	0x8c, 0xc0, 0xfb, 0xad, 0x02, 0x55, 0x7a, 0xa2, 0x25, 0x4e, 0x4e, 0xc3, 0x90, 0x63, 0xb7, 0x6d,
+	0x22, 0xb4, 0xf9, 0x00, 0x55, 0xa1, 0xe8, 0x9d, 0x9c, 0x84, 0x98, 0x08, 0x0f, 0xc5, 0x48, 0x46,
+	0x41, 0x5e, 0x4a, 0x41, 0x15, 0x8a, 0x21, 0xa6, 0x79, 0x4f, 0xb8, 0x25, 0x46, 0xaa, 0x03, 0xb3,
+	0x7d, 0x40, 0x04, 0xe8, 0x25, 0x28, 0x13, 0x8f, 0x18, 0x8e, 0x6e, 0x7a, 0x1d, 0x37, 0xc2, 0x03,
+	0x6c, 0x6a, 0x9b, 0xce, 0xa0, 0x47, 0x50, 0x0c, 0x70, 0xd8, 0x71, 0x28, 0xa8, 0xfc, 0x72, 0x79,
+	0xbd, 0x96, 0x0e, 0xd3, 0x28, 0x69, 0x69, 0x42, 0x4f, 0xdd, 0x82, 0x99, 0x17, 0x47, 0x47, 0xaf,
+	0x69, 0x2a, 0x7c, 0xcb, 0x13, 0xe4, 0x0b, 0x6c, 0x58, 0x38, 0x40, 0x15, 0xc8, 0xf7, 0x42, 0x87,
+	0xfe, 0x4b, 0x79, 0x38, 0x37, 0x9c, 0x4e, 0x94, 0xd8, 0xf8, 0x40, 0xfd, 0x4f, 0x01, 0x26, 0x52,
+	0x2b, 0xa0, 0x8f, 0x61, 0x3c, 0x71, 0x1a, 0xf4, 0x98, 0xe8, 0xb1, 0xc4, 0xec, 0x5e, 0x13, 0x6d,
+	0xc0, 0xf0, 0x29, 0xdb, 0x2c, 0x14, 0x70, 0xeb, 0x0c, 0xae, 0x14, 0x8f, 0x16, 0xa9, 0xa2, 0x6f,
+	0xc1, 0x04, 0x2f, 0x1e, 0xba, 0x65, 0x10, 0x43, 0xef, 0x04, 0x8e, 0x88, 0x9b, 0x31, 0x3e, 0xdd,
+	0x34, 0x88, 0x71, 0xac, 0xbd, 0x42, 0xeb, 0x30, 0xf3, 0x13, 0xcf, 0x76, 0x75, 0xd7, 0x23, 0xf6,
+	0x49, 0x04, 0x85, 0x6a, 0x73, 0xba, 0xa7, 0xa8, 0xf0, 0x20, 0x21, 0xa3, 0x36, 0x8f, 0x60, 0xda,
+	0x30, 0xcf, 0xfa, 0x4d, 0x78, 0x76, 0x45, 0x86, 0x79, 0x96, 0xb6, 0xd8, 0x80, 0x2a, 0x0e, 0x02,
+	0x2f, 0xe8, 0xb7, 0xe1, 0x19, 0x76, 0x9a, 0x49, 0xd3, 0x56, 0x8f, 0x61, 0x36, 0x24, 0x06, 0xe9,
+	0x84, 0xfd, 0x66, 0xbc, 0x28, 0xcf, 0x70, 0x71, 0xda, 0x6e, 0x13, 0xe6, 0x1c, 0x4f, 0x28, 0xf7,
+	0x59, 0xf2, 0xc2, 0x3c, 0x1b, 0x29, 0xf4, 0xdb, 0x96, 0xb0, 0x6b, 0xf9, 0x9e, 0xed, 0x92, 0xb0,
+	0x56, 0x62, 0x7c, 0xcf, 0xcb, 0xf8, 0xde, 0x11, 0x4a, 0x5a, 0x4f, 0x9d, 0x06, 0xb5, 0x69, 0x38,
+	0x4e, 0x8b, 0x92, 0x13, 0x62, 0x33, 0xc0, 0x84, 0x15, 0xee, 0x92, 0x36, 0x1e, 0x4d, 0x1f, 0xb2,
+	0x59, 0x5a, 0xe7, 0x4c, 0xaf, 0xed, 0x07, 0x38, 0x0c, 0x69, 0xb2, 0x2c, 0xf3, 0x3a, 0x97, 0x98,
+	0xa2, 0x99, 0xa9, 0x65, 0x10, 0xf3, 0x54, 0x0f, 0xed, 0x77, 0x98, 0x55, 0xeb, 0x31, 0xad, 0xc4,
+	0x66, 0x0e, 0xed, 0x77, 0x98, 0x66, 0x4e, 0x2e, 0x8e, 0x0a, 0xba, 0xde, 0x0e, 0x59, 0x35, 0x1e,
+	0xd3, 0x26, 0x98, 0x20, 0x2a, 0xda, 0xfb, 0x87, 0xea, 0x8f, 0x61, 0x36, 0x03, 0x3b, 0x8d, 0x55,
+	0x7c, 0x8e, 0xc5, 0x19, 0x29, 0x69, 0x7c, 0x40, 0x63, 0x9a, 0x12, 0xc5, 0xe3, 0x97, 0xfe, 0x4b,
+	0x0f, 0xe1, 0x89, 0xed, 0x10, 0x1c, 0x88, 0x18, 0x12, 0x23, 0xf5, 0x0b, 0x98, 0xe7, 0x45, 0x2b,
+	0xb5, 0x41, 0x94, 0x13, 0x1e, 0x43, 0xd9, 0xee, 0xcd, 0x8a, 0xa2, 0x30, 0x2d, 0xa3, 0x53, 0x4b,
+	0x2a, 0xaa, 0xcf, 0x60, 0x6e, 0x17, 0x93, 0x8c, 0x45, 0xaf, 0x76, 0x6c, 0xd4, 0x23, 0xa8, 0xcb,
+	0xd6, 0x10, 0x39, 0xe2, 0xa6, 0xc8, 0xbe, 0x80, 0x79, 0x5e, 0x02, 0x3f, 0xb0, 0xc7, 0x3b, 0x30,
+	0xcf, 0x4b, 0xcd, 0xed, 0x9c, 0xde, 0xe2, 0xe9, 0xf9, 0x36, 0x0b, 0x4c, 0x25, 0x8c, 0xe3, 0xcb,
+	0xdb, 0x32, 0x14, 0xce, 0x6c, 0x97, 0xdb, 0x8c, 0x0b, 0x7f, 0x12, 0x7a, 0x2f, 0x6d, 0xd7, 0xd2,
+	0x98, 0x46, 0x94, 0x97, 0x65, 0x9c, 0xdf, 0x30, 0x2f, 0x4b, 0xf0, 0xc4, 0x79, 0xf9, 0x77, 0x39,
+	0x8a, 0xf7, 0xc4, 0xe9, 0xfc, 0xbc, 0xf9, 0xec, 0x06, 0xa9, 0xb5, 0x0e, 0x23, 0xd1, 0xe9, 0x15,
+	0xe1, 0x1e, 0x8f, 0x69, 0xe9, 0xb3, 0x5a, 0x22, 0xde, 0x73, 0x56, 0x8b, 0xea, 0x76, 0x42, 0x1c,
+	0x24, 0x2a, 0x6c, 0x3c, 0xa6, 0x32, 0xdf, 0x08, 0xc3, 0x9f, 0x79, 0x41, 0x74, 0xc5, 0x8c, 0xc7,
+	0x34, 0xc1, 0x06, 0x98, 0x60, 0x97, 0x01, 0xf1, 0x3d, 0xc7, 0x36, 0xbb, 0xc9, 0xbb, 0xe5, 0x54,
+	0x2c, 0x7c, 0xcd, 0x64, 0xf4, 0x72, 0x89, 0x36, 0xa0, 0xe4, 0x07, 0xd8, 0xb4, 0x59, 0x76, 0x18,
+	0x66, 0x9c, 0x57, 0x05, 0x17, 0xdc, 0xd7, 0xd7, 0x91, 0x54, 0xeb, 0x29, 0xaa, 0x6f, 0xa0, 0xc1,
+	0x4f, 0xa3, 0x84, 0x91, 0x28, 0x0c, 0x36, 0x65, 0xf1, 0x59, 0xbb, 0xb0, 0x76, 0x66, 0x8c, 0x3e,
+	0x87, 0x85, 0x5d, 0x4c, 0x06, 0x2c, 0x7e, 0xc5, 0x18, 0xfb, 0x12, 0x16, 0xb3, 0xd6, 0x11, 0x91,
+	0x72, 0x1b, 0x94, 0x6f, 0xa0, 0xc1, 0x4f, 0xe8, 0xff, 0x88, 0x85, 0x3d, 0x68, 0xf0, 0x93, 0x7a,
+	0x7b, 0x22, 0xfe, 0xa4, 0x40, 0xf5, 0x08, 0xdf, 0xe2, 0xb8, 0xc6, 0xe7, 0x32, 0x77, 0xd9, 0xb9,
+	0xa4, 0x29, 0x9c, 0x65, 0xf7, 0xb0, 0x96, 0x67, 0x4d, 0xa2, 0x18, 0xa1, 0x59, 0x18, 0xb6, 0x82,
+	0xae, 0x1e, 0x74, 0x5c, 0x16, 0xd5, 0x23, 0x5a, 0xd1, 0x0a, 0xba, 0x5a, 0xc7, 0x55, 0xf7, 0x61,
+	0xb6, 0x0f, 0x5b, 0xdc, 0x3b, 0x44, 0xe7, 0x54, 0x49, 0x5c, 0x48, 0x12, 0x9a, 0xd4, 0x50, 0x63,
+	0x1a, 0xf1, 0x49, 0xfd, 0x25, 0xcc, 0x48, 0x15, 0x32, 0x6a, 0xd0, 0xa7, 0x30, 0x12, 0x70, 0x2a,
+	0xa2, 0x5b, 0xcf, 0x1d, 0xf9, 0x26, 0x4c, 0x47, 0x8b, 0x95, 0xd9, 0x72, 0xf4, 0x2e, 0x21, 0x4e,
+	0x2e, 0x1f, 0xa8, 0x5f, 0xe5, 0xa0, 0x2a, 0x37, 0xa5, 0xc4, 0xb4, 0x31, 0x39, 0xf5, 0x2c, 0x01,
+	0x40, 0x8c, 0x24, 0x55, 0xf0, 0x59, 0xef, 0x22, 0x96, 0x67, 0x90, 0x96, 0x07, 0x40, 0x5a, 0xe5,
+	0x17, 0xb2, 0x70, 0xc7, 0x25, 0x41, 0xb7, 0x77, 0x2d, 0x43, 0x50, 0x68, 0x79, 0x56, 0x97, 0x71,
+	0x3d, 0xaa, 0xb1, 0xff, 0x69, 0x5e, 0x14, 0xd7, 0x1c, 0xfa, 0x48, 0xc0, 0x12, 0xc8, 0x98, 0x06,
+	0x7c, 0x8a, 0x3e, 0x4e, 0xf4, 0x7c, 0x2a, 0x26, 0x7c, 0xaa, 0x6f, 0xc2, 0x68, 0x72, 0x8f, 0xab,
+	0x5e, 0x45, 0x37, 0x73, 0x9f, 0x29, 0xea, 0x3f, 0x15, 0x98, 0xd5, 0xb0, 0x1f, 0x78, 0x26, 0x0e,
+	0x43, 0xf1, 0x9c, 0x70, 0xcd, 0xd0, 0xdb, 0x86, 0x89, 0x90, 0x18, 0x01, 0xd1, 0xe3, 0xb7, 0x23,
+	0xb6, 0x0d, 0x8d, 0x86, 0xf4, 0xa3, 0xc2, 0x51, 0xa4, 0xa1, 0x8d, 0x33, 0x93, 0x78, 0x8c, 0xb6,
+	0x60, 0x0c, 0xbb, 0x56, 0x62, 0x89, 0xfc, 0xa5, 0x4b, 0x8c, 0x62, 0xd7, 0x8a, 0x47, 0xb4, 0x79,
+	0xe9, 0xf7, 0xa3, 0xaf, 0x79, 0x29, 0xb1, 0xe6, 0xe5, 0x0f, 0x79, 0x98, 0x4a, 0x2b, 0xff, 0xc0,
+	0x6b, 0xa5, 0xf5, 0xd0, 0xe7, 0x00, 0x26, 0xcb, 0xa3, 0x96, 0x6e, 0x90, 0x2b, 0x38, 0x55, 0x12,
+	0xda, 0x4f, 0x09, 0x35, 0xed, 0xf8, 0x56, 0x64, 0x7a, 0xb9, 0x33, 0x25, 0xa1, 0xfd, 0x94, 0xc8,
+	0xf8, 0x2c, 0xdc, 0x9e, 0xcf, 0xa1, 0xeb, 0xf1, 0x49, 0x43, 0x86, 0x06, 0x5e, 0x54, 0x9d, 0xf8,
+	0x80, 0x76, 0x91, 0xa2, 0x99, 0xe0, 0xa5, 0x7b, 0x98, 0x85, 0x68, 0x99, 0xcf, 0xf1, 0xda, 0xbd,
+	0x04, 0x65, 0x7e, 0xc3, 0xe7, 0x1a, 0x23, 0x3c, 0x88, 0xd9, 0x14, 0x57, 0x88, 0x83, 0xb8, 0x94,
+	0x3c, 0x98, 0xe7, 0xb0, 0x44, 0x8b, 0xba, 0xe4, 0xb3, 0x5c, 0x37, 0x1e, 0xe3, 0xfe, 0x33, 0x27,
+	0xef, 0x3f, 0xf3, 0xc9, 0xfe, 0x53, 0xed, 0x40, 0x23, 0x7b, 0xdf, 0xdb, 0xdd, 0x57, 0x24, 0x6b,
+	0x46, 0x59, 0x70, 0xe5, 0x13, 0x98, 0x48, 0xa5, 0x67, 0x34, 0x02, 0x05, 0x7a, 0xe7, 0xab, 0x7c,
+	0x84, 0x46, 0x61, 0x64, 0xef, 0xe0, 0xf9, 0xab, 0xe3, 0x1f, 0x35, 0x9f, 0x55, 0x94, 0x95, 0x2d,
+	0x98, 0xec, 0xab, 0xf6, 0xa8, 0x08, 0xb9, 0x83, 0xc3, 0xca, 0x47, 0x68, 0x08, 0x94, 0xe3, 0x8a,
+	0x42, 0x87, 0xfb, 0x87, 0x95, 0x1c, 0x1d, 0x1e, 0x56, 0xf2, 0xf4, 0xcf, 0x7e, 0xa5, 0x40, 0xff,
+	0xbc, 0xa8, 0x0c, 0xad, 0xff, 0x7b, 0x1a, 0x50, 0xa2, 0xa7, 0x3d, 0xe4, 0xcf, 0x05, 0x08, 0x43,
+	0x91, 0xdf, 0x12, 0xd0, 0x02, 0x83, 0x9b, 0xf5, 0x8a, 0x55, 0x5f, 0xcc, 0x12, 0x73, 0x7a, 0xd4,
+	0xf9, 0x5f, 0xfd, 0xeb, 0x9b, 0xaf, 0x73, 0x55, 0x75, 0x92, 0xbf, 0x40, 0xf7, 0x34, 0xc2, 0x4d,
+	0x65, 0x05, 0xbd, 0x81, 0xfc, 0x2e, 0x26, 0x88, 0x97, 0x06, 0xe9, 0x63, 0x55, 0xfd, 0x8e, 0x54,
+	0x26, 0x56, 0x5f, 0x64, 0xab, 0xd7, 0x50, 0xb5, 0x6f, 0xf5, 0xb5, 0x5f, 0xd8, 0xd6, 0x7b, 0xe4,
+	0x42, 0x91, 0x97, 0x79, 0xe1, 0x46, 0xd6, 0xc3, 0x54, 0xbd, 0xda, 0x17, 0xfb, 0x3b, 0xf4, 0x25,
+	0x5c, 0x7d, 0xc8, 0x36, 0x78, 0x50, 0x57, 0x25, 0x1b, 0x24, 0x46, 0xab, 0xb6, 0xf5, 0x9e, 0xfa,
+	0xa3, 0x43, 0x91, 0x97, 0x7d, 0xb1, 0x5f, 0xd6, 0xc3, 0x50, 0xe6, 0x7e, 0xc2, 0xa1, 0x95, 0x2c,
+	0x87, 0xbe, 0x84, 0x02, 0x8d, 0x48, 0xc4, 0x59, 0x91, 0x3f, 0xb2, 0xd4, 0xe7, 0xe5, 0x42, 0xc1,
+	0xd9, 0x1c, 0xdb, 0x62, 0x0a, 0xf5, 0x7f, 0x11, 0xd4, 0x86, 0x21, 0xf6, 0xc8, 0x83, 0xf8, 0x0a,
+	0x19, 0x2f, 0x55, 0xf5, 0x85, 0x0c, 0xa9, 0xd8, 0xe0, 0x01, 0xdb, 0xe0, 0xae, 0x3a, 0x2f, 0xf7,
+	0x61, 0xcd, 0xa4, 0x86, 0x94, 0xad, 0xbf, 0x28, 0x30, 0x23, 0xed, 0x0c, 0xd1, 0xdd, 0x44, 0x54,
+	0xc9, 0x7b, 0x9d, 0x4c, 0x06, 0x5f, 0xb2, 0xdd, 0x77, 0xd4, 0x27, 0xb2, 0xdd, 0x7b, 0xcb, 0xac,
+	0x5e, 0xcc, 0x16, 0xef, 0xd7, 0x12, 0xb2, 0x70, 0xed, 0x94, 0x10, 0x9f, 0x22, 0xfc, 0x5a, 0x01,
+	0xd4, 0xdf, 0x1f, 0xa2, 0xc5, 0x28, 0x26, 0x33, 0xb0, 0x2d, 0x65, 0xca, 0x05, 0x45, 0xdf, 0x67,
+	0x20, 0x1f, 0xa3, 0x8d, 0xc1, 0x61, 0x25, 0x07, 0xc6, 0x78, 0x93, 0xf6, 0x97, 0x82, 0xb7, 0x41,
+	0xbd, 0xe7, 0x65, 0xbc, 0xd5, 0x3f, 0x08, 0x6f, 0xbf, 0x57, 0x60, 0x46, 0xda, 0xa9, 0x0a, 0x84,
+	0x83, 0xba, 0xd8, 0x4c, 0x84, 0x82, 0xb4, 0x95, 0x9b, 0x91, 0xf6, 0x77, 0x25, 0x7a, 0x3b, 0x97,
+	0xb6, 0x82, 0x89, 0x80, 0xcb, 0xbe, 0xb2, 0x67, 0x42, 0xfb, 0x21, 0x83, 0xb6, 0xa7, 0x36, 0x6f,
+	0x43, 0x9e, 0xcd, 0xf6, 0xb5, 0x5a, 0x94, 0xc0, 0xbf, 0x2a, 0xec, 0x4d, 0x5e, 0x06, 0x55, 0x8d,
+	0x82, 0x6b, 0x00, 0xce, 0x7b, 0x03, 0x75, 0x44, 0x10, 0x3e, 0x61, 0xa0, 0x37, 0xd1, 0x67, 0xd7,
+	0xe5, 0x33, 0x02, 0xca, 0x38, 0xcd, 0x6c, 0xa3, 0x04, 0xa7, 0x97, 0xb5, 0x59, 0x97, 0x71, 0x5a,
+	0xff, 0x60, 0x9c, 0xfe, 0x59, 0x81, 0xb9, 0xcc, 0xa6, 0x4c, 0xa0, 0xbd, 0xac, 0x69, 0xcb, 0x44,
+	0x2b, 0xc8, 0x5c, 0xb9, 0x39, 0x99, 0xbf, 0x51, 0xa0, 0x92, 0x7a, 0x14, 0x09, 0x13, 0x79, 0x5e,
+	0x82, 0x65, 0x5e, 0x2e, 0x14, 0x9f, 0xf7, 0x53, 0x86, 0xe8, 0xbb, 0x68, 0xed, 0x9a, 0x88, 0xd0,
+	0x57, 0x0a, 0x4c, 0xa4, 0x9a, 0x3a, 0x81, 0xe3, 0x08, 0x0f, 0xc0, 0x91, 0xd1, 0x07, 0xaa, 0x5b,
+	0x0c, 0xc7, 0xe7, 0xea, 0xb5, 0x8f, 0x2d, 0xc1, 0x21, 0x11, 0xc9, 0xa4, 0x92, 0xbe, 0x2e, 0x89,
+	0x0a, 0x95, 0xd1, 0x9d, 0xd4, 0x17, 0x32, 0xa4, 0x37, 0x80, 0x14, 0x44, 0x8b, 0x3c, 0x14, 0x3f,
+	0xb3, 0x52, 0x48, 0x7f, 0x53, 0xa0, 0x96, 0x75, 0x33, 0x44, 0xf7, 0xe3, 0xcf, 0x32, 0xe0, 0xc2,
+	0x5a, 0xff, 0xf8, 0x12, 0xad, 0x1b, 0x54, 0x8a, 0x3e, 0xa8, 0xe8, 0xd7, 0x0a, 0x8c, 0xee, 0x62,
+	0x12, 0xff, 0xa6, 0x85, 0xee, 0x49, 0x6e, 0x53, 0xe9, 0xdf, 0xc8, 0xea, 0xf7, 0x07, 0x2b, 0x09,
+	0x64, 0x9f, 0x30, 0x64, 0xf7, 0xd0, 0xdd, 0x8c, 0x32, 0xcf, 0x7f, 0x21, 0x7b, 0x78, 0x86, 0xbb,
+	0xad, 0x22, 0x3b, 0x2c, 0xdf, 0xfb, 0xef, 0x00, 0x15, 0x27, 0xdd, 0xfe, 0xa5, 0x20, 0x00, 0x00,
 }
@@ -0,0 +1,1169 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: campaign.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type CampaignCommand struct {
+	// FPort used (must be > 0).
+	FPort uint32 `protobuf:"varint,1,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Base64 encoded data.
+	// Either the data or the json_object must be set.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// JSON object (string).
+	// This will be encoded using the codec of the application.
+	JsonObject           string   `protobuf:"bytes,3,opt,name=json_object,json=jsonObject,proto3" json:"json_object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CampaignCommand) Reset()         { *m = CampaignCommand{} }
+func (m *CampaignCommand) String() string { return proto.CompactTextString(m) }
+func (*CampaignCommand) ProtoMessage()    {}
+func (*CampaignCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{0}
+}
+func (m *CampaignCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CampaignCommand.Unmarshal(m, b)
+}
+func (m *CampaignCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CampaignCommand.Marshal(b, m, deterministic)
+}
+func (dst *CampaignCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CampaignCommand.Merge(dst, src)
+}
+func (m *CampaignCommand) XXX_Size() int {
+	return xxx_messageInfo_CampaignCommand.Size(m)
+}
+func (m *CampaignCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CampaignCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CampaignCommand proto.InternalMessageInfo
+
+func (m *CampaignCommand) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *CampaignCommand) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *CampaignCommand) GetJsonObject() string {
+	if m != nil {
+		return m.JsonObject
+	}
+	return ""
+}
+
+type Campaign struct {
+	// Campaign ID (string formatted UUID).
+	// This will be generated automatically on create.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,2,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Device filter ID (optional).
+	// When set, the campaign only applies to the devices of the application
+	// matching the saved device filter.
+	DeviceFilterId int64 `protobuf:"varint,3,opt,name=device_filter_id,json=deviceFilterID,proto3" json:"device_filter_id,omitempty"`
+	// Name of the campaign.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Commands to enqueue (in the given order) as confirmed downlinks.
+	Commands []*CampaignCommand `protobuf:"bytes,5,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Start of the campaign window.
+	StartAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// End of the campaign window.
+	// Devices which have not confirmed the commands by then are marked
+	// as failed.
+	EndAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	// Interval between handling two devices.
+	PacingInterval *duration.Duration `protobuf:"bytes,8,opt,name=pacing_interval,json=pacingInterval,proto3" json:"pacing_interval,omitempty"`
+	// Interval after which the commands are enqueued again, when the
+	// device did not confirm the last command.
+	RetryInterval *duration.Duration `protobuf:"bytes,9,opt,name=retry_interval,json=retryInterval,proto3" json:"retry_interval,omitempty"`
+	// Max number of times the commands are enqueued for a device.
+	MaxAttempts          uint32   `protobuf:"varint,10,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Campaign) Reset()         { *m = Campaign{} }
+func (m *Campaign) String() string { return proto.CompactTextString(m) }
+func (*Campaign) ProtoMessage()    {}
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{1}
+}
+func (m *Campaign) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Campaign.Unmarshal(m, b)
+}
+func (m *Campaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Campaign.Marshal(b, m, deterministic)
+}
+func (dst *Campaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Campaign.Merge(dst, src)
+}
+func (m *Campaign) XXX_Size() int {
+	return xxx_messageInfo_Campaign.Size(m)
+}
+func (m *Campaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_Campaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Campaign proto.InternalMessageInfo
+
+func (m *Campaign) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Campaign) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *Campaign) GetDeviceFilterId() int64 {
+	if m != nil {
+		return m.DeviceFilterId
+	}
+	return 0
+}
+
+func (m *Campaign) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Campaign) GetCommands() []*CampaignCommand {
+	if m != nil {
+		return m.Commands
+	}
+	return nil
+}
+
+func (m *Campaign) GetStartAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartAt
+	}
+	return nil
+}
+
+func (m *Campaign) GetEndAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndAt
+	}
+	return nil
+}
+
+func (m *Campaign) GetPacingInterval() *duration.Duration {
+	if m != nil {
+		return m.PacingInterval
+	}
+	return nil
+}
+
+func (m *Campaign) GetRetryInterval() *duration.Duration {
+	if m != nil {
+		return m.RetryInterval
+	}
+	return nil
+}
+
+func (m *Campaign) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+type CampaignListItem struct {
+	// Campaign ID (string formatted UUID).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Name of the campaign.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// State of the campaign (PENDING, RUNNING or DONE).
+	State string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	// Start of the campaign window.
+	StartAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// End of the campaign window.
+	EndAt                *timestamp.Timestamp `protobuf:"bytes,7,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CampaignListItem) Reset()         { *m = CampaignListItem{} }
+func (m *CampaignListItem) String() string { return proto.CompactTextString(m) }
+func (*CampaignListItem) ProtoMessage()    {}
+func (*CampaignListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{2}
+}
+func (m *CampaignListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CampaignListItem.Unmarshal(m, b)
+}
+func (m *CampaignListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CampaignListItem.Marshal(b, m, deterministic)
+}
+func (dst *CampaignListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CampaignListItem.Merge(dst, src)
+}
+func (m *CampaignListItem) XXX_Size() int {
+	return xxx_messageInfo_CampaignListItem.Size(m)
+}
+func (m *CampaignListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CampaignListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CampaignListItem proto.InternalMessageInfo
+
+func (m *CampaignListItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CampaignListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *CampaignListItem) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *CampaignListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CampaignListItem) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *CampaignListItem) GetStartAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartAt
+	}
+	return nil
+}
+
+func (m *CampaignListItem) GetEndAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndAt
+	}
+	return nil
+}
+
+type CampaignDevice struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// State of the device (PENDING, ENQUEUED, CONFIRMED or FAILED).
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Number of times the commands have been enqueued.
+	Attempts uint32 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Frame-counter of the last enqueued command.
+	FCnt uint32 `protobuf:"varint,4,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// Timestamp of the last attempt.
+	EnqueuedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=enqueued_at,json=enqueuedAt,proto3" json:"enqueued_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Error of the last attempt (if any).
+	Error                string   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CampaignDevice) Reset()         { *m = CampaignDevice{} }
+func (m *CampaignDevice) String() string { return proto.CompactTextString(m) }
+func (*CampaignDevice) ProtoMessage()    {}
+func (*CampaignDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{3}
+}
+func (m *CampaignDevice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CampaignDevice.Unmarshal(m, b)
+}
+func (m *CampaignDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CampaignDevice.Marshal(b, m, deterministic)
+}
+func (dst *CampaignDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CampaignDevice.Merge(dst, src)
+}
+func (m *CampaignDevice) XXX_Size() int {
+	return xxx_messageInfo_CampaignDevice.Size(m)
+}
+func (m *CampaignDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_CampaignDevice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CampaignDevice proto.InternalMessageInfo
+
+func (m *CampaignDevice) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *CampaignDevice) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *CampaignDevice) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *CampaignDevice) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *CampaignDevice) GetEnqueuedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.EnqueuedAt
+	}
+	return nil
+}
+
+func (m *CampaignDevice) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *CampaignDevice) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type CreateCampaignRequest struct {
+	// Campaign object to create.
+	Campaign             *Campaign `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateCampaignRequest) Reset()         { *m = CreateCampaignRequest{} }
+func (m *CreateCampaignRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCampaignRequest) ProtoMessage()    {}
+func (*CreateCampaignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{4}
+}
+func (m *CreateCampaignRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCampaignRequest.Unmarshal(m, b)
+}
+func (m *CreateCampaignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCampaignRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateCampaignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCampaignRequest.Merge(dst, src)
+}
+func (m *CreateCampaignRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCampaignRequest.Size(m)
+}
+func (m *CreateCampaignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCampaignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCampaignRequest proto.InternalMessageInfo
+
+func (m *CreateCampaignRequest) GetCampaign() *Campaign {
+	if m != nil {
+		return m.Campaign
+	}
+	return nil
+}
+
+type CreateCampaignResponse struct {
+	// ID of the created campaign (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCampaignResponse) Reset()         { *m = CreateCampaignResponse{} }
+func (m *CreateCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCampaignResponse) ProtoMessage()    {}
+func (*CreateCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{5}
+}
+func (m *CreateCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCampaignResponse.Unmarshal(m, b)
+}
+func (m *CreateCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCampaignResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCampaignResponse.Merge(dst, src)
+}
+func (m *CreateCampaignResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCampaignResponse.Size(m)
+}
+func (m *CreateCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCampaignResponse proto.InternalMessageInfo
+
+func (m *CreateCampaignResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetCampaignRequest struct {
+	// Campaign ID (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCampaignRequest) Reset()         { *m = GetCampaignRequest{} }
+func (m *GetCampaignRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCampaignRequest) ProtoMessage()    {}
+func (*GetCampaignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{6}
+}
+func (m *GetCampaignRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCampaignRequest.Unmarshal(m, b)
+}
+func (m *GetCampaignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCampaignRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetCampaignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCampaignRequest.Merge(dst, src)
+}
+func (m *GetCampaignRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCampaignRequest.Size(m)
+}
+func (m *GetCampaignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCampaignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCampaignRequest proto.InternalMessageInfo
+
+func (m *GetCampaignRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetCampaignResponse struct {
+	// Campaign object.
+	Campaign *Campaign `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// State of the campaign (PENDING, RUNNING or DONE).
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// Number of pending devices.
+	PendingCount uint32 `protobuf:"varint,5,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	// Number of devices which have not confirmed the commands yet.
+	EnqueuedCount uint32 `protobuf:"varint,6,opt,name=enqueued_count,json=enqueuedCount,proto3" json:"enqueued_count,omitempty"`
+	// Number of devices which confirmed the commands.
+	ConfirmedCount uint32 `protobuf:"varint,7,opt,name=confirmed_count,json=confirmedCount,proto3" json:"confirmed_count,omitempty"`
+	// Number of failed devices.
+	FailedCount          uint32   `protobuf:"varint,8,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCampaignResponse) Reset()         { *m = GetCampaignResponse{} }
+func (m *GetCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCampaignResponse) ProtoMessage()    {}
+func (*GetCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{7}
+}
+func (m *GetCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCampaignResponse.Unmarshal(m, b)
+}
+func (m *GetCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCampaignResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCampaignResponse.Merge(dst, src)
+}
+func (m *GetCampaignResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCampaignResponse.Size(m)
+}
+func (m *GetCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCampaignResponse proto.InternalMessageInfo
+
+func (m *GetCampaignResponse) GetCampaign() *Campaign {
+	if m != nil {
+		return m.Campaign
+	}
+	return nil
+}
+
+func (m *GetCampaignResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetCampaignResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *GetCampaignResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *GetCampaignResponse) GetPendingCount() uint32 {
+	if m != nil {
+		return m.PendingCount
+	}
+	return 0
+}
+
+func (m *GetCampaignResponse) GetEnqueuedCount() uint32 {
+	if m != nil {
+		return m.EnqueuedCount
+	}
+	return 0
+}
+
+func (m *GetCampaignResponse) GetConfirmedCount() uint32 {
+	if m != nil {
+		return m.ConfirmedCount
+	}
+	return 0
+}
+
+func (m *GetCampaignResponse) GetFailedCount() uint32 {
+	if m != nil {
+		return m.FailedCount
+	}
+	return 0
+}
+
+type DeleteCampaignRequest struct {
+	// Campaign ID (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCampaignRequest) Reset()         { *m = DeleteCampaignRequest{} }
+func (m *DeleteCampaignRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCampaignRequest) ProtoMessage()    {}
+func (*DeleteCampaignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{8}
+}
+func (m *DeleteCampaignRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCampaignRequest.Unmarshal(m, b)
+}
+func (m *DeleteCampaignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCampaignRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteCampaignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCampaignRequest.Merge(dst, src)
+}
+func (m *DeleteCampaignRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCampaignRequest.Size(m)
+}
+func (m *DeleteCampaignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCampaignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCampaignRequest proto.InternalMessageInfo
+
+func (m *DeleteCampaignRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListCampaignRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Max number of campaigns to return in the result-set.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCampaignRequest) Reset()         { *m = ListCampaignRequest{} }
+func (m *ListCampaignRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCampaignRequest) ProtoMessage()    {}
+func (*ListCampaignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{9}
+}
+func (m *ListCampaignRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCampaignRequest.Unmarshal(m, b)
+}
+func (m *ListCampaignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCampaignRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListCampaignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCampaignRequest.Merge(dst, src)
+}
+func (m *ListCampaignRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCampaignRequest.Size(m)
+}
+func (m *ListCampaignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCampaignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCampaignRequest proto.InternalMessageInfo
+
+func (m *ListCampaignRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ListCampaignRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListCampaignRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListCampaignResponse struct {
+	// Total number of campaigns available within the result-set.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Campaigns within this result-set.
+	Result               []*CampaignListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListCampaignResponse) Reset()         { *m = ListCampaignResponse{} }
+func (m *ListCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCampaignResponse) ProtoMessage()    {}
+func (*ListCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{10}
+}
+func (m *ListCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCampaignResponse.Unmarshal(m, b)
+}
+func (m *ListCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCampaignResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCampaignResponse.Merge(dst, src)
+}
+func (m *ListCampaignResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCampaignResponse.Size(m)
+}
+func (m *ListCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCampaignResponse proto.InternalMessageInfo
+
+func (m *ListCampaignResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListCampaignResponse) GetResult() []*CampaignListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ListCampaignDevicesRequest struct {
+	// Campaign ID (string formatted UUID).
+	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignID,proto3" json:"campaign_id,omitempty"`
+	// Max number of devices to return in the result-set.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCampaignDevicesRequest) Reset()         { *m = ListCampaignDevicesRequest{} }
+func (m *ListCampaignDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCampaignDevicesRequest) ProtoMessage()    {}
+func (*ListCampaignDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{11}
+}
+func (m *ListCampaignDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCampaignDevicesRequest.Unmarshal(m, b)
+}
+func (m *ListCampaignDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCampaignDevicesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListCampaignDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCampaignDevicesRequest.Merge(dst, src)
+}
+func (m *ListCampaignDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCampaignDevicesRequest.Size(m)
+}
+func (m *ListCampaignDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCampaignDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCampaignDevicesRequest proto.InternalMessageInfo
+
+func (m *ListCampaignDevicesRequest) GetCampaignId() string {
+	if m != nil {
+		return m.CampaignId
+	}
+	return ""
+}
+
+func (m *ListCampaignDevicesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListCampaignDevicesRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListCampaignDevicesResponse struct {
+	// Total number of devices available within the result-set.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Devices within this result-set.
+	Result               []*CampaignDevice `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListCampaignDevicesResponse) Reset()         { *m = ListCampaignDevicesResponse{} }
+func (m *ListCampaignDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCampaignDevicesResponse) ProtoMessage()    {}
+func (*ListCampaignDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_campaign_0277edece6660935, []int{12}
+}
+func (m *ListCampaignDevicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCampaignDevicesResponse.Unmarshal(m, b)
+}
+func (m *ListCampaignDevicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCampaignDevicesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListCampaignDevicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCampaignDevicesResponse.Merge(dst, src)
+}
+func (m *ListCampaignDevicesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCampaignDevicesResponse.Size(m)
+}
+func (m *ListCampaignDevicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCampaignDevicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCampaignDevicesResponse proto.InternalMessageInfo
+
+func (m *ListCampaignDevicesResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListCampaignDevicesResponse) GetResult() []*CampaignDevice {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CampaignCommand)(nil), "api.CampaignCommand")
+	proto.RegisterType((*Campaign)(nil), "api.Campaign")
+	proto.RegisterType((*CampaignListItem)(nil), "api.CampaignListItem")
+	proto.RegisterType((*CampaignDevice)(nil), "api.CampaignDevice")
+	proto.RegisterType((*CreateCampaignRequest)(nil), "api.CreateCampaignRequest")
+	proto.RegisterType((*CreateCampaignResponse)(nil), "api.CreateCampaignResponse")
+	proto.RegisterType((*GetCampaignRequest)(nil), "api.GetCampaignRequest")
+	proto.RegisterType((*GetCampaignResponse)(nil), "api.GetCampaignResponse")
+	proto.RegisterType((*DeleteCampaignRequest)(nil), "api.DeleteCampaignRequest")
+	proto.RegisterType((*ListCampaignRequest)(nil), "api.ListCampaignRequest")
+	proto.RegisterType((*ListCampaignResponse)(nil), "api.ListCampaignResponse")
+	proto.RegisterType((*ListCampaignDevicesRequest)(nil), "api.ListCampaignDevicesRequest")
+	proto.RegisterType((*ListCampaignDevicesResponse)(nil), "api.ListCampaignDevicesResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CampaignServiceClient is the client API for CampaignService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CampaignServiceClient interface {
+	// Create creates the given campaign.
+	Create(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error)
+	// Get returns the campaign matching the given id.
+	Get(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error)
+	// Delete deletes the campaign matching the given id.
+	// Commands which have already been enqueued are not removed from the
+	// device-queue.
+	Delete(ctx context.Context, in *DeleteCampaignRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List lists the campaigns of the given application.
+	List(ctx context.Context, in *ListCampaignRequest, opts ...grpc.CallOption) (*ListCampaignResponse, error)
+	// ListDevices lists the devices of the given campaign and their state.
+	ListDevices(ctx context.Context, in *ListCampaignDevicesRequest, opts ...grpc.CallOption) (*ListCampaignDevicesResponse, error)
+}
+
+type campaignServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCampaignServiceClient(cc *grpc.ClientConn) CampaignServiceClient {
+	return &campaignServiceClient{cc}
+}
+
+func (c *campaignServiceClient) Create(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error) {
+	out := new(CreateCampaignResponse)
+	err := c.cc.Invoke(ctx, "/api.CampaignService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) Get(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error) {
+	out := new(GetCampaignResponse)
+	err := c.cc.Invoke(ctx, "/api.CampaignService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) Delete(ctx context.Context, in *DeleteCampaignRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.CampaignService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) List(ctx context.Context, in *ListCampaignRequest, opts ...grpc.CallOption) (*ListCampaignResponse, error) {
+	out := new(ListCampaignResponse)
+	err := c.cc.Invoke(ctx, "/api.CampaignService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) ListDevices(ctx context.Context, in *ListCampaignDevicesRequest, opts ...grpc.CallOption) (*ListCampaignDevicesResponse, error) {
+	out := new(ListCampaignDevicesResponse)
+	err := c.cc.Invoke(ctx, "/api.CampaignService/ListDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CampaignServiceServer is the server API for CampaignService service.
+type CampaignServiceServer interface {
+	// Create creates the given campaign.
+	Create(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error)
+	// Get returns the campaign matching the given id.
+	Get(context.Context, *GetCampaignRequest) (*GetCampaignResponse, error)
+	// Delete deletes the campaign matching the given id.
+	// Commands which have already been enqueued are not removed from the
+	// device-queue.
+	Delete(context.Context, *DeleteCampaignRequest) (*empty.Empty, error)
+	// List lists the campaigns of the given application.
+	List(context.Context, *ListCampaignRequest) (*ListCampaignResponse, error)
+	// ListDevices lists the devices of the given campaign and their state.
+	ListDevices(context.Context, *ListCampaignDevicesRequest) (*ListCampaignDevicesResponse, error)
+}
+
+func RegisterCampaignServiceServer(s *grpc.Server, srv CampaignServiceServer) {
+	s.RegisterService(&_CampaignService_serviceDesc, srv)
+}
+
+func _CampaignService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CampaignService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).Create(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CampaignService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).Get(ctx, req.(*GetCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CampaignService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).Delete(ctx, req.(*DeleteCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CampaignService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).List(ctx, req.(*ListCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CampaignService/ListDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).ListDevices(ctx, req.(*ListCampaignDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CampaignService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.CampaignService",
+	HandlerType: (*CampaignServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _CampaignService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CampaignService_Get_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CampaignService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _CampaignService_List_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _CampaignService_ListDevices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "campaign.proto",
+}
+
+func init() { proto.RegisterFile("campaign.proto", fileDescriptor_campaign_0277edece6660935) }
+
+var fileDescriptor_campaign_0277edece6660935 = []byte{
+	// 989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0x56, 0xe2, 0xc4, 0x9b, 0x9c, 0x6c, 0xb2, 0xab, 0x49, 0xb2, 0xf5, 0x7a, 0x11, 0x49, 0x4d,
+	0xa1, 0xa1, 0x40, 0x02, 0x8b, 0xb8, 0x00, 0x6e, 0x48, 0x93, 0x52, 0x45, 0x42, 0x02, 0xb9, 0xf4,
+	0x02, 0x09, 0x64, 0xcd, 0xda, 0xe3, 0xd5, 0x6c, 0xe3, 0x9f, 0xda, 0x27, 0xab, 0x56, 0xa8, 0x5c,
+	0xf0, 0x0a, 0xbc, 0x06, 0x8f, 0xc1, 0x1b, 0xf0, 0x0a, 0x7d, 0x06, 0x2e, 0x11, 0xf2, 0xcc, 0xd8,
+	0x9b, 0x38, 0x41, 0xe9, 0xde, 0xc0, 0x9d, 0xe7, 0xcc, 0x77, 0xbe, 0x73, 0xce, 0x77, 0xce, 0xcc,
+	0x18, 0x3a, 0x2e, 0x0d, 0x62, 0xca, 0x2f, 0xc3, 0x71, 0x9c, 0x44, 0x18, 0x11, 0x8d, 0xc6, 0xdc,
+	0x7c, 0xeb, 0x32, 0x8a, 0x2e, 0x97, 0x6c, 0x42, 0x63, 0x3e, 0xa1, 0x61, 0x18, 0x21, 0x45, 0x1e,
+	0x85, 0xa9, 0x84, 0x98, 0x03, 0xb5, 0x2b, 0x56, 0x17, 0x2b, 0x7f, 0x82, 0x3c, 0x60, 0x29, 0xd2,
+	0x20, 0x56, 0x80, 0xb7, 0xcb, 0x00, 0x6f, 0x95, 0x08, 0x06, 0xb5, 0x7f, 0x56, 0xde, 0x67, 0x41,
+	0x8c, 0x2f, 0xe5, 0xa6, 0xf5, 0x13, 0x1c, 0xcd, 0x54, 0x4a, 0xb3, 0x28, 0x08, 0x68, 0xe8, 0x91,
+	0x3e, 0xe8, 0xbe, 0x13, 0x47, 0x09, 0x1a, 0x95, 0x61, 0x65, 0xd4, 0xb6, 0xeb, 0xfe, 0x77, 0x51,
+	0x82, 0x84, 0x40, 0xcd, 0xa3, 0x48, 0x8d, 0xea, 0xb0, 0x32, 0x3a, 0xb4, 0xc5, 0x37, 0x19, 0x40,
+	0xeb, 0x2a, 0x8d, 0x42, 0x27, 0xba, 0xb8, 0x62, 0x2e, 0x1a, 0xda, 0xb0, 0x32, 0x6a, 0xda, 0x90,
+	0x99, 0xbe, 0x15, 0x16, 0xeb, 0x0f, 0x0d, 0x1a, 0x39, 0x3f, 0xe9, 0x40, 0x95, 0x7b, 0x82, 0xb4,
+	0x69, 0x57, 0xb9, 0x47, 0xde, 0x85, 0x0e, 0x8d, 0xe3, 0x25, 0x77, 0x45, 0xb6, 0x0e, 0xf7, 0x04,
+	0xb7, 0x66, 0xb7, 0xd7, 0xac, 0x8b, 0x39, 0x19, 0xc1, 0xb1, 0xc7, 0xae, 0xb9, 0xcb, 0x1c, 0x9f,
+	0x2f, 0x91, 0x25, 0x19, 0x50, 0x13, 0xc0, 0x8e, 0xb4, 0x7f, 0x2d, 0xcc, 0x8b, 0x79, 0x96, 0x62,
+	0x48, 0x03, 0x66, 0xd4, 0x44, 0x08, 0xf1, 0x4d, 0x3e, 0x86, 0x86, 0x2b, 0x0b, 0x4b, 0x8d, 0xfa,
+	0x50, 0x1b, 0xb5, 0xce, 0x7b, 0x63, 0x1a, 0xf3, 0x71, 0xa9, 0x6a, 0xbb, 0x40, 0x91, 0xcf, 0xa0,
+	0x91, 0x22, 0x4d, 0xd0, 0xa1, 0x68, 0xe8, 0xc3, 0xca, 0xa8, 0x75, 0x6e, 0x8e, 0xa5, 0x84, 0xe3,
+	0x5c, 0xc2, 0xf1, 0xf7, 0x79, 0x0f, 0xec, 0x03, 0x81, 0x9d, 0x22, 0xf9, 0x04, 0x74, 0x16, 0x7a,
+	0x99, 0xd3, 0xc1, 0x5e, 0xa7, 0x3a, 0x0b, 0xbd, 0x29, 0x92, 0x87, 0x70, 0x14, 0x53, 0x97, 0x87,
+	0x97, 0x0e, 0x0f, 0x91, 0x25, 0xd7, 0x74, 0x69, 0x34, 0x84, 0xef, 0xe9, 0x96, 0xef, 0x5c, 0xf5,
+	0xd4, 0xee, 0x48, 0x8f, 0x85, 0x72, 0x20, 0x5f, 0x41, 0x27, 0x61, 0x98, 0xbc, 0xbc, 0xa1, 0x68,
+	0xee, 0xa3, 0x68, 0x0b, 0x87, 0x82, 0xe1, 0x2e, 0x1c, 0x06, 0xf4, 0x85, 0x43, 0x11, 0xb3, 0xc1,
+	0x48, 0x0d, 0x10, 0x5d, 0x6f, 0x05, 0xf4, 0xc5, 0x54, 0x99, 0xac, 0xdf, 0xab, 0x70, 0x9c, 0x0b,
+	0xf6, 0x0d, 0x4f, 0x71, 0x81, 0x2c, 0xd8, 0x6a, 0xe7, 0xe7, 0x00, 0x6e, 0xc2, 0x28, 0x32, 0x21,
+	0x42, 0x75, 0xaf, 0x08, 0x4d, 0x85, 0x9e, 0x62, 0xe6, 0xba, 0x8a, 0xbd, 0xdc, 0x55, 0xdb, 0xef,
+	0xaa, 0xd0, 0x53, 0xdc, 0xd9, 0xf3, 0x1e, 0xd4, 0x53, 0xa4, 0xc8, 0x8c, 0xba, 0x30, 0xca, 0xc5,
+	0x7f, 0xd7, 0x57, 0xeb, 0xef, 0x0a, 0x74, 0x72, 0xb9, 0xe6, 0x62, 0x44, 0xc9, 0x1d, 0x38, 0xf0,
+	0xd8, 0xb5, 0xc3, 0x56, 0x5c, 0x29, 0xa6, 0x7b, 0xec, 0xfa, 0xd1, 0xd3, 0xc5, 0x4d, 0xae, 0xd5,
+	0xf5, 0x5c, 0x4d, 0x68, 0x14, 0xfd, 0xd0, 0x44, 0x3f, 0x8a, 0x35, 0xe9, 0x42, 0xdd, 0x77, 0xdc,
+	0x10, 0x45, 0xc9, 0x6d, 0xbb, 0xe6, 0xcf, 0x42, 0x24, 0x5f, 0x42, 0x8b, 0x85, 0xcf, 0x57, 0x6c,
+	0x25, 0x25, 0xac, 0xef, 0x4d, 0x15, 0x72, 0xf8, 0x96, 0xfc, 0xfa, 0x6d, 0xe4, 0xef, 0x41, 0x9d,
+	0x25, 0x49, 0x94, 0x08, 0x71, 0x9a, 0xb6, 0x5c, 0x58, 0x0f, 0xa1, 0x3f, 0x13, 0xcd, 0xcd, 0x55,
+	0xb0, 0xd9, 0xf3, 0x15, 0x4b, 0x91, 0xbc, 0x0f, 0x8d, 0xfc, 0x06, 0x14, 0x3a, 0xb4, 0xce, 0xdb,
+	0x1b, 0xa7, 0xd1, 0x2e, 0xb6, 0xad, 0x11, 0x9c, 0x94, 0x39, 0xd2, 0x38, 0x0a, 0x53, 0x56, 0x1e,
+	0x3c, 0xeb, 0x1e, 0x90, 0xc7, 0x0c, 0xcb, 0xa1, 0xca, 0xa8, 0xd7, 0x55, 0xe8, 0x6e, 0xc0, 0x14,
+	0xdb, 0x9b, 0xa7, 0xf4, 0x3f, 0x4d, 0x78, 0x31, 0x21, 0xb5, 0xf5, 0x09, 0x79, 0x07, 0xda, 0x31,
+	0x0b, 0xbd, 0xec, 0xf2, 0x70, 0xa3, 0x55, 0x28, 0x5b, 0xde, 0xb6, 0x0f, 0x95, 0x71, 0x96, 0xd9,
+	0xb2, 0x1b, 0xb6, 0x98, 0x0a, 0x89, 0xd2, 0x05, 0xaa, 0x9d, 0x5b, 0x25, 0xec, 0x3e, 0x1c, 0xb9,
+	0x51, 0xe8, 0xf3, 0x24, 0x28, 0x70, 0x07, 0x02, 0xd7, 0x29, 0xcc, 0x12, 0x78, 0x17, 0x0e, 0x7d,
+	0xca, 0x97, 0x05, 0xaa, 0x21, 0xaf, 0x0a, 0x69, 0x13, 0x10, 0xeb, 0x3e, 0xf4, 0xe7, 0x6c, 0xc9,
+	0x90, 0xed, 0xeb, 0xc7, 0x15, 0x74, 0xb3, 0xab, 0xa4, 0x0c, 0xdb, 0x7e, 0x14, 0x2a, 0xbb, 0x1e,
+	0x85, 0x1e, 0xd4, 0x97, 0x3c, 0xe0, 0xa8, 0x9e, 0x0c, 0xb9, 0x20, 0x27, 0xa0, 0x47, 0xbe, 0x9f,
+	0x32, 0x54, 0x0f, 0x84, 0x5a, 0x59, 0x3e, 0xf4, 0x36, 0x63, 0xa9, 0xde, 0x0f, 0xa0, 0x85, 0x11,
+	0xd2, 0xa5, 0x2a, 0x47, 0x46, 0x02, 0x61, 0x92, 0x05, 0x7f, 0x04, 0x7a, 0xc2, 0xd2, 0xd5, 0x32,
+	0x8b, 0x93, 0xbd, 0x1d, 0xfd, 0x8d, 0xd1, 0xc8, 0xaf, 0x42, 0x5b, 0x81, 0xac, 0x67, 0x60, 0xae,
+	0xc7, 0x91, 0x67, 0x3f, 0xcd, 0x4b, 0x1b, 0x40, 0x2b, 0x1f, 0x25, 0xa7, 0x90, 0x02, 0x72, 0xd3,
+	0xad, 0x8b, 0x7a, 0x06, 0x67, 0x3b, 0x83, 0xbd, 0x69, 0x6d, 0x1f, 0x94, 0x6a, 0xeb, 0x6e, 0xd4,
+	0x26, 0xe9, 0xf2, 0xca, 0xce, 0xff, 0xd2, 0x6e, 0x7e, 0x14, 0x9e, 0xb0, 0x24, 0xdb, 0x23, 0x3f,
+	0x82, 0x2e, 0x4f, 0x28, 0x31, 0xa5, 0xeb, 0xae, 0x23, 0x6f, 0x9e, 0xed, 0xdc, 0x93, 0x49, 0x5a,
+	0xa7, 0xbf, 0xfe, 0xf9, 0xfa, 0xb7, 0x6a, 0xd7, 0xea, 0x88, 0x9f, 0x9f, 0x5c, 0x8a, 0xf4, 0x8b,
+	0xca, 0x03, 0xf2, 0x14, 0xb4, 0xc7, 0x0c, 0xc9, 0x1d, 0xe1, 0xbe, 0x7d, 0xbe, 0x4d, 0x63, 0x7b,
+	0x43, 0x91, 0x9e, 0x09, 0xd2, 0x3e, 0xe9, 0x6e, 0x92, 0x4e, 0x7e, 0xe6, 0xde, 0x2b, 0xf2, 0x03,
+	0xe8, 0x72, 0x3e, 0x55, 0xd2, 0x3b, 0x87, 0xd5, 0x3c, 0xd9, 0x3a, 0x9a, 0x8f, 0xb2, 0x9f, 0xa6,
+	0x9c, 0xfa, 0xc1, 0x4e, 0xea, 0x27, 0x50, 0xcb, 0x1a, 0x42, 0x64, 0x66, 0x3b, 0x86, 0xdb, 0x3c,
+	0xdd, 0xb1, 0xa3, 0x92, 0x3e, 0x11, 0xcc, 0xc7, 0xa4, 0xa4, 0x04, 0xf9, 0x05, 0x5a, 0x19, 0x5e,
+	0x75, 0x97, 0x0c, 0xb6, 0x18, 0x36, 0x87, 0xcc, 0x1c, 0xfe, 0x3b, 0x40, 0x45, 0xfa, 0x50, 0x44,
+	0x7a, 0x8f, 0xdc, 0x2b, 0xd7, 0xb0, 0x36, 0x9c, 0xaf, 0x26, 0xf2, 0xd7, 0x2a, 0xbd, 0xd0, 0x85,
+	0x02, 0x9f, 0xfe, 0x33, 0x00, 0x2b, 0x01, 0xea, 0x37, 0xba, 0x0a, 0x00, 0x00,
+}
@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cluster.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ClusterThroughput struct {
+	// Name of the counter (uplink, join, ack or downlink).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Total count since the instance was started.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Rate (per second) since the previous heartbeat.
+	Rate                 float64  `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterThroughput) Reset()         { *m = ClusterThroughput{} }
+func (m *ClusterThroughput) String() string { return proto.CompactTextString(m) }
+func (*ClusterThroughput) ProtoMessage()    {}
+func (*ClusterThroughput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_2d58145b3a3b383d, []int{0}
+}
+func (m *ClusterThroughput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterThroughput.Unmarshal(m, b)
+}
+func (m *ClusterThroughput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterThroughput.Marshal(b, m, deterministic)
+}
+func (dst *ClusterThroughput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterThroughput.Merge(dst, src)
+}
+func (m *ClusterThroughput) XXX_Size() int {
+	return xxx_messageInfo_ClusterThroughput.Size(m)
+}
+func (m *ClusterThroughput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterThroughput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterThroughput proto.InternalMessageInfo
+
+func (m *ClusterThroughput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClusterThroughput) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ClusterThroughput) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+type ClusterInstance struct {
+	// Instance ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Hostname.
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Version of the instance.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Timestamp at which the instance was started.
+	StartedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Timestamp of the last heartbeat.
+	HeartbeatAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=heartbeat_at,json=heartbeatAt,proto3" json:"heartbeat_at,omitempty"`
+	// Leader roles held by the instance.
+	Roles []string `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Throughput of the instance.
+	Throughput           []*ClusterThroughput `protobuf:"bytes,7,rep,name=throughput,proto3" json:"throughput,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ClusterInstance) Reset()         { *m = ClusterInstance{} }
+func (m *ClusterInstance) String() string { return proto.CompactTextString(m) }
+func (*ClusterInstance) ProtoMessage()    {}
+func (*ClusterInstance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_2d58145b3a3b383d, []int{1}
+}
+func (m *ClusterInstance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterInstance.Unmarshal(m, b)
+}
+func (m *ClusterInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterInstance.Marshal(b, m, deterministic)
+}
+func (dst *ClusterInstance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterInstance.Merge(dst, src)
+}
+func (m *ClusterInstance) XXX_Size() int {
+	return xxx_messageInfo_ClusterInstance.Size(m)
+}
+func (m *ClusterInstance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterInstance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterInstance proto.InternalMessageInfo
+
+func (m *ClusterInstance) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ClusterInstance) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *ClusterInstance) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ClusterInstance) GetStartedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartedAt
+	}
+	return nil
+}
+
+func (m *ClusterInstance) GetHeartbeatAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.HeartbeatAt
+	}
+	return nil
+}
+
+func (m *ClusterInstance) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *ClusterInstance) GetThroughput() []*ClusterThroughput {
+	if m != nil {
+		return m.Throughput
+	}
+	return nil
+}
+
+type ClusterLeader struct {
+	// Leader role.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// ID of the instance holding the role.
+	InstanceId           string   `protobuf:"bytes,2,opt,name=instance_id,json=instanceID,proto3" json:"instance_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterLeader) Reset()         { *m = ClusterLeader{} }
+func (m *ClusterLeader) String() string { return proto.CompactTextString(m) }
+func (*ClusterLeader) ProtoMessage()    {}
+func (*ClusterLeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_2d58145b3a3b383d, []int{2}
+}
+func (m *ClusterLeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterLeader.Unmarshal(m, b)
+}
+func (m *ClusterLeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterLeader.Marshal(b, m, deterministic)
+}
+func (dst *ClusterLeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterLeader.Merge(dst, src)
+}
+func (m *ClusterLeader) XXX_Size() int {
+	return xxx_messageInfo_ClusterLeader.Size(m)
+}
+func (m *ClusterLeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterLeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterLeader proto.InternalMessageInfo
+
+func (m *ClusterLeader) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ClusterLeader) GetInstanceId() string {
+	if m != nil {
+		return m.InstanceId
+	}
+	return ""
+}
+
+type ListClusterInstancesResponse struct {
+	// Running instances.
+	Result []*ClusterInstance `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	// Leaders of the background loops.
+	Leaders              []*ClusterLeader `protobuf:"bytes,2,rep,name=leaders,proto3" json:"leaders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListClusterInstancesResponse) Reset()         { *m = ListClusterInstancesResponse{} }
+func (m *ListClusterInstancesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListClusterInstancesResponse) ProtoMessage()    {}
+func (*ListClusterInstancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_2d58145b3a3b383d, []int{3}
+}
+func (m *ListClusterInstancesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListClusterInstancesResponse.Unmarshal(m, b)
+}
+func (m *ListClusterInstancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListClusterInstancesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListClusterInstancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClusterInstancesResponse.Merge(dst, src)
+}
+func (m *ListClusterInstancesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListClusterInstancesResponse.Size(m)
+}
+func (m *ListClusterInstancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClusterInstancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListClusterInstancesResponse proto.InternalMessageInfo
+
+func (m *ListClusterInstancesResponse) GetResult() []*ClusterInstance {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ListClusterInstancesResponse) GetLeaders() []*ClusterLeader {
+	if m != nil {
+		return m.Leaders
+	}
+	return nil
+}
+
+type StorageDurationBucket struct {
+	// Upper bound of the bucket.
+	// This is not set for the last bucket, containing the queries exceeding
+	// the upper bound of the previous bucket.
+	Le *duration.Duration `protobuf:"bytes,1,opt,name=le,proto3" json:"le,omitempty"`
+	// Number of queries within the bucket.
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageDurationBucket) Reset()         { *m = StorageDurationBucket{} }
+func (m *StorageDurationBucket) String() string { return proto.CompactTextString(m) }
+func (*StorageDurationBucket) ProtoMessage()    {}
+func (*StorageDurationBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_2d58145b3a3b383d, []int{4}
+}
+func (m *StorageDurationBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageDurationBucket.Unmarshal(m, b)
+}
+func (m *StorageDurationBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageDurationBucket.Marshal(b, m, deterministic)
+}
+func (dst *StorageDurationBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageDurationBucket.Merge(dst, src)
+}
+func (m *StorageDurationBucket) XXX_Size() int {
+	return xxx_messageInfo_StorageDurationBucket.Size(m)
+}
+func (m *StorageDurationBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageDurationBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageDurationBucket proto.InternalMessageInfo
+
+func (m *StorageDurationBucket) GetLe() *duration.Duration {
+	if m != nil {
+		return m.Le
+	}
+	return nil
+}
+
+func (m *StorageDurationBucket) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type StorageOperation struct {
+	// Storage function executing the queries (e.g. storage.GetDevice).
+	Function string `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
+	// Number of executed queries.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Number of queries exceeding the slow-query threshold.
+	SlowCount uint64 `protobuf:"varint,3,opt,name=slow_count,json=slowCount,proto3" json:"slow_count,omitempty"`
+	// Mean query duration.
+	MeanDuration *duration.Duration `protobuf:"bytes,4,opt,name=mean_duration,json=meanDuration,proto3" json:"mean_duration,omitempty"`
+	// Max. query duration.
+	MaxDuration *duration.Duration `protobuf:"bytes,5,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+	// Query duration histogram.
+	Buckets              []*StorageDurationBucket `protobuf:"bytes,6,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *StorageOperation) Reset()         { *m = StorageOperation{} }
+func (m *StorageOperation) String() string { return proto.CompactTextString(m) }
+func (*StorageOperation) ProtoMessage()    {}
+func (*StorageOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_2d58145b3a3b383d, []int{5}
+}
+func (m *StorageOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageOperation.Unmarshal(m, b)
+}
+func (m *StorageOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageOperation.Marshal(b, m, deterministic)
+}
+func (dst *StorageOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageOperation.Merge(dst, src)
+}
+func (m *StorageOperation) XXX_Size() int {
+	return xxx_messageInfo_StorageOperation.Size(m)
+}
+func (m *StorageOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageOperation proto.InternalMessageInfo
+
+func (m *StorageOperation) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *StorageOperation) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *StorageOperation) GetSlowCount() uint64 {
+	if m != nil {
+		return m.SlowCount
+	}
+	return 0
+}
+
+func (m *StorageOperation) GetMeanDuration() *duration.Duration {
+	if m != nil {
+		return m.MeanDuration
+	}
+	return nil
+}
+
+func (m *StorageOperation) GetMaxDuration() *duration.Duration {
+	if m != nil {
+		return m.MaxDuration
+	}
+	return nil
+}
+
+func (m *StorageOperation) GetBuckets() []*StorageDurationBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type ListSlowStorageOperationsRequest struct {
+	// Max number of operations to return (default 10).
+	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSlowStorageOperationsRequest) Reset()         { *m = ListSlowStorageOperationsRequest{} }
+func (m *ListSlowStorageOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSlowStorageOperationsRequest) ProtoMessage()    {}
+func (*ListSlowStorageOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_2d58145b3a3b383d, []int{6}
+}
+func (m *ListSlowStorageOperationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSlowStorageOperationsRequest.Unmarshal(m, b)
+}
+func (m *ListSlowStorageOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSlowStorageOperationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListSlowStorageOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSlowStorageOperationsRequest.Merge(dst, src)
+}
+func (m *ListSlowStorageOperationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSlowStorageOperationsRequest.Size(m)
+}
+func (m *ListSlowStorageOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSlowStorageOperationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSlowStorageOperationsRequest proto.InternalMessageInfo
+
+func (m *ListSlowStorageOperationsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListSlowStorageOperationsResponse struct {
+	// ID of the instance which measured the operations.
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceID,proto3" json:"instance_id,omitempty"`
+	// Storage operations, ordered by max. query duration.
+	Result               []*StorageOperation `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListSlowStorageOperationsResponse) Reset()         { *m = ListSlowStorageOperationsResponse{} }
+func (m *ListSlowStorageOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSlowStorageOperationsResponse) ProtoMessage()    {}
+func (*ListSlowStorageOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_2d58145b3a3b383d, []int{7}
+}
+func (m *ListSlowStorageOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSlowStorageOperationsResponse.Unmarshal(m, b)
+}
+func (m *ListSlowStorageOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSlowStorageOperationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListSlowStorageOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSlowStorageOperationsResponse.Merge(dst, src)
+}
+func (m *ListSlowStorageOperationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSlowStorageOperationsResponse.Size(m)
+}
+func (m *ListSlowStorageOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSlowStorageOperationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSlowStorageOperationsResponse proto.InternalMessageInfo
+
+func (m *ListSlowStorageOperationsResponse) GetInstanceId() string {
+	if m != nil {
+		return m.InstanceId
+	}
+	return ""
+}
+
+func (m *ListSlowStorageOperationsResponse) GetResult() []*StorageOperation {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ClusterThroughput)(nil), "api.ClusterThroughput")
+	proto.RegisterType((*ClusterInstance)(nil), "api.ClusterInstance")
+	proto.RegisterType((*ClusterLeader)(nil), "api.ClusterLeader")
+	proto.RegisterType((*ListClusterInstancesResponse)(nil), "api.ListClusterInstancesResponse")
+	proto.RegisterType((*StorageDurationBucket)(nil), "api.StorageDurationBucket")
+	proto.RegisterType((*StorageOperation)(nil), "api.StorageOperation")
+	proto.RegisterType((*ListSlowStorageOperationsRequest)(nil), "api.ListSlowStorageOperationsRequest")
+	proto.RegisterType((*ListSlowStorageOperationsResponse)(nil), "api.ListSlowStorageOperationsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ClusterServiceClient is the client API for ClusterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ClusterServiceClient interface {
+	// ListInstances lists the running application-server instances.
+	// Only global admin users are allowed to use this endpoint.
+	ListInstances(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListClusterInstancesResponse, error)
+	// ListSlowStorageOperations lists the storage operations with the highest
+	// max. query duration, as measured by the instance handling the request.
+	// Only global admin users are allowed to use this endpoint.
+	ListSlowStorageOperations(ctx context.Context, in *ListSlowStorageOperationsRequest, opts ...grpc.CallOption) (*ListSlowStorageOperationsResponse, error)
+}
+
+type clusterServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewClusterServiceClient(cc *grpc.ClientConn) ClusterServiceClient {
+	return &clusterServiceClient{cc}
+}
+
+func (c *clusterServiceClient) ListInstances(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListClusterInstancesResponse, error) {
+	out := new(ListClusterInstancesResponse)
+	err := c.cc.Invoke(ctx, "/api.ClusterService/ListInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ListSlowStorageOperations(ctx context.Context, in *ListSlowStorageOperationsRequest, opts ...grpc.CallOption) (*ListSlowStorageOperationsResponse, error) {
+	out := new(ListSlowStorageOperationsResponse)
+	err := c.cc.Invoke(ctx, "/api.ClusterService/ListSlowStorageOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClusterServiceServer is the server API for ClusterService service.
+type ClusterServiceServer interface {
+	// ListInstances lists the running application-server instances.
+	// Only global admin users are allowed to use this endpoint.
+	ListInstances(context.Context, *empty.Empty) (*ListClusterInstancesResponse, error)
+	// ListSlowStorageOperations lists the storage operations with the highest
+	// max. query duration, as measured by the instance handling the request.
+	// Only global admin users are allowed to use this endpoint.
+	ListSlowStorageOperations(context.Context, *ListSlowStorageOperationsRequest) (*ListSlowStorageOperationsResponse, error)
+}
+
+func RegisterClusterServiceServer(s *grpc.Server, srv ClusterServiceServer) {
+	s.RegisterService(&_ClusterService_serviceDesc, srv)
+}
+
+func _ClusterService_ListInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ListInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ClusterService/ListInstances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ListInstances(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ListSlowStorageOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSlowStorageOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ListSlowStorageOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ClusterService/ListSlowStorageOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ListSlowStorageOperations(ctx, req.(*ListSlowStorageOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ClusterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.ClusterService",
+	HandlerType: (*ClusterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListInstances",
+			Handler:    _ClusterService_ListInstances_Handler,
+		},
+		{
+			MethodName: "ListSlowStorageOperations",
+			Handler:    _ClusterService_ListSlowStorageOperations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cluster.proto",
+}
+
+func init() { proto.RegisterFile("cluster.proto", fileDescriptor_cluster_2d58145b3a3b383d) }
+
+var fileDescriptor_cluster_2d58145b3a3b383d = []byte{
+	// 671 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xdf, 0x4e, 0x13, 0x41,
+	0x14, 0xc6, 0xb3, 0xdb, 0x42, 0xed, 0x29, 0x45, 0x9d, 0x00, 0x59, 0x2a, 0xc2, 0xb2, 0x89, 0x5a,
+	0x13, 0x68, 0x93, 0x6a, 0x8c, 0x26, 0x6a, 0x82, 0xe0, 0x05, 0x09, 0x89, 0x71, 0xe0, 0xc2, 0xbb,
+	0x66, 0xda, 0x0e, 0xed, 0xe8, 0xee, 0xce, 0x3a, 0x33, 0x0b, 0xe8, 0xa5, 0x8f, 0x20, 0x17, 0x5e,
+	0xfa, 0x50, 0xbe, 0x82, 0x0f, 0x62, 0xe6, 0xcf, 0xae, 0xb5, 0x08, 0xbd, 0x9b, 0x33, 0xe7, 0x77,
+	0xce, 0x9e, 0xf3, 0xf5, 0xeb, 0x40, 0x73, 0x18, 0xe7, 0x52, 0x51, 0xd1, 0xc9, 0x04, 0x57, 0x1c,
+	0x55, 0x48, 0xc6, 0x5a, 0x1b, 0x63, 0xce, 0xc7, 0x31, 0xed, 0x92, 0x8c, 0x75, 0x49, 0x9a, 0x72,
+	0x45, 0x14, 0xe3, 0xa9, 0xb4, 0x48, 0x6b, 0xcb, 0x65, 0x4d, 0x34, 0xc8, 0x4f, 0xbb, 0x8a, 0x25,
+	0x54, 0x2a, 0x92, 0x64, 0x0e, 0xb8, 0x37, 0x0b, 0xd0, 0x24, 0x53, 0x5f, 0x5c, 0x72, 0x73, 0x36,
+	0x39, 0xca, 0x85, 0x69, 0x6f, 0xf3, 0xd1, 0x7b, 0xb8, 0xbb, 0x6f, 0x27, 0x3a, 0x99, 0x08, 0x9e,
+	0x8f, 0x27, 0x59, 0xae, 0x10, 0x82, 0x6a, 0x4a, 0x12, 0x1a, 0x78, 0xa1, 0xd7, 0xae, 0x63, 0x73,
+	0x46, 0x2b, 0xb0, 0x30, 0xe4, 0x79, 0xaa, 0x02, 0x3f, 0xf4, 0xda, 0x55, 0x6c, 0x03, 0x4d, 0x0a,
+	0xa2, 0x68, 0x50, 0x09, 0xbd, 0xb6, 0x87, 0xcd, 0x39, 0xfa, 0xe9, 0xc3, 0x6d, 0xd7, 0xf3, 0x30,
+	0x95, 0x8a, 0xa4, 0x43, 0x8a, 0x96, 0xc1, 0x67, 0x23, 0xd7, 0xcf, 0x67, 0x23, 0xd4, 0x82, 0x5b,
+	0x13, 0x2e, 0x95, 0xf9, 0x8a, 0x6f, 0x6e, 0xcb, 0x18, 0x05, 0x50, 0x3b, 0xa3, 0x42, 0x32, 0x9e,
+	0x9a, 0xb6, 0x75, 0x5c, 0x84, 0xe8, 0x05, 0x80, 0x54, 0x44, 0x28, 0x3a, 0xea, 0x13, 0x15, 0x54,
+	0x43, 0xaf, 0xdd, 0xe8, 0xb5, 0x3a, 0x76, 0xc3, 0x4e, 0xb1, 0x61, 0xe7, 0xa4, 0xd0, 0x07, 0xd7,
+	0x1d, 0xbd, 0xa7, 0xd0, 0x2b, 0x58, 0x9a, 0x50, 0x22, 0xd4, 0x80, 0x12, 0xa5, 0x8b, 0x17, 0xe6,
+	0x16, 0x37, 0x4a, 0x7e, 0x4f, 0xe9, 0xed, 0x05, 0x8f, 0xa9, 0x0c, 0x16, 0xc3, 0x4a, 0xbb, 0x8e,
+	0x6d, 0x80, 0x9e, 0x01, 0xa8, 0x52, 0xb5, 0xa0, 0x16, 0x56, 0xda, 0x8d, 0xde, 0x5a, 0x87, 0x64,
+	0xac, 0x73, 0x45, 0x53, 0x3c, 0x45, 0x46, 0x07, 0xd0, 0x74, 0xc0, 0x11, 0x25, 0x23, 0x2a, 0x8c,
+	0x8c, 0x3c, 0x2e, 0x05, 0xd7, 0x67, 0xb4, 0x05, 0x0d, 0xe6, 0xe4, 0xeb, 0xb3, 0x91, 0x53, 0x09,
+	0x8a, 0xab, 0xc3, 0x83, 0xe8, 0x2b, 0x6c, 0x1c, 0x31, 0xa9, 0x66, 0xa4, 0x96, 0x98, 0xca, 0x8c,
+	0xa7, 0x92, 0xa2, 0x1d, 0x58, 0x14, 0x54, 0xe6, 0xb1, 0x0a, 0x3c, 0x33, 0xd9, 0xca, 0xf4, 0x64,
+	0x05, 0x8e, 0x1d, 0x83, 0x76, 0xa0, 0x16, 0x9b, 0x61, 0x64, 0xe0, 0x1b, 0x1c, 0x4d, 0xe3, 0x76,
+	0x4e, 0x5c, 0x20, 0xd1, 0x07, 0x58, 0x3d, 0x56, 0x5c, 0x90, 0x31, 0x3d, 0x70, 0x7e, 0x7a, 0x93,
+	0x0f, 0x3f, 0x51, 0x85, 0x1e, 0x83, 0xef, 0xf6, 0x68, 0xf4, 0xd6, 0xaf, 0xa8, 0x5b, 0xc0, 0xd8,
+	0x8f, 0xaf, 0x71, 0x54, 0xf4, 0xc3, 0x87, 0x3b, 0xae, 0xf5, 0xbb, 0x8c, 0x5a, 0x5c, 0xdb, 0xe5,
+	0x34, 0x4f, 0x87, 0xfa, 0xec, 0x34, 0x2a, 0xe3, 0x6b, 0x8c, 0x79, 0x1f, 0x40, 0xc6, 0xfc, 0xbc,
+	0x6f, 0x53, 0x15, 0x93, 0xaa, 0xeb, 0x9b, 0x7d, 0x93, 0x7e, 0x0d, 0xcd, 0x84, 0x92, 0xb4, 0x5f,
+	0xfc, 0x1b, 0x82, 0xea, 0xbc, 0x89, 0x97, 0x34, 0x5f, 0x44, 0xe8, 0x25, 0x2c, 0x25, 0xe4, 0xe2,
+	0x6f, 0xf9, 0xc2, 0xbc, 0xf2, 0x46, 0x42, 0x2e, 0xca, 0xea, 0xa7, 0x50, 0x1b, 0x18, 0xb9, 0xac,
+	0x9f, 0xb4, 0x0f, 0xb5, 0xd6, 0xff, 0x55, 0x14, 0x17, 0x68, 0xf4, 0x1c, 0x42, 0xfd, 0x7b, 0x1f,
+	0xc7, 0xfc, 0x7c, 0x56, 0x20, 0x89, 0xe9, 0xe7, 0x9c, 0x4a, 0xe3, 0xd3, 0x98, 0x25, 0x4c, 0x19,
+	0x95, 0x2a, 0xd8, 0x06, 0x91, 0x84, 0xed, 0x1b, 0x2a, 0x9d, 0x5d, 0x66, 0xfc, 0xe6, 0xcd, 0xfa,
+	0x0d, 0xed, 0x96, 0x7e, 0xb2, 0x06, 0x59, 0x9d, 0x1e, 0xba, 0x6c, 0x58, 0x18, 0xaa, 0xf7, 0xdd,
+	0x87, 0x65, 0xe7, 0x9e, 0x63, 0x2a, 0xce, 0xd8, 0x90, 0xa2, 0x8f, 0xd0, 0xd4, 0x73, 0x94, 0x56,
+	0x45, 0x6b, 0x57, 0x04, 0x7b, 0xab, 0xdf, 0xae, 0xd6, 0xb6, 0x69, 0x7d, 0x93, 0xbb, 0xa3, 0xcd,
+	0x6f, 0xbf, 0x7e, 0x5f, 0xfa, 0x01, 0x5a, 0x33, 0xcf, 0xa6, 0x7b, 0x55, 0xbb, 0xac, 0x6c, 0x7d,
+	0xe9, 0xc1, 0xfa, 0xb5, 0x4b, 0xa3, 0x07, 0xe5, 0x07, 0x6e, 0x92, 0xb3, 0xf5, 0x70, 0x1e, 0xe6,
+	0x86, 0x79, 0x64, 0x86, 0xd9, 0x46, 0x5b, 0xff, 0x0c, 0x23, 0x2d, 0xbf, 0xcb, 0xcb, 0x82, 0xc1,
+	0xa2, 0x59, 0xf4, 0xc9, 0x9f, 0x01, 0x00, 0xe1, 0x66, 0x98, 0xc6, 0x07, 0x06, 0x00, 0x00,
+}
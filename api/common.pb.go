@@ -42,7 +42,121 @@ func (x RXWindow) String() string {
 	return proto.EnumName(RXWindow_name, int32(x))
 }
 func (RXWindow) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_common_244c5cf40c0db15f, []int{0}
+	return fileDescriptor_common_e54cd6e33beac981, []int{0}
+}
+
+// ErrorCode defines the machine-readable code of an API error. It is
+// returned as ErrorDetails in the details of the gRPC status (and in the
+// details of the RESTful JSON error body).
+type ErrorCode int32
+
+const (
+	// Unspecified error.
+	ErrorCode_UNSPECIFIED_ERROR ErrorCode = 0
+	// The object does not exist.
+	ErrorCode_DOES_NOT_EXIST ErrorCode = 1
+	// The object already exists.
+	ErrorCode_ALREADY_EXISTS ErrorCode = 2
+	// A device with the same DevEUI already exists.
+	ErrorCode_DUPLICATE_DEV_EUI ErrorCode = 3
+	// The network-server is unavailable or did not respond in time.
+	ErrorCode_NETWORK_SERVER_UNAVAILABLE ErrorCode = 4
+	// The payload exceeds the max. payload size.
+	ErrorCode_PAYLOAD_TOO_LARGE ErrorCode = 5
+	// The payload codec failed to encode or decode the payload.
+	ErrorCode_CODEC_ERROR ErrorCode = 6
+	// The max. number of downlinks per hour or day of the device has been
+	// reached.
+	ErrorCode_DOWNLINK_RATE_LIMITED ErrorCode = 7
+	// The current terms version must be accepted by the user.
+	ErrorCode_TERMS_NOT_ACCEPTED ErrorCode = 8
+)
+
+var ErrorCode_name = map[int32]string{
+	0: "UNSPECIFIED_ERROR",
+	1: "DOES_NOT_EXIST",
+	2: "ALREADY_EXISTS",
+	3: "DUPLICATE_DEV_EUI",
+	4: "NETWORK_SERVER_UNAVAILABLE",
+	5: "PAYLOAD_TOO_LARGE",
+	6: "CODEC_ERROR",
+	7: "DOWNLINK_RATE_LIMITED",
+	8: "TERMS_NOT_ACCEPTED",
+}
+var ErrorCode_value = map[string]int32{
+	"UNSPECIFIED_ERROR":          0,
+	"DOES_NOT_EXIST":             1,
+	"ALREADY_EXISTS":             2,
+	"DUPLICATE_DEV_EUI":          3,
+	"NETWORK_SERVER_UNAVAILABLE": 4,
+	"PAYLOAD_TOO_LARGE":          5,
+	"CODEC_ERROR":                6,
+	"DOWNLINK_RATE_LIMITED":      7,
+	"TERMS_NOT_ACCEPTED":         8,
+}
+
+func (x ErrorCode) String() string {
+	return proto.EnumName(ErrorCode_name, int32(x))
+}
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_common_e54cd6e33beac981, []int{1}
+}
+
+// DeviceConflictSource defines where a conflicting DevEUI is in use.
+type DeviceConflictSource int32
+
+const (
+	// The DevEUI is used by a device in the same organization.
+	DeviceConflictSource_CONFLICT_SAME_ORGANIZATION DeviceConflictSource = 0
+	// The DevEUI is used by a device in an other organization.
+	DeviceConflictSource_CONFLICT_OTHER_ORGANIZATION DeviceConflictSource = 1
+	// The DevEUI is not used by this application-server, but the device
+	// already exists on the network-server.
+	DeviceConflictSource_CONFLICT_NETWORK_SERVER DeviceConflictSource = 2
+)
+
+var DeviceConflictSource_name = map[int32]string{
+	0: "CONFLICT_SAME_ORGANIZATION",
+	1: "CONFLICT_OTHER_ORGANIZATION",
+	2: "CONFLICT_NETWORK_SERVER",
+}
+var DeviceConflictSource_value = map[string]int32{
+	"CONFLICT_SAME_ORGANIZATION":  0,
+	"CONFLICT_OTHER_ORGANIZATION": 1,
+	"CONFLICT_NETWORK_SERVER":     2,
+}
+
+func (x DeviceConflictSource) String() string {
+	return proto.EnumName(DeviceConflictSource_name, int32(x))
+}
+func (DeviceConflictSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_common_e54cd6e33beac981, []int{2}
+}
+
+// DownlinkRateLimitPeriod defines the period of a downlink rate-limit.
+type DownlinkRateLimitPeriod int32
+
+const (
+	// Max. number of downlinks per hour.
+	DownlinkRateLimitPeriod_RATE_LIMIT_HOUR DownlinkRateLimitPeriod = 0
+	// Max. number of downlinks per day.
+	DownlinkRateLimitPeriod_RATE_LIMIT_DAY DownlinkRateLimitPeriod = 1
+)
+
+var DownlinkRateLimitPeriod_name = map[int32]string{
+	0: "RATE_LIMIT_HOUR",
+	1: "RATE_LIMIT_DAY",
+}
+var DownlinkRateLimitPeriod_value = map[string]int32{
+	"RATE_LIMIT_HOUR": 0,
+	"RATE_LIMIT_DAY":  1,
+}
+
+func (x DownlinkRateLimitPeriod) String() string {
+	return proto.EnumName(DownlinkRateLimitPeriod_name, int32(x))
+}
+func (DownlinkRateLimitPeriod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_common_e54cd6e33beac981, []int{3}
 }
 
 type UplinkFrameLog struct {
@@ -61,7 +175,7 @@ func (m *UplinkFrameLog) Reset()         { *m = UplinkFrameLog{} }
 func (m *UplinkFrameLog) String() string { return proto.CompactTextString(m) }
 func (*UplinkFrameLog) ProtoMessage()    {}
 func (*UplinkFrameLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_244c5cf40c0db15f, []int{0}
+	return fileDescriptor_common_e54cd6e33beac981, []int{0}
 }
 func (m *UplinkFrameLog) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UplinkFrameLog.Unmarshal(m, b)
@@ -116,7 +230,7 @@ func (m *DownlinkFrameLog) Reset()         { *m = DownlinkFrameLog{} }
 func (m *DownlinkFrameLog) String() string { return proto.CompactTextString(m) }
 func (*DownlinkFrameLog) ProtoMessage()    {}
 func (*DownlinkFrameLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_244c5cf40c0db15f, []int{1}
+	return fileDescriptor_common_e54cd6e33beac981, []int{1}
 }
 func (m *DownlinkFrameLog) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownlinkFrameLog.Unmarshal(m, b)
@@ -193,7 +307,7 @@ func (m *UplinkRXInfo) Reset()         { *m = UplinkRXInfo{} }
 func (m *UplinkRXInfo) String() string { return proto.CompactTextString(m) }
 func (*UplinkRXInfo) ProtoMessage()    {}
 func (*UplinkRXInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_244c5cf40c0db15f, []int{2}
+	return fileDescriptor_common_e54cd6e33beac981, []int{2}
 }
 func (m *UplinkRXInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UplinkRXInfo.Unmarshal(m, b)
@@ -427,7 +541,7 @@ func (m *EncryptedFineTimestamp) Reset()         { *m = EncryptedFineTimestamp{}
 func (m *EncryptedFineTimestamp) String() string { return proto.CompactTextString(m) }
 func (*EncryptedFineTimestamp) ProtoMessage()    {}
 func (*EncryptedFineTimestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_244c5cf40c0db15f, []int{3}
+	return fileDescriptor_common_e54cd6e33beac981, []int{3}
 }
 func (m *EncryptedFineTimestamp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EncryptedFineTimestamp.Unmarshal(m, b)
@@ -501,7 +615,7 @@ func (m *DownlinkTXInfo) Reset()         { *m = DownlinkTXInfo{} }
 func (m *DownlinkTXInfo) String() string { return proto.CompactTextString(m) }
 func (*DownlinkTXInfo) ProtoMessage()    {}
 func (*DownlinkTXInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_244c5cf40c0db15f, []int{4}
+	return fileDescriptor_common_e54cd6e33beac981, []int{4}
 }
 func (m *DownlinkTXInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownlinkTXInfo.Unmarshal(m, b)
@@ -695,67 +809,352 @@ func _DownlinkTXInfo_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type ErrorDetails struct {
+	// Error code.
+	Code ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=api.ErrorCode" json:"code,omitempty"`
+	// Conflict details.
+	// This is set for DUPLICATE_DEV_EUI errors.
+	DeviceConflict *DeviceConflictDetails `protobuf:"bytes,2,opt,name=device_conflict,json=deviceConflict,proto3" json:"device_conflict,omitempty"`
+	// Downlink rate-limit details.
+	// This is set for DOWNLINK_RATE_LIMITED errors.
+	DownlinkRateLimit *DownlinkRateLimitDetails `protobuf:"bytes,3,opt,name=downlink_rate_limit,json=downlinkRateLimit,proto3" json:"downlink_rate_limit,omitempty"`
+	// Terms details.
+	// This is set for TERMS_NOT_ACCEPTED errors.
+	TermsNotAccepted     *TermsNotAcceptedDetails `protobuf:"bytes,4,opt,name=terms_not_accepted,json=termsNotAccepted,proto3" json:"terms_not_accepted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ErrorDetails) Reset()         { *m = ErrorDetails{} }
+func (m *ErrorDetails) String() string { return proto.CompactTextString(m) }
+func (*ErrorDetails) ProtoMessage()    {}
+func (*ErrorDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_common_e54cd6e33beac981, []int{5}
+}
+func (m *ErrorDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ErrorDetails.Unmarshal(m, b)
+}
+func (m *ErrorDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ErrorDetails.Marshal(b, m, deterministic)
+}
+func (dst *ErrorDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorDetails.Merge(dst, src)
+}
+func (m *ErrorDetails) XXX_Size() int {
+	return xxx_messageInfo_ErrorDetails.Size(m)
+}
+func (m *ErrorDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorDetails proto.InternalMessageInfo
+
+func (m *ErrorDetails) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_UNSPECIFIED_ERROR
+}
+
+func (m *ErrorDetails) GetDeviceConflict() *DeviceConflictDetails {
+	if m != nil {
+		return m.DeviceConflict
+	}
+	return nil
+}
+
+func (m *ErrorDetails) GetDownlinkRateLimit() *DownlinkRateLimitDetails {
+	if m != nil {
+		return m.DownlinkRateLimit
+	}
+	return nil
+}
+
+func (m *ErrorDetails) GetTermsNotAccepted() *TermsNotAcceptedDetails {
+	if m != nil {
+		return m.TermsNotAccepted
+	}
+	return nil
+}
+
+type TermsNotAcceptedDetails struct {
+	// ID of the terms version which must be accepted.
+	TermsVersionId int64 `protobuf:"varint,1,opt,name=terms_version_id,json=termsVersionID,proto3" json:"terms_version_id,omitempty"`
+	// Version label.
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TermsNotAcceptedDetails) Reset()         { *m = TermsNotAcceptedDetails{} }
+func (m *TermsNotAcceptedDetails) String() string { return proto.CompactTextString(m) }
+func (*TermsNotAcceptedDetails) ProtoMessage()    {}
+func (*TermsNotAcceptedDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_common_e54cd6e33beac981, []int{6}
+}
+func (m *TermsNotAcceptedDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TermsNotAcceptedDetails.Unmarshal(m, b)
+}
+func (m *TermsNotAcceptedDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TermsNotAcceptedDetails.Marshal(b, m, deterministic)
+}
+func (dst *TermsNotAcceptedDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TermsNotAcceptedDetails.Merge(dst, src)
+}
+func (m *TermsNotAcceptedDetails) XXX_Size() int {
+	return xxx_messageInfo_TermsNotAcceptedDetails.Size(m)
+}
+func (m *TermsNotAcceptedDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_TermsNotAcceptedDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TermsNotAcceptedDetails proto.InternalMessageInfo
+
+func (m *TermsNotAcceptedDetails) GetTermsVersionId() int64 {
+	if m != nil {
+		return m.TermsVersionId
+	}
+	return 0
+}
+
+func (m *TermsNotAcceptedDetails) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type DeviceConflictDetails struct {
+	// ID of the recorded device conflict.
+	// The conflict can be resolved by a global admin.
+	ConflictId int64 `protobuf:"varint,1,opt,name=conflict_id,json=conflictID,proto3" json:"conflict_id,omitempty"`
+	// DevEUI of the device (HEX encoded).
+	DevEui string `protobuf:"bytes,2,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Where the DevEUI is in use.
+	Source DeviceConflictSource `protobuf:"varint,3,opt,name=source,proto3,enum=api.DeviceConflictSource" json:"source,omitempty"`
+	// Application ID of the existing device.
+	// This is only set when the existing device belongs to the same
+	// organization.
+	ExistingApplicationId int64    `protobuf:"varint,4,opt,name=existing_application_id,json=existingApplicationID,proto3" json:"existing_application_id,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *DeviceConflictDetails) Reset()         { *m = DeviceConflictDetails{} }
+func (m *DeviceConflictDetails) String() string { return proto.CompactTextString(m) }
+func (*DeviceConflictDetails) ProtoMessage()    {}
+func (*DeviceConflictDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_common_e54cd6e33beac981, []int{7}
+}
+func (m *DeviceConflictDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceConflictDetails.Unmarshal(m, b)
+}
+func (m *DeviceConflictDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceConflictDetails.Marshal(b, m, deterministic)
+}
+func (dst *DeviceConflictDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceConflictDetails.Merge(dst, src)
+}
+func (m *DeviceConflictDetails) XXX_Size() int {
+	return xxx_messageInfo_DeviceConflictDetails.Size(m)
+}
+func (m *DeviceConflictDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceConflictDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceConflictDetails proto.InternalMessageInfo
+
+func (m *DeviceConflictDetails) GetConflictId() int64 {
+	if m != nil {
+		return m.ConflictId
+	}
+	return 0
+}
+
+func (m *DeviceConflictDetails) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *DeviceConflictDetails) GetSource() DeviceConflictSource {
+	if m != nil {
+		return m.Source
+	}
+	return DeviceConflictSource_CONFLICT_SAME_ORGANIZATION
+}
+
+func (m *DeviceConflictDetails) GetExistingApplicationId() int64 {
+	if m != nil {
+		return m.ExistingApplicationId
+	}
+	return 0
+}
+
+type DownlinkRateLimitDetails struct {
+	// Period of the exceeded rate-limit.
+	Period DownlinkRateLimitPeriod `protobuf:"varint,1,opt,name=period,proto3,enum=api.DownlinkRateLimitPeriod" json:"period,omitempty"`
+	// Max. number of downlinks within the period.
+	MaxDownlinks uint32 `protobuf:"varint,2,opt,name=max_downlinks,json=maxDownlinks,proto3" json:"max_downlinks,omitempty"`
+	// Duration after which a downlink can be enqueued again.
+	RetryAfter           *duration.Duration `protobuf:"bytes,3,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DownlinkRateLimitDetails) Reset()         { *m = DownlinkRateLimitDetails{} }
+func (m *DownlinkRateLimitDetails) String() string { return proto.CompactTextString(m) }
+func (*DownlinkRateLimitDetails) ProtoMessage()    {}
+func (*DownlinkRateLimitDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_common_e54cd6e33beac981, []int{8}
+}
+func (m *DownlinkRateLimitDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownlinkRateLimitDetails.Unmarshal(m, b)
+}
+func (m *DownlinkRateLimitDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownlinkRateLimitDetails.Marshal(b, m, deterministic)
+}
+func (dst *DownlinkRateLimitDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownlinkRateLimitDetails.Merge(dst, src)
+}
+func (m *DownlinkRateLimitDetails) XXX_Size() int {
+	return xxx_messageInfo_DownlinkRateLimitDetails.Size(m)
+}
+func (m *DownlinkRateLimitDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownlinkRateLimitDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownlinkRateLimitDetails proto.InternalMessageInfo
+
+func (m *DownlinkRateLimitDetails) GetPeriod() DownlinkRateLimitPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return DownlinkRateLimitPeriod_RATE_LIMIT_HOUR
+}
+
+func (m *DownlinkRateLimitDetails) GetMaxDownlinks() uint32 {
+	if m != nil {
+		return m.MaxDownlinks
+	}
+	return 0
+}
+
+func (m *DownlinkRateLimitDetails) GetRetryAfter() *duration.Duration {
+	if m != nil {
+		return m.RetryAfter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UplinkFrameLog)(nil), "api.UplinkFrameLog")
 	proto.RegisterType((*DownlinkFrameLog)(nil), "api.DownlinkFrameLog")
 	proto.RegisterType((*UplinkRXInfo)(nil), "api.UplinkRXInfo")
 	proto.RegisterType((*EncryptedFineTimestamp)(nil), "api.EncryptedFineTimestamp")
 	proto.RegisterType((*DownlinkTXInfo)(nil), "api.DownlinkTXInfo")
+	proto.RegisterType((*ErrorDetails)(nil), "api.ErrorDetails")
+	proto.RegisterType((*TermsNotAcceptedDetails)(nil), "api.TermsNotAcceptedDetails")
+	proto.RegisterType((*DeviceConflictDetails)(nil), "api.DeviceConflictDetails")
+	proto.RegisterType((*DownlinkRateLimitDetails)(nil), "api.DownlinkRateLimitDetails")
 	proto.RegisterEnum("api.RXWindow", RXWindow_name, RXWindow_value)
-}
-
-func init() { proto.RegisterFile("common.proto", fileDescriptor_common_244c5cf40c0db15f) }
-
-var fileDescriptor_common_244c5cf40c0db15f = []byte{
-	// 795 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x51, 0x73, 0xda, 0x46,
-	0x10, 0x8e, 0x82, 0x31, 0xb0, 0x02, 0x0a, 0xe7, 0xd4, 0x55, 0xa8, 0xdb, 0x52, 0x9e, 0x68, 0x26,
-	0x15, 0x53, 0x3a, 0xfd, 0x03, 0xa9, 0x9d, 0xd4, 0x89, 0x9b, 0x66, 0x0e, 0x77, 0xe2, 0x37, 0xcd,
-	0x21, 0x9d, 0xc4, 0xc5, 0xe8, 0xee, 0x7a, 0x12, 0xc5, 0xfa, 0x05, 0x7d, 0xee, 0xef, 0xed, 0x4b,
-	0xe7, 0xee, 0x04, 0x18, 0x99, 0x8e, 0xdf, 0xfa, 0x24, 0xed, 0x77, 0xdf, 0x7e, 0xbb, 0xa7, 0xfd,
-	0x56, 0xd0, 0x0e, 0x45, 0x9a, 0x0a, 0xee, 0x4b, 0x25, 0x72, 0x81, 0x6a, 0x44, 0xb2, 0xc1, 0x37,
-	0x89, 0x10, 0xc9, 0x92, 0x4e, 0x0c, 0x34, 0x5f, 0xc5, 0x93, 0x9c, 0xa5, 0x34, 0xcb, 0x49, 0x2a,
-	0x2d, 0x6b, 0xf0, 0x75, 0x95, 0x10, 0xad, 0x14, 0xc9, 0xd9, 0x46, 0x65, 0xf0, 0x53, 0xc2, 0xf2,
-	0xc5, 0x6a, 0xee, 0x87, 0x22, 0x9d, 0xcc, 0x95, 0x08, 0x09, 0x51, 0x93, 0xa5, 0x50, 0x24, 0xa3,
-	0xea, 0x4f, 0xaa, 0x26, 0x44, 0xb2, 0x89, 0xad, 0x3a, 0xb9, 0x5f, 0x7c, 0xf0, 0xfd, 0xe3, 0x69,
-	0xc9, 0x7a, 0x92, 0xac, 0x2d, 0x7d, 0xf4, 0xb7, 0x03, 0xdd, 0xdf, 0xe5, 0x92, 0xf1, 0xdb, 0xd7,
-	0x8a, 0xa4, 0xf4, 0x4a, 0x24, 0xe8, 0x3b, 0x68, 0xe4, 0x77, 0x01, 0xe3, 0xb1, 0xf0, 0x9c, 0xa1,
-	0x33, 0x76, 0xa7, 0x3d, 0x3f, 0x59, 0xfb, 0x96, 0x74, 0x7d, 0x73, 0xc9, 0x63, 0x81, 0x8f, 0xf3,
-	0x3b, 0xfd, 0x44, 0x2f, 0xa0, 0xa1, 0x4a, 0xea, 0xd3, 0x61, 0x6d, 0xec, 0x4e, 0xfb, 0x3e, 0x91,
-	0xac, 0xe4, 0xe2, 0x92, 0xab, 0x2c, 0x77, 0x0c, 0x3d, 0xb9, 0x28, 0x02, 0x49, 0x8a, 0xa5, 0x20,
-	0x51, 0xf0, 0x29, 0x13, 0xdc, 0xab, 0x0d, 0x9d, 0x71, 0x0b, 0x77, 0xe5, 0xa2, 0xf8, 0x60, 0xe1,
-	0xb7, 0xb3, 0xdf, 0xde, 0x8f, 0x3e, 0x41, 0xef, 0x5c, 0xac, 0xf9, 0x5e, 0x53, 0x2f, 0xab, 0x4d,
-	0x9d, 0x98, 0x4a, 0x1b, 0x5e, 0xa5, 0xaf, 0x43, 0xb5, 0x9e, 0x1e, 0xac, 0xf5, 0x57, 0x1d, 0xda,
-	0xf7, 0xdb, 0x45, 0x5f, 0x01, 0x24, 0x24, 0xa7, 0x6b, 0x52, 0x04, 0x2c, 0x32, 0xb5, 0x5a, 0xb8,
-	0x55, 0x22, 0x97, 0x11, 0xf2, 0xe1, 0x48, 0x0f, 0xd2, 0xa8, 0xb9, 0xd3, 0x81, 0x6f, 0x87, 0xe8,
-	0x6f, 0x86, 0xe8, 0x5f, 0x6f, 0xa6, 0x8c, 0x0d, 0x0f, 0xbd, 0x85, 0x67, 0xfa, 0x19, 0x64, 0x8c,
-	0x87, 0x34, 0x48, 0x64, 0x16, 0x50, 0x29, 0xc2, 0x85, 0xb9, 0xb9, 0x3b, 0x7d, 0xfe, 0x20, 0xff,
-	0xbc, 0x34, 0x01, 0xee, 0xeb, 0xb4, 0x99, 0xce, 0x7a, 0x23, 0xb3, 0x0b, 0x9d, 0x83, 0xce, 0xa0,
-	0xb5, 0x35, 0x91, 0x77, 0x34, 0x74, 0xc6, 0x1d, 0xbc, 0x03, 0x10, 0x82, 0x23, 0x95, 0x65, 0xcc,
-	0xab, 0x0f, 0x9d, 0x71, 0x1d, 0x9b, 0x77, 0xf4, 0x1c, 0x9a, 0x7a, 0xf6, 0x41, 0xc6, 0x95, 0x77,
-	0x3c, 0x74, 0xc6, 0x0e, 0x6e, 0xe8, 0x78, 0xc6, 0x15, 0xf2, 0xa0, 0x11, 0x2e, 0x08, 0xe7, 0x74,
-	0xe9, 0x35, 0x8c, 0xd4, 0x26, 0xd4, 0x49, 0x2a, 0x0e, 0xc2, 0x05, 0x61, 0xdc, 0x6b, 0xda, 0x23,
-	0x15, 0xff, 0xac, 0x43, 0xf4, 0x0c, 0xea, 0x73, 0x41, 0x54, 0xe4, 0xb5, 0x0c, 0x6e, 0x03, 0x2d,
-	0x45, 0x78, 0x4e, 0x39, 0x27, 0x1e, 0x58, 0x7e, 0x19, 0xa2, 0x97, 0xba, 0x7e, 0x68, 0x2e, 0xe4,
-	0xb9, 0xa5, 0x97, 0x4a, 0xb7, 0x5e, 0x95, 0x38, 0xde, 0x32, 0xd0, 0x05, 0x9c, 0xc4, 0x8c, 0xd3,
-	0x60, 0x7b, 0xa7, 0x20, 0x2f, 0x24, 0xf5, 0xda, 0x43, 0x67, 0xdc, 0x9d, 0x7e, 0xae, 0x4d, 0xf8,
-	0x9a, 0x71, 0xba, 0xfd, 0xc2, 0xd7, 0x85, 0xa4, 0xb8, 0x1f, 0x57, 0x21, 0xf4, 0x11, 0x3c, 0xca,
-	0x43, 0x55, 0xc8, 0x9c, 0x46, 0xc1, 0xbe, 0xa0, 0xd7, 0x31, 0x4d, 0x7c, 0x69, 0xbc, 0x73, 0xb1,
-	0x21, 0xed, 0xa9, 0xfe, 0xf2, 0x04, 0x9f, 0xd2, 0x83, 0x27, 0x7a, 0x96, 0x72, 0x49, 0x18, 0xaf,
-	0x8a, 0x76, 0x8d, 0xe8, 0xa9, 0x6e, 0xf0, 0x83, 0x3e, 0xaf, 0xea, 0x21, 0xf9, 0x00, 0x7d, 0xd5,
-	0x83, 0xee, 0xbe, 0xca, 0xe8, 0x0e, 0x4e, 0x0f, 0x77, 0x84, 0x46, 0xd0, 0x21, 0x34, 0x0b, 0x6e,
-	0x69, 0x11, 0x30, 0x1e, 0xd1, 0x3b, 0xe3, 0xca, 0x0e, 0x76, 0x09, 0xcd, 0xde, 0xd1, 0xe2, 0x52,
-	0x43, 0xe8, 0x5b, 0x68, 0xef, 0x2e, 0xcd, 0x33, 0xe3, 0xcf, 0x36, 0x76, 0xb7, 0xd8, 0xfb, 0x19,
-	0xfa, 0x02, 0x1a, 0xb1, 0x4c, 0x88, 0xb6, 0xb5, 0xdd, 0xbb, 0x63, 0x1d, 0x5e, 0x9e, 0x8f, 0xfe,
-	0xa9, 0x41, 0x77, 0x7f, 0x91, 0x1e, 0xdb, 0x82, 0x21, 0xb8, 0x2c, 0x4d, 0x69, 0xc4, 0x48, 0x4e,
-	0x97, 0x85, 0x29, 0xd6, 0xc4, 0xf7, 0xa1, 0xff, 0xd1, 0xf7, 0x67, 0xd0, 0x8a, 0x15, 0xfd, 0x63,
-	0x45, 0x79, 0x58, 0x18, 0xf3, 0x77, 0xf0, 0x0e, 0xd0, 0x8e, 0x95, 0x62, 0x4d, 0xad, 0xfd, 0xeb,
-	0xd8, 0x06, 0x68, 0x0a, 0x90, 0x8a, 0x68, 0xb5, 0xb4, 0xce, 0x6c, 0x18, 0x83, 0xa1, 0x8d, 0x33,
-	0x7f, 0xdd, 0x9e, 0xe0, 0x7b, 0x2c, 0x7d, 0x23, 0xb3, 0x4b, 0x3b, 0xc8, 0xfe, 0x8e, 0x9a, 0xbb,
-	0xe9, 0x5f, 0x09, 0x4c, 0x76, 0xd9, 0xfa, 0x43, 0xea, 0xe9, 0xeb, 0xac, 0x7d, 0x14, 0xbd, 0x81,
-	0x93, 0x38, 0xbb, 0x7d, 0x20, 0xd5, 0x32, 0x52, 0xd6, 0xe9, 0xb3, 0x77, 0x0f, 0x94, 0xfa, 0x71,
-	0x76, 0x5b, 0x11, 0xda, 0x2e, 0x24, 0xfc, 0xc7, 0x42, 0xba, 0x7b, 0x0b, 0xf9, 0xaa, 0x0f, 0x9f,
-	0x55, 0x8a, 0xbe, 0x38, 0x83, 0x26, 0xbe, 0xf9, 0xc8, 0x78, 0x24, 0xd6, 0xa8, 0x01, 0x35, 0x7c,
-	0xf3, 0x43, 0xef, 0x89, 0x7d, 0x99, 0xf6, 0x9c, 0xf9, 0xb1, 0x99, 0xd0, 0x8f, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x1b, 0x68, 0xe9, 0x51, 0xe2, 0x06, 0x00, 0x00,
+	proto.RegisterEnum("api.ErrorCode", ErrorCode_name, ErrorCode_value)
+	proto.RegisterEnum("api.DeviceConflictSource", DeviceConflictSource_name, DeviceConflictSource_value)
+	proto.RegisterEnum("api.DownlinkRateLimitPeriod", DownlinkRateLimitPeriod_name, DownlinkRateLimitPeriod_value)
+}
+
+func init() { proto.RegisterFile("common.proto", fileDescriptor_common_e54cd6e33beac981) }
+
+var fileDescriptor_common_e54cd6e33beac981 = []byte{
+	// 1350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x52, 0xe3, 0x46,
+	0x17, 0x45, 0x18, 0x6c, 0x7c, 0x0d, 0x46, 0x34, 0x03, 0x18, 0x86, 0x99, 0xf1, 0xe7, 0x6f, 0xe3,
+	0x50, 0x13, 0xbb, 0xc6, 0xf9, 0x59, 0x64, 0x27, 0x2c, 0xc1, 0x68, 0x30, 0x16, 0xd5, 0x36, 0x3f,
+	0x93, 0xaa, 0x54, 0x57, 0x23, 0xb5, 0x8d, 0x06, 0x5b, 0x52, 0x5a, 0xe2, 0xc7, 0x4f, 0x90, 0x75,
+	0xf2, 0x26, 0xd9, 0xe7, 0x51, 0xf2, 0x26, 0xd9, 0xa4, 0xba, 0x25, 0xd9, 0xd8, 0x98, 0x9a, 0x5d,
+	0x56, 0x76, 0x9f, 0x7b, 0xee, 0xb9, 0xb7, 0xfb, 0x9e, 0x6e, 0xc1, 0xaa, 0xed, 0x0f, 0x87, 0xbe,
+	0x57, 0x0b, 0xb8, 0x1f, 0xf9, 0x28, 0x43, 0x03, 0x77, 0xef, 0x5d, 0xdf, 0xf7, 0xfb, 0x03, 0x56,
+	0x97, 0xd0, 0xf5, 0x5d, 0xaf, 0x1e, 0xb9, 0x43, 0x16, 0x46, 0x74, 0x18, 0xc4, 0xac, 0xbd, 0xb7,
+	0xb3, 0x04, 0xe7, 0x8e, 0xd3, 0xc8, 0x4d, 0x55, 0xf6, 0x7e, 0xe8, 0xbb, 0xd1, 0xcd, 0xdd, 0x75,
+	0xcd, 0xf6, 0x87, 0xf5, 0x6b, 0xee, 0xdb, 0x94, 0xf2, 0xfa, 0xc0, 0xe7, 0x34, 0x64, 0xfc, 0x9e,
+	0xf1, 0x3a, 0x0d, 0xdc, 0x7a, 0x5c, 0xb5, 0xfe, 0xb4, 0xf8, 0xde, 0xb7, 0x5f, 0x4f, 0xeb, 0x3f,
+	0xd4, 0xfb, 0x0f, 0x31, 0xbd, 0xf2, 0xbb, 0x02, 0xc5, 0xf3, 0x60, 0xe0, 0x7a, 0xb7, 0x47, 0x9c,
+	0x0e, 0x59, 0xcb, 0xef, 0xa3, 0x6f, 0x20, 0x17, 0x3d, 0x12, 0xd7, 0xeb, 0xf9, 0x25, 0xa5, 0xac,
+	0x54, 0x0b, 0x0d, 0xb5, 0xd6, 0x7f, 0xa8, 0xc5, 0xa4, 0xee, 0x95, 0xe9, 0xf5, 0x7c, 0x9c, 0x8d,
+	0x1e, 0xc5, 0x2f, 0x3a, 0x80, 0x1c, 0x4f, 0xa8, 0x8b, 0xe5, 0x4c, 0xb5, 0xd0, 0xd8, 0xa8, 0xd1,
+	0xc0, 0x4d, 0xb8, 0x38, 0xe1, 0xf2, 0x98, 0x5b, 0x05, 0x35, 0xb8, 0x19, 0x91, 0x80, 0x8e, 0x06,
+	0x3e, 0x75, 0xc8, 0x97, 0xd0, 0xf7, 0x4a, 0x99, 0xb2, 0x52, 0xcd, 0xe3, 0x62, 0x70, 0x33, 0x3a,
+	0x8b, 0xe1, 0x4f, 0x1d, 0xab, 0x5d, 0xf9, 0x02, 0xaa, 0xee, 0x3f, 0x78, 0x53, 0x4d, 0xbd, 0x9f,
+	0x6d, 0x6a, 0x53, 0x56, 0x4a, 0x79, 0x33, 0x7d, 0xcd, 0xab, 0xb5, 0x38, 0xb7, 0xd6, 0x6f, 0xcb,
+	0xb0, 0xfa, 0xb4, 0x5d, 0xf4, 0x06, 0xa0, 0x4f, 0x23, 0xf6, 0x40, 0x47, 0xc4, 0x75, 0x64, 0xad,
+	0x3c, 0xce, 0x27, 0x88, 0xe9, 0xa0, 0x1a, 0x2c, 0x89, 0x41, 0x4a, 0xb5, 0x42, 0x63, 0xaf, 0x16,
+	0x0f, 0xb1, 0x96, 0x0e, 0xb1, 0xd6, 0x4d, 0xa7, 0x8c, 0x25, 0x0f, 0x7d, 0x82, 0x57, 0xe2, 0x97,
+	0x84, 0xae, 0x67, 0x33, 0xd2, 0x0f, 0x42, 0xc2, 0x02, 0xdf, 0xbe, 0x91, 0x3b, 0x2f, 0x34, 0x76,
+	0x9f, 0xe5, 0xeb, 0x89, 0x09, 0xf0, 0x86, 0x48, 0xeb, 0x88, 0xac, 0xe3, 0x20, 0x34, 0x44, 0x0e,
+	0xda, 0x87, 0xfc, 0xd8, 0x44, 0xa5, 0xa5, 0xb2, 0x52, 0x5d, 0xc3, 0x13, 0x00, 0x21, 0x58, 0xe2,
+	0x61, 0xe8, 0x96, 0x96, 0xcb, 0x4a, 0x75, 0x19, 0xcb, 0xff, 0x68, 0x17, 0x56, 0xc4, 0xec, 0x49,
+	0xe8, 0xf1, 0x52, 0xb6, 0xac, 0x54, 0x15, 0x9c, 0x13, 0xeb, 0x8e, 0xc7, 0x51, 0x09, 0x72, 0xf6,
+	0x0d, 0xf5, 0x3c, 0x36, 0x28, 0xe5, 0xa4, 0x54, 0xba, 0x14, 0x49, 0xbc, 0x47, 0xec, 0x1b, 0xea,
+	0x7a, 0xa5, 0x95, 0x38, 0xc4, 0x7b, 0x4d, 0xb1, 0x44, 0xaf, 0x60, 0xf9, 0xda, 0xa7, 0xdc, 0x29,
+	0xe5, 0x25, 0x1e, 0x2f, 0x84, 0x14, 0xf5, 0x22, 0xe6, 0x79, 0xb4, 0x04, 0x31, 0x3f, 0x59, 0xa2,
+	0xf7, 0xa2, 0xbe, 0x2d, 0x37, 0x54, 0x2a, 0x24, 0x5e, 0x4a, 0xdc, 0xda, 0x4a, 0x70, 0x3c, 0x66,
+	0x20, 0x03, 0x36, 0x7b, 0xae, 0xc7, 0xc8, 0x78, 0x4f, 0x24, 0x1a, 0x05, 0xac, 0xb4, 0x5a, 0x56,
+	0xaa, 0xc5, 0xc6, 0x96, 0x30, 0xe1, 0x91, 0xeb, 0xb1, 0xf1, 0x09, 0x77, 0x47, 0x01, 0xc3, 0x1b,
+	0xbd, 0x59, 0x08, 0x5d, 0x42, 0x89, 0x79, 0x36, 0x1f, 0x05, 0x11, 0x73, 0xc8, 0xb4, 0x60, 0x69,
+	0x4d, 0x36, 0xf1, 0x5a, 0x7a, 0xc7, 0x48, 0x49, 0x53, 0xaa, 0x1f, 0x17, 0xf0, 0x36, 0x9b, 0x1b,
+	0x11, 0xb3, 0x0c, 0x06, 0xd4, 0xf5, 0x66, 0x45, 0x8b, 0x52, 0x74, 0x5b, 0x34, 0x78, 0x26, 0xe2,
+	0xb3, 0x7a, 0x28, 0x78, 0x86, 0x1e, 0xaa, 0x50, 0x9c, 0x56, 0xa9, 0x3c, 0xc2, 0xf6, 0xfc, 0x8e,
+	0x50, 0x05, 0xd6, 0x28, 0x0b, 0xc9, 0x2d, 0x1b, 0x11, 0xd7, 0x73, 0xd8, 0xa3, 0x74, 0xe5, 0x1a,
+	0x2e, 0x50, 0x16, 0x9e, 0xb0, 0x91, 0x29, 0x20, 0xf4, 0x3f, 0x58, 0x9d, 0x6c, 0xda, 0x0b, 0xa5,
+	0x3f, 0x57, 0x71, 0x61, 0x8c, 0xb5, 0x3b, 0x68, 0x07, 0x72, 0xbd, 0xa0, 0x4f, 0x85, 0xad, 0xe3,
+	0x7b, 0x97, 0x15, 0x4b, 0x53, 0xaf, 0xfc, 0x93, 0x81, 0xe2, 0xf4, 0x45, 0xfa, 0xda, 0x2d, 0x28,
+	0x43, 0xc1, 0x1d, 0x0e, 0x99, 0xe3, 0xd2, 0x88, 0x0d, 0x46, 0xb2, 0xd8, 0x0a, 0x7e, 0x0a, 0xfd,
+	0x87, 0xbe, 0xdf, 0x87, 0x7c, 0x8f, 0xb3, 0x5f, 0xef, 0x98, 0x67, 0x8f, 0xa4, 0xf9, 0xd7, 0xf0,
+	0x04, 0x10, 0x8e, 0x0d, 0xfc, 0x07, 0x16, 0xdb, 0x7f, 0x19, 0xc7, 0x0b, 0xd4, 0x00, 0x18, 0xfa,
+	0xce, 0xdd, 0x20, 0x76, 0x66, 0x4e, 0x1a, 0x0c, 0xa5, 0xce, 0x3c, 0x1d, 0x47, 0xf0, 0x13, 0x96,
+	0xd8, 0x91, 0xbc, 0x4b, 0x13, 0x28, 0x7e, 0x8e, 0x56, 0x26, 0xd3, 0x6f, 0xf9, 0x98, 0x4e, 0xb2,
+	0xc5, 0x41, 0x8a, 0xe9, 0x8b, 0xac, 0x69, 0x14, 0x1d, 0xc3, 0x66, 0x2f, 0xbc, 0x7d, 0x26, 0x95,
+	0x97, 0x52, 0xb1, 0xd3, 0x3b, 0x27, 0xcf, 0x94, 0x36, 0x7a, 0xe1, 0xed, 0x8c, 0xd0, 0xf8, 0x42,
+	0xc2, 0x0b, 0x17, 0xb2, 0x30, 0x75, 0x21, 0x0f, 0x37, 0x60, 0x7d, 0xa6, 0x68, 0xe5, 0x8f, 0x45,
+	0x58, 0x35, 0x38, 0xf7, 0xb9, 0xce, 0x22, 0xea, 0x0e, 0x42, 0x54, 0x81, 0x25, 0xdb, 0x77, 0x98,
+	0x9c, 0x7a, 0xb1, 0x51, 0x8c, 0xef, 0x8a, 0x20, 0x34, 0x7d, 0x87, 0x61, 0x19, 0x43, 0x4d, 0x58,
+	0x77, 0xd8, 0xbd, 0x6b, 0x33, 0x62, 0xfb, 0x5e, 0x6f, 0xe0, 0xda, 0xd1, 0xf8, 0x45, 0x94, 0xcf,
+	0xb2, 0x8c, 0x35, 0x93, 0x50, 0x22, 0x8c, 0x8b, 0xce, 0x14, 0x8c, 0x4e, 0x61, 0xd3, 0x49, 0x6c,
+	0x47, 0x38, 0x8d, 0x18, 0x19, 0xb8, 0x43, 0x37, 0x4a, 0x2c, 0xf2, 0x66, 0xea, 0x7d, 0xc7, 0x34,
+	0x62, 0x2d, 0x11, 0x4d, 0xb5, 0x36, 0x9c, 0xd9, 0x08, 0xfa, 0x04, 0x28, 0x62, 0x7c, 0x18, 0x12,
+	0xcf, 0x8f, 0x08, 0xb5, 0x6d, 0x26, 0x7c, 0x2f, 0xfd, 0x52, 0x68, 0xec, 0x4b, 0xb5, 0xae, 0x08,
+	0xb7, 0xfd, 0x48, 0x4b, 0x82, 0xa9, 0x98, 0x1a, 0xcd, 0x04, 0x2a, 0xbf, 0xc0, 0xce, 0x0b, 0x64,
+	0xf1, 0x6d, 0x89, 0xcb, 0xdc, 0x33, 0x1e, 0xca, 0x53, 0x8c, 0x2f, 0x48, 0x06, 0x17, 0x25, 0x7e,
+	0x11, 0xc3, 0xa6, 0x2e, 0xc6, 0x90, 0x70, 0x92, 0x8f, 0x4f, 0xba, 0xac, 0xfc, 0xa5, 0xc0, 0xd6,
+	0xdc, 0x33, 0x42, 0xef, 0xa0, 0x90, 0x9e, 0xe8, 0x44, 0x18, 0x52, 0xc8, 0xd4, 0xc5, 0x2d, 0x76,
+	0xd8, 0x3d, 0x61, 0x77, 0x6e, 0x22, 0x9a, 0x75, 0xd8, 0xbd, 0x71, 0x6e, 0xa2, 0x0f, 0x90, 0x0d,
+	0xfd, 0x3b, 0x6e, 0x33, 0x79, 0x80, 0xc5, 0xc6, 0xee, 0x9c, 0x49, 0x74, 0x24, 0x01, 0x27, 0x44,
+	0xf4, 0x23, 0xec, 0xb0, 0x47, 0x37, 0x8c, 0x5c, 0xaf, 0x4f, 0x68, 0x10, 0x0c, 0x5c, 0x3b, 0xf1,
+	0x45, 0x7c, 0x6c, 0x19, 0xbc, 0x95, 0x86, 0xb5, 0x49, 0xd4, 0xd4, 0x2b, 0x7f, 0x2a, 0x50, 0x7a,
+	0x69, 0x32, 0xe8, 0x7b, 0xc8, 0x06, 0x8c, 0xbb, 0xbe, 0x93, 0x18, 0x68, 0x7f, 0xfe, 0x20, 0xcf,
+	0x24, 0x07, 0x27, 0x5c, 0xf4, 0x7f, 0x58, 0x1b, 0xd2, 0x47, 0x92, 0x4e, 0x35, 0x7e, 0xc0, 0xd6,
+	0xf0, 0xea, 0x90, 0x3e, 0xa6, 0xa9, 0x21, 0xfa, 0x09, 0x0a, 0x9c, 0x45, 0x7c, 0x44, 0x68, 0x2f,
+	0x62, 0xfc, 0xeb, 0x6f, 0x09, 0x48, 0xb6, 0x26, 0xc8, 0x07, 0xfb, 0xb0, 0x82, 0xaf, 0x2e, 0x5d,
+	0xcf, 0xf1, 0x1f, 0x50, 0x0e, 0x32, 0xf8, 0xea, 0x83, 0xba, 0x10, 0xff, 0x69, 0xa8, 0xca, 0xc1,
+	0xdf, 0x0a, 0xe4, 0xc7, 0x1e, 0x47, 0x5b, 0xb0, 0x71, 0xde, 0xee, 0x9c, 0x19, 0x4d, 0xf3, 0xc8,
+	0x34, 0x74, 0x62, 0x60, 0x6c, 0x61, 0x75, 0x01, 0x21, 0x28, 0xea, 0x96, 0xd1, 0x21, 0x6d, 0xab,
+	0x4b, 0x8c, 0x2b, 0xb3, 0xd3, 0x55, 0x15, 0x81, 0x69, 0x2d, 0x6c, 0x68, 0xfa, 0xe7, 0x18, 0xea,
+	0xa8, 0x8b, 0x22, 0x5d, 0x3f, 0x3f, 0x6b, 0x99, 0x4d, 0xad, 0x6b, 0x10, 0xdd, 0xb8, 0x20, 0xc6,
+	0xb9, 0xa9, 0x66, 0xd0, 0x5b, 0xd8, 0x6b, 0x1b, 0xdd, 0x4b, 0x0b, 0x9f, 0x90, 0x8e, 0x81, 0x2f,
+	0x0c, 0x4c, 0xce, 0xdb, 0xda, 0x85, 0x66, 0xb6, 0xb4, 0xc3, 0x96, 0xa1, 0x2e, 0x89, 0xb4, 0x33,
+	0xed, 0x73, 0xcb, 0xd2, 0x74, 0xd2, 0xb5, 0x2c, 0xd2, 0xd2, 0xf0, 0xb1, 0xa1, 0x2e, 0xa3, 0x75,
+	0x28, 0x34, 0x2d, 0xdd, 0x68, 0x26, 0x6d, 0x64, 0xd1, 0x2e, 0x6c, 0xe9, 0xd6, 0x65, 0xbb, 0x65,
+	0xb6, 0x4f, 0x08, 0x16, 0x25, 0x5a, 0xe6, 0xa9, 0xd9, 0x35, 0x74, 0x35, 0x87, 0xb6, 0x01, 0x75,
+	0x0d, 0x7c, 0x1a, 0xb7, 0xa8, 0x35, 0x9b, 0xc6, 0x99, 0xc0, 0x57, 0x0e, 0x22, 0x78, 0x35, 0xcf,
+	0x08, 0xa2, 0xa5, 0xa6, 0xd5, 0x3e, 0x6a, 0x99, 0xcd, 0x2e, 0xe9, 0x68, 0xa7, 0x06, 0xb1, 0xf0,
+	0xb1, 0xd6, 0x36, 0x7f, 0xd6, 0xba, 0xa6, 0xd5, 0x56, 0x17, 0xd0, 0x3b, 0x78, 0x3d, 0x8e, 0x5b,
+	0xdd, 0x8f, 0x06, 0x9e, 0x26, 0x28, 0xe8, 0x35, 0xec, 0x8c, 0x09, 0xd3, 0x9b, 0x53, 0x17, 0x0f,
+	0x0e, 0x61, 0xe7, 0x85, 0xb1, 0xa3, 0x4d, 0x58, 0x9f, 0xb4, 0x4e, 0x3e, 0x5a, 0xe7, 0xc9, 0xf9,
+	0x3e, 0x01, 0x75, 0xed, 0xb3, 0xaa, 0x5c, 0x67, 0xe5, 0x54, 0xbf, 0xfb, 0x77, 0x00, 0x8e, 0xc8,
+	0xea, 0x30, 0x62, 0x0b, 0x00, 0x00,
 }
@@ -7,6 +7,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import common "github.com/brocaar/loraserver/api/common"
+import duration "github.com/golang/protobuf/ptypes/duration"
 import empty "github.com/golang/protobuf/ptypes/empty"
 import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
@@ -27,6 +28,66 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type DeviceEmbedView int32
+
+const (
+	// Device details, location track and link statistics.
+	DeviceEmbedView_DEVICE_DETAIL DeviceEmbedView = 0
+	// Live frame-logs.
+	DeviceEmbedView_LIVE_FRAMES DeviceEmbedView = 1
+)
+
+var DeviceEmbedView_name = map[int32]string{
+	0: "DEVICE_DETAIL",
+	1: "LIVE_FRAMES",
+}
+var DeviceEmbedView_value = map[string]int32{
+	"DEVICE_DETAIL": 0,
+	"LIVE_FRAMES":   1,
+}
+
+func (x DeviceEmbedView) String() string {
+	return proto.EnumName(DeviceEmbedView_name, int32(x))
+}
+func (DeviceEmbedView) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{0}
+}
+
+type DeviceLifecycleState int32
+
+const (
+	// The device has been provisioned, but has not been seen yet.
+	// It becomes active on its first uplink.
+	DeviceLifecycleState_PROVISIONED DeviceLifecycleState = 0
+	// The device is active.
+	DeviceLifecycleState_ACTIVE DeviceLifecycleState = 1
+	// Uplinks of the device are recorded, but not published to the
+	// integrations. Downlinks are blocked.
+	DeviceLifecycleState_SUSPENDED DeviceLifecycleState = 2
+	// The device is retired and read-only. This is a terminal state.
+	DeviceLifecycleState_RETIRED DeviceLifecycleState = 3
+)
+
+var DeviceLifecycleState_name = map[int32]string{
+	0: "PROVISIONED",
+	1: "ACTIVE",
+	2: "SUSPENDED",
+	3: "RETIRED",
+}
+var DeviceLifecycleState_value = map[string]int32{
+	"PROVISIONED": 0,
+	"ACTIVE":      1,
+	"SUSPENDED":   2,
+	"RETIRED":     3,
+}
+
+func (x DeviceLifecycleState) String() string {
+	return proto.EnumName(DeviceLifecycleState_name, int32(x))
+}
+func (DeviceLifecycleState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{1}
+}
+
 type Device struct {
 	// Device EUI (HEX encoded).
 	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
@@ -54,7 +115,7 @@ func (m *Device) Reset()         { *m = Device{} }
 func (m *Device) String() string { return proto.CompactTextString(m) }
 func (*Device) ProtoMessage()    {}
 func (*Device) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{0}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{0}
 }
 func (m *Device) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Device.Unmarshal(m, b)
@@ -154,7 +215,9 @@ type DeviceListItem struct {
 	DeviceStatusBatteryLevel float32 `protobuf:"fixed32,12,opt,name=device_status_battery_level,json=deviceStatusBatteryLevel,proto3" json:"device_status_battery_level,omitempty"`
 	// The last time the application-server received any data from the device,
 	// or an empty string when the device never sent any data.
-	LastSeenAt           *timestamp.Timestamp `protobuf:"bytes,9,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	LastSeenAt *timestamp.Timestamp `protobuf:"bytes,9,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// Lifecycle state of the device.
+	LifecycleState       DeviceLifecycleState `protobuf:"varint,13,opt,name=lifecycle_state,json=lifecycleState,proto3,enum=api.DeviceLifecycleState" json:"lifecycle_state,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -164,7 +227,7 @@ func (m *DeviceListItem) Reset()         { *m = DeviceListItem{} }
 func (m *DeviceListItem) String() string { return proto.CompactTextString(m) }
 func (*DeviceListItem) ProtoMessage()    {}
 func (*DeviceListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{1}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{1}
 }
 func (m *DeviceListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeviceListItem.Unmarshal(m, b)
@@ -268,6 +331,13 @@ func (m *DeviceListItem) GetLastSeenAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *DeviceListItem) GetLifecycleState() DeviceLifecycleState {
+	if m != nil {
+		return m.LifecycleState
+	}
+	return DeviceLifecycleState_PROVISIONED
+}
+
 type DeviceKeys struct {
 	// Device EUI (HEX encoded).
 	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
@@ -286,7 +356,7 @@ func (m *DeviceKeys) Reset()         { *m = DeviceKeys{} }
 func (m *DeviceKeys) String() string { return proto.CompactTextString(m) }
 func (*DeviceKeys) ProtoMessage()    {}
 func (*DeviceKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{2}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{2}
 }
 func (m *DeviceKeys) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeviceKeys.Unmarshal(m, b)
@@ -339,7 +409,7 @@ func (m *CreateDeviceRequest) Reset()         { *m = CreateDeviceRequest{} }
 func (m *CreateDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateDeviceRequest) ProtoMessage()    {}
 func (*CreateDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{3}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{3}
 }
 func (m *CreateDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDeviceRequest.Unmarshal(m, b)
@@ -378,7 +448,7 @@ func (m *GetDeviceRequest) Reset()         { *m = GetDeviceRequest{} }
 func (m *GetDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceRequest) ProtoMessage()    {}
 func (*GetDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{4}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{4}
 }
 func (m *GetDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceRequest.Unmarshal(m, b)
@@ -423,17 +493,19 @@ type GetDeviceResponse struct {
 	// Device location.
 	// This will set when the network-server was able to resolve the location
 	// using the geolocation-server.
-	Location             *common.Location `protobuf:"bytes,21,opt,name=location,proto3" json:"location,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Location *common.Location `protobuf:"bytes,21,opt,name=location,proto3" json:"location,omitempty"`
+	// Lifecycle state of the device.
+	LifecycleState       DeviceLifecycleState `protobuf:"varint,22,opt,name=lifecycle_state,json=lifecycleState,proto3,enum=api.DeviceLifecycleState" json:"lifecycle_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *GetDeviceResponse) Reset()         { *m = GetDeviceResponse{} }
 func (m *GetDeviceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceResponse) ProtoMessage()    {}
 func (*GetDeviceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{5}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{5}
 }
 func (m *GetDeviceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceResponse.Unmarshal(m, b)
@@ -488,6 +560,13 @@ func (m *GetDeviceResponse) GetLocation() *common.Location {
 	return nil
 }
 
+func (m *GetDeviceResponse) GetLifecycleState() DeviceLifecycleState {
+	if m != nil {
+		return m.LifecycleState
+	}
+	return DeviceLifecycleState_PROVISIONED
+}
+
 type ListDeviceRequest struct {
 	// Max number of devices to return in the result-set.
 	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -500,17 +579,23 @@ type ListDeviceRequest struct {
 	// Multicast-group ID to filter on (string formatted UUID).
 	MulticastGroupId string `protobuf:"bytes,5,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
 	// Service-profile ID to filter on (string formatted UUID).
-	ServiceProfileId     string   `protobuf:"bytes,6,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ServiceProfileId string `protobuf:"bytes,6,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
+	// Saved device filter ID to filter on.
+	// When application_id or search is set as well, it takes precedence
+	// over the value stored in the device filter.
+	DeviceFilterId int64 `protobuf:"varint,7,opt,name=device_filter_id,json=deviceFilterID,proto3" json:"device_filter_id,omitempty"`
+	// Lifecycle states to filter on.
+	LifecycleStates      []DeviceLifecycleState `protobuf:"varint,8,rep,packed,name=lifecycle_states,json=lifecycleStates,proto3,enum=api.DeviceLifecycleState" json:"lifecycle_states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *ListDeviceRequest) Reset()         { *m = ListDeviceRequest{} }
 func (m *ListDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDeviceRequest) ProtoMessage()    {}
 func (*ListDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{6}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{6}
 }
 func (m *ListDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListDeviceRequest.Unmarshal(m, b)
@@ -572,6 +657,20 @@ func (m *ListDeviceRequest) GetServiceProfileId() string {
 	return ""
 }
 
+func (m *ListDeviceRequest) GetDeviceFilterId() int64 {
+	if m != nil {
+		return m.DeviceFilterId
+	}
+	return 0
+}
+
+func (m *ListDeviceRequest) GetLifecycleStates() []DeviceLifecycleState {
+	if m != nil {
+		return m.LifecycleStates
+	}
+	return nil
+}
+
 type ListDeviceResponse struct {
 	// Total number of devices available within the result-set.
 	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
@@ -586,7 +685,7 @@ func (m *ListDeviceResponse) Reset()         { *m = ListDeviceResponse{} }
 func (m *ListDeviceResponse) String() string { return proto.CompactTextString(m) }
 func (*ListDeviceResponse) ProtoMessage()    {}
 func (*ListDeviceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{7}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{7}
 }
 func (m *ListDeviceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListDeviceResponse.Unmarshal(m, b)
@@ -632,7 +731,7 @@ func (m *DeleteDeviceRequest) Reset()         { *m = DeleteDeviceRequest{} }
 func (m *DeleteDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteDeviceRequest) ProtoMessage()    {}
 func (*DeleteDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{8}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{8}
 }
 func (m *DeleteDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteDeviceRequest.Unmarshal(m, b)
@@ -671,7 +770,7 @@ func (m *UpdateDeviceRequest) Reset()         { *m = UpdateDeviceRequest{} }
 func (m *UpdateDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateDeviceRequest) ProtoMessage()    {}
 func (*UpdateDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{9}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{9}
 }
 func (m *UpdateDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDeviceRequest.Unmarshal(m, b)
@@ -698,6 +797,54 @@ func (m *UpdateDeviceRequest) GetDevice() *Device {
 	return nil
 }
 
+type UpdateDeviceLifecycleStateRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Lifecycle state to transition to.
+	LifecycleState       DeviceLifecycleState `protobuf:"varint,2,opt,name=lifecycle_state,json=lifecycleState,proto3,enum=api.DeviceLifecycleState" json:"lifecycle_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UpdateDeviceLifecycleStateRequest) Reset()         { *m = UpdateDeviceLifecycleStateRequest{} }
+func (m *UpdateDeviceLifecycleStateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceLifecycleStateRequest) ProtoMessage()    {}
+func (*UpdateDeviceLifecycleStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{10}
+}
+func (m *UpdateDeviceLifecycleStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDeviceLifecycleStateRequest.Unmarshal(m, b)
+}
+func (m *UpdateDeviceLifecycleStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDeviceLifecycleStateRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateDeviceLifecycleStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeviceLifecycleStateRequest.Merge(dst, src)
+}
+func (m *UpdateDeviceLifecycleStateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateDeviceLifecycleStateRequest.Size(m)
+}
+func (m *UpdateDeviceLifecycleStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeviceLifecycleStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDeviceLifecycleStateRequest proto.InternalMessageInfo
+
+func (m *UpdateDeviceLifecycleStateRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *UpdateDeviceLifecycleStateRequest) GetLifecycleState() DeviceLifecycleState {
+	if m != nil {
+		return m.LifecycleState
+	}
+	return DeviceLifecycleState_PROVISIONED
+}
+
 type CreateDeviceKeysRequest struct {
 	// Device-keys object to create.
 	DeviceKeys           *DeviceKeys `protobuf:"bytes,1,opt,name=device_keys,json=deviceKeys,proto3" json:"device_keys,omitempty"`
@@ -710,7 +857,7 @@ func (m *CreateDeviceKeysRequest) Reset()         { *m = CreateDeviceKeysRequest
 func (m *CreateDeviceKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateDeviceKeysRequest) ProtoMessage()    {}
 func (*CreateDeviceKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{10}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{11}
 }
 func (m *CreateDeviceKeysRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDeviceKeysRequest.Unmarshal(m, b)
@@ -749,7 +896,7 @@ func (m *GetDeviceKeysRequest) Reset()         { *m = GetDeviceKeysRequest{} }
 func (m *GetDeviceKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceKeysRequest) ProtoMessage()    {}
 func (*GetDeviceKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{11}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{12}
 }
 func (m *GetDeviceKeysRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceKeysRequest.Unmarshal(m, b)
@@ -788,7 +935,7 @@ func (m *GetDeviceKeysResponse) Reset()         { *m = GetDeviceKeysResponse{} }
 func (m *GetDeviceKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceKeysResponse) ProtoMessage()    {}
 func (*GetDeviceKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{12}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{13}
 }
 func (m *GetDeviceKeysResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceKeysResponse.Unmarshal(m, b)
@@ -827,7 +974,7 @@ func (m *UpdateDeviceKeysRequest) Reset()         { *m = UpdateDeviceKeysRequest
 func (m *UpdateDeviceKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateDeviceKeysRequest) ProtoMessage()    {}
 func (*UpdateDeviceKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{13}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{14}
 }
 func (m *UpdateDeviceKeysRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDeviceKeysRequest.Unmarshal(m, b)
@@ -866,7 +1013,7 @@ func (m *DeleteDeviceKeysRequest) Reset()         { *m = DeleteDeviceKeysRequest
 func (m *DeleteDeviceKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteDeviceKeysRequest) ProtoMessage()    {}
 func (*DeleteDeviceKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{14}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{15}
 }
 func (m *DeleteDeviceKeysRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteDeviceKeysRequest.Unmarshal(m, b)
@@ -921,7 +1068,7 @@ func (m *DeviceActivation) Reset()         { *m = DeviceActivation{} }
 func (m *DeviceActivation) String() string { return proto.CompactTextString(m) }
 func (*DeviceActivation) ProtoMessage()    {}
 func (*DeviceActivation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{15}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{16}
 }
 func (m *DeviceActivation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeviceActivation.Unmarshal(m, b)
@@ -1015,7 +1162,7 @@ func (m *ActivateDeviceRequest) Reset()         { *m = ActivateDeviceRequest{} }
 func (m *ActivateDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateDeviceRequest) ProtoMessage()    {}
 func (*ActivateDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{16}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{17}
 }
 func (m *ActivateDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ActivateDeviceRequest.Unmarshal(m, b)
@@ -1054,7 +1201,7 @@ func (m *DeactivateDeviceRequest) Reset()         { *m = DeactivateDeviceRequest
 func (m *DeactivateDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*DeactivateDeviceRequest) ProtoMessage()    {}
 func (*DeactivateDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{17}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{18}
 }
 func (m *DeactivateDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeactivateDeviceRequest.Unmarshal(m, b)
@@ -1093,7 +1240,7 @@ func (m *GetDeviceActivationRequest) Reset()         { *m = GetDeviceActivationR
 func (m *GetDeviceActivationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceActivationRequest) ProtoMessage()    {}
 func (*GetDeviceActivationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{18}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{19}
 }
 func (m *GetDeviceActivationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceActivationRequest.Unmarshal(m, b)
@@ -1132,7 +1279,7 @@ func (m *GetDeviceActivationResponse) Reset()         { *m = GetDeviceActivation
 func (m *GetDeviceActivationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceActivationResponse) ProtoMessage()    {}
 func (*GetDeviceActivationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{19}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{20}
 }
 func (m *GetDeviceActivationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceActivationResponse.Unmarshal(m, b)
@@ -1159,6 +1306,346 @@ func (m *GetDeviceActivationResponse) GetDeviceActivation() *DeviceActivation {
 	return nil
 }
 
+type DeviceActivationContext struct {
+	// Device-activation object.
+	DeviceActivation *DeviceActivation `protobuf:"bytes,1,opt,name=device_activation,json=deviceActivation,proto3" json:"device_activation,omitempty"`
+	// Join-nonce of the device-keys (OTAA only).
+	JoinNonce uint32 `protobuf:"varint,2,opt,name=join_nonce,json=joinNonce,proto3" json:"join_nonce,omitempty"`
+	// Timestamp of the export.
+	ExportedAt           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeviceActivationContext) Reset()         { *m = DeviceActivationContext{} }
+func (m *DeviceActivationContext) String() string { return proto.CompactTextString(m) }
+func (*DeviceActivationContext) ProtoMessage()    {}
+func (*DeviceActivationContext) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{21}
+}
+func (m *DeviceActivationContext) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceActivationContext.Unmarshal(m, b)
+}
+func (m *DeviceActivationContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceActivationContext.Marshal(b, m, deterministic)
+}
+func (dst *DeviceActivationContext) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceActivationContext.Merge(dst, src)
+}
+func (m *DeviceActivationContext) XXX_Size() int {
+	return xxx_messageInfo_DeviceActivationContext.Size(m)
+}
+func (m *DeviceActivationContext) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceActivationContext.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceActivationContext proto.InternalMessageInfo
+
+func (m *DeviceActivationContext) GetDeviceActivation() *DeviceActivation {
+	if m != nil {
+		return m.DeviceActivation
+	}
+	return nil
+}
+
+func (m *DeviceActivationContext) GetJoinNonce() uint32 {
+	if m != nil {
+		return m.JoinNonce
+	}
+	return 0
+}
+
+func (m *DeviceActivationContext) GetExportedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExportedAt
+	}
+	return nil
+}
+
+type ExportDeviceActivationRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportDeviceActivationRequest) Reset()         { *m = ExportDeviceActivationRequest{} }
+func (m *ExportDeviceActivationRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportDeviceActivationRequest) ProtoMessage()    {}
+func (*ExportDeviceActivationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{22}
+}
+func (m *ExportDeviceActivationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportDeviceActivationRequest.Unmarshal(m, b)
+}
+func (m *ExportDeviceActivationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportDeviceActivationRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportDeviceActivationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDeviceActivationRequest.Merge(dst, src)
+}
+func (m *ExportDeviceActivationRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportDeviceActivationRequest.Size(m)
+}
+func (m *ExportDeviceActivationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDeviceActivationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportDeviceActivationRequest proto.InternalMessageInfo
+
+func (m *ExportDeviceActivationRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type ExportDeviceActivationResponse struct {
+	// Activation context.
+	ActivationContext    *DeviceActivationContext `protobuf:"bytes,1,opt,name=activation_context,json=activationContext,proto3" json:"activation_context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExportDeviceActivationResponse) Reset()         { *m = ExportDeviceActivationResponse{} }
+func (m *ExportDeviceActivationResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportDeviceActivationResponse) ProtoMessage()    {}
+func (*ExportDeviceActivationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{23}
+}
+func (m *ExportDeviceActivationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportDeviceActivationResponse.Unmarshal(m, b)
+}
+func (m *ExportDeviceActivationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportDeviceActivationResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportDeviceActivationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDeviceActivationResponse.Merge(dst, src)
+}
+func (m *ExportDeviceActivationResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportDeviceActivationResponse.Size(m)
+}
+func (m *ExportDeviceActivationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDeviceActivationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportDeviceActivationResponse proto.InternalMessageInfo
+
+func (m *ExportDeviceActivationResponse) GetActivationContext() *DeviceActivationContext {
+	if m != nil {
+		return m.ActivationContext
+	}
+	return nil
+}
+
+type ImportDeviceActivationRequest struct {
+	// Activation context.
+	ActivationContext    *DeviceActivationContext `protobuf:"bytes,1,opt,name=activation_context,json=activationContext,proto3" json:"activation_context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ImportDeviceActivationRequest) Reset()         { *m = ImportDeviceActivationRequest{} }
+func (m *ImportDeviceActivationRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportDeviceActivationRequest) ProtoMessage()    {}
+func (*ImportDeviceActivationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{24}
+}
+func (m *ImportDeviceActivationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportDeviceActivationRequest.Unmarshal(m, b)
+}
+func (m *ImportDeviceActivationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportDeviceActivationRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportDeviceActivationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportDeviceActivationRequest.Merge(dst, src)
+}
+func (m *ImportDeviceActivationRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportDeviceActivationRequest.Size(m)
+}
+func (m *ImportDeviceActivationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportDeviceActivationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportDeviceActivationRequest proto.InternalMessageInfo
+
+func (m *ImportDeviceActivationRequest) GetActivationContext() *DeviceActivationContext {
+	if m != nil {
+		return m.ActivationContext
+	}
+	return nil
+}
+
+type ImportDevicesRequest struct {
+	// ID of the application to which the devices must be added.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Device-profile ID of the devices for which the device_profile_id
+	// column is not set (optional).
+	DeviceProfileId string `protobuf:"bytes,2,opt,name=device_profile_id,json=deviceProfileID,proto3" json:"device_profile_id,omitempty"`
+	// CSV document (max. 10000 rows). The first row must contain the column
+	// names. The dev_eui column is required, the name, description,
+	// device_profile_id and app_key columns are optional.
+	// Note: The app_key is the LoRaWAN 1.0.x AppKey (stored as network root key).
+	Csv                  string   `protobuf:"bytes,3,opt,name=csv,proto3" json:"csv,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportDevicesRequest) Reset()         { *m = ImportDevicesRequest{} }
+func (m *ImportDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportDevicesRequest) ProtoMessage()    {}
+func (*ImportDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{25}
+}
+func (m *ImportDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportDevicesRequest.Unmarshal(m, b)
+}
+func (m *ImportDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportDevicesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportDevicesRequest.Merge(dst, src)
+}
+func (m *ImportDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportDevicesRequest.Size(m)
+}
+func (m *ImportDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportDevicesRequest proto.InternalMessageInfo
+
+func (m *ImportDevicesRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ImportDevicesRequest) GetDeviceProfileId() string {
+	if m != nil {
+		return m.DeviceProfileId
+	}
+	return ""
+}
+
+func (m *ImportDevicesRequest) GetCsv() string {
+	if m != nil {
+		return m.Csv
+	}
+	return ""
+}
+
+type ImportDevicesResponse struct {
+	// Number of created devices.
+	CreatedCount uint32 `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	// Rows which could not be imported.
+	Errors               []*ImportDeviceError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ImportDevicesResponse) Reset()         { *m = ImportDevicesResponse{} }
+func (m *ImportDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportDevicesResponse) ProtoMessage()    {}
+func (*ImportDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{26}
+}
+func (m *ImportDevicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportDevicesResponse.Unmarshal(m, b)
+}
+func (m *ImportDevicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportDevicesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImportDevicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportDevicesResponse.Merge(dst, src)
+}
+func (m *ImportDevicesResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportDevicesResponse.Size(m)
+}
+func (m *ImportDevicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportDevicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportDevicesResponse proto.InternalMessageInfo
+
+func (m *ImportDevicesResponse) GetCreatedCount() uint32 {
+	if m != nil {
+		return m.CreatedCount
+	}
+	return 0
+}
+
+func (m *ImportDevicesResponse) GetErrors() []*ImportDeviceError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type ImportDeviceError struct {
+	// Row number within the CSV document (the header being row 1).
+	Row uint32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	// Device EUI (as given in the CSV document).
+	DevEui string `protobuf:"bytes,2,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Error description.
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportDeviceError) Reset()         { *m = ImportDeviceError{} }
+func (m *ImportDeviceError) String() string { return proto.CompactTextString(m) }
+func (*ImportDeviceError) ProtoMessage()    {}
+func (*ImportDeviceError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{27}
+}
+func (m *ImportDeviceError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportDeviceError.Unmarshal(m, b)
+}
+func (m *ImportDeviceError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportDeviceError.Marshal(b, m, deterministic)
+}
+func (dst *ImportDeviceError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportDeviceError.Merge(dst, src)
+}
+func (m *ImportDeviceError) XXX_Size() int {
+	return xxx_messageInfo_ImportDeviceError.Size(m)
+}
+func (m *ImportDeviceError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportDeviceError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportDeviceError proto.InternalMessageInfo
+
+func (m *ImportDeviceError) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportDeviceError) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *ImportDeviceError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type GetRandomDevAddrRequest struct {
 	// Device EUI (HEX encoded).
 	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
@@ -1171,7 +1658,7 @@ func (m *GetRandomDevAddrRequest) Reset()         { *m = GetRandomDevAddrRequest
 func (m *GetRandomDevAddrRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRandomDevAddrRequest) ProtoMessage()    {}
 func (*GetRandomDevAddrRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{20}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{28}
 }
 func (m *GetRandomDevAddrRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRandomDevAddrRequest.Unmarshal(m, b)
@@ -1210,7 +1697,7 @@ func (m *GetRandomDevAddrResponse) Reset()         { *m = GetRandomDevAddrRespon
 func (m *GetRandomDevAddrResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRandomDevAddrResponse) ProtoMessage()    {}
 func (*GetRandomDevAddrResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{21}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{29}
 }
 func (m *GetRandomDevAddrResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRandomDevAddrResponse.Unmarshal(m, b)
@@ -1249,7 +1736,7 @@ func (m *StreamDeviceFrameLogsRequest) Reset()         { *m = StreamDeviceFrameL
 func (m *StreamDeviceFrameLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamDeviceFrameLogsRequest) ProtoMessage()    {}
 func (*StreamDeviceFrameLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{22}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{30}
 }
 func (m *StreamDeviceFrameLogsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamDeviceFrameLogsRequest.Unmarshal(m, b)
@@ -1290,7 +1777,7 @@ func (m *StreamDeviceFrameLogsResponse) Reset()         { *m = StreamDeviceFrame
 func (m *StreamDeviceFrameLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamDeviceFrameLogsResponse) ProtoMessage()    {}
 func (*StreamDeviceFrameLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{23}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{31}
 }
 func (m *StreamDeviceFrameLogsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamDeviceFrameLogsResponse.Unmarshal(m, b)
@@ -1433,7 +1920,7 @@ func (m *StreamDeviceEventLogsRequest) Reset()         { *m = StreamDeviceEventL
 func (m *StreamDeviceEventLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamDeviceEventLogsRequest) ProtoMessage()    {}
 func (*StreamDeviceEventLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{24}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{32}
 }
 func (m *StreamDeviceEventLogsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamDeviceEventLogsRequest.Unmarshal(m, b)
@@ -1474,7 +1961,7 @@ func (m *StreamDeviceEventLogsResponse) Reset()         { *m = StreamDeviceEvent
 func (m *StreamDeviceEventLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamDeviceEventLogsResponse) ProtoMessage()    {}
 func (*StreamDeviceEventLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_89a06033700147e8, []int{25}
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{33}
 }
 func (m *StreamDeviceEventLogsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamDeviceEventLogsResponse.Unmarshal(m, b)
@@ -1508,33 +1995,554 @@ func (m *StreamDeviceEventLogsResponse) GetPayloadJson() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Device)(nil), "api.Device")
-	proto.RegisterType((*DeviceListItem)(nil), "api.DeviceListItem")
-	proto.RegisterType((*DeviceKeys)(nil), "api.DeviceKeys")
-	proto.RegisterType((*CreateDeviceRequest)(nil), "api.CreateDeviceRequest")
-	proto.RegisterType((*GetDeviceRequest)(nil), "api.GetDeviceRequest")
-	proto.RegisterType((*GetDeviceResponse)(nil), "api.GetDeviceResponse")
-	proto.RegisterType((*ListDeviceRequest)(nil), "api.ListDeviceRequest")
-	proto.RegisterType((*ListDeviceResponse)(nil), "api.ListDeviceResponse")
-	proto.RegisterType((*DeleteDeviceRequest)(nil), "api.DeleteDeviceRequest")
-	proto.RegisterType((*UpdateDeviceRequest)(nil), "api.UpdateDeviceRequest")
-	proto.RegisterType((*CreateDeviceKeysRequest)(nil), "api.CreateDeviceKeysRequest")
-	proto.RegisterType((*GetDeviceKeysRequest)(nil), "api.GetDeviceKeysRequest")
-	proto.RegisterType((*GetDeviceKeysResponse)(nil), "api.GetDeviceKeysResponse")
-	proto.RegisterType((*UpdateDeviceKeysRequest)(nil), "api.UpdateDeviceKeysRequest")
-	proto.RegisterType((*DeleteDeviceKeysRequest)(nil), "api.DeleteDeviceKeysRequest")
-	proto.RegisterType((*DeviceActivation)(nil), "api.DeviceActivation")
-	proto.RegisterType((*ActivateDeviceRequest)(nil), "api.ActivateDeviceRequest")
-	proto.RegisterType((*DeactivateDeviceRequest)(nil), "api.DeactivateDeviceRequest")
-	proto.RegisterType((*GetDeviceActivationRequest)(nil), "api.GetDeviceActivationRequest")
-	proto.RegisterType((*GetDeviceActivationResponse)(nil), "api.GetDeviceActivationResponse")
-	proto.RegisterType((*GetRandomDevAddrRequest)(nil), "api.GetRandomDevAddrRequest")
-	proto.RegisterType((*GetRandomDevAddrResponse)(nil), "api.GetRandomDevAddrResponse")
-	proto.RegisterType((*StreamDeviceFrameLogsRequest)(nil), "api.StreamDeviceFrameLogsRequest")
-	proto.RegisterType((*StreamDeviceFrameLogsResponse)(nil), "api.StreamDeviceFrameLogsResponse")
-	proto.RegisterType((*StreamDeviceEventLogsRequest)(nil), "api.StreamDeviceEventLogsRequest")
+type GetDeviceLocationTrackRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Start timestamp (inclusive).
+	// When not set, the track starts at the first known location.
+	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// End timestamp (exclusive).
+	// When not set, the track ends at the last known location.
+	EndTimestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// Simplification tolerance (in meters).
+	// When set, points deviating less than the given distance from the
+	// simplified track are removed (Ramer-Douglas-Peucker).
+	Tolerance float64 `protobuf:"fixed64,4,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	// Max number of points to return.
+	// When set, the (simplified) track is evenly sampled down to the given
+	// number of points.
+	MaxPoints            uint32   `protobuf:"varint,5,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceLocationTrackRequest) Reset()         { *m = GetDeviceLocationTrackRequest{} }
+func (m *GetDeviceLocationTrackRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceLocationTrackRequest) ProtoMessage()    {}
+func (*GetDeviceLocationTrackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{34}
+}
+func (m *GetDeviceLocationTrackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceLocationTrackRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceLocationTrackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceLocationTrackRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceLocationTrackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceLocationTrackRequest.Merge(dst, src)
+}
+func (m *GetDeviceLocationTrackRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceLocationTrackRequest.Size(m)
+}
+func (m *GetDeviceLocationTrackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceLocationTrackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceLocationTrackRequest proto.InternalMessageInfo
+
+func (m *GetDeviceLocationTrackRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *GetDeviceLocationTrackRequest) GetStartTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *GetDeviceLocationTrackRequest) GetEndTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return nil
+}
+
+func (m *GetDeviceLocationTrackRequest) GetTolerance() float64 {
+	if m != nil {
+		return m.Tolerance
+	}
+	return 0
+}
+
+func (m *GetDeviceLocationTrackRequest) GetMaxPoints() uint32 {
+	if m != nil {
+		return m.MaxPoints
+	}
+	return 0
+}
+
+type DeviceLocationTrackPoint struct {
+	// Timestamp of the location.
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Location source.
+	Source common.LocationSource `protobuf:"varint,2,opt,name=source,proto3,enum=common.LocationSource" json:"source,omitempty"`
+	// Latitude.
+	Latitude float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude.
+	Longitude float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Altitude.
+	Altitude             float64  `protobuf:"fixed64,5,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceLocationTrackPoint) Reset()         { *m = DeviceLocationTrackPoint{} }
+func (m *DeviceLocationTrackPoint) String() string { return proto.CompactTextString(m) }
+func (*DeviceLocationTrackPoint) ProtoMessage()    {}
+func (*DeviceLocationTrackPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{35}
+}
+func (m *DeviceLocationTrackPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceLocationTrackPoint.Unmarshal(m, b)
+}
+func (m *DeviceLocationTrackPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceLocationTrackPoint.Marshal(b, m, deterministic)
+}
+func (dst *DeviceLocationTrackPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceLocationTrackPoint.Merge(dst, src)
+}
+func (m *DeviceLocationTrackPoint) XXX_Size() int {
+	return xxx_messageInfo_DeviceLocationTrackPoint.Size(m)
+}
+func (m *DeviceLocationTrackPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceLocationTrackPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceLocationTrackPoint proto.InternalMessageInfo
+
+func (m *DeviceLocationTrackPoint) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *DeviceLocationTrackPoint) GetSource() common.LocationSource {
+	if m != nil {
+		return m.Source
+	}
+	return common.LocationSource_UNKNOWN
+}
+
+func (m *DeviceLocationTrackPoint) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *DeviceLocationTrackPoint) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *DeviceLocationTrackPoint) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+type GetDeviceLocationTrackResponse struct {
+	// Track points (oldest first).
+	Points []*DeviceLocationTrackPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	// The track as GeoJSON Feature with a LineString geometry.
+	GeoJson              string   `protobuf:"bytes,2,opt,name=geo_json,json=geoJSON,proto3" json:"geo_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceLocationTrackResponse) Reset()         { *m = GetDeviceLocationTrackResponse{} }
+func (m *GetDeviceLocationTrackResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceLocationTrackResponse) ProtoMessage()    {}
+func (*GetDeviceLocationTrackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{36}
+}
+func (m *GetDeviceLocationTrackResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceLocationTrackResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceLocationTrackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceLocationTrackResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceLocationTrackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceLocationTrackResponse.Merge(dst, src)
+}
+func (m *GetDeviceLocationTrackResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceLocationTrackResponse.Size(m)
+}
+func (m *GetDeviceLocationTrackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceLocationTrackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceLocationTrackResponse proto.InternalMessageInfo
+
+func (m *GetDeviceLocationTrackResponse) GetPoints() []*DeviceLocationTrackPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+func (m *GetDeviceLocationTrackResponse) GetGeoJson() string {
+	if m != nil {
+		return m.GeoJson
+	}
+	return ""
+}
+
+type GetDeviceLinkStatsRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Start timestamp (inclusive, rounded down to the day in UTC).
+	// When not set, this defaults to 7 days before the end timestamp.
+	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// End timestamp (inclusive, rounded down to the day in UTC).
+	// When not set, this defaults to the current time.
+	EndTimestamp         *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetDeviceLinkStatsRequest) Reset()         { *m = GetDeviceLinkStatsRequest{} }
+func (m *GetDeviceLinkStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceLinkStatsRequest) ProtoMessage()    {}
+func (*GetDeviceLinkStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{37}
+}
+func (m *GetDeviceLinkStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceLinkStatsRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceLinkStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceLinkStatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceLinkStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceLinkStatsRequest.Merge(dst, src)
+}
+func (m *GetDeviceLinkStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceLinkStatsRequest.Size(m)
+}
+func (m *GetDeviceLinkStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceLinkStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceLinkStatsRequest proto.InternalMessageInfo
+
+func (m *GetDeviceLinkStatsRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *GetDeviceLinkStatsRequest) GetStartTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *GetDeviceLinkStatsRequest) GetEndTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return nil
+}
+
+type DeviceLinkStats struct {
+	// Date (UTC, YYYY-MM-DD).
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Number of received uplinks.
+	Uplinks uint32 `protobuf:"varint,2,opt,name=uplinks,proto3" json:"uplinks,omitempty"`
+	// Estimated number of lost uplinks.
+	Lost uint32 `protobuf:"varint,3,opt,name=lost,proto3" json:"lost,omitempty"`
+	// Estimated packet-loss (percentage).
+	PacketLoss           float64  `protobuf:"fixed64,4,opt,name=packet_loss,json=packetLoss,proto3" json:"packet_loss,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceLinkStats) Reset()         { *m = DeviceLinkStats{} }
+func (m *DeviceLinkStats) String() string { return proto.CompactTextString(m) }
+func (*DeviceLinkStats) ProtoMessage()    {}
+func (*DeviceLinkStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{38}
+}
+func (m *DeviceLinkStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceLinkStats.Unmarshal(m, b)
+}
+func (m *DeviceLinkStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceLinkStats.Marshal(b, m, deterministic)
+}
+func (dst *DeviceLinkStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceLinkStats.Merge(dst, src)
+}
+func (m *DeviceLinkStats) XXX_Size() int {
+	return xxx_messageInfo_DeviceLinkStats.Size(m)
+}
+func (m *DeviceLinkStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceLinkStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceLinkStats proto.InternalMessageInfo
+
+func (m *DeviceLinkStats) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *DeviceLinkStats) GetUplinks() uint32 {
+	if m != nil {
+		return m.Uplinks
+	}
+	return 0
+}
+
+func (m *DeviceLinkStats) GetLost() uint32 {
+	if m != nil {
+		return m.Lost
+	}
+	return 0
+}
+
+func (m *DeviceLinkStats) GetPacketLoss() float64 {
+	if m != nil {
+		return m.PacketLoss
+	}
+	return 0
+}
+
+type GetDeviceLinkStatsResponse struct {
+	// Daily statistics (oldest first).
+	// Days without uplinks are omitted.
+	Result []*DeviceLinkStats `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	// Estimated packet-loss (percentage) over the whole time-range.
+	PacketLoss float64 `protobuf:"fixed64,2,opt,name=packet_loss,json=packetLoss,proto3" json:"packet_loss,omitempty"`
+	// The packet-loss over the whole time-range exceeds the configured
+	// unhealthy packet-loss threshold.
+	UnhealthyLink        bool     `protobuf:"varint,3,opt,name=unhealthy_link,json=unhealthyLink,proto3" json:"unhealthy_link,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceLinkStatsResponse) Reset()         { *m = GetDeviceLinkStatsResponse{} }
+func (m *GetDeviceLinkStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceLinkStatsResponse) ProtoMessage()    {}
+func (*GetDeviceLinkStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{39}
+}
+func (m *GetDeviceLinkStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceLinkStatsResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceLinkStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceLinkStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceLinkStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceLinkStatsResponse.Merge(dst, src)
+}
+func (m *GetDeviceLinkStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceLinkStatsResponse.Size(m)
+}
+func (m *GetDeviceLinkStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceLinkStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceLinkStatsResponse proto.InternalMessageInfo
+
+func (m *GetDeviceLinkStatsResponse) GetResult() []*DeviceLinkStats {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetDeviceLinkStatsResponse) GetPacketLoss() float64 {
+	if m != nil {
+		return m.PacketLoss
+	}
+	return 0
+}
+
+func (m *GetDeviceLinkStatsResponse) GetUnhealthyLink() bool {
+	if m != nil {
+		return m.UnhealthyLink
+	}
+	return false
+}
+
+type CreateDeviceEmbedTokenRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// View to which the token gives access.
+	View DeviceEmbedView `protobuf:"varint,2,opt,name=view,proto3,enum=api.DeviceEmbedView" json:"view,omitempty"`
+	// Time the token is valid (max. 24 hours).
+	// When not set, this defaults to 15 minutes.
+	Ttl                  *duration.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CreateDeviceEmbedTokenRequest) Reset()         { *m = CreateDeviceEmbedTokenRequest{} }
+func (m *CreateDeviceEmbedTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceEmbedTokenRequest) ProtoMessage()    {}
+func (*CreateDeviceEmbedTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{40}
+}
+func (m *CreateDeviceEmbedTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceEmbedTokenRequest.Unmarshal(m, b)
+}
+func (m *CreateDeviceEmbedTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceEmbedTokenRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceEmbedTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceEmbedTokenRequest.Merge(dst, src)
+}
+func (m *CreateDeviceEmbedTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceEmbedTokenRequest.Size(m)
+}
+func (m *CreateDeviceEmbedTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceEmbedTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceEmbedTokenRequest proto.InternalMessageInfo
+
+func (m *CreateDeviceEmbedTokenRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *CreateDeviceEmbedTokenRequest) GetView() DeviceEmbedView {
+	if m != nil {
+		return m.View
+	}
+	return DeviceEmbedView_DEVICE_DETAIL
+}
+
+func (m *CreateDeviceEmbedTokenRequest) GetTtl() *duration.Duration {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+type CreateDeviceEmbedTokenResponse struct {
+	// Token to use as bearer token for the API requests of the view.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Embed URL (only set when the embed_url_template has been configured).
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Timestamp until which the token is valid.
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CreateDeviceEmbedTokenResponse) Reset()         { *m = CreateDeviceEmbedTokenResponse{} }
+func (m *CreateDeviceEmbedTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceEmbedTokenResponse) ProtoMessage()    {}
+func (*CreateDeviceEmbedTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_ffe3ee3c1478fb94, []int{41}
+}
+func (m *CreateDeviceEmbedTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceEmbedTokenResponse.Unmarshal(m, b)
+}
+func (m *CreateDeviceEmbedTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceEmbedTokenResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceEmbedTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceEmbedTokenResponse.Merge(dst, src)
+}
+func (m *CreateDeviceEmbedTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceEmbedTokenResponse.Size(m)
+}
+func (m *CreateDeviceEmbedTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceEmbedTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceEmbedTokenResponse proto.InternalMessageInfo
+
+func (m *CreateDeviceEmbedTokenResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *CreateDeviceEmbedTokenResponse) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CreateDeviceEmbedTokenResponse) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Device)(nil), "api.Device")
+	proto.RegisterType((*DeviceListItem)(nil), "api.DeviceListItem")
+	proto.RegisterType((*DeviceKeys)(nil), "api.DeviceKeys")
+	proto.RegisterType((*CreateDeviceRequest)(nil), "api.CreateDeviceRequest")
+	proto.RegisterType((*GetDeviceRequest)(nil), "api.GetDeviceRequest")
+	proto.RegisterType((*GetDeviceResponse)(nil), "api.GetDeviceResponse")
+	proto.RegisterType((*ListDeviceRequest)(nil), "api.ListDeviceRequest")
+	proto.RegisterType((*ListDeviceResponse)(nil), "api.ListDeviceResponse")
+	proto.RegisterType((*DeleteDeviceRequest)(nil), "api.DeleteDeviceRequest")
+	proto.RegisterType((*UpdateDeviceRequest)(nil), "api.UpdateDeviceRequest")
+	proto.RegisterType((*UpdateDeviceLifecycleStateRequest)(nil), "api.UpdateDeviceLifecycleStateRequest")
+	proto.RegisterType((*CreateDeviceKeysRequest)(nil), "api.CreateDeviceKeysRequest")
+	proto.RegisterType((*GetDeviceKeysRequest)(nil), "api.GetDeviceKeysRequest")
+	proto.RegisterType((*GetDeviceKeysResponse)(nil), "api.GetDeviceKeysResponse")
+	proto.RegisterType((*UpdateDeviceKeysRequest)(nil), "api.UpdateDeviceKeysRequest")
+	proto.RegisterType((*DeleteDeviceKeysRequest)(nil), "api.DeleteDeviceKeysRequest")
+	proto.RegisterType((*DeviceActivation)(nil), "api.DeviceActivation")
+	proto.RegisterType((*ActivateDeviceRequest)(nil), "api.ActivateDeviceRequest")
+	proto.RegisterType((*DeactivateDeviceRequest)(nil), "api.DeactivateDeviceRequest")
+	proto.RegisterType((*GetDeviceActivationRequest)(nil), "api.GetDeviceActivationRequest")
+	proto.RegisterType((*GetDeviceActivationResponse)(nil), "api.GetDeviceActivationResponse")
+	proto.RegisterType((*DeviceActivationContext)(nil), "api.DeviceActivationContext")
+	proto.RegisterType((*ExportDeviceActivationRequest)(nil), "api.ExportDeviceActivationRequest")
+	proto.RegisterType((*ExportDeviceActivationResponse)(nil), "api.ExportDeviceActivationResponse")
+	proto.RegisterType((*ImportDeviceActivationRequest)(nil), "api.ImportDeviceActivationRequest")
+	proto.RegisterType((*ImportDevicesRequest)(nil), "api.ImportDevicesRequest")
+	proto.RegisterType((*ImportDevicesResponse)(nil), "api.ImportDevicesResponse")
+	proto.RegisterType((*ImportDeviceError)(nil), "api.ImportDeviceError")
+	proto.RegisterType((*GetRandomDevAddrRequest)(nil), "api.GetRandomDevAddrRequest")
+	proto.RegisterType((*GetRandomDevAddrResponse)(nil), "api.GetRandomDevAddrResponse")
+	proto.RegisterType((*StreamDeviceFrameLogsRequest)(nil), "api.StreamDeviceFrameLogsRequest")
+	proto.RegisterType((*StreamDeviceFrameLogsResponse)(nil), "api.StreamDeviceFrameLogsResponse")
+	proto.RegisterType((*StreamDeviceEventLogsRequest)(nil), "api.StreamDeviceEventLogsRequest")
 	proto.RegisterType((*StreamDeviceEventLogsResponse)(nil), "api.StreamDeviceEventLogsResponse")
+	proto.RegisterType((*GetDeviceLocationTrackRequest)(nil), "api.GetDeviceLocationTrackRequest")
+	proto.RegisterType((*DeviceLocationTrackPoint)(nil), "api.DeviceLocationTrackPoint")
+	proto.RegisterType((*GetDeviceLocationTrackResponse)(nil), "api.GetDeviceLocationTrackResponse")
+	proto.RegisterType((*GetDeviceLinkStatsRequest)(nil), "api.GetDeviceLinkStatsRequest")
+	proto.RegisterType((*DeviceLinkStats)(nil), "api.DeviceLinkStats")
+	proto.RegisterType((*GetDeviceLinkStatsResponse)(nil), "api.GetDeviceLinkStatsResponse")
+	proto.RegisterType((*CreateDeviceEmbedTokenRequest)(nil), "api.CreateDeviceEmbedTokenRequest")
+	proto.RegisterType((*CreateDeviceEmbedTokenResponse)(nil), "api.CreateDeviceEmbedTokenResponse")
+	proto.RegisterEnum("api.DeviceEmbedView", DeviceEmbedView_name, DeviceEmbedView_value)
+	proto.RegisterEnum("api.DeviceLifecycleState", DeviceLifecycleState_name, DeviceLifecycleState_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1560,6 +2568,8 @@ type DeviceServiceClient interface {
 	// Update updates the device matching the given DevEUI.
 	Update(ctx context.Context, in *UpdateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// CreateKeys creates the given device-keys.
+	// When the application has a master-key, the device-keys derived from it
+	// are overridden by the given device-keys.
 	CreateKeys(ctx context.Context, in *CreateDeviceKeysRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// GetKeys returns the device-keys for the given DevEUI.
 	GetKeys(ctx context.Context, in *GetDeviceKeysRequest, opts ...grpc.CallOption) (*GetDeviceKeysResponse, error)
@@ -1571,10 +2581,28 @@ type DeviceServiceClient interface {
 	Activate(ctx context.Context, in *ActivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Deactivate de-activates the device.
 	Deactivate(ctx context.Context, in *DeactivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// UpdateLifecycleState updates the lifecycle state of the device.
+	UpdateLifecycleState(ctx context.Context, in *UpdateDeviceLifecycleStateRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// GetActivation returns the current activation details of the device (OTAA and ABP).
 	GetActivation(ctx context.Context, in *GetDeviceActivationRequest, opts ...grpc.CallOption) (*GetDeviceActivationResponse, error)
+	// ExportActivation exports the activation context of the device (OTAA and
+	// ABP), so that it can be imported on an other LoRa App Server / LoRa Server
+	// instance without requiring the device to re-join.
+	ExportActivation(ctx context.Context, in *ExportDeviceActivationRequest, opts ...grpc.CallOption) (*ExportDeviceActivationResponse, error)
+	// ImportActivation (re)activates the device (OTAA and ABP) using an
+	// activation context exported by ExportActivation.
+	ImportActivation(ctx context.Context, in *ImportDeviceActivationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Import creates the devices and their keys from the given CSV document.
+	// Invalid rows are skipped and returned in the error report.
+	Import(ctx context.Context, in *ImportDevicesRequest, opts ...grpc.CallOption) (*ImportDevicesResponse, error)
 	// GetRandomDevAddr returns a random DevAddr taking the NwkID prefix into account.
 	GetRandomDevAddr(ctx context.Context, in *GetRandomDevAddrRequest, opts ...grpc.CallOption) (*GetRandomDevAddrResponse, error)
+	// GetLocationTrack returns the location history of the device within the given time-range.
+	// The track can be simplified by setting a tolerance (in meters) and / or a maximum number of points.
+	GetLocationTrack(ctx context.Context, in *GetDeviceLocationTrackRequest, opts ...grpc.CallOption) (*GetDeviceLocationTrackResponse, error)
+	// GetLinkStats returns the daily uplink packet-loss of the device within the given time-range.
+	// The packet-loss is estimated from the gaps in the uplink frame-counters.
+	GetLinkStats(ctx context.Context, in *GetDeviceLinkStatsRequest, opts ...grpc.CallOption) (*GetDeviceLinkStatsResponse, error)
 	// StreamFrameLogs streams the uplink and downlink frame-logs for the given DevEUI.
 	//   * These are the raw LoRaWAN frames and this endpoint is intended for debugging only.
 	//   * This endpoint does not work from a web-browser.
@@ -1583,6 +2611,10 @@ type DeviceServiceClient interface {
 	//   * This endpoint is intended for debugging only.
 	//   * This endpoint does not work from a web-browser.
 	StreamEventLogs(ctx context.Context, in *StreamDeviceEventLogsRequest, opts ...grpc.CallOption) (DeviceService_StreamEventLogsClient, error)
+	// CreateEmbedToken creates a short-lived token giving read-only access
+	// to a single view of the device, e.g. to embed the device details or
+	// the live frames in an external portal (iframe).
+	CreateEmbedToken(ctx context.Context, in *CreateDeviceEmbedTokenRequest, opts ...grpc.CallOption) (*CreateDeviceEmbedTokenResponse, error)
 }
 
 type deviceServiceClient struct {
@@ -1692,6 +2724,15 @@ func (c *deviceServiceClient) Deactivate(ctx context.Context, in *DeactivateDevi
 	return out, nil
 }
 
+func (c *deviceServiceClient) UpdateLifecycleState(ctx context.Context, in *UpdateDeviceLifecycleStateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/UpdateLifecycleState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deviceServiceClient) GetActivation(ctx context.Context, in *GetDeviceActivationRequest, opts ...grpc.CallOption) (*GetDeviceActivationResponse, error) {
 	out := new(GetDeviceActivationResponse)
 	err := c.cc.Invoke(ctx, "/api.DeviceService/GetActivation", in, out, opts...)
@@ -1701,6 +2742,33 @@ func (c *deviceServiceClient) GetActivation(ctx context.Context, in *GetDeviceAc
 	return out, nil
 }
 
+func (c *deviceServiceClient) ExportActivation(ctx context.Context, in *ExportDeviceActivationRequest, opts ...grpc.CallOption) (*ExportDeviceActivationResponse, error) {
+	out := new(ExportDeviceActivationResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/ExportActivation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) ImportActivation(ctx context.Context, in *ImportDeviceActivationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/ImportActivation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) Import(ctx context.Context, in *ImportDevicesRequest, opts ...grpc.CallOption) (*ImportDevicesResponse, error) {
+	out := new(ImportDevicesResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deviceServiceClient) GetRandomDevAddr(ctx context.Context, in *GetRandomDevAddrRequest, opts ...grpc.CallOption) (*GetRandomDevAddrResponse, error) {
 	out := new(GetRandomDevAddrResponse)
 	err := c.cc.Invoke(ctx, "/api.DeviceService/GetRandomDevAddr", in, out, opts...)
@@ -1710,6 +2778,24 @@ func (c *deviceServiceClient) GetRandomDevAddr(ctx context.Context, in *GetRando
 	return out, nil
 }
 
+func (c *deviceServiceClient) GetLocationTrack(ctx context.Context, in *GetDeviceLocationTrackRequest, opts ...grpc.CallOption) (*GetDeviceLocationTrackResponse, error) {
+	out := new(GetDeviceLocationTrackResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetLocationTrack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetLinkStats(ctx context.Context, in *GetDeviceLinkStatsRequest, opts ...grpc.CallOption) (*GetDeviceLinkStatsResponse, error) {
+	out := new(GetDeviceLinkStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetLinkStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deviceServiceClient) StreamFrameLogs(ctx context.Context, in *StreamDeviceFrameLogsRequest, opts ...grpc.CallOption) (DeviceService_StreamFrameLogsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_DeviceService_serviceDesc.Streams[0], "/api.DeviceService/StreamFrameLogs", opts...)
 	if err != nil {
@@ -1774,6 +2860,15 @@ func (x *deviceServiceStreamEventLogsClient) Recv() (*StreamDeviceEventLogsRespo
 	return m, nil
 }
 
+func (c *deviceServiceClient) CreateEmbedToken(ctx context.Context, in *CreateDeviceEmbedTokenRequest, opts ...grpc.CallOption) (*CreateDeviceEmbedTokenResponse, error) {
+	out := new(CreateDeviceEmbedTokenResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/CreateEmbedToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceServiceServer is the server API for DeviceService service.
 type DeviceServiceServer interface {
 	// Create creates the given device.
@@ -1787,6 +2882,8 @@ type DeviceServiceServer interface {
 	// Update updates the device matching the given DevEUI.
 	Update(context.Context, *UpdateDeviceRequest) (*empty.Empty, error)
 	// CreateKeys creates the given device-keys.
+	// When the application has a master-key, the device-keys derived from it
+	// are overridden by the given device-keys.
 	CreateKeys(context.Context, *CreateDeviceKeysRequest) (*empty.Empty, error)
 	// GetKeys returns the device-keys for the given DevEUI.
 	GetKeys(context.Context, *GetDeviceKeysRequest) (*GetDeviceKeysResponse, error)
@@ -1798,10 +2895,28 @@ type DeviceServiceServer interface {
 	Activate(context.Context, *ActivateDeviceRequest) (*empty.Empty, error)
 	// Deactivate de-activates the device.
 	Deactivate(context.Context, *DeactivateDeviceRequest) (*empty.Empty, error)
+	// UpdateLifecycleState updates the lifecycle state of the device.
+	UpdateLifecycleState(context.Context, *UpdateDeviceLifecycleStateRequest) (*empty.Empty, error)
 	// GetActivation returns the current activation details of the device (OTAA and ABP).
 	GetActivation(context.Context, *GetDeviceActivationRequest) (*GetDeviceActivationResponse, error)
+	// ExportActivation exports the activation context of the device (OTAA and
+	// ABP), so that it can be imported on an other LoRa App Server / LoRa Server
+	// instance without requiring the device to re-join.
+	ExportActivation(context.Context, *ExportDeviceActivationRequest) (*ExportDeviceActivationResponse, error)
+	// ImportActivation (re)activates the device (OTAA and ABP) using an
+	// activation context exported by ExportActivation.
+	ImportActivation(context.Context, *ImportDeviceActivationRequest) (*empty.Empty, error)
+	// Import creates the devices and their keys from the given CSV document.
+	// Invalid rows are skipped and returned in the error report.
+	Import(context.Context, *ImportDevicesRequest) (*ImportDevicesResponse, error)
 	// GetRandomDevAddr returns a random DevAddr taking the NwkID prefix into account.
 	GetRandomDevAddr(context.Context, *GetRandomDevAddrRequest) (*GetRandomDevAddrResponse, error)
+	// GetLocationTrack returns the location history of the device within the given time-range.
+	// The track can be simplified by setting a tolerance (in meters) and / or a maximum number of points.
+	GetLocationTrack(context.Context, *GetDeviceLocationTrackRequest) (*GetDeviceLocationTrackResponse, error)
+	// GetLinkStats returns the daily uplink packet-loss of the device within the given time-range.
+	// The packet-loss is estimated from the gaps in the uplink frame-counters.
+	GetLinkStats(context.Context, *GetDeviceLinkStatsRequest) (*GetDeviceLinkStatsResponse, error)
 	// StreamFrameLogs streams the uplink and downlink frame-logs for the given DevEUI.
 	//   * These are the raw LoRaWAN frames and this endpoint is intended for debugging only.
 	//   * This endpoint does not work from a web-browser.
@@ -1810,6 +2925,10 @@ type DeviceServiceServer interface {
 	//   * This endpoint is intended for debugging only.
 	//   * This endpoint does not work from a web-browser.
 	StreamEventLogs(*StreamDeviceEventLogsRequest, DeviceService_StreamEventLogsServer) error
+	// CreateEmbedToken creates a short-lived token giving read-only access
+	// to a single view of the device, e.g. to embed the device details or
+	// the live frames in an external portal (iframe).
+	CreateEmbedToken(context.Context, *CreateDeviceEmbedTokenRequest) (*CreateDeviceEmbedTokenResponse, error)
 }
 
 func RegisterDeviceServiceServer(s *grpc.Server, srv DeviceServiceServer) {
@@ -2014,6 +3133,24 @@ func _DeviceService_Deactivate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceService_UpdateLifecycleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceLifecycleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).UpdateLifecycleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/UpdateLifecycleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).UpdateLifecycleState(ctx, req.(*UpdateDeviceLifecycleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeviceService_GetActivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDeviceActivationRequest)
 	if err := dec(in); err != nil {
@@ -2032,6 +3169,60 @@ func _DeviceService_GetActivation_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceService_ExportActivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDeviceActivationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ExportActivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/ExportActivation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ExportActivation(ctx, req.(*ExportDeviceActivationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_ImportActivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDeviceActivationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ImportActivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/ImportActivation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ImportActivation(ctx, req.(*ImportDeviceActivationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).Import(ctx, req.(*ImportDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeviceService_GetRandomDevAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRandomDevAddrRequest)
 	if err := dec(in); err != nil {
@@ -2050,6 +3241,42 @@ func _DeviceService_GetRandomDevAddr_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceService_GetLocationTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceLocationTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetLocationTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetLocationTrack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetLocationTrack(ctx, req.(*GetDeviceLocationTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetLinkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceLinkStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetLinkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetLinkStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetLinkStats(ctx, req.(*GetDeviceLinkStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeviceService_StreamFrameLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamDeviceFrameLogsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2092,6 +3319,24 @@ func (x *deviceServiceStreamEventLogsServer) Send(m *StreamDeviceEventLogsRespon
 	return x.ServerStream.SendMsg(m)
 }
 
+func _DeviceService_CreateEmbedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceEmbedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).CreateEmbedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/CreateEmbedToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).CreateEmbedToken(ctx, req.(*CreateDeviceEmbedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DeviceService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.DeviceService",
 	HandlerType: (*DeviceServiceServer)(nil),
@@ -2140,14 +3385,42 @@ var _DeviceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Deactivate",
 			Handler:    _DeviceService_Deactivate_Handler,
 		},
+		{
+			MethodName: "UpdateLifecycleState",
+			Handler:    _DeviceService_UpdateLifecycleState_Handler,
+		},
 		{
 			MethodName: "GetActivation",
 			Handler:    _DeviceService_GetActivation_Handler,
 		},
+		{
+			MethodName: "ExportActivation",
+			Handler:    _DeviceService_ExportActivation_Handler,
+		},
+		{
+			MethodName: "ImportActivation",
+			Handler:    _DeviceService_ImportActivation_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _DeviceService_Import_Handler,
+		},
 		{
 			MethodName: "GetRandomDevAddr",
 			Handler:    _DeviceService_GetRandomDevAddr_Handler,
 		},
+		{
+			MethodName: "GetLocationTrack",
+			Handler:    _DeviceService_GetLocationTrack_Handler,
+		},
+		{
+			MethodName: "GetLinkStats",
+			Handler:    _DeviceService_GetLinkStats_Handler,
+		},
+		{
+			MethodName: "CreateEmbedToken",
+			Handler:    _DeviceService_CreateEmbedToken_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2164,109 +3437,172 @@ var _DeviceService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "device.proto",
 }
 
-func init() { proto.RegisterFile("device.proto", fileDescriptor_device_89a06033700147e8) }
-
-var fileDescriptor_device_89a06033700147e8 = []byte{
-	// 1615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4b, 0x73, 0xdb, 0x46,
-	0x12, 0x5e, 0x88, 0x12, 0x25, 0x35, 0x49, 0x3d, 0x46, 0x2f, 0x98, 0xb2, 0x56, 0x14, 0xb4, 0x2e,
-	0xd3, 0xb2, 0x97, 0xd4, 0x6a, 0xcb, 0xbb, 0x5b, 0x2e, 0xef, 0x56, 0xc9, 0x92, 0xac, 0xd5, 0x4a,
-	0xf6, 0xba, 0x40, 0x6b, 0xb7, 0x2a, 0x39, 0xa0, 0x46, 0xc0, 0x90, 0x46, 0x08, 0x0e, 0x10, 0x60,
-	0x48, 0x85, 0x95, 0xb8, 0x2a, 0xf1, 0x31, 0xd7, 0xfc, 0x83, 0xdc, 0xf3, 0x6b, 0x72, 0xcd, 0x31,
-	0x97, 0xfc, 0x8b, 0xd4, 0x3c, 0x48, 0x0d, 0x1f, 0xd0, 0x23, 0xc9, 0x25, 0x27, 0x12, 0xdd, 0x5f,
-	0x3f, 0xbe, 0x9e, 0x9e, 0x6e, 0x00, 0xf2, 0x1e, 0xe9, 0xf8, 0x2e, 0xa9, 0x44, 0x71, 0xc8, 0x42,
-	0x94, 0xc1, 0x91, 0x5f, 0x7c, 0xda, 0xf0, 0xd9, 0xbb, 0xf6, 0x45, 0xc5, 0x0d, 0x5b, 0xd5, 0x8b,
-	0x38, 0x74, 0x31, 0x8e, 0xab, 0x41, 0x18, 0xe3, 0x84, 0xc4, 0x1d, 0x12, 0x57, 0x71, 0xe4, 0x57,
-	0xdd, 0xb0, 0xd5, 0x0a, 0xa9, 0xfa, 0x91, 0xb6, 0xc5, 0xfb, 0x8d, 0x30, 0x6c, 0x04, 0x44, 0xe8,
-	0x31, 0xa5, 0x21, 0xc3, 0xcc, 0x0f, 0x69, 0xa2, 0xb4, 0x9b, 0x4a, 0x2b, 0x9e, 0x2e, 0xda, 0xf5,
-	0x2a, 0xf3, 0x5b, 0x24, 0x61, 0xb8, 0x15, 0x29, 0xc0, 0xfa, 0x30, 0x80, 0xb4, 0x22, 0xd6, 0x55,
-	0xca, 0xbc, 0x1e, 0xc9, 0xfa, 0x30, 0x01, 0xd9, 0x43, 0x91, 0x36, 0x5a, 0x83, 0x69, 0x8f, 0x74,
-	0x1c, 0xd2, 0xf6, 0x4d, 0xa3, 0x64, 0x94, 0x67, 0xed, 0xac, 0x47, 0x3a, 0x47, 0xe7, 0x27, 0x08,
-	0xc1, 0x24, 0xc5, 0x2d, 0x62, 0x4e, 0x08, 0xa9, 0xf8, 0x8f, 0x1e, 0xc0, 0x1c, 0x8e, 0xa2, 0xc0,
-	0x77, 0x45, 0x66, 0x8e, 0xef, 0x99, 0x99, 0x92, 0x51, 0xce, 0xd8, 0x05, 0x4d, 0x7a, 0x72, 0x88,
-	0x4a, 0x90, 0xf3, 0x48, 0xe2, 0xc6, 0x7e, 0xc4, 0x05, 0xe6, 0xa4, 0xf0, 0xa0, 0x8b, 0xd0, 0x0e,
-	0x2c, 0xca, 0xb2, 0x39, 0x51, 0x1c, 0xd6, 0xfd, 0x80, 0x70, 0x5f, 0x53, 0x02, 0x37, 0x2f, 0x15,
-	0x6f, 0xa4, 0xfc, 0xe4, 0x10, 0x3d, 0x84, 0x85, 0xa4, 0xe9, 0x47, 0x4e, 0xdd, 0x71, 0x29, 0x73,
-	0xdc, 0x77, 0xc4, 0x6d, 0x9a, 0xd9, 0x92, 0x51, 0x9e, 0xb1, 0x0b, 0x5c, 0xfe, 0xf2, 0x80, 0xb2,
-	0x03, 0x2e, 0x44, 0x7f, 0x06, 0x14, 0x93, 0x3a, 0x89, 0x09, 0x75, 0x89, 0x83, 0x03, 0xe6, 0xb3,
-	0xb6, 0x47, 0xcc, 0xe9, 0x92, 0x51, 0x36, 0xec, 0xc5, 0xbe, 0x66, 0x5f, 0x29, 0xac, 0x9f, 0x26,
-	0x61, 0x4e, 0x16, 0xe1, 0xcc, 0x4f, 0xd8, 0x09, 0x23, 0xad, 0xdf, 0x41, 0x31, 0x2a, 0xb0, 0x34,
-	0x84, 0x15, 0x79, 0x65, 0x05, 0x7a, 0x71, 0x00, 0xfd, 0x9a, 0x27, 0xb9, 0x07, 0x2b, 0x0a, 0x9f,
-	0x30, 0xcc, 0xda, 0x89, 0x73, 0x81, 0x19, 0x23, 0x71, 0x57, 0x94, 0xa5, 0x60, 0x2b, 0x67, 0x35,
-	0xa1, 0x7b, 0x21, 0x55, 0x68, 0x17, 0x96, 0x07, 0x6d, 0x5a, 0x38, 0x6e, 0xf8, 0xd4, 0x9c, 0x29,
-	0x19, 0xe5, 0x29, 0x1b, 0xe9, 0x26, 0xaf, 0x84, 0x06, 0x9d, 0xc1, 0xf6, 0xa0, 0x05, 0xf9, 0x8c,
-	0x91, 0x98, 0xe2, 0xc0, 0x89, 0xc2, 0x4b, 0x12, 0x3b, 0x49, 0xd8, 0x8e, 0x5d, 0x62, 0x82, 0x38,
-	0xb5, 0x4d, 0xdd, 0xc1, 0x91, 0x02, 0xbe, 0xe1, 0xb8, 0x9a, 0x80, 0xa1, 0xb7, 0xf0, 0x70, 0x6c,
-	0xce, 0x4e, 0x40, 0x3a, 0x24, 0x70, 0xda, 0x14, 0x77, 0xb0, 0x1f, 0xe0, 0x8b, 0x80, 0x98, 0x39,
-	0xe1, 0x71, 0x7b, 0x0c, 0x8b, 0x33, 0x8e, 0x3d, 0xbf, 0x82, 0xa2, 0x7f, 0xc2, 0xfa, 0x35, 0x5e,
-	0xcd, 0x7c, 0xc9, 0x28, 0x4f, 0xd8, 0x66, 0x9a, 0x27, 0xf4, 0x1c, 0xf2, 0x01, 0x4e, 0x98, 0x93,
-	0x10, 0x42, 0x1d, 0xcc, 0xcc, 0xd9, 0x92, 0x51, 0xce, 0xed, 0x15, 0x2b, 0xf2, 0xd2, 0x55, 0x7a,
-	0x97, 0xae, 0xf2, 0xb6, 0x77, 0x2b, 0x6d, 0xe0, 0xf8, 0x1a, 0x21, 0x74, 0x9f, 0x59, 0xff, 0x07,
-	0x90, 0xad, 0x76, 0x4a, 0xba, 0x49, 0x7a, 0x9b, 0xad, 0xc1, 0x34, 0xbd, 0x6c, 0x3a, 0x4d, 0xd2,
-	0x55, 0x9d, 0x96, 0xa5, 0x97, 0xcd, 0x53, 0xd2, 0xe5, 0x0a, 0x1c, 0x45, 0x42, 0x91, 0x91, 0x0a,
-	0x1c, 0x45, 0xa7, 0xa4, 0x6b, 0x3d, 0x83, 0xa5, 0x83, 0x98, 0x60, 0x46, 0xa4, 0x7b, 0x9b, 0x7c,
-	0xda, 0x26, 0x09, 0x43, 0xdb, 0x90, 0x95, 0x4c, 0x44, 0x80, 0xdc, 0x5e, 0xae, 0x82, 0x23, 0xbf,
-	0xa2, 0x30, 0x4a, 0x65, 0x3d, 0x86, 0x85, 0x63, 0xc2, 0x06, 0x0d, 0xd3, 0x52, 0xb3, 0xbe, 0x9e,
-	0x80, 0x45, 0x0d, 0x9d, 0x44, 0x21, 0x4d, 0xc8, 0xad, 0xe2, 0x8c, 0x94, 0x6e, 0xea, 0x2e, 0xa5,
-	0x4b, 0xef, 0xe0, 0xec, 0xdd, 0x3b, 0x78, 0x39, 0xb5, 0x83, 0x9f, 0xc0, 0x4c, 0x10, 0xca, 0x3b,
-	0x6b, 0xae, 0x88, 0xfc, 0x16, 0x2a, 0x6a, 0x64, 0x9e, 0x29, 0xb9, 0xdd, 0x47, 0x58, 0x3f, 0x18,
-	0xb0, 0xc8, 0x87, 0xc6, 0x60, 0xed, 0x96, 0x61, 0x2a, 0xf0, 0x5b, 0x3e, 0x13, 0xb5, 0xc8, 0xd8,
-	0xf2, 0x01, 0xad, 0x42, 0x36, 0xac, 0xd7, 0x13, 0xc2, 0xc4, 0x91, 0x66, 0x6c, 0xf5, 0x74, 0xdb,
-	0xf1, 0xb1, 0x0a, 0xd9, 0x84, 0xe0, 0xd8, 0x7d, 0xa7, 0x26, 0x87, 0x7a, 0x42, 0x4f, 0x00, 0xb5,
-	0xda, 0x01, 0xf3, 0x5d, 0x5e, 0xd9, 0x46, 0x1c, 0xb6, 0xa3, 0xab, 0xa9, 0xb1, 0xd0, 0xd7, 0x1c,
-	0x73, 0xc5, 0xc9, 0x21, 0x47, 0xf3, 0xe5, 0x33, 0x34, 0x63, 0xe4, 0xd4, 0x58, 0x50, 0x9a, 0xfe,
-	0x90, 0xb1, 0x2e, 0x00, 0xe9, 0xec, 0xd4, 0x59, 0x6f, 0x42, 0x8e, 0x85, 0x0c, 0x07, 0x8e, 0x1b,
-	0xb6, 0x69, 0x8f, 0x24, 0x08, 0xd1, 0x01, 0x97, 0xa0, 0xc7, 0x90, 0x8d, 0x49, 0xd2, 0x0e, 0x38,
-	0xd3, 0x4c, 0x39, 0xb7, 0xb7, 0xa4, 0x35, 0x43, 0x6f, 0xc4, 0xda, 0x0a, 0x62, 0x55, 0x60, 0xe9,
-	0x90, 0x04, 0x64, 0xb8, 0x71, 0x53, 0xfb, 0xef, 0x19, 0x2c, 0x9d, 0x47, 0xde, 0x2f, 0x6b, 0xf4,
-	0x53, 0x58, 0xd3, 0x2f, 0x09, 0xbf, 0x83, 0x3d, 0xfb, 0x5d, 0x3e, 0x9d, 0x45, 0x5d, 0x9a, 0xa4,
-	0x9b, 0x28, 0x27, 0xf3, 0x9a, 0x13, 0x01, 0x06, 0xaf, 0xff, 0xdf, 0xaa, 0xc2, 0x72, 0xff, 0x1e,
-	0xe8, 0x9e, 0x52, 0x33, 0x3f, 0x81, 0x95, 0x21, 0x03, 0x55, 0xd0, 0xbb, 0xc7, 0x3e, 0x85, 0x35,
-	0xbd, 0x08, 0xbf, 0x8e, 0xc8, 0x1e, 0xac, 0xe9, 0x27, 0x70, 0x2b, 0x2e, 0xdf, 0x4d, 0xc0, 0x82,
-	0x84, 0xef, 0xbb, 0xcc, 0xef, 0x88, 0x26, 0x4d, 0x1f, 0x67, 0xf7, 0x60, 0x86, 0x2b, 0xb0, 0xe7,
-	0xc5, 0x6a, 0x9e, 0x71, 0xe0, 0xbe, 0xe7, 0xc5, 0xa8, 0x08, 0xb3, 0x7c, 0xa0, 0x25, 0xda, 0x48,
-	0xe3, 0x13, 0xae, 0xc6, 0x87, 0xdd, 0x16, 0x14, 0xf8, 0x14, 0x4c, 0x1c, 0x42, 0x5d, 0xa1, 0x97,
-	0x9d, 0x0f, 0xf4, 0xb2, 0x59, 0x3b, 0xa2, 0x2e, 0x87, 0xfc, 0x09, 0xe6, 0x13, 0x47, 0x82, 0x7c,
-	0xca, 0x04, 0x68, 0x46, 0x2e, 0xd6, 0xe4, 0xf5, 0x65, 0xb3, 0x76, 0x42, 0x99, 0x42, 0xd5, 0x87,
-	0x50, 0xb3, 0x12, 0x55, 0xd7, 0x50, 0x26, 0xcc, 0xc8, 0x57, 0x8b, 0x76, 0x24, 0xee, 0x4f, 0xc1,
-	0xce, 0xd6, 0x0f, 0x28, 0x3b, 0x8f, 0xd0, 0x26, 0xe4, 0xa9, 0x7a, 0xed, 0xf0, 0xc2, 0x4b, 0xaa,
-	0x26, 0xce, 0x2c, 0xe5, 0xaf, 0x1c, 0x87, 0xe1, 0x25, 0xe5, 0x00, 0xac, 0x03, 0x40, 0x02, 0x70,
-	0x0f, 0x60, 0x7d, 0x0c, 0x2b, 0xaa, 0x50, 0x43, 0x7d, 0xfb, 0xa2, 0xbf, 0xf3, 0x71, 0xbf, 0x90,
-	0xea, 0xd0, 0x56, 0xb4, 0x43, 0xbb, 0xaa, 0xb2, 0xbd, 0xe0, 0x0d, 0x49, 0xe4, 0x01, 0xe2, 0xb1,
-	0xee, 0x53, 0x0f, 0xf0, 0x29, 0x14, 0xfb, 0xcd, 0xa8, 0x39, 0xbf, 0xc9, 0x0c, 0xc3, 0xfa, 0x58,
-	0x33, 0xd5, 0xc9, 0xbf, 0x11, 0x9b, 0x63, 0xc2, 0x6c, 0x4c, 0xbd, 0xb0, 0x75, 0x28, 0xbb, 0xe4,
-	0x16, 0x6c, 0xcc, 0x51, 0x1b, 0x95, 0x93, 0xde, 0x7c, 0xc6, 0x40, 0xf3, 0x59, 0x7f, 0x87, 0xfb,
-	0x35, 0x16, 0x13, 0xdc, 0x92, 0x69, 0xbd, 0x8c, 0x71, 0x8b, 0x9c, 0x85, 0x8d, 0x9b, 0xdb, 0xff,
-	0x5b, 0x03, 0x36, 0x52, 0x2c, 0x55, 0xd4, 0x7f, 0x40, 0xbe, 0x1d, 0x05, 0x3e, 0x6d, 0x3a, 0x75,
-	0xae, 0x53, 0x45, 0x90, 0x93, 0xf0, 0x5c, 0x28, 0x7a, 0x36, 0xff, 0xfe, 0x83, 0x9d, 0x6b, 0x5f,
-	0x49, 0xd0, 0xbf, 0x60, 0x8e, 0xf7, 0x90, 0x66, 0x3b, 0xa1, 0x17, 0x50, 0xa9, 0x34, 0xeb, 0x82,
-	0xa7, 0xcb, 0x5e, 0x4c, 0xc3, 0x94, 0x30, 0x1b, 0x66, 0x77, 0xd4, 0x21, 0x94, 0xdd, 0x8a, 0xdd,
-	0xff, 0x06, 0xc9, 0x69, 0x86, 0x8a, 0x1c, 0x82, 0x49, 0xd6, 0x8d, 0x88, 0x32, 0x13, 0xff, 0xd1,
-	0x16, 0xe4, 0x23, 0xdc, 0x0d, 0x42, 0xec, 0x39, 0x9f, 0x24, 0x21, 0x55, 0xf7, 0x3c, 0xa7, 0x64,
-	0xff, 0xa9, 0xfd, 0xf7, 0xf5, 0xde, 0x87, 0x02, 0x14, 0xa4, 0xcb, 0x9a, 0xdc, 0x34, 0xa8, 0x06,
-	0x59, 0x39, 0x90, 0x91, 0x29, 0xd8, 0x8d, 0x79, 0x85, 0x29, 0xae, 0x8e, 0xbc, 0x1f, 0x1c, 0xf1,
-	0xef, 0x19, 0x6b, 0xed, 0xc3, 0xf7, 0x3f, 0x7e, 0x33, 0xb1, 0x68, 0xe5, 0xc5, 0x77, 0x92, 0x6c,
-	0xa3, 0xe4, 0x99, 0xb1, 0x83, 0xde, 0x42, 0xe6, 0x98, 0x30, 0x24, 0xeb, 0x35, 0xfc, 0x62, 0x53,
-	0x5c, 0x1d, 0x16, 0x4b, 0x4e, 0xd6, 0x1f, 0x85, 0x3b, 0x13, 0xad, 0xea, 0xee, 0xaa, 0x9f, 0xab,
-	0x0a, 0xbd, 0x47, 0xaf, 0x60, 0x92, 0xef, 0x2e, 0x24, 0xed, 0x47, 0x96, 0x7e, 0x71, 0x6d, 0x44,
-	0xae, 0x1c, 0x2f, 0x0b, 0xc7, 0x73, 0x68, 0x20, 0x4f, 0xf4, 0x11, 0xff, 0xf0, 0xe2, 0x43, 0x57,
-	0x31, 0x1f, 0xb3, 0x03, 0x53, 0x99, 0xab, 0x54, 0x77, 0xd2, 0x52, 0xf5, 0x20, 0x2b, 0xb7, 0x83,
-	0xf2, 0x3d, 0x66, 0x5f, 0xa6, 0xfa, 0x2e, 0x0b, 0xdf, 0x56, 0x71, 0x63, 0xc4, 0x37, 0xff, 0xb6,
-	0xed, 0x85, 0xe0, 0x65, 0xee, 0x00, 0xc8, 0xe3, 0x12, 0xaf, 0xb2, 0xf7, 0x47, 0xce, 0x4f, 0xdb,
-	0x23, 0xa9, 0xd1, 0xf6, 0x44, 0xb4, 0x27, 0xd6, 0xc3, 0x71, 0xd1, 0xc4, 0x02, 0xeb, 0x87, 0xac,
-	0xf2, 0x27, 0x1e, 0x97, 0xc0, 0xf4, 0x31, 0x61, 0x22, 0xe8, 0xbd, 0xc1, 0xb3, 0xd4, 0x23, 0x16,
-	0xc7, 0xa9, 0xd4, 0x89, 0x6c, 0x8b, 0xa8, 0x1b, 0x68, 0x7d, 0x7c, 0xfd, 0x44, 0x24, 0x4e, 0x4f,
-	0xd6, 0x4d, 0xa3, 0x97, 0xb2, 0x73, 0x6f, 0xa2, 0x57, 0xbc, 0x0b, 0xbd, 0x06, 0xff, 0x42, 0xe0,
-	0xbd, 0xa0, 0xc5, 0x4d, 0x59, 0xcf, 0xa9, 0x71, 0x15, 0xc1, 0x9d, 0x6b, 0x09, 0x7e, 0x01, 0x33,
-	0xbd, 0x95, 0x84, 0x64, 0xb5, 0xc6, 0x6e, 0xa8, 0xd4, 0x20, 0xcf, 0x45, 0x90, 0xbf, 0x59, 0x7f,
-	0x19, 0x4b, 0xee, 0x6a, 0xfe, 0x5f, 0x51, 0xec, 0xad, 0x28, 0x4e, 0xb3, 0xc5, 0x69, 0xf6, 0x04,
-	0x7d, 0x9a, 0xf8, 0x4e, 0x19, 0x3c, 0x12, 0x19, 0x6c, 0xef, 0x6c, 0xa5, 0xd0, 0xbc, 0xca, 0x01,
-	0xbd, 0x87, 0xc2, 0x31, 0x61, 0xda, 0xbb, 0xca, 0xe6, 0x60, 0x7f, 0x8c, 0xac, 0xc0, 0x62, 0x29,
-	0x1d, 0xa0, 0xda, 0x48, 0x85, 0x47, 0xb7, 0x08, 0xff, 0xa5, 0x21, 0x3e, 0xb1, 0x06, 0x16, 0x94,
-	0x22, 0x9d, 0xb2, 0xeb, 0x8a, 0x1b, 0x29, 0x5a, 0x15, 0xbc, 0x2a, 0x82, 0x3f, 0x1a, 0x73, 0x73,
-	0x64, 0xf0, 0xc6, 0x70, 0xb4, 0xaf, 0x0c, 0x98, 0x97, 0x53, 0xbd, 0xbf, 0xac, 0xd0, 0x96, 0x88,
-	0x71, 0xdd, 0x0a, 0x2c, 0x5a, 0xd7, 0x41, 0x54, 0x2e, 0x0f, 0x44, 0x2e, 0x9b, 0x68, 0x23, 0x25,
-	0x17, 0xb1, 0x8e, 0x92, 0x5d, 0x43, 0xcb, 0xa1, 0xbf, 0x53, 0xc6, 0xe4, 0x30, 0xbc, 0xa8, 0xc6,
-	0xe4, 0x30, 0xb2, 0x92, 0x6e, 0xcc, 0x81, 0x70, 0x8b, 0x64, 0xd7, 0xb8, 0xc8, 0x8a, 0x26, 0xfa,
-	0xeb, 0xcf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x64, 0x32, 0x7c, 0xaf, 0x13, 0x00, 0x00,
+func init() { proto.RegisterFile("device.proto", fileDescriptor_device_ffe3ee3c1478fb94) }
+
+var fileDescriptor_device_ffe3ee3c1478fb94 = []byte{
+	// 2621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x73, 0x1b, 0x59,
+	0xf1, 0xdf, 0x91, 0x62, 0xd9, 0x6e, 0x59, 0xb6, 0xfc, 0xa2, 0xd8, 0x8a, 0x12, 0xd9, 0xce, 0x78,
+	0x77, 0xa3, 0x75, 0x12, 0x39, 0xeb, 0x6f, 0xe5, 0xcb, 0x12, 0x16, 0x28, 0xc7, 0x52, 0x82, 0xb0,
+	0xe3, 0xa4, 0x46, 0xb6, 0xa1, 0xe0, 0x30, 0xf5, 0x3c, 0xf3, 0xe4, 0xcc, 0x6a, 0x34, 0x33, 0xcc,
+	0x3c, 0xd9, 0x31, 0xcb, 0x56, 0x2d, 0xcb, 0x09, 0xaa, 0x28, 0xaa, 0xe0, 0x3f, 0xe0, 0xc4, 0x65,
+	0xcf, 0x14, 0xfc, 0x0d, 0x14, 0x17, 0x6e, 0x9c, 0xf9, 0x2f, 0xb8, 0x50, 0xef, 0x87, 0x46, 0xa3,
+	0xd1, 0x8c, 0x64, 0x43, 0x2e, 0x7b, 0x92, 0xa6, 0xbb, 0x5f, 0xf7, 0xa7, 0xfb, 0xf5, 0xeb, 0x7e,
+	0xfd, 0x60, 0xc1, 0x24, 0xe7, 0x96, 0x41, 0xea, 0x9e, 0xef, 0x52, 0x17, 0x65, 0xb1, 0x67, 0x55,
+	0x9e, 0x9c, 0x59, 0xf4, 0x4d, 0xff, 0xb4, 0x6e, 0xb8, 0xbd, 0xed, 0x53, 0xdf, 0x35, 0x30, 0xf6,
+	0xb7, 0x6d, 0xd7, 0xc7, 0x01, 0xf1, 0xcf, 0x89, 0xbf, 0x8d, 0x3d, 0x6b, 0xdb, 0x70, 0x7b, 0x3d,
+	0xd7, 0x91, 0x3f, 0x62, 0x6d, 0xe5, 0xee, 0x99, 0xeb, 0x9e, 0xd9, 0x84, 0xf3, 0xb1, 0xe3, 0xb8,
+	0x14, 0x53, 0xcb, 0x75, 0x02, 0xc9, 0x5d, 0x97, 0x5c, 0xfe, 0x75, 0xda, 0xef, 0x6c, 0x53, 0xab,
+	0x47, 0x02, 0x8a, 0x7b, 0x9e, 0x14, 0x58, 0x8b, 0x0b, 0x98, 0x7d, 0x9f, 0x6b, 0x90, 0xfc, 0x3b,
+	0x71, 0x3e, 0xe9, 0x79, 0xf4, 0x52, 0x32, 0x17, 0xa2, 0x48, 0xd4, 0xaf, 0x32, 0x90, 0x6b, 0x70,
+	0xb7, 0xd0, 0x2a, 0xcc, 0x9a, 0xe4, 0x5c, 0x27, 0x7d, 0xab, 0xac, 0x6c, 0x28, 0xb5, 0x79, 0x2d,
+	0x67, 0x92, 0xf3, 0xe6, 0x71, 0x0b, 0x21, 0xb8, 0xe1, 0xe0, 0x1e, 0x29, 0x67, 0x38, 0x95, 0xff,
+	0x47, 0x1f, 0xc0, 0x22, 0xf6, 0x3c, 0xdb, 0x32, 0xb8, 0x5d, 0xdd, 0x32, 0xcb, 0xd9, 0x0d, 0xa5,
+	0x96, 0xd5, 0x0a, 0x11, 0x6a, 0xab, 0x81, 0x36, 0x20, 0x6f, 0x92, 0xc0, 0xf0, 0x2d, 0x8f, 0x11,
+	0xca, 0x37, 0xb8, 0x86, 0x28, 0x09, 0x6d, 0xc1, 0xb2, 0x08, 0xab, 0xee, 0xf9, 0x6e, 0xc7, 0xb2,
+	0x09, 0xd3, 0x35, 0xc3, 0xe5, 0x96, 0x04, 0xe3, 0xb5, 0xa0, 0xb7, 0x1a, 0xe8, 0x3e, 0x14, 0x83,
+	0xae, 0xe5, 0xe9, 0x1d, 0xdd, 0x70, 0xa8, 0x6e, 0xbc, 0x21, 0x46, 0xb7, 0x9c, 0xdb, 0x50, 0x6a,
+	0x73, 0x5a, 0x81, 0xd1, 0x9f, 0xef, 0x39, 0x74, 0x8f, 0x11, 0xd1, 0x23, 0x40, 0x3e, 0xe9, 0x10,
+	0x9f, 0x38, 0x06, 0xd1, 0xb1, 0x4d, 0x2d, 0xda, 0x37, 0x49, 0x79, 0x76, 0x43, 0xa9, 0x29, 0xda,
+	0x72, 0xc8, 0xd9, 0x95, 0x0c, 0xf5, 0xeb, 0x19, 0x58, 0x14, 0x41, 0x38, 0xb0, 0x02, 0xda, 0xa2,
+	0xa4, 0xf7, 0x0d, 0x08, 0x46, 0x1d, 0x6e, 0xc6, 0x64, 0x39, 0xae, 0x1c, 0x97, 0x5e, 0x1e, 0x91,
+	0x3e, 0x64, 0x20, 0x77, 0xe0, 0x96, 0x94, 0x0f, 0x28, 0xa6, 0xfd, 0x40, 0x3f, 0xc5, 0x94, 0x12,
+	0xff, 0x92, 0x87, 0xa5, 0xa0, 0x49, 0x65, 0x6d, 0xce, 0x7b, 0x26, 0x58, 0xe8, 0x31, 0x94, 0x46,
+	0xd7, 0xf4, 0xb0, 0x7f, 0x66, 0x39, 0xe5, 0xb9, 0x0d, 0xa5, 0x36, 0xa3, 0xa1, 0xe8, 0x92, 0x97,
+	0x9c, 0x83, 0x0e, 0x60, 0x73, 0x74, 0x05, 0x79, 0x4b, 0x89, 0xef, 0x60, 0x5b, 0xf7, 0xdc, 0x0b,
+	0xe2, 0xeb, 0x81, 0xdb, 0xf7, 0x0d, 0x52, 0x06, 0xbe, 0x6b, 0xeb, 0x51, 0x05, 0x4d, 0x29, 0xf8,
+	0x9a, 0xc9, 0xb5, 0xb9, 0x18, 0x3a, 0x82, 0xfb, 0x89, 0x98, 0x75, 0x9b, 0x9c, 0x13, 0x5b, 0xef,
+	0x3b, 0xf8, 0x1c, 0x5b, 0x36, 0x3e, 0xb5, 0x49, 0x39, 0xcf, 0x35, 0x6e, 0x26, 0x78, 0x71, 0xc0,
+	0x64, 0x8f, 0x87, 0xa2, 0xe8, 0xbb, 0x70, 0x67, 0x82, 0xd6, 0xf2, 0xc2, 0x86, 0x52, 0xcb, 0x68,
+	0xe5, 0x34, 0x4d, 0xe8, 0x53, 0x58, 0xb0, 0x71, 0x40, 0xf5, 0x80, 0x10, 0x47, 0xc7, 0xb4, 0x3c,
+	0xbf, 0xa1, 0xd4, 0xf2, 0x3b, 0x95, 0xba, 0x38, 0x74, 0xf5, 0xc1, 0xa1, 0xab, 0x1f, 0x0d, 0x4e,
+	0xad, 0x06, 0x4c, 0xbe, 0x4d, 0x88, 0xb3, 0x4b, 0xd1, 0x33, 0x58, 0xb2, 0xad, 0x0e, 0x31, 0x2e,
+	0x0d, 0x5b, 0xd8, 0x27, 0xe5, 0xc2, 0x86, 0x52, 0x5b, 0xdc, 0xb9, 0x5d, 0xc7, 0x9e, 0x55, 0x1f,
+	0xa4, 0xa1, 0x94, 0x60, 0xe6, 0x89, 0xb6, 0x68, 0x8f, 0x7c, 0xab, 0x3f, 0x02, 0x10, 0x72, 0xfb,
+	0xe4, 0x32, 0x48, 0x4f, 0xd5, 0x55, 0x98, 0x75, 0x2e, 0xba, 0x7a, 0x97, 0x5c, 0xca, 0x6c, 0xcd,
+	0x39, 0x17, 0xdd, 0x7d, 0x72, 0xc9, 0x18, 0xd8, 0xf3, 0x38, 0x23, 0x2b, 0x18, 0xd8, 0xf3, 0xf6,
+	0xc9, 0xa5, 0xfa, 0x14, 0x6e, 0xee, 0xf9, 0x04, 0x53, 0x22, 0xd4, 0x6b, 0xe4, 0x67, 0x7d, 0x12,
+	0x50, 0xb4, 0x09, 0x39, 0x11, 0x0d, 0x6e, 0x20, 0xbf, 0x93, 0x8f, 0x40, 0xd5, 0x24, 0x4b, 0x7d,
+	0x00, 0xc5, 0x17, 0x84, 0x8e, 0x2e, 0x4c, 0x83, 0xa6, 0xfe, 0x3d, 0x03, 0xcb, 0x11, 0xe9, 0xc0,
+	0x73, 0x9d, 0x80, 0x5c, 0xc9, 0xce, 0x58, 0xf8, 0x67, 0xae, 0x15, 0xfe, 0xd4, 0x53, 0x90, 0xbb,
+	0xfe, 0x29, 0x28, 0xa5, 0x9e, 0x82, 0x87, 0x30, 0x67, 0xbb, 0xe2, 0xdc, 0x97, 0x6f, 0x71, 0x7c,
+	0xc5, 0xba, 0x2c, 0xbb, 0x07, 0x92, 0xae, 0x85, 0x12, 0x49, 0x29, 0xb1, 0x72, 0xdd, 0x94, 0xf8,
+	0x5b, 0x06, 0x96, 0x59, 0xf1, 0x1a, 0x8d, 0x7f, 0x09, 0x66, 0x6c, 0xab, 0x67, 0x51, 0x1e, 0xcf,
+	0xac, 0x26, 0x3e, 0xd0, 0x0a, 0xe4, 0xdc, 0x4e, 0x27, 0x20, 0x94, 0xa7, 0x45, 0x56, 0x93, 0x5f,
+	0x57, 0x2d, 0x63, 0x2b, 0x90, 0x0b, 0x08, 0xf6, 0x8d, 0x37, 0xb2, 0x82, 0xc9, 0x2f, 0xf4, 0x10,
+	0x50, 0xaf, 0x6f, 0x53, 0xcb, 0x60, 0xbb, 0x73, 0xe6, 0xbb, 0x7d, 0x6f, 0x58, 0xbd, 0x8a, 0x21,
+	0xe7, 0x05, 0x63, 0xb4, 0x1a, 0x4c, 0x9a, 0x35, 0xc9, 0x58, 0xad, 0x13, 0xd5, 0xab, 0x28, 0x39,
+	0xc3, 0x62, 0x57, 0x83, 0xa2, 0xdc, 0x82, 0x8e, 0x65, 0x53, 0xe2, 0x33, 0xd9, 0x59, 0x0e, 0x6e,
+	0x51, 0xd0, 0x9f, 0x73, 0x72, 0xab, 0x81, 0x1a, 0x50, 0x8c, 0x05, 0x33, 0x28, 0xcf, 0x6d, 0x64,
+	0x27, 0x47, 0x73, 0x69, 0x34, 0x9a, 0x81, 0x7a, 0x0a, 0x28, 0x1a, 0x4d, 0x99, 0x9f, 0xeb, 0x90,
+	0xa7, 0x2e, 0xc5, 0xb6, 0x6e, 0xb8, 0x7d, 0x67, 0x10, 0x54, 0xe0, 0xa4, 0x3d, 0x46, 0x41, 0x0f,
+	0x20, 0xe7, 0x93, 0xa0, 0x6f, 0xb3, 0xc8, 0x66, 0x6b, 0xf9, 0x9d, 0x9b, 0x23, 0x26, 0x45, 0x6b,
+	0xd1, 0xa4, 0x88, 0x5a, 0x87, 0x9b, 0x0d, 0x62, 0x13, 0x4a, 0xae, 0x78, 0x66, 0x9e, 0xc2, 0xcd,
+	0x63, 0xcf, 0xfc, 0xef, 0x0e, 0xe7, 0x97, 0x0a, 0xdc, 0x8b, 0x2e, 0x8e, 0xf9, 0x3f, 0xc5, 0x74,
+	0x52, 0x86, 0x66, 0xae, 0x9b, 0xa1, 0xfb, 0xb0, 0x1a, 0xad, 0x2d, 0xac, 0x74, 0x0d, 0xec, 0x3e,
+	0x66, 0x8d, 0x91, 0xef, 0x6e, 0x97, 0x5c, 0x06, 0xd2, 0x8f, 0xa5, 0x88, 0x6a, 0x2e, 0x0c, 0x66,
+	0xf8, 0x5f, 0xdd, 0x86, 0x52, 0x58, 0x3e, 0xa2, 0x9a, 0x52, 0x83, 0xd7, 0x82, 0x5b, 0xb1, 0x05,
+	0x72, 0x4f, 0xaf, 0x6f, 0x7b, 0x1f, 0x56, 0xa3, 0xa1, 0xfc, 0xdf, 0x1c, 0xd9, 0x81, 0xd5, 0x68,
+	0x12, 0x5c, 0xc9, 0x97, 0xaf, 0x33, 0x50, 0x14, 0xe2, 0xbb, 0x06, 0xb5, 0xce, 0x45, 0x11, 0x49,
+	0xdd, 0xbb, 0xdb, 0x30, 0xc7, 0x18, 0xd8, 0x34, 0x7d, 0xd9, 0x06, 0x98, 0xe0, 0xae, 0x69, 0xfa,
+	0xa8, 0x02, 0xf3, 0xac, 0x0f, 0x04, 0x91, 0x4e, 0xc0, 0x1a, 0x43, 0x9b, 0xf5, 0x88, 0x7b, 0x50,
+	0x60, 0xcd, 0x23, 0xd0, 0x89, 0x63, 0x70, 0xbe, 0x38, 0xec, 0xe0, 0x5c, 0x74, 0xdb, 0x4d, 0xc7,
+	0x60, 0x22, 0xef, 0xc3, 0x52, 0xa0, 0x0b, 0x21, 0xcb, 0xa1, 0x5c, 0x68, 0x4e, 0xdc, 0x69, 0x82,
+	0xc3, 0x8b, 0x6e, 0xbb, 0xe5, 0x50, 0x29, 0xd5, 0x89, 0x49, 0xcd, 0x0b, 0xa9, 0x4e, 0x44, 0xaa,
+	0x0c, 0x73, 0xe2, 0x56, 0xd7, 0xf7, 0x78, 0xc9, 0x28, 0x68, 0xb9, 0xce, 0x9e, 0x43, 0x8f, 0x3d,
+	0xb4, 0x0e, 0x0b, 0x8e, 0xbc, 0xf1, 0x99, 0xee, 0x85, 0x23, 0x0b, 0xf5, 0xbc, 0xc3, 0x6e, 0x7b,
+	0x0d, 0xf7, 0xc2, 0x61, 0x02, 0x38, 0x2a, 0x00, 0x42, 0x00, 0x0f, 0x04, 0xd4, 0x9f, 0xc2, 0x2d,
+	0x19, 0xa8, 0xd8, 0xd1, 0x79, 0x16, 0x5e, 0xb7, 0x70, 0x18, 0x48, 0xb9, 0x69, 0xb7, 0x22, 0x9b,
+	0x36, 0x8c, 0xb2, 0x56, 0x34, 0x63, 0x14, 0xb1, 0x81, 0x38, 0x51, 0x7d, 0xea, 0x06, 0x3e, 0x81,
+	0x4a, 0x98, 0x8c, 0x11, 0xe5, 0xd3, 0x96, 0x61, 0xb8, 0x93, 0xb8, 0x4c, 0x66, 0xf2, 0xbb, 0xf0,
+	0xe6, 0x2f, 0x0a, 0x73, 0x67, 0x94, 0xb8, 0xe7, 0x3a, 0x94, 0xbc, 0x7d, 0x27, 0xd1, 0x42, 0x55,
+	0x80, 0xcf, 0x5c, 0xcb, 0xd1, 0x1d, 0xd7, 0x31, 0x44, 0x0d, 0x29, 0x68, 0xf3, 0x8c, 0x72, 0xc8,
+	0x08, 0xe8, 0x3b, 0x90, 0x27, 0x6f, 0x3d, 0xd7, 0xa7, 0xc4, 0x64, 0xad, 0x3d, 0x3b, 0xbd, 0xb5,
+	0x0f, 0xc4, 0x77, 0xa9, 0xfa, 0x09, 0x54, 0x9b, 0xfc, 0xeb, 0xda, 0x81, 0xed, 0xc1, 0x5a, 0xda,
+	0x4a, 0x19, 0xdb, 0x7d, 0x40, 0x43, 0xa7, 0x75, 0x43, 0x44, 0x44, 0x3a, 0x7f, 0x37, 0xd1, 0x79,
+	0x19, 0x35, 0x6d, 0x19, 0xc7, 0x49, 0xaa, 0x0d, 0xd5, 0x56, 0x6f, 0x12, 0xd0, 0x77, 0x6a, 0xed,
+	0x73, 0x28, 0x45, 0xad, 0x85, 0xe5, 0x65, 0xbc, 0xdb, 0x2b, 0x49, 0xdd, 0x3e, 0x71, 0x24, 0xc9,
+	0x24, 0x8f, 0x24, 0x45, 0xc8, 0x1a, 0xc1, 0xb9, 0xac, 0x24, 0xec, 0xaf, 0x6a, 0xc3, 0xad, 0x98,
+	0xf1, 0xf0, 0xaa, 0x57, 0x30, 0x78, 0x37, 0x30, 0x23, 0xcd, 0xb4, 0xa0, 0x2d, 0x48, 0xa2, 0x68,
+	0xa7, 0x75, 0xc8, 0x11, 0xdf, 0x77, 0xfd, 0x40, 0xb6, 0xd3, 0x15, 0xee, 0x7b, 0x54, 0x61, 0x93,
+	0xb1, 0x35, 0x29, 0xa5, 0x1e, 0xc1, 0xf2, 0x18, 0x93, 0x81, 0xf2, 0xdd, 0x0b, 0xa9, 0x9f, 0xfd,
+	0x8d, 0xe6, 0x41, 0x66, 0xa4, 0x54, 0x96, 0x60, 0x86, 0x6b, 0x92, 0x1e, 0x88, 0x0f, 0x76, 0xc2,
+	0x5f, 0x10, 0xaa, 0x61, 0xc7, 0x74, 0x7b, 0x0d, 0x51, 0x39, 0xaf, 0x70, 0xc2, 0xcb, 0xe3, 0x6b,
+	0xa4, 0xeb, 0xd1, 0x82, 0xac, 0x8c, 0x14, 0x64, 0xf5, 0x5b, 0x70, 0xb7, 0x4d, 0x7d, 0x82, 0x7b,
+	0xc2, 0x81, 0xe7, 0x3e, 0xee, 0x91, 0x03, 0xf7, 0x6c, 0x7a, 0x4b, 0xf8, 0xa3, 0x02, 0xd5, 0x94,
+	0x95, 0xd2, 0xea, 0x27, 0xb0, 0xd0, 0xf7, 0x6c, 0xcb, 0xe9, 0xea, 0x1d, 0xc6, 0x93, 0xd9, 0x24,
+	0x2e, 0x28, 0xc7, 0x9c, 0x31, 0x58, 0xf3, 0x83, 0xf7, 0xb4, 0x7c, 0x7f, 0x48, 0x41, 0xdf, 0x83,
+	0x45, 0x56, 0x57, 0x23, 0x6b, 0x33, 0xd1, 0x43, 0x2f, 0x59, 0x91, 0xd5, 0x05, 0x33, 0x4a, 0x7b,
+	0x36, 0x0b, 0x33, 0x7c, 0x59, 0xdc, 0xbb, 0xe6, 0x39, 0x71, 0xe8, 0x95, 0xbc, 0x3b, 0x81, 0x6a,
+	0xca, 0x42, 0xe9, 0x1c, 0x82, 0x1b, 0xf4, 0xd2, 0x23, 0x72, 0x19, 0xff, 0x8f, 0xee, 0xc1, 0x82,
+	0x87, 0x2f, 0x6d, 0x17, 0x9b, 0xfa, 0x67, 0x81, 0xeb, 0xc8, 0xad, 0xce, 0x4b, 0xda, 0x0f, 0xdb,
+	0xaf, 0x0e, 0xd5, 0x7f, 0x2b, 0x50, 0x0d, 0x2b, 0xea, 0xe0, 0x62, 0x7e, 0xe4, 0x63, 0xa3, 0x3b,
+	0xf5, 0x46, 0xb4, 0x07, 0x4b, 0x01, 0xc5, 0x3e, 0xd5, 0xc3, 0xb7, 0x99, 0x72, 0x66, 0x6a, 0xb5,
+	0x5a, 0xe4, 0x4b, 0xc2, 0x6f, 0xf4, 0x7d, 0x28, 0x10, 0xc7, 0x8c, 0xa8, 0x98, 0x5e, 0xf0, 0x16,
+	0x88, 0x63, 0x0e, 0x15, 0xdc, 0x85, 0x79, 0xea, 0xda, 0xc4, 0xc7, 0xac, 0x9a, 0xde, 0xe0, 0xcf,
+	0x1b, 0x43, 0x02, 0x2b, 0xb6, 0x3d, 0xfc, 0x56, 0xf7, 0x5c, 0xcb, 0xa1, 0x81, 0xec, 0xaa, 0xf3,
+	0x3d, 0xfc, 0xf6, 0x35, 0x27, 0xa8, 0xff, 0x54, 0xa0, 0x9c, 0xe0, 0x3a, 0xe7, 0xa2, 0x4f, 0x60,
+	0x7e, 0x08, 0x4b, 0x99, 0x0a, 0x6b, 0x28, 0xcc, 0x0e, 0xad, 0x1c, 0xf2, 0xc5, 0x15, 0x71, 0x25,
+	0x3e, 0xf9, 0x88, 0xd9, 0x5e, 0x93, 0x52, 0xa8, 0x02, 0x73, 0x36, 0x96, 0x2f, 0x34, 0x59, 0xee,
+	0x42, 0xf8, 0xcd, 0xfc, 0xb3, 0x5d, 0xe7, 0x4c, 0x30, 0xa5, 0x7f, 0x21, 0x81, 0xad, 0x0c, 0xdf,
+	0x76, 0x66, 0xc4, 0xca, 0xc1, 0xb7, 0xea, 0xc3, 0x5a, 0xda, 0xce, 0xca, 0x9c, 0x79, 0x02, 0x39,
+	0x19, 0x19, 0x85, 0x17, 0x97, 0x6a, 0xf4, 0x2a, 0x3b, 0x16, 0x10, 0x4d, 0x0a, 0xb3, 0xd3, 0x7b,
+	0x46, 0xdc, 0x68, 0x4a, 0xcd, 0x9e, 0x11, 0x97, 0xa7, 0xd3, 0x5f, 0x15, 0xb8, 0x3d, 0x34, 0x6a,
+	0x39, 0x5d, 0x76, 0xf1, 0x0d, 0xbe, 0x19, 0xa9, 0xa4, 0x52, 0x58, 0x8a, 0x01, 0x67, 0xa7, 0xca,
+	0x64, 0x57, 0x7d, 0x79, 0xaa, 0xd8, 0x7f, 0x54, 0x86, 0x59, 0x51, 0x1b, 0x02, 0xd9, 0xbd, 0x07,
+	0x9f, 0x4c, 0xda, 0x76, 0x03, 0xd1, 0xb4, 0x0b, 0x1a, 0xff, 0xcf, 0x06, 0x26, 0x0f, 0x1b, 0x5d,
+	0x42, 0x75, 0xdb, 0x0d, 0x02, 0xb9, 0x83, 0x20, 0x48, 0x07, 0x6e, 0x10, 0xa8, 0xbf, 0x57, 0x22,
+	0x57, 0xa1, 0x48, 0xc8, 0xe4, 0x1e, 0x3d, 0x0c, 0xe7, 0x29, 0xb1, 0x47, 0xa5, 0x91, 0x71, 0x63,
+	0x20, 0x2d, 0x65, 0xe2, 0xd6, 0x32, 0x71, 0x6b, 0xac, 0xe5, 0xf5, 0x9d, 0x37, 0x04, 0xdb, 0xf4,
+	0xcd, 0xa5, 0xce, 0x50, 0x73, 0xb0, 0x73, 0x5a, 0x21, 0xa4, 0x32, 0xa5, 0xea, 0xef, 0x14, 0xa8,
+	0x46, 0x47, 0x95, 0x66, 0xef, 0x94, 0x98, 0x47, 0x6e, 0x97, 0x4c, 0xbd, 0x49, 0xa0, 0x1a, 0xdc,
+	0x38, 0xb7, 0xc8, 0x85, 0x4c, 0xfd, 0x28, 0x5c, 0xae, 0xe4, 0xc4, 0x22, 0x17, 0x1a, 0x97, 0x40,
+	0x0f, 0x20, 0x4b, 0xa9, 0x2d, 0xb7, 0xe9, 0xf6, 0xd8, 0x36, 0x35, 0xe4, 0x8b, 0xae, 0xc6, 0xa4,
+	0xd4, 0x5f, 0x29, 0xb0, 0x96, 0x86, 0x48, 0x86, 0xaa, 0x04, 0x33, 0x94, 0x11, 0x24, 0x20, 0xf1,
+	0xc1, 0x9a, 0x5f, 0xdf, 0xb7, 0x65, 0xa2, 0xb2, 0xbf, 0xe8, 0xdb, 0xc0, 0xee, 0x4c, 0x96, 0x4f,
+	0x82, 0xab, 0xdd, 0xb0, 0xe6, 0xa5, 0xf4, 0x2e, 0xdd, 0x7a, 0x02, 0x4b, 0x11, 0xf3, 0xcc, 0x17,
+	0xb4, 0x0c, 0x85, 0x46, 0xf3, 0xa4, 0xb5, 0xd7, 0xd4, 0x1b, 0xcd, 0xa3, 0xdd, 0xd6, 0x41, 0xf1,
+	0x3d, 0xb4, 0x04, 0xf9, 0x83, 0xd6, 0x49, 0x53, 0x7f, 0xae, 0xed, 0xbe, 0x6c, 0xb6, 0x8b, 0xca,
+	0xd6, 0x2b, 0x28, 0x25, 0x0d, 0x88, 0x4c, 0xf0, 0xb5, 0xf6, 0xea, 0xa4, 0xd5, 0x6e, 0xbd, 0x3a,
+	0x6c, 0x36, 0x8a, 0xef, 0x21, 0x80, 0xdc, 0xee, 0xde, 0x51, 0xeb, 0xa4, 0x59, 0x54, 0x50, 0x01,
+	0xe6, 0xdb, 0xc7, 0xed, 0xd7, 0xcd, 0xc3, 0x46, 0xb3, 0x51, 0xcc, 0xa0, 0x3c, 0xcc, 0x6a, 0xcd,
+	0xa3, 0x96, 0xd6, 0x6c, 0x14, 0xb3, 0x3b, 0x7f, 0x2e, 0x41, 0x41, 0x68, 0x6c, 0x8b, 0x77, 0x02,
+	0xd4, 0x86, 0x9c, 0x08, 0x0f, 0x2a, 0xf3, 0x90, 0x27, 0x3c, 0x62, 0x55, 0x56, 0xc6, 0x9c, 0x6c,
+	0xb2, 0x57, 0x71, 0x75, 0xf5, 0xab, 0x7f, 0xfc, 0xeb, 0x0f, 0x99, 0x65, 0x75, 0x81, 0xbf, 0xc6,
+	0x8b, 0x2b, 0x4d, 0xf0, 0x54, 0xd9, 0x42, 0x47, 0x90, 0x7d, 0x41, 0x28, 0x12, 0x6d, 0x2e, 0xfe,
+	0xb4, 0x55, 0x59, 0x89, 0x93, 0xc5, 0x3e, 0xa8, 0x6b, 0x5c, 0x5d, 0x19, 0xad, 0x44, 0xd5, 0x6d,
+	0x7f, 0x2e, 0xd3, 0xe5, 0x0b, 0xf4, 0x12, 0x6e, 0xb0, 0x97, 0x00, 0x24, 0xd6, 0x8f, 0x3d, 0xd9,
+	0x54, 0x56, 0xc7, 0xe8, 0x52, 0x71, 0x89, 0x2b, 0x5e, 0x44, 0x23, 0x38, 0xd1, 0x4f, 0x20, 0x27,
+	0xe6, 0x47, 0xe9, 0x79, 0xc2, 0x8b, 0x42, 0xaa, 0xe7, 0x12, 0xea, 0x56, 0x1a, 0x54, 0x13, 0x72,
+	0x62, 0xd0, 0x95, 0xba, 0x13, 0x5e, 0x1f, 0x52, 0x75, 0xd7, 0xb8, 0x6e, 0xb5, 0x52, 0x1d, 0xd3,
+	0x6d, 0x19, 0xa4, 0x3e, 0x30, 0xc1, 0xc2, 0x7c, 0x0e, 0x20, 0xb6, 0x8b, 0x3f, 0x66, 0xde, 0x1d,
+	0xdb, 0xbf, 0xc8, 0x48, 0x9c, 0x6a, 0x6d, 0x87, 0x5b, 0x7b, 0xa8, 0xde, 0x4f, 0xb2, 0xc6, 0x67,
+	0xf1, 0xd0, 0xe4, 0x36, 0xfb, 0x62, 0x76, 0x09, 0xcc, 0xbe, 0x20, 0x94, 0x1b, 0xbd, 0x3d, 0xba,
+	0x97, 0x51, 0x8b, 0x95, 0x24, 0x96, 0xdc, 0x91, 0x4d, 0x6e, 0xb5, 0x8a, 0xee, 0x24, 0xc7, 0x8f,
+	0x5b, 0x62, 0xee, 0x89, 0xb8, 0x45, 0xdc, 0x4b, 0x79, 0x3e, 0x98, 0xe6, 0x5e, 0xe5, 0x3a, 0xee,
+	0x9d, 0x01, 0x88, 0x5c, 0x88, 0xd8, 0x4d, 0x79, 0x69, 0x48, 0xb5, 0x2b, 0x1d, 0xdc, 0x9a, 0xe8,
+	0xe0, 0x2f, 0x60, 0x6e, 0x30, 0x5d, 0x23, 0x11, 0xad, 0xc4, 0x61, 0x3b, 0xd5, 0xc8, 0xa7, 0xdc,
+	0xc8, 0xff, 0xab, 0x1f, 0x27, 0x3a, 0x37, 0x9c, 0x67, 0x86, 0x2e, 0x4a, 0x1a, 0x61, 0x6e, 0xf6,
+	0x98, 0x9b, 0x03, 0x42, 0xe8, 0x26, 0xbe, 0x16, 0x82, 0x8f, 0x38, 0x82, 0xcd, 0xad, 0x7b, 0x29,
+	0x6e, 0x0e, 0x31, 0xa0, 0x5f, 0x2b, 0x50, 0x12, 0xbb, 0x17, 0x2b, 0x66, 0x1f, 0x8e, 0x6d, 0x6c,
+	0xe2, 0x13, 0x5b, 0x2a, 0x86, 0x8f, 0x39, 0x86, 0x07, 0x95, 0x0f, 0x53, 0x30, 0x84, 0x8f, 0x69,
+	0x8f, 0x02, 0x2a, 0x5d, 0xff, 0x02, 0x0a, 0x2f, 0x08, 0x8d, 0x0c, 0xd7, 0xeb, 0xa3, 0xb9, 0x3a,
+	0x36, 0x57, 0x56, 0x36, 0xd2, 0x05, 0x64, 0x4a, 0xcb, 0x50, 0xa0, 0x2b, 0x84, 0xe2, 0xb7, 0x0a,
+	0x14, 0xc5, 0xd4, 0x1c, 0x81, 0xa0, 0x72, 0x0b, 0x13, 0xc7, 0xf0, 0xca, 0xe6, 0x44, 0x19, 0x09,
+	0xe4, 0x31, 0x07, 0xb2, 0x85, 0x6a, 0x53, 0x81, 0x6c, 0x8b, 0x27, 0x00, 0xf4, 0x27, 0x05, 0x8a,
+	0x62, 0xfa, 0x1b, 0xc3, 0x33, 0x71, 0xda, 0x4e, 0xdd, 0x92, 0x1f, 0x73, 0x08, 0x9a, 0xfa, 0x72,
+	0x14, 0xc2, 0xf8, 0x64, 0x5e, 0x9f, 0x9e, 0xab, 0x0c, 0xa7, 0xc5, 0x51, 0xb0, 0x9d, 0xd3, 0x21,
+	0x27, 0x20, 0xc9, 0xca, 0x93, 0x34, 0x9f, 0x57, 0x2a, 0x49, 0xac, 0xd1, 0x26, 0xa3, 0xde, 0x1c,
+	0x81, 0x36, 0x34, 0xf0, 0xa5, 0x02, 0xc5, 0xf8, 0xfc, 0x29, 0x0f, 0x47, 0xca, 0x28, 0x5b, 0xa9,
+	0xa6, 0x70, 0xa5, 0xc5, 0x6d, 0x6e, 0xf1, 0xa3, 0x84, 0x0a, 0x2b, 0xfc, 0x3c, 0x8b, 0x5b, 0xfb,
+	0xa5, 0x80, 0x30, 0x72, 0x93, 0x46, 0xea, 0x68, 0x02, 0x26, 0x8d, 0x5c, 0x95, 0xcd, 0x89, 0x32,
+	0x12, 0xce, 0xfb, 0x1c, 0xce, 0x1a, 0xba, 0x9b, 0x02, 0x87, 0x72, 0x73, 0x3f, 0x87, 0x05, 0x06,
+	0x21, 0xbc, 0xd0, 0xae, 0xc5, 0x54, 0xc7, 0xae, 0xe8, 0x95, 0xf5, 0x54, 0xfe, 0x15, 0x8f, 0x07,
+	0xbb, 0x53, 0xf2, 0x03, 0x1a, 0x30, 0xff, 0x97, 0xc4, 0xd0, 0x1a, 0xce, 0xe2, 0xe8, 0x1e, 0xd7,
+	0x3f, 0x69, 0xc2, 0xaf, 0xa8, 0x93, 0x44, 0x24, 0x8a, 0x0f, 0x38, 0x8a, 0x75, 0x54, 0x4d, 0x41,
+	0xc1, 0xa7, 0xed, 0xe0, 0xb1, 0x12, 0xc1, 0x10, 0x8e, 0xcc, 0x09, 0x18, 0xe2, 0x73, 0x78, 0x45,
+	0x9d, 0x24, 0x72, 0x45, 0x0c, 0x84, 0xad, 0x60, 0x18, 0x7e, 0xa3, 0x40, 0x51, 0xb4, 0xf3, 0xe1,
+	0xa5, 0x55, 0xe6, 0xc1, 0xc4, 0x3b, 0x76, 0x65, 0x73, 0xa2, 0x8c, 0x84, 0xf1, 0x88, 0xc3, 0xb8,
+	0xaf, 0xaa, 0x69, 0x30, 0xd8, 0x92, 0x47, 0xfc, 0x2e, 0xfc, 0x54, 0xd9, 0x3a, 0xcd, 0xf1, 0x23,
+	0xfe, 0x7f, 0xff, 0x19, 0x00, 0xf0, 0x5f, 0xad, 0x5b, 0xca, 0x21, 0x00, 0x00,
 }
@@ -0,0 +1,711 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: deviceConflict.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type DeviceConflictStatus int32
+
+const (
+	// The conflict has not yet been resolved.
+	DeviceConflictStatus_PENDING DeviceConflictStatus = 0
+	// The conflict has been resolved by force-take or link.
+	DeviceConflictStatus_RESOLVED DeviceConflictStatus = 1
+	// The requested device has been rejected.
+	DeviceConflictStatus_REJECTED DeviceConflictStatus = 2
+)
+
+var DeviceConflictStatus_name = map[int32]string{
+	0: "PENDING",
+	1: "RESOLVED",
+	2: "REJECTED",
+}
+var DeviceConflictStatus_value = map[string]int32{
+	"PENDING":  0,
+	"RESOLVED": 1,
+	"REJECTED": 2,
+}
+
+func (x DeviceConflictStatus) String() string {
+	return proto.EnumName(DeviceConflictStatus_name, int32(x))
+}
+func (DeviceConflictStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_deviceConflict_7cf3843eb9a0318b, []int{0}
+}
+
+type DeviceConflictResolution int32
+
+const (
+	// Reject the requested device, keeping the existing device.
+	DeviceConflictResolution_REJECT DeviceConflictResolution = 0
+	// Delete the existing device (locally and on the network-server) and
+	// create the requested device.
+	DeviceConflictResolution_FORCE_TAKE DeviceConflictResolution = 1
+	// Create the requested device and link it to the existing
+	// network-server device, keeping its activation and keys.
+	// This is only possible for CONFLICT_NETWORK_SERVER conflicts.
+	DeviceConflictResolution_LINK DeviceConflictResolution = 2
+)
+
+var DeviceConflictResolution_name = map[int32]string{
+	0: "REJECT",
+	1: "FORCE_TAKE",
+	2: "LINK",
+}
+var DeviceConflictResolution_value = map[string]int32{
+	"REJECT":     0,
+	"FORCE_TAKE": 1,
+	"LINK":       2,
+}
+
+func (x DeviceConflictResolution) String() string {
+	return proto.EnumName(DeviceConflictResolution_name, int32(x))
+}
+func (DeviceConflictResolution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_deviceConflict_7cf3843eb9a0318b, []int{1}
+}
+
+type DeviceConflict struct {
+	// Device conflict ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// DevEUI of the device (HEX encoded).
+	DevEui string `protobuf:"bytes,2,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Organization ID of the requested device.
+	OrganizationId int64 `protobuf:"varint,3,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Application ID of the requested device.
+	ApplicationId int64 `protobuf:"varint,4,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Device-profile ID of the requested device.
+	DeviceProfileId string `protobuf:"bytes,5,opt,name=device_profile_id,json=deviceProfileID,proto3" json:"device_profile_id,omitempty"`
+	// Name of the requested device.
+	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the requested device.
+	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	// Where the DevEUI is in use.
+	Source DeviceConflictSource `protobuf:"varint,8,opt,name=source,proto3,enum=api.DeviceConflictSource" json:"source,omitempty"`
+	// Organization ID of the existing device.
+	// This is only set for local conflicts and for global admin users.
+	ExistingOrganizationId int64 `protobuf:"varint,9,opt,name=existing_organization_id,json=existingOrganizationID,proto3" json:"existing_organization_id,omitempty"`
+	// Application ID of the existing device.
+	// This is only set for local conflicts and for global admin users.
+	ExistingApplicationId int64 `protobuf:"varint,10,opt,name=existing_application_id,json=existingApplicationID,proto3" json:"existing_application_id,omitempty"`
+	// Status of the conflict.
+	Status DeviceConflictStatus `protobuf:"varint,11,opt,name=status,proto3,enum=api.DeviceConflictStatus" json:"status,omitempty"`
+	// Resolution of the conflict.
+	// This is only set when the status is RESOLVED.
+	Resolution DeviceConflictResolution `protobuf:"varint,12,opt,name=resolution,proto3,enum=api.DeviceConflictResolution" json:"resolution,omitempty"`
+	// Username of the user who resolved the conflict.
+	ResolvedBy string `protobuf:"bytes,13,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeviceConflict) Reset()         { *m = DeviceConflict{} }
+func (m *DeviceConflict) String() string { return proto.CompactTextString(m) }
+func (*DeviceConflict) ProtoMessage()    {}
+func (*DeviceConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceConflict_7cf3843eb9a0318b, []int{0}
+}
+func (m *DeviceConflict) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceConflict.Unmarshal(m, b)
+}
+func (m *DeviceConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceConflict.Marshal(b, m, deterministic)
+}
+func (dst *DeviceConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceConflict.Merge(dst, src)
+}
+func (m *DeviceConflict) XXX_Size() int {
+	return xxx_messageInfo_DeviceConflict.Size(m)
+}
+func (m *DeviceConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceConflict proto.InternalMessageInfo
+
+func (m *DeviceConflict) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeviceConflict) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *DeviceConflict) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *DeviceConflict) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *DeviceConflict) GetDeviceProfileId() string {
+	if m != nil {
+		return m.DeviceProfileId
+	}
+	return ""
+}
+
+func (m *DeviceConflict) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceConflict) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DeviceConflict) GetSource() DeviceConflictSource {
+	if m != nil {
+		return m.Source
+	}
+	return DeviceConflictSource_CONFLICT_SAME_ORGANIZATION
+}
+
+func (m *DeviceConflict) GetExistingOrganizationId() int64 {
+	if m != nil {
+		return m.ExistingOrganizationId
+	}
+	return 0
+}
+
+func (m *DeviceConflict) GetExistingApplicationId() int64 {
+	if m != nil {
+		return m.ExistingApplicationId
+	}
+	return 0
+}
+
+func (m *DeviceConflict) GetStatus() DeviceConflictStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DeviceConflictStatus_PENDING
+}
+
+func (m *DeviceConflict) GetResolution() DeviceConflictResolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return DeviceConflictResolution_REJECT
+}
+
+func (m *DeviceConflict) GetResolvedBy() string {
+	if m != nil {
+		return m.ResolvedBy
+	}
+	return ""
+}
+
+func (m *DeviceConflict) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *DeviceConflict) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type GetDeviceConflictRequest struct {
+	// Device conflict ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceConflictRequest) Reset()         { *m = GetDeviceConflictRequest{} }
+func (m *GetDeviceConflictRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceConflictRequest) ProtoMessage()    {}
+func (*GetDeviceConflictRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceConflict_7cf3843eb9a0318b, []int{1}
+}
+func (m *GetDeviceConflictRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceConflictRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceConflictRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceConflictRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceConflictRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceConflictRequest.Merge(dst, src)
+}
+func (m *GetDeviceConflictRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceConflictRequest.Size(m)
+}
+func (m *GetDeviceConflictRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceConflictRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceConflictRequest proto.InternalMessageInfo
+
+func (m *GetDeviceConflictRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDeviceConflictResponse struct {
+	// Device conflict object.
+	DeviceConflict       *DeviceConflict `protobuf:"bytes,1,opt,name=device_conflict,json=deviceConflict,proto3" json:"device_conflict,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetDeviceConflictResponse) Reset()         { *m = GetDeviceConflictResponse{} }
+func (m *GetDeviceConflictResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceConflictResponse) ProtoMessage()    {}
+func (*GetDeviceConflictResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceConflict_7cf3843eb9a0318b, []int{2}
+}
+func (m *GetDeviceConflictResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceConflictResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceConflictResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceConflictResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceConflictResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceConflictResponse.Merge(dst, src)
+}
+func (m *GetDeviceConflictResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceConflictResponse.Size(m)
+}
+func (m *GetDeviceConflictResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceConflictResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceConflictResponse proto.InternalMessageInfo
+
+func (m *GetDeviceConflictResponse) GetDeviceConflict() *DeviceConflict {
+	if m != nil {
+		return m.DeviceConflict
+	}
+	return nil
+}
+
+type ListDeviceConflictRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Organization ID to filter on.
+	// This is required for non-admin users.
+	OrganizationId int64 `protobuf:"varint,3,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Include the resolved and rejected conflicts.
+	// By default only the pending conflicts are returned.
+	IncludeResolved      bool     `protobuf:"varint,4,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeviceConflictRequest) Reset()         { *m = ListDeviceConflictRequest{} }
+func (m *ListDeviceConflictRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceConflictRequest) ProtoMessage()    {}
+func (*ListDeviceConflictRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceConflict_7cf3843eb9a0318b, []int{3}
+}
+func (m *ListDeviceConflictRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceConflictRequest.Unmarshal(m, b)
+}
+func (m *ListDeviceConflictRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceConflictRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceConflictRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceConflictRequest.Merge(dst, src)
+}
+func (m *ListDeviceConflictRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceConflictRequest.Size(m)
+}
+func (m *ListDeviceConflictRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceConflictRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceConflictRequest proto.InternalMessageInfo
+
+func (m *ListDeviceConflictRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeviceConflictRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListDeviceConflictRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *ListDeviceConflictRequest) GetIncludeResolved() bool {
+	if m != nil {
+		return m.IncludeResolved
+	}
+	return false
+}
+
+type ListDeviceConflictResponse struct {
+	// Total number of device conflicts.
+	TotalCount           int64             `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Result               []*DeviceConflict `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListDeviceConflictResponse) Reset()         { *m = ListDeviceConflictResponse{} }
+func (m *ListDeviceConflictResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceConflictResponse) ProtoMessage()    {}
+func (*ListDeviceConflictResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceConflict_7cf3843eb9a0318b, []int{4}
+}
+func (m *ListDeviceConflictResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceConflictResponse.Unmarshal(m, b)
+}
+func (m *ListDeviceConflictResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceConflictResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceConflictResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceConflictResponse.Merge(dst, src)
+}
+func (m *ListDeviceConflictResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceConflictResponse.Size(m)
+}
+func (m *ListDeviceConflictResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceConflictResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceConflictResponse proto.InternalMessageInfo
+
+func (m *ListDeviceConflictResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDeviceConflictResponse) GetResult() []*DeviceConflict {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ResolveDeviceConflictRequest struct {
+	// Device conflict ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Resolution.
+	Resolution           DeviceConflictResolution `protobuf:"varint,2,opt,name=resolution,proto3,enum=api.DeviceConflictResolution" json:"resolution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ResolveDeviceConflictRequest) Reset()         { *m = ResolveDeviceConflictRequest{} }
+func (m *ResolveDeviceConflictRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveDeviceConflictRequest) ProtoMessage()    {}
+func (*ResolveDeviceConflictRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceConflict_7cf3843eb9a0318b, []int{5}
+}
+func (m *ResolveDeviceConflictRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveDeviceConflictRequest.Unmarshal(m, b)
+}
+func (m *ResolveDeviceConflictRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveDeviceConflictRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResolveDeviceConflictRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveDeviceConflictRequest.Merge(dst, src)
+}
+func (m *ResolveDeviceConflictRequest) XXX_Size() int {
+	return xxx_messageInfo_ResolveDeviceConflictRequest.Size(m)
+}
+func (m *ResolveDeviceConflictRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveDeviceConflictRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveDeviceConflictRequest proto.InternalMessageInfo
+
+func (m *ResolveDeviceConflictRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ResolveDeviceConflictRequest) GetResolution() DeviceConflictResolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return DeviceConflictResolution_REJECT
+}
+
+func init() {
+	proto.RegisterType((*DeviceConflict)(nil), "api.DeviceConflict")
+	proto.RegisterType((*GetDeviceConflictRequest)(nil), "api.GetDeviceConflictRequest")
+	proto.RegisterType((*GetDeviceConflictResponse)(nil), "api.GetDeviceConflictResponse")
+	proto.RegisterType((*ListDeviceConflictRequest)(nil), "api.ListDeviceConflictRequest")
+	proto.RegisterType((*ListDeviceConflictResponse)(nil), "api.ListDeviceConflictResponse")
+	proto.RegisterType((*ResolveDeviceConflictRequest)(nil), "api.ResolveDeviceConflictRequest")
+	proto.RegisterEnum("api.DeviceConflictStatus", DeviceConflictStatus_name, DeviceConflictStatus_value)
+	proto.RegisterEnum("api.DeviceConflictResolution", DeviceConflictResolution_name, DeviceConflictResolution_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DeviceConflictServiceClient is the client API for DeviceConflictService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeviceConflictServiceClient interface {
+	// Get returns the device conflict matching the given id.
+	Get(ctx context.Context, in *GetDeviceConflictRequest, opts ...grpc.CallOption) (*GetDeviceConflictResponse, error)
+	// List lists the device conflicts.
+	List(ctx context.Context, in *ListDeviceConflictRequest, opts ...grpc.CallOption) (*ListDeviceConflictResponse, error)
+	// Resolve resolves the given device conflict.
+	// This requires global admin permissions.
+	Resolve(ctx context.Context, in *ResolveDeviceConflictRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type deviceConflictServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeviceConflictServiceClient(cc *grpc.ClientConn) DeviceConflictServiceClient {
+	return &deviceConflictServiceClient{cc}
+}
+
+func (c *deviceConflictServiceClient) Get(ctx context.Context, in *GetDeviceConflictRequest, opts ...grpc.CallOption) (*GetDeviceConflictResponse, error) {
+	out := new(GetDeviceConflictResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceConflictService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceConflictServiceClient) List(ctx context.Context, in *ListDeviceConflictRequest, opts ...grpc.CallOption) (*ListDeviceConflictResponse, error) {
+	out := new(ListDeviceConflictResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceConflictService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceConflictServiceClient) Resolve(ctx context.Context, in *ResolveDeviceConflictRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceConflictService/Resolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceConflictServiceServer is the server API for DeviceConflictService service.
+type DeviceConflictServiceServer interface {
+	// Get returns the device conflict matching the given id.
+	Get(context.Context, *GetDeviceConflictRequest) (*GetDeviceConflictResponse, error)
+	// List lists the device conflicts.
+	List(context.Context, *ListDeviceConflictRequest) (*ListDeviceConflictResponse, error)
+	// Resolve resolves the given device conflict.
+	// This requires global admin permissions.
+	Resolve(context.Context, *ResolveDeviceConflictRequest) (*empty.Empty, error)
+}
+
+func RegisterDeviceConflictServiceServer(s *grpc.Server, srv DeviceConflictServiceServer) {
+	s.RegisterService(&_DeviceConflictService_serviceDesc, srv)
+}
+
+func _DeviceConflictService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceConflictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceConflictServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceConflictService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceConflictServiceServer).Get(ctx, req.(*GetDeviceConflictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceConflictService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceConflictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceConflictServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceConflictService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceConflictServiceServer).List(ctx, req.(*ListDeviceConflictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceConflictService_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDeviceConflictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceConflictServiceServer).Resolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceConflictService/Resolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceConflictServiceServer).Resolve(ctx, req.(*ResolveDeviceConflictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeviceConflictService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.DeviceConflictService",
+	HandlerType: (*DeviceConflictServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _DeviceConflictService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _DeviceConflictService_List_Handler,
+		},
+		{
+			MethodName: "Resolve",
+			Handler:    _DeviceConflictService_Resolve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "deviceConflict.proto",
+}
+
+func init() {
+	proto.RegisterFile("deviceConflict.proto", fileDescriptor_deviceConflict_7cf3843eb9a0318b)
+}
+
+var fileDescriptor_deviceConflict_7cf3843eb9a0318b = []byte{
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5d, 0x6f, 0xe3, 0x44,
+	0x14, 0xad, 0x9d, 0x34, 0x49, 0x6f, 0x5a, 0x27, 0x0c, 0xfd, 0x70, 0x4d, 0x4b, 0x82, 0x25, 0xd4,
+	0x10, 0xd4, 0x44, 0x04, 0x09, 0x01, 0x02, 0x41, 0x48, 0x4c, 0x15, 0x5a, 0xb5, 0x95, 0x5b, 0x90,
+	0x78, 0xb2, 0x5c, 0x7b, 0x12, 0x06, 0xd9, 0x1e, 0x63, 0x8f, 0x23, 0x0a, 0xe2, 0x85, 0xbf, 0xb0,
+	0x4f, 0xfb, 0xb0, 0xbf, 0x6a, 0xdf, 0xf7, 0x69, 0x7f, 0xc8, 0xca, 0xe3, 0x71, 0x95, 0xa4, 0xf1,
+	0x6e, 0xf7, 0x2d, 0xf7, 0xde, 0x73, 0xe6, 0xde, 0x33, 0xf7, 0x4c, 0x0c, 0xbb, 0x2e, 0x9e, 0x13,
+	0x07, 0x8f, 0x68, 0x30, 0xf5, 0x88, 0xc3, 0x7a, 0x61, 0x44, 0x19, 0x45, 0x25, 0x3b, 0x24, 0xda,
+	0xd1, 0x8c, 0xd2, 0x99, 0x87, 0xfb, 0x76, 0x48, 0xfa, 0x76, 0x10, 0x50, 0x66, 0x33, 0x42, 0x83,
+	0x38, 0x83, 0x68, 0x2d, 0x51, 0xe5, 0xd1, 0x5d, 0x32, 0xed, 0x33, 0xe2, 0xe3, 0x98, 0xd9, 0x7e,
+	0x28, 0x00, 0x1f, 0xad, 0x02, 0xb0, 0x1f, 0xb2, 0x7b, 0x51, 0xdc, 0x76, 0xa8, 0xef, 0xd3, 0x20,
+	0x8b, 0xf4, 0xe7, 0x9b, 0xa0, 0x8c, 0x97, 0xe6, 0x40, 0x0a, 0xc8, 0xc4, 0x55, 0xa5, 0xb6, 0xd4,
+	0x29, 0x99, 0x32, 0x71, 0xd1, 0x01, 0x54, 0x5d, 0x3c, 0xb7, 0x70, 0x42, 0x54, 0xb9, 0x2d, 0x75,
+	0xb6, 0xcc, 0x8a, 0x8b, 0xe7, 0xc6, 0xaf, 0x13, 0x74, 0x02, 0x0d, 0x1a, 0xcd, 0xec, 0x80, 0xfc,
+	0xc3, 0xc7, 0xb3, 0x88, 0xab, 0x96, 0x38, 0x4b, 0x59, 0x4c, 0x4f, 0xc6, 0xe8, 0x53, 0x50, 0xec,
+	0x30, 0xf4, 0x88, 0xf3, 0x80, 0x2b, 0x73, 0xdc, 0xce, 0x42, 0x76, 0x32, 0x46, 0x5d, 0xf8, 0x20,
+	0xbb, 0x12, 0x2b, 0x8c, 0xe8, 0x94, 0x78, 0x38, 0x45, 0x6e, 0xf2, 0x96, 0x8d, 0xac, 0x70, 0x9d,
+	0xe5, 0x27, 0x63, 0x84, 0xa0, 0x1c, 0xd8, 0x3e, 0x56, 0x2b, 0xbc, 0xcc, 0x7f, 0xa3, 0x36, 0xd4,
+	0x5d, 0x1c, 0x3b, 0x11, 0x09, 0xd3, 0x03, 0xd5, 0x2a, 0x2f, 0x2d, 0xa6, 0xd0, 0x17, 0x50, 0x89,
+	0x69, 0x12, 0x39, 0x58, 0xad, 0xb5, 0xa5, 0x8e, 0x32, 0x38, 0xec, 0xd9, 0x21, 0xe9, 0x2d, 0xeb,
+	0xbf, 0xe1, 0x00, 0x53, 0x00, 0xd1, 0xd7, 0xa0, 0xe2, 0xbf, 0x49, 0xcc, 0x48, 0x30, 0xb3, 0x56,
+	0xd5, 0x6e, 0x71, 0x15, 0xfb, 0x79, 0xfd, 0x6a, 0x59, 0xf5, 0x57, 0x70, 0xf0, 0xc0, 0x5c, 0x91,
+	0x0f, 0x9c, 0xb8, 0x97, 0x97, 0x87, 0x4b, 0xd7, 0x90, 0x0e, 0xc9, 0x6c, 0x96, 0xc4, 0x6a, 0xbd,
+	0x78, 0x48, 0x0e, 0x30, 0x05, 0x10, 0x7d, 0x0f, 0x10, 0xe1, 0x98, 0x7a, 0x09, 0x17, 0xbe, 0xcd,
+	0x69, 0xc7, 0x6b, 0x68, 0xe6, 0x03, 0xc8, 0x5c, 0x20, 0xa0, 0x16, 0xd4, 0x79, 0x34, 0xc7, 0xae,
+	0x75, 0x77, 0xaf, 0xee, 0xf0, 0x8b, 0x83, 0x3c, 0xf5, 0xd3, 0x3d, 0xfa, 0x06, 0xc0, 0x89, 0xb0,
+	0xcd, 0xb0, 0x6b, 0xd9, 0x4c, 0x55, 0xda, 0x52, 0xa7, 0x3e, 0xd0, 0x7a, 0x99, 0xcb, 0x7a, 0xb9,
+	0xcb, 0x7a, 0xb7, 0xb9, 0x0d, 0xcd, 0x2d, 0x81, 0x1e, 0xb2, 0x94, 0x9a, 0x84, 0x6e, 0x4e, 0x6d,
+	0xbc, 0x9b, 0x2a, 0xd0, 0x43, 0xa6, 0x77, 0x41, 0x3d, 0xc3, 0x6c, 0x55, 0xc1, 0x5f, 0x09, 0x8e,
+	0x1f, 0x99, 0x54, 0xff, 0x1d, 0x0e, 0xd7, 0x60, 0xe3, 0x90, 0x06, 0x31, 0x46, 0xdf, 0x81, 0xf0,
+	0x8f, 0xe5, 0x88, 0x12, 0x67, 0xd6, 0x07, 0x1f, 0xae, 0xbb, 0x23, 0x65, 0xf9, 0x5d, 0xea, 0x2f,
+	0x24, 0x38, 0xbc, 0x20, 0x71, 0xc1, 0x20, 0xbb, 0xb0, 0xe9, 0x11, 0x9f, 0x30, 0x31, 0x4b, 0x16,
+	0xa0, 0x7d, 0xa8, 0xd0, 0xe9, 0x34, 0xc6, 0x8c, 0x3f, 0x99, 0x92, 0x29, 0xa2, 0xa7, 0x3f, 0x99,
+	0xcf, 0xa0, 0x49, 0x02, 0xc7, 0x4b, 0x5c, 0x6c, 0xe5, 0x7b, 0xe0, 0x8f, 0xa6, 0x66, 0x36, 0x44,
+	0xde, 0x14, 0x69, 0xfd, 0x4f, 0xd0, 0xd6, 0x8d, 0x27, 0xb4, 0xb7, 0xa0, 0xce, 0x28, 0xb3, 0x3d,
+	0xcb, 0xa1, 0x49, 0x90, 0x4f, 0x09, 0x3c, 0x35, 0x4a, 0x33, 0xe8, 0x73, 0xa8, 0x44, 0x38, 0x4e,
+	0xbc, 0x74, 0xd4, 0x52, 0xd1, 0x9d, 0x08, 0x88, 0xee, 0xc3, 0x91, 0xe8, 0xfb, 0xa4, 0xb5, 0xac,
+	0x18, 0x53, 0x7e, 0x4f, 0x63, 0x76, 0x7f, 0x80, 0xdd, 0x75, 0xbe, 0x47, 0x75, 0xa8, 0x5e, 0x1b,
+	0x97, 0xe3, 0xc9, 0xe5, 0x59, 0x73, 0x03, 0x6d, 0x43, 0xcd, 0x34, 0x6e, 0xae, 0x2e, 0x7e, 0x33,
+	0xc6, 0x4d, 0x29, 0x8b, 0x7e, 0x31, 0x46, 0xb7, 0xc6, 0xb8, 0x29, 0x77, 0x7f, 0x04, 0xb5, 0xa8,
+	0x11, 0x02, 0xa8, 0x64, 0xc8, 0xe6, 0x06, 0x52, 0x00, 0x7e, 0xbe, 0x32, 0x47, 0x86, 0x75, 0x3b,
+	0x3c, 0x37, 0x9a, 0x12, 0xaa, 0x41, 0xf9, 0x62, 0x72, 0x79, 0xde, 0x94, 0x07, 0xaf, 0x64, 0xd8,
+	0x5b, 0x99, 0x01, 0x47, 0x69, 0x88, 0xfe, 0x80, 0xd2, 0x19, 0x66, 0x28, 0x93, 0x53, 0x64, 0x54,
+	0xed, 0xe3, 0xa2, 0x72, 0xb6, 0x1f, 0x5d, 0xff, 0xff, 0xe5, 0xeb, 0x67, 0xf2, 0x11, 0xd2, 0xf8,
+	0x9f, 0x7d, 0x66, 0xbd, 0xd3, 0xdc, 0xa6, 0x71, 0xff, 0x5f, 0xe2, 0xfe, 0x87, 0xa6, 0x50, 0x4e,
+	0x37, 0x8c, 0xb2, 0xb3, 0x0a, 0xbd, 0xa8, 0xb5, 0x0a, 0xeb, 0xa2, 0xd9, 0x31, 0x6f, 0x76, 0x80,
+	0xf6, 0xd6, 0x36, 0x43, 0x31, 0x54, 0xc5, 0x76, 0xd1, 0x27, 0xfc, 0xa8, 0xb7, 0xed, 0x5a, 0xdb,
+	0x7f, 0xf4, 0x8a, 0x8d, 0xf4, 0x33, 0xa3, 0x9f, 0xf2, 0x26, 0x27, 0xba, 0x5e, 0xac, 0xa8, 0x2f,
+	0x3c, 0xfd, 0xad, 0xd4, 0xbd, 0xab, 0x70, 0xfa, 0x97, 0x6f, 0x06, 0x00, 0x2f, 0x75, 0x98, 0x0f,
+	0x0f, 0x07, 0x00, 0x00,
+}
@@ -0,0 +1,832 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: deviceFilter.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type DeviceFilter struct {
+	// Device filter ID.
+	// This will be generated automatically on create.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	// After creation, this can not be updated.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Name of the device filter.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Application ID to filter on (optional).
+	ApplicationId int64 `protobuf:"varint,4,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Device-profile ID to filter on (string formatted UUID, optional).
+	DeviceProfileId string `protobuf:"bytes,5,opt,name=device_profile_id,json=deviceProfileID,proto3" json:"device_profile_id,omitempty"`
+	// Search on name or DevEUI (optional).
+	Search string `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
+	// Filter on the devices that have not been seen for the given number
+	// of seconds, including the devices that have never been seen
+	// (optional, 0 = disabled).
+	LastSeenOlderThan int64 `protobuf:"varint,7,opt,name=last_seen_older_than,json=lastSeenOlderThan,proto3" json:"last_seen_older_than,omitempty"`
+	// Filter on the devices of which the battery level (percentage) is
+	// below the given value (optional, 0 = disabled).
+	BatteryBelow         float32  `protobuf:"fixed32,8,opt,name=battery_below,json=batteryBelow,proto3" json:"battery_below,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceFilter) Reset()         { *m = DeviceFilter{} }
+func (m *DeviceFilter) String() string { return proto.CompactTextString(m) }
+func (*DeviceFilter) ProtoMessage()    {}
+func (*DeviceFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceFilter_7890f3d3588cd5e3, []int{0}
+}
+func (m *DeviceFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceFilter.Unmarshal(m, b)
+}
+func (m *DeviceFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceFilter.Marshal(b, m, deterministic)
+}
+func (dst *DeviceFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceFilter.Merge(dst, src)
+}
+func (m *DeviceFilter) XXX_Size() int {
+	return xxx_messageInfo_DeviceFilter.Size(m)
+}
+func (m *DeviceFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceFilter proto.InternalMessageInfo
+
+func (m *DeviceFilter) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeviceFilter) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *DeviceFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceFilter) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *DeviceFilter) GetDeviceProfileId() string {
+	if m != nil {
+		return m.DeviceProfileId
+	}
+	return ""
+}
+
+func (m *DeviceFilter) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *DeviceFilter) GetLastSeenOlderThan() int64 {
+	if m != nil {
+		return m.LastSeenOlderThan
+	}
+	return 0
+}
+
+func (m *DeviceFilter) GetBatteryBelow() float32 {
+	if m != nil {
+		return m.BatteryBelow
+	}
+	return 0
+}
+
+type DeviceFilterListItem struct {
+	// Device filter ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the device filter.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeviceFilterListItem) Reset()         { *m = DeviceFilterListItem{} }
+func (m *DeviceFilterListItem) String() string { return proto.CompactTextString(m) }
+func (*DeviceFilterListItem) ProtoMessage()    {}
+func (*DeviceFilterListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceFilter_7890f3d3588cd5e3, []int{1}
+}
+func (m *DeviceFilterListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceFilterListItem.Unmarshal(m, b)
+}
+func (m *DeviceFilterListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceFilterListItem.Marshal(b, m, deterministic)
+}
+func (dst *DeviceFilterListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceFilterListItem.Merge(dst, src)
+}
+func (m *DeviceFilterListItem) XXX_Size() int {
+	return xxx_messageInfo_DeviceFilterListItem.Size(m)
+}
+func (m *DeviceFilterListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceFilterListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceFilterListItem proto.InternalMessageInfo
+
+func (m *DeviceFilterListItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeviceFilterListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceFilterListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *DeviceFilterListItem) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type CreateDeviceFilterRequest struct {
+	// Device filter object to create.
+	DeviceFilter         *DeviceFilter `protobuf:"bytes,1,opt,name=device_filter,json=deviceFilter,proto3" json:"device_filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CreateDeviceFilterRequest) Reset()         { *m = CreateDeviceFilterRequest{} }
+func (m *CreateDeviceFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceFilterRequest) ProtoMessage()    {}
+func (*CreateDeviceFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceFilter_7890f3d3588cd5e3, []int{2}
+}
+func (m *CreateDeviceFilterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceFilterRequest.Unmarshal(m, b)
+}
+func (m *CreateDeviceFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceFilterRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceFilterRequest.Merge(dst, src)
+}
+func (m *CreateDeviceFilterRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceFilterRequest.Size(m)
+}
+func (m *CreateDeviceFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceFilterRequest proto.InternalMessageInfo
+
+func (m *CreateDeviceFilterRequest) GetDeviceFilter() *DeviceFilter {
+	if m != nil {
+		return m.DeviceFilter
+	}
+	return nil
+}
+
+type CreateDeviceFilterResponse struct {
+	// ID of the created device filter.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDeviceFilterResponse) Reset()         { *m = CreateDeviceFilterResponse{} }
+func (m *CreateDeviceFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceFilterResponse) ProtoMessage()    {}
+func (*CreateDeviceFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceFilter_7890f3d3588cd5e3, []int{3}
+}
+func (m *CreateDeviceFilterResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceFilterResponse.Unmarshal(m, b)
+}
+func (m *CreateDeviceFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceFilterResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceFilterResponse.Merge(dst, src)
+}
+func (m *CreateDeviceFilterResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceFilterResponse.Size(m)
+}
+func (m *CreateDeviceFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceFilterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceFilterResponse proto.InternalMessageInfo
+
+func (m *CreateDeviceFilterResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDeviceFilterRequest struct {
+	// Device filter ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceFilterRequest) Reset()         { *m = GetDeviceFilterRequest{} }
+func (m *GetDeviceFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceFilterRequest) ProtoMessage()    {}
+func (*GetDeviceFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceFilter_7890f3d3588cd5e3, []int{4}
+}
+func (m *GetDeviceFilterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceFilterRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceFilterRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceFilterRequest.Merge(dst, src)
+}
+func (m *GetDeviceFilterRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceFilterRequest.Size(m)
+}
+func (m *GetDeviceFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceFilterRequest proto.InternalMessageInfo
+
+func (m *GetDeviceFilterRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDeviceFilterResponse struct {
+	// Device filter object.
+	DeviceFilter *DeviceFilter `protobuf:"bytes,1,opt,name=device_filter,json=deviceFilter,proto3" json:"device_filter,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetDeviceFilterResponse) Reset()         { *m = GetDeviceFilterResponse{} }
+func (m *GetDeviceFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceFilterResponse) ProtoMessage()    {}
+func (*GetDeviceFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceFilter_7890f3d3588cd5e3, []int{5}
+}
+func (m *GetDeviceFilterResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceFilterResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceFilterResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceFilterResponse.Merge(dst, src)
+}
+func (m *GetDeviceFilterResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceFilterResponse.Size(m)
+}
+func (m *GetDeviceFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceFilterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceFilterResponse proto.InternalMessageInfo
+
+func (m *GetDeviceFilterResponse) GetDeviceFilter() *DeviceFilter {
+	if m != nil {
+		return m.DeviceFilter
+	}
+	return nil
+}
+
+func (m *GetDeviceFilterResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetDeviceFilterResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type UpdateDeviceFilterRequest struct {
+	// Device filter object to update.
+	DeviceFilter         *DeviceFilter `protobuf:"bytes,1,opt,name=device_filter,json=deviceFilter,proto3" json:"device_filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *UpdateDeviceFilterRequest) Reset()         { *m = UpdateDeviceFilterRequest{} }
+func (m *UpdateDeviceFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceFilterRequest) ProtoMessage()    {}
+func (*UpdateDeviceFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceFilter_7890f3d3588cd5e3, []int{6}
+}
+func (m *UpdateDeviceFilterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDeviceFilterRequest.Unmarshal(m, b)
+}
+func (m *UpdateDeviceFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDeviceFilterRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateDeviceFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeviceFilterRequest.Merge(dst, src)
+}
+func (m *UpdateDeviceFilterRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateDeviceFilterRequest.Size(m)
+}
+func (m *UpdateDeviceFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeviceFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDeviceFilterRequest proto.InternalMessageInfo
+
+func (m *UpdateDeviceFilterRequest) GetDeviceFilter() *DeviceFilter {
+	if m != nil {
+		return m.DeviceFilter
+	}
+	return nil
+}
+
+type DeleteDeviceFilterRequest struct {
+	// Device filter ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDeviceFilterRequest) Reset()         { *m = DeleteDeviceFilterRequest{} }
+func (m *DeleteDeviceFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDeviceFilterRequest) ProtoMessage()    {}
+func (*DeleteDeviceFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceFilter_7890f3d3588cd5e3, []int{7}
+}
+func (m *DeleteDeviceFilterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDeviceFilterRequest.Unmarshal(m, b)
+}
+func (m *DeleteDeviceFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDeviceFilterRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteDeviceFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDeviceFilterRequest.Merge(dst, src)
+}
+func (m *DeleteDeviceFilterRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteDeviceFilterRequest.Size(m)
+}
+func (m *DeleteDeviceFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDeviceFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDeviceFilterRequest proto.InternalMessageInfo
+
+func (m *DeleteDeviceFilterRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListDeviceFilterRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Organization ID.
+	OrganizationId       int64    `protobuf:"varint,3,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeviceFilterRequest) Reset()         { *m = ListDeviceFilterRequest{} }
+func (m *ListDeviceFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceFilterRequest) ProtoMessage()    {}
+func (*ListDeviceFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceFilter_7890f3d3588cd5e3, []int{8}
+}
+func (m *ListDeviceFilterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceFilterRequest.Unmarshal(m, b)
+}
+func (m *ListDeviceFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceFilterRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceFilterRequest.Merge(dst, src)
+}
+func (m *ListDeviceFilterRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceFilterRequest.Size(m)
+}
+func (m *ListDeviceFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceFilterRequest proto.InternalMessageInfo
+
+func (m *ListDeviceFilterRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeviceFilterRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListDeviceFilterRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type ListDeviceFilterResponse struct {
+	// Total number of device filters.
+	TotalCount           int64                   `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Result               []*DeviceFilterListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ListDeviceFilterResponse) Reset()         { *m = ListDeviceFilterResponse{} }
+func (m *ListDeviceFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceFilterResponse) ProtoMessage()    {}
+func (*ListDeviceFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceFilter_7890f3d3588cd5e3, []int{9}
+}
+func (m *ListDeviceFilterResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceFilterResponse.Unmarshal(m, b)
+}
+func (m *ListDeviceFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceFilterResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceFilterResponse.Merge(dst, src)
+}
+func (m *ListDeviceFilterResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceFilterResponse.Size(m)
+}
+func (m *ListDeviceFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceFilterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceFilterResponse proto.InternalMessageInfo
+
+func (m *ListDeviceFilterResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDeviceFilterResponse) GetResult() []*DeviceFilterListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DeviceFilter)(nil), "api.DeviceFilter")
+	proto.RegisterType((*DeviceFilterListItem)(nil), "api.DeviceFilterListItem")
+	proto.RegisterType((*CreateDeviceFilterRequest)(nil), "api.CreateDeviceFilterRequest")
+	proto.RegisterType((*CreateDeviceFilterResponse)(nil), "api.CreateDeviceFilterResponse")
+	proto.RegisterType((*GetDeviceFilterRequest)(nil), "api.GetDeviceFilterRequest")
+	proto.RegisterType((*GetDeviceFilterResponse)(nil), "api.GetDeviceFilterResponse")
+	proto.RegisterType((*UpdateDeviceFilterRequest)(nil), "api.UpdateDeviceFilterRequest")
+	proto.RegisterType((*DeleteDeviceFilterRequest)(nil), "api.DeleteDeviceFilterRequest")
+	proto.RegisterType((*ListDeviceFilterRequest)(nil), "api.ListDeviceFilterRequest")
+	proto.RegisterType((*ListDeviceFilterResponse)(nil), "api.ListDeviceFilterResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DeviceFilterServiceClient is the client API for DeviceFilterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeviceFilterServiceClient interface {
+	// Create creates the given device filter.
+	Create(ctx context.Context, in *CreateDeviceFilterRequest, opts ...grpc.CallOption) (*CreateDeviceFilterResponse, error)
+	// Get returns the device filter matching the given id.
+	Get(ctx context.Context, in *GetDeviceFilterRequest, opts ...grpc.CallOption) (*GetDeviceFilterResponse, error)
+	// Update updates the given device filter.
+	Update(ctx context.Context, in *UpdateDeviceFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete deletes the device filter matching the given id.
+	Delete(ctx context.Context, in *DeleteDeviceFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List lists the device filters of the given organization.
+	List(ctx context.Context, in *ListDeviceFilterRequest, opts ...grpc.CallOption) (*ListDeviceFilterResponse, error)
+}
+
+type deviceFilterServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeviceFilterServiceClient(cc *grpc.ClientConn) DeviceFilterServiceClient {
+	return &deviceFilterServiceClient{cc}
+}
+
+func (c *deviceFilterServiceClient) Create(ctx context.Context, in *CreateDeviceFilterRequest, opts ...grpc.CallOption) (*CreateDeviceFilterResponse, error) {
+	out := new(CreateDeviceFilterResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceFilterService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceFilterServiceClient) Get(ctx context.Context, in *GetDeviceFilterRequest, opts ...grpc.CallOption) (*GetDeviceFilterResponse, error) {
+	out := new(GetDeviceFilterResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceFilterService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceFilterServiceClient) Update(ctx context.Context, in *UpdateDeviceFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceFilterService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceFilterServiceClient) Delete(ctx context.Context, in *DeleteDeviceFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceFilterService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceFilterServiceClient) List(ctx context.Context, in *ListDeviceFilterRequest, opts ...grpc.CallOption) (*ListDeviceFilterResponse, error) {
+	out := new(ListDeviceFilterResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceFilterService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceFilterServiceServer is the server API for DeviceFilterService service.
+type DeviceFilterServiceServer interface {
+	// Create creates the given device filter.
+	Create(context.Context, *CreateDeviceFilterRequest) (*CreateDeviceFilterResponse, error)
+	// Get returns the device filter matching the given id.
+	Get(context.Context, *GetDeviceFilterRequest) (*GetDeviceFilterResponse, error)
+	// Update updates the given device filter.
+	Update(context.Context, *UpdateDeviceFilterRequest) (*empty.Empty, error)
+	// Delete deletes the device filter matching the given id.
+	Delete(context.Context, *DeleteDeviceFilterRequest) (*empty.Empty, error)
+	// List lists the device filters of the given organization.
+	List(context.Context, *ListDeviceFilterRequest) (*ListDeviceFilterResponse, error)
+}
+
+func RegisterDeviceFilterServiceServer(s *grpc.Server, srv DeviceFilterServiceServer) {
+	s.RegisterService(&_DeviceFilterService_serviceDesc, srv)
+}
+
+func _DeviceFilterService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceFilterServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceFilterService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceFilterServiceServer).Create(ctx, req.(*CreateDeviceFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceFilterService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceFilterServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceFilterService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceFilterServiceServer).Get(ctx, req.(*GetDeviceFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceFilterService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceFilterServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceFilterService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceFilterServiceServer).Update(ctx, req.(*UpdateDeviceFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceFilterService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceFilterServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceFilterService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceFilterServiceServer).Delete(ctx, req.(*DeleteDeviceFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceFilterService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceFilterServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceFilterService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceFilterServiceServer).List(ctx, req.(*ListDeviceFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeviceFilterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.DeviceFilterService",
+	HandlerType: (*DeviceFilterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _DeviceFilterService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DeviceFilterService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _DeviceFilterService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DeviceFilterService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _DeviceFilterService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "deviceFilter.proto",
+}
+
+func init() { proto.RegisterFile("deviceFilter.proto", fileDescriptor_deviceFilter_7890f3d3588cd5e3) }
+
+var fileDescriptor_deviceFilter_7890f3d3588cd5e3 = []byte{
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0x95, 0xed, 0xd4, 0xdf, 0xd7, 0x49, 0x7f, 0xd4, 0x6d, 0x69, 0x5d, 0xb7, 0xb4, 0x91, 0x11,
+	0x10, 0xb5, 0xe0, 0xa8, 0x41, 0x42, 0x82, 0xbb, 0xd2, 0x40, 0x15, 0x09, 0x09, 0x94, 0x96, 0x6b,
+	0xb3, 0x89, 0x27, 0xed, 0x4a, 0x8e, 0xd7, 0xd8, 0x1b, 0x50, 0x41, 0xbd, 0xe1, 0x15, 0xb8, 0x45,
+	0xe2, 0x15, 0x78, 0x0b, 0x1e, 0x80, 0x57, 0xe0, 0x41, 0x90, 0x77, 0x37, 0xc8, 0x4d, 0x6c, 0x01,
+	0x15, 0x77, 0xde, 0x99, 0x33, 0x73, 0x76, 0xce, 0x9c, 0x35, 0x90, 0x10, 0xdf, 0xb2, 0x01, 0x3e,
+	0x63, 0x91, 0xc0, 0xd4, 0x4f, 0x52, 0x2e, 0x38, 0xb1, 0x68, 0xc2, 0xdc, 0xed, 0x33, 0xce, 0xcf,
+	0x22, 0x6c, 0xd1, 0x84, 0xb5, 0x68, 0x1c, 0x73, 0x41, 0x05, 0xe3, 0x71, 0xa6, 0x20, 0xee, 0xae,
+	0xce, 0xca, 0x53, 0x7f, 0x3c, 0x6c, 0x09, 0x36, 0xc2, 0x4c, 0xd0, 0x51, 0xa2, 0x01, 0x5b, 0xd3,
+	0x00, 0x1c, 0x25, 0xe2, 0x42, 0x25, 0xbd, 0xcf, 0x26, 0x2c, 0x74, 0x0a, 0xbc, 0x64, 0x09, 0x4c,
+	0x16, 0x3a, 0x46, 0xc3, 0x68, 0x5a, 0x3d, 0x93, 0x85, 0xe4, 0x2e, 0x2c, 0xf3, 0xf4, 0x8c, 0xc6,
+	0xec, 0xbd, 0x64, 0x0d, 0x58, 0xe8, 0x98, 0x32, 0xb9, 0x54, 0x0c, 0x77, 0x3b, 0x84, 0x40, 0x2d,
+	0xa6, 0x23, 0x74, 0xac, 0x86, 0xd1, 0x9c, 0xef, 0xc9, 0x6f, 0x72, 0x1b, 0x96, 0x68, 0x92, 0x44,
+	0x6c, 0xf0, 0xab, 0xb6, 0x26, 0x6b, 0x17, 0x0b, 0xd1, 0x6e, 0x87, 0xec, 0xc1, 0x8a, 0x9a, 0x3d,
+	0x48, 0x52, 0x3e, 0x64, 0x11, 0xe6, 0xc8, 0x39, 0xd9, 0x67, 0x59, 0x25, 0x5e, 0xaa, 0x78, 0xb7,
+	0x43, 0xd6, 0xc1, 0xce, 0x90, 0xa6, 0x83, 0x73, 0xc7, 0x96, 0x00, 0x7d, 0x22, 0x2d, 0x58, 0x8b,
+	0x68, 0x26, 0x82, 0x0c, 0x31, 0x0e, 0x78, 0x14, 0x62, 0x1a, 0x88, 0x73, 0x1a, 0x3b, 0xff, 0x49,
+	0xc2, 0x95, 0x3c, 0x77, 0x82, 0x18, 0xbf, 0xc8, 0x33, 0xa7, 0xe7, 0x34, 0x26, 0xb7, 0x60, 0xb1,
+	0x4f, 0x85, 0xc0, 0xf4, 0x22, 0xe8, 0x63, 0xc4, 0xdf, 0x39, 0xff, 0x37, 0x8c, 0xa6, 0xd9, 0x5b,
+	0xd0, 0xc1, 0x27, 0x79, 0xcc, 0xfb, 0x6a, 0xc0, 0x5a, 0x51, 0x9e, 0xe7, 0x2c, 0x13, 0x5d, 0x81,
+	0xa3, 0x19, 0x99, 0x26, 0xd3, 0x9b, 0x85, 0xe9, 0x1f, 0x01, 0x0c, 0x52, 0xa4, 0x02, 0xc3, 0x80,
+	0x0a, 0xa9, 0x4b, 0xbd, 0xed, 0xfa, 0x6a, 0x1b, 0xfe, 0x64, 0x1b, 0xfe, 0xe9, 0x64, 0x5d, 0xbd,
+	0x79, 0x8d, 0x3e, 0x14, 0x79, 0xe9, 0x38, 0x09, 0x27, 0xa5, 0xb5, 0xdf, 0x97, 0x6a, 0xf4, 0xa1,
+	0xf0, 0x4e, 0x60, 0xf3, 0x48, 0xf6, 0x29, 0xde, 0xbb, 0x87, 0x6f, 0xc6, 0x98, 0x09, 0xf2, 0x10,
+	0x16, 0xb5, 0xd2, 0x43, 0x19, 0x97, 0x13, 0xd4, 0xdb, 0x2b, 0x3e, 0x4d, 0x98, 0x7f, 0xa5, 0x60,
+	0xa1, 0xe8, 0x46, 0xef, 0x1e, 0xb8, 0x65, 0x4d, 0xb3, 0x84, 0xc7, 0x19, 0x4e, 0x8b, 0xe1, 0x35,
+	0x61, 0xfd, 0x18, 0x45, 0x19, 0xff, 0x34, 0xf2, 0x9b, 0x01, 0x1b, 0x33, 0x50, 0xdd, 0xf5, 0x9a,
+	0x77, 0x9d, 0x92, 0xdd, 0xbc, 0xbe, 0xec, 0xd6, 0x5f, 0xca, 0xfe, 0x4a, 0x1e, 0xfe, 0xa5, 0xec,
+	0xfb, 0xb0, 0xd9, 0xc1, 0x08, 0x05, 0xfe, 0x89, 0x96, 0x09, 0x6c, 0xe4, 0xf6, 0x2c, 0x83, 0xae,
+	0xc1, 0x5c, 0xc4, 0x46, 0x4c, 0x68, 0xb4, 0x3a, 0xe4, 0x4f, 0x89, 0x0f, 0x87, 0x19, 0x0a, 0xfd,
+	0xa2, 0xf5, 0xa9, 0xec, 0xc9, 0x5b, 0x65, 0x4f, 0xde, 0x8b, 0xc1, 0x99, 0x65, 0xd4, 0xdb, 0xdb,
+	0x85, 0xba, 0xe0, 0x82, 0x46, 0xc1, 0x80, 0x8f, 0xe3, 0x09, 0x31, 0xc8, 0xd0, 0x51, 0x1e, 0x21,
+	0x07, 0x60, 0xa7, 0x98, 0x8d, 0xa3, 0x9c, 0xdd, 0x6a, 0xd6, 0xdb, 0x9b, 0x33, 0x62, 0x4c, 0x1e,
+	0x5b, 0x4f, 0x03, 0xdb, 0x5f, 0x6a, 0xb0, 0x5a, 0x04, 0x9c, 0x60, 0x9a, 0x1f, 0x08, 0x03, 0x5b,
+	0xb9, 0x93, 0xec, 0xc8, 0x26, 0x95, 0xfe, 0x77, 0x77, 0x2b, 0xf3, 0xea, 0xda, 0xde, 0xce, 0xc7,
+	0xef, 0x3f, 0x3e, 0x99, 0x8e, 0xb7, 0x2a, 0xff, 0xb6, 0x6a, 0x19, 0xf7, 0xd5, 0xd2, 0xb2, 0xc7,
+	0xc6, 0x1e, 0xe9, 0x83, 0x75, 0x8c, 0x82, 0x6c, 0xc9, 0x3e, 0xe5, 0x26, 0x77, 0xb7, 0xcb, 0x93,
+	0x9a, 0xa1, 0x21, 0x19, 0x5c, 0xe2, 0x94, 0x30, 0xb4, 0x3e, 0xb0, 0xf0, 0x92, 0x64, 0x60, 0x2b,
+	0x2b, 0xe9, 0x71, 0x2a, 0x7d, 0xe5, 0xae, 0xcf, 0x78, 0xf3, 0x69, 0xfe, 0x6f, 0xf7, 0x0e, 0x24,
+	0xc7, 0xbe, 0x7b, 0xa7, 0x94, 0xe3, 0x8a, 0x15, 0x7d, 0x16, 0x5e, 0xaa, 0xc1, 0x6c, 0x65, 0x35,
+	0x4d, 0x5a, 0xe9, 0xbb, 0x4a, 0x52, 0x3d, 0xd8, 0x5e, 0xf5, 0x60, 0xaf, 0xa1, 0x96, 0xef, 0x94,
+	0x28, 0x81, 0x2a, 0xcc, 0xea, 0xde, 0xac, 0xc8, 0x6a, 0xfd, 0xb6, 0x24, 0xcd, 0x0d, 0x52, 0xb6,
+	0xa1, 0xbe, 0x2d, 0xef, 0xf4, 0xe0, 0xe7, 0x00, 0xc8, 0x3b, 0xca, 0xae, 0x4c, 0x07, 0x00, 0x00,
+}
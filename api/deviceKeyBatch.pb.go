@@ -0,0 +1,1074 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: deviceKeyBatch.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type DeviceKeyBatch struct {
+	// ID of the batch.
+	// This will be generated automatically on create.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Batch name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// JoinEUI (AppEUI) of the devices (HEX encoded).
+	JoinEui string `protobuf:"bytes,4,opt,name=join_eui,json=joinEUI,proto3" json:"join_eui,omitempty"`
+	// First DevEUI of the assigned DevEUI block (HEX encoded).
+	DevEuiBlockStart string `protobuf:"bytes,5,opt,name=dev_eui_block_start,json=devEUIBlockStart,proto3" json:"dev_eui_block_start,omitempty"`
+	// Last DevEUI of the assigned DevEUI block (HEX encoded).
+	DevEuiBlockEnd string `protobuf:"bytes,6,opt,name=dev_eui_block_end,json=devEUIBlockEnd,proto3" json:"dev_eui_block_end,omitempty"`
+	// Number of DevEUI / AppKey pairs (max 10000).
+	Count                uint32   `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceKeyBatch) Reset()         { *m = DeviceKeyBatch{} }
+func (m *DeviceKeyBatch) String() string { return proto.CompactTextString(m) }
+func (*DeviceKeyBatch) ProtoMessage()    {}
+func (*DeviceKeyBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{0}
+}
+func (m *DeviceKeyBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceKeyBatch.Unmarshal(m, b)
+}
+func (m *DeviceKeyBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceKeyBatch.Marshal(b, m, deterministic)
+}
+func (dst *DeviceKeyBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceKeyBatch.Merge(dst, src)
+}
+func (m *DeviceKeyBatch) XXX_Size() int {
+	return xxx_messageInfo_DeviceKeyBatch.Size(m)
+}
+func (m *DeviceKeyBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceKeyBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceKeyBatch proto.InternalMessageInfo
+
+func (m *DeviceKeyBatch) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeviceKeyBatch) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *DeviceKeyBatch) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceKeyBatch) GetJoinEui() string {
+	if m != nil {
+		return m.JoinEui
+	}
+	return ""
+}
+
+func (m *DeviceKeyBatch) GetDevEuiBlockStart() string {
+	if m != nil {
+		return m.DevEuiBlockStart
+	}
+	return ""
+}
+
+func (m *DeviceKeyBatch) GetDevEuiBlockEnd() string {
+	if m != nil {
+		return m.DevEuiBlockEnd
+	}
+	return ""
+}
+
+func (m *DeviceKeyBatch) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type DeviceKeyBatchListItem struct {
+	// ID of the batch.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Batch name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// JoinEUI (AppEUI) of the devices (HEX encoded).
+	JoinEui string `protobuf:"bytes,3,opt,name=join_eui,json=joinEUI,proto3" json:"join_eui,omitempty"`
+	// Number of DevEUI / AppKey pairs.
+	Count uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// Number of keys which have been used by a join of the device.
+	ConsumedCount uint32 `protobuf:"varint,5,opt,name=consumed_count,json=consumedCount,proto3" json:"consumed_count,omitempty"`
+	// Created at timestamp.
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeviceKeyBatchListItem) Reset()         { *m = DeviceKeyBatchListItem{} }
+func (m *DeviceKeyBatchListItem) String() string { return proto.CompactTextString(m) }
+func (*DeviceKeyBatchListItem) ProtoMessage()    {}
+func (*DeviceKeyBatchListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{1}
+}
+func (m *DeviceKeyBatchListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceKeyBatchListItem.Unmarshal(m, b)
+}
+func (m *DeviceKeyBatchListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceKeyBatchListItem.Marshal(b, m, deterministic)
+}
+func (dst *DeviceKeyBatchListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceKeyBatchListItem.Merge(dst, src)
+}
+func (m *DeviceKeyBatchListItem) XXX_Size() int {
+	return xxx_messageInfo_DeviceKeyBatchListItem.Size(m)
+}
+func (m *DeviceKeyBatchListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceKeyBatchListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceKeyBatchListItem proto.InternalMessageInfo
+
+func (m *DeviceKeyBatchListItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeviceKeyBatchListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceKeyBatchListItem) GetJoinEui() string {
+	if m != nil {
+		return m.JoinEui
+	}
+	return ""
+}
+
+func (m *DeviceKeyBatchListItem) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *DeviceKeyBatchListItem) GetConsumedCount() uint32 {
+	if m != nil {
+		return m.ConsumedCount
+	}
+	return 0
+}
+
+func (m *DeviceKeyBatchListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type CreateDeviceKeyBatchRequest struct {
+	// Batch object to create.
+	Batch                *DeviceKeyBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CreateDeviceKeyBatchRequest) Reset()         { *m = CreateDeviceKeyBatchRequest{} }
+func (m *CreateDeviceKeyBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceKeyBatchRequest) ProtoMessage()    {}
+func (*CreateDeviceKeyBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{2}
+}
+func (m *CreateDeviceKeyBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceKeyBatchRequest.Unmarshal(m, b)
+}
+func (m *CreateDeviceKeyBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceKeyBatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceKeyBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceKeyBatchRequest.Merge(dst, src)
+}
+func (m *CreateDeviceKeyBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceKeyBatchRequest.Size(m)
+}
+func (m *CreateDeviceKeyBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceKeyBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceKeyBatchRequest proto.InternalMessageInfo
+
+func (m *CreateDeviceKeyBatchRequest) GetBatch() *DeviceKeyBatch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+type CreateDeviceKeyBatchResponse struct {
+	// ID of the created batch.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDeviceKeyBatchResponse) Reset()         { *m = CreateDeviceKeyBatchResponse{} }
+func (m *CreateDeviceKeyBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceKeyBatchResponse) ProtoMessage()    {}
+func (*CreateDeviceKeyBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{3}
+}
+func (m *CreateDeviceKeyBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceKeyBatchResponse.Unmarshal(m, b)
+}
+func (m *CreateDeviceKeyBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceKeyBatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceKeyBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceKeyBatchResponse.Merge(dst, src)
+}
+func (m *CreateDeviceKeyBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceKeyBatchResponse.Size(m)
+}
+func (m *CreateDeviceKeyBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceKeyBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceKeyBatchResponse proto.InternalMessageInfo
+
+func (m *CreateDeviceKeyBatchResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDeviceKeyBatchRequest struct {
+	// ID of the batch.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceKeyBatchRequest) Reset()         { *m = GetDeviceKeyBatchRequest{} }
+func (m *GetDeviceKeyBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceKeyBatchRequest) ProtoMessage()    {}
+func (*GetDeviceKeyBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{4}
+}
+func (m *GetDeviceKeyBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceKeyBatchRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceKeyBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceKeyBatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceKeyBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceKeyBatchRequest.Merge(dst, src)
+}
+func (m *GetDeviceKeyBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceKeyBatchRequest.Size(m)
+}
+func (m *GetDeviceKeyBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceKeyBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceKeyBatchRequest proto.InternalMessageInfo
+
+func (m *GetDeviceKeyBatchRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDeviceKeyBatchResponse struct {
+	// Batch object.
+	Batch *DeviceKeyBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetDeviceKeyBatchResponse) Reset()         { *m = GetDeviceKeyBatchResponse{} }
+func (m *GetDeviceKeyBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceKeyBatchResponse) ProtoMessage()    {}
+func (*GetDeviceKeyBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{5}
+}
+func (m *GetDeviceKeyBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceKeyBatchResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceKeyBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceKeyBatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceKeyBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceKeyBatchResponse.Merge(dst, src)
+}
+func (m *GetDeviceKeyBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceKeyBatchResponse.Size(m)
+}
+func (m *GetDeviceKeyBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceKeyBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceKeyBatchResponse proto.InternalMessageInfo
+
+func (m *GetDeviceKeyBatchResponse) GetBatch() *DeviceKeyBatch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (m *GetDeviceKeyBatchResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetDeviceKeyBatchResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type DeleteDeviceKeyBatchRequest struct {
+	// ID of the batch.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDeviceKeyBatchRequest) Reset()         { *m = DeleteDeviceKeyBatchRequest{} }
+func (m *DeleteDeviceKeyBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDeviceKeyBatchRequest) ProtoMessage()    {}
+func (*DeleteDeviceKeyBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{6}
+}
+func (m *DeleteDeviceKeyBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDeviceKeyBatchRequest.Unmarshal(m, b)
+}
+func (m *DeleteDeviceKeyBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDeviceKeyBatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteDeviceKeyBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDeviceKeyBatchRequest.Merge(dst, src)
+}
+func (m *DeleteDeviceKeyBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteDeviceKeyBatchRequest.Size(m)
+}
+func (m *DeleteDeviceKeyBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDeviceKeyBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDeviceKeyBatchRequest proto.InternalMessageInfo
+
+func (m *DeleteDeviceKeyBatchRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListDeviceKeyBatchRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Organization id.
+	OrganizationId       int64    `protobuf:"varint,3,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeviceKeyBatchRequest) Reset()         { *m = ListDeviceKeyBatchRequest{} }
+func (m *ListDeviceKeyBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceKeyBatchRequest) ProtoMessage()    {}
+func (*ListDeviceKeyBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{7}
+}
+func (m *ListDeviceKeyBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceKeyBatchRequest.Unmarshal(m, b)
+}
+func (m *ListDeviceKeyBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceKeyBatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceKeyBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceKeyBatchRequest.Merge(dst, src)
+}
+func (m *ListDeviceKeyBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceKeyBatchRequest.Size(m)
+}
+func (m *ListDeviceKeyBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceKeyBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceKeyBatchRequest proto.InternalMessageInfo
+
+func (m *ListDeviceKeyBatchRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeviceKeyBatchRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListDeviceKeyBatchRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type ListDeviceKeyBatchResponse struct {
+	// Total number of batches.
+	TotalCount           int64                     `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Result               []*DeviceKeyBatchListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListDeviceKeyBatchResponse) Reset()         { *m = ListDeviceKeyBatchResponse{} }
+func (m *ListDeviceKeyBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceKeyBatchResponse) ProtoMessage()    {}
+func (*ListDeviceKeyBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{8}
+}
+func (m *ListDeviceKeyBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceKeyBatchResponse.Unmarshal(m, b)
+}
+func (m *ListDeviceKeyBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceKeyBatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceKeyBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceKeyBatchResponse.Merge(dst, src)
+}
+func (m *ListDeviceKeyBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceKeyBatchResponse.Size(m)
+}
+func (m *ListDeviceKeyBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceKeyBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceKeyBatchResponse proto.InternalMessageInfo
+
+func (m *ListDeviceKeyBatchResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDeviceKeyBatchResponse) GetResult() []*DeviceKeyBatchListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ExportDeviceKeyBatchRequest struct {
+	// ID of the batch.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Passphrase used for encrypting the export (min 8 characters).
+	Passphrase           string   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportDeviceKeyBatchRequest) Reset()         { *m = ExportDeviceKeyBatchRequest{} }
+func (m *ExportDeviceKeyBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportDeviceKeyBatchRequest) ProtoMessage()    {}
+func (*ExportDeviceKeyBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{9}
+}
+func (m *ExportDeviceKeyBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportDeviceKeyBatchRequest.Unmarshal(m, b)
+}
+func (m *ExportDeviceKeyBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportDeviceKeyBatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportDeviceKeyBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDeviceKeyBatchRequest.Merge(dst, src)
+}
+func (m *ExportDeviceKeyBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportDeviceKeyBatchRequest.Size(m)
+}
+func (m *ExportDeviceKeyBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDeviceKeyBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportDeviceKeyBatchRequest proto.InternalMessageInfo
+
+func (m *ExportDeviceKeyBatchRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ExportDeviceKeyBatchRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type ExportDeviceKeyBatchResponse struct {
+	// Encrypted CSV (dev_eui, join_eui, app_key).
+	// Format: salt (16 bytes) | nonce (12 bytes) | AES-256-GCM ciphertext,
+	// the key is derived from the passphrase using PBKDF2 (SHA-256,
+	// 100000 iterations).
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportDeviceKeyBatchResponse) Reset()         { *m = ExportDeviceKeyBatchResponse{} }
+func (m *ExportDeviceKeyBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportDeviceKeyBatchResponse) ProtoMessage()    {}
+func (*ExportDeviceKeyBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{10}
+}
+func (m *ExportDeviceKeyBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportDeviceKeyBatchResponse.Unmarshal(m, b)
+}
+func (m *ExportDeviceKeyBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportDeviceKeyBatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportDeviceKeyBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDeviceKeyBatchResponse.Merge(dst, src)
+}
+func (m *ExportDeviceKeyBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportDeviceKeyBatchResponse.Size(m)
+}
+func (m *ExportDeviceKeyBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDeviceKeyBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportDeviceKeyBatchResponse proto.InternalMessageInfo
+
+func (m *ExportDeviceKeyBatchResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type GetDeviceKeyBatchReconciliationRequest struct {
+	// ID of the batch.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceKeyBatchReconciliationRequest) Reset() {
+	*m = GetDeviceKeyBatchReconciliationRequest{}
+}
+func (m *GetDeviceKeyBatchReconciliationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceKeyBatchReconciliationRequest) ProtoMessage()    {}
+func (*GetDeviceKeyBatchReconciliationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{11}
+}
+func (m *GetDeviceKeyBatchReconciliationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceKeyBatchReconciliationRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceKeyBatchReconciliationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceKeyBatchReconciliationRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceKeyBatchReconciliationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceKeyBatchReconciliationRequest.Merge(dst, src)
+}
+func (m *GetDeviceKeyBatchReconciliationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceKeyBatchReconciliationRequest.Size(m)
+}
+func (m *GetDeviceKeyBatchReconciliationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceKeyBatchReconciliationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceKeyBatchReconciliationRequest proto.InternalMessageInfo
+
+func (m *GetDeviceKeyBatchReconciliationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDeviceKeyBatchReconciliationResponse struct {
+	// Total number of keys.
+	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Keys for which no device has been created.
+	UnusedCount uint32 `protobuf:"varint,2,opt,name=unused_count,json=unusedCount,proto3" json:"unused_count,omitempty"`
+	// Keys for which a device has been created, but which did not join yet.
+	ProvisionedCount uint32 `protobuf:"varint,3,opt,name=provisioned_count,json=provisionedCount,proto3" json:"provisioned_count,omitempty"`
+	// Keys which have been used by a join of the device.
+	ConsumedCount uint32 `protobuf:"varint,4,opt,name=consumed_count,json=consumedCount,proto3" json:"consumed_count,omitempty"`
+	// DevEUIs of the created devices which did not join yet.
+	NotJoinedDevEuis []string `protobuf:"bytes,5,rep,name=not_joined_dev_euis,json=notJoinedDevEUIs,proto3" json:"not_joined_dev_euis,omitempty"`
+	// DevEUIs of the devices for which the root-key does not match the
+	// pre-provisioned AppKey.
+	KeyMismatchDevEuis   []string `protobuf:"bytes,6,rep,name=key_mismatch_dev_euis,json=keyMismatchDevEUIs,proto3" json:"key_mismatch_dev_euis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceKeyBatchReconciliationResponse) Reset() {
+	*m = GetDeviceKeyBatchReconciliationResponse{}
+}
+func (m *GetDeviceKeyBatchReconciliationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceKeyBatchReconciliationResponse) ProtoMessage()    {}
+func (*GetDeviceKeyBatchReconciliationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceKeyBatch_171bb2eda3058172, []int{12}
+}
+func (m *GetDeviceKeyBatchReconciliationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceKeyBatchReconciliationResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceKeyBatchReconciliationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceKeyBatchReconciliationResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceKeyBatchReconciliationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceKeyBatchReconciliationResponse.Merge(dst, src)
+}
+func (m *GetDeviceKeyBatchReconciliationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceKeyBatchReconciliationResponse.Size(m)
+}
+func (m *GetDeviceKeyBatchReconciliationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceKeyBatchReconciliationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceKeyBatchReconciliationResponse proto.InternalMessageInfo
+
+func (m *GetDeviceKeyBatchReconciliationResponse) GetTotalCount() uint32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *GetDeviceKeyBatchReconciliationResponse) GetUnusedCount() uint32 {
+	if m != nil {
+		return m.UnusedCount
+	}
+	return 0
+}
+
+func (m *GetDeviceKeyBatchReconciliationResponse) GetProvisionedCount() uint32 {
+	if m != nil {
+		return m.ProvisionedCount
+	}
+	return 0
+}
+
+func (m *GetDeviceKeyBatchReconciliationResponse) GetConsumedCount() uint32 {
+	if m != nil {
+		return m.ConsumedCount
+	}
+	return 0
+}
+
+func (m *GetDeviceKeyBatchReconciliationResponse) GetNotJoinedDevEuis() []string {
+	if m != nil {
+		return m.NotJoinedDevEuis
+	}
+	return nil
+}
+
+func (m *GetDeviceKeyBatchReconciliationResponse) GetKeyMismatchDevEuis() []string {
+	if m != nil {
+		return m.KeyMismatchDevEuis
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DeviceKeyBatch)(nil), "api.DeviceKeyBatch")
+	proto.RegisterType((*DeviceKeyBatchListItem)(nil), "api.DeviceKeyBatchListItem")
+	proto.RegisterType((*CreateDeviceKeyBatchRequest)(nil), "api.CreateDeviceKeyBatchRequest")
+	proto.RegisterType((*CreateDeviceKeyBatchResponse)(nil), "api.CreateDeviceKeyBatchResponse")
+	proto.RegisterType((*GetDeviceKeyBatchRequest)(nil), "api.GetDeviceKeyBatchRequest")
+	proto.RegisterType((*GetDeviceKeyBatchResponse)(nil), "api.GetDeviceKeyBatchResponse")
+	proto.RegisterType((*DeleteDeviceKeyBatchRequest)(nil), "api.DeleteDeviceKeyBatchRequest")
+	proto.RegisterType((*ListDeviceKeyBatchRequest)(nil), "api.ListDeviceKeyBatchRequest")
+	proto.RegisterType((*ListDeviceKeyBatchResponse)(nil), "api.ListDeviceKeyBatchResponse")
+	proto.RegisterType((*ExportDeviceKeyBatchRequest)(nil), "api.ExportDeviceKeyBatchRequest")
+	proto.RegisterType((*ExportDeviceKeyBatchResponse)(nil), "api.ExportDeviceKeyBatchResponse")
+	proto.RegisterType((*GetDeviceKeyBatchReconciliationRequest)(nil), "api.GetDeviceKeyBatchReconciliationRequest")
+	proto.RegisterType((*GetDeviceKeyBatchReconciliationResponse)(nil), "api.GetDeviceKeyBatchReconciliationResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DeviceKeyBatchServiceClient is the client API for DeviceKeyBatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeviceKeyBatchServiceClient interface {
+	// Create creates the given batch, allocating the DevEUIs from the given
+	// DevEUI block and generating a random AppKey for each DevEUI.
+	Create(ctx context.Context, in *CreateDeviceKeyBatchRequest, opts ...grpc.CallOption) (*CreateDeviceKeyBatchResponse, error)
+	// Get returns the batch for the given id.
+	Get(ctx context.Context, in *GetDeviceKeyBatchRequest, opts ...grpc.CallOption) (*GetDeviceKeyBatchResponse, error)
+	// Delete deletes the batch for the given id.
+	Delete(ctx context.Context, in *DeleteDeviceKeyBatchRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List lists the batches of the given organization.
+	List(ctx context.Context, in *ListDeviceKeyBatchRequest, opts ...grpc.CallOption) (*ListDeviceKeyBatchResponse, error)
+	// Export returns the keys of the batch as encrypted CSV, for the
+	// factory programmer.
+	Export(ctx context.Context, in *ExportDeviceKeyBatchRequest, opts ...grpc.CallOption) (*ExportDeviceKeyBatchResponse, error)
+	// GetReconciliation returns the reconciliation report of the batch,
+	// comparing the keys with the created devices and their joins.
+	GetReconciliation(ctx context.Context, in *GetDeviceKeyBatchReconciliationRequest, opts ...grpc.CallOption) (*GetDeviceKeyBatchReconciliationResponse, error)
+}
+
+type deviceKeyBatchServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeviceKeyBatchServiceClient(cc *grpc.ClientConn) DeviceKeyBatchServiceClient {
+	return &deviceKeyBatchServiceClient{cc}
+}
+
+func (c *deviceKeyBatchServiceClient) Create(ctx context.Context, in *CreateDeviceKeyBatchRequest, opts ...grpc.CallOption) (*CreateDeviceKeyBatchResponse, error) {
+	out := new(CreateDeviceKeyBatchResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceKeyBatchService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceKeyBatchServiceClient) Get(ctx context.Context, in *GetDeviceKeyBatchRequest, opts ...grpc.CallOption) (*GetDeviceKeyBatchResponse, error) {
+	out := new(GetDeviceKeyBatchResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceKeyBatchService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceKeyBatchServiceClient) Delete(ctx context.Context, in *DeleteDeviceKeyBatchRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceKeyBatchService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceKeyBatchServiceClient) List(ctx context.Context, in *ListDeviceKeyBatchRequest, opts ...grpc.CallOption) (*ListDeviceKeyBatchResponse, error) {
+	out := new(ListDeviceKeyBatchResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceKeyBatchService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceKeyBatchServiceClient) Export(ctx context.Context, in *ExportDeviceKeyBatchRequest, opts ...grpc.CallOption) (*ExportDeviceKeyBatchResponse, error) {
+	out := new(ExportDeviceKeyBatchResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceKeyBatchService/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceKeyBatchServiceClient) GetReconciliation(ctx context.Context, in *GetDeviceKeyBatchReconciliationRequest, opts ...grpc.CallOption) (*GetDeviceKeyBatchReconciliationResponse, error) {
+	out := new(GetDeviceKeyBatchReconciliationResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceKeyBatchService/GetReconciliation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceKeyBatchServiceServer is the server API for DeviceKeyBatchService service.
+type DeviceKeyBatchServiceServer interface {
+	// Create creates the given batch, allocating the DevEUIs from the given
+	// DevEUI block and generating a random AppKey for each DevEUI.
+	Create(context.Context, *CreateDeviceKeyBatchRequest) (*CreateDeviceKeyBatchResponse, error)
+	// Get returns the batch for the given id.
+	Get(context.Context, *GetDeviceKeyBatchRequest) (*GetDeviceKeyBatchResponse, error)
+	// Delete deletes the batch for the given id.
+	Delete(context.Context, *DeleteDeviceKeyBatchRequest) (*empty.Empty, error)
+	// List lists the batches of the given organization.
+	List(context.Context, *ListDeviceKeyBatchRequest) (*ListDeviceKeyBatchResponse, error)
+	// Export returns the keys of the batch as encrypted CSV, for the
+	// factory programmer.
+	Export(context.Context, *ExportDeviceKeyBatchRequest) (*ExportDeviceKeyBatchResponse, error)
+	// GetReconciliation returns the reconciliation report of the batch,
+	// comparing the keys with the created devices and their joins.
+	GetReconciliation(context.Context, *GetDeviceKeyBatchReconciliationRequest) (*GetDeviceKeyBatchReconciliationResponse, error)
+}
+
+func RegisterDeviceKeyBatchServiceServer(s *grpc.Server, srv DeviceKeyBatchServiceServer) {
+	s.RegisterService(&_DeviceKeyBatchService_serviceDesc, srv)
+}
+
+func _DeviceKeyBatchService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceKeyBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceKeyBatchServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceKeyBatchService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceKeyBatchServiceServer).Create(ctx, req.(*CreateDeviceKeyBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceKeyBatchService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceKeyBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceKeyBatchServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceKeyBatchService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceKeyBatchServiceServer).Get(ctx, req.(*GetDeviceKeyBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceKeyBatchService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceKeyBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceKeyBatchServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceKeyBatchService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceKeyBatchServiceServer).Delete(ctx, req.(*DeleteDeviceKeyBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceKeyBatchService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceKeyBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceKeyBatchServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceKeyBatchService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceKeyBatchServiceServer).List(ctx, req.(*ListDeviceKeyBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceKeyBatchService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDeviceKeyBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceKeyBatchServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceKeyBatchService/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceKeyBatchServiceServer).Export(ctx, req.(*ExportDeviceKeyBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceKeyBatchService_GetReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceKeyBatchReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceKeyBatchServiceServer).GetReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceKeyBatchService/GetReconciliation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceKeyBatchServiceServer).GetReconciliation(ctx, req.(*GetDeviceKeyBatchReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeviceKeyBatchService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.DeviceKeyBatchService",
+	HandlerType: (*DeviceKeyBatchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _DeviceKeyBatchService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DeviceKeyBatchService_Get_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DeviceKeyBatchService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _DeviceKeyBatchService_List_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _DeviceKeyBatchService_Export_Handler,
+		},
+		{
+			MethodName: "GetReconciliation",
+			Handler:    _DeviceKeyBatchService_GetReconciliation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "deviceKeyBatch.proto",
+}
+
+func init() {
+	proto.RegisterFile("deviceKeyBatch.proto", fileDescriptor_deviceKeyBatch_171bb2eda3058172)
+}
+
+var fileDescriptor_deviceKeyBatch_171bb2eda3058172 = []byte{
+	// 876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x76, 0xdb, 0x44,
+	0x10, 0x3e, 0xb2, 0x6c, 0x97, 0x8e, 0x1b, 0xd3, 0x6c, 0xd2, 0xa0, 0xc8, 0x26, 0x71, 0xc4, 0x4f,
+	0x4d, 0x82, 0xed, 0x83, 0x73, 0x03, 0xdc, 0xb5, 0x89, 0x4f, 0x08, 0xd0, 0x1b, 0x97, 0x5e, 0xeb,
+	0x6c, 0xac, 0x49, 0xb2, 0x8d, 0xa5, 0x55, 0xb5, 0xab, 0x1c, 0x0c, 0xa7, 0x37, 0xbc, 0x02, 0x8f,
+	0xd0, 0xd7, 0xe0, 0x1d, 0xb8, 0xe0, 0x15, 0xb8, 0xe0, 0x8e, 0x57, 0xe0, 0x68, 0x77, 0x1d, 0x2c,
+	0x47, 0x72, 0xd2, 0x3b, 0xcd, 0xcc, 0x37, 0xfb, 0xed, 0x37, 0xb3, 0x33, 0x82, 0xcd, 0x00, 0xaf,
+	0xd9, 0x04, 0x7f, 0xc0, 0xd9, 0x73, 0x2a, 0x27, 0x97, 0xfd, 0x38, 0xe1, 0x92, 0x13, 0x9b, 0xc6,
+	0xcc, 0x6d, 0x5f, 0x70, 0x7e, 0x31, 0xc5, 0x01, 0x8d, 0xd9, 0x80, 0x46, 0x11, 0x97, 0x54, 0x32,
+	0x1e, 0x09, 0x0d, 0x71, 0x77, 0x4d, 0x54, 0x59, 0x67, 0xe9, 0xf9, 0x40, 0xb2, 0x10, 0x85, 0xa4,
+	0x61, 0x6c, 0x00, 0xad, 0x65, 0x00, 0x86, 0xb1, 0x9c, 0xe9, 0xa0, 0xf7, 0x8f, 0x05, 0xcd, 0xe3,
+	0x1c, 0x33, 0x69, 0x42, 0x85, 0x05, 0x8e, 0xd5, 0xb1, 0xba, 0xf6, 0xb8, 0xc2, 0x02, 0xf2, 0x14,
+	0x3e, 0xe4, 0xc9, 0x05, 0x8d, 0xd8, 0x2f, 0x8a, 0xd7, 0x67, 0x81, 0x53, 0x51, 0xc1, 0xe6, 0xa2,
+	0xfb, 0xf4, 0x98, 0x10, 0xa8, 0x46, 0x34, 0x44, 0xc7, 0xee, 0x58, 0xdd, 0x87, 0x63, 0xf5, 0x4d,
+	0xb6, 0xe1, 0x83, 0xd7, 0x9c, 0x45, 0x3e, 0xa6, 0xcc, 0xa9, 0x2a, 0xff, 0x83, 0xcc, 0x1e, 0xbd,
+	0x3a, 0x25, 0x3d, 0xd8, 0x08, 0xf0, 0x3a, 0x8b, 0xf8, 0x67, 0x53, 0x3e, 0xb9, 0xf2, 0x85, 0xa4,
+	0x89, 0x74, 0x6a, 0x0a, 0xf5, 0x38, 0xc0, 0xeb, 0xd1, 0xab, 0xd3, 0xe7, 0x59, 0xe0, 0x65, 0xe6,
+	0x27, 0x5f, 0xc0, 0x7a, 0x1e, 0x8e, 0x51, 0xe0, 0xd4, 0x15, 0xb8, 0xb9, 0x00, 0x1e, 0x45, 0x01,
+	0xd9, 0x84, 0xda, 0x84, 0xa7, 0x91, 0x74, 0x1e, 0x74, 0xac, 0xee, 0xda, 0x58, 0x1b, 0xde, 0x9f,
+	0x16, 0x6c, 0xe5, 0xa5, 0xfe, 0xc8, 0x84, 0x3c, 0x95, 0x18, 0xde, 0x92, 0x3c, 0x57, 0x52, 0x29,
+	0x51, 0x62, 0xe7, 0x95, 0xdc, 0xf0, 0x55, 0x17, 0xf8, 0xc8, 0x67, 0xd0, 0x9c, 0xf0, 0x48, 0xa4,
+	0x21, 0x06, 0xbe, 0x0e, 0xd7, 0x54, 0x78, 0x6d, 0xee, 0x3d, 0x52, 0xb0, 0x6f, 0x00, 0x26, 0x09,
+	0x52, 0x89, 0x81, 0x4f, 0xa5, 0x12, 0xd4, 0x18, 0xba, 0x7d, 0xdd, 0xb3, 0xfe, 0xbc, 0x67, 0xfd,
+	0x9f, 0xe6, 0x4d, 0x1d, 0x3f, 0x34, 0xe8, 0x67, 0xd2, 0xfb, 0x0e, 0x5a, 0x47, 0xca, 0xc8, 0xcb,
+	0x1a, 0xe3, 0x9b, 0x14, 0x45, 0x56, 0xb1, 0xda, 0x59, 0x66, 0x2b, 0x61, 0x8d, 0xe1, 0x46, 0x9f,
+	0xc6, 0xac, 0xbf, 0x04, 0xd5, 0x08, 0xaf, 0x0f, 0xed, 0xe2, 0x93, 0x44, 0xcc, 0x23, 0x81, 0xcb,
+	0x05, 0xf2, 0xf6, 0xc1, 0x39, 0x41, 0x59, 0x4c, 0xbb, 0x8c, 0xfd, 0xc3, 0x82, 0xed, 0x02, 0xb0,
+	0x39, 0xf9, 0xfe, 0x97, 0x5c, 0xaa, 0x54, 0xe5, 0x3d, 0x2a, 0x95, 0xa5, 0xa6, 0x71, 0x30, 0x4f,
+	0xb5, 0xef, 0x4e, 0x35, 0xe8, 0x67, 0xd2, 0xeb, 0x41, 0xeb, 0x18, 0xa7, 0x28, 0xf1, 0x7e, 0x6a,
+	0x13, 0xd8, 0xce, 0x9e, 0x55, 0x31, 0x78, 0x13, 0x6a, 0x53, 0x16, 0x32, 0x69, 0xf0, 0xda, 0x20,
+	0x5b, 0x50, 0xe7, 0xe7, 0xe7, 0x02, 0xa5, 0x99, 0x2b, 0x63, 0x15, 0x0d, 0x9e, 0x5d, 0x34, 0x78,
+	0x5e, 0x02, 0x6e, 0x11, 0xa7, 0xa9, 0xf0, 0x2e, 0x34, 0x24, 0x97, 0x74, 0x6a, 0x1e, 0xa1, 0xa6,
+	0x06, 0xe5, 0xd2, 0x2f, 0xf0, 0x10, 0xea, 0x09, 0x8a, 0x74, 0x9a, 0xf1, 0xdb, 0xdd, 0xc6, 0xb0,
+	0x55, 0xd0, 0x83, 0xf9, 0xa8, 0x8c, 0x0d, 0xd4, 0x7b, 0x01, 0xad, 0xd1, 0xcf, 0x31, 0x4f, 0xee,
+	0xf7, 0x08, 0xc8, 0x0e, 0x40, 0x4c, 0x85, 0x88, 0x2f, 0x13, 0x2a, 0xe6, 0x73, 0xb5, 0xe0, 0xf1,
+	0x86, 0xd0, 0x2e, 0x3e, 0xce, 0x88, 0x20, 0x50, 0x0d, 0xa8, 0xa4, 0xea, 0xc4, 0x47, 0x63, 0xf5,
+	0xed, 0x7d, 0x0d, 0x9f, 0x17, 0xbc, 0xab, 0x09, 0x8f, 0x26, 0x6c, 0xca, 0x54, 0x6d, 0xca, 0x9a,
+	0xf4, 0xae, 0x02, 0x4f, 0xef, 0x4c, 0x2d, 0x2f, 0xdf, 0x5a, 0xae, 0x7c, 0x7b, 0xf0, 0x28, 0x8d,
+	0x52, 0x71, 0x33, 0xe5, 0x15, 0x85, 0x68, 0x68, 0x9f, 0x86, 0x1c, 0xc0, 0x7a, 0x9c, 0xf0, 0x6b,
+	0x26, 0x18, 0x8f, 0x6e, 0x70, 0xb6, 0xc2, 0x3d, 0x5e, 0x08, 0x1c, 0x95, 0xec, 0x8d, 0x6a, 0xd1,
+	0xde, 0xe8, 0xc1, 0x46, 0xc4, 0xa5, 0x9f, 0xed, 0x20, 0x0c, 0x7c, 0xb3, 0x1a, 0x85, 0x53, 0xeb,
+	0xd8, 0xd9, 0xfa, 0x8c, 0xb8, 0xfc, 0x5e, 0x45, 0x8e, 0xd5, 0x6a, 0x14, 0xe4, 0x2b, 0x78, 0x72,
+	0x85, 0x33, 0x3f, 0x64, 0x22, 0xcc, 0xc4, 0xfe, 0x9f, 0x50, 0x57, 0x09, 0xe4, 0x0a, 0x67, 0x2f,
+	0x4c, 0xcc, 0xa4, 0x0c, 0xff, 0xad, 0xc1, 0x93, 0x7c, 0x89, 0x5e, 0x62, 0x92, 0x99, 0xe4, 0x0d,
+	0xd4, 0xf5, 0xba, 0x20, 0x1d, 0xf5, 0x56, 0x56, 0x6c, 0x21, 0x77, 0x6f, 0x05, 0x42, 0x97, 0xd8,
+	0xf3, 0x7e, 0xfb, 0xeb, 0xef, 0xdf, 0x2b, 0x6d, 0xef, 0x23, 0xf5, 0x8b, 0xd3, 0x3f, 0xc2, 0xde,
+	0x15, 0xce, 0x7a, 0x6a, 0xee, 0x51, 0x7c, 0x6b, 0xed, 0x93, 0xd7, 0x60, 0x9f, 0xa0, 0x24, 0x1f,
+	0xab, 0xd3, 0xca, 0x76, 0x8f, 0xbb, 0x53, 0x16, 0x36, 0x4c, 0x9f, 0x2a, 0xa6, 0x1d, 0xd2, 0x2e,
+	0x61, 0x1a, 0xfc, 0xca, 0x82, 0xb7, 0xe4, 0x12, 0xea, 0x7a, 0xe4, 0x8d, 0xbc, 0x15, 0xf3, 0xef,
+	0x6e, 0xdd, 0xda, 0x22, 0xa3, 0xec, 0xf7, 0x3a, 0x67, 0xda, 0xbf, 0x8b, 0xa9, 0x9a, 0x4d, 0x16,
+	0xd1, 0xf7, 0x2e, 0x5d, 0x1c, 0xee, 0x6e, 0x69, 0xdc, 0x08, 0xdb, 0x55, 0x74, 0xdb, 0xa4, 0xac,
+	0x84, 0xe4, 0x2d, 0xd4, 0xf5, 0x80, 0x19, 0x4d, 0x2b, 0x86, 0xd7, 0xdd, 0x5b, 0x81, 0x30, 0x7c,
+	0x7d, 0xc5, 0xd7, 0xf5, 0x3e, 0x59, 0x25, 0x6f, 0x80, 0xea, 0x88, 0xac, 0x7d, 0xef, 0x2c, 0x58,
+	0x3f, 0x41, 0x99, 0x9f, 0x31, 0x72, 0x50, 0xd6, 0xae, 0x82, 0x21, 0x76, 0xbf, 0xbc, 0x1f, 0xd8,
+	0x5c, 0xf0, 0x50, 0x5d, 0xb0, 0x47, 0x0e, 0x56, 0x5e, 0x30, 0xc9, 0x25, 0x9f, 0xd5, 0x55, 0x13,
+	0x0f, 0xff, 0x1b, 0x00, 0xfa, 0x86, 0x34, 0x62, 0x8d, 0x09, 0x00, 0x00,
+}
@@ -26,6 +26,483 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type PayloadCodecStageType int32
+
+const (
+	// Executes the Process(fPort, data) function of the script.
+	// For pre-processors, data is the payload byte array and an array of
+	// bytes must be returned. For post-processors, data is the decoded
+	// object and an object must be returned.
+	PayloadCodecStageType_CODEC_STAGE_CUSTOM_JS PayloadCodecStageType = 0
+	// Renames the fields of the decoded object.
+	PayloadCodecStageType_CODEC_STAGE_RENAME_FIELDS PayloadCodecStageType = 1
+	// Scales the numeric fields of the decoded object
+	// (value * factor + offset).
+	PayloadCodecStageType_CODEC_STAGE_SCALE_FIELDS PayloadCodecStageType = 2
+)
+
+var PayloadCodecStageType_name = map[int32]string{
+	0: "CODEC_STAGE_CUSTOM_JS",
+	1: "CODEC_STAGE_RENAME_FIELDS",
+	2: "CODEC_STAGE_SCALE_FIELDS",
+}
+var PayloadCodecStageType_value = map[string]int32{
+	"CODEC_STAGE_CUSTOM_JS":     0,
+	"CODEC_STAGE_RENAME_FIELDS": 1,
+	"CODEC_STAGE_SCALE_FIELDS":  2,
+}
+
+func (x PayloadCodecStageType) String() string {
+	return proto.EnumName(PayloadCodecStageType_name, int32(x))
+}
+func (PayloadCodecStageType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{0}
+}
+
+type PayloadCodecFieldScale struct {
+	// Field path (dot separated, e.g. "sensor.temperature").
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Factor.
+	Factor float64 `protobuf:"fixed64,2,opt,name=factor,proto3" json:"factor,omitempty"`
+	// Offset.
+	Offset               float64  `protobuf:"fixed64,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayloadCodecFieldScale) Reset()         { *m = PayloadCodecFieldScale{} }
+func (m *PayloadCodecFieldScale) String() string { return proto.CompactTextString(m) }
+func (*PayloadCodecFieldScale) ProtoMessage()    {}
+func (*PayloadCodecFieldScale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{0}
+}
+func (m *PayloadCodecFieldScale) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayloadCodecFieldScale.Unmarshal(m, b)
+}
+func (m *PayloadCodecFieldScale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayloadCodecFieldScale.Marshal(b, m, deterministic)
+}
+func (dst *PayloadCodecFieldScale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadCodecFieldScale.Merge(dst, src)
+}
+func (m *PayloadCodecFieldScale) XXX_Size() int {
+	return xxx_messageInfo_PayloadCodecFieldScale.Size(m)
+}
+func (m *PayloadCodecFieldScale) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadCodecFieldScale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadCodecFieldScale proto.InternalMessageInfo
+
+func (m *PayloadCodecFieldScale) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *PayloadCodecFieldScale) GetFactor() float64 {
+	if m != nil {
+		return m.Factor
+	}
+	return 0
+}
+
+func (m *PayloadCodecFieldScale) GetOffset() float64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type PayloadCodecStage struct {
+	// Name of the stage.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Stage type.
+	Type PayloadCodecStageType `protobuf:"varint,2,opt,name=type,proto3,enum=api.PayloadCodecStageType" json:"type,omitempty"`
+	// Script (CODEC_STAGE_CUSTOM_JS).
+	Script string `protobuf:"bytes,3,opt,name=script,proto3" json:"script,omitempty"`
+	// Field renames, from field path to field path
+	// (CODEC_STAGE_RENAME_FIELDS).
+	Renames map[string]string `protobuf:"bytes,4,rep,name=renames,proto3" json:"renames,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Field scales (CODEC_STAGE_SCALE_FIELDS).
+	Scales               []*PayloadCodecFieldScale `protobuf:"bytes,5,rep,name=scales,proto3" json:"scales,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *PayloadCodecStage) Reset()         { *m = PayloadCodecStage{} }
+func (m *PayloadCodecStage) String() string { return proto.CompactTextString(m) }
+func (*PayloadCodecStage) ProtoMessage()    {}
+func (*PayloadCodecStage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{1}
+}
+func (m *PayloadCodecStage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayloadCodecStage.Unmarshal(m, b)
+}
+func (m *PayloadCodecStage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayloadCodecStage.Marshal(b, m, deterministic)
+}
+func (dst *PayloadCodecStage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadCodecStage.Merge(dst, src)
+}
+func (m *PayloadCodecStage) XXX_Size() int {
+	return xxx_messageInfo_PayloadCodecStage.Size(m)
+}
+func (m *PayloadCodecStage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadCodecStage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadCodecStage proto.InternalMessageInfo
+
+func (m *PayloadCodecStage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PayloadCodecStage) GetType() PayloadCodecStageType {
+	if m != nil {
+		return m.Type
+	}
+	return PayloadCodecStageType_CODEC_STAGE_CUSTOM_JS
+}
+
+func (m *PayloadCodecStage) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *PayloadCodecStage) GetRenames() map[string]string {
+	if m != nil {
+		return m.Renames
+	}
+	return nil
+}
+
+func (m *PayloadCodecStage) GetScales() []*PayloadCodecFieldScale {
+	if m != nil {
+		return m.Scales
+	}
+	return nil
+}
+
+type PayloadCodecChain struct {
+	// Stages applied in order to the payload bytes, before the payload codec
+	// of the application decodes the payload.
+	// Only CODEC_STAGE_CUSTOM_JS stages can be used as pre-processor.
+	PreProcessors []*PayloadCodecStage `protobuf:"bytes,1,rep,name=pre_processors,json=preProcessors,proto3" json:"pre_processors,omitempty"`
+	// Stages applied in order to the object decoded by the payload codec of
+	// the application.
+	PostProcessors       []*PayloadCodecStage `protobuf:"bytes,2,rep,name=post_processors,json=postProcessors,proto3" json:"post_processors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PayloadCodecChain) Reset()         { *m = PayloadCodecChain{} }
+func (m *PayloadCodecChain) String() string { return proto.CompactTextString(m) }
+func (*PayloadCodecChain) ProtoMessage()    {}
+func (*PayloadCodecChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{2}
+}
+func (m *PayloadCodecChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayloadCodecChain.Unmarshal(m, b)
+}
+func (m *PayloadCodecChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayloadCodecChain.Marshal(b, m, deterministic)
+}
+func (dst *PayloadCodecChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadCodecChain.Merge(dst, src)
+}
+func (m *PayloadCodecChain) XXX_Size() int {
+	return xxx_messageInfo_PayloadCodecChain.Size(m)
+}
+func (m *PayloadCodecChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadCodecChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadCodecChain proto.InternalMessageInfo
+
+func (m *PayloadCodecChain) GetPreProcessors() []*PayloadCodecStage {
+	if m != nil {
+		return m.PreProcessors
+	}
+	return nil
+}
+
+func (m *PayloadCodecChain) GetPostProcessors() []*PayloadCodecStage {
+	if m != nil {
+		return m.PostProcessors
+	}
+	return nil
+}
+
+type GetPayloadCodecChainRequest struct {
+	// Device-profile ID (UUID string).
+	DeviceProfileId      string   `protobuf:"bytes,1,opt,name=device_profile_id,json=deviceProfileID,proto3" json:"device_profile_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPayloadCodecChainRequest) Reset()         { *m = GetPayloadCodecChainRequest{} }
+func (m *GetPayloadCodecChainRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPayloadCodecChainRequest) ProtoMessage()    {}
+func (*GetPayloadCodecChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{3}
+}
+func (m *GetPayloadCodecChainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayloadCodecChainRequest.Unmarshal(m, b)
+}
+func (m *GetPayloadCodecChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayloadCodecChainRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetPayloadCodecChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayloadCodecChainRequest.Merge(dst, src)
+}
+func (m *GetPayloadCodecChainRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPayloadCodecChainRequest.Size(m)
+}
+func (m *GetPayloadCodecChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayloadCodecChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPayloadCodecChainRequest proto.InternalMessageInfo
+
+func (m *GetPayloadCodecChainRequest) GetDeviceProfileId() string {
+	if m != nil {
+		return m.DeviceProfileId
+	}
+	return ""
+}
+
+type GetPayloadCodecChainResponse struct {
+	// Payload codec chain.
+	Chain                *PayloadCodecChain `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetPayloadCodecChainResponse) Reset()         { *m = GetPayloadCodecChainResponse{} }
+func (m *GetPayloadCodecChainResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPayloadCodecChainResponse) ProtoMessage()    {}
+func (*GetPayloadCodecChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{4}
+}
+func (m *GetPayloadCodecChainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayloadCodecChainResponse.Unmarshal(m, b)
+}
+func (m *GetPayloadCodecChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayloadCodecChainResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetPayloadCodecChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayloadCodecChainResponse.Merge(dst, src)
+}
+func (m *GetPayloadCodecChainResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPayloadCodecChainResponse.Size(m)
+}
+func (m *GetPayloadCodecChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayloadCodecChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPayloadCodecChainResponse proto.InternalMessageInfo
+
+func (m *GetPayloadCodecChainResponse) GetChain() *PayloadCodecChain {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
+type UpdatePayloadCodecChainRequest struct {
+	// Device-profile ID (UUID string).
+	DeviceProfileId string `protobuf:"bytes,1,opt,name=device_profile_id,json=deviceProfileID,proto3" json:"device_profile_id,omitempty"`
+	// Payload codec chain.
+	// An empty chain removes the chain.
+	Chain                *PayloadCodecChain `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *UpdatePayloadCodecChainRequest) Reset()         { *m = UpdatePayloadCodecChainRequest{} }
+func (m *UpdatePayloadCodecChainRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePayloadCodecChainRequest) ProtoMessage()    {}
+func (*UpdatePayloadCodecChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{5}
+}
+func (m *UpdatePayloadCodecChainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdatePayloadCodecChainRequest.Unmarshal(m, b)
+}
+func (m *UpdatePayloadCodecChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdatePayloadCodecChainRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdatePayloadCodecChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePayloadCodecChainRequest.Merge(dst, src)
+}
+func (m *UpdatePayloadCodecChainRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdatePayloadCodecChainRequest.Size(m)
+}
+func (m *UpdatePayloadCodecChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePayloadCodecChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePayloadCodecChainRequest proto.InternalMessageInfo
+
+func (m *UpdatePayloadCodecChainRequest) GetDeviceProfileId() string {
+	if m != nil {
+		return m.DeviceProfileId
+	}
+	return ""
+}
+
+func (m *UpdatePayloadCodecChainRequest) GetChain() *PayloadCodecChain {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
+type TestPayloadCodecStageRequest struct {
+	// Device-profile ID (UUID string).
+	DeviceProfileId string `protobuf:"bytes,1,opt,name=device_profile_id,json=deviceProfileID,proto3" json:"device_profile_id,omitempty"`
+	// Stage to test.
+	Stage *PayloadCodecStage `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	// Test the stage as pre-processor.
+	// When set, the data is used as input, else the object_json.
+	PreProcessor bool `protobuf:"varint,3,opt,name=pre_processor,json=preProcessor,proto3" json:"pre_processor,omitempty"`
+	// FPort.
+	FPort uint32 `protobuf:"varint,4,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Input payload bytes (pre-processor).
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// Input object as JSON (post-processor).
+	ObjectJson           string   `protobuf:"bytes,6,opt,name=object_json,json=objectJSON,proto3" json:"object_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TestPayloadCodecStageRequest) Reset()         { *m = TestPayloadCodecStageRequest{} }
+func (m *TestPayloadCodecStageRequest) String() string { return proto.CompactTextString(m) }
+func (*TestPayloadCodecStageRequest) ProtoMessage()    {}
+func (*TestPayloadCodecStageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{6}
+}
+func (m *TestPayloadCodecStageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestPayloadCodecStageRequest.Unmarshal(m, b)
+}
+func (m *TestPayloadCodecStageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestPayloadCodecStageRequest.Marshal(b, m, deterministic)
+}
+func (dst *TestPayloadCodecStageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestPayloadCodecStageRequest.Merge(dst, src)
+}
+func (m *TestPayloadCodecStageRequest) XXX_Size() int {
+	return xxx_messageInfo_TestPayloadCodecStageRequest.Size(m)
+}
+func (m *TestPayloadCodecStageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestPayloadCodecStageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestPayloadCodecStageRequest proto.InternalMessageInfo
+
+func (m *TestPayloadCodecStageRequest) GetDeviceProfileId() string {
+	if m != nil {
+		return m.DeviceProfileId
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecStageRequest) GetStage() *PayloadCodecStage {
+	if m != nil {
+		return m.Stage
+	}
+	return nil
+}
+
+func (m *TestPayloadCodecStageRequest) GetPreProcessor() bool {
+	if m != nil {
+		return m.PreProcessor
+	}
+	return false
+}
+
+func (m *TestPayloadCodecStageRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *TestPayloadCodecStageRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TestPayloadCodecStageRequest) GetObjectJson() string {
+	if m != nil {
+		return m.ObjectJson
+	}
+	return ""
+}
+
+type TestPayloadCodecStageResponse struct {
+	// Output payload bytes (pre-processor).
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Output object as JSON (post-processor).
+	ObjectJson           string   `protobuf:"bytes,2,opt,name=object_json,json=objectJSON,proto3" json:"object_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TestPayloadCodecStageResponse) Reset()         { *m = TestPayloadCodecStageResponse{} }
+func (m *TestPayloadCodecStageResponse) String() string { return proto.CompactTextString(m) }
+func (*TestPayloadCodecStageResponse) ProtoMessage()    {}
+func (*TestPayloadCodecStageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{7}
+}
+func (m *TestPayloadCodecStageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestPayloadCodecStageResponse.Unmarshal(m, b)
+}
+func (m *TestPayloadCodecStageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestPayloadCodecStageResponse.Marshal(b, m, deterministic)
+}
+func (dst *TestPayloadCodecStageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestPayloadCodecStageResponse.Merge(dst, src)
+}
+func (m *TestPayloadCodecStageResponse) XXX_Size() int {
+	return xxx_messageInfo_TestPayloadCodecStageResponse.Size(m)
+}
+func (m *TestPayloadCodecStageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestPayloadCodecStageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestPayloadCodecStageResponse proto.InternalMessageInfo
+
+func (m *TestPayloadCodecStageResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TestPayloadCodecStageResponse) GetObjectJson() string {
+	if m != nil {
+		return m.ObjectJson
+	}
+	return ""
+}
+
 type CreateDeviceProfileRequest struct {
 	// Device-profile object to create.
 	DeviceProfile        *DeviceProfile `protobuf:"bytes,1,opt,name=device_profile,json=deviceProfile,proto3" json:"device_profile,omitempty"`
@@ -38,7 +515,7 @@ func (m *CreateDeviceProfileRequest) Reset()         { *m = CreateDeviceProfileR
 func (m *CreateDeviceProfileRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateDeviceProfileRequest) ProtoMessage()    {}
 func (*CreateDeviceProfileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceProfile_b4dd410e0cee7d90, []int{0}
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{8}
 }
 func (m *CreateDeviceProfileRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDeviceProfileRequest.Unmarshal(m, b)
@@ -77,7 +554,7 @@ func (m *CreateDeviceProfileResponse) Reset()         { *m = CreateDeviceProfile
 func (m *CreateDeviceProfileResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateDeviceProfileResponse) ProtoMessage()    {}
 func (*CreateDeviceProfileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceProfile_b4dd410e0cee7d90, []int{1}
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{9}
 }
 func (m *CreateDeviceProfileResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDeviceProfileResponse.Unmarshal(m, b)
@@ -116,7 +593,7 @@ func (m *GetDeviceProfileRequest) Reset()         { *m = GetDeviceProfileRequest
 func (m *GetDeviceProfileRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceProfileRequest) ProtoMessage()    {}
 func (*GetDeviceProfileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceProfile_b4dd410e0cee7d90, []int{2}
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{10}
 }
 func (m *GetDeviceProfileRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceProfileRequest.Unmarshal(m, b)
@@ -159,7 +636,7 @@ func (m *GetDeviceProfileResponse) Reset()         { *m = GetDeviceProfileRespon
 func (m *GetDeviceProfileResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceProfileResponse) ProtoMessage()    {}
 func (*GetDeviceProfileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceProfile_b4dd410e0cee7d90, []int{3}
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{11}
 }
 func (m *GetDeviceProfileResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceProfileResponse.Unmarshal(m, b)
@@ -212,7 +689,7 @@ func (m *UpdateDeviceProfileRequest) Reset()         { *m = UpdateDeviceProfileR
 func (m *UpdateDeviceProfileRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateDeviceProfileRequest) ProtoMessage()    {}
 func (*UpdateDeviceProfileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceProfile_b4dd410e0cee7d90, []int{4}
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{12}
 }
 func (m *UpdateDeviceProfileRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDeviceProfileRequest.Unmarshal(m, b)
@@ -251,7 +728,7 @@ func (m *DeleteDeviceProfileRequest) Reset()         { *m = DeleteDeviceProfileR
 func (m *DeleteDeviceProfileRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteDeviceProfileRequest) ProtoMessage()    {}
 func (*DeleteDeviceProfileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceProfile_b4dd410e0cee7d90, []int{5}
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{13}
 }
 func (m *DeleteDeviceProfileRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteDeviceProfileRequest.Unmarshal(m, b)
@@ -290,17 +767,19 @@ type DeviceProfileListItem struct {
 	// Created at timestamp.
 	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Last update timestamp.
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Device-profile is shared with all organizations.
+	IsShared             bool     `protobuf:"varint,7,opt,name=is_shared,json=isShared,proto3" json:"is_shared,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DeviceProfileListItem) Reset()         { *m = DeviceProfileListItem{} }
 func (m *DeviceProfileListItem) String() string { return proto.CompactTextString(m) }
 func (*DeviceProfileListItem) ProtoMessage()    {}
 func (*DeviceProfileListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceProfile_b4dd410e0cee7d90, []int{6}
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{14}
 }
 func (m *DeviceProfileListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeviceProfileListItem.Unmarshal(m, b)
@@ -362,6 +841,13 @@ func (m *DeviceProfileListItem) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *DeviceProfileListItem) GetIsShared() bool {
+	if m != nil {
+		return m.IsShared
+	}
+	return false
+}
+
 type ListDeviceProfileRequest struct {
 	// Max number of items to return.
 	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -380,7 +866,7 @@ func (m *ListDeviceProfileRequest) Reset()         { *m = ListDeviceProfileReque
 func (m *ListDeviceProfileRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDeviceProfileRequest) ProtoMessage()    {}
 func (*ListDeviceProfileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceProfile_b4dd410e0cee7d90, []int{7}
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{15}
 }
 func (m *ListDeviceProfileRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListDeviceProfileRequest.Unmarshal(m, b)
@@ -441,7 +927,7 @@ func (m *ListDeviceProfileResponse) Reset()         { *m = ListDeviceProfileResp
 func (m *ListDeviceProfileResponse) String() string { return proto.CompactTextString(m) }
 func (*ListDeviceProfileResponse) ProtoMessage()    {}
 func (*ListDeviceProfileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceProfile_b4dd410e0cee7d90, []int{8}
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{16}
 }
 func (m *ListDeviceProfileResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListDeviceProfileResponse.Unmarshal(m, b)
@@ -475,7 +961,112 @@ func (m *ListDeviceProfileResponse) GetResult() []*DeviceProfileListItem {
 	return nil
 }
 
+type CopyDeviceProfileRequest struct {
+	// Device-profile ID (UUID string) of the device-profile to copy.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID which will own the copy.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Name of the copy.
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CopyDeviceProfileRequest) Reset()         { *m = CopyDeviceProfileRequest{} }
+func (m *CopyDeviceProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*CopyDeviceProfileRequest) ProtoMessage()    {}
+func (*CopyDeviceProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{17}
+}
+func (m *CopyDeviceProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopyDeviceProfileRequest.Unmarshal(m, b)
+}
+func (m *CopyDeviceProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopyDeviceProfileRequest.Marshal(b, m, deterministic)
+}
+func (dst *CopyDeviceProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyDeviceProfileRequest.Merge(dst, src)
+}
+func (m *CopyDeviceProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_CopyDeviceProfileRequest.Size(m)
+}
+func (m *CopyDeviceProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyDeviceProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyDeviceProfileRequest proto.InternalMessageInfo
+
+func (m *CopyDeviceProfileRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CopyDeviceProfileRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *CopyDeviceProfileRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CopyDeviceProfileResponse struct {
+	// Device-profile ID (UUID string) of the copy.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CopyDeviceProfileResponse) Reset()         { *m = CopyDeviceProfileResponse{} }
+func (m *CopyDeviceProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*CopyDeviceProfileResponse) ProtoMessage()    {}
+func (*CopyDeviceProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceProfile_ec837b0fd61bb19c, []int{18}
+}
+func (m *CopyDeviceProfileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopyDeviceProfileResponse.Unmarshal(m, b)
+}
+func (m *CopyDeviceProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopyDeviceProfileResponse.Marshal(b, m, deterministic)
+}
+func (dst *CopyDeviceProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyDeviceProfileResponse.Merge(dst, src)
+}
+func (m *CopyDeviceProfileResponse) XXX_Size() int {
+	return xxx_messageInfo_CopyDeviceProfileResponse.Size(m)
+}
+func (m *CopyDeviceProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyDeviceProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyDeviceProfileResponse proto.InternalMessageInfo
+
+func (m *CopyDeviceProfileResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterType((*PayloadCodecFieldScale)(nil), "api.PayloadCodecFieldScale")
+	proto.RegisterType((*PayloadCodecStage)(nil), "api.PayloadCodecStage")
+	proto.RegisterMapType((map[string]string)(nil), "api.PayloadCodecStage.RenamesEntry")
+	proto.RegisterType((*PayloadCodecChain)(nil), "api.PayloadCodecChain")
+	proto.RegisterType((*GetPayloadCodecChainRequest)(nil), "api.GetPayloadCodecChainRequest")
+	proto.RegisterType((*GetPayloadCodecChainResponse)(nil), "api.GetPayloadCodecChainResponse")
+	proto.RegisterType((*UpdatePayloadCodecChainRequest)(nil), "api.UpdatePayloadCodecChainRequest")
+	proto.RegisterType((*TestPayloadCodecStageRequest)(nil), "api.TestPayloadCodecStageRequest")
+	proto.RegisterType((*TestPayloadCodecStageResponse)(nil), "api.TestPayloadCodecStageResponse")
 	proto.RegisterType((*CreateDeviceProfileRequest)(nil), "api.CreateDeviceProfileRequest")
 	proto.RegisterType((*CreateDeviceProfileResponse)(nil), "api.CreateDeviceProfileResponse")
 	proto.RegisterType((*GetDeviceProfileRequest)(nil), "api.GetDeviceProfileRequest")
@@ -485,6 +1076,9 @@ func init() {
 	proto.RegisterType((*DeviceProfileListItem)(nil), "api.DeviceProfileListItem")
 	proto.RegisterType((*ListDeviceProfileRequest)(nil), "api.ListDeviceProfileRequest")
 	proto.RegisterType((*ListDeviceProfileResponse)(nil), "api.ListDeviceProfileResponse")
+	proto.RegisterType((*CopyDeviceProfileRequest)(nil), "api.CopyDeviceProfileRequest")
+	proto.RegisterType((*CopyDeviceProfileResponse)(nil), "api.CopyDeviceProfileResponse")
+	proto.RegisterEnum("api.PayloadCodecStageType", PayloadCodecStageType_name, PayloadCodecStageType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -509,6 +1103,18 @@ type DeviceProfileServiceClient interface {
 	Delete(ctx context.Context, in *DeleteDeviceProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// List lists the available device-profiles.
 	List(ctx context.Context, in *ListDeviceProfileRequest, opts ...grpc.CallOption) (*ListDeviceProfileResponse, error)
+	// Copy creates an organization owned copy of the given device-profile.
+	// This can be used to customize a shared device-profile.
+	Copy(ctx context.Context, in *CopyDeviceProfileRequest, opts ...grpc.CallOption) (*CopyDeviceProfileResponse, error)
+	// GetPayloadCodecChain returns the payload codec chain of the given
+	// device-profile.
+	GetPayloadCodecChain(ctx context.Context, in *GetPayloadCodecChainRequest, opts ...grpc.CallOption) (*GetPayloadCodecChainResponse, error)
+	// UpdatePayloadCodecChain updates the payload codec chain of the given
+	// device-profile.
+	UpdatePayloadCodecChain(ctx context.Context, in *UpdatePayloadCodecChainRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// TestPayloadCodecStage executes a single codec chain stage on the given
+	// input, so that each stage can be tested independently.
+	TestPayloadCodecStage(ctx context.Context, in *TestPayloadCodecStageRequest, opts ...grpc.CallOption) (*TestPayloadCodecStageResponse, error)
 }
 
 type deviceProfileServiceClient struct {
@@ -564,6 +1170,42 @@ func (c *deviceProfileServiceClient) List(ctx context.Context, in *ListDevicePro
 	return out, nil
 }
 
+func (c *deviceProfileServiceClient) Copy(ctx context.Context, in *CopyDeviceProfileRequest, opts ...grpc.CallOption) (*CopyDeviceProfileResponse, error) {
+	out := new(CopyDeviceProfileResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceProfileService/Copy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceProfileServiceClient) GetPayloadCodecChain(ctx context.Context, in *GetPayloadCodecChainRequest, opts ...grpc.CallOption) (*GetPayloadCodecChainResponse, error) {
+	out := new(GetPayloadCodecChainResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceProfileService/GetPayloadCodecChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceProfileServiceClient) UpdatePayloadCodecChain(ctx context.Context, in *UpdatePayloadCodecChainRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceProfileService/UpdatePayloadCodecChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceProfileServiceClient) TestPayloadCodecStage(ctx context.Context, in *TestPayloadCodecStageRequest, opts ...grpc.CallOption) (*TestPayloadCodecStageResponse, error) {
+	out := new(TestPayloadCodecStageResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceProfileService/TestPayloadCodecStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceProfileServiceServer is the server API for DeviceProfileService service.
 type DeviceProfileServiceServer interface {
 	// Create creates the given device-profile.
@@ -576,6 +1218,18 @@ type DeviceProfileServiceServer interface {
 	Delete(context.Context, *DeleteDeviceProfileRequest) (*empty.Empty, error)
 	// List lists the available device-profiles.
 	List(context.Context, *ListDeviceProfileRequest) (*ListDeviceProfileResponse, error)
+	// Copy creates an organization owned copy of the given device-profile.
+	// This can be used to customize a shared device-profile.
+	Copy(context.Context, *CopyDeviceProfileRequest) (*CopyDeviceProfileResponse, error)
+	// GetPayloadCodecChain returns the payload codec chain of the given
+	// device-profile.
+	GetPayloadCodecChain(context.Context, *GetPayloadCodecChainRequest) (*GetPayloadCodecChainResponse, error)
+	// UpdatePayloadCodecChain updates the payload codec chain of the given
+	// device-profile.
+	UpdatePayloadCodecChain(context.Context, *UpdatePayloadCodecChainRequest) (*empty.Empty, error)
+	// TestPayloadCodecStage executes a single codec chain stage on the given
+	// input, so that each stage can be tested independently.
+	TestPayloadCodecStage(context.Context, *TestPayloadCodecStageRequest) (*TestPayloadCodecStageResponse, error)
 }
 
 func RegisterDeviceProfileServiceServer(s *grpc.Server, srv DeviceProfileServiceServer) {
@@ -672,6 +1326,78 @@ func _DeviceProfileService_List_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceProfileService_Copy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyDeviceProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceProfileServiceServer).Copy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceProfileService/Copy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceProfileServiceServer).Copy(ctx, req.(*CopyDeviceProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceProfileService_GetPayloadCodecChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayloadCodecChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceProfileServiceServer).GetPayloadCodecChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceProfileService/GetPayloadCodecChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceProfileServiceServer).GetPayloadCodecChain(ctx, req.(*GetPayloadCodecChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceProfileService_UpdatePayloadCodecChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePayloadCodecChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceProfileServiceServer).UpdatePayloadCodecChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceProfileService/UpdatePayloadCodecChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceProfileServiceServer).UpdatePayloadCodecChain(ctx, req.(*UpdatePayloadCodecChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceProfileService_TestPayloadCodecStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestPayloadCodecStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceProfileServiceServer).TestPayloadCodecStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceProfileService/TestPayloadCodecStage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceProfileServiceServer).TestPayloadCodecStage(ctx, req.(*TestPayloadCodecStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DeviceProfileService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.DeviceProfileService",
 	HandlerType: (*DeviceProfileServiceServer)(nil),
@@ -696,52 +1422,107 @@ var _DeviceProfileService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _DeviceProfileService_List_Handler,
 		},
+		{
+			MethodName: "Copy",
+			Handler:    _DeviceProfileService_Copy_Handler,
+		},
+		{
+			MethodName: "GetPayloadCodecChain",
+			Handler:    _DeviceProfileService_GetPayloadCodecChain_Handler,
+		},
+		{
+			MethodName: "UpdatePayloadCodecChain",
+			Handler:    _DeviceProfileService_UpdatePayloadCodecChain_Handler,
+		},
+		{
+			MethodName: "TestPayloadCodecStage",
+			Handler:    _DeviceProfileService_TestPayloadCodecStage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "deviceProfile.proto",
 }
 
-func init() { proto.RegisterFile("deviceProfile.proto", fileDescriptor_deviceProfile_b4dd410e0cee7d90) }
-
-var fileDescriptor_deviceProfile_b4dd410e0cee7d90 = []byte{
-	// 616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x56, 0xe2, 0xd4, 0x52, 0xa7, 0x6a, 0x2a, 0x96, 0x52, 0x52, 0x27, 0x90, 0x62, 0x09, 0x51,
-	0x22, 0xe2, 0x48, 0xe9, 0xa9, 0xdc, 0xaa, 0x06, 0x55, 0x91, 0x38, 0x20, 0x03, 0xe2, 0x18, 0x6d,
-	0xed, 0x49, 0xb4, 0xc2, 0xf6, 0x2e, 0xf6, 0x26, 0x08, 0x50, 0x2f, 0x1c, 0x78, 0x01, 0x2e, 0x3c,
-	0x05, 0x2f, 0xc2, 0x91, 0x57, 0xe0, 0x41, 0x90, 0x77, 0xd7, 0x28, 0x3f, 0x36, 0x3f, 0x15, 0x37,
-	0x7b, 0xe7, 0x9b, 0x99, 0x6f, 0xbe, 0xf9, 0x81, 0x9b, 0x21, 0x2e, 0x58, 0x80, 0xcf, 0x52, 0x3e,
-	0x65, 0x11, 0x7a, 0x22, 0xe5, 0x92, 0x13, 0x8b, 0x0a, 0xe6, 0x74, 0x66, 0x9c, 0xcf, 0x22, 0x1c,
-	0x50, 0xc1, 0x06, 0x34, 0x49, 0xb8, 0xa4, 0x92, 0xf1, 0x24, 0xd3, 0x10, 0xa7, 0x6b, 0xac, 0xea,
-	0xef, 0x72, 0x3e, 0x1d, 0x48, 0x16, 0x63, 0x26, 0x69, 0x2c, 0x0c, 0xa0, 0xbd, 0x0e, 0xc0, 0x58,
-	0xc8, 0x77, 0xc6, 0xd8, 0x14, 0x3a, 0x9f, 0x89, 0xe6, 0xbe, 0x02, 0xe7, 0x3c, 0x45, 0x2a, 0x71,
-	0xb4, 0xcc, 0xc6, 0xc7, 0x37, 0x73, 0xcc, 0x24, 0x39, 0x85, 0xa6, 0x66, 0x39, 0x31, 0x6e, 0xad,
-	0xda, 0x51, 0xed, 0x78, 0x67, 0x48, 0x3c, 0x2a, 0x98, 0xb7, 0xea, 0xb2, 0xbb, 0x52, 0x8f, 0xdb,
-	0x87, 0x76, 0x69, 0xe0, 0x4c, 0xf0, 0x24, 0x43, 0xd2, 0x84, 0x3a, 0x0b, 0x55, 0xb4, 0x6d, 0xbf,
-	0xce, 0x42, 0xf7, 0x21, 0xdc, 0xbe, 0x40, 0x59, 0x4a, 0x62, 0x1d, 0xfa, 0xad, 0x06, 0xad, 0x4d,
-	0xac, 0x89, 0x7b, 0x7d, 0xc6, 0xe4, 0x14, 0x20, 0x50, 0x8c, 0xc3, 0x09, 0x95, 0xad, 0xba, 0x72,
-	0x73, 0x3c, 0x2d, 0xa6, 0x57, 0x88, 0xe9, 0xbd, 0x28, 0xd4, 0xf6, 0xb7, 0x0d, 0xfa, 0x2c, 0xd7,
-	0x09, 0xe6, 0x22, 0x2c, 0x5c, 0xad, 0x3f, 0xbb, 0x1a, 0xf4, 0x99, 0xcc, 0x1b, 0xf0, 0x52, 0xfd,
-	0xfc, 0xef, 0x06, 0x3c, 0x02, 0x67, 0x84, 0x11, 0x56, 0x04, 0x5e, 0x17, 0xf5, 0x53, 0x1d, 0x6e,
-	0xad, 0x00, 0x9f, 0xb2, 0x4c, 0x8e, 0x25, 0xc6, 0xeb, 0x48, 0x42, 0xa0, 0x91, 0xd0, 0x18, 0x95,
-	0x40, 0xdb, 0xbe, 0xfa, 0x26, 0x0f, 0x60, 0x8f, 0xa7, 0x33, 0x9a, 0xb0, 0xf7, 0x6a, 0x54, 0x27,
-	0x2c, 0x54, 0x22, 0x58, 0x7e, 0x73, 0xf9, 0x79, 0x3c, 0x22, 0x3d, 0xb8, 0x91, 0xa0, 0x7c, 0xcb,
-	0xd3, 0xd7, 0x93, 0x0c, 0xd3, 0x05, 0xa6, 0x39, 0xb4, 0xa1, 0xa0, 0x7b, 0xc6, 0xf0, 0x5c, 0xbd,
-	0x8f, 0x47, 0x6b, 0xfd, 0xd8, 0xba, 0x7e, 0x3f, 0xec, 0x7f, 0xe9, 0xc7, 0x97, 0x1a, 0xb4, 0xf2,
-	0xda, 0x4b, 0x55, 0xdb, 0x87, 0xad, 0x88, 0xc5, 0x4c, 0x2a, 0x39, 0x2c, 0x5f, 0xff, 0x90, 0x03,
-	0xb0, 0xf9, 0x74, 0x9a, 0xa1, 0x1e, 0x1a, 0xcb, 0x37, 0x7f, 0x7f, 0xaf, 0xca, 0x7d, 0x68, 0x52,
-	0x21, 0x22, 0x16, 0xfc, 0xc2, 0x69, 0x49, 0x76, 0x97, 0x5e, 0xc7, 0x23, 0x57, 0xc0, 0x61, 0x09,
-	0x33, 0x33, 0xf8, 0x5d, 0xd8, 0x91, 0x5c, 0xd2, 0x68, 0x12, 0xf0, 0x79, 0x52, 0x10, 0x04, 0xf5,
-	0x74, 0x9e, 0xbf, 0x90, 0x21, 0xd8, 0x29, 0x66, 0xf3, 0x28, 0x67, 0x69, 0x29, 0x3d, 0x36, 0x46,
-	0xa8, 0xe8, 0xb9, 0x6f, 0x90, 0xc3, 0xaf, 0x0d, 0xd8, 0x5f, 0x41, 0xe4, 0xcd, 0x61, 0x01, 0x92,
-	0x08, 0x6c, 0xbd, 0xdd, 0xa4, 0xab, 0xc2, 0x54, 0xdf, 0x10, 0xe7, 0xa8, 0x1a, 0xa0, 0xa9, 0xbb,
-	0xdd, 0x8f, 0xdf, 0x7f, 0x7c, 0xae, 0x1f, 0xba, 0xfb, 0xea, 0xe2, 0xe9, 0x29, 0xee, 0x17, 0x77,
-	0xea, 0x71, 0xad, 0x47, 0x10, 0xac, 0x0b, 0x94, 0xa4, 0xa3, 0x22, 0x55, 0x9c, 0x09, 0xe7, 0x4e,
-	0x85, 0xd5, 0x24, 0xb9, 0xa7, 0x92, 0xb4, 0xc9, 0x61, 0x59, 0x92, 0xc1, 0x07, 0x16, 0x5e, 0x91,
-	0x05, 0xd8, 0x7a, 0x15, 0x4d, 0x51, 0xd5, 0x7b, 0xe9, 0x1c, 0x6c, 0x0c, 0xd3, 0x93, 0xfc, 0xc8,
-	0xba, 0x27, 0x2a, 0x4b, 0xdf, 0x39, 0x2e, 0xcf, 0xb2, 0xba, 0xcb, 0x1e, 0x0b, 0xaf, 0xf2, 0xf2,
-	0x42, 0xb0, 0xf5, 0xa6, 0x9a, 0xbc, 0xd5, 0x6b, 0x5b, 0x99, 0xd7, 0x54, 0xd7, 0xfb, 0x4d, 0x75,
-	0x01, 0x34, 0xf2, 0xfe, 0x12, 0xad, 0x53, 0xd5, 0x88, 0x3b, 0x77, 0xab, 0xcc, 0x46, 0xc7, 0x8e,
-	0xca, 0x74, 0x40, 0x4a, 0x9b, 0x75, 0x69, 0x2b, 0x5e, 0x27, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xce, 0xf1, 0xe7, 0xa5, 0xdd, 0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("deviceProfile.proto", fileDescriptor_deviceProfile_ec837b0fd61bb19c) }
+
+var fileDescriptor_deviceProfile_ec837b0fd61bb19c = []byte{
+	// 1236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x4e, 0xdc, 0x46,
+	0x14, 0xae, 0xbd, 0x3f, 0x09, 0x27, 0x61, 0x49, 0xa6, 0x40, 0x8c, 0x21, 0xb0, 0x31, 0xaa, 0x4a,
+	0x68, 0x58, 0x24, 0x52, 0x55, 0x05, 0x35, 0x8d, 0xd0, 0xee, 0x06, 0x6d, 0x44, 0x12, 0xe4, 0x25,
+	0xea, 0x5d, 0xad, 0xc1, 0x9e, 0x25, 0x4e, 0xbc, 0x3b, 0x53, 0x7b, 0x96, 0x6a, 0x53, 0xe5, 0xa6,
+	0xaf, 0x90, 0x8b, 0xb6, 0x57, 0xbd, 0x68, 0xdf, 0xa6, 0x97, 0x7d, 0x85, 0xde, 0xf4, 0x21, 0x2a,
+	0x55, 0xf3, 0x63, 0xf0, 0xb2, 0x36, 0xa5, 0x28, 0x77, 0x9e, 0x99, 0xef, 0x9c, 0xf3, 0x9d, 0xef,
+	0x9c, 0x39, 0x1e, 0xf8, 0x38, 0x20, 0x27, 0xa1, 0x4f, 0x0e, 0x62, 0xda, 0x0b, 0x23, 0xd2, 0x60,
+	0x31, 0xe5, 0x14, 0x95, 0x30, 0x0b, 0xed, 0xa5, 0x63, 0x4a, 0x8f, 0x23, 0xb2, 0x89, 0x59, 0xb8,
+	0x89, 0x07, 0x03, 0xca, 0x31, 0x0f, 0xe9, 0x20, 0x51, 0x10, 0x7b, 0x45, 0x9f, 0xca, 0xd5, 0xd1,
+	0xb0, 0xb7, 0xc9, 0xc3, 0x3e, 0x49, 0x38, 0xee, 0x33, 0x0d, 0x58, 0x3c, 0x0f, 0x20, 0x7d, 0xc6,
+	0x47, 0xfa, 0xb0, 0xc6, 0x54, 0x3c, 0xed, 0xcd, 0xf9, 0x16, 0xe6, 0x0f, 0xf0, 0x28, 0xa2, 0x38,
+	0x68, 0xd2, 0x80, 0xf8, 0x4f, 0x42, 0x12, 0x05, 0x5d, 0x1f, 0x47, 0x04, 0xcd, 0x42, 0xa5, 0x27,
+	0x56, 0x96, 0x51, 0x37, 0xd6, 0xa6, 0x5c, 0xb5, 0x40, 0xf3, 0x50, 0xed, 0x61, 0x9f, 0xd3, 0xd8,
+	0x32, 0xeb, 0xc6, 0x9a, 0xe1, 0xea, 0x95, 0xd8, 0xa7, 0xbd, 0x5e, 0x42, 0xb8, 0x55, 0x52, 0xfb,
+	0x6a, 0xe5, 0xfc, 0x6a, 0xc2, 0xed, 0x6c, 0x80, 0x2e, 0xc7, 0xc7, 0x04, 0x21, 0x28, 0x0f, 0x70,
+	0x9f, 0x68, 0xd7, 0xf2, 0x1b, 0x35, 0xa0, 0xcc, 0x47, 0x8c, 0x48, 0xbf, 0xb5, 0x2d, 0xbb, 0x81,
+	0x59, 0xd8, 0x98, 0xb0, 0x3c, 0x1c, 0x31, 0xe2, 0x4a, 0x9c, 0x88, 0x98, 0xf8, 0x71, 0xc8, 0x54,
+	0xc4, 0x29, 0x57, 0xaf, 0xd0, 0x23, 0xb8, 0x16, 0x13, 0xe1, 0x31, 0xb1, 0xca, 0xf5, 0xd2, 0xda,
+	0x8d, 0xad, 0xd5, 0x7c, 0x57, 0x0d, 0x57, 0xa1, 0xda, 0x03, 0x1e, 0x8f, 0xdc, 0xd4, 0x06, 0x3d,
+	0x14, 0x6e, 0x71, 0x44, 0x12, 0xab, 0x22, 0xad, 0x17, 0x27, 0xac, 0xcf, 0x34, 0x72, 0x35, 0xd4,
+	0xde, 0x81, 0x9b, 0x59, 0x6f, 0xe8, 0x16, 0x94, 0xde, 0x90, 0x91, 0x4e, 0x4f, 0x7c, 0x0a, 0x35,
+	0x4f, 0x70, 0x34, 0x54, 0xe9, 0x4d, 0xb9, 0x6a, 0xb1, 0x63, 0x7e, 0x69, 0x38, 0xef, 0x8d, 0x71,
+	0x85, 0x9a, 0xaf, 0x70, 0x38, 0x40, 0x8f, 0xa0, 0xc6, 0x62, 0xe2, 0xb1, 0x98, 0xfa, 0x24, 0x49,
+	0x68, 0x9c, 0x58, 0x86, 0xa4, 0x33, 0x9f, 0x9f, 0x8c, 0x3b, 0xcd, 0x62, 0x72, 0x70, 0x0a, 0x46,
+	0x8f, 0x61, 0x86, 0xd1, 0x84, 0x67, 0xed, 0xcd, 0x0b, 0xed, 0x6b, 0x02, 0x7e, 0xe6, 0xc0, 0xe9,
+	0xc0, 0xe2, 0x1e, 0xe1, 0x13, 0xbc, 0x5c, 0xf2, 0xdd, 0x90, 0x24, 0x1c, 0xad, 0xc3, 0x6d, 0xd5,
+	0xbe, 0x9e, 0xee, 0x27, 0x2f, 0x4c, 0x1b, 0x65, 0x66, 0xac, 0xaf, 0x3b, 0x2d, 0x67, 0x1f, 0x96,
+	0xf2, 0x5d, 0x25, 0x8c, 0x0e, 0x12, 0x82, 0x1e, 0x40, 0xc5, 0x17, 0x1b, 0xd2, 0x3e, 0x8f, 0xa1,
+	0x82, 0x2b, 0x90, 0xf3, 0x16, 0x96, 0x5f, 0xb2, 0x00, 0x73, 0xf2, 0x21, 0xb8, 0x9d, 0xc5, 0x36,
+	0x2f, 0x13, 0xfb, 0x6f, 0x03, 0x96, 0x0e, 0x49, 0xc2, 0x27, 0xe5, 0xbb, 0x5a, 0xe8, 0x44, 0xd8,
+	0x16, 0x86, 0x56, 0x9e, 0x15, 0x08, 0xad, 0xc2, 0xf4, 0x58, 0x3f, 0xc8, 0xa6, 0xbf, 0xee, 0xde,
+	0xcc, 0x96, 0x1d, 0xcd, 0x41, 0xb5, 0xe7, 0x31, 0x1a, 0x73, 0xab, 0x5c, 0x37, 0xd6, 0xa6, 0xdd,
+	0x4a, 0xef, 0x80, 0xc6, 0x5c, 0xdc, 0xb6, 0x00, 0x73, 0x6c, 0x55, 0xea, 0xc6, 0xda, 0x4d, 0x57,
+	0x7e, 0xa3, 0x15, 0xb8, 0x41, 0x8f, 0x5e, 0x13, 0x9f, 0x7b, 0xaf, 0x13, 0x3a, 0xb0, 0xaa, 0x92,
+	0x23, 0xa8, 0xad, 0xa7, 0xdd, 0x17, 0xcf, 0x9d, 0x43, 0xb8, 0x5b, 0x90, 0xaa, 0x2e, 0x5b, 0xea,
+	0xd5, 0x28, 0xf6, 0x6a, 0x4e, 0x78, 0xfd, 0x06, 0xec, 0x66, 0x4c, 0x30, 0x27, 0xad, 0xac, 0x1a,
+	0xa9, 0x7c, 0xdb, 0x50, 0x1b, 0x97, 0x4f, 0xb7, 0x04, 0x92, 0xda, 0x8c, 0x9b, 0x4c, 0x8f, 0xe9,
+	0xe9, 0x6c, 0xc0, 0x62, 0xae, 0x63, 0x4d, 0xb6, 0x06, 0xe6, 0x69, 0x25, 0xcc, 0x30, 0x70, 0xee,
+	0xc3, 0x9d, 0x3d, 0xc2, 0x73, 0x49, 0x9c, 0x87, 0xfe, 0x61, 0x80, 0x35, 0x89, 0xd5, 0x7e, 0xaf,
+	0xce, 0x18, 0x6d, 0x03, 0xf8, 0x92, 0x71, 0xe0, 0x61, 0xae, 0x9b, 0xc0, 0x6e, 0xa8, 0xd9, 0xdd,
+	0x48, 0x67, 0x77, 0xe3, 0x30, 0x1d, 0xee, 0xee, 0x94, 0x46, 0xef, 0x0a, 0x9d, 0x60, 0xc8, 0x82,
+	0xd4, 0xb4, 0xf4, 0xdf, 0xa6, 0x1a, 0xbd, 0xcb, 0x45, 0x01, 0xd4, 0xf5, 0xf9, 0xd0, 0x05, 0x78,
+	0x00, 0x76, 0x8b, 0x44, 0x84, 0x93, 0x4b, 0x89, 0xfa, 0x9b, 0x09, 0x73, 0x63, 0xc0, 0xfd, 0x30,
+	0xe1, 0x1d, 0x4e, 0xfa, 0xe7, 0x91, 0xa7, 0xbf, 0x0a, 0x33, 0xf3, 0xab, 0xf8, 0x14, 0x66, 0x68,
+	0x7c, 0x8c, 0x07, 0xe1, 0x5b, 0xf9, 0x67, 0x14, 0x97, 0x4c, 0x88, 0x50, 0x72, 0x6b, 0xd9, 0xed,
+	0x4e, 0x4b, 0xdc, 0xc7, 0x01, 0xe1, 0xdf, 0xd3, 0xf8, 0x8d, 0x97, 0x90, 0xf8, 0x84, 0xc4, 0x02,
+	0x5a, 0x96, 0xd0, 0x19, 0x7d, 0xd0, 0x95, 0xfb, 0x9d, 0xd6, 0xb9, 0x7a, 0x54, 0xae, 0x5e, 0x8f,
+	0xea, 0xff, 0xa8, 0x07, 0x5a, 0x84, 0xa9, 0x30, 0xf1, 0x92, 0x57, 0x38, 0x26, 0x81, 0x75, 0x4d,
+	0xde, 0xe9, 0xeb, 0x61, 0xd2, 0x95, 0x6b, 0xe7, 0x67, 0x03, 0x2c, 0x21, 0x4c, 0xae, 0xa4, 0xb3,
+	0x50, 0x89, 0xc2, 0x7e, 0xc8, 0xa5, 0x56, 0x25, 0x57, 0x2d, 0x32, 0xff, 0x61, 0x53, 0x6e, 0xeb,
+	0xd5, 0xe5, 0x25, 0xfb, 0x04, 0x6a, 0x98, 0xb1, 0x28, 0xf4, 0x4f, 0x71, 0x4a, 0xaf, 0xe9, 0xcc,
+	0x6e, 0xa7, 0xe5, 0x30, 0x58, 0xc8, 0x61, 0xa6, 0x6f, 0xc5, 0x0a, 0xdc, 0xe0, 0x94, 0xe3, 0xc8,
+	0xf3, 0xe9, 0x70, 0x90, 0x12, 0x04, 0xb9, 0xd5, 0x14, 0x3b, 0x68, 0x0b, 0xaa, 0x31, 0x49, 0x86,
+	0x11, 0xd7, 0x7f, 0x25, 0x7b, 0xb2, 0xbf, 0xd2, 0x86, 0x70, 0x35, 0xd2, 0x39, 0x06, 0xab, 0x49,
+	0xd9, 0xe8, 0x32, 0xed, 0x95, 0x97, 0xad, 0x99, 0x9b, 0x6d, 0xda, 0x5d, 0xa5, 0xb3, 0xee, 0x72,
+	0x3e, 0x83, 0x85, 0x9c, 0x40, 0xf9, 0x83, 0x64, 0x9d, 0xc2, 0x5c, 0xee, 0x23, 0x05, 0x2d, 0xc0,
+	0x5c, 0xf3, 0x45, 0xab, 0xdd, 0xf4, 0xba, 0x87, 0xbb, 0x7b, 0x6d, 0xaf, 0xf9, 0xb2, 0x7b, 0xf8,
+	0xe2, 0x99, 0xf7, 0xb4, 0x7b, 0xeb, 0x23, 0x74, 0x17, 0x16, 0xb2, 0x47, 0x6e, 0xfb, 0xf9, 0xee,
+	0xb3, 0xb6, 0xf7, 0xa4, 0xd3, 0xde, 0x6f, 0x75, 0x6f, 0x19, 0x68, 0x09, 0xac, 0xec, 0x71, 0xb7,
+	0xb9, 0xbb, 0x7f, 0x7a, 0x6a, 0x6e, 0xfd, 0x73, 0x1d, 0x66, 0xc7, 0xa8, 0x89, 0x06, 0x0e, 0x7d,
+	0x82, 0x22, 0xa8, 0xaa, 0x09, 0x88, 0x56, 0xa4, 0x9a, 0xc5, 0x73, 0xd6, 0xae, 0x17, 0x03, 0x54,
+	0x9a, 0xce, 0xca, 0x8f, 0x7f, 0xfe, 0xf5, 0xde, 0x5c, 0x70, 0x66, 0xe5, 0x23, 0x54, 0xdd, 0xf4,
+	0x8d, 0xf4, 0xe9, 0xb8, 0x63, 0xac, 0x23, 0x02, 0xa5, 0x3d, 0xc2, 0xd1, 0x92, 0xf4, 0x54, 0x30,
+	0x4a, 0xed, 0xbb, 0x05, 0xa7, 0x3a, 0xc8, 0x3d, 0x19, 0x64, 0x11, 0x2d, 0xe4, 0x05, 0xd9, 0xfc,
+	0x21, 0x0c, 0xde, 0xa1, 0x13, 0xa8, 0xaa, 0x71, 0xa5, 0x93, 0x2a, 0x9e, 0x5d, 0xf6, 0xfc, 0xc4,
+	0x85, 0x6b, 0x8b, 0x77, 0xaf, 0xf3, 0x50, 0x46, 0xd9, 0xb0, 0xd7, 0xf2, 0xa3, 0x8c, 0xcf, 0xbb,
+	0x46, 0x18, 0xbc, 0x13, 0xe9, 0x05, 0x50, 0x55, 0xd3, 0x4c, 0xc7, 0x2d, 0x1e, 0x6d, 0x85, 0x71,
+	0x75, 0x76, 0xeb, 0x17, 0x64, 0xe7, 0x43, 0x59, 0xb4, 0x39, 0x52, 0x3a, 0x15, 0xdd, 0x74, 0x7b,
+	0xb9, 0xe8, 0x58, 0xeb, 0xb8, 0x24, 0x23, 0xcd, 0xa3, 0xdc, 0x62, 0x21, 0x06, 0x65, 0xd1, 0xce,
+	0x3a, 0x48, 0xd1, 0x15, 0xb2, 0x97, 0x8b, 0x8e, 0x75, 0x90, 0xfb, 0x32, 0xc8, 0xaa, 0xb3, 0x5c,
+	0x98, 0xce, 0xa6, 0x4f, 0xd9, 0x48, 0x88, 0xf7, 0x8b, 0x01, 0xb3, 0x79, 0x2f, 0x3e, 0x54, 0x4f,
+	0xfb, 0xa1, 0xe8, 0xed, 0x66, 0xdf, 0xbb, 0x00, 0xa1, 0x89, 0x7c, 0x25, 0x89, 0x7c, 0x81, 0x3e,
+	0xbf, 0x4c, 0x3d, 0x3d, 0xc5, 0x2b, 0x20, 0xfe, 0x86, 0x7c, 0xc2, 0xa1, 0x9f, 0x0c, 0xb8, 0x53,
+	0xf0, 0x7e, 0x44, 0xab, 0x99, 0x16, 0x2b, 0x64, 0x58, 0x54, 0xee, 0xc7, 0x92, 0xd6, 0xb6, 0x7d,
+	0x25, 0x5a, 0x42, 0xb5, 0xdf, 0x0d, 0x98, 0xcb, 0x7d, 0x71, 0x21, 0x25, 0xca, 0x45, 0x0f, 0x4f,
+	0xdb, 0xb9, 0x08, 0xa2, 0x85, 0x6b, 0x49, 0x86, 0x5f, 0x3b, 0xdb, 0x57, 0x61, 0xb8, 0xc9, 0x49,
+	0xc2, 0x77, 0x8c, 0xf5, 0xa3, 0xaa, 0xcc, 0xfb, 0xe1, 0xbf, 0x03, 0x00, 0x98, 0xe4, 0xb2, 0xe3,
+	0xbf, 0x0e, 0x00, 0x00,
 }
@@ -6,6 +6,7 @@ package api
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
 import empty "github.com/golang/protobuf/ptypes/empty"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 
@@ -52,7 +53,7 @@ func (m *DeviceQueueItem) Reset()         { *m = DeviceQueueItem{} }
 func (m *DeviceQueueItem) String() string { return proto.CompactTextString(m) }
 func (*DeviceQueueItem) ProtoMessage()    {}
 func (*DeviceQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceQueue_36df4b0b4d3182e0, []int{0}
+	return fileDescriptor_deviceQueue_40ef5b13510ac09a, []int{0}
 }
 func (m *DeviceQueueItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeviceQueueItem.Unmarshal(m, b)
@@ -116,17 +117,26 @@ func (m *DeviceQueueItem) GetJsonObject() string {
 
 type EnqueueDeviceQueueItemRequest struct {
 	// Queue-item object to enqueue.
-	DeviceQueueItem      *DeviceQueueItem `protobuf:"bytes,1,opt,name=device_queue_item,json=deviceQueueItem,proto3" json:"device_queue_item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	DeviceQueueItem *DeviceQueueItem `protobuf:"bytes,1,opt,name=device_queue_item,json=deviceQueueItem,proto3" json:"device_queue_item,omitempty"`
+	// FPort on which a response uplink is expected (optional).
+	// When set, the first uplink of the device on this fPort within the
+	// response timeout is correlated to this downlink (the correlationID is
+	// set in the uplink event). When no such uplink is received, a
+	// RESPONSE_TIMEOUT error event is emitted.
+	ResponseFPort uint32 `protobuf:"varint,2,opt,name=response_f_port,json=responseFPort,proto3" json:"response_f_port,omitempty"`
+	// Time to wait for the response uplink (max. 24 hours).
+	// This must be set when response_f_port is set.
+	ResponseTimeout      *duration.Duration `protobuf:"bytes,3,opt,name=response_timeout,json=responseTimeout,proto3" json:"response_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *EnqueueDeviceQueueItemRequest) Reset()         { *m = EnqueueDeviceQueueItemRequest{} }
 func (m *EnqueueDeviceQueueItemRequest) String() string { return proto.CompactTextString(m) }
 func (*EnqueueDeviceQueueItemRequest) ProtoMessage()    {}
 func (*EnqueueDeviceQueueItemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceQueue_36df4b0b4d3182e0, []int{1}
+	return fileDescriptor_deviceQueue_40ef5b13510ac09a, []int{1}
 }
 func (m *EnqueueDeviceQueueItemRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EnqueueDeviceQueueItemRequest.Unmarshal(m, b)
@@ -153,9 +163,27 @@ func (m *EnqueueDeviceQueueItemRequest) GetDeviceQueueItem() *DeviceQueueItem {
 	return nil
 }
 
+func (m *EnqueueDeviceQueueItemRequest) GetResponseFPort() uint32 {
+	if m != nil {
+		return m.ResponseFPort
+	}
+	return 0
+}
+
+func (m *EnqueueDeviceQueueItemRequest) GetResponseTimeout() *duration.Duration {
+	if m != nil {
+		return m.ResponseTimeout
+	}
+	return nil
+}
+
 type EnqueueDeviceQueueItemResponse struct {
 	// Frame-counter for the enqueued payload.
-	FCnt                 uint32   `protobuf:"varint,1,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	FCnt uint32 `protobuf:"varint,1,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// Correlation ID of the expected response uplink.
+	// Only set when a response_f_port was given and the response window
+	// could be opened.
+	CorrelationId        string   `protobuf:"bytes,2,opt,name=correlation_id,json=correlationID,proto3" json:"correlation_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -165,7 +193,7 @@ func (m *EnqueueDeviceQueueItemResponse) Reset()         { *m = EnqueueDeviceQue
 func (m *EnqueueDeviceQueueItemResponse) String() string { return proto.CompactTextString(m) }
 func (*EnqueueDeviceQueueItemResponse) ProtoMessage()    {}
 func (*EnqueueDeviceQueueItemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceQueue_36df4b0b4d3182e0, []int{2}
+	return fileDescriptor_deviceQueue_40ef5b13510ac09a, []int{2}
 }
 func (m *EnqueueDeviceQueueItemResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EnqueueDeviceQueueItemResponse.Unmarshal(m, b)
@@ -192,6 +220,13 @@ func (m *EnqueueDeviceQueueItemResponse) GetFCnt() uint32 {
 	return 0
 }
 
+func (m *EnqueueDeviceQueueItemResponse) GetCorrelationId() string {
+	if m != nil {
+		return m.CorrelationId
+	}
+	return ""
+}
+
 type FlushDeviceQueueRequest struct {
 	// Device EUI (HEX encoded).
 	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
@@ -204,7 +239,7 @@ func (m *FlushDeviceQueueRequest) Reset()         { *m = FlushDeviceQueueRequest
 func (m *FlushDeviceQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushDeviceQueueRequest) ProtoMessage()    {}
 func (*FlushDeviceQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceQueue_36df4b0b4d3182e0, []int{3}
+	return fileDescriptor_deviceQueue_40ef5b13510ac09a, []int{3}
 }
 func (m *FlushDeviceQueueRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FlushDeviceQueueRequest.Unmarshal(m, b)
@@ -243,7 +278,7 @@ func (m *ListDeviceQueueItemsRequest) Reset()         { *m = ListDeviceQueueItem
 func (m *ListDeviceQueueItemsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDeviceQueueItemsRequest) ProtoMessage()    {}
 func (*ListDeviceQueueItemsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceQueue_36df4b0b4d3182e0, []int{4}
+	return fileDescriptor_deviceQueue_40ef5b13510ac09a, []int{4}
 }
 func (m *ListDeviceQueueItemsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListDeviceQueueItemsRequest.Unmarshal(m, b)
@@ -281,7 +316,7 @@ func (m *ListDeviceQueueItemsResponse) Reset()         { *m = ListDeviceQueueIte
 func (m *ListDeviceQueueItemsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListDeviceQueueItemsResponse) ProtoMessage()    {}
 func (*ListDeviceQueueItemsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deviceQueue_36df4b0b4d3182e0, []int{5}
+	return fileDescriptor_deviceQueue_40ef5b13510ac09a, []int{5}
 }
 func (m *ListDeviceQueueItemsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListDeviceQueueItemsResponse.Unmarshal(m, b)
@@ -461,37 +496,42 @@ var _DeviceQueueService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "deviceQueue.proto",
 }
 
-func init() { proto.RegisterFile("deviceQueue.proto", fileDescriptor_deviceQueue_36df4b0b4d3182e0) }
-
-var fileDescriptor_deviceQueue_36df4b0b4d3182e0 = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xd6, 0xe6, 0xaf, 0x74, 0x0a, 0x2a, 0x0c, 0x3f, 0xb5, 0x5c, 0x53, 0x8c, 0xe1, 0x10, 0xf5,
-	0x60, 0x4b, 0xa9, 0x40, 0x82, 0x13, 0x02, 0x82, 0x54, 0x09, 0x09, 0x30, 0xe2, 0x6c, 0x39, 0xf6,
-	0xb8, 0x6c, 0x95, 0xec, 0x3a, 0xde, 0x75, 0x24, 0x84, 0xb8, 0x70, 0xe7, 0xc4, 0x53, 0xf0, 0x3c,
-	0xbc, 0x02, 0x8f, 0xc1, 0x01, 0x79, 0xed, 0x28, 0x21, 0x21, 0xe6, 0xb6, 0xfe, 0x76, 0x66, 0xbf,
-	0x9f, 0xf1, 0xc0, 0x8d, 0x94, 0x16, 0x3c, 0xa1, 0x77, 0x25, 0x95, 0xe4, 0xe7, 0x85, 0xd4, 0x12,
-	0xbb, 0x71, 0xce, 0x6d, 0xe7, 0x42, 0xca, 0x8b, 0x29, 0x05, 0x71, 0xce, 0x83, 0x58, 0x08, 0xa9,
-	0x63, 0xcd, 0xa5, 0x50, 0x75, 0x89, 0x7d, 0xdc, 0xdc, 0x9a, 0xaf, 0x49, 0x99, 0x05, 0x34, 0xcb,
-	0xf5, 0xa7, 0xfa, 0xd2, 0xfb, 0xc1, 0xe0, 0xf0, 0xe5, 0xea, 0xd5, 0x73, 0x4d, 0x33, 0x3c, 0x82,
-	0xbd, 0x94, 0x16, 0x11, 0x95, 0xdc, 0x62, 0x2e, 0x1b, 0xee, 0x87, 0x83, 0x94, 0x16, 0xe3, 0x0f,
-	0xe7, 0xe8, 0xc0, 0x7e, 0x22, 0x45, 0xc6, 0x8b, 0x19, 0xa5, 0x56, 0xc7, 0x65, 0xc3, 0x2b, 0xe1,
-	0x0a, 0xc0, 0x9b, 0xd0, 0xcf, 0xa2, 0x44, 0x68, 0x6b, 0xe0, 0xb2, 0xe1, 0xb5, 0xb0, 0x97, 0xbd,
-	0x10, 0x1a, 0x6f, 0xc3, 0x20, 0x8b, 0x72, 0x59, 0x68, 0xab, 0x6b, 0xd0, 0x7e, 0xf6, 0x56, 0x16,
-	0x1a, 0x11, 0x7a, 0x69, 0xac, 0x63, 0xab, 0xe7, 0xb2, 0xe1, 0xd5, 0xd0, 0x9c, 0xf1, 0x1e, 0x1c,
-	0x5c, 0x2a, 0x29, 0x22, 0x39, 0xb9, 0xa4, 0x44, 0x5b, 0x7d, 0x43, 0x0d, 0x15, 0xf4, 0xc6, 0x20,
-	0x5e, 0x0c, 0x77, 0xc7, 0x62, 0x5e, 0xc9, 0xdc, 0x50, 0x1c, 0xd2, 0xbc, 0x24, 0xa5, 0xf1, 0xd9,
-	0x32, 0xa1, 0xc8, 0x54, 0x45, 0x5c, 0xd3, 0xcc, 0x58, 0x38, 0x18, 0xdd, 0xf2, 0xe3, 0x9c, 0xfb,
-	0x9b, 0x7d, 0x87, 0xe9, 0xdf, 0x80, 0xf7, 0x08, 0x4e, 0x76, 0x51, 0xa8, 0x5c, 0x0a, 0x45, 0x2b,
-	0x97, 0x6c, 0xe5, 0xd2, 0x1b, 0xc1, 0xd1, 0xab, 0x69, 0xa9, 0x3e, 0xae, 0x35, 0x2d, 0x35, 0xed,
-	0x0a, 0xd3, 0x7b, 0x0c, 0xc7, 0xaf, 0xb9, 0xd2, 0x1b, 0x3c, 0xea, 0xbf, 0x7d, 0x13, 0x70, 0xfe,
-	0xdd, 0xd7, 0x08, 0x7c, 0x0e, 0xb8, 0x15, 0x82, 0xb2, 0x98, 0xdb, 0xdd, 0x99, 0xc2, 0xf5, 0x8d,
-	0x14, 0xd4, 0xe8, 0x77, 0x07, 0x70, 0xad, 0xea, 0x3d, 0x15, 0xd5, 0x19, 0xbf, 0x31, 0xd8, 0x6b,
-	0xe2, 0x41, 0xcf, 0x3c, 0xd5, 0x3a, 0x0f, 0xfb, 0x41, 0x6b, 0x4d, 0xad, 0xd7, 0x7b, 0xf2, 0xf5,
-	0xe7, 0xaf, 0xef, 0x9d, 0x33, 0xcf, 0x37, 0xbf, 0x6f, 0x2d, 0x45, 0x05, 0x9f, 0xb7, 0x3c, 0xf8,
-	0x4d, 0x1c, 0x5f, 0x02, 0x83, 0x3d, 0x65, 0xa7, 0x98, 0x40, 0xdf, 0xc4, 0x8e, 0x8e, 0x21, 0xda,
-	0x31, 0x02, 0xfb, 0x8e, 0x5f, 0x6f, 0x80, 0xbf, 0xdc, 0x00, 0x7f, 0x5c, 0x6d, 0x80, 0xf7, 0xd0,
-	0x30, 0x9f, 0x9c, 0x3a, 0x5b, 0xcc, 0x6b, 0x3c, 0x38, 0x87, 0x5e, 0x95, 0x37, 0xba, 0x86, 0xa3,
-	0x65, 0x64, 0xf6, 0xfd, 0x96, 0x8a, 0xc6, 0x6c, 0x43, 0x89, 0xad, 0x94, 0x93, 0x81, 0x11, 0x7a,
-	0xf6, 0x27, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x6a, 0x2a, 0x84, 0xf0, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("deviceQueue.proto", fileDescriptor_deviceQueue_40ef5b13510ac09a) }
+
+var fileDescriptor_deviceQueue_40ef5b13510ac09a = []byte{
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcb, 0x8a, 0xd3, 0x5e,
+	0x18, 0xe7, 0xf4, 0x36, 0xff, 0x7e, 0xfd, 0xd7, 0x8e, 0xc7, 0xcb, 0xc4, 0x4e, 0xad, 0x31, 0x5e,
+	0x28, 0xb3, 0x48, 0xa1, 0x03, 0x82, 0xae, 0x44, 0xdb, 0x81, 0x82, 0xa0, 0x46, 0xdd, 0x09, 0x21,
+	0x4d, 0xbe, 0x8c, 0x67, 0x68, 0x72, 0xd2, 0x9c, 0x93, 0x82, 0x88, 0x1b, 0xf7, 0xae, 0x7c, 0x0a,
+	0x9f, 0xc6, 0x85, 0xaf, 0xe0, 0x63, 0xb8, 0x90, 0x9c, 0xa4, 0xd3, 0x90, 0xda, 0xb8, 0x4b, 0xbe,
+	0xdb, 0xef, 0x96, 0xc0, 0x55, 0x0f, 0xd7, 0xcc, 0xc5, 0xd7, 0x09, 0x26, 0x68, 0x46, 0x31, 0x97,
+	0x9c, 0xd6, 0x9d, 0x88, 0xf5, 0x07, 0xe7, 0x9c, 0x9f, 0x2f, 0x71, 0xec, 0x44, 0x6c, 0xec, 0x84,
+	0x21, 0x97, 0x8e, 0x64, 0x3c, 0x14, 0xd9, 0x48, 0xff, 0x38, 0xef, 0xaa, 0xb7, 0x45, 0xe2, 0x8f,
+	0x31, 0x88, 0xe4, 0xc7, 0xbc, 0x39, 0x2c, 0x37, 0xbd, 0x24, 0x56, 0xdb, 0x59, 0xdf, 0xf8, 0x4e,
+	0xa0, 0x37, 0xdd, 0xa2, 0xce, 0x25, 0x06, 0xf4, 0x08, 0x0e, 0x3c, 0x5c, 0xdb, 0x98, 0x30, 0x8d,
+	0xe8, 0x64, 0xd4, 0xb6, 0x5a, 0x1e, 0xae, 0x67, 0xef, 0xe6, 0x74, 0x00, 0x6d, 0x97, 0x87, 0x3e,
+	0x8b, 0x03, 0xf4, 0xb4, 0x9a, 0x4e, 0x46, 0xff, 0x59, 0xdb, 0x02, 0xbd, 0x06, 0x4d, 0xdf, 0x76,
+	0x43, 0xa9, 0xb5, 0x74, 0x32, 0xea, 0x5a, 0x0d, 0xff, 0x79, 0x28, 0xe9, 0x0d, 0x68, 0xf9, 0x76,
+	0xc4, 0x63, 0xa9, 0xd5, 0x55, 0xb5, 0xe9, 0xbf, 0xe2, 0xb1, 0xa4, 0x14, 0x1a, 0x9e, 0x23, 0x1d,
+	0xad, 0xa1, 0x93, 0xd1, 0xff, 0x96, 0x7a, 0xa6, 0x77, 0xa0, 0x73, 0x21, 0x78, 0x68, 0xf3, 0xc5,
+	0x05, 0xba, 0x52, 0x6b, 0x2a, 0x68, 0x48, 0x4b, 0x2f, 0x55, 0xc5, 0xf8, 0x41, 0xe0, 0xf6, 0x2c,
+	0x5c, 0xa5, 0x3c, 0x4b, 0x94, 0x2d, 0x5c, 0x25, 0x28, 0x24, 0x7d, 0xba, 0xb1, 0xd0, 0x56, 0x53,
+	0x36, 0x93, 0x18, 0x28, 0x0d, 0x9d, 0xc9, 0x75, 0xd3, 0x89, 0x98, 0x59, 0xde, 0xeb, 0x79, 0x25,
+	0xed, 0x0f, 0xa1, 0x17, 0xa3, 0x88, 0x78, 0x28, 0xd0, 0xce, 0x89, 0xd7, 0x14, 0xf1, 0xee, 0xa6,
+	0x7c, 0xa6, 0x04, 0x4c, 0xe1, 0xf0, 0x72, 0x4e, 0xb2, 0x00, 0x79, 0x92, 0x29, 0xec, 0x4c, 0x6e,
+	0x99, 0x99, 0xe5, 0xe6, 0xc6, 0x72, 0x73, 0x9a, 0x5b, 0x6e, 0x5d, 0x9e, 0x7e, 0x9b, 0x6d, 0x18,
+	0xef, 0x61, 0xb8, 0x4f, 0x50, 0x36, 0xb8, 0x35, 0x95, 0x14, 0x4c, 0x7d, 0x00, 0x57, 0x5c, 0x1e,
+	0xc7, 0xb8, 0x54, 0x67, 0x6d, 0x96, 0x85, 0xd1, 0xb6, 0xba, 0x85, 0xea, 0x7c, 0x6a, 0x4c, 0xe0,
+	0xe8, 0x6c, 0x99, 0x88, 0x0f, 0x85, 0xdb, 0x1b, 0xa3, 0xf6, 0x45, 0x6c, 0x3c, 0x82, 0xe3, 0x17,
+	0x4c, 0xc8, 0x12, 0x1d, 0xf1, 0xcf, 0xbd, 0x05, 0x0c, 0xfe, 0xbe, 0x97, 0xeb, 0x78, 0x06, 0x74,
+	0x27, 0x19, 0xa1, 0x11, 0xbd, 0xbe, 0x37, 0x9a, 0xc3, 0x52, 0x34, 0x62, 0xf2, 0xbb, 0x06, 0xb4,
+	0x30, 0xf5, 0x06, 0xe3, 0xf4, 0x99, 0x7e, 0x25, 0x70, 0x90, 0xbb, 0x48, 0x0d, 0x75, 0xaa, 0xf2,
+	0x23, 0xe9, 0xdf, 0xab, 0x9c, 0xc9, 0xf8, 0x1a, 0x8f, 0xbf, 0xfc, 0xfc, 0xf5, 0xad, 0x76, 0x6a,
+	0x98, 0xea, 0xa7, 0xcb, 0xa8, 0x88, 0xf1, 0xa7, 0x1d, 0x0d, 0x66, 0x6e, 0xc7, 0xe7, 0xb1, 0xaa,
+	0x3d, 0x21, 0x27, 0xd4, 0x85, 0xa6, 0xb2, 0x9d, 0x0e, 0x14, 0xd0, 0x9e, 0x08, 0xfa, 0x37, 0x77,
+	0xbe, 0x93, 0x59, 0xfa, 0xdf, 0x1a, 0xf7, 0x15, 0xf2, 0xf0, 0x64, 0xb0, 0x83, 0x5c, 0xc0, 0xa1,
+	0x2b, 0x68, 0xa4, 0x7e, 0x53, 0x5d, 0x61, 0x54, 0x44, 0xd6, 0xbf, 0x5b, 0x31, 0x91, 0x8b, 0xcd,
+	0x21, 0x69, 0x25, 0xe4, 0xa2, 0xa5, 0x88, 0x9e, 0xfe, 0x19, 0x00, 0xdd, 0x93, 0x46, 0x6e, 0xa6,
+	0x04, 0x00, 0x00,
 }
@@ -0,0 +1,914 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: deviceWebhook.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type DeviceWebhookEvent int32
+
+const (
+	// The device has been created.
+	DeviceWebhookEvent_CREATED DeviceWebhookEvent = 0
+	// The device has been activated (first join).
+	DeviceWebhookEvent_ACTIVATED DeviceWebhookEvent = 1
+	// The device has been decommissioned (retired or deleted).
+	DeviceWebhookEvent_DECOMMISSIONED DeviceWebhookEvent = 2
+)
+
+var DeviceWebhookEvent_name = map[int32]string{
+	0: "CREATED",
+	1: "ACTIVATED",
+	2: "DECOMMISSIONED",
+}
+var DeviceWebhookEvent_value = map[string]int32{
+	"CREATED":        0,
+	"ACTIVATED":      1,
+	"DECOMMISSIONED": 2,
+}
+
+func (x DeviceWebhookEvent) String() string {
+	return proto.EnumName(DeviceWebhookEvent_name, int32(x))
+}
+func (DeviceWebhookEvent) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{0}
+}
+
+type DeviceWebhookHeader struct {
+	// Key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Value.
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceWebhookHeader) Reset()         { *m = DeviceWebhookHeader{} }
+func (m *DeviceWebhookHeader) String() string { return proto.CompactTextString(m) }
+func (*DeviceWebhookHeader) ProtoMessage()    {}
+func (*DeviceWebhookHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{0}
+}
+func (m *DeviceWebhookHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceWebhookHeader.Unmarshal(m, b)
+}
+func (m *DeviceWebhookHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceWebhookHeader.Marshal(b, m, deterministic)
+}
+func (dst *DeviceWebhookHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceWebhookHeader.Merge(dst, src)
+}
+func (m *DeviceWebhookHeader) XXX_Size() int {
+	return xxx_messageInfo_DeviceWebhookHeader.Size(m)
+}
+func (m *DeviceWebhookHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceWebhookHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceWebhookHeader proto.InternalMessageInfo
+
+func (m *DeviceWebhookHeader) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DeviceWebhookHeader) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type DeviceWebhook struct {
+	// Device webhook ID.
+	// This will be generated automatically on create.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	// After creation, this can not be updated.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Name of the device webhook.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// URL to which the device events are posted.
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// Secret used to sign the request body (optional).
+	// When set, the hex encoded HMAC-SHA256 signature of the body is set
+	// as the X-LoRa-Signature header.
+	Secret string `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Headers to use when calling the webhook.
+	Headers []*DeviceWebhookHeader `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Events for which the webhook is called.
+	// When empty, the webhook is called for all events.
+	Events               []DeviceWebhookEvent `protobuf:"varint,7,rep,packed,name=events,proto3,enum=api.DeviceWebhookEvent" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeviceWebhook) Reset()         { *m = DeviceWebhook{} }
+func (m *DeviceWebhook) String() string { return proto.CompactTextString(m) }
+func (*DeviceWebhook) ProtoMessage()    {}
+func (*DeviceWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{1}
+}
+func (m *DeviceWebhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceWebhook.Unmarshal(m, b)
+}
+func (m *DeviceWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceWebhook.Marshal(b, m, deterministic)
+}
+func (dst *DeviceWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceWebhook.Merge(dst, src)
+}
+func (m *DeviceWebhook) XXX_Size() int {
+	return xxx_messageInfo_DeviceWebhook.Size(m)
+}
+func (m *DeviceWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceWebhook proto.InternalMessageInfo
+
+func (m *DeviceWebhook) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeviceWebhook) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *DeviceWebhook) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceWebhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DeviceWebhook) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *DeviceWebhook) GetHeaders() []*DeviceWebhookHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *DeviceWebhook) GetEvents() []DeviceWebhookEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type DeviceWebhookListItem struct {
+	// Device webhook ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the device webhook.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// URL of the device webhook.
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeviceWebhookListItem) Reset()         { *m = DeviceWebhookListItem{} }
+func (m *DeviceWebhookListItem) String() string { return proto.CompactTextString(m) }
+func (*DeviceWebhookListItem) ProtoMessage()    {}
+func (*DeviceWebhookListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{2}
+}
+func (m *DeviceWebhookListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceWebhookListItem.Unmarshal(m, b)
+}
+func (m *DeviceWebhookListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceWebhookListItem.Marshal(b, m, deterministic)
+}
+func (dst *DeviceWebhookListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceWebhookListItem.Merge(dst, src)
+}
+func (m *DeviceWebhookListItem) XXX_Size() int {
+	return xxx_messageInfo_DeviceWebhookListItem.Size(m)
+}
+func (m *DeviceWebhookListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceWebhookListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceWebhookListItem proto.InternalMessageInfo
+
+func (m *DeviceWebhookListItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeviceWebhookListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceWebhookListItem) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DeviceWebhookListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *DeviceWebhookListItem) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type CreateDeviceWebhookRequest struct {
+	// Device webhook object to create.
+	DeviceWebhook        *DeviceWebhook `protobuf:"bytes,1,opt,name=device_webhook,json=deviceWebhook,proto3" json:"device_webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CreateDeviceWebhookRequest) Reset()         { *m = CreateDeviceWebhookRequest{} }
+func (m *CreateDeviceWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceWebhookRequest) ProtoMessage()    {}
+func (*CreateDeviceWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{3}
+}
+func (m *CreateDeviceWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceWebhookRequest.Unmarshal(m, b)
+}
+func (m *CreateDeviceWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceWebhookRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceWebhookRequest.Merge(dst, src)
+}
+func (m *CreateDeviceWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceWebhookRequest.Size(m)
+}
+func (m *CreateDeviceWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceWebhookRequest proto.InternalMessageInfo
+
+func (m *CreateDeviceWebhookRequest) GetDeviceWebhook() *DeviceWebhook {
+	if m != nil {
+		return m.DeviceWebhook
+	}
+	return nil
+}
+
+type CreateDeviceWebhookResponse struct {
+	// ID of the created device webhook.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDeviceWebhookResponse) Reset()         { *m = CreateDeviceWebhookResponse{} }
+func (m *CreateDeviceWebhookResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceWebhookResponse) ProtoMessage()    {}
+func (*CreateDeviceWebhookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{4}
+}
+func (m *CreateDeviceWebhookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceWebhookResponse.Unmarshal(m, b)
+}
+func (m *CreateDeviceWebhookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceWebhookResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceWebhookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceWebhookResponse.Merge(dst, src)
+}
+func (m *CreateDeviceWebhookResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceWebhookResponse.Size(m)
+}
+func (m *CreateDeviceWebhookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceWebhookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceWebhookResponse proto.InternalMessageInfo
+
+func (m *CreateDeviceWebhookResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDeviceWebhookRequest struct {
+	// Device webhook ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceWebhookRequest) Reset()         { *m = GetDeviceWebhookRequest{} }
+func (m *GetDeviceWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceWebhookRequest) ProtoMessage()    {}
+func (*GetDeviceWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{5}
+}
+func (m *GetDeviceWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceWebhookRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceWebhookRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceWebhookRequest.Merge(dst, src)
+}
+func (m *GetDeviceWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceWebhookRequest.Size(m)
+}
+func (m *GetDeviceWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceWebhookRequest proto.InternalMessageInfo
+
+func (m *GetDeviceWebhookRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDeviceWebhookResponse struct {
+	// Device webhook object.
+	DeviceWebhook *DeviceWebhook `protobuf:"bytes,1,opt,name=device_webhook,json=deviceWebhook,proto3" json:"device_webhook,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetDeviceWebhookResponse) Reset()         { *m = GetDeviceWebhookResponse{} }
+func (m *GetDeviceWebhookResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceWebhookResponse) ProtoMessage()    {}
+func (*GetDeviceWebhookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{6}
+}
+func (m *GetDeviceWebhookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceWebhookResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceWebhookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceWebhookResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceWebhookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceWebhookResponse.Merge(dst, src)
+}
+func (m *GetDeviceWebhookResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceWebhookResponse.Size(m)
+}
+func (m *GetDeviceWebhookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceWebhookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceWebhookResponse proto.InternalMessageInfo
+
+func (m *GetDeviceWebhookResponse) GetDeviceWebhook() *DeviceWebhook {
+	if m != nil {
+		return m.DeviceWebhook
+	}
+	return nil
+}
+
+func (m *GetDeviceWebhookResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetDeviceWebhookResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type UpdateDeviceWebhookRequest struct {
+	// Device webhook object to update.
+	DeviceWebhook        *DeviceWebhook `protobuf:"bytes,1,opt,name=device_webhook,json=deviceWebhook,proto3" json:"device_webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UpdateDeviceWebhookRequest) Reset()         { *m = UpdateDeviceWebhookRequest{} }
+func (m *UpdateDeviceWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceWebhookRequest) ProtoMessage()    {}
+func (*UpdateDeviceWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{7}
+}
+func (m *UpdateDeviceWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDeviceWebhookRequest.Unmarshal(m, b)
+}
+func (m *UpdateDeviceWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDeviceWebhookRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateDeviceWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeviceWebhookRequest.Merge(dst, src)
+}
+func (m *UpdateDeviceWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateDeviceWebhookRequest.Size(m)
+}
+func (m *UpdateDeviceWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeviceWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDeviceWebhookRequest proto.InternalMessageInfo
+
+func (m *UpdateDeviceWebhookRequest) GetDeviceWebhook() *DeviceWebhook {
+	if m != nil {
+		return m.DeviceWebhook
+	}
+	return nil
+}
+
+type DeleteDeviceWebhookRequest struct {
+	// Device webhook ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDeviceWebhookRequest) Reset()         { *m = DeleteDeviceWebhookRequest{} }
+func (m *DeleteDeviceWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDeviceWebhookRequest) ProtoMessage()    {}
+func (*DeleteDeviceWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{8}
+}
+func (m *DeleteDeviceWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDeviceWebhookRequest.Unmarshal(m, b)
+}
+func (m *DeleteDeviceWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDeviceWebhookRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteDeviceWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDeviceWebhookRequest.Merge(dst, src)
+}
+func (m *DeleteDeviceWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteDeviceWebhookRequest.Size(m)
+}
+func (m *DeleteDeviceWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDeviceWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDeviceWebhookRequest proto.InternalMessageInfo
+
+func (m *DeleteDeviceWebhookRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListDeviceWebhookRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Organization ID.
+	OrganizationId       int64    `protobuf:"varint,3,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeviceWebhookRequest) Reset()         { *m = ListDeviceWebhookRequest{} }
+func (m *ListDeviceWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceWebhookRequest) ProtoMessage()    {}
+func (*ListDeviceWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{9}
+}
+func (m *ListDeviceWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceWebhookRequest.Unmarshal(m, b)
+}
+func (m *ListDeviceWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceWebhookRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceWebhookRequest.Merge(dst, src)
+}
+func (m *ListDeviceWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceWebhookRequest.Size(m)
+}
+func (m *ListDeviceWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceWebhookRequest proto.InternalMessageInfo
+
+func (m *ListDeviceWebhookRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeviceWebhookRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListDeviceWebhookRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type ListDeviceWebhookResponse struct {
+	// Total number of device webhooks.
+	TotalCount           int64                    `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Result               []*DeviceWebhookListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ListDeviceWebhookResponse) Reset()         { *m = ListDeviceWebhookResponse{} }
+func (m *ListDeviceWebhookResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceWebhookResponse) ProtoMessage()    {}
+func (*ListDeviceWebhookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deviceWebhook_d90a446eaaa84ea6, []int{10}
+}
+func (m *ListDeviceWebhookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceWebhookResponse.Unmarshal(m, b)
+}
+func (m *ListDeviceWebhookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceWebhookResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceWebhookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceWebhookResponse.Merge(dst, src)
+}
+func (m *ListDeviceWebhookResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceWebhookResponse.Size(m)
+}
+func (m *ListDeviceWebhookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceWebhookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceWebhookResponse proto.InternalMessageInfo
+
+func (m *ListDeviceWebhookResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDeviceWebhookResponse) GetResult() []*DeviceWebhookListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DeviceWebhookHeader)(nil), "api.DeviceWebhookHeader")
+	proto.RegisterType((*DeviceWebhook)(nil), "api.DeviceWebhook")
+	proto.RegisterType((*DeviceWebhookListItem)(nil), "api.DeviceWebhookListItem")
+	proto.RegisterType((*CreateDeviceWebhookRequest)(nil), "api.CreateDeviceWebhookRequest")
+	proto.RegisterType((*CreateDeviceWebhookResponse)(nil), "api.CreateDeviceWebhookResponse")
+	proto.RegisterType((*GetDeviceWebhookRequest)(nil), "api.GetDeviceWebhookRequest")
+	proto.RegisterType((*GetDeviceWebhookResponse)(nil), "api.GetDeviceWebhookResponse")
+	proto.RegisterType((*UpdateDeviceWebhookRequest)(nil), "api.UpdateDeviceWebhookRequest")
+	proto.RegisterType((*DeleteDeviceWebhookRequest)(nil), "api.DeleteDeviceWebhookRequest")
+	proto.RegisterType((*ListDeviceWebhookRequest)(nil), "api.ListDeviceWebhookRequest")
+	proto.RegisterType((*ListDeviceWebhookResponse)(nil), "api.ListDeviceWebhookResponse")
+	proto.RegisterEnum("api.DeviceWebhookEvent", DeviceWebhookEvent_name, DeviceWebhookEvent_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DeviceWebhookServiceClient is the client API for DeviceWebhookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeviceWebhookServiceClient interface {
+	// Create creates the given device webhook.
+	Create(ctx context.Context, in *CreateDeviceWebhookRequest, opts ...grpc.CallOption) (*CreateDeviceWebhookResponse, error)
+	// Get returns the device webhook matching the given id.
+	Get(ctx context.Context, in *GetDeviceWebhookRequest, opts ...grpc.CallOption) (*GetDeviceWebhookResponse, error)
+	// Update updates the given device webhook.
+	Update(ctx context.Context, in *UpdateDeviceWebhookRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete deletes the device webhook matching the given id.
+	Delete(ctx context.Context, in *DeleteDeviceWebhookRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List lists the device webhooks of the given organization.
+	List(ctx context.Context, in *ListDeviceWebhookRequest, opts ...grpc.CallOption) (*ListDeviceWebhookResponse, error)
+}
+
+type deviceWebhookServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeviceWebhookServiceClient(cc *grpc.ClientConn) DeviceWebhookServiceClient {
+	return &deviceWebhookServiceClient{cc}
+}
+
+func (c *deviceWebhookServiceClient) Create(ctx context.Context, in *CreateDeviceWebhookRequest, opts ...grpc.CallOption) (*CreateDeviceWebhookResponse, error) {
+	out := new(CreateDeviceWebhookResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceWebhookService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceWebhookServiceClient) Get(ctx context.Context, in *GetDeviceWebhookRequest, opts ...grpc.CallOption) (*GetDeviceWebhookResponse, error) {
+	out := new(GetDeviceWebhookResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceWebhookService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceWebhookServiceClient) Update(ctx context.Context, in *UpdateDeviceWebhookRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceWebhookService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceWebhookServiceClient) Delete(ctx context.Context, in *DeleteDeviceWebhookRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceWebhookService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceWebhookServiceClient) List(ctx context.Context, in *ListDeviceWebhookRequest, opts ...grpc.CallOption) (*ListDeviceWebhookResponse, error) {
+	out := new(ListDeviceWebhookResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceWebhookService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceWebhookServiceServer is the server API for DeviceWebhookService service.
+type DeviceWebhookServiceServer interface {
+	// Create creates the given device webhook.
+	Create(context.Context, *CreateDeviceWebhookRequest) (*CreateDeviceWebhookResponse, error)
+	// Get returns the device webhook matching the given id.
+	Get(context.Context, *GetDeviceWebhookRequest) (*GetDeviceWebhookResponse, error)
+	// Update updates the given device webhook.
+	Update(context.Context, *UpdateDeviceWebhookRequest) (*empty.Empty, error)
+	// Delete deletes the device webhook matching the given id.
+	Delete(context.Context, *DeleteDeviceWebhookRequest) (*empty.Empty, error)
+	// List lists the device webhooks of the given organization.
+	List(context.Context, *ListDeviceWebhookRequest) (*ListDeviceWebhookResponse, error)
+}
+
+func RegisterDeviceWebhookServiceServer(s *grpc.Server, srv DeviceWebhookServiceServer) {
+	s.RegisterService(&_DeviceWebhookService_serviceDesc, srv)
+}
+
+func _DeviceWebhookService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceWebhookServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceWebhookService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceWebhookServiceServer).Create(ctx, req.(*CreateDeviceWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceWebhookService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceWebhookServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceWebhookService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceWebhookServiceServer).Get(ctx, req.(*GetDeviceWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceWebhookService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceWebhookServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceWebhookService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceWebhookServiceServer).Update(ctx, req.(*UpdateDeviceWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceWebhookService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceWebhookServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceWebhookService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceWebhookServiceServer).Delete(ctx, req.(*DeleteDeviceWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceWebhookService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceWebhookServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceWebhookService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceWebhookServiceServer).List(ctx, req.(*ListDeviceWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeviceWebhookService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.DeviceWebhookService",
+	HandlerType: (*DeviceWebhookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _DeviceWebhookService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DeviceWebhookService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _DeviceWebhookService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DeviceWebhookService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _DeviceWebhookService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "deviceWebhook.proto",
+}
+
+func init() { proto.RegisterFile("deviceWebhook.proto", fileDescriptor_deviceWebhook_d90a446eaaa84ea6) }
+
+var fileDescriptor_deviceWebhook_d90a446eaaa84ea6 = []byte{
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xfe, 0x25, 0x4e, 0x5c, 0x75, 0xa2, 0xe6, 0x57, 0x6d, 0x43, 0xeb, 0xba, 0x85, 0x04, 0x5f,
+	0x08, 0x15, 0x4d, 0xa4, 0xf4, 0x04, 0x12, 0x87, 0x28, 0x8e, 0x4a, 0x24, 0x4a, 0x25, 0xb7, 0xd0,
+	0x63, 0xe4, 0xc6, 0xd3, 0x76, 0x55, 0xc7, 0x76, 0xed, 0x75, 0x50, 0x41, 0xbd, 0xf0, 0x0a, 0xbc,
+	0x07, 0x2f, 0xc1, 0x91, 0x23, 0xaf, 0xc0, 0x8d, 0x97, 0x40, 0xde, 0x5d, 0x8b, 0x26, 0xb6, 0xf9,
+	0x53, 0x71, 0xf3, 0xec, 0x7c, 0xf3, 0xe7, 0x9b, 0xf9, 0x26, 0x81, 0x35, 0x07, 0x67, 0x74, 0x82,
+	0x27, 0x78, 0x7a, 0xe1, 0xfb, 0x97, 0x9d, 0x20, 0xf4, 0x99, 0x4f, 0x14, 0x3b, 0xa0, 0xfa, 0xf6,
+	0xb9, 0xef, 0x9f, 0xbb, 0xd8, 0xb5, 0x03, 0xda, 0xb5, 0x3d, 0xcf, 0x67, 0x36, 0xa3, 0xbe, 0x17,
+	0x09, 0x88, 0xde, 0x94, 0x5e, 0x6e, 0x9d, 0xc6, 0x67, 0x5d, 0x46, 0xa7, 0x18, 0x31, 0x7b, 0x1a,
+	0x48, 0xc0, 0xd6, 0x22, 0x00, 0xa7, 0x01, 0xbb, 0x16, 0x4e, 0xe3, 0x39, 0xac, 0x99, 0xb7, 0xeb,
+	0xbe, 0x40, 0xdb, 0xc1, 0x90, 0xac, 0x82, 0x72, 0x89, 0xd7, 0x5a, 0xa9, 0x55, 0x6a, 0x2f, 0x5b,
+	0xc9, 0x27, 0x69, 0x40, 0x75, 0x66, 0xbb, 0x31, 0x6a, 0x65, 0xfe, 0x26, 0x0c, 0xe3, 0x7b, 0x09,
+	0x56, 0xe6, 0xe2, 0x49, 0x1d, 0xca, 0xd4, 0xe1, 0x81, 0x8a, 0x55, 0xa6, 0x0e, 0x79, 0x04, 0xff,
+	0xfb, 0xe1, 0xb9, 0xed, 0xd1, 0x77, 0xbc, 0xeb, 0x31, 0x75, 0x78, 0x06, 0xc5, 0xaa, 0xdf, 0x7e,
+	0x1e, 0x99, 0x84, 0x40, 0xc5, 0xb3, 0xa7, 0xa8, 0x29, 0x3c, 0x3f, 0xff, 0x4e, 0xda, 0x88, 0x43,
+	0x57, 0xab, 0x88, 0x36, 0xe2, 0xd0, 0x25, 0xeb, 0xa0, 0x46, 0x38, 0x09, 0x91, 0x69, 0x55, 0xfe,
+	0x28, 0x2d, 0xd2, 0x83, 0xa5, 0x0b, 0xde, 0x7a, 0xa4, 0xa9, 0x2d, 0xa5, 0x5d, 0xeb, 0x69, 0x1d,
+	0x3b, 0xa0, 0x9d, 0x1c, 0x6e, 0x56, 0x0a, 0x24, 0x5d, 0x50, 0x71, 0x86, 0x1e, 0x8b, 0xb4, 0xa5,
+	0x96, 0xd2, 0xae, 0xf7, 0x36, 0xb2, 0x21, 0xc3, 0xc4, 0x6f, 0x49, 0x98, 0xf1, 0xb9, 0x04, 0xf7,
+	0xe6, 0xdc, 0x2f, 0x69, 0xc4, 0x46, 0x0c, 0xa7, 0x19, 0xd6, 0x29, 0x99, 0x72, 0x96, 0x8c, 0xf2,
+	0x93, 0xcc, 0x53, 0x80, 0x49, 0x88, 0x36, 0x43, 0x67, 0x6c, 0x33, 0xce, 0xb2, 0xd6, 0xd3, 0x3b,
+	0x62, 0x5d, 0x9d, 0x74, 0x5d, 0x9d, 0xe3, 0x74, 0x9f, 0xd6, 0xb2, 0x44, 0xf7, 0x59, 0x12, 0x1a,
+	0x07, 0x4e, 0x1a, 0x5a, 0xfd, 0x7d, 0xa8, 0x44, 0xf7, 0x99, 0x71, 0x02, 0xfa, 0x80, 0xe7, 0x99,
+	0xa3, 0x62, 0xe1, 0x55, 0x8c, 0x51, 0x92, 0xb8, 0x2e, 0x84, 0x38, 0x7e, 0x2b, 0x1c, 0x9c, 0x55,
+	0xad, 0x47, 0xb2, 0xc3, 0xb1, 0x56, 0xe6, 0x24, 0x6b, 0xec, 0xc2, 0x56, 0x6e, 0xe2, 0x28, 0xf0,
+	0xbd, 0x08, 0x17, 0x67, 0x64, 0x3c, 0x86, 0x8d, 0x7d, 0x64, 0xb9, 0x4d, 0x2c, 0x42, 0xbf, 0x94,
+	0x40, 0xcb, 0x62, 0x65, 0xde, 0xbb, 0x77, 0xbc, 0xb0, 0x80, 0xf2, 0xdd, 0x17, 0xa0, 0xfc, 0xe5,
+	0x02, 0x5e, 0x73, 0xe3, 0x5f, 0x2f, 0xe0, 0x09, 0xe8, 0x26, 0xba, 0xc8, 0xf0, 0x8f, 0x86, 0x7a,
+	0x05, 0x5a, 0xa2, 0xdf, 0x5c, 0x6c, 0x03, 0xaa, 0x2e, 0x9d, 0x52, 0x26, 0xe1, 0xc2, 0x48, 0x8e,
+	0xcf, 0x3f, 0x3b, 0x8b, 0x90, 0xc9, 0x13, 0x96, 0x56, 0xde, 0x8d, 0x2b, 0x79, 0x37, 0x6e, 0x04,
+	0xb0, 0x99, 0x53, 0x52, 0xee, 0xb1, 0x09, 0x35, 0xe6, 0x33, 0xdb, 0x1d, 0x4f, 0xfc, 0xd8, 0x4b,
+	0x2b, 0x03, 0x7f, 0x1a, 0x24, 0x2f, 0xa4, 0x07, 0x6a, 0x88, 0x51, 0xec, 0x26, 0xe5, 0x15, 0x3e,
+	0xee, 0xcc, 0x44, 0xd2, 0x83, 0xb4, 0x24, 0x72, 0xc7, 0x04, 0x92, 0x3d, 0x68, 0x52, 0x83, 0xa5,
+	0x81, 0x35, 0xec, 0x1f, 0x0f, 0xcd, 0xd5, 0xff, 0xc8, 0x0a, 0x2c, 0xf7, 0x07, 0xc7, 0xa3, 0x37,
+	0xdc, 0x2c, 0x11, 0x02, 0x75, 0x73, 0x38, 0x38, 0x3c, 0x38, 0x18, 0x1d, 0x1d, 0x8d, 0x0e, 0x5f,
+	0x0d, 0xcd, 0xd5, 0x72, 0xef, 0x53, 0x05, 0x1a, 0x73, 0x69, 0x8e, 0x30, 0x4c, 0x2c, 0xe2, 0x82,
+	0x2a, 0x24, 0x4f, 0x9a, 0xbc, 0x99, 0xe2, 0xc3, 0xd2, 0x5b, 0xc5, 0x00, 0x31, 0x00, 0xa3, 0xf9,
+	0xe1, 0xeb, 0xb7, 0x8f, 0xe5, 0x4d, 0xa3, 0xc1, 0x7f, 0xe9, 0xc5, 0x6a, 0x77, 0xa5, 0x08, 0xa2,
+	0x67, 0xa5, 0x1d, 0x82, 0xa0, 0xec, 0x23, 0x23, 0xdb, 0x3c, 0x53, 0xc1, 0xed, 0xe8, 0xf7, 0x0b,
+	0xbc, 0xb2, 0xc8, 0x43, 0x5e, 0x64, 0x8b, 0x6c, 0xe6, 0x15, 0xe9, 0xbe, 0xa7, 0xce, 0x0d, 0x99,
+	0x81, 0x2a, 0xf4, 0x29, 0x49, 0x15, 0x8b, 0x55, 0x5f, 0xcf, 0x28, 0x7e, 0x98, 0xfc, 0xb9, 0x18,
+	0x7b, 0xbc, 0xca, 0xae, 0xde, 0xce, 0xaf, 0x32, 0x2f, 0xf0, 0x0e, 0x75, 0x6e, 0x12, 0x7a, 0x0e,
+	0xa8, 0x42, 0xbe, 0xb2, 0x6e, 0xb1, 0x96, 0x0b, 0xeb, 0x4a, 0x76, 0x3b, 0xbf, 0x60, 0x37, 0x81,
+	0x4a, 0xa2, 0x12, 0x22, 0xe6, 0x54, 0x74, 0x01, 0xfa, 0x83, 0x22, 0xb7, 0x9c, 0xe3, 0x36, 0xaf,
+	0xb4, 0x4e, 0x72, 0x97, 0x75, 0xaa, 0xf2, 0xbe, 0xf6, 0x7e, 0x0c, 0x00, 0x15, 0x79, 0x54, 0x56,
+	0xd5, 0x07, 0x00, 0x00,
+}
@@ -49,17 +49,22 @@ type Gateway struct {
 	// This is (currently) only needed when the gateway supports the fine-timestamp
 	// and you you would like to add the FPGA ID to the gateway meta-data or would
 	// like LoRa Server to decrypt the fine-timestamp.
-	Boards               []*GatewayBoard `protobuf:"bytes,9,rep,name=boards,proto3" json:"boards,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Boards []*GatewayBoard `protobuf:"bytes,9,rep,name=boards,proto3" json:"boards,omitempty"`
+	// Tags (optional).
+	// These can be used to store gateway meta-data like site, owner,
+	// install date or antenna type. Tags are included in the RX info of the
+	// published events.
+	Tags                 map[string]string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Gateway) Reset()         { *m = Gateway{} }
 func (m *Gateway) String() string { return proto.CompactTextString(m) }
 func (*Gateway) ProtoMessage()    {}
 func (*Gateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{0}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{0}
 }
 func (m *Gateway) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Gateway.Unmarshal(m, b)
@@ -142,6 +147,13 @@ func (m *Gateway) GetBoards() []*GatewayBoard {
 	return nil
 }
 
+func (m *Gateway) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type GatewayBoard struct {
 	// FPGA ID of the gateway (HEX encoded) (optional).
 	FpgaId string `protobuf:"bytes,1,opt,name=fpga_id,json=fpgaID,proto3" json:"fpga_id,omitempty"`
@@ -156,7 +168,7 @@ func (m *GatewayBoard) Reset()         { *m = GatewayBoard{} }
 func (m *GatewayBoard) String() string { return proto.CompactTextString(m) }
 func (*GatewayBoard) ProtoMessage()    {}
 func (*GatewayBoard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{1}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{1}
 }
 func (m *GatewayBoard) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayBoard.Unmarshal(m, b)
@@ -202,7 +214,7 @@ func (m *CreateGatewayRequest) Reset()         { *m = CreateGatewayRequest{} }
 func (m *CreateGatewayRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateGatewayRequest) ProtoMessage()    {}
 func (*CreateGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{2}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{2}
 }
 func (m *CreateGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateGatewayRequest.Unmarshal(m, b)
@@ -241,7 +253,7 @@ func (m *GetGatewayRequest) Reset()         { *m = GetGatewayRequest{} }
 func (m *GetGatewayRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayRequest) ProtoMessage()    {}
 func (*GetGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{3}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{3}
 }
 func (m *GetGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayRequest.Unmarshal(m, b)
@@ -288,7 +300,7 @@ func (m *GetGatewayResponse) Reset()         { *m = GetGatewayResponse{} }
 func (m *GetGatewayResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayResponse) ProtoMessage()    {}
 func (*GetGatewayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{4}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{4}
 }
 func (m *GetGatewayResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayResponse.Unmarshal(m, b)
@@ -355,7 +367,7 @@ func (m *DeleteGatewayRequest) Reset()         { *m = DeleteGatewayRequest{} }
 func (m *DeleteGatewayRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteGatewayRequest) ProtoMessage()    {}
 func (*DeleteGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{5}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{5}
 }
 func (m *DeleteGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteGatewayRequest.Unmarshal(m, b)
@@ -391,17 +403,19 @@ type ListGatewayRequest struct {
 	// response will return all gateways to which the user has access to.
 	OrganizationId int64 `protobuf:"varint,3,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
 	// Search on name or gateway MAC (optional).
-	Search               string   `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Search string `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	// Only return gateways having all the given tags (optional).
+	Tags                 map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ListGatewayRequest) Reset()         { *m = ListGatewayRequest{} }
 func (m *ListGatewayRequest) String() string { return proto.CompactTextString(m) }
 func (*ListGatewayRequest) ProtoMessage()    {}
 func (*ListGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{6}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{6}
 }
 func (m *ListGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListGatewayRequest.Unmarshal(m, b)
@@ -449,6 +463,13 @@ func (m *ListGatewayRequest) GetSearch() string {
 	return ""
 }
 
+func (m *ListGatewayRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type GatewayListItem struct {
 	// Gateway ID (HEX encoded).
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -463,17 +484,19 @@ type GatewayListItem struct {
 	// Organization ID.
 	OrganizationId int64 `protobuf:"varint,6,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
 	// Network-server ID.
-	NetworkServerId      int64    `protobuf:"varint,7,opt,name=network_server_id,json=networkServerID,proto3" json:"network_server_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	NetworkServerId int64 `protobuf:"varint,7,opt,name=network_server_id,json=networkServerID,proto3" json:"network_server_id,omitempty"`
+	// Tags.
+	Tags                 map[string]string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *GatewayListItem) Reset()         { *m = GatewayListItem{} }
 func (m *GatewayListItem) String() string { return proto.CompactTextString(m) }
 func (*GatewayListItem) ProtoMessage()    {}
 func (*GatewayListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{7}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{7}
 }
 func (m *GatewayListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayListItem.Unmarshal(m, b)
@@ -542,6 +565,13 @@ func (m *GatewayListItem) GetNetworkServerId() int64 {
 	return 0
 }
 
+func (m *GatewayListItem) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type ListGatewayResponse struct {
 	// Total number of nodes available within the result-set.
 	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
@@ -556,7 +586,7 @@ func (m *ListGatewayResponse) Reset()         { *m = ListGatewayResponse{} }
 func (m *ListGatewayResponse) String() string { return proto.CompactTextString(m) }
 func (*ListGatewayResponse) ProtoMessage()    {}
 func (*ListGatewayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{8}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{8}
 }
 func (m *ListGatewayResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListGatewayResponse.Unmarshal(m, b)
@@ -602,7 +632,7 @@ func (m *UpdateGatewayRequest) Reset()         { *m = UpdateGatewayRequest{} }
 func (m *UpdateGatewayRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateGatewayRequest) ProtoMessage()    {}
 func (*UpdateGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{9}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{9}
 }
 func (m *UpdateGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateGatewayRequest.Unmarshal(m, b)
@@ -649,7 +679,7 @@ func (m *GatewayStats) Reset()         { *m = GatewayStats{} }
 func (m *GatewayStats) String() string { return proto.CompactTextString(m) }
 func (*GatewayStats) ProtoMessage()    {}
 func (*GatewayStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{10}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{10}
 }
 func (m *GatewayStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayStats.Unmarshal(m, b)
@@ -723,7 +753,7 @@ func (m *GetGatewayStatsRequest) Reset()         { *m = GetGatewayStatsRequest{}
 func (m *GetGatewayStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayStatsRequest) ProtoMessage()    {}
 func (*GetGatewayStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{11}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{11}
 }
 func (m *GetGatewayStatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayStatsRequest.Unmarshal(m, b)
@@ -782,7 +812,7 @@ func (m *GetGatewayStatsResponse) Reset()         { *m = GetGatewayStatsResponse
 func (m *GetGatewayStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayStatsResponse) ProtoMessage()    {}
 func (*GetGatewayStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{12}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{12}
 }
 func (m *GetGatewayStatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayStatsResponse.Unmarshal(m, b)
@@ -831,7 +861,7 @@ func (m *PingRX) Reset()         { *m = PingRX{} }
 func (m *PingRX) String() string { return proto.CompactTextString(m) }
 func (*PingRX) ProtoMessage()    {}
 func (*PingRX) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{13}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{13}
 }
 func (m *PingRX) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingRX.Unmarshal(m, b)
@@ -905,7 +935,7 @@ func (m *GetLastPingRequest) Reset()         { *m = GetLastPingRequest{} }
 func (m *GetLastPingRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLastPingRequest) ProtoMessage()    {}
 func (*GetLastPingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{14}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{14}
 }
 func (m *GetLastPingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetLastPingRequest.Unmarshal(m, b)
@@ -950,7 +980,7 @@ func (m *GetLastPingResponse) Reset()         { *m = GetLastPingResponse{} }
 func (m *GetLastPingResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLastPingResponse) ProtoMessage()    {}
 func (*GetLastPingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{15}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{15}
 }
 func (m *GetLastPingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetLastPingResponse.Unmarshal(m, b)
@@ -1010,7 +1040,7 @@ func (m *StreamGatewayFrameLogsRequest) Reset()         { *m = StreamGatewayFram
 func (m *StreamGatewayFrameLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamGatewayFrameLogsRequest) ProtoMessage()    {}
 func (*StreamGatewayFrameLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{16}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{16}
 }
 func (m *StreamGatewayFrameLogsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamGatewayFrameLogsRequest.Unmarshal(m, b)
@@ -1051,7 +1081,7 @@ func (m *StreamGatewayFrameLogsResponse) Reset()         { *m = StreamGatewayFra
 func (m *StreamGatewayFrameLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamGatewayFrameLogsResponse) ProtoMessage()    {}
 func (*StreamGatewayFrameLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gateway_7c343f8be711ab3c, []int{17}
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{17}
 }
 func (m *StreamGatewayFrameLogsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamGatewayFrameLogsResponse.Unmarshal(m, b)
@@ -1182,15 +1212,339 @@ func _StreamGatewayFrameLogsResponse_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type RequestGatewayLogRequest struct {
+	// Gateway ID (HEX encoded).
+	GatewayId string `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayID,proto3" json:"gateway_id,omitempty"`
+	// Number of log lines to request (default 100, max 1000).
+	Lines                uint32   `protobuf:"varint,2,opt,name=lines,proto3" json:"lines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestGatewayLogRequest) Reset()         { *m = RequestGatewayLogRequest{} }
+func (m *RequestGatewayLogRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestGatewayLogRequest) ProtoMessage()    {}
+func (*RequestGatewayLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{18}
+}
+func (m *RequestGatewayLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestGatewayLogRequest.Unmarshal(m, b)
+}
+func (m *RequestGatewayLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestGatewayLogRequest.Marshal(b, m, deterministic)
+}
+func (dst *RequestGatewayLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestGatewayLogRequest.Merge(dst, src)
+}
+func (m *RequestGatewayLogRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestGatewayLogRequest.Size(m)
+}
+func (m *RequestGatewayLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestGatewayLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestGatewayLogRequest proto.InternalMessageInfo
+
+func (m *RequestGatewayLogRequest) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
+	}
+	return ""
+}
+
+func (m *RequestGatewayLogRequest) GetLines() uint32 {
+	if m != nil {
+		return m.Lines
+	}
+	return 0
+}
+
+type RequestGatewayLogResponse struct {
+	// ID of the request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Pending until timestamp.
+	// When the gateway has not picked up the request by then, the request
+	// expires.
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RequestGatewayLogResponse) Reset()         { *m = RequestGatewayLogResponse{} }
+func (m *RequestGatewayLogResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestGatewayLogResponse) ProtoMessage()    {}
+func (*RequestGatewayLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{19}
+}
+func (m *RequestGatewayLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestGatewayLogResponse.Unmarshal(m, b)
+}
+func (m *RequestGatewayLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestGatewayLogResponse.Marshal(b, m, deterministic)
+}
+func (dst *RequestGatewayLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestGatewayLogResponse.Merge(dst, src)
+}
+func (m *RequestGatewayLogResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestGatewayLogResponse.Size(m)
+}
+func (m *RequestGatewayLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestGatewayLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestGatewayLogResponse proto.InternalMessageInfo
+
+func (m *RequestGatewayLogResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RequestGatewayLogResponse) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type ListGatewayLogsRequest struct {
+	// Gateway ID (HEX encoded).
+	GatewayId            string   `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayID,proto3" json:"gateway_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGatewayLogsRequest) Reset()         { *m = ListGatewayLogsRequest{} }
+func (m *ListGatewayLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGatewayLogsRequest) ProtoMessage()    {}
+func (*ListGatewayLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{20}
+}
+func (m *ListGatewayLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGatewayLogsRequest.Unmarshal(m, b)
+}
+func (m *ListGatewayLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGatewayLogsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListGatewayLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGatewayLogsRequest.Merge(dst, src)
+}
+func (m *ListGatewayLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGatewayLogsRequest.Size(m)
+}
+func (m *ListGatewayLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGatewayLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGatewayLogsRequest proto.InternalMessageInfo
+
+func (m *ListGatewayLogsRequest) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
+	}
+	return ""
+}
+
+type GatewayLogExcerpt struct {
+	// ID of the request to which the excerpt is the response.
+	// This is empty when the excerpt was pushed without request.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestID,proto3" json:"request_id,omitempty"`
+	// Received at timestamp.
+	ReceivedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	// Log lines.
+	Lines                []string `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayLogExcerpt) Reset()         { *m = GatewayLogExcerpt{} }
+func (m *GatewayLogExcerpt) String() string { return proto.CompactTextString(m) }
+func (*GatewayLogExcerpt) ProtoMessage()    {}
+func (*GatewayLogExcerpt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{21}
+}
+func (m *GatewayLogExcerpt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayLogExcerpt.Unmarshal(m, b)
+}
+func (m *GatewayLogExcerpt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayLogExcerpt.Marshal(b, m, deterministic)
+}
+func (dst *GatewayLogExcerpt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayLogExcerpt.Merge(dst, src)
+}
+func (m *GatewayLogExcerpt) XXX_Size() int {
+	return xxx_messageInfo_GatewayLogExcerpt.Size(m)
+}
+func (m *GatewayLogExcerpt) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayLogExcerpt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayLogExcerpt proto.InternalMessageInfo
+
+func (m *GatewayLogExcerpt) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *GatewayLogExcerpt) GetReceivedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return nil
+}
+
+func (m *GatewayLogExcerpt) GetLines() []string {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+type ListGatewayLogsResponse struct {
+	// Pending request ID (if any).
+	PendingRequestId string `protobuf:"bytes,1,opt,name=pending_request_id,json=pendingRequestID,proto3" json:"pending_request_id,omitempty"`
+	// Log excerpts.
+	Result               []*GatewayLogExcerpt `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListGatewayLogsResponse) Reset()         { *m = ListGatewayLogsResponse{} }
+func (m *ListGatewayLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGatewayLogsResponse) ProtoMessage()    {}
+func (*ListGatewayLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{22}
+}
+func (m *ListGatewayLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGatewayLogsResponse.Unmarshal(m, b)
+}
+func (m *ListGatewayLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGatewayLogsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListGatewayLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGatewayLogsResponse.Merge(dst, src)
+}
+func (m *ListGatewayLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGatewayLogsResponse.Size(m)
+}
+func (m *ListGatewayLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGatewayLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGatewayLogsResponse proto.InternalMessageInfo
+
+func (m *ListGatewayLogsResponse) GetPendingRequestId() string {
+	if m != nil {
+		return m.PendingRequestId
+	}
+	return ""
+}
+
+func (m *ListGatewayLogsResponse) GetResult() []*GatewayLogExcerpt {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type GetGatewayLogTokenRequest struct {
+	// Gateway ID (HEX encoded).
+	GatewayId            string   `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayID,proto3" json:"gateway_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGatewayLogTokenRequest) Reset()         { *m = GetGatewayLogTokenRequest{} }
+func (m *GetGatewayLogTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGatewayLogTokenRequest) ProtoMessage()    {}
+func (*GetGatewayLogTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{23}
+}
+func (m *GetGatewayLogTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGatewayLogTokenRequest.Unmarshal(m, b)
+}
+func (m *GetGatewayLogTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGatewayLogTokenRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetGatewayLogTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewayLogTokenRequest.Merge(dst, src)
+}
+func (m *GetGatewayLogTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGatewayLogTokenRequest.Size(m)
+}
+func (m *GetGatewayLogTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewayLogTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGatewayLogTokenRequest proto.InternalMessageInfo
+
+func (m *GetGatewayLogTokenRequest) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
+	}
+	return ""
+}
+
+type GetGatewayLogTokenResponse struct {
+	// Bearer token of the gateway.
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGatewayLogTokenResponse) Reset()         { *m = GetGatewayLogTokenResponse{} }
+func (m *GetGatewayLogTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGatewayLogTokenResponse) ProtoMessage()    {}
+func (*GetGatewayLogTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gateway_5ba88ef1266f47d1, []int{24}
+}
+func (m *GetGatewayLogTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGatewayLogTokenResponse.Unmarshal(m, b)
+}
+func (m *GetGatewayLogTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGatewayLogTokenResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetGatewayLogTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewayLogTokenResponse.Merge(dst, src)
+}
+func (m *GetGatewayLogTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGatewayLogTokenResponse.Size(m)
+}
+func (m *GetGatewayLogTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewayLogTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGatewayLogTokenResponse proto.InternalMessageInfo
+
+func (m *GetGatewayLogTokenResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Gateway)(nil), "api.Gateway")
+	proto.RegisterMapType((map[string]string)(nil), "api.Gateway.TagsEntry")
 	proto.RegisterType((*GatewayBoard)(nil), "api.GatewayBoard")
 	proto.RegisterType((*CreateGatewayRequest)(nil), "api.CreateGatewayRequest")
 	proto.RegisterType((*GetGatewayRequest)(nil), "api.GetGatewayRequest")
 	proto.RegisterType((*GetGatewayResponse)(nil), "api.GetGatewayResponse")
 	proto.RegisterType((*DeleteGatewayRequest)(nil), "api.DeleteGatewayRequest")
 	proto.RegisterType((*ListGatewayRequest)(nil), "api.ListGatewayRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.ListGatewayRequest.TagsEntry")
 	proto.RegisterType((*GatewayListItem)(nil), "api.GatewayListItem")
+	proto.RegisterMapType((map[string]string)(nil), "api.GatewayListItem.TagsEntry")
 	proto.RegisterType((*ListGatewayResponse)(nil), "api.ListGatewayResponse")
 	proto.RegisterType((*UpdateGatewayRequest)(nil), "api.UpdateGatewayRequest")
 	proto.RegisterType((*GatewayStats)(nil), "api.GatewayStats")
@@ -1201,6 +1555,13 @@ func init() {
 	proto.RegisterType((*GetLastPingResponse)(nil), "api.GetLastPingResponse")
 	proto.RegisterType((*StreamGatewayFrameLogsRequest)(nil), "api.StreamGatewayFrameLogsRequest")
 	proto.RegisterType((*StreamGatewayFrameLogsResponse)(nil), "api.StreamGatewayFrameLogsResponse")
+	proto.RegisterType((*RequestGatewayLogRequest)(nil), "api.RequestGatewayLogRequest")
+	proto.RegisterType((*RequestGatewayLogResponse)(nil), "api.RequestGatewayLogResponse")
+	proto.RegisterType((*ListGatewayLogsRequest)(nil), "api.ListGatewayLogsRequest")
+	proto.RegisterType((*GatewayLogExcerpt)(nil), "api.GatewayLogExcerpt")
+	proto.RegisterType((*ListGatewayLogsResponse)(nil), "api.ListGatewayLogsResponse")
+	proto.RegisterType((*GetGatewayLogTokenRequest)(nil), "api.GetGatewayLogTokenRequest")
+	proto.RegisterType((*GetGatewayLogTokenResponse)(nil), "api.GetGatewayLogTokenResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1234,6 +1595,16 @@ type GatewayServiceClient interface {
 	//   * These are the raw LoRaWAN frames and this endpoint is intended for debugging only.
 	//   * This endpoint does not work from a web-browser.
 	StreamFrameLogs(ctx context.Context, in *StreamGatewayFrameLogsRequest, opts ...grpc.CallOption) (GatewayService_StreamFrameLogsClient, error)
+	// RequestLog requests an excerpt of the packet-forwarder log of the given
+	// gateway. The excerpt is pushed by the gateway (e.g. through the gateway
+	// bridge) once it has picked up the request.
+	RequestLog(ctx context.Context, in *RequestGatewayLogRequest, opts ...grpc.CallOption) (*RequestGatewayLogResponse, error)
+	// ListLogs lists the packet-forwarder log excerpts pushed by the given
+	// gateway, the most recent excerpt first.
+	ListLogs(ctx context.Context, in *ListGatewayLogsRequest, opts ...grpc.CallOption) (*ListGatewayLogsResponse, error)
+	// GetLogToken returns the bearer token which the given gateway must use
+	// for picking up the log requests and pushing the log excerpts.
+	GetLogToken(ctx context.Context, in *GetGatewayLogTokenRequest, opts ...grpc.CallOption) (*GetGatewayLogTokenResponse, error)
 }
 
 type gatewayServiceClient struct {
@@ -1339,6 +1710,33 @@ func (x *gatewayServiceStreamFrameLogsClient) Recv() (*StreamGatewayFrameLogsRes
 	return m, nil
 }
 
+func (c *gatewayServiceClient) RequestLog(ctx context.Context, in *RequestGatewayLogRequest, opts ...grpc.CallOption) (*RequestGatewayLogResponse, error) {
+	out := new(RequestGatewayLogResponse)
+	err := c.cc.Invoke(ctx, "/api.GatewayService/RequestLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListLogs(ctx context.Context, in *ListGatewayLogsRequest, opts ...grpc.CallOption) (*ListGatewayLogsResponse, error) {
+	out := new(ListGatewayLogsResponse)
+	err := c.cc.Invoke(ctx, "/api.GatewayService/ListLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) GetLogToken(ctx context.Context, in *GetGatewayLogTokenRequest, opts ...grpc.CallOption) (*GetGatewayLogTokenResponse, error) {
+	out := new(GetGatewayLogTokenResponse)
+	err := c.cc.Invoke(ctx, "/api.GatewayService/GetLogToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GatewayServiceServer is the server API for GatewayService service.
 type GatewayServiceServer interface {
 	// Create creates the given gateway.
@@ -1360,6 +1758,16 @@ type GatewayServiceServer interface {
 	//   * These are the raw LoRaWAN frames and this endpoint is intended for debugging only.
 	//   * This endpoint does not work from a web-browser.
 	StreamFrameLogs(*StreamGatewayFrameLogsRequest, GatewayService_StreamFrameLogsServer) error
+	// RequestLog requests an excerpt of the packet-forwarder log of the given
+	// gateway. The excerpt is pushed by the gateway (e.g. through the gateway
+	// bridge) once it has picked up the request.
+	RequestLog(context.Context, *RequestGatewayLogRequest) (*RequestGatewayLogResponse, error)
+	// ListLogs lists the packet-forwarder log excerpts pushed by the given
+	// gateway, the most recent excerpt first.
+	ListLogs(context.Context, *ListGatewayLogsRequest) (*ListGatewayLogsResponse, error)
+	// GetLogToken returns the bearer token which the given gateway must use
+	// for picking up the log requests and pushing the log excerpts.
+	GetLogToken(context.Context, *GetGatewayLogTokenRequest) (*GetGatewayLogTokenResponse, error)
 }
 
 func RegisterGatewayServiceServer(s *grpc.Server, srv GatewayServiceServer) {
@@ -1513,6 +1921,60 @@ func (x *gatewayServiceStreamFrameLogsServer) Send(m *StreamGatewayFrameLogsResp
 	return x.ServerStream.SendMsg(m)
 }
 
+func _GatewayService_RequestLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestGatewayLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).RequestLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GatewayService/RequestLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).RequestLog(ctx, req.(*RequestGatewayLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ListLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewayLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GatewayService/ListLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListLogs(ctx, req.(*ListGatewayLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_GetLogToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayLogTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetLogToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GatewayService/GetLogToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetLogToken(ctx, req.(*GetGatewayLogTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GatewayService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.GatewayService",
 	HandlerType: (*GatewayServiceServer)(nil),
@@ -1545,6 +2007,18 @@ var _GatewayService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLastPing",
 			Handler:    _GatewayService_GetLastPing_Handler,
 		},
+		{
+			MethodName: "RequestLog",
+			Handler:    _GatewayService_RequestLog_Handler,
+		},
+		{
+			MethodName: "ListLogs",
+			Handler:    _GatewayService_ListLogs_Handler,
+		},
+		{
+			MethodName: "GetLogToken",
+			Handler:    _GatewayService_GetLogToken_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1556,92 +2030,112 @@ var _GatewayService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "gateway.proto",
 }
 
-func init() { proto.RegisterFile("gateway.proto", fileDescriptor_gateway_7c343f8be711ab3c) }
-
-var fileDescriptor_gateway_7c343f8be711ab3c = []byte{
-	// 1341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4b, 0x73, 0x1b, 0xc5,
-	0x16, 0xbe, 0xa3, 0xc7, 0xc8, 0x3a, 0xb2, 0xfc, 0x68, 0x3b, 0x8e, 0xa2, 0x38, 0x37, 0xba, 0x93,
-	0x9b, 0xc4, 0xc9, 0xf5, 0x95, 0x28, 0xa7, 0xa8, 0x0a, 0x14, 0x65, 0x2a, 0x89, 0x82, 0x71, 0xc5,
-	0x05, 0xae, 0x31, 0x2e, 0xd8, 0x4d, 0xb5, 0x34, 0x2d, 0xa5, 0xcb, 0xa3, 0xe9, 0xa1, 0xbb, 0xe5,
-	0xc4, 0x50, 0xd9, 0xc0, 0x82, 0x05, 0x4b, 0xfe, 0x01, 0xb0, 0x64, 0xc1, 0x8a, 0x3f, 0xc2, 0x06,
-	0xf6, 0xfc, 0x10, 0xaa, 0x1f, 0x1a, 0x8f, 0xa5, 0x89, 0xed, 0xa4, 0x58, 0x69, 0xfa, 0xbc, 0xcf,
-	0x77, 0x1e, 0xdd, 0x82, 0xfa, 0x10, 0x4b, 0xf2, 0x02, 0x9f, 0xb4, 0x13, 0xce, 0x24, 0x43, 0x45,
-	0x9c, 0xd0, 0xe6, 0xfa, 0x90, 0xb1, 0x61, 0x44, 0x3a, 0x38, 0xa1, 0x1d, 0x1c, 0xc7, 0x4c, 0x62,
-	0x49, 0x59, 0x2c, 0x8c, 0x48, 0xf3, 0xa6, 0xe5, 0xea, 0x53, 0x6f, 0x3c, 0xe8, 0x48, 0x3a, 0x22,
-	0x42, 0xe2, 0x51, 0x62, 0x05, 0xae, 0x4f, 0x0b, 0x90, 0x51, 0x22, 0xad, 0x83, 0xe6, 0xbb, 0x43,
-	0x2a, 0x9f, 0x8f, 0x7b, 0xed, 0x3e, 0x1b, 0x75, 0x7a, 0x9c, 0xf5, 0x31, 0xe6, 0x9d, 0x88, 0x71,
-	0x2c, 0x08, 0x3f, 0x26, 0x5c, 0xbb, 0xec, 0xb3, 0xd1, 0x88, 0xc5, 0xf6, 0xc7, 0xaa, 0xcd, 0x67,
-	0x4f, 0xde, 0x1f, 0x05, 0xa8, 0xec, 0x98, 0xb8, 0xd1, 0x02, 0x14, 0x68, 0xd8, 0x70, 0x5a, 0xce,
-	0x46, 0xd5, 0x2f, 0xd0, 0x10, 0x21, 0x28, 0xc5, 0x78, 0x44, 0x1a, 0x05, 0x4d, 0xd1, 0xdf, 0xa8,
-	0x05, 0xb5, 0x90, 0x88, 0x3e, 0xa7, 0x89, 0x4a, 0xa4, 0x51, 0xd4, 0xac, 0x2c, 0x09, 0x6d, 0xc2,
-	0x5c, 0xc4, 0xfa, 0x3a, 0xcf, 0x46, 0xa9, 0xe5, 0x6c, 0xd4, 0xb6, 0x96, 0xda, 0xd6, 0xe5, 0x9e,
-	0xa5, 0xfb, 0xa9, 0x04, 0xba, 0x0b, 0x8b, 0x8c, 0x0f, 0x71, 0x4c, 0xbf, 0xd2, 0xe7, 0x80, 0x86,
-	0x8d, 0x72, 0xcb, 0xd9, 0x28, 0xfa, 0x0b, 0x59, 0xf2, 0x6e, 0x17, 0xfd, 0x0f, 0x96, 0x43, 0x2a,
-	0xfa, 0xec, 0x98, 0xf0, 0x93, 0x80, 0xc4, 0xb8, 0x17, 0x91, 0xb0, 0xe1, 0xb6, 0x9c, 0x8d, 0x39,
-	0x7f, 0x29, 0x65, 0x3c, 0x35, 0x74, 0x74, 0x1f, 0x96, 0x63, 0x22, 0x5f, 0x30, 0x7e, 0x14, 0x18,
-	0x34, 0x94, 0xdd, 0x8a, 0xb6, 0xbb, 0x68, 0x19, 0x07, 0x9a, 0xbe, 0xdb, 0x45, 0x9b, 0x80, 0x6c,
-	0xe1, 0x82, 0x84, 0xb3, 0x01, 0x8d, 0x88, 0x12, 0x9e, 0xd3, 0x89, 0x2d, 0x59, 0xce, 0xbe, 0x61,
-	0xec, 0x76, 0xd1, 0x3d, 0x70, 0x7b, 0x0c, 0xf3, 0x50, 0x34, 0xaa, 0xad, 0xe2, 0x46, 0x6d, 0x6b,
-	0xb9, 0x8d, 0x13, 0xda, 0xb6, 0x08, 0x3e, 0x56, 0x1c, 0xdf, 0x0a, 0x78, 0x87, 0x30, 0x9f, 0xa5,
-	0xa3, 0xab, 0x50, 0x19, 0x24, 0x43, 0x1c, 0xa4, 0x18, 0xbb, 0xea, 0x68, 0x22, 0x18, 0xd0, 0x98,
-	0x04, 0x69, 0xf5, 0x83, 0x23, 0x72, 0x62, 0x51, 0x5f, 0x52, 0x9c, 0xcf, 0x26, 0x8c, 0x67, 0xe4,
-	0xc4, 0xdb, 0x86, 0xd5, 0x27, 0x9c, 0x60, 0x49, 0xac, 0x71, 0x9f, 0x7c, 0x39, 0x26, 0x42, 0xa2,
-	0x3b, 0x50, 0xb1, 0xd1, 0x6a, 0xf3, 0xb5, 0xad, 0xf9, 0x6c, 0x68, 0xfe, 0x84, 0xe9, 0xdd, 0x82,
-	0xe5, 0x1d, 0x22, 0xa7, 0x94, 0xa7, 0x4a, 0xef, 0xfd, 0x5a, 0x00, 0x94, 0x95, 0x12, 0x09, 0x8b,
-	0x05, 0xb9, 0xac, 0x0f, 0xf4, 0x1e, 0x40, 0x5f, 0xc7, 0x18, 0x06, 0x58, 0xea, 0x4c, 0x6a, 0x5b,
-	0xcd, 0xb6, 0x69, 0xe6, 0xf6, 0xa4, 0x99, 0xdb, 0x69, 0x5a, 0x7e, 0xd5, 0x4a, 0x3f, 0x92, 0x4a,
-	0x75, 0x9c, 0x84, 0x13, 0xd5, 0xe2, 0xc5, 0xaa, 0x56, 0xfa, 0x91, 0x44, 0xdb, 0x50, 0x1f, 0x50,
-	0x2e, 0x64, 0x20, 0x08, 0x89, 0x95, 0x76, 0xe9, 0x42, 0xed, 0x9a, 0x56, 0x38, 0x20, 0x24, 0x7e,
-	0x24, 0xd1, 0x07, 0x30, 0x1f, 0xe1, 0x8c, 0x7a, 0xf9, 0x42, 0x75, 0x50, 0xf2, 0x46, 0xdb, 0xbb,
-	0x03, 0xab, 0x5d, 0x12, 0x91, 0x99, 0xba, 0x4c, 0x43, 0xfb, 0xad, 0x03, 0x68, 0x8f, 0x8a, 0xe9,
-	0x0a, 0xac, 0x42, 0x39, 0xa2, 0x23, 0x2a, 0xb5, 0x64, 0xd9, 0x37, 0x07, 0xb4, 0x06, 0x2e, 0x1b,
-	0x0c, 0x04, 0x31, 0x20, 0x96, 0x7d, 0x7b, 0xca, 0x1b, 0x9b, 0x62, 0xee, 0xd8, 0xac, 0x81, 0x2b,
-	0x08, 0xe6, 0xfd, 0xe7, 0x1a, 0x8c, 0xaa, 0x6f, 0x4f, 0xde, 0x8f, 0x05, 0x58, 0xb4, 0x11, 0xa8,
-	0x60, 0x76, 0x25, 0x19, 0xfd, 0x43, 0xf3, 0x7f, 0xb6, 0xf6, 0xa5, 0xb7, 0xaf, 0x7d, 0xf9, 0x4d,
-	0x6a, 0x9f, 0x03, 0x88, 0x9b, 0x0b, 0xc8, 0x1b, 0xac, 0x06, 0x2f, 0x84, 0x95, 0x33, 0x95, 0xb2,
-	0x53, 0x70, 0x13, 0x6a, 0x92, 0x49, 0x1c, 0x05, 0x7d, 0x36, 0x8e, 0x4d, 0xc1, 0x8a, 0x3e, 0x68,
-	0xd2, 0x13, 0x45, 0x41, 0x9b, 0xe0, 0x72, 0x22, 0xc6, 0x91, 0xaa, 0x9a, 0x5a, 0x12, 0xab, 0xd9,
-	0x29, 0x99, 0xc0, 0xed, 0x5b, 0x19, 0x35, 0xd0, 0x87, 0x3a, 0x8f, 0xb7, 0x1c, 0xe8, 0xef, 0x0b,
-	0xe9, 0xa2, 0x39, 0x90, 0x58, 0x0a, 0xf4, 0x10, 0xaa, 0xe9, 0x2a, 0xb1, 0xaa, 0xe7, 0xa2, 0x98,
-	0x0a, 0xa3, 0x36, 0xac, 0xf0, 0x97, 0x41, 0x82, 0xfb, 0x47, 0x44, 0x8a, 0x80, 0x93, 0x3e, 0xa1,
-	0xc7, 0x24, 0xb4, 0xbd, 0xb7, 0xcc, 0x5f, 0xee, 0x1b, 0x8e, 0x6f, 0x19, 0xe8, 0x01, 0xac, 0xe5,
-	0xc8, 0x07, 0xec, 0x48, 0x37, 0x46, 0xd9, 0x5f, 0x99, 0x51, 0xf9, 0xf4, 0x99, 0x72, 0x22, 0x73,
-	0x9c, 0x94, 0x8c, 0x13, 0x39, 0xe3, 0x64, 0x13, 0x50, 0x46, 0x9e, 0x8c, 0xa8, 0x94, 0xc4, 0xdc,
-	0x12, 0x65, 0x7f, 0x29, 0x15, 0x7f, 0x6a, 0xe8, 0xde, 0x9f, 0x0e, 0xac, 0x9d, 0x6e, 0x2e, 0x0d,
-	0xc8, 0x04, 0xd0, 0x1b, 0x00, 0x93, 0x4d, 0x9f, 0xf6, 0x79, 0xd5, 0x52, 0x76, 0xbb, 0xa8, 0x09,
-	0x73, 0x34, 0x96, 0x84, 0x1f, 0xe3, 0xc8, 0xb6, 0x7c, 0x7a, 0x46, 0x4f, 0x60, 0x51, 0x48, 0xcc,
-	0xe5, 0xe9, 0x8e, 0xbe, 0xc4, 0x6a, 0x5a, 0xd0, 0x2a, 0xe9, 0x19, 0x7d, 0x08, 0x75, 0x12, 0x87,
-	0x19, 0x13, 0x17, 0x0f, 0xc7, 0x3c, 0x89, 0xc3, 0xf4, 0xe4, 0x75, 0xe1, 0xea, 0x4c, 0x6a, 0xb6,
-	0x27, 0xef, 0xa5, 0x2d, 0xe7, 0xcc, 0xde, 0x4b, 0x46, 0x74, 0xd2, 0x6f, 0xbf, 0x38, 0xe0, 0xee,
-	0xd3, 0x78, 0xe8, 0x7f, 0x71, 0x11, 0x22, 0x08, 0x4a, 0x5c, 0x08, 0x6a, 0xeb, 0xaf, 0xbf, 0xd1,
-	0x35, 0x75, 0xbd, 0x73, 0x1c, 0x88, 0x98, 0x6b, 0x08, 0x1c, 0xbf, 0x12, 0x31, 0x1f, 0x1f, 0x7c,
-	0xe2, 0x2b, 0x00, 0x23, 0x2c, 0xa9, 0x1c, 0x87, 0x44, 0xa7, 0xe6, 0xf8, 0xe9, 0x19, 0xad, 0x43,
-	0x35, 0x62, 0xf1, 0xd0, 0x30, 0xcb, 0x9a, 0x79, 0x4a, 0x50, 0x9a, 0x38, 0xb2, 0x9a, 0xae, 0xd1,
-	0x9c, 0x9c, 0xbd, 0x07, 0xfa, 0x26, 0xda, 0xc3, 0x42, 0xea, 0xa0, 0x2f, 0x55, 0x4b, 0xef, 0x67,
-	0x07, 0x56, 0xce, 0x68, 0x59, 0x98, 0xce, 0x2e, 0x27, 0xe7, 0x4d, 0x96, 0xd3, 0x3a, 0x54, 0x07,
-	0x5c, 0x79, 0x8f, 0xfb, 0xe6, 0x72, 0xae, 0xfb, 0xa7, 0x04, 0xb5, 0x3b, 0x43, 0x03, 0x48, 0xdd,
-	0x2f, 0x84, 0x1c, 0xfd, 0x17, 0x2a, 0x09, 0x8d, 0x87, 0x01, 0x7f, 0xd9, 0x28, 0xe9, 0x82, 0xd4,
-	0x74, 0x41, 0x0c, 0xee, 0xbe, 0x9b, 0xe8, 0x5f, 0x6f, 0x1b, 0x6e, 0x1c, 0x48, 0x4e, 0xf0, 0xc8,
-	0x16, 0xea, 0x23, 0x8e, 0x47, 0x64, 0x8f, 0x0d, 0x2f, 0xd9, 0xb2, 0xde, 0x4f, 0x0e, 0xfc, 0xfb,
-	0x75, 0x06, 0x6c, 0xc6, 0x0f, 0x61, 0x7e, 0x9c, 0x44, 0x34, 0x3e, 0x0a, 0x06, 0x8a, 0x67, 0x73,
-	0x5e, 0xd1, 0xd1, 0x1c, 0x6a, 0xc6, 0x44, 0xe7, 0xe3, 0x7f, 0xf9, 0xb5, 0xf1, 0x29, 0x05, 0x6d,
-	0xc3, 0x42, 0xc8, 0x5e, 0xc4, 0x19, 0x5d, 0x73, 0x91, 0x5f, 0xd1, 0xba, 0x5d, 0xcb, 0xca, 0x68,
-	0xd7, 0xc3, 0x2c, 0xed, 0x71, 0x05, 0xca, 0x5a, 0x6d, 0xeb, 0x37, 0x17, 0x16, 0x26, 0x9d, 0x48,
-	0xf8, 0x31, 0xed, 0x13, 0x74, 0x08, 0xae, 0x79, 0xc4, 0xa0, 0x6b, 0xda, 0x5a, 0xde, 0x8b, 0xa6,
-	0xb9, 0x36, 0x53, 0x98, 0xa7, 0xea, 0xf9, 0xeb, 0x35, 0xbe, 0xf9, 0xfd, 0xaf, 0x1f, 0x0a, 0xc8,
-	0xab, 0xeb, 0x37, 0xae, 0x45, 0x43, 0xbc, 0xef, 0xdc, 0x47, 0x3e, 0x14, 0x77, 0x88, 0x44, 0x6b,
-	0xa6, 0xf9, 0xa7, 0x5f, 0x39, 0xcd, 0xab, 0x33, 0x74, 0x03, 0x92, 0xd7, 0xd4, 0x16, 0x57, 0x11,
-	0x3a, 0x63, 0xb1, 0xf3, 0x35, 0x0d, 0x5f, 0xa1, 0x1e, 0xb8, 0x66, 0x3d, 0xdb, 0x50, 0xf3, 0x76,
-	0xf5, 0x6b, 0x43, 0xbd, 0xad, 0x0d, 0xdf, 0x6c, 0x36, 0xa7, 0x0c, 0x4f, 0xfe, 0x2a, 0xd0, 0xf0,
-	0x95, 0x8a, 0xfb, 0x73, 0x70, 0xcd, 0xdb, 0xc1, 0xfa, 0xc8, 0x7b, 0x48, 0xbc, 0xd6, 0x87, 0x0d,
-	0xfe, 0x7e, 0x5e, 0xf0, 0xfb, 0x50, 0x52, 0xf7, 0x0d, 0x32, 0x99, 0xcf, 0x3e, 0x3b, 0x9a, 0x8d,
-	0x59, 0x86, 0xc5, 0xe4, 0x8a, 0x36, 0xbb, 0x88, 0xce, 0xa2, 0x8c, 0x18, 0xcc, 0xed, 0x10, 0x69,
-	0x2e, 0x9a, 0xeb, 0x53, 0x78, 0x66, 0xb7, 0x6d, 0x73, 0x3d, 0x9f, 0x69, 0xad, 0x6f, 0x68, 0xeb,
-	0x1e, 0x6a, 0xe5, 0x03, 0x13, 0xd0, 0xf0, 0x55, 0x47, 0x68, 0x27, 0x0c, 0x6a, 0x99, 0x49, 0x46,
-	0x69, 0x0d, 0xa7, 0x36, 0x82, 0xcd, 0x24, 0x67, 0xe8, 0xbd, 0xff, 0x6b, 0x5f, 0x77, 0xd1, 0xed,
-	0x73, 0x7c, 0xa9, 0x81, 0x14, 0x1d, 0xf5, 0x9c, 0x43, 0xdf, 0x39, 0xb0, 0x68, 0x86, 0x2a, 0x9d,
-	0x26, 0xe4, 0x69, 0xe3, 0xe7, 0xce, 0x6a, 0xf3, 0xd6, 0xb9, 0x32, 0x36, 0x96, 0x7b, 0x3a, 0x96,
-	0x5b, 0xe8, 0x3f, 0xe7, 0xc4, 0xa2, 0xa7, 0x46, 0xbc, 0xe3, 0xf4, 0x5c, 0x5d, 0xe9, 0x07, 0x7f,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x8e, 0xbd, 0x9b, 0x5a, 0x0e, 0x00, 0x00,
+func init() { proto.RegisterFile("gateway.proto", fileDescriptor_gateway_5ba88ef1266f47d1) }
+
+var fileDescriptor_gateway_5ba88ef1266f47d1 = []byte{
+	// 1659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4b, 0x6f, 0xdb, 0xca,
+	0x15, 0x2e, 0xf5, 0xb2, 0x75, 0xe4, 0xe7, 0xd8, 0xb1, 0x65, 0xc6, 0xb1, 0x15, 0xa6, 0x89, 0x1d,
+	0xd7, 0x95, 0x0a, 0x05, 0x41, 0x1e, 0x2d, 0x5c, 0x38, 0x91, 0xeb, 0x1a, 0x31, 0x1a, 0x83, 0x8e,
+	0xd1, 0xee, 0x88, 0xb1, 0x38, 0x52, 0x08, 0x53, 0x1c, 0x76, 0x38, 0xf2, 0xa3, 0x85, 0x51, 0xa0,
+	0x40, 0xd1, 0x45, 0x17, 0x5d, 0xf4, 0x27, 0xb4, 0xe8, 0xaa, 0x8b, 0xfe, 0x81, 0xfb, 0x2b, 0xee,
+	0xea, 0xee, 0xef, 0x3f, 0xb8, 0x7f, 0xe0, 0x62, 0x1e, 0xa2, 0x68, 0x89, 0xb6, 0xe4, 0x20, 0x2b,
+	0xe9, 0xbc, 0xcf, 0xf9, 0xce, 0x99, 0x33, 0x43, 0x98, 0x6e, 0x63, 0x4e, 0x2e, 0xf0, 0x55, 0x35,
+	0x64, 0x94, 0x53, 0x94, 0xc5, 0xa1, 0x67, 0xae, 0xb6, 0x29, 0x6d, 0xfb, 0xa4, 0x86, 0x43, 0xaf,
+	0x86, 0x83, 0x80, 0x72, 0xcc, 0x3d, 0x1a, 0x44, 0x4a, 0xc5, 0x5c, 0xd7, 0x52, 0x49, 0x9d, 0x76,
+	0x5b, 0x35, 0xee, 0x75, 0x48, 0xc4, 0x71, 0x27, 0xd4, 0x0a, 0x0f, 0x07, 0x15, 0x48, 0x27, 0xe4,
+	0x3a, 0x80, 0xf9, 0xb2, 0xed, 0xf1, 0xcf, 0xdd, 0xd3, 0x6a, 0x93, 0x76, 0x6a, 0xa7, 0x8c, 0x36,
+	0x31, 0x66, 0x35, 0x9f, 0x32, 0x1c, 0x11, 0x76, 0x4e, 0x98, 0x0c, 0xd9, 0xa4, 0x9d, 0x0e, 0x0d,
+	0xf4, 0x8f, 0x36, 0x9b, 0x4a, 0x52, 0xd6, 0x37, 0x59, 0x98, 0xd8, 0x57, 0x79, 0xa3, 0x19, 0xc8,
+	0x78, 0x6e, 0xd9, 0xa8, 0x18, 0x9b, 0x45, 0x3b, 0xe3, 0xb9, 0x08, 0x41, 0x2e, 0xc0, 0x1d, 0x52,
+	0xce, 0x48, 0x8e, 0xfc, 0x8f, 0x2a, 0x50, 0x72, 0x49, 0xd4, 0x64, 0x5e, 0x28, 0x0a, 0x29, 0x67,
+	0xa5, 0x28, 0xc9, 0x42, 0xdb, 0x30, 0xe9, 0xd3, 0xa6, 0xac, 0xb3, 0x9c, 0xab, 0x18, 0x9b, 0xa5,
+	0xfa, 0x5c, 0x55, 0x87, 0x3c, 0xd4, 0x7c, 0x3b, 0xd6, 0x40, 0x1b, 0x30, 0x4b, 0x59, 0x1b, 0x07,
+	0xde, 0x9f, 0x24, 0xed, 0x78, 0x6e, 0x39, 0x5f, 0x31, 0x36, 0xb3, 0xf6, 0x4c, 0x92, 0x7d, 0xd0,
+	0x40, 0x3f, 0x83, 0x79, 0xd7, 0x8b, 0x9a, 0xf4, 0x9c, 0xb0, 0x2b, 0x87, 0x04, 0xf8, 0xd4, 0x27,
+	0x6e, 0xb9, 0x50, 0x31, 0x36, 0x27, 0xed, 0xb9, 0x58, 0xb0, 0xa7, 0xf8, 0x68, 0x0b, 0xe6, 0x03,
+	0xc2, 0x2f, 0x28, 0x3b, 0x73, 0x14, 0x1a, 0xc2, 0xef, 0x84, 0xf4, 0x3b, 0xab, 0x05, 0xc7, 0x92,
+	0x7f, 0xd0, 0x40, 0xdb, 0x80, 0x74, 0xe3, 0x9c, 0x90, 0xd1, 0x96, 0xe7, 0x13, 0xa1, 0x3c, 0x29,
+	0x0b, 0x9b, 0xd3, 0x92, 0x23, 0x25, 0x38, 0x68, 0xa0, 0xe7, 0x50, 0x38, 0xa5, 0x98, 0xb9, 0x51,
+	0xb9, 0x58, 0xc9, 0x6e, 0x96, 0xea, 0xf3, 0x55, 0x1c, 0x7a, 0x55, 0x8d, 0xe0, 0x3b, 0x21, 0xb1,
+	0xb5, 0x02, 0xda, 0x82, 0x1c, 0xc7, 0xed, 0xa8, 0x0c, 0x52, 0x71, 0x29, 0xa9, 0x58, 0xfd, 0x84,
+	0xdb, 0xd1, 0x5e, 0xc0, 0xd9, 0x95, 0x2d, 0x75, 0xcc, 0x57, 0x50, 0x8c, 0x59, 0x68, 0x0e, 0xb2,
+	0x67, 0xe4, 0x4a, 0x37, 0x42, 0xfc, 0x45, 0x8b, 0x90, 0x3f, 0xc7, 0x7e, 0xb7, 0xd7, 0x0a, 0x45,
+	0xbc, 0xcd, 0xbc, 0x36, 0xac, 0x13, 0x98, 0x4a, 0x06, 0x47, 0xcb, 0x30, 0xd1, 0x0a, 0xdb, 0xd8,
+	0x89, 0x1b, 0x59, 0x10, 0xa4, 0x2a, 0xb3, 0xe5, 0x05, 0xc4, 0x89, 0x47, 0xcc, 0x11, 0x31, 0x94,
+	0xbf, 0x39, 0x21, 0xf9, 0xd4, 0x13, 0x7c, 0x20, 0x57, 0xd6, 0x0e, 0x2c, 0xbe, 0x67, 0x04, 0x73,
+	0xa2, 0x9d, 0xdb, 0xe4, 0x8f, 0x5d, 0x12, 0x71, 0xf4, 0x0c, 0x26, 0x34, 0x24, 0xd2, 0x7d, 0xa9,
+	0x3e, 0x95, 0x2c, 0xcb, 0xee, 0x09, 0xad, 0x27, 0x30, 0xbf, 0x4f, 0xf8, 0x80, 0xf1, 0xc0, 0x7c,
+	0x59, 0xff, 0xcf, 0x00, 0x4a, 0x6a, 0x45, 0x21, 0x0d, 0x22, 0x32, 0x6e, 0x0c, 0xf4, 0x06, 0xa0,
+	0x29, 0x73, 0x74, 0x1d, 0xcc, 0x65, 0x25, 0xa5, 0xba, 0x59, 0x55, 0x27, 0xa6, 0xda, 0x3b, 0x31,
+	0xd5, 0xb8, 0x2c, 0xbb, 0xa8, 0xb5, 0x77, 0xb9, 0x30, 0xed, 0x86, 0x6e, 0xcf, 0x34, 0x3b, 0xda,
+	0x54, 0x6b, 0xef, 0x72, 0xb4, 0x03, 0xd3, 0x2d, 0x8f, 0x45, 0xdc, 0x89, 0x08, 0x09, 0x84, 0x75,
+	0x6e, 0xa4, 0x75, 0x49, 0x1a, 0x1c, 0x13, 0x12, 0xec, 0x72, 0xf4, 0x2b, 0x98, 0xf2, 0x71, 0xc2,
+	0x3c, 0x3f, 0xd2, 0x1c, 0x7c, 0xdc, 0xb3, 0xb6, 0x9e, 0xc1, 0x62, 0x83, 0xf8, 0x84, 0x93, 0x11,
+	0xd0, 0xfe, 0x60, 0x00, 0x3a, 0xf4, 0xa2, 0xc1, 0x0e, 0x2c, 0x42, 0xde, 0xf7, 0x3a, 0x1e, 0x97,
+	0x9a, 0x79, 0x5b, 0x11, 0x68, 0x09, 0x0a, 0xb4, 0xd5, 0x8a, 0x88, 0x02, 0x31, 0x6f, 0x6b, 0x2a,
+	0xed, 0x6c, 0x66, 0x53, 0xcf, 0xe6, 0x12, 0x14, 0x22, 0x82, 0x59, 0xf3, 0xb3, 0x04, 0xa3, 0x68,
+	0x6b, 0x0a, 0xbd, 0xd4, 0x27, 0x20, 0x2f, 0x4f, 0xc0, 0x63, 0xd9, 0xc6, 0xe1, 0xac, 0xbe, 0xde,
+	0x61, 0xf8, 0x67, 0x16, 0x66, 0xb5, 0x6f, 0x11, 0xe6, 0x80, 0x93, 0xce, 0x57, 0x5a, 0x6a, 0x37,
+	0x67, 0x2d, 0xf7, 0xe5, 0xb3, 0x96, 0xbf, 0xcf, 0xac, 0xa5, 0x34, 0xa0, 0x90, 0xda, 0x80, 0xfb,
+	0xec, 0xbb, 0xba, 0x6e, 0xca, 0xa4, 0x6c, 0xca, 0x5a, 0xf2, 0x6c, 0xf5, 0x40, 0xfb, 0x7a, 0x1d,
+	0x71, 0x61, 0xe1, 0x46, 0xc3, 0xf5, 0x11, 0x5f, 0x87, 0x12, 0xa7, 0x1c, 0xfb, 0x4e, 0x93, 0x76,
+	0x03, 0x35, 0x8d, 0x59, 0x1b, 0x24, 0xeb, 0xbd, 0xe0, 0xa0, 0x6d, 0x28, 0x30, 0x12, 0x75, 0x7d,
+	0x31, 0x92, 0x22, 0xcd, 0xc5, 0xb4, 0x34, 0x6d, 0xad, 0x23, 0xb6, 0xd5, 0x89, 0x04, 0xed, 0x0b,
+	0xb7, 0xd5, 0x3f, 0x32, 0xf1, 0x16, 0x3d, 0xe6, 0x98, 0x47, 0xe8, 0x35, 0x14, 0xe3, 0x3d, 0x59,
+	0x36, 0x46, 0xb7, 0x2c, 0x56, 0x46, 0x55, 0x58, 0x60, 0x97, 0x4e, 0x88, 0x9b, 0x67, 0x84, 0x47,
+	0x0e, 0x23, 0x4d, 0xe2, 0x9d, 0x13, 0x57, 0x1f, 0xac, 0x79, 0x76, 0x79, 0xa4, 0x24, 0xb6, 0x16,
+	0xa0, 0x17, 0xb0, 0x94, 0xa2, 0xef, 0xd0, 0x33, 0x39, 0x85, 0x79, 0x7b, 0x61, 0xc8, 0xe4, 0xe3,
+	0x07, 0x11, 0x84, 0xa7, 0x04, 0xc9, 0xa9, 0x20, 0x7c, 0x28, 0xc8, 0x36, 0xa0, 0x84, 0x3e, 0xe9,
+	0x78, 0x9c, 0x13, 0x75, 0xcf, 0xe6, 0xed, 0xb9, 0x58, 0x7d, 0x4f, 0xf1, 0xad, 0xef, 0x0c, 0x58,
+	0xea, 0xaf, 0x65, 0x09, 0x48, 0x0f, 0xd0, 0x47, 0x00, 0xbd, 0xbb, 0x32, 0x3e, 0x54, 0x45, 0xcd,
+	0x39, 0x68, 0x20, 0x13, 0x26, 0xbd, 0x80, 0x13, 0x76, 0x8e, 0x7d, 0x3d, 0x0a, 0x31, 0x8d, 0xde,
+	0xc3, 0x6c, 0xc4, 0x31, 0xe3, 0xfd, 0x0b, 0x68, 0x8c, 0xbd, 0x3b, 0x23, 0x4d, 0x62, 0x1a, 0xfd,
+	0x1a, 0xa6, 0x49, 0xe0, 0x26, 0x5c, 0x8c, 0x3e, 0x89, 0x53, 0x24, 0x70, 0x63, 0xca, 0x6a, 0xc0,
+	0xf2, 0x50, 0x69, 0x7a, 0x26, 0x9f, 0xc7, 0x23, 0x67, 0x0c, 0xdf, 0xec, 0x4a, 0xb5, 0x37, 0x6f,
+	0xff, 0x33, 0xa0, 0x70, 0xe4, 0x05, 0x6d, 0xfb, 0x0f, 0xa3, 0x10, 0x41, 0x90, 0x63, 0x51, 0xe4,
+	0xe9, 0xfe, 0xcb, 0xff, 0x68, 0x45, 0x3c, 0x90, 0x18, 0x76, 0xa2, 0x80, 0x49, 0x08, 0x0c, 0x7b,
+	0xc2, 0xa7, 0x36, 0x3e, 0xfe, 0x9d, 0x2d, 0x00, 0xf4, 0x31, 0xf7, 0x78, 0xd7, 0x25, 0xb2, 0x34,
+	0xc3, 0x8e, 0x69, 0xb4, 0x0a, 0x45, 0x9f, 0x06, 0x6d, 0x25, 0xcc, 0x4b, 0x61, 0x9f, 0x21, 0x2c,
+	0xb1, 0xaf, 0x2d, 0x0b, 0xca, 0xb2, 0x47, 0x5b, 0x2f, 0xe4, 0x35, 0x7b, 0x88, 0x23, 0x2e, 0x93,
+	0x1e, 0xab, 0x97, 0xd6, 0x7f, 0x0c, 0x58, 0xb8, 0x61, 0xa5, 0x61, 0xba, 0xb9, 0x09, 0x8d, 0xfb,
+	0x6c, 0xc2, 0x55, 0x28, 0xb6, 0x98, 0x88, 0x1e, 0x34, 0xd5, 0xcb, 0x63, 0xda, 0xee, 0x33, 0xc4,
+	0xa2, 0x76, 0x15, 0x20, 0xd3, 0x76, 0xc6, 0x65, 0xe8, 0xa7, 0x30, 0x11, 0x7a, 0x41, 0xdb, 0x61,
+	0x97, 0xe5, 0x9c, 0x6c, 0x48, 0x49, 0x36, 0x44, 0xe1, 0x6e, 0x17, 0x42, 0xf9, 0x6b, 0xed, 0xc0,
+	0xa3, 0x63, 0xce, 0x08, 0xee, 0xe8, 0x46, 0xfd, 0x86, 0xe1, 0x0e, 0x39, 0xa4, 0xed, 0x31, 0x47,
+	0xd6, 0xfa, 0xb7, 0x01, 0x6b, 0xb7, 0x39, 0xd0, 0x15, 0xbf, 0x86, 0xa9, 0x6e, 0xe8, 0x7b, 0xc1,
+	0x99, 0xd3, 0x12, 0x32, 0x5d, 0xf3, 0x82, 0xcc, 0xe6, 0x44, 0x0a, 0x7a, 0x36, 0xbf, 0xfd, 0x89,
+	0x5d, 0xea, 0xf6, 0x39, 0x68, 0x07, 0x66, 0x5c, 0x7a, 0x11, 0x24, 0x6c, 0xd5, 0x2b, 0xe5, 0x81,
+	0xb4, 0x6d, 0x68, 0x51, 0xc2, 0x7a, 0xda, 0x4d, 0xf2, 0xde, 0x4d, 0x40, 0x5e, 0x9a, 0x59, 0x1f,
+	0xa1, 0xac, 0xeb, 0xe9, 0xad, 0x40, 0x3a, 0x66, 0x1f, 0xd5, 0x95, 0x1f, 0x90, 0x48, 0x03, 0xae,
+	0x08, 0xab, 0x05, 0x2b, 0x29, 0x0e, 0x75, 0xc1, 0x83, 0x57, 0xe6, 0x1b, 0x00, 0x72, 0x19, 0x7a,
+	0x8c, 0x44, 0x63, 0x3e, 0xb4, 0xb4, 0xf6, 0x2e, 0xb7, 0x5e, 0xc1, 0x52, 0x62, 0xff, 0xdf, 0xa3,
+	0x2f, 0x7f, 0x33, 0x60, 0xbe, 0x6f, 0xb5, 0x77, 0xd9, 0x24, 0x2c, 0x94, 0x46, 0x4c, 0xd9, 0x27,
+	0x8c, 0x34, 0xe7, 0xa0, 0x81, 0x7e, 0x09, 0xa5, 0x78, 0x83, 0x8e, 0x95, 0x29, 0xf4, 0xd4, 0x77,
+	0x79, 0x1f, 0xa8, 0x6c, 0x25, 0x2b, 0x2e, 0x31, 0x05, 0xd4, 0x05, 0x2c, 0x0f, 0x15, 0xa0, 0x61,
+	0xda, 0x06, 0x14, 0x92, 0xc0, 0x95, 0x33, 0x3a, 0x98, 0xd4, 0x9c, 0x96, 0xd8, 0x71, 0x6e, 0xd5,
+	0x81, 0x1b, 0xed, 0xc6, 0xf7, 0x40, 0xbf, 0xc4, 0x78, 0xc7, 0xbc, 0x85, 0x95, 0xfe, 0xa6, 0x3a,
+	0xa4, 0xed, 0x4f, 0xf4, 0x8c, 0x04, 0x63, 0x82, 0x57, 0x07, 0x33, 0xcd, 0x56, 0xe7, 0xbd, 0x08,
+	0x79, 0x2e, 0x18, 0xda, 0x4e, 0x11, 0xf5, 0xff, 0x16, 0x61, 0xa6, 0xb7, 0xec, 0x08, 0x3b, 0xf7,
+	0x9a, 0x04, 0x9d, 0x40, 0x41, 0x7d, 0x04, 0xa0, 0x15, 0x99, 0x6c, 0xda, 0x17, 0x81, 0xb9, 0x34,
+	0x04, 0xef, 0x9e, 0xf8, 0x46, 0xb5, 0xca, 0x7f, 0xfd, 0xf6, 0xfb, 0x7f, 0x65, 0x90, 0x35, 0x2d,
+	0x3f, 0x44, 0x75, 0x6e, 0xd1, 0x5b, 0x63, 0x0b, 0xd9, 0x90, 0xdd, 0x27, 0x1c, 0x69, 0x00, 0x06,
+	0xbf, 0x12, 0xcc, 0xe5, 0x21, 0xbe, 0xca, 0xdb, 0x32, 0xa5, 0xc7, 0x45, 0x84, 0x6e, 0x78, 0xac,
+	0xfd, 0xd9, 0x73, 0xaf, 0xd1, 0x29, 0x14, 0xd4, 0x0b, 0x40, 0xa7, 0x9a, 0xf6, 0x1c, 0xb8, 0x35,
+	0xd5, 0xa7, 0xd2, 0xf1, 0xba, 0x69, 0x0e, 0x38, 0xd6, 0xff, 0xaa, 0x9e, 0x7b, 0x2d, 0xf2, 0xfe,
+	0x3d, 0x14, 0xd4, 0xdb, 0x5b, 0xc7, 0x48, 0x7b, 0x88, 0xdf, 0x1a, 0x43, 0x27, 0xbf, 0x95, 0x96,
+	0xfc, 0x11, 0xe4, 0xc4, 0x8c, 0xa1, 0xe5, 0x5b, 0x1e, 0xc8, 0x66, 0x79, 0x58, 0xa0, 0x31, 0x79,
+	0x20, 0xdd, 0xce, 0xa2, 0x9b, 0x28, 0x23, 0x0a, 0x93, 0xfb, 0x84, 0xab, 0xb7, 0xcc, 0xc3, 0x01,
+	0x3c, 0x93, 0x17, 0xba, 0xb9, 0x9a, 0x2e, 0xd4, 0xde, 0x37, 0xa5, 0x77, 0x0b, 0x55, 0xd2, 0x81,
+	0x71, 0x3c, 0xf7, 0xba, 0x16, 0xc9, 0x20, 0x14, 0x4a, 0x89, 0xcb, 0x02, 0xc5, 0x3d, 0x1c, 0xb8,
+	0x74, 0xcc, 0xf2, 0xb0, 0x40, 0xc7, 0xfa, 0xb9, 0x8c, 0xb5, 0x81, 0x9e, 0xde, 0x11, 0x4b, 0xec,
+	0xfc, 0xa8, 0x26, 0x3e, 0x87, 0xd0, 0xdf, 0x0d, 0x98, 0x55, 0x7b, 0x3b, 0x5e, 0xd8, 0xc8, 0x92,
+	0xce, 0xef, 0xbc, 0x0e, 0xcc, 0x27, 0x77, 0xea, 0xe8, 0x5c, 0x9e, 0xcb, 0x5c, 0x9e, 0xa0, 0xc7,
+	0x77, 0xe4, 0x22, 0x17, 0x73, 0xf4, 0x0b, 0x03, 0xfd, 0x05, 0x40, 0x3b, 0x3f, 0xa4, 0x6d, 0xf4,
+	0x48, 0xfa, 0xbf, 0x6d, 0x59, 0x9b, 0x6b, 0xb7, 0x89, 0x75, 0xe4, 0xba, 0x8c, 0xbc, 0x6d, 0x6d,
+	0xdc, 0x11, 0xd9, 0xa7, 0xed, 0xa8, 0xa6, 0x37, 0x8e, 0x98, 0xcb, 0x00, 0x26, 0xc5, 0x68, 0x48,
+	0x08, 0x1e, 0x0e, 0x4e, 0x4a, 0xb2, 0xf6, 0xd5, 0x74, 0xa1, 0x0e, 0xbd, 0x21, 0x43, 0x3f, 0x46,
+	0xeb, 0x23, 0x42, 0xa3, 0x6b, 0xd5, 0x6b, 0xbd, 0x56, 0xd0, 0xda, 0xc0, 0x08, 0x0d, 0xec, 0x2a,
+	0x73, 0xfd, 0x56, 0xf9, 0x3d, 0x3a, 0x2f, 0x6b, 0x96, 0x8b, 0xea, 0xb4, 0x20, 0x4f, 0xd6, 0x8b,
+	0x1f, 0x07, 0x00, 0xab, 0xda, 0xb5, 0x07, 0x6f, 0x13, 0x00, 0x00,
 }
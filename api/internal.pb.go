@@ -39,7 +39,7 @@ func (m *ProfileSettings) Reset()         { *m = ProfileSettings{} }
 func (m *ProfileSettings) String() string { return proto.CompactTextString(m) }
 func (*ProfileSettings) ProtoMessage()    {}
 func (*ProfileSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_47d8d8871dbbf124, []int{0}
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{0}
 }
 func (m *ProfileSettings) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileSettings.Unmarshal(m, b)
@@ -87,7 +87,7 @@ func (m *OrganizationLink) Reset()         { *m = OrganizationLink{} }
 func (m *OrganizationLink) String() string { return proto.CompactTextString(m) }
 func (*OrganizationLink) ProtoMessage()    {}
 func (*OrganizationLink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_47d8d8871dbbf124, []int{1}
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{1}
 }
 func (m *OrganizationLink) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrganizationLink.Unmarshal(m, b)
@@ -146,7 +146,11 @@ type LoginRequest struct {
 	// Username of the user.
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	// Password of the user.
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// ID of the terms version accepted by the user (optional).
+	// This must be set to the ID of the current terms version when the
+	// user has not yet accepted this version.
+	AcceptTermsVersionId int64    `protobuf:"varint,3,opt,name=accept_terms_version_id,json=acceptTermsVersionID,proto3" json:"accept_terms_version_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -156,7 +160,7 @@ func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
 func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
 func (*LoginRequest) ProtoMessage()    {}
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_47d8d8871dbbf124, []int{2}
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{2}
 }
 func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoginRequest.Unmarshal(m, b)
@@ -190,6 +194,13 @@ func (m *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (m *LoginRequest) GetAcceptTermsVersionId() int64 {
+	if m != nil {
+		return m.AcceptTermsVersionId
+	}
+	return 0
+}
+
 type LoginResponse struct {
 	// The JWT tag to be used to access lora-app-server interfaces.
 	Jwt                  string   `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
@@ -202,7 +213,7 @@ func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
 func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
 func (*LoginResponse) ProtoMessage()    {}
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_47d8d8871dbbf124, []int{3}
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{3}
 }
 func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoginResponse.Unmarshal(m, b)
@@ -245,7 +256,7 @@ func (m *ProfileResponse) Reset()         { *m = ProfileResponse{} }
 func (m *ProfileResponse) String() string { return proto.CompactTextString(m) }
 func (*ProfileResponse) ProtoMessage()    {}
 func (*ProfileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_47d8d8871dbbf124, []int{4}
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{4}
 }
 func (m *ProfileResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileResponse.Unmarshal(m, b)
@@ -302,7 +313,7 @@ func (m *GlobalSearchRequest) Reset()         { *m = GlobalSearchRequest{} }
 func (m *GlobalSearchRequest) String() string { return proto.CompactTextString(m) }
 func (*GlobalSearchRequest) ProtoMessage()    {}
 func (*GlobalSearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_47d8d8871dbbf124, []int{5}
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{5}
 }
 func (m *GlobalSearchRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GlobalSearchRequest.Unmarshal(m, b)
@@ -354,7 +365,7 @@ func (m *GlobalSearchResponse) Reset()         { *m = GlobalSearchResponse{} }
 func (m *GlobalSearchResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalSearchResponse) ProtoMessage()    {}
 func (*GlobalSearchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_47d8d8871dbbf124, []int{6}
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{6}
 }
 func (m *GlobalSearchResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GlobalSearchResponse.Unmarshal(m, b)
@@ -411,7 +422,7 @@ func (m *GlobalSearchResult) Reset()         { *m = GlobalSearchResult{} }
 func (m *GlobalSearchResult) String() string { return proto.CompactTextString(m) }
 func (*GlobalSearchResult) ProtoMessage()    {}
 func (*GlobalSearchResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_47d8d8871dbbf124, []int{7}
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{7}
 }
 func (m *GlobalSearchResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GlobalSearchResult.Unmarshal(m, b)
@@ -507,7 +518,9 @@ type BrandingResponse struct {
 	// Registration html.
 	Registration string `protobuf:"bytes,2,opt,name=registration,proto3" json:"registration,omitempty"`
 	// Footer html.
-	Footer               string   `protobuf:"bytes,3,opt,name=footer,proto3" json:"footer,omitempty"`
+	Footer string `protobuf:"bytes,3,opt,name=footer,proto3" json:"footer,omitempty"`
+	// Login banner html.
+	LoginBanner          string   `protobuf:"bytes,4,opt,name=login_banner,json=loginBanner,proto3" json:"login_banner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -517,7 +530,7 @@ func (m *BrandingResponse) Reset()         { *m = BrandingResponse{} }
 func (m *BrandingResponse) String() string { return proto.CompactTextString(m) }
 func (*BrandingResponse) ProtoMessage()    {}
 func (*BrandingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_47d8d8871dbbf124, []int{8}
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{8}
 }
 func (m *BrandingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BrandingResponse.Unmarshal(m, b)
@@ -558,6 +571,203 @@ func (m *BrandingResponse) GetFooter() string {
 	return ""
 }
 
+func (m *BrandingResponse) GetLoginBanner() string {
+	if m != nil {
+		return m.LoginBanner
+	}
+	return ""
+}
+
+type GetDeviceHistoryRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceHistoryRequest) Reset()         { *m = GetDeviceHistoryRequest{} }
+func (m *GetDeviceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceHistoryRequest) ProtoMessage()    {}
+func (*GetDeviceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{9}
+}
+func (m *GetDeviceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceHistoryRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceHistoryRequest.Merge(dst, src)
+}
+func (m *GetDeviceHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceHistoryRequest.Size(m)
+}
+func (m *GetDeviceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceHistoryRequest proto.InternalMessageInfo
+
+func (m *GetDeviceHistoryRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type GetDeviceHistoryResponse struct {
+	// Device-history items (newest first).
+	Result               []*DeviceHistoryItem `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetDeviceHistoryResponse) Reset()         { *m = GetDeviceHistoryResponse{} }
+func (m *GetDeviceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceHistoryResponse) ProtoMessage()    {}
+func (*GetDeviceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{10}
+}
+func (m *GetDeviceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceHistoryResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceHistoryResponse.Merge(dst, src)
+}
+func (m *GetDeviceHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceHistoryResponse.Size(m)
+}
+func (m *GetDeviceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceHistoryResponse proto.InternalMessageInfo
+
+func (m *GetDeviceHistoryResponse) GetResult() []*DeviceHistoryItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeviceHistoryItem struct {
+	// Organization id.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Organization name.
+	OrganizationName string `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	// Application id.
+	ApplicationId int64 `protobuf:"varint,3,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Application name.
+	ApplicationName string `protobuf:"bytes,4,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	// Device name.
+	DeviceName string `protobuf:"bytes,5,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Timestamp at which the device was added to the application.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp at which the device was removed from the application.
+	// This is not set when the device still exists.
+	DeletedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Last seen timestamp.
+	LastSeenAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// The device was activated (OTAA join or ABP activation).
+	Activated            bool     `protobuf:"varint,9,opt,name=activated,proto3" json:"activated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceHistoryItem) Reset()         { *m = DeviceHistoryItem{} }
+func (m *DeviceHistoryItem) String() string { return proto.CompactTextString(m) }
+func (*DeviceHistoryItem) ProtoMessage()    {}
+func (*DeviceHistoryItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_internal_be3ba8c7e9c371ec, []int{11}
+}
+func (m *DeviceHistoryItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceHistoryItem.Unmarshal(m, b)
+}
+func (m *DeviceHistoryItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceHistoryItem.Marshal(b, m, deterministic)
+}
+func (dst *DeviceHistoryItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceHistoryItem.Merge(dst, src)
+}
+func (m *DeviceHistoryItem) XXX_Size() int {
+	return xxx_messageInfo_DeviceHistoryItem.Size(m)
+}
+func (m *DeviceHistoryItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceHistoryItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceHistoryItem proto.InternalMessageInfo
+
+func (m *DeviceHistoryItem) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *DeviceHistoryItem) GetOrganizationName() string {
+	if m != nil {
+		return m.OrganizationName
+	}
+	return ""
+}
+
+func (m *DeviceHistoryItem) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *DeviceHistoryItem) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+func (m *DeviceHistoryItem) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *DeviceHistoryItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *DeviceHistoryItem) GetDeletedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
+func (m *DeviceHistoryItem) GetLastSeenAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return nil
+}
+
+func (m *DeviceHistoryItem) GetActivated() bool {
+	if m != nil {
+		return m.Activated
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ProfileSettings)(nil), "api.ProfileSettings")
 	proto.RegisterType((*OrganizationLink)(nil), "api.OrganizationLink")
@@ -568,6 +778,9 @@ func init() {
 	proto.RegisterType((*GlobalSearchResponse)(nil), "api.GlobalSearchResponse")
 	proto.RegisterType((*GlobalSearchResult)(nil), "api.GlobalSearchResult")
 	proto.RegisterType((*BrandingResponse)(nil), "api.BrandingResponse")
+	proto.RegisterType((*GetDeviceHistoryRequest)(nil), "api.GetDeviceHistoryRequest")
+	proto.RegisterType((*GetDeviceHistoryResponse)(nil), "api.GetDeviceHistoryResponse")
+	proto.RegisterType((*DeviceHistoryItem)(nil), "api.DeviceHistoryItem")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -590,6 +803,11 @@ type InternalServiceClient interface {
 	Branding(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BrandingResponse, error)
 	// Perform a global search.
 	GlobalSearch(ctx context.Context, in *GlobalSearchRequest, opts ...grpc.CallOption) (*GlobalSearchResponse, error)
+	// Get the device-history for the given DevEUI.
+	// This returns all the applications (and organizations) in which the
+	// DevEUI exists or existed, including its activation state and last
+	// activity. Only global admin users have access to this endpoint.
+	GetDeviceHistory(ctx context.Context, in *GetDeviceHistoryRequest, opts ...grpc.CallOption) (*GetDeviceHistoryResponse, error)
 }
 
 type internalServiceClient struct {
@@ -636,6 +854,15 @@ func (c *internalServiceClient) GlobalSearch(ctx context.Context, in *GlobalSear
 	return out, nil
 }
 
+func (c *internalServiceClient) GetDeviceHistory(ctx context.Context, in *GetDeviceHistoryRequest, opts ...grpc.CallOption) (*GetDeviceHistoryResponse, error) {
+	out := new(GetDeviceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/GetDeviceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InternalServiceServer is the server API for InternalService service.
 type InternalServiceServer interface {
 	// Log in a user
@@ -646,6 +873,11 @@ type InternalServiceServer interface {
 	Branding(context.Context, *empty.Empty) (*BrandingResponse, error)
 	// Perform a global search.
 	GlobalSearch(context.Context, *GlobalSearchRequest) (*GlobalSearchResponse, error)
+	// Get the device-history for the given DevEUI.
+	// This returns all the applications (and organizations) in which the
+	// DevEUI exists or existed, including its activation state and last
+	// activity. Only global admin users have access to this endpoint.
+	GetDeviceHistory(context.Context, *GetDeviceHistoryRequest) (*GetDeviceHistoryResponse, error)
 }
 
 func RegisterInternalServiceServer(s *grpc.Server, srv InternalServiceServer) {
@@ -724,6 +956,24 @@ func _InternalService_GlobalSearch_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InternalService_GetDeviceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).GetDeviceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/GetDeviceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).GetDeviceHistory(ctx, req.(*GetDeviceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _InternalService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.InternalService",
 	HandlerType: (*InternalServiceServer)(nil),
@@ -744,64 +994,82 @@ var _InternalService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalSearch",
 			Handler:    _InternalService_GlobalSearch_Handler,
 		},
+		{
+			MethodName: "GetDeviceHistory",
+			Handler:    _InternalService_GetDeviceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal.proto",
 }
 
-func init() { proto.RegisterFile("internal.proto", fileDescriptor_internal_47d8d8871dbbf124) }
-
-var fileDescriptor_internal_47d8d8871dbbf124 = []byte{
-	// 810 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x8e, 0xdb, 0x44,
-	0x14, 0x86, 0xe5, 0x38, 0xbb, 0x9b, 0x9c, 0x64, 0x77, 0xd3, 0x69, 0x9a, 0xba, 0xa1, 0xcb, 0xa6,
-	0x16, 0x88, 0x00, 0x52, 0x82, 0xc2, 0x15, 0x70, 0x15, 0xd8, 0xa5, 0x8a, 0x54, 0x0a, 0xf2, 0xb6,
-	0x48, 0x88, 0x0b, 0x6b, 0x12, 0x4f, 0xcc, 0x50, 0x7b, 0xc6, 0x78, 0x26, 0xbb, 0x94, 0x4b, 0x5e,
-	0x81, 0x07, 0xe0, 0xa1, 0x78, 0x05, 0x5e, 0x80, 0x2b, 0xc4, 0x1d, 0x9a, 0xe3, 0xb1, 0x65, 0xa7,
-	0xbb, 0x82, 0x3b, 0x9f, 0x33, 0xdf, 0xfc, 0x3e, 0xf3, 0xcf, 0x39, 0x03, 0x27, 0x5c, 0x68, 0x96,
-	0x0b, 0x9a, 0xcc, 0xb2, 0x5c, 0x6a, 0x49, 0x5c, 0x9a, 0xf1, 0xf1, 0xe3, 0x58, 0xca, 0x38, 0x61,
-	0x73, 0x9a, 0xf1, 0x39, 0x15, 0x42, 0x6a, 0xaa, 0xb9, 0x14, 0xaa, 0x40, 0xc6, 0xe7, 0x76, 0x15,
-	0xa3, 0xf5, 0x6e, 0x3b, 0xd7, 0x3c, 0x65, 0x4a, 0xd3, 0x34, 0xb3, 0xc0, 0x5b, 0xfb, 0x00, 0x4b,
-	0x33, 0xfd, 0xda, 0x2e, 0xc2, 0x4e, 0xb1, 0xbc, 0xf8, 0xf6, 0x5f, 0xc0, 0xe9, 0x37, 0xb9, 0xdc,
-	0xf2, 0x84, 0x5d, 0x31, 0xad, 0xb9, 0x88, 0x15, 0x59, 0xc2, 0x59, 0xc4, 0x15, 0x5d, 0x27, 0x2c,
-	0xa4, 0x4a, 0xf1, 0x58, 0x84, 0xec, 0x67, 0xae, 0xcc, 0x5a, 0x68, 0x36, 0x2a, 0xcf, 0x99, 0x38,
-	0xd3, 0x4e, 0x30, 0xb6, 0xd0, 0x12, 0x99, 0x4b, 0x8b, 0xbc, 0x34, 0x84, 0xff, 0x8f, 0x03, 0x83,
-	0xaf, 0xf3, 0x98, 0x0a, 0xfe, 0x0b, 0xd6, 0xfd, 0x8c, 0x8b, 0x57, 0xe4, 0x3d, 0x38, 0x95, 0xb5,
-	0x5c, 0xc8, 0x23, 0x54, 0x72, 0x83, 0x93, 0x7a, 0x7a, 0x75, 0x41, 0x3e, 0x84, 0x7b, 0x0d, 0x50,
-	0xd0, 0x94, 0x79, 0xad, 0x89, 0x33, 0xed, 0x06, 0x83, 0xfa, 0xc2, 0x73, 0x9a, 0x32, 0xf2, 0x08,
-	0x3a, 0x5c, 0x85, 0x34, 0x4a, 0xb9, 0xf0, 0x5c, 0x2c, 0xec, 0x88, 0xab, 0xa5, 0x09, 0xc9, 0x27,
-	0x00, 0x9b, 0x9c, 0x51, 0xcd, 0xa2, 0x90, 0x6a, 0xaf, 0x3d, 0x71, 0xa6, 0xbd, 0xc5, 0x78, 0x56,
-	0x38, 0x33, 0x2b, 0x9d, 0x99, 0xbd, 0x28, 0xad, 0x0b, 0xba, 0x96, 0x5e, 0x6a, 0xb3, 0x75, 0x97,
-	0x45, 0xe5, 0xd6, 0x83, 0xff, 0xde, 0x6a, 0xe9, 0xa5, 0xf6, 0xbf, 0x84, 0xfe, 0x33, 0x19, 0x73,
-	0x11, 0xb0, 0x9f, 0x76, 0x4c, 0x69, 0x32, 0x86, 0x8e, 0xb1, 0x0d, 0x0f, 0xe1, 0xe0, 0x21, 0xaa,
-	0xd8, 0xac, 0x65, 0x54, 0xa9, 0x1b, 0x99, 0x47, 0xf6, 0x80, 0x55, 0xec, 0x3f, 0x81, 0x63, 0xab,
-	0xa3, 0x32, 0x29, 0x14, 0x23, 0x03, 0x70, 0x7f, 0xbc, 0xd1, 0x56, 0xc3, 0x7c, 0xfa, 0xbf, 0x3b,
-	0xd5, 0xed, 0x55, 0xd4, 0x19, 0xb4, 0x8d, 0x3c, 0x62, 0xbd, 0x45, 0x77, 0x46, 0x33, 0x3e, 0x33,
-	0x97, 0x12, 0x60, 0x9a, 0x7c, 0x06, 0xc7, 0x75, 0x0b, 0x95, 0xe7, 0x4e, 0xdc, 0x69, 0x6f, 0xf1,
-	0x00, 0xb9, 0xfd, 0x2b, 0x0b, 0x9a, 0x2c, 0xf9, 0x08, 0x3a, 0xca, 0x76, 0x89, 0xb5, 0x73, 0x88,
-	0xfb, 0xf6, 0x3a, 0x28, 0xa8, 0x28, 0xff, 0x7b, 0xb8, 0xff, 0x34, 0x91, 0x6b, 0x9a, 0x5c, 0x31,
-	0x9a, 0x6f, 0x7e, 0x28, 0x3d, 0x19, 0xc1, 0xa1, 0xc2, 0x84, 0x3d, 0x8d, 0x8d, 0xc8, 0x10, 0x0e,
-	0x12, 0x9e, 0x72, 0x8d, 0x66, 0xb8, 0x41, 0x11, 0x18, 0x5a, 0x6e, 0xb7, 0x8a, 0x69, 0xbc, 0x60,
-	0x37, 0xb0, 0x91, 0xff, 0x14, 0x86, 0x4d, 0x71, 0x6b, 0xc1, 0x1c, 0x0e, 0x73, 0xa6, 0x76, 0x89,
-	0xf1, 0xca, 0x1c, 0xee, 0x21, 0x16, 0xb9, 0x87, 0xee, 0x12, 0x1d, 0x58, 0xcc, 0xff, 0xab, 0x05,
-	0xe4, 0xcd, 0x65, 0x42, 0xa0, 0xfd, 0x8a, 0x8b, 0xc8, 0xd6, 0x88, 0xdf, 0xa6, 0x42, 0xb5, 0x91,
-	0x79, 0xd1, 0x8f, 0xad, 0xa0, 0x08, 0x6e, 0x6b, 0x6d, 0xf7, 0xff, 0xb7, 0x76, 0xfb, 0x8e, 0xd6,
-	0x7e, 0x17, 0x4e, 0x68, 0x96, 0x25, 0x7c, 0x53, 0x89, 0x1e, 0xa0, 0xe8, 0x71, 0x2d, 0xbb, 0xba,
-	0x20, 0xef, 0xc3, 0xa0, 0x8e, 0xa1, 0xe4, 0x21, 0x4a, 0x9e, 0xd6, 0xf2, 0xa8, 0xf8, 0x0e, 0x9c,
-	0x44, 0xec, 0x9a, 0x6f, 0x58, 0x18, 0xb1, 0xeb, 0x90, 0xed, 0xb8, 0x77, 0x84, 0x60, 0xbf, 0xc8,
-	0x5e, 0xb0, 0xeb, 0xcb, 0x97, 0x2b, 0x72, 0x0e, 0x3d, 0x4b, 0xa1, 0x56, 0x07, 0x11, 0x28, 0x52,
-	0x28, 0x73, 0x0e, 0xbd, 0x98, 0x6a, 0x76, 0x43, 0x5f, 0x87, 0x29, 0xdd, 0x78, 0xdd, 0x02, 0xb0,
-	0xa9, 0xaf, 0x96, 0x5f, 0x90, 0x27, 0xd0, 0x2f, 0x01, 0x94, 0x00, 0x24, 0xca, 0x4d, 0x46, 0xc3,
-	0x5f, 0xc3, 0xe0, 0xf3, 0x9c, 0x8a, 0x88, 0x8b, 0xb8, 0xba, 0x38, 0x02, 0xed, 0x44, 0xc6, 0xb2,
-	0x34, 0xdc, 0x7c, 0x13, 0x1f, 0xfa, 0x39, 0x8b, 0xb9, 0xd2, 0x39, 0x1e, 0xc3, 0x8e, 0x49, 0x23,
-	0x67, 0x1a, 0x64, 0x2b, 0xa5, 0x66, 0x39, 0xba, 0xde, 0x0d, 0x6c, 0xb4, 0xf8, 0xbb, 0x05, 0xa7,
-	0x2b, 0xfb, 0xb8, 0x5e, 0xb1, 0xdc, 0xd4, 0x4f, 0x9e, 0xc3, 0x01, 0x8e, 0x15, 0xb9, 0x87, 0x5d,
-	0x51, 0x1f, 0xd5, 0x31, 0xa9, 0xa7, 0x8a, 0x9a, 0xfc, 0xb7, 0x7f, 0xfd, 0xe3, 0xcf, 0xdf, 0x5a,
-	0x9e, 0x7f, 0x1f, 0x9f, 0xe2, 0xf2, 0xa9, 0x9e, 0x27, 0x06, 0xfa, 0xd4, 0xf9, 0x80, 0x7c, 0x0b,
-	0x47, 0xb6, 0xfd, 0xc9, 0xe8, 0x8d, 0x07, 0xe2, 0xd2, 0xbc, 0xba, 0xe3, 0xc6, 0x90, 0x54, 0xc2,
-	0x67, 0x28, 0xfc, 0x90, 0x3c, 0x68, 0x0a, 0x67, 0x56, 0xec, 0x3b, 0xe8, 0x94, 0xfe, 0xdc, 0x29,
-	0x5c, 0x4c, 0xed, 0xbe, 0x8d, 0x65, 0xc9, 0x64, 0xd4, 0x54, 0x5e, 0x97, 0x72, 0x14, 0xfa, 0xf5,
-	0x6e, 0x27, 0xde, 0x2d, 0xf3, 0x51, 0x18, 0xf2, 0xe8, 0xb6, 0xc9, 0x29, 0x7e, 0xf2, 0x18, 0x7f,
-	0x32, 0x22, 0xc3, 0xe6, 0x4f, 0x8a, 0x41, 0x5e, 0x1f, 0x62, 0xa5, 0x1f, 0xff, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x59, 0x32, 0x94, 0x5a, 0xdc, 0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("internal.proto", fileDescriptor_internal_be3ba8c7e9c371ec) }
+
+var fileDescriptor_internal_be3ba8c7e9c371ec = []byte{
+	// 1027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0x96, 0x33, 0x4e, 0x62, 0x97, 0x9d, 0xc4, 0xe9, 0xcd, 0x26, 0x5e, 0x93, 0x90, 0xec, 0x08,
+	0xb4, 0x59, 0x90, 0x6c, 0x14, 0xc4, 0x81, 0x9f, 0x8b, 0x97, 0x44, 0xc1, 0x68, 0x59, 0xd0, 0x24,
+	0xbb, 0x12, 0xe2, 0x30, 0x6a, 0x7b, 0x2a, 0xde, 0x66, 0x67, 0x7a, 0x86, 0xe9, 0x76, 0x42, 0x40,
+	0x2b, 0x21, 0x24, 0x9e, 0x80, 0x07, 0xe0, 0x0d, 0x78, 0x19, 0x5e, 0x81, 0x17, 0xe0, 0x08, 0x27,
+	0xd4, 0x35, 0x3d, 0x66, 0xc6, 0x76, 0x94, 0xe5, 0xb0, 0xb7, 0xe9, 0xaa, 0xaf, 0xbe, 0xae, 0xfa,
+	0xba, 0xaa, 0x7b, 0x60, 0x5d, 0x48, 0x8d, 0xa9, 0xe4, 0x61, 0x37, 0x49, 0x63, 0x1d, 0x33, 0x87,
+	0x27, 0xa2, 0xb3, 0x3b, 0x8e, 0xe3, 0x71, 0x88, 0x3d, 0x9e, 0x88, 0x1e, 0x97, 0x32, 0xd6, 0x5c,
+	0x8b, 0x58, 0xaa, 0x0c, 0xd2, 0xd9, 0xb7, 0x5e, 0x5a, 0x0d, 0x27, 0x17, 0x3d, 0x2d, 0x22, 0x54,
+	0x9a, 0x47, 0x89, 0x05, 0xbc, 0x31, 0x0b, 0xc0, 0x28, 0xd1, 0xd7, 0xd6, 0x09, 0x13, 0x85, 0x69,
+	0xf6, 0xed, 0x9e, 0xc3, 0xc6, 0x57, 0x69, 0x7c, 0x21, 0x42, 0x3c, 0x43, 0xad, 0x85, 0x1c, 0x2b,
+	0xd6, 0x87, 0xbd, 0x40, 0x28, 0x3e, 0x0c, 0xd1, 0xe7, 0x4a, 0x89, 0xb1, 0xf4, 0xf1, 0x7b, 0xa1,
+	0x8c, 0xcf, 0x37, 0x81, 0xaa, 0x5d, 0x39, 0xa8, 0x1c, 0xd6, 0xbc, 0x8e, 0x05, 0xf5, 0x09, 0x73,
+	0x62, 0x21, 0x4f, 0x0d, 0xc2, 0xfd, 0xbb, 0x02, 0xad, 0x2f, 0xd3, 0x31, 0x97, 0xe2, 0x07, 0xca,
+	0xfb, 0xb1, 0x90, 0x2f, 0xd8, 0x03, 0xd8, 0x88, 0x0b, 0x36, 0x5f, 0x04, 0xc4, 0xe4, 0x78, 0xeb,
+	0x45, 0xf3, 0xe0, 0x98, 0xbd, 0x0b, 0x9b, 0x25, 0xa0, 0xe4, 0x11, 0xb6, 0x97, 0x0e, 0x2a, 0x87,
+	0x75, 0xaf, 0x55, 0x74, 0x3c, 0xe1, 0x11, 0xb2, 0x7b, 0x50, 0x13, 0xca, 0xe7, 0x41, 0x24, 0x64,
+	0xdb, 0xa1, 0xc4, 0x56, 0x85, 0xea, 0x9b, 0x25, 0xfb, 0x10, 0x60, 0x94, 0x22, 0xd7, 0x18, 0xf8,
+	0x5c, 0xb7, 0xab, 0x07, 0x95, 0xc3, 0xc6, 0x51, 0xa7, 0x9b, 0x29, 0xd3, 0xcd, 0x95, 0xe9, 0x9e,
+	0xe7, 0xd2, 0x79, 0x75, 0x8b, 0xee, 0x6b, 0x13, 0x3a, 0x49, 0x82, 0x3c, 0x74, 0xf9, 0xf6, 0x50,
+	0x8b, 0xee, 0x6b, 0xf7, 0x25, 0x34, 0x1f, 0xc7, 0x63, 0x21, 0x3d, 0xfc, 0x6e, 0x82, 0x4a, 0xb3,
+	0x0e, 0xd4, 0x8c, 0x6c, 0x54, 0x44, 0x85, 0x8a, 0x98, 0xae, 0x8d, 0x2f, 0xe1, 0x4a, 0x5d, 0xc5,
+	0x69, 0x60, 0x0b, 0x9c, 0xae, 0xd9, 0x07, 0xb0, 0xc3, 0x47, 0x23, 0x4c, 0xb4, 0xaf, 0x31, 0x8d,
+	0x94, 0x7f, 0x89, 0xa9, 0xb2, 0xb2, 0x39, 0x24, 0xdb, 0x56, 0xe6, 0x3e, 0x37, 0xde, 0x67, 0x99,
+	0x73, 0x70, 0xec, 0xde, 0x87, 0x35, 0xbb, 0xbd, 0x4a, 0x62, 0xa9, 0x90, 0xb5, 0xc0, 0xf9, 0xf6,
+	0x4a, 0xdb, 0xad, 0xcd, 0xa7, 0xfb, 0x5b, 0x65, 0x7a, 0xe8, 0x53, 0xd4, 0x1e, 0x54, 0x4d, 0x56,
+	0x04, 0x6b, 0x1c, 0xd5, 0xbb, 0x3c, 0x11, 0x5d, 0x73, 0x96, 0x1e, 0x99, 0xd9, 0xc7, 0xb0, 0x56,
+	0x54, 0x5e, 0xb5, 0x9d, 0x03, 0xe7, 0xb0, 0x71, 0x74, 0x97, 0x70, 0xb3, 0x27, 0xed, 0x95, 0xb1,
+	0xec, 0x3d, 0xa8, 0x29, 0xdb, 0x5c, 0xf6, 0x14, 0xb6, 0x28, 0x6e, 0xa6, 0xf1, 0xbc, 0x29, 0xca,
+	0xfd, 0x06, 0xee, 0x9c, 0x86, 0xf1, 0x90, 0x87, 0x67, 0xc8, 0xd3, 0xd1, 0xf3, 0x5c, 0xca, 0x6d,
+	0x58, 0x51, 0x64, 0xb0, 0xd5, 0xd8, 0x15, 0xdb, 0x82, 0xe5, 0x50, 0x44, 0x42, 0x93, 0x86, 0x8e,
+	0x97, 0x2d, 0x0c, 0x3a, 0xbe, 0xb8, 0x50, 0xa8, 0xad, 0x5e, 0x76, 0xe5, 0x9e, 0xc2, 0x56, 0x99,
+	0xdc, 0x4a, 0xd0, 0x83, 0x95, 0x14, 0xd5, 0x24, 0x34, 0x5a, 0x99, 0xe2, 0x76, 0x28, 0xc9, 0x19,
+	0xe8, 0x24, 0xd4, 0x9e, 0x85, 0xb9, 0x7f, 0x2d, 0x01, 0x9b, 0x77, 0x33, 0x06, 0xd5, 0x17, 0x42,
+	0x06, 0x36, 0x47, 0xfa, 0x36, 0x19, 0xaa, 0x51, 0x9c, 0x66, 0x6d, 0xbc, 0xe4, 0x65, 0x8b, 0x45,
+	0x13, 0xe1, 0xbc, 0xfa, 0x44, 0x54, 0x6f, 0x98, 0x88, 0xb7, 0x61, 0x9d, 0x27, 0x49, 0x28, 0x46,
+	0x53, 0xd2, 0x65, 0x22, 0x5d, 0x2b, 0x58, 0x07, 0xc7, 0xec, 0x21, 0xb4, 0x8a, 0x30, 0xa2, 0x5c,
+	0x21, 0xca, 0x8d, 0x82, 0x9d, 0x18, 0xdf, 0x82, 0xf5, 0x00, 0x2f, 0xc5, 0x08, 0xfd, 0x00, 0x2f,
+	0x7d, 0x9c, 0x88, 0xf6, 0x2a, 0x01, 0x9b, 0x99, 0xf5, 0x18, 0x2f, 0x4f, 0x9e, 0x0e, 0xd8, 0x3e,
+	0x34, 0x2c, 0x8a, 0xb8, 0x6a, 0x04, 0x81, 0xcc, 0x44, 0x34, 0xfb, 0xd0, 0x18, 0x73, 0x8d, 0x57,
+	0xfc, 0xda, 0x8f, 0xf8, 0xa8, 0x5d, 0xcf, 0x00, 0xd6, 0xf4, 0x45, 0xff, 0x53, 0x76, 0x1f, 0x9a,
+	0x39, 0x80, 0x28, 0x80, 0x10, 0x79, 0x90, 0xe1, 0x70, 0x7f, 0xa9, 0x40, 0xeb, 0x51, 0xca, 0x65,
+	0x20, 0xe4, 0x78, 0x7a, 0x72, 0x0c, 0xaa, 0x61, 0x3c, 0x8e, 0x73, 0xc5, 0xcd, 0x37, 0x73, 0xa1,
+	0x99, 0xe2, 0x58, 0x28, 0x9d, 0x52, 0x1d, 0x76, 0xbc, 0x4a, 0x36, 0xd3, 0x21, 0x17, 0x71, 0xac,
+	0x31, 0x25, 0xd9, 0xeb, 0x9e, 0x5d, 0x99, 0x3c, 0x42, 0x33, 0x43, 0xfe, 0x90, 0x4b, 0x89, 0xa9,
+	0x55, 0xba, 0x41, 0xb6, 0x47, 0x64, 0x72, 0x8f, 0x60, 0xe7, 0x14, 0xf5, 0x31, 0x15, 0xf7, 0x99,
+	0x50, 0x3a, 0x4e, 0xaf, 0xf3, 0x2e, 0xdd, 0x81, 0xd5, 0x5c, 0x26, 0xdb, 0xa6, 0x01, 0x09, 0xe4,
+	0x7e, 0x0e, 0xed, 0xf9, 0x18, 0x5b, 0x42, 0x77, 0xa6, 0xf9, 0xb6, 0xa9, 0xf9, 0x4a, 0xd8, 0x81,
+	0xc6, 0x68, 0xda, 0x7b, 0xbf, 0x3b, 0xb0, 0x39, 0xe7, 0x7d, 0x4d, 0x57, 0xec, 0x7c, 0x43, 0x39,
+	0xaf, 0xda, 0x50, 0xd5, 0xc5, 0x0d, 0x35, 0xd3, 0x2a, 0xcb, 0x73, 0xad, 0x52, 0xbe, 0xba, 0x57,
+	0xfe, 0xe7, 0xd5, 0x1d, 0x60, 0x88, 0x36, 0x74, 0xf5, 0xf6, 0x50, 0x8b, 0xee, 0x6b, 0xf6, 0x09,
+	0x34, 0x43, 0xae, 0xb4, 0xaf, 0x10, 0xa5, 0x09, 0xae, 0xdd, 0x1a, 0x0c, 0x06, 0x7f, 0x86, 0x28,
+	0xfb, 0x9a, 0xed, 0x42, 0x9d, 0x8f, 0xb4, 0xb8, 0x34, 0x79, 0x50, 0x73, 0xd7, 0xbc, 0xff, 0x0c,
+	0x47, 0xff, 0x38, 0xb0, 0x31, 0xb0, 0x0f, 0xfd, 0x19, 0xa6, 0xa6, 0x52, 0xf6, 0x04, 0x96, 0xe9,
+	0xae, 0x66, 0x9b, 0x74, 0xda, 0xc5, 0x67, 0xa3, 0xc3, 0x8a, 0xa6, 0xac, 0x49, 0xdc, 0x37, 0x7f,
+	0xfe, 0xe3, 0xcf, 0x5f, 0x97, 0xda, 0xee, 0x1d, 0xfa, 0x2d, 0xc8, 0x7f, 0x1b, 0x7a, 0xd4, 0x97,
+	0x1f, 0x55, 0xde, 0x61, 0xcf, 0x60, 0xd5, 0xde, 0xa9, 0x6c, 0x7b, 0x2e, 0xe9, 0x13, 0xf3, 0x07,
+	0xd0, 0x29, 0xdd, 0xbc, 0x53, 0xe2, 0x3d, 0x22, 0xde, 0x61, 0x77, 0xcb, 0xc4, 0x89, 0x25, 0xfb,
+	0x1a, 0x6a, 0xf9, 0xcc, 0xdd, 0x48, 0x9c, 0x3d, 0x05, 0xb3, 0xa3, 0x99, 0xa7, 0xcc, 0xb6, 0xcb,
+	0xcc, 0xc3, 0x9c, 0x8e, 0x43, 0xb3, 0x78, 0x85, 0xb2, 0xf6, 0x82, 0x4b, 0x37, 0x13, 0xe4, 0xde,
+	0x02, 0x8f, 0xdd, 0x64, 0x97, 0x36, 0xd9, 0x66, 0x5b, 0xe5, 0x4d, 0xec, 0xeb, 0xf0, 0x53, 0x05,
+	0x5a, 0xb3, 0x73, 0xc7, 0x76, 0x33, 0xb6, 0xc5, 0x23, 0xdc, 0xd9, 0xbb, 0xc1, 0x6b, 0xf7, 0xeb,
+	0xd1, 0x7e, 0x0f, 0xd9, 0x83, 0xf2, 0x7e, 0x59, 0xff, 0xaa, 0xde, 0x8f, 0x76, 0xfc, 0x5f, 0xf6,
+	0x9e, 0x67, 0x81, 0xc3, 0x15, 0x12, 0xeb, 0xfd, 0x7f, 0x07, 0x00, 0x3b, 0xab, 0x92, 0x5e, 0xeb,
+	0x09, 0x00, 0x00,
 }
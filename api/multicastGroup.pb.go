@@ -48,7 +48,7 @@ func (x MulticastGroupType) String() string {
 	return proto.EnumName(MulticastGroupType_name, int32(x))
 }
 func (MulticastGroupType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{0}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{0}
 }
 
 type MulticastGroup struct {
@@ -86,7 +86,7 @@ func (m *MulticastGroup) Reset()         { *m = MulticastGroup{} }
 func (m *MulticastGroup) String() string { return proto.CompactTextString(m) }
 func (*MulticastGroup) ProtoMessage()    {}
 func (*MulticastGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{0}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{0}
 }
 func (m *MulticastGroup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MulticastGroup.Unmarshal(m, b)
@@ -201,7 +201,7 @@ func (m *MulticastGroupListItem) Reset()         { *m = MulticastGroupListItem{}
 func (m *MulticastGroupListItem) String() string { return proto.CompactTextString(m) }
 func (*MulticastGroupListItem) ProtoMessage()    {}
 func (*MulticastGroupListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{1}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{1}
 }
 func (m *MulticastGroupListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MulticastGroupListItem.Unmarshal(m, b)
@@ -261,7 +261,7 @@ func (m *CreateMulticastGroupRequest) Reset()         { *m = CreateMulticastGrou
 func (m *CreateMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateMulticastGroupRequest) ProtoMessage()    {}
 func (*CreateMulticastGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{2}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{2}
 }
 func (m *CreateMulticastGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMulticastGroupRequest.Unmarshal(m, b)
@@ -300,7 +300,7 @@ func (m *CreateMulticastGroupResponse) Reset()         { *m = CreateMulticastGro
 func (m *CreateMulticastGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateMulticastGroupResponse) ProtoMessage()    {}
 func (*CreateMulticastGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{3}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{3}
 }
 func (m *CreateMulticastGroupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMulticastGroupResponse.Unmarshal(m, b)
@@ -339,7 +339,7 @@ func (m *GetMulticastGroupRequest) Reset()         { *m = GetMulticastGroupReque
 func (m *GetMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMulticastGroupRequest) ProtoMessage()    {}
 func (*GetMulticastGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{4}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{4}
 }
 func (m *GetMulticastGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMulticastGroupRequest.Unmarshal(m, b)
@@ -382,7 +382,7 @@ func (m *GetMulticastGroupResponse) Reset()         { *m = GetMulticastGroupResp
 func (m *GetMulticastGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMulticastGroupResponse) ProtoMessage()    {}
 func (*GetMulticastGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{5}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{5}
 }
 func (m *GetMulticastGroupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMulticastGroupResponse.Unmarshal(m, b)
@@ -435,7 +435,7 @@ func (m *UpdateMulticastGroupRequest) Reset()         { *m = UpdateMulticastGrou
 func (m *UpdateMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateMulticastGroupRequest) ProtoMessage()    {}
 func (*UpdateMulticastGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{6}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{6}
 }
 func (m *UpdateMulticastGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateMulticastGroupRequest.Unmarshal(m, b)
@@ -474,7 +474,7 @@ func (m *DeleteMulticastGroupRequest) Reset()         { *m = DeleteMulticastGrou
 func (m *DeleteMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteMulticastGroupRequest) ProtoMessage()    {}
 func (*DeleteMulticastGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{7}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{7}
 }
 func (m *DeleteMulticastGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteMulticastGroupRequest.Unmarshal(m, b)
@@ -517,7 +517,7 @@ func (m *AddDeviceToMulticastGroupRequest) Reset()         { *m = AddDeviceToMul
 func (m *AddDeviceToMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*AddDeviceToMulticastGroupRequest) ProtoMessage()    {}
 func (*AddDeviceToMulticastGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{8}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{8}
 }
 func (m *AddDeviceToMulticastGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddDeviceToMulticastGroupRequest.Unmarshal(m, b)
@@ -565,7 +565,7 @@ func (m *RemoveDeviceFromMulticastGroupRequest) Reset()         { *m = RemoveDev
 func (m *RemoveDeviceFromMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveDeviceFromMulticastGroupRequest) ProtoMessage()    {}
 func (*RemoveDeviceFromMulticastGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{9}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{9}
 }
 func (m *RemoveDeviceFromMulticastGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveDeviceFromMulticastGroupRequest.Unmarshal(m, b)
@@ -621,7 +621,7 @@ func (m *ListMulticastGroupRequest) Reset()         { *m = ListMulticastGroupReq
 func (m *ListMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*ListMulticastGroupRequest) ProtoMessage()    {}
 func (*ListMulticastGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{10}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{10}
 }
 func (m *ListMulticastGroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListMulticastGroupRequest.Unmarshal(m, b)
@@ -696,7 +696,7 @@ func (m *ListMulticastGroupResponse) Reset()         { *m = ListMulticastGroupRe
 func (m *ListMulticastGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*ListMulticastGroupResponse) ProtoMessage()    {}
 func (*ListMulticastGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{11}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{11}
 }
 func (m *ListMulticastGroupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListMulticastGroupResponse.Unmarshal(m, b)
@@ -749,7 +749,7 @@ func (m *MulticastQueueItem) Reset()         { *m = MulticastQueueItem{} }
 func (m *MulticastQueueItem) String() string { return proto.CompactTextString(m) }
 func (*MulticastQueueItem) ProtoMessage()    {}
 func (*MulticastQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{12}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{12}
 }
 func (m *MulticastQueueItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MulticastQueueItem.Unmarshal(m, b)
@@ -809,7 +809,7 @@ func (m *EnqueueMulticastQueueItemRequest) Reset()         { *m = EnqueueMultica
 func (m *EnqueueMulticastQueueItemRequest) String() string { return proto.CompactTextString(m) }
 func (*EnqueueMulticastQueueItemRequest) ProtoMessage()    {}
 func (*EnqueueMulticastQueueItemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{13}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{13}
 }
 func (m *EnqueueMulticastQueueItemRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EnqueueMulticastQueueItemRequest.Unmarshal(m, b)
@@ -848,7 +848,7 @@ func (m *EnqueueMulticastQueueItemResponse) Reset()         { *m = EnqueueMultic
 func (m *EnqueueMulticastQueueItemResponse) String() string { return proto.CompactTextString(m) }
 func (*EnqueueMulticastQueueItemResponse) ProtoMessage()    {}
 func (*EnqueueMulticastQueueItemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{14}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{14}
 }
 func (m *EnqueueMulticastQueueItemResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EnqueueMulticastQueueItemResponse.Unmarshal(m, b)
@@ -887,7 +887,7 @@ func (m *FlushMulticastGroupQueueItemsRequest) Reset()         { *m = FlushMulti
 func (m *FlushMulticastGroupQueueItemsRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushMulticastGroupQueueItemsRequest) ProtoMessage()    {}
 func (*FlushMulticastGroupQueueItemsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{15}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{15}
 }
 func (m *FlushMulticastGroupQueueItemsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FlushMulticastGroupQueueItemsRequest.Unmarshal(m, b)
@@ -926,7 +926,7 @@ func (m *ListMulticastGroupQueueItemsRequest) Reset()         { *m = ListMultica
 func (m *ListMulticastGroupQueueItemsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListMulticastGroupQueueItemsRequest) ProtoMessage()    {}
 func (*ListMulticastGroupQueueItemsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{16}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{16}
 }
 func (m *ListMulticastGroupQueueItemsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListMulticastGroupQueueItemsRequest.Unmarshal(m, b)
@@ -964,7 +964,7 @@ func (m *ListMulticastGroupQueueItemsResponse) Reset()         { *m = ListMultic
 func (m *ListMulticastGroupQueueItemsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListMulticastGroupQueueItemsResponse) ProtoMessage()    {}
 func (*ListMulticastGroupQueueItemsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multicastGroup_6c303266faad4a6b, []int{17}
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{17}
 }
 func (m *ListMulticastGroupQueueItemsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListMulticastGroupQueueItemsResponse.Unmarshal(m, b)
@@ -991,6 +991,326 @@ func (m *ListMulticastGroupQueueItemsResponse) GetMulticastQueueItems() []*Multi
 	return nil
 }
 
+type MulticastQueueSchedule struct {
+	// Queue schedule ID (string formatted UUID).
+	// This will be automatically set on create.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Multicast-group ID (string formatted UUID).
+	MulticastGroupId string `protobuf:"bytes,2,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
+	// Cron expression (minute, hour, day of month, month and day of week),
+	// e.g. "0 9 * * 1-5".
+	Cron string `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	// Time zone in which the cron expression is evaluated, e.g.
+	// "Europe/Amsterdam".
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// FPort used (must be > 0).
+	FPort uint32 `protobuf:"varint,5,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Base64 encoded data.
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// Next scheduled time.
+	// This will be automatically set.
+	NextRunAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	// Scheduled time of the last successful execution.
+	// This will be automatically set.
+	LastRunAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	// Error of the last failed execution attempt.
+	// This will be automatically set.
+	LastError            string   `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MulticastQueueSchedule) Reset()         { *m = MulticastQueueSchedule{} }
+func (m *MulticastQueueSchedule) String() string { return proto.CompactTextString(m) }
+func (*MulticastQueueSchedule) ProtoMessage()    {}
+func (*MulticastQueueSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{18}
+}
+func (m *MulticastQueueSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MulticastQueueSchedule.Unmarshal(m, b)
+}
+func (m *MulticastQueueSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MulticastQueueSchedule.Marshal(b, m, deterministic)
+}
+func (dst *MulticastQueueSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MulticastQueueSchedule.Merge(dst, src)
+}
+func (m *MulticastQueueSchedule) XXX_Size() int {
+	return xxx_messageInfo_MulticastQueueSchedule.Size(m)
+}
+func (m *MulticastQueueSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MulticastQueueSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MulticastQueueSchedule proto.InternalMessageInfo
+
+func (m *MulticastQueueSchedule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MulticastQueueSchedule) GetMulticastGroupId() string {
+	if m != nil {
+		return m.MulticastGroupId
+	}
+	return ""
+}
+
+func (m *MulticastQueueSchedule) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *MulticastQueueSchedule) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *MulticastQueueSchedule) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *MulticastQueueSchedule) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *MulticastQueueSchedule) GetNextRunAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextRunAt
+	}
+	return nil
+}
+
+func (m *MulticastQueueSchedule) GetLastRunAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastRunAt
+	}
+	return nil
+}
+
+func (m *MulticastQueueSchedule) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type CreateMulticastQueueScheduleRequest struct {
+	// Multicast queue schedule object to create.
+	MulticastQueueSchedule *MulticastQueueSchedule `protobuf:"bytes,1,opt,name=multicast_queue_schedule,json=multicastQueueSchedule,proto3" json:"multicast_queue_schedule,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                `json:"-"`
+	XXX_unrecognized       []byte                  `json:"-"`
+	XXX_sizecache          int32                   `json:"-"`
+}
+
+func (m *CreateMulticastQueueScheduleRequest) Reset()         { *m = CreateMulticastQueueScheduleRequest{} }
+func (m *CreateMulticastQueueScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateMulticastQueueScheduleRequest) ProtoMessage()    {}
+func (*CreateMulticastQueueScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{19}
+}
+func (m *CreateMulticastQueueScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateMulticastQueueScheduleRequest.Unmarshal(m, b)
+}
+func (m *CreateMulticastQueueScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateMulticastQueueScheduleRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateMulticastQueueScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateMulticastQueueScheduleRequest.Merge(dst, src)
+}
+func (m *CreateMulticastQueueScheduleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateMulticastQueueScheduleRequest.Size(m)
+}
+func (m *CreateMulticastQueueScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateMulticastQueueScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateMulticastQueueScheduleRequest proto.InternalMessageInfo
+
+func (m *CreateMulticastQueueScheduleRequest) GetMulticastQueueSchedule() *MulticastQueueSchedule {
+	if m != nil {
+		return m.MulticastQueueSchedule
+	}
+	return nil
+}
+
+type CreateMulticastQueueScheduleResponse struct {
+	// ID of the created queue schedule (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateMulticastQueueScheduleResponse) Reset()         { *m = CreateMulticastQueueScheduleResponse{} }
+func (m *CreateMulticastQueueScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateMulticastQueueScheduleResponse) ProtoMessage()    {}
+func (*CreateMulticastQueueScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{20}
+}
+func (m *CreateMulticastQueueScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateMulticastQueueScheduleResponse.Unmarshal(m, b)
+}
+func (m *CreateMulticastQueueScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateMulticastQueueScheduleResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateMulticastQueueScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateMulticastQueueScheduleResponse.Merge(dst, src)
+}
+func (m *CreateMulticastQueueScheduleResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateMulticastQueueScheduleResponse.Size(m)
+}
+func (m *CreateMulticastQueueScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateMulticastQueueScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateMulticastQueueScheduleResponse proto.InternalMessageInfo
+
+func (m *CreateMulticastQueueScheduleResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListMulticastQueueSchedulesRequest struct {
+	// Multicast-group ID (string formatted UUID).
+	MulticastGroupId     string   `protobuf:"bytes,1,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMulticastQueueSchedulesRequest) Reset()         { *m = ListMulticastQueueSchedulesRequest{} }
+func (m *ListMulticastQueueSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMulticastQueueSchedulesRequest) ProtoMessage()    {}
+func (*ListMulticastQueueSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{21}
+}
+func (m *ListMulticastQueueSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMulticastQueueSchedulesRequest.Unmarshal(m, b)
+}
+func (m *ListMulticastQueueSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMulticastQueueSchedulesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListMulticastQueueSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMulticastQueueSchedulesRequest.Merge(dst, src)
+}
+func (m *ListMulticastQueueSchedulesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMulticastQueueSchedulesRequest.Size(m)
+}
+func (m *ListMulticastQueueSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMulticastQueueSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMulticastQueueSchedulesRequest proto.InternalMessageInfo
+
+func (m *ListMulticastQueueSchedulesRequest) GetMulticastGroupId() string {
+	if m != nil {
+		return m.MulticastGroupId
+	}
+	return ""
+}
+
+type ListMulticastQueueSchedulesResponse struct {
+	MulticastQueueSchedules []*MulticastQueueSchedule `protobuf:"bytes,1,rep,name=multicast_queue_schedules,json=multicastQueueSchedules,proto3" json:"multicast_queue_schedules,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                  `json:"-"`
+	XXX_unrecognized        []byte                    `json:"-"`
+	XXX_sizecache           int32                     `json:"-"`
+}
+
+func (m *ListMulticastQueueSchedulesResponse) Reset()         { *m = ListMulticastQueueSchedulesResponse{} }
+func (m *ListMulticastQueueSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMulticastQueueSchedulesResponse) ProtoMessage()    {}
+func (*ListMulticastQueueSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{22}
+}
+func (m *ListMulticastQueueSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMulticastQueueSchedulesResponse.Unmarshal(m, b)
+}
+func (m *ListMulticastQueueSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMulticastQueueSchedulesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListMulticastQueueSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMulticastQueueSchedulesResponse.Merge(dst, src)
+}
+func (m *ListMulticastQueueSchedulesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMulticastQueueSchedulesResponse.Size(m)
+}
+func (m *ListMulticastQueueSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMulticastQueueSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMulticastQueueSchedulesResponse proto.InternalMessageInfo
+
+func (m *ListMulticastQueueSchedulesResponse) GetMulticastQueueSchedules() []*MulticastQueueSchedule {
+	if m != nil {
+		return m.MulticastQueueSchedules
+	}
+	return nil
+}
+
+type DeleteMulticastQueueScheduleRequest struct {
+	// Multicast-group ID (string formatted UUID).
+	MulticastGroupId string `protobuf:"bytes,1,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
+	// Queue schedule ID (string formatted UUID).
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteMulticastQueueScheduleRequest) Reset()         { *m = DeleteMulticastQueueScheduleRequest{} }
+func (m *DeleteMulticastQueueScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMulticastQueueScheduleRequest) ProtoMessage()    {}
+func (*DeleteMulticastQueueScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_multicastGroup_b702d460322d14da, []int{23}
+}
+func (m *DeleteMulticastQueueScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMulticastQueueScheduleRequest.Unmarshal(m, b)
+}
+func (m *DeleteMulticastQueueScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMulticastQueueScheduleRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteMulticastQueueScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMulticastQueueScheduleRequest.Merge(dst, src)
+}
+func (m *DeleteMulticastQueueScheduleRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteMulticastQueueScheduleRequest.Size(m)
+}
+func (m *DeleteMulticastQueueScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMulticastQueueScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteMulticastQueueScheduleRequest proto.InternalMessageInfo
+
+func (m *DeleteMulticastQueueScheduleRequest) GetMulticastGroupId() string {
+	if m != nil {
+		return m.MulticastGroupId
+	}
+	return ""
+}
+
+func (m *DeleteMulticastQueueScheduleRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MulticastGroup)(nil), "api.MulticastGroup")
 	proto.RegisterType((*MulticastGroupListItem)(nil), "api.MulticastGroupListItem")
@@ -1010,6 +1330,12 @@ func init() {
 	proto.RegisterType((*FlushMulticastGroupQueueItemsRequest)(nil), "api.FlushMulticastGroupQueueItemsRequest")
 	proto.RegisterType((*ListMulticastGroupQueueItemsRequest)(nil), "api.ListMulticastGroupQueueItemsRequest")
 	proto.RegisterType((*ListMulticastGroupQueueItemsResponse)(nil), "api.ListMulticastGroupQueueItemsResponse")
+	proto.RegisterType((*MulticastQueueSchedule)(nil), "api.MulticastQueueSchedule")
+	proto.RegisterType((*CreateMulticastQueueScheduleRequest)(nil), "api.CreateMulticastQueueScheduleRequest")
+	proto.RegisterType((*CreateMulticastQueueScheduleResponse)(nil), "api.CreateMulticastQueueScheduleResponse")
+	proto.RegisterType((*ListMulticastQueueSchedulesRequest)(nil), "api.ListMulticastQueueSchedulesRequest")
+	proto.RegisterType((*ListMulticastQueueSchedulesResponse)(nil), "api.ListMulticastQueueSchedulesResponse")
+	proto.RegisterType((*DeleteMulticastQueueScheduleRequest)(nil), "api.DeleteMulticastQueueScheduleRequest")
 	proto.RegisterEnum("api.MulticastGroupType", MulticastGroupType_name, MulticastGroupType_value)
 }
 
@@ -1045,6 +1371,13 @@ type MulticastGroupServiceClient interface {
 	FlushQueue(ctx context.Context, in *FlushMulticastGroupQueueItemsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// ListQueue lists the items in the multicast-group queue.
 	ListQueue(ctx context.Context, in *ListMulticastGroupQueueItemsRequest, opts ...grpc.CallOption) (*ListMulticastGroupQueueItemsResponse, error)
+	// CreateQueueSchedule creates a schedule which adds the given item to the
+	// multicast-queue according to a cron expression.
+	CreateQueueSchedule(ctx context.Context, in *CreateMulticastQueueScheduleRequest, opts ...grpc.CallOption) (*CreateMulticastQueueScheduleResponse, error)
+	// ListQueueSchedules lists the queue schedules of the multicast-group.
+	ListQueueSchedules(ctx context.Context, in *ListMulticastQueueSchedulesRequest, opts ...grpc.CallOption) (*ListMulticastQueueSchedulesResponse, error)
+	// DeleteQueueSchedule deletes the given queue schedule.
+	DeleteQueueSchedule(ctx context.Context, in *DeleteMulticastQueueScheduleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type multicastGroupServiceClient struct {
@@ -1145,6 +1478,33 @@ func (c *multicastGroupServiceClient) ListQueue(ctx context.Context, in *ListMul
 	return out, nil
 }
 
+func (c *multicastGroupServiceClient) CreateQueueSchedule(ctx context.Context, in *CreateMulticastQueueScheduleRequest, opts ...grpc.CallOption) (*CreateMulticastQueueScheduleResponse, error) {
+	out := new(CreateMulticastQueueScheduleResponse)
+	err := c.cc.Invoke(ctx, "/api.MulticastGroupService/CreateQueueSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) ListQueueSchedules(ctx context.Context, in *ListMulticastQueueSchedulesRequest, opts ...grpc.CallOption) (*ListMulticastQueueSchedulesResponse, error) {
+	out := new(ListMulticastQueueSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/api.MulticastGroupService/ListQueueSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) DeleteQueueSchedule(ctx context.Context, in *DeleteMulticastQueueScheduleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.MulticastGroupService/DeleteQueueSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MulticastGroupServiceServer is the server API for MulticastGroupService service.
 type MulticastGroupServiceServer interface {
 	// Create creates the given multicast-group.
@@ -1167,6 +1527,13 @@ type MulticastGroupServiceServer interface {
 	FlushQueue(context.Context, *FlushMulticastGroupQueueItemsRequest) (*empty.Empty, error)
 	// ListQueue lists the items in the multicast-group queue.
 	ListQueue(context.Context, *ListMulticastGroupQueueItemsRequest) (*ListMulticastGroupQueueItemsResponse, error)
+	// CreateQueueSchedule creates a schedule which adds the given item to the
+	// multicast-queue according to a cron expression.
+	CreateQueueSchedule(context.Context, *CreateMulticastQueueScheduleRequest) (*CreateMulticastQueueScheduleResponse, error)
+	// ListQueueSchedules lists the queue schedules of the multicast-group.
+	ListQueueSchedules(context.Context, *ListMulticastQueueSchedulesRequest) (*ListMulticastQueueSchedulesResponse, error)
+	// DeleteQueueSchedule deletes the given queue schedule.
+	DeleteQueueSchedule(context.Context, *DeleteMulticastQueueScheduleRequest) (*empty.Empty, error)
 }
 
 func RegisterMulticastGroupServiceServer(s *grpc.Server, srv MulticastGroupServiceServer) {
@@ -1353,6 +1720,60 @@ func _MulticastGroupService_ListQueue_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MulticastGroupService_CreateQueueSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMulticastQueueScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).CreateQueueSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/CreateQueueSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).CreateQueueSchedule(ctx, req.(*CreateMulticastQueueScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_ListQueueSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMulticastQueueSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).ListQueueSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/ListQueueSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).ListQueueSchedules(ctx, req.(*ListMulticastQueueSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_DeleteQueueSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMulticastQueueScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).DeleteQueueSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/DeleteQueueSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).DeleteQueueSchedule(ctx, req.(*DeleteMulticastQueueScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MulticastGroupService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.MulticastGroupService",
 	HandlerType: (*MulticastGroupServiceServer)(nil),
@@ -1397,89 +1818,118 @@ var _MulticastGroupService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListQueue",
 			Handler:    _MulticastGroupService_ListQueue_Handler,
 		},
+		{
+			MethodName: "CreateQueueSchedule",
+			Handler:    _MulticastGroupService_CreateQueueSchedule_Handler,
+		},
+		{
+			MethodName: "ListQueueSchedules",
+			Handler:    _MulticastGroupService_ListQueueSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteQueueSchedule",
+			Handler:    _MulticastGroupService_DeleteQueueSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "multicastGroup.proto",
 }
 
 func init() {
-	proto.RegisterFile("multicastGroup.proto", fileDescriptor_multicastGroup_6c303266faad4a6b)
-}
-
-var fileDescriptor_multicastGroup_6c303266faad4a6b = []byte{
-	// 1170 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0xc6, 0xbb, 0xc9, 0x86, 0x3d, 0xdb, 0x6e, 0xa3, 0x69, 0x93, 0xb8, 0x4e, 0x4a, 0xb6, 0xa6,
-	0x85, 0xed, 0xaa, 0xdd, 0xad, 0xb6, 0x02, 0x0a, 0x2a, 0x48, 0x4b, 0x92, 0x46, 0xa1, 0xa5, 0x0a,
-	0xde, 0xf4, 0x8a, 0x0b, 0xcb, 0xb5, 0xc7, 0x9b, 0x51, 0xd6, 0x1e, 0xd7, 0x1e, 0xa7, 0x5a, 0xaa,
-	0xdc, 0xf4, 0xae, 0xd7, 0xdc, 0x20, 0xc4, 0x13, 0xf0, 0x08, 0xbc, 0x04, 0x42, 0xbc, 0x01, 0xe2,
-	0x41, 0x90, 0x8f, 0x67, 0x7f, 0x63, 0x6f, 0x12, 0xaa, 0xde, 0x79, 0x66, 0xce, 0x9c, 0xef, 0x3b,
-	0xdf, 0x9c, 0x1f, 0xc3, 0x35, 0x2f, 0xee, 0x0b, 0x66, 0x5b, 0x91, 0xd8, 0x0d, 0x79, 0x1c, 0x34,
-	0x83, 0x90, 0x0b, 0x4e, 0x8a, 0x56, 0xc0, 0xb4, 0x8d, 0x1e, 0xe7, 0xbd, 0x3e, 0x6d, 0x59, 0x01,
-	0x6b, 0x59, 0xbe, 0xcf, 0x85, 0x25, 0x18, 0xf7, 0xa3, 0xd4, 0x44, 0xdb, 0x94, 0xa7, 0xb8, 0x7a,
-	0x11, 0xbb, 0x2d, 0xc1, 0x3c, 0x1a, 0x09, 0xcb, 0x93, 0x3e, 0xb4, 0xf5, 0x59, 0x03, 0xea, 0x05,
-	0x62, 0x90, 0x1e, 0xea, 0xff, 0x14, 0xa0, 0xfa, 0xfd, 0x14, 0x32, 0xa9, 0x42, 0x81, 0x39, 0xaa,
-	0x52, 0x53, 0xea, 0x65, 0xa3, 0xc0, 0x1c, 0x42, 0x60, 0xc1, 0xb7, 0x3c, 0xaa, 0x16, 0x70, 0x07,
-	0xbf, 0xc9, 0x1a, 0x2c, 0x79, 0xb6, 0x69, 0x39, 0x4e, 0xa8, 0x16, 0x71, 0xbb, 0xe4, 0xd9, 0x1d,
-	0xc7, 0x09, 0xc9, 0x26, 0x5c, 0xf2, 0x6c, 0xd3, 0x7f, 0x75, 0x64, 0x46, 0xe6, 0x11, 0x1d, 0xa8,
-	0x0b, 0x78, 0x5a, 0xf6, 0xec, 0x67, 0xaf, 0x8e, 0xba, 0x4f, 0xe8, 0x40, 0x1a, 0x58, 0x41, 0x20,
-	0x0d, 0x16, 0x87, 0x06, 0x9d, 0x20, 0x40, 0x83, 0xab, 0xb0, 0xe8, 0x9a, 0xb6, 0x2f, 0xd4, 0x52,
-	0x4d, 0xa9, 0x5f, 0x36, 0x16, 0xdc, 0x2d, 0x5f, 0x90, 0xcf, 0x01, 0x7a, 0x09, 0x39, 0x53, 0x0c,
-	0x02, 0xaa, 0x2e, 0xd5, 0x94, 0x7a, 0xb5, 0xbd, 0xd6, 0xb4, 0x02, 0xd6, 0x9c, 0x26, 0x7f, 0x30,
-	0x08, 0xa8, 0x51, 0xee, 0x0d, 0x3f, 0x93, 0x58, 0x9c, 0x50, 0xfd, 0x10, 0x3d, 0x15, 0x9c, 0x90,
-	0x6c, 0x40, 0xd9, 0x0d, 0xe9, 0xcb, 0x98, 0xfa, 0xf6, 0x40, 0x2d, 0xe3, 0xf6, 0x78, 0x83, 0xd4,
-	0x61, 0x39, 0x60, 0x7e, 0xcf, 0x8c, 0xfa, 0x5c, 0x98, 0x01, 0x0d, 0x19, 0x77, 0x54, 0x40, 0xa3,
-	0x6a, 0xb2, 0xdf, 0xed, 0x73, 0xb1, 0x8f, 0xbb, 0xe4, 0x2e, 0x90, 0x88, 0x86, 0xc7, 0xcc, 0xa6,
-	0x66, 0x10, 0x72, 0x97, 0xf5, 0xa9, 0xc9, 0x1c, 0xb5, 0x82, 0xb1, 0x2c, 0xcb, 0x93, 0xfd, 0xf4,
-	0x60, 0x6f, 0x5b, 0xff, 0x4d, 0x81, 0xd5, 0x69, 0x9e, 0x4f, 0x59, 0x24, 0xf6, 0x04, 0xf5, 0xce,
-	0x25, 0x76, 0x36, 0x58, 0x31, 0x1b, 0x8c, 0xdc, 0x87, 0x6b, 0xb3, 0xd6, 0xe8, 0x31, 0x7d, 0x09,
-	0x32, 0x6d, 0xff, 0xcc, 0xf2, 0xa8, 0xfe, 0x23, 0xac, 0x6f, 0x85, 0xd4, 0x12, 0x74, 0x9a, 0xa3,
-	0x91, 0xc8, 0x12, 0x09, 0xf2, 0x08, 0xae, 0x8c, 0x72, 0xd3, 0x44, 0x69, 0x91, 0x6f, 0xa5, 0x7d,
-	0x35, 0xe3, 0x01, 0x8c, 0xea, 0x74, 0x1e, 0xeb, 0x4d, 0xd8, 0xc8, 0x76, 0x1e, 0x05, 0xdc, 0x8f,
-	0xe8, 0xac, 0x00, 0x7a, 0x03, 0xd4, 0x5d, 0x2a, 0xb2, 0x99, 0xcc, 0xda, 0xfe, 0xa9, 0xc0, 0xf5,
-	0x0c, 0x63, 0xe9, 0xf9, 0x9d, 0x78, 0x93, 0x2f, 0x01, 0x6c, 0xe4, 0xed, 0x98, 0x96, 0xc0, 0xe7,
-	0xa8, 0xb4, 0xb5, 0x66, 0x5a, 0x4a, 0xcd, 0x61, 0x29, 0x35, 0x0f, 0x86, 0xb5, 0x66, 0x94, 0xa5,
-	0x75, 0x47, 0x24, 0x57, 0xe3, 0xc0, 0x19, 0x5e, 0x2d, 0x9e, 0x7d, 0x55, 0x5a, 0x77, 0x44, 0xf2,
-	0x14, 0xcf, 0x71, 0xf1, 0x3e, 0x9e, 0xe2, 0x1e, 0xac, 0x6f, 0xd3, 0x3e, 0xcd, 0x73, 0x3e, 0xab,
-	0x2e, 0x83, 0x5a, 0xc7, 0x71, 0xb6, 0x69, 0x92, 0x2e, 0x07, 0x3c, 0xfb, 0xce, 0x5d, 0x20, 0x33,
-	0x84, 0xcc, 0x91, 0x8f, 0xe5, 0x69, 0xf8, 0xbd, 0xed, 0xa4, 0x6b, 0x38, 0xf4, 0xd8, 0xa4, 0x31,
-	0x93, 0xf9, 0x5d, 0x72, 0xe8, 0xf1, 0xce, 0xf3, 0x3d, 0xdd, 0x87, 0xdb, 0x06, 0xf5, 0xf8, 0x31,
-	0x4d, 0xd1, 0x1e, 0x87, 0xdc, 0x7b, 0xaf, 0x78, 0x7f, 0x29, 0x70, 0x3d, 0x29, 0xc1, 0x6c, 0x90,
-	0x6b, 0xb0, 0xd8, 0x67, 0x1e, 0x13, 0xe8, 0xb7, 0x68, 0xa4, 0x0b, 0xb2, 0x0a, 0x25, 0xee, 0xba,
-	0x11, 0x4d, 0x93, 0xa1, 0x68, 0xc8, 0x15, 0xf9, 0x14, 0xae, 0xf0, 0xb0, 0x67, 0xf9, 0xec, 0x27,
-	0x6c, 0xcb, 0xc3, 0xd2, 0x2c, 0x1a, 0xd5, 0xc9, 0xed, 0x69, 0x36, 0x0b, 0x93, 0x6c, 0x72, 0xea,
-	0x7b, 0x31, 0xa7, 0xbe, 0x57, 0xa1, 0x14, 0x51, 0x2b, 0xb4, 0x0f, 0xb1, 0x41, 0x96, 0x0d, 0xb9,
-	0xd2, 0x43, 0xd0, 0xb2, 0x42, 0x92, 0xc5, 0xb0, 0x09, 0x15, 0xc1, 0x85, 0xd5, 0x37, 0x6d, 0x1e,
-	0xfb, 0xc3, 0xc8, 0x00, 0xb7, 0xb6, 0x92, 0x1d, 0xf2, 0x00, 0x4a, 0x21, 0x8d, 0xe2, 0x7e, 0x12,
-	0x5e, 0xb1, 0x5e, 0x69, 0xaf, 0x67, 0x64, 0xd4, 0xb0, 0x6b, 0x19, 0xd2, 0x54, 0x7f, 0xa3, 0x00,
-	0x19, 0x99, 0xfc, 0x10, 0xd3, 0x98, 0x62, 0x53, 0xbb, 0xd8, 0x2b, 0x8d, 0x1a, 0x7e, 0x61, 0xa2,
-	0xe1, 0xaf, 0x40, 0xc9, 0x35, 0x03, 0x1e, 0xa6, 0xf5, 0x73, 0xd9, 0x58, 0x74, 0xf7, 0x79, 0x28,
-	0x92, 0xf6, 0xe8, 0x58, 0xc2, 0x42, 0x01, 0x2f, 0x19, 0xf8, 0xad, 0x7b, 0x50, 0xdb, 0xf1, 0x5f,
-	0x26, 0xe0, 0xa7, 0xa9, 0x0c, 0x9f, 0x74, 0x6f, 0x62, 0xbe, 0x9a, 0x68, 0x6b, 0x32, 0x41, 0x3d,
-	0x59, 0x3d, 0x33, 0x93, 0x64, 0x7c, 0x7b, 0x1c, 0xc6, 0x68, 0x4f, 0x7f, 0x08, 0x37, 0xe7, 0xc0,
-	0x49, 0xb9, 0x47, 0x31, 0x29, 0xe3, 0x98, 0xf4, 0x03, 0xb8, 0xf5, 0xb8, 0x1f, 0x47, 0x87, 0xd3,
-	0xa2, 0x8e, 0x2e, 0x47, 0xff, 0x2b, 0xc9, 0xf5, 0x2e, 0x7c, 0x7c, 0xfa, 0xdd, 0xdf, 0xd5, 0x69,
-	0x04, 0xb7, 0xe6, 0x3b, 0x95, 0x71, 0x3e, 0x81, 0x95, 0x2c, 0x5d, 0x23, 0x55, 0xc1, 0x24, 0xca,
-	0x15, 0xf6, 0xea, 0x69, 0x61, 0xa3, 0x46, 0x73, 0x22, 0x99, 0x46, 0xd3, 0x9c, 0x54, 0x60, 0x69,
-	0xeb, 0x69, 0xa7, 0xdb, 0x35, 0xb7, 0x96, 0x3f, 0x18, 0x2f, 0xbe, 0x5d, 0x56, 0xda, 0x7f, 0x00,
-	0xac, 0x4c, 0x5f, 0xe8, 0xa6, 0xc5, 0x42, 0x38, 0x94, 0xd2, 0xa1, 0x43, 0x6a, 0xc8, 0x60, 0xce,
-	0x78, 0xd3, 0x6e, 0xce, 0xb1, 0x48, 0xa3, 0xd4, 0x6b, 0x6f, 0xfe, 0xfe, 0xf7, 0xe7, 0x82, 0xa6,
-	0xaf, 0xe0, 0x2f, 0xd8, 0x88, 0xfa, 0x3d, 0x94, 0x31, 0xfa, 0x4a, 0x69, 0x90, 0x43, 0x28, 0xee,
-	0x52, 0x41, 0x6e, 0xa0, 0xaf, 0xbc, 0xf9, 0xa5, 0x7d, 0x94, 0x77, 0x2c, 0x71, 0x74, 0xc4, 0xd9,
-	0x20, 0x5a, 0x26, 0x4e, 0xeb, 0x35, 0x73, 0x4e, 0xc8, 0x00, 0x4a, 0xe9, 0x84, 0x90, 0xa1, 0xcd,
-	0x19, 0x17, 0xda, 0xea, 0xa9, 0xa1, 0xb3, 0x93, 0xfc, 0xfa, 0xe9, 0x9f, 0x21, 0x4e, 0x4b, 0x6b,
-	0xe4, 0xe0, 0xcc, 0x24, 0x4a, 0x93, 0x39, 0x27, 0x49, 0x90, 0x2e, 0x94, 0xd2, 0xf9, 0x21, 0xa1,
-	0xe7, 0x0c, 0x93, 0x5c, 0x68, 0x19, 0x62, 0x63, 0x5e, 0x88, 0x2e, 0x2c, 0x24, 0xc9, 0x47, 0x52,
-	0xb9, 0x72, 0xfb, 0xb4, 0xb6, 0x99, 0x7b, 0x2e, 0xf5, 0xbc, 0x81, 0x60, 0x6b, 0x24, 0xfb, 0xdd,
-	0xc8, 0x5b, 0x05, 0xca, 0xa3, 0x09, 0x47, 0x6e, 0xa3, 0xb7, 0xb3, 0x26, 0x5e, 0x6e, 0x60, 0x5f,
-	0x23, 0xd6, 0x17, 0x7a, 0xfb, 0x7c, 0x9a, 0x9a, 0xcc, 0x39, 0x69, 0x39, 0x88, 0x84, 0x09, 0xf4,
-	0x8b, 0x02, 0x97, 0x26, 0x47, 0x20, 0x69, 0x20, 0x9d, 0x73, 0x4d, 0xc5, 0x5c, 0x4e, 0xdb, 0xc8,
-	0xe9, 0x9b, 0xc6, 0xa3, 0x8b, 0x73, 0x6a, 0xbd, 0x96, 0xb3, 0xea, 0x84, 0xfc, 0xae, 0xc0, 0x92,
-	0xec, 0x78, 0x52, 0xa4, 0xb3, 0xda, 0xad, 0xf6, 0xc9, 0x59, 0x66, 0xf2, 0x81, 0xf6, 0x91, 0xe0,
-	0x77, 0xfa, 0xce, 0x99, 0x04, 0xc7, 0xbd, 0xa5, 0x99, 0xc5, 0x1a, 0x8f, 0x13, 0x1d, 0xdf, 0x2a,
-	0x00, 0xd8, 0x64, 0x11, 0x8c, 0xdc, 0x41, 0x22, 0xe7, 0xe9, 0xba, 0xb9, 0x22, 0x3e, 0x44, 0x8e,
-	0xed, 0xc6, 0xfd, 0x0b, 0x88, 0x98, 0x8a, 0xf5, 0xab, 0x02, 0xe5, 0x24, 0x3b, 0x53, 0x2a, 0xf5,
-	0x9c, 0x6c, 0x3d, 0xcd, 0xe4, 0xce, 0x39, 0x2c, 0xa5, 0x80, 0x92, 0x1c, 0xb9, 0x30, 0xb9, 0x17,
-	0x25, 0x0c, 0xf3, 0xc1, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x26, 0x0a, 0x40, 0x7b, 0x78, 0x0e,
-	0x00, 0x00,
+	proto.RegisterFile("multicastGroup.proto", fileDescriptor_multicastGroup_b702d460322d14da)
+}
+
+var fileDescriptor_multicastGroup_b702d460322d14da = []byte{
+	// 1454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdf, 0x6f, 0xd3, 0xd6,
+	0x17, 0xff, 0x3a, 0x69, 0x53, 0x72, 0x0a, 0xa1, 0xba, 0xa5, 0xad, 0x71, 0xcb, 0xb7, 0xc1, 0x85,
+	0x11, 0x22, 0x48, 0x50, 0xd0, 0x18, 0x43, 0x6c, 0x53, 0x68, 0x4b, 0xd5, 0xc1, 0x50, 0xe7, 0x14,
+	0x6d, 0xd2, 0xa4, 0x59, 0xc6, 0xbe, 0x69, 0x2d, 0xe2, 0x1f, 0xd8, 0xd7, 0x65, 0x81, 0x75, 0x0f,
+	0xbc, 0xf1, 0xbc, 0x97, 0x69, 0xda, 0xc3, 0x9e, 0xb7, 0x7f, 0x64, 0xda, 0xcb, 0x34, 0xed, 0x65,
+	0xcf, 0xd3, 0xfe, 0x90, 0xc9, 0xc7, 0xd7, 0x49, 0x9c, 0xd8, 0x49, 0x0a, 0xe2, 0x2d, 0xbe, 0xf7,
+	0xdc, 0x73, 0x3e, 0xe7, 0x73, 0x7e, 0x06, 0xce, 0x59, 0x41, 0x87, 0x99, 0xba, 0xe6, 0xb3, 0x1d,
+	0xcf, 0x09, 0xdc, 0x9a, 0xeb, 0x39, 0xcc, 0x21, 0x79, 0xcd, 0x35, 0xa5, 0xb5, 0x03, 0xc7, 0x39,
+	0xe8, 0xd0, 0xba, 0xe6, 0x9a, 0x75, 0xcd, 0xb6, 0x1d, 0xa6, 0x31, 0xd3, 0xb1, 0xfd, 0x48, 0x44,
+	0x5a, 0xe7, 0xb7, 0xf8, 0xf5, 0x24, 0x68, 0xd7, 0x99, 0x69, 0x51, 0x9f, 0x69, 0x16, 0xd7, 0x21,
+	0xad, 0x0e, 0x0b, 0x50, 0xcb, 0x65, 0xdd, 0xe8, 0x52, 0xfe, 0x27, 0x07, 0xa5, 0xcf, 0x12, 0x96,
+	0x49, 0x09, 0x72, 0xa6, 0x21, 0x0a, 0x65, 0xa1, 0x52, 0x54, 0x72, 0xa6, 0x41, 0x08, 0xcc, 0xd8,
+	0x9a, 0x45, 0xc5, 0x1c, 0x9e, 0xe0, 0x6f, 0xb2, 0x02, 0x73, 0x96, 0xae, 0x6a, 0x86, 0xe1, 0x89,
+	0x79, 0x3c, 0x2e, 0x58, 0x7a, 0xd3, 0x30, 0x3c, 0xb2, 0x0e, 0xa7, 0x2d, 0x5d, 0xb5, 0x9f, 0x3f,
+	0x55, 0x7d, 0xf5, 0x29, 0xed, 0x8a, 0x33, 0x78, 0x5b, 0xb4, 0xf4, 0x47, 0xcf, 0x9f, 0xb6, 0x1e,
+	0xd0, 0x2e, 0x17, 0xd0, 0x5c, 0x97, 0x0b, 0xcc, 0xc6, 0x02, 0x4d, 0xd7, 0x45, 0x81, 0x45, 0x98,
+	0x6d, 0xab, 0xba, 0xcd, 0xc4, 0x42, 0x59, 0xa8, 0x9c, 0x51, 0x66, 0xda, 0x9b, 0x36, 0x23, 0xb7,
+	0x00, 0x0e, 0x42, 0x70, 0x2a, 0xeb, 0xba, 0x54, 0x9c, 0x2b, 0x0b, 0x95, 0x52, 0x63, 0xa5, 0xa6,
+	0xb9, 0x66, 0x2d, 0x09, 0x7e, 0xbf, 0xeb, 0x52, 0xa5, 0x78, 0x10, 0xff, 0x0c, 0x7d, 0x31, 0x3c,
+	0xf1, 0x14, 0x6a, 0xca, 0x19, 0x1e, 0x59, 0x83, 0x62, 0xdb, 0xa3, 0xcf, 0x02, 0x6a, 0xeb, 0x5d,
+	0xb1, 0x88, 0xc7, 0xfd, 0x03, 0x52, 0x81, 0x05, 0xd7, 0xb4, 0x0f, 0x54, 0xbf, 0xe3, 0x30, 0xd5,
+	0xa5, 0x9e, 0xe9, 0x18, 0x22, 0xa0, 0x50, 0x29, 0x3c, 0x6f, 0x75, 0x1c, 0xb6, 0x87, 0xa7, 0xe4,
+	0x1a, 0x10, 0x9f, 0x7a, 0x47, 0xa6, 0x4e, 0x55, 0xd7, 0x73, 0xda, 0x66, 0x87, 0xaa, 0xa6, 0x21,
+	0xce, 0xa3, 0x2f, 0x0b, 0xfc, 0x66, 0x2f, 0xba, 0xd8, 0xdd, 0x92, 0x7f, 0x12, 0x60, 0x39, 0x89,
+	0xf3, 0xa1, 0xe9, 0xb3, 0x5d, 0x46, 0xad, 0xa9, 0xc8, 0x4e, 0x37, 0x96, 0x4f, 0x37, 0x46, 0x6e,
+	0xc0, 0xb9, 0x61, 0x69, 0xd4, 0x18, 0x45, 0x82, 0x24, 0xe5, 0x1f, 0x69, 0x16, 0x95, 0xbf, 0x82,
+	0xd5, 0x4d, 0x8f, 0x6a, 0x8c, 0x26, 0x31, 0x2a, 0x21, 0x2d, 0x3e, 0x23, 0x77, 0xe1, 0x6c, 0x2f,
+	0x37, 0x55, 0xa4, 0x16, 0xf1, 0xce, 0x37, 0x16, 0x53, 0x02, 0xa0, 0x94, 0x92, 0x79, 0x2c, 0xd7,
+	0x60, 0x2d, 0x5d, 0xb9, 0xef, 0x3a, 0xb6, 0x4f, 0x87, 0x09, 0x90, 0xab, 0x20, 0xee, 0x50, 0x96,
+	0x8e, 0x64, 0x58, 0xf6, 0x0f, 0x01, 0xce, 0xa7, 0x08, 0x73, 0xcd, 0x6f, 0x85, 0x9b, 0x7c, 0x08,
+	0xa0, 0x23, 0x6e, 0x43, 0xd5, 0x18, 0x86, 0x63, 0xbe, 0x21, 0xd5, 0xa2, 0x52, 0xaa, 0xc5, 0xa5,
+	0x54, 0xdb, 0x8f, 0x6b, 0x4d, 0x29, 0x72, 0xe9, 0x26, 0x0b, 0x9f, 0x06, 0xae, 0x11, 0x3f, 0xcd,
+	0x4f, 0x7e, 0xca, 0xa5, 0x9b, 0x2c, 0x0c, 0xc5, 0x63, 0xfc, 0x78, 0x17, 0xa1, 0xb8, 0x0e, 0xab,
+	0x5b, 0xb4, 0x43, 0x19, 0x9d, 0x8e, 0x5d, 0x13, 0xca, 0x4d, 0xc3, 0xd8, 0xa2, 0x61, 0xba, 0xec,
+	0x3b, 0xe9, 0x6f, 0xae, 0x01, 0x19, 0x02, 0xa4, 0xf6, 0x74, 0x2c, 0x24, 0xcd, 0xef, 0x6e, 0x85,
+	0x5d, 0xc3, 0xa0, 0x47, 0x2a, 0x0d, 0x4c, 0x9e, 0xdf, 0x05, 0x83, 0x1e, 0x6d, 0x3f, 0xde, 0x95,
+	0x6d, 0xb8, 0xac, 0x50, 0xcb, 0x39, 0xa2, 0x91, 0xb5, 0xfb, 0x9e, 0x63, 0xbd, 0x53, 0x7b, 0x7f,
+	0x0a, 0x70, 0x3e, 0x2c, 0xc1, 0x74, 0x23, 0xe7, 0x60, 0xb6, 0x63, 0x5a, 0x26, 0x43, 0xbd, 0x79,
+	0x25, 0xfa, 0x20, 0xcb, 0x50, 0x70, 0xda, 0x6d, 0x9f, 0x46, 0xc9, 0x90, 0x57, 0xf8, 0x17, 0xb9,
+	0x02, 0x67, 0x1d, 0xef, 0x40, 0xb3, 0xcd, 0x17, 0xd8, 0x96, 0xe3, 0xd2, 0xcc, 0x2b, 0xa5, 0xc1,
+	0xe3, 0x24, 0x9a, 0x99, 0x41, 0x34, 0x19, 0xf5, 0x3d, 0x9b, 0x51, 0xdf, 0xcb, 0x50, 0xf0, 0xa9,
+	0xe6, 0xe9, 0x87, 0xd8, 0x20, 0x8b, 0x0a, 0xff, 0x92, 0x3d, 0x90, 0xd2, 0x5c, 0xe2, 0xc5, 0xb0,
+	0x0e, 0xf3, 0xcc, 0x61, 0x5a, 0x47, 0xd5, 0x9d, 0xc0, 0x8e, 0x3d, 0x03, 0x3c, 0xda, 0x0c, 0x4f,
+	0xc8, 0x4d, 0x28, 0x78, 0xd4, 0x0f, 0x3a, 0xa1, 0x7b, 0xf9, 0xca, 0x7c, 0x63, 0x35, 0x25, 0xa3,
+	0xe2, 0xae, 0xa5, 0x70, 0x51, 0xf9, 0x95, 0x00, 0xa4, 0x27, 0xf2, 0x79, 0x40, 0x03, 0x1a, 0x5e,
+	0x9f, 0x30, 0x4a, 0xbd, 0x86, 0x9f, 0x1b, 0x68, 0xf8, 0x4b, 0x50, 0x68, 0xab, 0xae, 0xe3, 0x45,
+	0xf5, 0x73, 0x46, 0x99, 0x6d, 0xef, 0x39, 0x1e, 0x0b, 0xdb, 0xa3, 0xa1, 0x31, 0x0d, 0x09, 0x3c,
+	0xad, 0xe0, 0x6f, 0xd9, 0x82, 0xf2, 0xb6, 0xfd, 0x2c, 0x34, 0x3e, 0x0a, 0x25, 0x0e, 0xe9, 0xee,
+	0xc0, 0x7c, 0x55, 0x51, 0x56, 0x35, 0x19, 0xb5, 0x78, 0xf5, 0x0c, 0x4d, 0x92, 0xfe, 0x6b, 0x62,
+	0x8d, 0x9c, 0xc9, 0xb7, 0xe1, 0xe2, 0x18, 0x73, 0x9c, 0xee, 0x9e, 0x4f, 0x42, 0xdf, 0x27, 0x79,
+	0x1f, 0x2e, 0xdd, 0xef, 0x04, 0xfe, 0x61, 0x92, 0xd4, 0xde, 0x63, 0xff, 0x8d, 0x92, 0x5c, 0x6e,
+	0xc1, 0xc6, 0x68, 0xdc, 0xdf, 0x56, 0xa9, 0x0f, 0x97, 0xc6, 0x2b, 0xe5, 0x7e, 0x3e, 0x80, 0xa5,
+	0x34, 0x5e, 0x7d, 0x51, 0x28, 0xe7, 0xc7, 0x11, 0xbb, 0x38, 0x4a, 0xac, 0x2f, 0xff, 0x96, 0x1b,
+	0x18, 0x93, 0x78, 0xde, 0xd2, 0x0f, 0xa9, 0x11, 0x74, 0x46, 0xa6, 0x44, 0x86, 0x37, 0xb9, 0x8c,
+	0x0c, 0x23, 0x30, 0xa3, 0x7b, 0x8e, 0xcd, 0x47, 0x26, 0xfe, 0x26, 0x12, 0x9c, 0x62, 0xa6, 0x45,
+	0x5f, 0x38, 0x76, 0x3c, 0x1a, 0x7b, 0xdf, 0x03, 0xc9, 0x37, 0x9b, 0x96, 0x7c, 0x85, 0x7e, 0xf2,
+	0x91, 0x3b, 0x30, 0x6f, 0xd3, 0x6f, 0x98, 0xea, 0x05, 0x76, 0xd8, 0xec, 0xe7, 0x26, 0x37, 0xfb,
+	0x50, 0x5c, 0x09, 0xec, 0x26, 0x0b, 0xdf, 0x76, 0x34, 0xbf, 0xf7, 0xf6, 0xd4, 0xe4, 0xb7, 0xa1,
+	0x78, 0xf4, 0xf6, 0x02, 0x00, 0xbe, 0xa5, 0x9e, 0xe7, 0x78, 0xb8, 0xc9, 0x14, 0xa3, 0xeb, 0xed,
+	0xf0, 0x40, 0xfe, 0x16, 0x36, 0x86, 0xa6, 0x6e, 0x82, 0xcf, 0x38, 0x29, 0x1e, 0x83, 0x38, 0x1c,
+	0x3e, 0x9f, 0x8b, 0xf0, 0xd2, 0x58, 0x4d, 0x89, 0x60, 0x4f, 0xcb, 0xb2, 0x95, 0x7a, 0x2e, 0xdf,
+	0x82, 0x4b, 0xe3, 0xad, 0x67, 0xcc, 0x7e, 0x05, 0xe4, 0x44, 0xd6, 0x25, 0x5e, 0xbd, 0x61, 0x26,
+	0x7f, 0x07, 0x1b, 0x63, 0x75, 0x72, 0x28, 0x5f, 0xc0, 0xf9, 0x2c, 0x26, 0xe2, 0x64, 0x1e, 0x4b,
+	0xc5, 0x4a, 0x3a, 0x15, 0xbe, 0xac, 0xc3, 0xc6, 0xd0, 0xd0, 0x4d, 0x8d, 0xc4, 0xc9, 0x5a, 0x66,
+	0x44, 0x5c, 0x2e, 0x26, 0xae, 0x5a, 0x1b, 0x68, 0xc3, 0xbd, 0x3d, 0x98, 0xcc, 0xc3, 0xdc, 0xe6,
+	0xc3, 0x66, 0xab, 0xa5, 0x6e, 0x2e, 0xfc, 0xaf, 0xff, 0x71, 0x6f, 0x41, 0x68, 0xfc, 0x5d, 0x82,
+	0xa5, 0xe4, 0x83, 0x56, 0x34, 0x66, 0x88, 0x03, 0x85, 0x28, 0x74, 0xa4, 0x8c, 0xee, 0x8e, 0x59,
+	0x0c, 0xa5, 0x8b, 0x63, 0x24, 0x22, 0x5a, 0xe5, 0xf2, 0xab, 0xbf, 0xfe, 0xfd, 0x3e, 0x27, 0xc9,
+	0x4b, 0xf8, 0xe7, 0xa5, 0xe7, 0xc7, 0x75, 0xf4, 0xd0, 0xbf, 0x23, 0x54, 0xc9, 0x21, 0xe4, 0x77,
+	0x28, 0x23, 0x17, 0x50, 0x57, 0xd6, 0xe6, 0x27, 0xfd, 0x3f, 0xeb, 0x9a, 0xdb, 0x91, 0xd1, 0xce,
+	0x1a, 0x91, 0x52, 0xed, 0xd4, 0x5f, 0x9a, 0xc6, 0x31, 0xe9, 0x42, 0x21, 0xda, 0xad, 0xb8, 0x6b,
+	0x63, 0x16, 0x2d, 0x69, 0x79, 0xa4, 0x0a, 0xb7, 0xc3, 0x3f, 0x4d, 0xf2, 0xfb, 0x68, 0xa7, 0x2e,
+	0x55, 0x33, 0xec, 0x0c, 0xc5, 0xb0, 0x66, 0x1a, 0xc7, 0xa1, 0x93, 0x6d, 0x28, 0x44, 0x49, 0xc0,
+	0x4d, 0x8f, 0x59, 0xc3, 0x32, 0x4d, 0x73, 0x17, 0xab, 0xe3, 0x5c, 0x6c, 0xc3, 0x4c, 0x98, 0xec,
+	0x24, 0xa2, 0x2b, 0x73, 0xc3, 0x91, 0xd6, 0x33, 0xef, 0x39, 0x9f, 0x17, 0xd0, 0xd8, 0x0a, 0x49,
+	0x8f, 0x1b, 0x79, 0x2d, 0x40, 0xb1, 0xb7, 0x1b, 0x92, 0xcb, 0xa8, 0x6d, 0xd2, 0xae, 0x98, 0xe9,
+	0xd8, 0x47, 0x68, 0xeb, 0x03, 0xb9, 0x31, 0x1d, 0xa7, 0xaa, 0x69, 0x1c, 0xd7, 0x0d, 0xb4, 0x84,
+	0x09, 0xf4, 0x83, 0x00, 0xa7, 0x07, 0x97, 0x47, 0x52, 0x45, 0x38, 0x53, 0xed, 0x93, 0x99, 0x98,
+	0xb6, 0x10, 0xd3, 0xc7, 0xd5, 0xbb, 0x27, 0xc7, 0x54, 0x7f, 0xc9, 0xb7, 0xbc, 0x63, 0xf2, 0x8b,
+	0x00, 0x73, 0x7c, 0x57, 0xe0, 0x24, 0x4d, 0x5a, 0x54, 0xa4, 0xf7, 0x26, 0x89, 0xf1, 0x00, 0xed,
+	0x21, 0xc0, 0x4f, 0xe5, 0xed, 0x89, 0x00, 0xfb, 0x53, 0xb9, 0x96, 0x86, 0x1a, 0xaf, 0x43, 0x1e,
+	0x5f, 0x0b, 0x00, 0xb8, 0x9e, 0xa0, 0x31, 0x72, 0x15, 0x81, 0x4c, 0xb3, 0xaf, 0x64, 0x92, 0x78,
+	0x1b, 0x31, 0x36, 0xaa, 0x37, 0x4e, 0x40, 0x62, 0x44, 0xd6, 0x8f, 0x02, 0x14, 0xc3, 0xec, 0x8c,
+	0xa0, 0x54, 0x32, 0xb2, 0x75, 0x14, 0xc9, 0xd5, 0x29, 0x24, 0x39, 0x81, 0x1c, 0x1c, 0x39, 0x39,
+	0xb8, 0xdf, 0x05, 0x58, 0x8c, 0x9a, 0x5e, 0x72, 0x47, 0xa9, 0xa4, 0xb5, 0xc3, 0xb4, 0x66, 0x2f,
+	0x5d, 0x9d, 0x42, 0x92, 0xc3, 0xfc, 0x1a, 0x61, 0x7e, 0x29, 0xb7, 0xa6, 0x8c, 0x73, 0x3c, 0xb4,
+	0xb2, 0x63, 0x7d, 0x3d, 0x16, 0xc1, 0xea, 0xf9, 0x55, 0x00, 0xf2, 0xd0, 0x1c, 0xb2, 0xee, 0x93,
+	0x2b, 0xa3, 0x44, 0xa6, 0x0e, 0x63, 0xa9, 0x32, 0x59, 0x90, 0x7b, 0x72, 0x0f, 0x3d, 0xb9, 0x4b,
+	0xee, 0x9c, 0x94, 0xf0, 0x3e, 0x60, 0xf2, 0xb3, 0x00, 0x8b, 0x51, 0xef, 0x4c, 0xa3, 0x7e, 0x8a,
+	0x39, 0x9b, 0x99, 0xab, 0x3b, 0x88, 0xae, 0x59, 0xfd, 0xe4, 0xcd, 0xd1, 0x61, 0x0b, 0x7e, 0x52,
+	0x40, 0xc5, 0x37, 0xff, 0x1b, 0x00, 0xb6, 0x9d, 0xb3, 0x03, 0xd0, 0x13, 0x00, 0x00,
 }
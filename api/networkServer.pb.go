@@ -57,17 +57,22 @@ type NetworkServer struct {
 	// The frequency (Hz) of the gateway discovery 'ping'.
 	GatewayDiscoveryTxFrequency uint32 `protobuf:"varint,12,opt,name=gateway_discovery_tx_frequency,json=gatewayDiscoveryTXFrequency,proto3" json:"gateway_discovery_tx_frequency,omitempty"`
 	// The data-rate of the gateway discovery 'ping'.
-	GatewayDiscoveryDr   uint32   `protobuf:"varint,13,opt,name=gateway_discovery_dr,json=gatewayDiscoveryDR,proto3" json:"gateway_discovery_dr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	GatewayDiscoveryDr uint32 `protobuf:"varint,13,opt,name=gateway_discovery_dr,json=gatewayDiscoveryDR,proto3" json:"gateway_discovery_dr,omitempty"`
+	// Client certificate fingerprints (HEX encoded SHA-256) allowed to
+	// connect to the application-server API on behalf of this network-server.
+	// This is only used when client certificate pinning is enabled. Note
+	// that the routing-profile TLS certificate is always allowed.
+	ClientCertFingerprints []string `protobuf:"bytes,14,rep,name=client_cert_fingerprints,json=clientCertFingerprints,proto3" json:"client_cert_fingerprints,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *NetworkServer) Reset()         { *m = NetworkServer{} }
 func (m *NetworkServer) String() string { return proto.CompactTextString(m) }
 func (*NetworkServer) ProtoMessage()    {}
 func (*NetworkServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_networkServer_c5636b3563702496, []int{0}
+	return fileDescriptor_networkServer_038305dbf5600320, []int{0}
 }
 func (m *NetworkServer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkServer.Unmarshal(m, b)
@@ -178,6 +183,13 @@ func (m *NetworkServer) GetGatewayDiscoveryDr() uint32 {
 	return 0
 }
 
+func (m *NetworkServer) GetClientCertFingerprints() []string {
+	if m != nil {
+		return m.ClientCertFingerprints
+	}
+	return nil
+}
+
 type NetworkServerListItem struct {
 	// Network-server ID.
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -199,7 +211,7 @@ func (m *NetworkServerListItem) Reset()         { *m = NetworkServerListItem{} }
 func (m *NetworkServerListItem) String() string { return proto.CompactTextString(m) }
 func (*NetworkServerListItem) ProtoMessage()    {}
 func (*NetworkServerListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_networkServer_c5636b3563702496, []int{1}
+	return fileDescriptor_networkServer_038305dbf5600320, []int{1}
 }
 func (m *NetworkServerListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkServerListItem.Unmarshal(m, b)
@@ -266,7 +278,7 @@ func (m *CreateNetworkServerRequest) Reset()         { *m = CreateNetworkServerR
 func (m *CreateNetworkServerRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateNetworkServerRequest) ProtoMessage()    {}
 func (*CreateNetworkServerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_networkServer_c5636b3563702496, []int{2}
+	return fileDescriptor_networkServer_038305dbf5600320, []int{2}
 }
 func (m *CreateNetworkServerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateNetworkServerRequest.Unmarshal(m, b)
@@ -305,7 +317,7 @@ func (m *CreateNetworkServerResponse) Reset()         { *m = CreateNetworkServer
 func (m *CreateNetworkServerResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateNetworkServerResponse) ProtoMessage()    {}
 func (*CreateNetworkServerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_networkServer_c5636b3563702496, []int{3}
+	return fileDescriptor_networkServer_038305dbf5600320, []int{3}
 }
 func (m *CreateNetworkServerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateNetworkServerResponse.Unmarshal(m, b)
@@ -344,7 +356,7 @@ func (m *GetNetworkServerRequest) Reset()         { *m = GetNetworkServerRequest
 func (m *GetNetworkServerRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNetworkServerRequest) ProtoMessage()    {}
 func (*GetNetworkServerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_networkServer_c5636b3563702496, []int{4}
+	return fileDescriptor_networkServer_038305dbf5600320, []int{4}
 }
 func (m *GetNetworkServerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNetworkServerRequest.Unmarshal(m, b)
@@ -391,7 +403,7 @@ func (m *GetNetworkServerResponse) Reset()         { *m = GetNetworkServerRespon
 func (m *GetNetworkServerResponse) String() string { return proto.CompactTextString(m) }
 func (*GetNetworkServerResponse) ProtoMessage()    {}
 func (*GetNetworkServerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_networkServer_c5636b3563702496, []int{5}
+	return fileDescriptor_networkServer_038305dbf5600320, []int{5}
 }
 func (m *GetNetworkServerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNetworkServerResponse.Unmarshal(m, b)
@@ -458,7 +470,7 @@ func (m *UpdateNetworkServerRequest) Reset()         { *m = UpdateNetworkServerR
 func (m *UpdateNetworkServerRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateNetworkServerRequest) ProtoMessage()    {}
 func (*UpdateNetworkServerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_networkServer_c5636b3563702496, []int{6}
+	return fileDescriptor_networkServer_038305dbf5600320, []int{6}
 }
 func (m *UpdateNetworkServerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNetworkServerRequest.Unmarshal(m, b)
@@ -497,7 +509,7 @@ func (m *DeleteNetworkServerRequest) Reset()         { *m = DeleteNetworkServerR
 func (m *DeleteNetworkServerRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteNetworkServerRequest) ProtoMessage()    {}
 func (*DeleteNetworkServerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_networkServer_c5636b3563702496, []int{7}
+	return fileDescriptor_networkServer_038305dbf5600320, []int{7}
 }
 func (m *DeleteNetworkServerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteNetworkServerRequest.Unmarshal(m, b)
@@ -540,7 +552,7 @@ func (m *ListNetworkServerRequest) Reset()         { *m = ListNetworkServerReque
 func (m *ListNetworkServerRequest) String() string { return proto.CompactTextString(m) }
 func (*ListNetworkServerRequest) ProtoMessage()    {}
 func (*ListNetworkServerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_networkServer_c5636b3563702496, []int{8}
+	return fileDescriptor_networkServer_038305dbf5600320, []int{8}
 }
 func (m *ListNetworkServerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListNetworkServerRequest.Unmarshal(m, b)
@@ -595,7 +607,7 @@ func (m *ListNetworkServerResponse) Reset()         { *m = ListNetworkServerResp
 func (m *ListNetworkServerResponse) String() string { return proto.CompactTextString(m) }
 func (*ListNetworkServerResponse) ProtoMessage()    {}
 func (*ListNetworkServerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_networkServer_c5636b3563702496, []int{9}
+	return fileDescriptor_networkServer_038305dbf5600320, []int{9}
 }
 func (m *ListNetworkServerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListNetworkServerResponse.Unmarshal(m, b)
@@ -856,59 +868,61 @@ var _NetworkServerService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "networkServer.proto",
 }
 
-func init() { proto.RegisterFile("networkServer.proto", fileDescriptor_networkServer_c5636b3563702496) }
+func init() { proto.RegisterFile("networkServer.proto", fileDescriptor_networkServer_038305dbf5600320) }
 
-var fileDescriptor_networkServer_c5636b3563702496 = []byte{
-	// 810 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_networkServer_038305dbf5600320 = []byte{
+	// 840 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
 	0x14, 0x96, 0xe3, 0x34, 0x6d, 0x4f, 0x69, 0x91, 0x86, 0x6c, 0xe3, 0xb8, 0x65, 0x1b, 0x7c, 0x43,
-	0x58, 0xd1, 0x04, 0x75, 0x85, 0x10, 0x2b, 0x6e, 0xaa, 0x64, 0x59, 0x55, 0x54, 0x08, 0xb9, 0x41,
-	0x70, 0x67, 0x4d, 0xed, 0x93, 0x68, 0xb4, 0x8e, 0xc7, 0x3b, 0x9e, 0x64, 0x09, 0x68, 0x6f, 0x78,
-	0x05, 0xde, 0x03, 0xf1, 0x1a, 0x5c, 0xf3, 0x0a, 0xbc, 0x06, 0x12, 0x9a, 0x1f, 0xa3, 0x8d, 0x63,
-	0x83, 0x58, 0xb8, 0x89, 0x32, 0xfe, 0xbe, 0xef, 0x7c, 0x33, 0xe7, 0x7c, 0x63, 0xc3, 0x3b, 0x19,
-	0xca, 0x97, 0x5c, 0x3c, 0xbf, 0x43, 0xb1, 0x46, 0x31, 0xca, 0x05, 0x97, 0x9c, 0xb8, 0x34, 0x67,
-	0xfe, 0xf9, 0x82, 0xf3, 0x45, 0x8a, 0x63, 0x9a, 0xb3, 0x31, 0xcd, 0x32, 0x2e, 0xa9, 0x64, 0x3c,
-	0x2b, 0x0c, 0xc5, 0xbf, 0xb0, 0xa8, 0x5e, 0xdd, 0xaf, 0xe6, 0x63, 0xc9, 0x96, 0x58, 0x48, 0xba,
-	0xcc, 0x2d, 0xe1, 0xac, 0x4a, 0xc0, 0x65, 0x2e, 0x37, 0x06, 0x0c, 0x7e, 0x69, 0xc3, 0xf1, 0x97,
-	0xaf, 0x1b, 0x93, 0x13, 0x68, 0xb1, 0xc4, 0x73, 0x06, 0xce, 0xd0, 0x0d, 0x5b, 0x2c, 0x21, 0x04,
-	0xda, 0x19, 0x5d, 0xa2, 0xd7, 0x1a, 0x38, 0xc3, 0xc3, 0x50, 0xff, 0x27, 0xa7, 0xd0, 0x29, 0x34,
-	0xdb, 0x73, 0xf5, 0x53, 0xbb, 0x22, 0x3d, 0xd8, 0x8f, 0x69, 0x14, 0xa3, 0x90, 0x5e, 0xdb, 0x00,
-	0x31, 0x9d, 0xa0, 0x90, 0xa4, 0x0f, 0x07, 0x32, 0x2d, 0x0c, 0xb2, 0xa7, 0x91, 0x7d, 0x99, 0x16,
-	0x1a, 0xea, 0x81, 0xfa, 0x1b, 0x3d, 0xc7, 0x8d, 0xd7, 0x31, 0x1a, 0x99, 0x16, 0x5f, 0xe0, 0x86,
-	0x7c, 0x0c, 0x3d, 0xc1, 0x57, 0x92, 0x65, 0x8b, 0x28, 0x17, 0x7c, 0xce, 0x52, 0x8c, 0xca, 0xe2,
-	0xfb, 0x9a, 0xd8, 0xb5, 0xf0, 0x57, 0x06, 0x9d, 0x5c, 0xeb, 0x7a, 0x9f, 0x80, 0x57, 0x95, 0xfd,
-	0x65, 0x7d, 0xa0, 0x75, 0x0f, 0xb6, 0x75, 0xb3, 0xdb, 0x3b, 0x2d, 0xac, 0xf1, 0x2b, 0x37, 0x76,
-	0x58, 0xe7, 0x37, 0xbb, 0xbd, 0x53, 0xdb, 0x7c, 0x02, 0xfd, 0x05, 0x95, 0xf8, 0x92, 0x6e, 0xa2,
-	0x84, 0x15, 0x31, 0x5f, 0xa3, 0xd8, 0x44, 0x98, 0xd1, 0xfb, 0x14, 0x13, 0x0f, 0x06, 0xce, 0xf0,
-	0x20, 0xec, 0x59, 0xc2, 0xb4, 0xc4, 0x9f, 0x1a, 0x98, 0x7c, 0x06, 0xfe, 0xae, 0x96, 0x65, 0x12,
-	0xc5, 0x9a, 0xa6, 0xde, 0xd1, 0xc0, 0x19, 0x1e, 0x87, 0x5e, 0x55, 0x7c, 0x63, 0x71, 0x32, 0x81,
-	0x87, 0xbb, 0x6a, 0xf9, 0x5d, 0x34, 0x17, 0xf8, 0x62, 0x85, 0x59, 0xbc, 0xf1, 0xde, 0xd2, 0x15,
-	0xce, 0xaa, 0x15, 0x66, 0xdf, 0x7e, 0x5e, 0x52, 0xc8, 0x47, 0xd0, 0xdd, 0x2d, 0x92, 0x08, 0xef,
-	0x58, 0x4b, 0x49, 0x55, 0x3a, 0x0d, 0x83, 0x5f, 0x1d, 0x78, 0xb0, 0x15, 0x99, 0x5b, 0x56, 0xc8,
-	0x1b, 0x89, 0xcb, 0xff, 0x14, 0x9d, 0x4f, 0x01, 0x62, 0x81, 0x54, 0x62, 0x12, 0x51, 0x93, 0x9e,
-	0xa3, 0x2b, 0x7f, 0x64, 0xa2, 0x3b, 0x2a, 0xa3, 0x3b, 0x9a, 0x95, 0xd9, 0x0e, 0x0f, 0x2d, 0xfb,
-	0x5a, 0x2a, 0xe9, 0x2a, 0x4f, 0x4a, 0xe9, 0xde, 0x3f, 0x4b, 0x2d, 0xfb, 0x5a, 0x06, 0xdf, 0x80,
-	0x3f, 0xd1, 0x75, 0xb6, 0x0e, 0x14, 0xaa, 0xe6, 0x14, 0xaa, 0xf0, 0x89, 0xbd, 0x94, 0x91, 0xdd,
-	0xb3, 0xa3, 0x8b, 0x93, 0x11, 0xcd, 0xd9, 0x68, 0x5b, 0x72, 0xbc, 0x75, 0x7d, 0x83, 0x4b, 0x38,
-	0xab, 0x2d, 0x5c, 0xe4, 0x3c, 0x2b, 0xb0, 0xda, 0xa9, 0xe0, 0x03, 0xe8, 0x3d, 0x43, 0x59, 0xbb,
-	0x89, 0x2a, 0xf5, 0x0f, 0x07, 0xbc, 0x5d, 0xae, 0xad, 0xfb, 0xe6, 0x3b, 0xae, 0x0c, 0xa0, 0xf5,
-	0xe6, 0x03, 0x70, 0xff, 0xc5, 0x00, 0x88, 0x07, 0xfb, 0x6b, 0x14, 0x05, 0xe3, 0x99, 0x7d, 0x63,
-	0x94, 0x4b, 0x15, 0x14, 0x81, 0x0b, 0x05, 0x98, 0x17, 0x86, 0x5d, 0xa9, 0x91, 0x7d, 0xad, 0xe5,
-	0xff, 0xf7, 0xc8, 0x3e, 0x04, 0x7f, 0x8a, 0x29, 0x36, 0x14, 0xae, 0x8e, 0xe1, 0x05, 0x78, 0x2a,
-	0xf7, 0xb5, 0xdc, 0x2e, 0xec, 0xa5, 0x6c, 0xc9, 0xa4, 0xa5, 0x9b, 0x85, 0x3a, 0x10, 0x9f, 0xcf,
-	0x0b, 0x34, 0xcd, 0x75, 0x43, 0xbb, 0x22, 0xef, 0xc3, 0xdb, 0x5c, 0x2c, 0x68, 0xc6, 0xbe, 0xd7,
-	0xef, 0xf5, 0x88, 0x25, 0xba, 0x85, 0x6e, 0x78, 0xf2, 0xfa, 0xe3, 0x9b, 0x69, 0x90, 0x43, 0xbf,
-	0xc6, 0xd2, 0x4e, 0xfe, 0x02, 0x8e, 0x24, 0x97, 0x34, 0x8d, 0x62, 0xbe, 0xca, 0x4a, 0x67, 0xd0,
-	0x8f, 0x26, 0xea, 0x09, 0xb9, 0x52, 0xfd, 0x2c, 0x56, 0xa9, 0xb2, 0x77, 0xf5, 0x80, 0x76, 0x3a,
-	0x52, 0x5e, 0xe4, 0xd0, 0x32, 0xaf, 0x7e, 0x6e, 0x43, 0x77, 0x8b, 0xa1, 0x7e, 0x59, 0x8c, 0x24,
-	0x85, 0x8e, 0x89, 0x37, 0xb9, 0xd0, 0x65, 0x9a, 0x2f, 0x91, 0x3f, 0x68, 0x26, 0x98, 0xad, 0x07,
-	0x17, 0x3f, 0xfe, 0xf6, 0xfb, 0x4f, 0xad, 0x7e, 0xd0, 0xd5, 0x5f, 0x38, 0x3b, 0x94, 0x4b, 0x33,
-	0xbe, 0xe2, 0x89, 0xf3, 0x88, 0x20, 0xb8, 0xcf, 0x50, 0x92, 0x73, 0x5d, 0xa9, 0xe1, 0x9e, 0xf8,
-	0xef, 0x36, 0xa0, 0xd6, 0xe4, 0x3d, 0x6d, 0x72, 0x46, 0xfa, 0x75, 0x26, 0xe3, 0x1f, 0x58, 0xf2,
-	0x8a, 0xac, 0xa1, 0x63, 0x92, 0x65, 0x0f, 0xd5, 0x1c, 0x33, 0xff, 0x74, 0x27, 0xdd, 0x4f, 0xd5,
-	0x47, 0x35, 0x78, 0xac, 0x5d, 0x2e, 0xfd, 0x61, 0xbd, 0xcb, 0x76, 0x34, 0x47, 0x2c, 0x79, 0xa5,
-	0x8e, 0x97, 0x40, 0xc7, 0x04, 0xcf, 0xfa, 0x36, 0xa7, 0xb0, 0xd1, 0xd7, 0x9e, 0xee, 0xd1, 0xdf,
-	0x9c, 0x2e, 0x86, 0xb6, 0x9a, 0x2f, 0x31, 0x7d, 0x6a, 0xca, 0xae, 0xff, 0xb0, 0x09, 0xb6, 0x7d,
-	0x3c, 0xd7, 0x4e, 0xa7, 0xa4, 0x76, 0x58, 0xf7, 0x1d, 0xbd, 0xaf, 0xc7, 0x7f, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0x2f, 0x2e, 0xd5, 0xe2, 0xcd, 0x08, 0x00, 0x00,
+	0x58, 0xd1, 0x14, 0x75, 0x85, 0x80, 0x15, 0x37, 0x55, 0xba, 0xbb, 0xaa, 0xa8, 0x10, 0x72, 0x83,
+	0xe0, 0xce, 0x9a, 0xda, 0x27, 0xd1, 0x68, 0x1d, 0xdb, 0x3b, 0x33, 0xc9, 0x12, 0xd0, 0xde, 0xf0,
+	0x0a, 0xbc, 0x07, 0xef, 0xc1, 0x35, 0xaf, 0xc0, 0x15, 0xef, 0x80, 0x84, 0xe6, 0xc7, 0xa8, 0x71,
+	0x6c, 0x7e, 0xca, 0xde, 0x44, 0x19, 0x7f, 0xdf, 0x77, 0xbe, 0x99, 0x73, 0xbe, 0xb1, 0xe1, 0x9d,
+	0x0c, 0xe5, 0xab, 0x9c, 0xbf, 0xb8, 0x41, 0xbe, 0x44, 0x3e, 0x2a, 0x78, 0x2e, 0x73, 0xe2, 0xd2,
+	0x82, 0xf9, 0xc7, 0xb3, 0x3c, 0x9f, 0xa5, 0x78, 0x46, 0x0b, 0x76, 0x46, 0xb3, 0x2c, 0x97, 0x54,
+	0xb2, 0x3c, 0x13, 0x86, 0xe2, 0x9f, 0x58, 0x54, 0xaf, 0x6e, 0x17, 0xd3, 0x33, 0xc9, 0xe6, 0x28,
+	0x24, 0x9d, 0x17, 0x96, 0x70, 0x54, 0x25, 0xe0, 0xbc, 0x90, 0x2b, 0x03, 0x06, 0xbf, 0xb7, 0x61,
+	0xff, 0xcb, 0xbb, 0xc6, 0xe4, 0x00, 0x5a, 0x2c, 0xf1, 0x9c, 0x81, 0x33, 0x74, 0xc3, 0x16, 0x4b,
+	0x08, 0x81, 0x76, 0x46, 0xe7, 0xe8, 0xb5, 0x06, 0xce, 0x70, 0x37, 0xd4, 0xff, 0xc9, 0x21, 0x74,
+	0x84, 0x66, 0x7b, 0xae, 0x7e, 0x6a, 0x57, 0xa4, 0x07, 0xdb, 0x31, 0x8d, 0x62, 0xe4, 0xd2, 0x6b,
+	0x1b, 0x20, 0xa6, 0x63, 0xe4, 0x92, 0xf4, 0x61, 0x47, 0xa6, 0xc2, 0x20, 0x5b, 0x1a, 0xd9, 0x96,
+	0xa9, 0xd0, 0x50, 0x0f, 0xd4, 0xdf, 0xe8, 0x05, 0xae, 0xbc, 0x8e, 0xd1, 0xc8, 0x54, 0x7c, 0x81,
+	0x2b, 0xf2, 0x31, 0xf4, 0x78, 0xbe, 0x90, 0x2c, 0x9b, 0x45, 0x05, 0xcf, 0xa7, 0x2c, 0xc5, 0xa8,
+	0x2c, 0xbe, 0xad, 0x89, 0x5d, 0x0b, 0x7f, 0x65, 0xd0, 0xf1, 0x85, 0xae, 0xf7, 0x09, 0x78, 0x55,
+	0xd9, 0x5f, 0xd6, 0x3b, 0x5a, 0xf7, 0x60, 0x5d, 0x37, 0xb9, 0xbe, 0xd1, 0xc2, 0x1a, 0xbf, 0x72,
+	0x63, 0xbb, 0x75, 0x7e, 0x93, 0xeb, 0x1b, 0xb5, 0xcd, 0x27, 0xd0, 0x9f, 0x51, 0x89, 0xaf, 0xe8,
+	0x2a, 0x4a, 0x98, 0x88, 0xf3, 0x25, 0xf2, 0x55, 0x84, 0x19, 0xbd, 0x4d, 0x31, 0xf1, 0x60, 0xe0,
+	0x0c, 0x77, 0xc2, 0x9e, 0x25, 0x5c, 0x96, 0xf8, 0x53, 0x03, 0x93, 0xcf, 0xc1, 0xdf, 0xd4, 0xb2,
+	0x4c, 0x22, 0x5f, 0xd2, 0xd4, 0xdb, 0x1b, 0x38, 0xc3, 0xfd, 0xd0, 0xab, 0x8a, 0xaf, 0x2c, 0x4e,
+	0xc6, 0xf0, 0x70, 0x53, 0x2d, 0xbf, 0x8b, 0xa6, 0x1c, 0x5f, 0x2e, 0x30, 0x8b, 0x57, 0xde, 0x5b,
+	0xba, 0xc2, 0x51, 0xb5, 0xc2, 0xe4, 0xdb, 0x67, 0x25, 0x85, 0x7c, 0x04, 0xdd, 0xcd, 0x22, 0x09,
+	0xf7, 0xf6, 0xb5, 0x94, 0x54, 0xa5, 0x97, 0x21, 0xf9, 0x14, 0xbc, 0x38, 0x65, 0x98, 0x49, 0xdd,
+	0xd3, 0x68, 0xca, 0xb2, 0x19, 0xf2, 0x82, 0xb3, 0x4c, 0x0a, 0xef, 0x60, 0xe0, 0x0e, 0x77, 0xc3,
+	0x43, 0x83, 0xab, 0xae, 0x3e, 0xbb, 0x83, 0x06, 0xbf, 0x38, 0xf0, 0x60, 0x2d, 0x6c, 0xd7, 0x4c,
+	0xc8, 0x2b, 0x89, 0xf3, 0xff, 0x15, 0xba, 0xcf, 0x00, 0x62, 0x8e, 0x54, 0x62, 0x12, 0x51, 0x93,
+	0xbb, 0xbd, 0x73, 0x7f, 0x64, 0x42, 0x3f, 0x2a, 0x43, 0x3f, 0x9a, 0x94, 0xb7, 0x22, 0xdc, 0xb5,
+	0xec, 0x0b, 0xa9, 0xa4, 0x8b, 0x22, 0x29, 0xa5, 0x5b, 0xff, 0x2c, 0xb5, 0xec, 0x0b, 0x19, 0x7c,
+	0x03, 0xfe, 0x58, 0xd7, 0x59, 0x3b, 0x50, 0xa8, 0xda, 0x2a, 0x54, 0xe1, 0x03, 0x7b, 0x9d, 0x23,
+	0xbb, 0x67, 0x47, 0x17, 0x27, 0x23, 0x5a, 0xb0, 0xd1, 0xba, 0x64, 0x7f, 0xed, 0xe2, 0x07, 0xa7,
+	0x70, 0x54, 0x5b, 0x58, 0x14, 0x79, 0x26, 0xb0, 0xda, 0xa9, 0xe0, 0x03, 0xe8, 0x3d, 0x47, 0x59,
+	0xbb, 0x89, 0x2a, 0xf5, 0x0f, 0x07, 0xbc, 0x4d, 0xae, 0xad, 0x7b, 0xff, 0x1d, 0x57, 0x06, 0xd0,
+	0xba, 0xff, 0x00, 0xdc, 0xff, 0x30, 0x00, 0xe2, 0xc1, 0xf6, 0x12, 0xb9, 0x60, 0x79, 0x66, 0xdf,
+	0x35, 0xe5, 0x52, 0x05, 0x85, 0xe3, 0x4c, 0x01, 0xe6, 0x55, 0x63, 0x57, 0x6a, 0x64, 0x5f, 0x6b,
+	0xf9, 0x9b, 0x1e, 0xd9, 0x87, 0xe0, 0x5f, 0x62, 0x8a, 0x12, 0xff, 0xd5, 0x18, 0x5e, 0x82, 0xa7,
+	0x72, 0x5f, 0xcb, 0xed, 0xc2, 0x56, 0xca, 0xe6, 0x4c, 0x5a, 0xba, 0x59, 0xa8, 0x03, 0xe5, 0xd3,
+	0xa9, 0x40, 0xd3, 0x5c, 0x37, 0xb4, 0x2b, 0xf2, 0x3e, 0xbc, 0x9d, 0xf3, 0x19, 0xcd, 0xd8, 0xf7,
+	0xfa, 0x8b, 0x10, 0xb1, 0x44, 0xb7, 0xd0, 0x0d, 0x0f, 0xee, 0x3e, 0xbe, 0xba, 0x0c, 0x0a, 0xe8,
+	0xd7, 0x58, 0xda, 0xc9, 0x9f, 0xc0, 0x9e, 0xcc, 0x25, 0x4d, 0xa3, 0x38, 0x5f, 0x64, 0xa5, 0x33,
+	0xe8, 0x47, 0x63, 0xf5, 0x84, 0x9c, 0xab, 0x7e, 0x8a, 0x45, 0xaa, 0xec, 0x5d, 0x3d, 0xa0, 0x8d,
+	0x8e, 0x94, 0x17, 0x39, 0xb4, 0xcc, 0xf3, 0x9f, 0xdb, 0xd0, 0x5d, 0x63, 0xa8, 0x5f, 0x16, 0x23,
+	0x49, 0xa1, 0x63, 0xe2, 0x4d, 0x4e, 0x74, 0x99, 0xe6, 0x4b, 0xe4, 0x0f, 0x9a, 0x09, 0x66, 0xeb,
+	0xc1, 0xc9, 0x8f, 0xbf, 0xfe, 0xf6, 0x53, 0xab, 0x1f, 0x74, 0xf5, 0xb7, 0xd1, 0x0e, 0xe5, 0xd4,
+	0x8c, 0x4f, 0x3c, 0x71, 0x1e, 0x11, 0x04, 0xf7, 0x39, 0x4a, 0x72, 0xac, 0x2b, 0x35, 0xdc, 0x13,
+	0xff, 0xdd, 0x06, 0xd4, 0x9a, 0xbc, 0xa7, 0x4d, 0x8e, 0x48, 0xbf, 0xce, 0xe4, 0xec, 0x07, 0x96,
+	0xbc, 0x26, 0x4b, 0xe8, 0x98, 0x64, 0xd9, 0x43, 0x35, 0xc7, 0xcc, 0x3f, 0xdc, 0x48, 0xf7, 0x53,
+	0xf5, 0x39, 0x0e, 0x1e, 0x6b, 0x97, 0x53, 0x7f, 0x58, 0xef, 0xb2, 0x1e, 0xcd, 0x11, 0x4b, 0x5e,
+	0xab, 0xe3, 0x25, 0xd0, 0x31, 0xc1, 0xb3, 0xbe, 0xcd, 0x29, 0x6c, 0xf4, 0xb5, 0xa7, 0x7b, 0xf4,
+	0x37, 0xa7, 0x8b, 0xa1, 0xad, 0xe6, 0x4b, 0x4c, 0x9f, 0x9a, 0xb2, 0xeb, 0x3f, 0x6c, 0x82, 0x6d,
+	0x1f, 0x8f, 0xb5, 0xd3, 0x21, 0xa9, 0x1d, 0xd6, 0x6d, 0x47, 0xef, 0xeb, 0xf1, 0x9f, 0x03, 0x00,
+	0xb1, 0xcf, 0x15, 0xd9, 0x07, 0x09, 0x00, 0x00,
 }
@@ -44,7 +44,7 @@ func (m *Organization) Reset()         { *m = Organization{} }
 func (m *Organization) String() string { return proto.CompactTextString(m) }
 func (*Organization) ProtoMessage()    {}
 func (*Organization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{0}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{0}
 }
 func (m *Organization) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Organization.Unmarshal(m, b)
@@ -114,7 +114,7 @@ func (m *OrganizationListItem) Reset()         { *m = OrganizationListItem{} }
 func (m *OrganizationListItem) String() string { return proto.CompactTextString(m) }
 func (*OrganizationListItem) ProtoMessage()    {}
 func (*OrganizationListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{1}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{1}
 }
 func (m *OrganizationListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrganizationListItem.Unmarshal(m, b)
@@ -188,7 +188,7 @@ func (m *GetOrganizationRequest) Reset()         { *m = GetOrganizationRequest{}
 func (m *GetOrganizationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOrganizationRequest) ProtoMessage()    {}
 func (*GetOrganizationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{2}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{2}
 }
 func (m *GetOrganizationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetOrganizationRequest.Unmarshal(m, b)
@@ -231,7 +231,7 @@ func (m *GetOrganizationResponse) Reset()         { *m = GetOrganizationResponse
 func (m *GetOrganizationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetOrganizationResponse) ProtoMessage()    {}
 func (*GetOrganizationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{3}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{3}
 }
 func (m *GetOrganizationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetOrganizationResponse.Unmarshal(m, b)
@@ -284,7 +284,7 @@ func (m *CreateOrganizationRequest) Reset()         { *m = CreateOrganizationReq
 func (m *CreateOrganizationRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateOrganizationRequest) ProtoMessage()    {}
 func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{4}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{4}
 }
 func (m *CreateOrganizationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateOrganizationRequest.Unmarshal(m, b)
@@ -323,7 +323,7 @@ func (m *CreateOrganizationResponse) Reset()         { *m = CreateOrganizationRe
 func (m *CreateOrganizationResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateOrganizationResponse) ProtoMessage()    {}
 func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{5}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{5}
 }
 func (m *CreateOrganizationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateOrganizationResponse.Unmarshal(m, b)
@@ -362,7 +362,7 @@ func (m *UpdateOrganizationRequest) Reset()         { *m = UpdateOrganizationReq
 func (m *UpdateOrganizationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateOrganizationRequest) ProtoMessage()    {}
 func (*UpdateOrganizationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{6}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{6}
 }
 func (m *UpdateOrganizationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateOrganizationRequest.Unmarshal(m, b)
@@ -401,7 +401,7 @@ func (m *DeleteOrganizationRequest) Reset()         { *m = DeleteOrganizationReq
 func (m *DeleteOrganizationRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteOrganizationRequest) ProtoMessage()    {}
 func (*DeleteOrganizationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{7}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{7}
 }
 func (m *DeleteOrganizationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteOrganizationRequest.Unmarshal(m, b)
@@ -445,7 +445,7 @@ func (m *ListOrganizationRequest) Reset()         { *m = ListOrganizationRequest
 func (m *ListOrganizationRequest) String() string { return proto.CompactTextString(m) }
 func (*ListOrganizationRequest) ProtoMessage()    {}
 func (*ListOrganizationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{8}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{8}
 }
 func (m *ListOrganizationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListOrganizationRequest.Unmarshal(m, b)
@@ -499,7 +499,7 @@ func (m *ListOrganizationResponse) Reset()         { *m = ListOrganizationRespon
 func (m *ListOrganizationResponse) String() string { return proto.CompactTextString(m) }
 func (*ListOrganizationResponse) ProtoMessage()    {}
 func (*ListOrganizationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{9}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{9}
 }
 func (m *ListOrganizationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListOrganizationResponse.Unmarshal(m, b)
@@ -551,7 +551,7 @@ func (m *OrganizationUser) Reset()         { *m = OrganizationUser{} }
 func (m *OrganizationUser) String() string { return proto.CompactTextString(m) }
 func (*OrganizationUser) ProtoMessage()    {}
 func (*OrganizationUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{10}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{10}
 }
 func (m *OrganizationUser) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrganizationUser.Unmarshal(m, b)
@@ -619,7 +619,7 @@ func (m *OrganizationUserListItem) Reset()         { *m = OrganizationUserListIt
 func (m *OrganizationUserListItem) String() string { return proto.CompactTextString(m) }
 func (*OrganizationUserListItem) ProtoMessage()    {}
 func (*OrganizationUserListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{11}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{11}
 }
 func (m *OrganizationUserListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrganizationUserListItem.Unmarshal(m, b)
@@ -686,7 +686,7 @@ func (m *AddOrganizationUserRequest) Reset()         { *m = AddOrganizationUserR
 func (m *AddOrganizationUserRequest) String() string { return proto.CompactTextString(m) }
 func (*AddOrganizationUserRequest) ProtoMessage()    {}
 func (*AddOrganizationUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{12}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{12}
 }
 func (m *AddOrganizationUserRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddOrganizationUserRequest.Unmarshal(m, b)
@@ -725,7 +725,7 @@ func (m *UpdateOrganizationUserRequest) Reset()         { *m = UpdateOrganizatio
 func (m *UpdateOrganizationUserRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateOrganizationUserRequest) ProtoMessage()    {}
 func (*UpdateOrganizationUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{13}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{13}
 }
 func (m *UpdateOrganizationUserRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateOrganizationUserRequest.Unmarshal(m, b)
@@ -766,7 +766,7 @@ func (m *DeleteOrganizationUserRequest) Reset()         { *m = DeleteOrganizatio
 func (m *DeleteOrganizationUserRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteOrganizationUserRequest) ProtoMessage()    {}
 func (*DeleteOrganizationUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{14}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{14}
 }
 func (m *DeleteOrganizationUserRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteOrganizationUserRequest.Unmarshal(m, b)
@@ -816,7 +816,7 @@ func (m *ListOrganizationUsersRequest) Reset()         { *m = ListOrganizationUs
 func (m *ListOrganizationUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListOrganizationUsersRequest) ProtoMessage()    {}
 func (*ListOrganizationUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{15}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{15}
 }
 func (m *ListOrganizationUsersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListOrganizationUsersRequest.Unmarshal(m, b)
@@ -870,7 +870,7 @@ func (m *ListOrganizationUsersResponse) Reset()         { *m = ListOrganizationU
 func (m *ListOrganizationUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListOrganizationUsersResponse) ProtoMessage()    {}
 func (*ListOrganizationUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{16}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{16}
 }
 func (m *ListOrganizationUsersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListOrganizationUsersResponse.Unmarshal(m, b)
@@ -918,7 +918,7 @@ func (m *GetOrganizationUserRequest) Reset()         { *m = GetOrganizationUserR
 func (m *GetOrganizationUserRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOrganizationUserRequest) ProtoMessage()    {}
 func (*GetOrganizationUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{17}
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{17}
 }
 func (m *GetOrganizationUserRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetOrganizationUserRequest.Unmarshal(m, b)
@@ -952,6 +952,189 @@ func (m *GetOrganizationUserRequest) GetUserId() int64 {
 	return 0
 }
 
+type BatchUpdateOrganizationUsersRequest struct {
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Users to add to or to update within the organization. A user is
+	// referenced by user_id or, when not set, by username.
+	Users []*OrganizationUser `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	// IDs of the users to remove from the organization.
+	RemoveUserIds []int64 `protobuf:"varint,3,rep,packed,name=remove_user_ids,json=removeUserIDs,proto3" json:"remove_user_ids,omitempty"`
+	// Remove the organization users which are not in users.
+	RemoveMissing        bool     `protobuf:"varint,4,opt,name=remove_missing,json=removeMissing,proto3" json:"remove_missing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchUpdateOrganizationUsersRequest) Reset()         { *m = BatchUpdateOrganizationUsersRequest{} }
+func (m *BatchUpdateOrganizationUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateOrganizationUsersRequest) ProtoMessage()    {}
+func (*BatchUpdateOrganizationUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{18}
+}
+func (m *BatchUpdateOrganizationUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateOrganizationUsersRequest.Unmarshal(m, b)
+}
+func (m *BatchUpdateOrganizationUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateOrganizationUsersRequest.Marshal(b, m, deterministic)
+}
+func (dst *BatchUpdateOrganizationUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateOrganizationUsersRequest.Merge(dst, src)
+}
+func (m *BatchUpdateOrganizationUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateOrganizationUsersRequest.Size(m)
+}
+func (m *BatchUpdateOrganizationUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateOrganizationUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchUpdateOrganizationUsersRequest proto.InternalMessageInfo
+
+func (m *BatchUpdateOrganizationUsersRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *BatchUpdateOrganizationUsersRequest) GetUsers() []*OrganizationUser {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+func (m *BatchUpdateOrganizationUsersRequest) GetRemoveUserIds() []int64 {
+	if m != nil {
+		return m.RemoveUserIds
+	}
+	return nil
+}
+
+func (m *BatchUpdateOrganizationUsersRequest) GetRemoveMissing() bool {
+	if m != nil {
+		return m.RemoveMissing
+	}
+	return false
+}
+
+type ImportOrganizationUsersRequest struct {
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// CSV document. The first row must contain the column names. The
+	// username column is required, the is_admin column (true / false) is
+	// optional.
+	Csv string `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	// Remove the organization users which are not in the CSV document.
+	RemoveMissing        bool     `protobuf:"varint,3,opt,name=remove_missing,json=removeMissing,proto3" json:"remove_missing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportOrganizationUsersRequest) Reset()         { *m = ImportOrganizationUsersRequest{} }
+func (m *ImportOrganizationUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportOrganizationUsersRequest) ProtoMessage()    {}
+func (*ImportOrganizationUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{19}
+}
+func (m *ImportOrganizationUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportOrganizationUsersRequest.Unmarshal(m, b)
+}
+func (m *ImportOrganizationUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportOrganizationUsersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportOrganizationUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportOrganizationUsersRequest.Merge(dst, src)
+}
+func (m *ImportOrganizationUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportOrganizationUsersRequest.Size(m)
+}
+func (m *ImportOrganizationUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportOrganizationUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportOrganizationUsersRequest proto.InternalMessageInfo
+
+func (m *ImportOrganizationUsersRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *ImportOrganizationUsersRequest) GetCsv() string {
+	if m != nil {
+		return m.Csv
+	}
+	return ""
+}
+
+func (m *ImportOrganizationUsersRequest) GetRemoveMissing() bool {
+	if m != nil {
+		return m.RemoveMissing
+	}
+	return false
+}
+
+type BatchUpdateOrganizationUsersResponse struct {
+	// Number of users added to the organization.
+	AddedCount uint32 `protobuf:"varint,1,opt,name=added_count,json=addedCount,proto3" json:"added_count,omitempty"`
+	// Number of updated organization users.
+	UpdatedCount uint32 `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	// Number of users removed from the organization.
+	RemovedCount         uint32   `protobuf:"varint,3,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchUpdateOrganizationUsersResponse) Reset()         { *m = BatchUpdateOrganizationUsersResponse{} }
+func (m *BatchUpdateOrganizationUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateOrganizationUsersResponse) ProtoMessage()    {}
+func (*BatchUpdateOrganizationUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{20}
+}
+func (m *BatchUpdateOrganizationUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateOrganizationUsersResponse.Unmarshal(m, b)
+}
+func (m *BatchUpdateOrganizationUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateOrganizationUsersResponse.Marshal(b, m, deterministic)
+}
+func (dst *BatchUpdateOrganizationUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateOrganizationUsersResponse.Merge(dst, src)
+}
+func (m *BatchUpdateOrganizationUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateOrganizationUsersResponse.Size(m)
+}
+func (m *BatchUpdateOrganizationUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateOrganizationUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchUpdateOrganizationUsersResponse proto.InternalMessageInfo
+
+func (m *BatchUpdateOrganizationUsersResponse) GetAddedCount() uint32 {
+	if m != nil {
+		return m.AddedCount
+	}
+	return 0
+}
+
+func (m *BatchUpdateOrganizationUsersResponse) GetUpdatedCount() uint32 {
+	if m != nil {
+		return m.UpdatedCount
+	}
+	return 0
+}
+
+func (m *BatchUpdateOrganizationUsersResponse) GetRemovedCount() uint32 {
+	if m != nil {
+		return m.RemovedCount
+	}
+	return 0
+}
+
 // Response for a user in the organization
 type GetOrganizationUserResponse struct {
 	// Organization-user object.
@@ -965,51 +1148,1276 @@ type GetOrganizationUserResponse struct {
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *GetOrganizationUserResponse) Reset()         { *m = GetOrganizationUserResponse{} }
-func (m *GetOrganizationUserResponse) String() string { return proto.CompactTextString(m) }
-func (*GetOrganizationUserResponse) ProtoMessage()    {}
-func (*GetOrganizationUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_organization_f7d28f2f8c3cdcd6, []int{18}
+func (m *GetOrganizationUserResponse) Reset()         { *m = GetOrganizationUserResponse{} }
+func (m *GetOrganizationUserResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrganizationUserResponse) ProtoMessage()    {}
+func (*GetOrganizationUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{21}
+}
+func (m *GetOrganizationUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrganizationUserResponse.Unmarshal(m, b)
+}
+func (m *GetOrganizationUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrganizationUserResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetOrganizationUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrganizationUserResponse.Merge(dst, src)
+}
+func (m *GetOrganizationUserResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrganizationUserResponse.Size(m)
+}
+func (m *GetOrganizationUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrganizationUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrganizationUserResponse proto.InternalMessageInfo
+
+func (m *GetOrganizationUserResponse) GetOrganizationUser() *OrganizationUser {
+	if m != nil {
+		return m.OrganizationUser
+	}
+	return nil
+}
+
+func (m *GetOrganizationUserResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetOrganizationUserResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type OrganizationHTTPIntegration struct {
+	// The id of the organization.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// The ids of the applications inheriting this integration.
+	// When empty, all the applications of the organization inherit it.
+	ApplicationIds []int64 `protobuf:"varint,2,rep,packed,name=application_ids,json=applicationIDs,proto3" json:"application_ids,omitempty"`
+	// The headers to use when making HTTP callbacks.
+	Headers []*HTTPIntegrationHeader `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	// The URL to call for uplink data.
+	UplinkDataUrl string `protobuf:"bytes,4,opt,name=uplink_data_url,json=uplinkDataURL,proto3" json:"uplink_data_url,omitempty"`
+	// The URL to call for join notifications.
+	JoinNotificationUrl string `protobuf:"bytes,5,opt,name=join_notification_url,json=joinNotificationURL,proto3" json:"join_notification_url,omitempty"`
+	// The URL to call for ACK notifications (for confirmed downlink data).
+	AckNotificationUrl string `protobuf:"bytes,6,opt,name=ack_notification_url,json=ackNotificationURL,proto3" json:"ack_notification_url,omitempty"`
+	// The URL to call for error notifications.
+	ErrorNotificationUrl string `protobuf:"bytes,7,opt,name=error_notification_url,json=errorNotificationURL,proto3" json:"error_notification_url,omitempty"`
+	// The URL to call for device-status notifications.
+	StatusNotificationUrl string `protobuf:"bytes,8,opt,name=status_notification_url,json=statusNotificationURL,proto3" json:"status_notification_url,omitempty"`
+	// The URL to call for location notifications.
+	LocationNotificationUrl string `protobuf:"bytes,9,opt,name=location_notification_url,json=locationNotificationURL,proto3" json:"location_notification_url,omitempty"`
+	// Additional endpoints, optionally filtered by a filter expression.
+	Endpoints            []*HTTPIntegrationEndpoint `protobuf:"bytes,10,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *OrganizationHTTPIntegration) Reset()         { *m = OrganizationHTTPIntegration{} }
+func (m *OrganizationHTTPIntegration) String() string { return proto.CompactTextString(m) }
+func (*OrganizationHTTPIntegration) ProtoMessage()    {}
+func (*OrganizationHTTPIntegration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{22}
+}
+func (m *OrganizationHTTPIntegration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationHTTPIntegration.Unmarshal(m, b)
+}
+func (m *OrganizationHTTPIntegration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationHTTPIntegration.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationHTTPIntegration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationHTTPIntegration.Merge(dst, src)
+}
+func (m *OrganizationHTTPIntegration) XXX_Size() int {
+	return xxx_messageInfo_OrganizationHTTPIntegration.Size(m)
+}
+func (m *OrganizationHTTPIntegration) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationHTTPIntegration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationHTTPIntegration proto.InternalMessageInfo
+
+func (m *OrganizationHTTPIntegration) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *OrganizationHTTPIntegration) GetApplicationIds() []int64 {
+	if m != nil {
+		return m.ApplicationIds
+	}
+	return nil
+}
+
+func (m *OrganizationHTTPIntegration) GetHeaders() []*HTTPIntegrationHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *OrganizationHTTPIntegration) GetUplinkDataUrl() string {
+	if m != nil {
+		return m.UplinkDataUrl
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetJoinNotificationUrl() string {
+	if m != nil {
+		return m.JoinNotificationUrl
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetAckNotificationUrl() string {
+	if m != nil {
+		return m.AckNotificationUrl
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetErrorNotificationUrl() string {
+	if m != nil {
+		return m.ErrorNotificationUrl
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetStatusNotificationUrl() string {
+	if m != nil {
+		return m.StatusNotificationUrl
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetLocationNotificationUrl() string {
+	if m != nil {
+		return m.LocationNotificationUrl
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetEndpoints() []*HTTPIntegrationEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+type CreateOrganizationHTTPIntegrationRequest struct {
+	// Integration object to create.
+	Integration          *OrganizationHTTPIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *CreateOrganizationHTTPIntegrationRequest) Reset() {
+	*m = CreateOrganizationHTTPIntegrationRequest{}
+}
+func (m *CreateOrganizationHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationHTTPIntegrationRequest) ProtoMessage()    {}
+func (*CreateOrganizationHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{23}
+}
+func (m *CreateOrganizationHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOrganizationHTTPIntegrationRequest.Unmarshal(m, b)
+}
+func (m *CreateOrganizationHTTPIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOrganizationHTTPIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateOrganizationHTTPIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrganizationHTTPIntegrationRequest.Merge(dst, src)
+}
+func (m *CreateOrganizationHTTPIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateOrganizationHTTPIntegrationRequest.Size(m)
+}
+func (m *CreateOrganizationHTTPIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrganizationHTTPIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrganizationHTTPIntegrationRequest proto.InternalMessageInfo
+
+func (m *CreateOrganizationHTTPIntegrationRequest) GetIntegration() *OrganizationHTTPIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type GetOrganizationHTTPIntegrationRequest struct {
+	// The id of the organization.
+	OrganizationId       int64    `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOrganizationHTTPIntegrationRequest) Reset()         { *m = GetOrganizationHTTPIntegrationRequest{} }
+func (m *GetOrganizationHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrganizationHTTPIntegrationRequest) ProtoMessage()    {}
+func (*GetOrganizationHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{24}
+}
+func (m *GetOrganizationHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrganizationHTTPIntegrationRequest.Unmarshal(m, b)
+}
+func (m *GetOrganizationHTTPIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrganizationHTTPIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetOrganizationHTTPIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrganizationHTTPIntegrationRequest.Merge(dst, src)
+}
+func (m *GetOrganizationHTTPIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrganizationHTTPIntegrationRequest.Size(m)
+}
+func (m *GetOrganizationHTTPIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrganizationHTTPIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrganizationHTTPIntegrationRequest proto.InternalMessageInfo
+
+func (m *GetOrganizationHTTPIntegrationRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type GetOrganizationHTTPIntegrationResponse struct {
+	// Integration object.
+	Integration          *OrganizationHTTPIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *GetOrganizationHTTPIntegrationResponse) Reset() {
+	*m = GetOrganizationHTTPIntegrationResponse{}
+}
+func (m *GetOrganizationHTTPIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrganizationHTTPIntegrationResponse) ProtoMessage()    {}
+func (*GetOrganizationHTTPIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{25}
+}
+func (m *GetOrganizationHTTPIntegrationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrganizationHTTPIntegrationResponse.Unmarshal(m, b)
+}
+func (m *GetOrganizationHTTPIntegrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrganizationHTTPIntegrationResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetOrganizationHTTPIntegrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrganizationHTTPIntegrationResponse.Merge(dst, src)
+}
+func (m *GetOrganizationHTTPIntegrationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrganizationHTTPIntegrationResponse.Size(m)
+}
+func (m *GetOrganizationHTTPIntegrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrganizationHTTPIntegrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrganizationHTTPIntegrationResponse proto.InternalMessageInfo
+
+func (m *GetOrganizationHTTPIntegrationResponse) GetIntegration() *OrganizationHTTPIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type UpdateOrganizationHTTPIntegrationRequest struct {
+	// Integration object to update.
+	Integration          *OrganizationHTTPIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *UpdateOrganizationHTTPIntegrationRequest) Reset() {
+	*m = UpdateOrganizationHTTPIntegrationRequest{}
+}
+func (m *UpdateOrganizationHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateOrganizationHTTPIntegrationRequest) ProtoMessage()    {}
+func (*UpdateOrganizationHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{26}
+}
+func (m *UpdateOrganizationHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateOrganizationHTTPIntegrationRequest.Unmarshal(m, b)
+}
+func (m *UpdateOrganizationHTTPIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateOrganizationHTTPIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateOrganizationHTTPIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateOrganizationHTTPIntegrationRequest.Merge(dst, src)
+}
+func (m *UpdateOrganizationHTTPIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateOrganizationHTTPIntegrationRequest.Size(m)
+}
+func (m *UpdateOrganizationHTTPIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateOrganizationHTTPIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateOrganizationHTTPIntegrationRequest proto.InternalMessageInfo
+
+func (m *UpdateOrganizationHTTPIntegrationRequest) GetIntegration() *OrganizationHTTPIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type DeleteOrganizationHTTPIntegrationRequest struct {
+	// The id of the organization.
+	OrganizationId       int64    `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteOrganizationHTTPIntegrationRequest) Reset() {
+	*m = DeleteOrganizationHTTPIntegrationRequest{}
+}
+func (m *DeleteOrganizationHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteOrganizationHTTPIntegrationRequest) ProtoMessage()    {}
+func (*DeleteOrganizationHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{27}
+}
+func (m *DeleteOrganizationHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteOrganizationHTTPIntegrationRequest.Unmarshal(m, b)
+}
+func (m *DeleteOrganizationHTTPIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteOrganizationHTTPIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteOrganizationHTTPIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteOrganizationHTTPIntegrationRequest.Merge(dst, src)
+}
+func (m *DeleteOrganizationHTTPIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteOrganizationHTTPIntegrationRequest.Size(m)
+}
+func (m *DeleteOrganizationHTTPIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteOrganizationHTTPIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteOrganizationHTTPIntegrationRequest proto.InternalMessageInfo
+
+func (m *DeleteOrganizationHTTPIntegrationRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type OrganizationInfluxDBIntegration struct {
+	// The id of the organization.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// The ids of the applications inheriting this integration.
+	// When empty, all the applications of the organization inherit it.
+	ApplicationIds []int64 `protobuf:"varint,2,rep,packed,name=application_ids,json=applicationIDs,proto3" json:"application_ids,omitempty"`
+	// InfluxDB API write endpoint (e.g. http://localhost:8086/write).
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// InfluxDB database name.
+	Db string `protobuf:"bytes,4,opt,name=db,proto3" json:"db,omitempty"`
+	// InfluxDB username.
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// InfluxDB password.
+	Password string `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	// InfluxDB retention policy name.
+	RetentionPolicyName string `protobuf:"bytes,7,opt,name=retention_policy_name,json=retentionPolicyName,proto3" json:"retention_policy_name,omitempty"`
+	// InfluxDB timestamp precision.
+	Precision            InfluxDBPrecision `protobuf:"varint,8,opt,name=precision,proto3,enum=api.InfluxDBPrecision" json:"precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *OrganizationInfluxDBIntegration) Reset()         { *m = OrganizationInfluxDBIntegration{} }
+func (m *OrganizationInfluxDBIntegration) String() string { return proto.CompactTextString(m) }
+func (*OrganizationInfluxDBIntegration) ProtoMessage()    {}
+func (*OrganizationInfluxDBIntegration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{28}
+}
+func (m *OrganizationInfluxDBIntegration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationInfluxDBIntegration.Unmarshal(m, b)
+}
+func (m *OrganizationInfluxDBIntegration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationInfluxDBIntegration.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationInfluxDBIntegration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationInfluxDBIntegration.Merge(dst, src)
+}
+func (m *OrganizationInfluxDBIntegration) XXX_Size() int {
+	return xxx_messageInfo_OrganizationInfluxDBIntegration.Size(m)
+}
+func (m *OrganizationInfluxDBIntegration) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationInfluxDBIntegration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationInfluxDBIntegration proto.InternalMessageInfo
+
+func (m *OrganizationInfluxDBIntegration) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *OrganizationInfluxDBIntegration) GetApplicationIds() []int64 {
+	if m != nil {
+		return m.ApplicationIds
+	}
+	return nil
+}
+
+func (m *OrganizationInfluxDBIntegration) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *OrganizationInfluxDBIntegration) GetDb() string {
+	if m != nil {
+		return m.Db
+	}
+	return ""
+}
+
+func (m *OrganizationInfluxDBIntegration) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *OrganizationInfluxDBIntegration) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *OrganizationInfluxDBIntegration) GetRetentionPolicyName() string {
+	if m != nil {
+		return m.RetentionPolicyName
+	}
+	return ""
+}
+
+func (m *OrganizationInfluxDBIntegration) GetPrecision() InfluxDBPrecision {
+	if m != nil {
+		return m.Precision
+	}
+	return InfluxDBPrecision_NS
+}
+
+type CreateOrganizationInfluxDBIntegrationRequest struct {
+	// Integration object to create.
+	Integration          *OrganizationInfluxDBIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *CreateOrganizationInfluxDBIntegrationRequest) Reset() {
+	*m = CreateOrganizationInfluxDBIntegrationRequest{}
+}
+func (m *CreateOrganizationInfluxDBIntegrationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CreateOrganizationInfluxDBIntegrationRequest) ProtoMessage() {}
+func (*CreateOrganizationInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{29}
+}
+func (m *CreateOrganizationInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOrganizationInfluxDBIntegrationRequest.Unmarshal(m, b)
+}
+func (m *CreateOrganizationInfluxDBIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOrganizationInfluxDBIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateOrganizationInfluxDBIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrganizationInfluxDBIntegrationRequest.Merge(dst, src)
+}
+func (m *CreateOrganizationInfluxDBIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateOrganizationInfluxDBIntegrationRequest.Size(m)
+}
+func (m *CreateOrganizationInfluxDBIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrganizationInfluxDBIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrganizationInfluxDBIntegrationRequest proto.InternalMessageInfo
+
+func (m *CreateOrganizationInfluxDBIntegrationRequest) GetIntegration() *OrganizationInfluxDBIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type GetOrganizationInfluxDBIntegrationRequest struct {
+	// The id of the organization.
+	OrganizationId       int64    `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOrganizationInfluxDBIntegrationRequest) Reset() {
+	*m = GetOrganizationInfluxDBIntegrationRequest{}
+}
+func (m *GetOrganizationInfluxDBIntegrationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GetOrganizationInfluxDBIntegrationRequest) ProtoMessage() {}
+func (*GetOrganizationInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{30}
+}
+func (m *GetOrganizationInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrganizationInfluxDBIntegrationRequest.Unmarshal(m, b)
+}
+func (m *GetOrganizationInfluxDBIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrganizationInfluxDBIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetOrganizationInfluxDBIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrganizationInfluxDBIntegrationRequest.Merge(dst, src)
+}
+func (m *GetOrganizationInfluxDBIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrganizationInfluxDBIntegrationRequest.Size(m)
+}
+func (m *GetOrganizationInfluxDBIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrganizationInfluxDBIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrganizationInfluxDBIntegrationRequest proto.InternalMessageInfo
+
+func (m *GetOrganizationInfluxDBIntegrationRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type GetOrganizationInfluxDBIntegrationResponse struct {
+	// Integration object.
+	Integration          *OrganizationInfluxDBIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *GetOrganizationInfluxDBIntegrationResponse) Reset() {
+	*m = GetOrganizationInfluxDBIntegrationResponse{}
+}
+func (m *GetOrganizationInfluxDBIntegrationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GetOrganizationInfluxDBIntegrationResponse) ProtoMessage() {}
+func (*GetOrganizationInfluxDBIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{31}
+}
+func (m *GetOrganizationInfluxDBIntegrationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrganizationInfluxDBIntegrationResponse.Unmarshal(m, b)
+}
+func (m *GetOrganizationInfluxDBIntegrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrganizationInfluxDBIntegrationResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetOrganizationInfluxDBIntegrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrganizationInfluxDBIntegrationResponse.Merge(dst, src)
+}
+func (m *GetOrganizationInfluxDBIntegrationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrganizationInfluxDBIntegrationResponse.Size(m)
+}
+func (m *GetOrganizationInfluxDBIntegrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrganizationInfluxDBIntegrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrganizationInfluxDBIntegrationResponse proto.InternalMessageInfo
+
+func (m *GetOrganizationInfluxDBIntegrationResponse) GetIntegration() *OrganizationInfluxDBIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type UpdateOrganizationInfluxDBIntegrationRequest struct {
+	// Integration object to update.
+	Integration          *OrganizationInfluxDBIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *UpdateOrganizationInfluxDBIntegrationRequest) Reset() {
+	*m = UpdateOrganizationInfluxDBIntegrationRequest{}
+}
+func (m *UpdateOrganizationInfluxDBIntegrationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*UpdateOrganizationInfluxDBIntegrationRequest) ProtoMessage() {}
+func (*UpdateOrganizationInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{32}
+}
+func (m *UpdateOrganizationInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateOrganizationInfluxDBIntegrationRequest.Unmarshal(m, b)
+}
+func (m *UpdateOrganizationInfluxDBIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateOrganizationInfluxDBIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateOrganizationInfluxDBIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateOrganizationInfluxDBIntegrationRequest.Merge(dst, src)
+}
+func (m *UpdateOrganizationInfluxDBIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateOrganizationInfluxDBIntegrationRequest.Size(m)
+}
+func (m *UpdateOrganizationInfluxDBIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateOrganizationInfluxDBIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateOrganizationInfluxDBIntegrationRequest proto.InternalMessageInfo
+
+func (m *UpdateOrganizationInfluxDBIntegrationRequest) GetIntegration() *OrganizationInfluxDBIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type DeleteOrganizationInfluxDBIntegrationRequest struct {
+	// The id of the organization.
+	OrganizationId       int64    `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteOrganizationInfluxDBIntegrationRequest) Reset() {
+	*m = DeleteOrganizationInfluxDBIntegrationRequest{}
+}
+func (m *DeleteOrganizationInfluxDBIntegrationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*DeleteOrganizationInfluxDBIntegrationRequest) ProtoMessage() {}
+func (*DeleteOrganizationInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{33}
+}
+func (m *DeleteOrganizationInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteOrganizationInfluxDBIntegrationRequest.Unmarshal(m, b)
+}
+func (m *DeleteOrganizationInfluxDBIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteOrganizationInfluxDBIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteOrganizationInfluxDBIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteOrganizationInfluxDBIntegrationRequest.Merge(dst, src)
+}
+func (m *DeleteOrganizationInfluxDBIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteOrganizationInfluxDBIntegrationRequest.Size(m)
+}
+func (m *DeleteOrganizationInfluxDBIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteOrganizationInfluxDBIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteOrganizationInfluxDBIntegrationRequest proto.InternalMessageInfo
+
+func (m *DeleteOrganizationInfluxDBIntegrationRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type ListOrganizationIntegrationRequest struct {
+	// The id of the organization.
+	OrganizationId       int64    `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListOrganizationIntegrationRequest) Reset()         { *m = ListOrganizationIntegrationRequest{} }
+func (m *ListOrganizationIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOrganizationIntegrationRequest) ProtoMessage()    {}
+func (*ListOrganizationIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{34}
+}
+func (m *ListOrganizationIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrganizationIntegrationRequest.Unmarshal(m, b)
+}
+func (m *ListOrganizationIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrganizationIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListOrganizationIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrganizationIntegrationRequest.Merge(dst, src)
+}
+func (m *ListOrganizationIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOrganizationIntegrationRequest.Size(m)
+}
+func (m *ListOrganizationIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrganizationIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrganizationIntegrationRequest proto.InternalMessageInfo
+
+func (m *ListOrganizationIntegrationRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type ListOrganizationIntegrationResponse struct {
+	// Total number of integrations available within the result-set.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Integrations within result-set.
+	Result               []*IntegrationListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ListOrganizationIntegrationResponse) Reset()         { *m = ListOrganizationIntegrationResponse{} }
+func (m *ListOrganizationIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOrganizationIntegrationResponse) ProtoMessage()    {}
+func (*ListOrganizationIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{35}
+}
+func (m *ListOrganizationIntegrationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrganizationIntegrationResponse.Unmarshal(m, b)
+}
+func (m *ListOrganizationIntegrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrganizationIntegrationResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListOrganizationIntegrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrganizationIntegrationResponse.Merge(dst, src)
+}
+func (m *ListOrganizationIntegrationResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOrganizationIntegrationResponse.Size(m)
+}
+func (m *ListOrganizationIntegrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrganizationIntegrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrganizationIntegrationResponse proto.InternalMessageInfo
+
+func (m *ListOrganizationIntegrationResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListOrganizationIntegrationResponse) GetResult() []*IntegrationListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type OrganizationHost struct {
+	// Hostname (e.g. lora.example.com).
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The id of the organization.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Login page logo / header (when empty, the configured branding is used).
+	BrandingLogo string `protobuf:"bytes,3,opt,name=branding_logo,json=brandingLogo,proto3" json:"branding_logo,omitempty"`
+	// Login page registration text (when empty, the configured branding is used).
+	BrandingRegistration string `protobuf:"bytes,4,opt,name=branding_registration,json=brandingRegistration,proto3" json:"branding_registration,omitempty"`
+	// Footer (when empty, the configured branding is used).
+	BrandingFooter       string   `protobuf:"bytes,5,opt,name=branding_footer,json=brandingFooter,proto3" json:"branding_footer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationHost) Reset()         { *m = OrganizationHost{} }
+func (m *OrganizationHost) String() string { return proto.CompactTextString(m) }
+func (*OrganizationHost) ProtoMessage()    {}
+func (*OrganizationHost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{36}
+}
+func (m *OrganizationHost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationHost.Unmarshal(m, b)
+}
+func (m *OrganizationHost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationHost.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationHost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationHost.Merge(dst, src)
+}
+func (m *OrganizationHost) XXX_Size() int {
+	return xxx_messageInfo_OrganizationHost.Size(m)
+}
+func (m *OrganizationHost) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationHost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationHost proto.InternalMessageInfo
+
+func (m *OrganizationHost) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *OrganizationHost) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *OrganizationHost) GetBrandingLogo() string {
+	if m != nil {
+		return m.BrandingLogo
+	}
+	return ""
+}
+
+func (m *OrganizationHost) GetBrandingRegistration() string {
+	if m != nil {
+		return m.BrandingRegistration
+	}
+	return ""
+}
+
+func (m *OrganizationHost) GetBrandingFooter() string {
+	if m != nil {
+		return m.BrandingFooter
+	}
+	return ""
+}
+
+type CreateOrganizationHostRequest struct {
+	// Host object to create.
+	Host                 *OrganizationHost `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateOrganizationHostRequest) Reset()         { *m = CreateOrganizationHostRequest{} }
+func (m *CreateOrganizationHostRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationHostRequest) ProtoMessage()    {}
+func (*CreateOrganizationHostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{37}
+}
+func (m *CreateOrganizationHostRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOrganizationHostRequest.Unmarshal(m, b)
+}
+func (m *CreateOrganizationHostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOrganizationHostRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateOrganizationHostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrganizationHostRequest.Merge(dst, src)
+}
+func (m *CreateOrganizationHostRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateOrganizationHostRequest.Size(m)
+}
+func (m *CreateOrganizationHostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrganizationHostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrganizationHostRequest proto.InternalMessageInfo
+
+func (m *CreateOrganizationHostRequest) GetHost() *OrganizationHost {
+	if m != nil {
+		return m.Host
+	}
+	return nil
+}
+
+type UpdateOrganizationHostRequest struct {
+	// Host object to update.
+	Host                 *OrganizationHost `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateOrganizationHostRequest) Reset()         { *m = UpdateOrganizationHostRequest{} }
+func (m *UpdateOrganizationHostRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateOrganizationHostRequest) ProtoMessage()    {}
+func (*UpdateOrganizationHostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{38}
+}
+func (m *UpdateOrganizationHostRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateOrganizationHostRequest.Unmarshal(m, b)
+}
+func (m *UpdateOrganizationHostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateOrganizationHostRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateOrganizationHostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateOrganizationHostRequest.Merge(dst, src)
+}
+func (m *UpdateOrganizationHostRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateOrganizationHostRequest.Size(m)
+}
+func (m *UpdateOrganizationHostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateOrganizationHostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateOrganizationHostRequest proto.InternalMessageInfo
+
+func (m *UpdateOrganizationHostRequest) GetHost() *OrganizationHost {
+	if m != nil {
+		return m.Host
+	}
+	return nil
+}
+
+type DeleteOrganizationHostRequest struct {
+	// The id of the organization.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Hostname.
+	Hostname             string   `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteOrganizationHostRequest) Reset()         { *m = DeleteOrganizationHostRequest{} }
+func (m *DeleteOrganizationHostRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteOrganizationHostRequest) ProtoMessage()    {}
+func (*DeleteOrganizationHostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{39}
+}
+func (m *DeleteOrganizationHostRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteOrganizationHostRequest.Unmarshal(m, b)
+}
+func (m *DeleteOrganizationHostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteOrganizationHostRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteOrganizationHostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteOrganizationHostRequest.Merge(dst, src)
+}
+func (m *DeleteOrganizationHostRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteOrganizationHostRequest.Size(m)
+}
+func (m *DeleteOrganizationHostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteOrganizationHostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteOrganizationHostRequest proto.InternalMessageInfo
+
+func (m *DeleteOrganizationHostRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *DeleteOrganizationHostRequest) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+type ListOrganizationHostsRequest struct {
+	// The id of the organization.
+	OrganizationId       int64    `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListOrganizationHostsRequest) Reset()         { *m = ListOrganizationHostsRequest{} }
+func (m *ListOrganizationHostsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOrganizationHostsRequest) ProtoMessage()    {}
+func (*ListOrganizationHostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{40}
+}
+func (m *ListOrganizationHostsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrganizationHostsRequest.Unmarshal(m, b)
+}
+func (m *ListOrganizationHostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrganizationHostsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListOrganizationHostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrganizationHostsRequest.Merge(dst, src)
+}
+func (m *ListOrganizationHostsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOrganizationHostsRequest.Size(m)
+}
+func (m *ListOrganizationHostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrganizationHostsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrganizationHostsRequest proto.InternalMessageInfo
+
+func (m *ListOrganizationHostsRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type ListOrganizationHostsResponse struct {
+	// Hosts of the organization.
+	Result               []*OrganizationHost `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
-func (m *GetOrganizationUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetOrganizationUserResponse.Unmarshal(m, b)
+
+func (m *ListOrganizationHostsResponse) Reset()         { *m = ListOrganizationHostsResponse{} }
+func (m *ListOrganizationHostsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOrganizationHostsResponse) ProtoMessage()    {}
+func (*ListOrganizationHostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{41}
 }
-func (m *GetOrganizationUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetOrganizationUserResponse.Marshal(b, m, deterministic)
+func (m *ListOrganizationHostsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrganizationHostsResponse.Unmarshal(m, b)
 }
-func (dst *GetOrganizationUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOrganizationUserResponse.Merge(dst, src)
+func (m *ListOrganizationHostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrganizationHostsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetOrganizationUserResponse) XXX_Size() int {
-	return xxx_messageInfo_GetOrganizationUserResponse.Size(m)
+func (dst *ListOrganizationHostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrganizationHostsResponse.Merge(dst, src)
 }
-func (m *GetOrganizationUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOrganizationUserResponse.DiscardUnknown(m)
+func (m *ListOrganizationHostsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOrganizationHostsResponse.Size(m)
+}
+func (m *ListOrganizationHostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrganizationHostsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOrganizationUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListOrganizationHostsResponse proto.InternalMessageInfo
 
-func (m *GetOrganizationUserResponse) GetOrganizationUser() *OrganizationUser {
+func (m *ListOrganizationHostsResponse) GetResult() []*OrganizationHost {
 	if m != nil {
-		return m.OrganizationUser
+		return m.Result
 	}
 	return nil
 }
 
-func (m *GetOrganizationUserResponse) GetCreatedAt() *timestamp.Timestamp {
+type OrganizationBundleAdminUser struct {
+	// ID of an existing user to add as organization admin.
+	// When set, the other fields are ignored.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Username of the user to create.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// E-mail of the user to create.
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Password of the user to create.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Optional note.
+	Note                 string   `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationBundleAdminUser) Reset()         { *m = OrganizationBundleAdminUser{} }
+func (m *OrganizationBundleAdminUser) String() string { return proto.CompactTextString(m) }
+func (*OrganizationBundleAdminUser) ProtoMessage()    {}
+func (*OrganizationBundleAdminUser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{42}
+}
+func (m *OrganizationBundleAdminUser) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationBundleAdminUser.Unmarshal(m, b)
+}
+func (m *OrganizationBundleAdminUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationBundleAdminUser.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationBundleAdminUser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationBundleAdminUser.Merge(dst, src)
+}
+func (m *OrganizationBundleAdminUser) XXX_Size() int {
+	return xxx_messageInfo_OrganizationBundleAdminUser.Size(m)
+}
+func (m *OrganizationBundleAdminUser) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationBundleAdminUser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationBundleAdminUser proto.InternalMessageInfo
+
+func (m *OrganizationBundleAdminUser) GetId() int64 {
 	if m != nil {
-		return m.CreatedAt
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OrganizationBundleAdminUser) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *OrganizationBundleAdminUser) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *OrganizationBundleAdminUser) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *OrganizationBundleAdminUser) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+type CreateOrganizationBundleRequest struct {
+	// Organization object to create.
+	Organization *Organization `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Initial organization admin user.
+	AdminUser *OrganizationBundleAdminUser `protobuf:"bytes,2,opt,name=admin_user,json=adminUser,proto3" json:"admin_user,omitempty"`
+	// Network-server ID on which the service-profile is provisioned.
+	NetworkServerId int64 `protobuf:"varint,3,opt,name=network_server_id,json=networkServerID,proto3" json:"network_server_id,omitempty"`
+	// Name of the service-profile (default: the organization name).
+	ServiceProfileName string `protobuf:"bytes,4,opt,name=service_profile_name,json=serviceProfileName,proto3" json:"service_profile_name,omitempty"`
+	// Name of the default application (default: "default").
+	ApplicationName      string   `protobuf:"bytes,5,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateOrganizationBundleRequest) Reset()         { *m = CreateOrganizationBundleRequest{} }
+func (m *CreateOrganizationBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationBundleRequest) ProtoMessage()    {}
+func (*CreateOrganizationBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{43}
+}
+func (m *CreateOrganizationBundleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOrganizationBundleRequest.Unmarshal(m, b)
+}
+func (m *CreateOrganizationBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOrganizationBundleRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateOrganizationBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrganizationBundleRequest.Merge(dst, src)
+}
+func (m *CreateOrganizationBundleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateOrganizationBundleRequest.Size(m)
+}
+func (m *CreateOrganizationBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrganizationBundleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrganizationBundleRequest proto.InternalMessageInfo
+
+func (m *CreateOrganizationBundleRequest) GetOrganization() *Organization {
+	if m != nil {
+		return m.Organization
 	}
 	return nil
 }
 
-func (m *GetOrganizationUserResponse) GetUpdatedAt() *timestamp.Timestamp {
+func (m *CreateOrganizationBundleRequest) GetAdminUser() *OrganizationBundleAdminUser {
 	if m != nil {
-		return m.UpdatedAt
+		return m.AdminUser
 	}
 	return nil
 }
 
+func (m *CreateOrganizationBundleRequest) GetNetworkServerId() int64 {
+	if m != nil {
+		return m.NetworkServerId
+	}
+	return 0
+}
+
+func (m *CreateOrganizationBundleRequest) GetServiceProfileName() string {
+	if m != nil {
+		return m.ServiceProfileName
+	}
+	return ""
+}
+
+func (m *CreateOrganizationBundleRequest) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+type CreateOrganizationBundleResponse struct {
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// ID of the admin user.
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userID,proto3" json:"user_id,omitempty"`
+	// Service-profile ID (UUID string).
+	ServiceProfileId string `protobuf:"bytes,3,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,4,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateOrganizationBundleResponse) Reset()         { *m = CreateOrganizationBundleResponse{} }
+func (m *CreateOrganizationBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationBundleResponse) ProtoMessage()    {}
+func (*CreateOrganizationBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_organization_62a3ff9ba9dd4922, []int{44}
+}
+func (m *CreateOrganizationBundleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOrganizationBundleResponse.Unmarshal(m, b)
+}
+func (m *CreateOrganizationBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOrganizationBundleResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateOrganizationBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrganizationBundleResponse.Merge(dst, src)
+}
+func (m *CreateOrganizationBundleResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateOrganizationBundleResponse.Size(m)
+}
+func (m *CreateOrganizationBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrganizationBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrganizationBundleResponse proto.InternalMessageInfo
+
+func (m *CreateOrganizationBundleResponse) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *CreateOrganizationBundleResponse) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *CreateOrganizationBundleResponse) GetServiceProfileId() string {
+	if m != nil {
+		return m.ServiceProfileId
+	}
+	return ""
+}
+
+func (m *CreateOrganizationBundleResponse) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Organization)(nil), "api.Organization")
 	proto.RegisterType((*OrganizationListItem)(nil), "api.OrganizationListItem")
@@ -1029,7 +2437,33 @@ func init() {
 	proto.RegisterType((*ListOrganizationUsersRequest)(nil), "api.ListOrganizationUsersRequest")
 	proto.RegisterType((*ListOrganizationUsersResponse)(nil), "api.ListOrganizationUsersResponse")
 	proto.RegisterType((*GetOrganizationUserRequest)(nil), "api.GetOrganizationUserRequest")
+	proto.RegisterType((*BatchUpdateOrganizationUsersRequest)(nil), "api.BatchUpdateOrganizationUsersRequest")
+	proto.RegisterType((*ImportOrganizationUsersRequest)(nil), "api.ImportOrganizationUsersRequest")
+	proto.RegisterType((*BatchUpdateOrganizationUsersResponse)(nil), "api.BatchUpdateOrganizationUsersResponse")
 	proto.RegisterType((*GetOrganizationUserResponse)(nil), "api.GetOrganizationUserResponse")
+	proto.RegisterType((*OrganizationHTTPIntegration)(nil), "api.OrganizationHTTPIntegration")
+	proto.RegisterType((*CreateOrganizationHTTPIntegrationRequest)(nil), "api.CreateOrganizationHTTPIntegrationRequest")
+	proto.RegisterType((*GetOrganizationHTTPIntegrationRequest)(nil), "api.GetOrganizationHTTPIntegrationRequest")
+	proto.RegisterType((*GetOrganizationHTTPIntegrationResponse)(nil), "api.GetOrganizationHTTPIntegrationResponse")
+	proto.RegisterType((*UpdateOrganizationHTTPIntegrationRequest)(nil), "api.UpdateOrganizationHTTPIntegrationRequest")
+	proto.RegisterType((*DeleteOrganizationHTTPIntegrationRequest)(nil), "api.DeleteOrganizationHTTPIntegrationRequest")
+	proto.RegisterType((*OrganizationInfluxDBIntegration)(nil), "api.OrganizationInfluxDBIntegration")
+	proto.RegisterType((*CreateOrganizationInfluxDBIntegrationRequest)(nil), "api.CreateOrganizationInfluxDBIntegrationRequest")
+	proto.RegisterType((*GetOrganizationInfluxDBIntegrationRequest)(nil), "api.GetOrganizationInfluxDBIntegrationRequest")
+	proto.RegisterType((*GetOrganizationInfluxDBIntegrationResponse)(nil), "api.GetOrganizationInfluxDBIntegrationResponse")
+	proto.RegisterType((*UpdateOrganizationInfluxDBIntegrationRequest)(nil), "api.UpdateOrganizationInfluxDBIntegrationRequest")
+	proto.RegisterType((*DeleteOrganizationInfluxDBIntegrationRequest)(nil), "api.DeleteOrganizationInfluxDBIntegrationRequest")
+	proto.RegisterType((*ListOrganizationIntegrationRequest)(nil), "api.ListOrganizationIntegrationRequest")
+	proto.RegisterType((*ListOrganizationIntegrationResponse)(nil), "api.ListOrganizationIntegrationResponse")
+	proto.RegisterType((*OrganizationHost)(nil), "api.OrganizationHost")
+	proto.RegisterType((*CreateOrganizationHostRequest)(nil), "api.CreateOrganizationHostRequest")
+	proto.RegisterType((*UpdateOrganizationHostRequest)(nil), "api.UpdateOrganizationHostRequest")
+	proto.RegisterType((*DeleteOrganizationHostRequest)(nil), "api.DeleteOrganizationHostRequest")
+	proto.RegisterType((*ListOrganizationHostsRequest)(nil), "api.ListOrganizationHostsRequest")
+	proto.RegisterType((*ListOrganizationHostsResponse)(nil), "api.ListOrganizationHostsResponse")
+	proto.RegisterType((*OrganizationBundleAdminUser)(nil), "api.OrganizationBundleAdminUser")
+	proto.RegisterType((*CreateOrganizationBundleRequest)(nil), "api.CreateOrganizationBundleRequest")
+	proto.RegisterType((*CreateOrganizationBundleResponse)(nil), "api.CreateOrganizationBundleResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1064,6 +2498,43 @@ type OrganizationServiceClient interface {
 	UpdateUser(ctx context.Context, in *UpdateOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Delete a user from an organization.
 	DeleteUser(ctx context.Context, in *DeleteOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// BatchUpdateUsers adds, updates and removes the given organization users
+	// within a single transaction.
+	BatchUpdateUsers(ctx context.Context, in *BatchUpdateOrganizationUsersRequest, opts ...grpc.CallOption) (*BatchUpdateOrganizationUsersResponse, error)
+	// ImportUsers adds and updates the organization users from the given CSV
+	// document within a single transaction.
+	ImportUsers(ctx context.Context, in *ImportOrganizationUsersRequest, opts ...grpc.CallOption) (*BatchUpdateOrganizationUsersResponse, error)
+	// CreateHTTPIntegration creates a HTTP organization-integration.
+	CreateHTTPIntegration(ctx context.Context, in *CreateOrganizationHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetHTTPIntegration returns the HTTP organization-integration.
+	GetHTTPIntegration(ctx context.Context, in *GetOrganizationHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetOrganizationHTTPIntegrationResponse, error)
+	// UpdateHTTPIntegration updates the HTTP organization-integration.
+	UpdateHTTPIntegration(ctx context.Context, in *UpdateOrganizationHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteHTTPIntegration deletes the HTTP organization-integration.
+	DeleteHTTPIntegration(ctx context.Context, in *DeleteOrganizationHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateInfluxDBIntegration creates an InfluxDB organization-integration.
+	CreateInfluxDBIntegration(ctx context.Context, in *CreateOrganizationInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetInfluxDBIntegration returns the InfluxDB organization-integration.
+	GetInfluxDBIntegration(ctx context.Context, in *GetOrganizationInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetOrganizationInfluxDBIntegrationResponse, error)
+	// UpdateInfluxDBIntegration updates the InfluxDB organization-integration.
+	UpdateInfluxDBIntegration(ctx context.Context, in *UpdateOrganizationInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteInfluxDBIntegration deletes the InfluxDB organization-integration.
+	DeleteInfluxDBIntegration(ctx context.Context, in *DeleteOrganizationInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListIntegrations lists all configured organization-integrations.
+	ListIntegrations(ctx context.Context, in *ListOrganizationIntegrationRequest, opts ...grpc.CallOption) (*ListOrganizationIntegrationResponse, error)
+	// CreateHost maps the given hostname to the organization. The API calls
+	// made through this hostname are scoped to the organization.
+	CreateHost(ctx context.Context, in *CreateOrganizationHostRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// UpdateHost updates the given organization host.
+	UpdateHost(ctx context.Context, in *UpdateOrganizationHostRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteHost deletes the given organization host.
+	DeleteHost(ctx context.Context, in *DeleteOrganizationHostRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListHosts lists the hosts of the organization.
+	ListHosts(ctx context.Context, in *ListOrganizationHostsRequest, opts ...grpc.CallOption) (*ListOrganizationHostsResponse, error)
+	// CreateBundle creates an organization, its initial admin user, a
+	// service-profile and a default application within a single transaction.
+	// This requires global admin permissions.
+	CreateBundle(ctx context.Context, in *CreateOrganizationBundleRequest, opts ...grpc.CallOption) (*CreateOrganizationBundleResponse, error)
 }
 
 type organizationServiceClient struct {
@@ -1119,45 +2590,189 @@ func (c *organizationServiceClient) Delete(ctx context.Context, in *DeleteOrgani
 	return out, nil
 }
 
-func (c *organizationServiceClient) ListUsers(ctx context.Context, in *ListOrganizationUsersRequest, opts ...grpc.CallOption) (*ListOrganizationUsersResponse, error) {
-	out := new(ListOrganizationUsersResponse)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/ListUsers", in, out, opts...)
+func (c *organizationServiceClient) ListUsers(ctx context.Context, in *ListOrganizationUsersRequest, opts ...grpc.CallOption) (*ListOrganizationUsersResponse, error) {
+	out := new(ListOrganizationUsersResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetUser(ctx context.Context, in *GetOrganizationUserRequest, opts ...grpc.CallOption) (*GetOrganizationUserResponse, error) {
+	out := new(GetOrganizationUserResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) AddUser(ctx context.Context, in *AddOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/AddUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateUser(ctx context.Context, in *UpdateOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) DeleteUser(ctx context.Context, in *DeleteOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) BatchUpdateUsers(ctx context.Context, in *BatchUpdateOrganizationUsersRequest, opts ...grpc.CallOption) (*BatchUpdateOrganizationUsersResponse, error) {
+	out := new(BatchUpdateOrganizationUsersResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/BatchUpdateUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ImportUsers(ctx context.Context, in *ImportOrganizationUsersRequest, opts ...grpc.CallOption) (*BatchUpdateOrganizationUsersResponse, error) {
+	out := new(BatchUpdateOrganizationUsersResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/ImportUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) CreateHTTPIntegration(ctx context.Context, in *CreateOrganizationHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/CreateHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetHTTPIntegration(ctx context.Context, in *GetOrganizationHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetOrganizationHTTPIntegrationResponse, error) {
+	out := new(GetOrganizationHTTPIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/GetHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateHTTPIntegration(ctx context.Context, in *UpdateOrganizationHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) DeleteHTTPIntegration(ctx context.Context, in *DeleteOrganizationHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/DeleteHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) CreateInfluxDBIntegration(ctx context.Context, in *CreateOrganizationInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/CreateInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetInfluxDBIntegration(ctx context.Context, in *GetOrganizationInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetOrganizationInfluxDBIntegrationResponse, error) {
+	out := new(GetOrganizationInfluxDBIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/GetInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateInfluxDBIntegration(ctx context.Context, in *UpdateOrganizationInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) DeleteInfluxDBIntegration(ctx context.Context, in *DeleteOrganizationInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/DeleteInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListIntegrations(ctx context.Context, in *ListOrganizationIntegrationRequest, opts ...grpc.CallOption) (*ListOrganizationIntegrationResponse, error) {
+	out := new(ListOrganizationIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/ListIntegrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) CreateHost(ctx context.Context, in *CreateOrganizationHostRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/CreateHost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *organizationServiceClient) GetUser(ctx context.Context, in *GetOrganizationUserRequest, opts ...grpc.CallOption) (*GetOrganizationUserResponse, error) {
-	out := new(GetOrganizationUserResponse)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/GetUser", in, out, opts...)
+func (c *organizationServiceClient) UpdateHost(ctx context.Context, in *UpdateOrganizationHostRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateHost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *organizationServiceClient) AddUser(ctx context.Context, in *AddOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *organizationServiceClient) DeleteHost(ctx context.Context, in *DeleteOrganizationHostRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/AddUser", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/DeleteHost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *organizationServiceClient) UpdateUser(ctx context.Context, in *UpdateOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateUser", in, out, opts...)
+func (c *organizationServiceClient) ListHosts(ctx context.Context, in *ListOrganizationHostsRequest, opts ...grpc.CallOption) (*ListOrganizationHostsResponse, error) {
+	out := new(ListOrganizationHostsResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/ListHosts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *organizationServiceClient) DeleteUser(ctx context.Context, in *DeleteOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/DeleteUser", in, out, opts...)
+func (c *organizationServiceClient) CreateBundle(ctx context.Context, in *CreateOrganizationBundleRequest, opts ...grpc.CallOption) (*CreateOrganizationBundleResponse, error) {
+	out := new(CreateOrganizationBundleResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/CreateBundle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1186,6 +2801,43 @@ type OrganizationServiceServer interface {
 	UpdateUser(context.Context, *UpdateOrganizationUserRequest) (*empty.Empty, error)
 	// Delete a user from an organization.
 	DeleteUser(context.Context, *DeleteOrganizationUserRequest) (*empty.Empty, error)
+	// BatchUpdateUsers adds, updates and removes the given organization users
+	// within a single transaction.
+	BatchUpdateUsers(context.Context, *BatchUpdateOrganizationUsersRequest) (*BatchUpdateOrganizationUsersResponse, error)
+	// ImportUsers adds and updates the organization users from the given CSV
+	// document within a single transaction.
+	ImportUsers(context.Context, *ImportOrganizationUsersRequest) (*BatchUpdateOrganizationUsersResponse, error)
+	// CreateHTTPIntegration creates a HTTP organization-integration.
+	CreateHTTPIntegration(context.Context, *CreateOrganizationHTTPIntegrationRequest) (*empty.Empty, error)
+	// GetHTTPIntegration returns the HTTP organization-integration.
+	GetHTTPIntegration(context.Context, *GetOrganizationHTTPIntegrationRequest) (*GetOrganizationHTTPIntegrationResponse, error)
+	// UpdateHTTPIntegration updates the HTTP organization-integration.
+	UpdateHTTPIntegration(context.Context, *UpdateOrganizationHTTPIntegrationRequest) (*empty.Empty, error)
+	// DeleteHTTPIntegration deletes the HTTP organization-integration.
+	DeleteHTTPIntegration(context.Context, *DeleteOrganizationHTTPIntegrationRequest) (*empty.Empty, error)
+	// CreateInfluxDBIntegration creates an InfluxDB organization-integration.
+	CreateInfluxDBIntegration(context.Context, *CreateOrganizationInfluxDBIntegrationRequest) (*empty.Empty, error)
+	// GetInfluxDBIntegration returns the InfluxDB organization-integration.
+	GetInfluxDBIntegration(context.Context, *GetOrganizationInfluxDBIntegrationRequest) (*GetOrganizationInfluxDBIntegrationResponse, error)
+	// UpdateInfluxDBIntegration updates the InfluxDB organization-integration.
+	UpdateInfluxDBIntegration(context.Context, *UpdateOrganizationInfluxDBIntegrationRequest) (*empty.Empty, error)
+	// DeleteInfluxDBIntegration deletes the InfluxDB organization-integration.
+	DeleteInfluxDBIntegration(context.Context, *DeleteOrganizationInfluxDBIntegrationRequest) (*empty.Empty, error)
+	// ListIntegrations lists all configured organization-integrations.
+	ListIntegrations(context.Context, *ListOrganizationIntegrationRequest) (*ListOrganizationIntegrationResponse, error)
+	// CreateHost maps the given hostname to the organization. The API calls
+	// made through this hostname are scoped to the organization.
+	CreateHost(context.Context, *CreateOrganizationHostRequest) (*empty.Empty, error)
+	// UpdateHost updates the given organization host.
+	UpdateHost(context.Context, *UpdateOrganizationHostRequest) (*empty.Empty, error)
+	// DeleteHost deletes the given organization host.
+	DeleteHost(context.Context, *DeleteOrganizationHostRequest) (*empty.Empty, error)
+	// ListHosts lists the hosts of the organization.
+	ListHosts(context.Context, *ListOrganizationHostsRequest) (*ListOrganizationHostsResponse, error)
+	// CreateBundle creates an organization, its initial admin user, a
+	// service-profile and a default application within a single transaction.
+	// This requires global admin permissions.
+	CreateBundle(context.Context, *CreateOrganizationBundleRequest) (*CreateOrganizationBundleResponse, error)
 }
 
 func RegisterOrganizationServiceServer(s *grpc.Server, srv OrganizationServiceServer) {
@@ -1372,6 +3024,294 @@ func _OrganizationService_DeleteUser_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrganizationService_BatchUpdateUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateOrganizationUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).BatchUpdateUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/BatchUpdateUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).BatchUpdateUsers(ctx, req.(*BatchUpdateOrganizationUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ImportUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOrganizationUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ImportUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/ImportUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ImportUsers(ctx, req.(*ImportOrganizationUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CreateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/CreateHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateHTTPIntegration(ctx, req.(*CreateOrganizationHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/GetHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetHTTPIntegration(ctx, req.(*GetOrganizationHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/UpdateHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateHTTPIntegration(ctx, req.(*UpdateOrganizationHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DeleteHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeleteHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/DeleteHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeleteHTTPIntegration(ctx, req.(*DeleteOrganizationHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CreateInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/CreateInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateInfluxDBIntegration(ctx, req.(*CreateOrganizationInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/GetInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetInfluxDBIntegration(ctx, req.(*GetOrganizationInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/UpdateInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateInfluxDBIntegration(ctx, req.(*UpdateOrganizationInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DeleteInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeleteInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/DeleteInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeleteInfluxDBIntegration(ctx, req.(*DeleteOrganizationInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListIntegrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListIntegrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/ListIntegrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListIntegrations(ctx, req.(*ListOrganizationIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CreateHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/CreateHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateHost(ctx, req.(*CreateOrganizationHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/UpdateHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateHost(ctx, req.(*UpdateOrganizationHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DeleteHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeleteHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/DeleteHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeleteHost(ctx, req.(*DeleteOrganizationHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/ListHosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListHosts(ctx, req.(*ListOrganizationHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CreateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/CreateBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateBundle(ctx, req.(*CreateOrganizationBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _OrganizationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.OrganizationService",
 	HandlerType: (*OrganizationServiceServer)(nil),
@@ -1416,74 +3356,222 @@ var _OrganizationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _OrganizationService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "BatchUpdateUsers",
+			Handler:    _OrganizationService_BatchUpdateUsers_Handler,
+		},
+		{
+			MethodName: "ImportUsers",
+			Handler:    _OrganizationService_ImportUsers_Handler,
+		},
+		{
+			MethodName: "CreateHTTPIntegration",
+			Handler:    _OrganizationService_CreateHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "GetHTTPIntegration",
+			Handler:    _OrganizationService_GetHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateHTTPIntegration",
+			Handler:    _OrganizationService_UpdateHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteHTTPIntegration",
+			Handler:    _OrganizationService_DeleteHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "CreateInfluxDBIntegration",
+			Handler:    _OrganizationService_CreateInfluxDBIntegration_Handler,
+		},
+		{
+			MethodName: "GetInfluxDBIntegration",
+			Handler:    _OrganizationService_GetInfluxDBIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateInfluxDBIntegration",
+			Handler:    _OrganizationService_UpdateInfluxDBIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteInfluxDBIntegration",
+			Handler:    _OrganizationService_DeleteInfluxDBIntegration_Handler,
+		},
+		{
+			MethodName: "ListIntegrations",
+			Handler:    _OrganizationService_ListIntegrations_Handler,
+		},
+		{
+			MethodName: "CreateHost",
+			Handler:    _OrganizationService_CreateHost_Handler,
+		},
+		{
+			MethodName: "UpdateHost",
+			Handler:    _OrganizationService_UpdateHost_Handler,
+		},
+		{
+			MethodName: "DeleteHost",
+			Handler:    _OrganizationService_DeleteHost_Handler,
+		},
+		{
+			MethodName: "ListHosts",
+			Handler:    _OrganizationService_ListHosts_Handler,
+		},
+		{
+			MethodName: "CreateBundle",
+			Handler:    _OrganizationService_CreateBundle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organization.proto",
 }
 
-func init() { proto.RegisterFile("organization.proto", fileDescriptor_organization_f7d28f2f8c3cdcd6) }
-
-var fileDescriptor_organization_f7d28f2f8c3cdcd6 = []byte{
-	// 971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0x23, 0x35,
-	0x18, 0x96, 0x93, 0x34, 0x6d, 0xdf, 0x54, 0xbb, 0x5b, 0x53, 0x9a, 0x64, 0xda, 0x90, 0xac, 0x85,
-	0x44, 0x08, 0xab, 0x44, 0x04, 0x16, 0x09, 0xb4, 0x97, 0xb0, 0x45, 0xa1, 0x12, 0x02, 0x69, 0x60,
-	0x25, 0x2e, 0x30, 0x78, 0x33, 0x6e, 0x6b, 0x29, 0x99, 0x99, 0x8d, 0x3d, 0x5d, 0x95, 0x55, 0x0f,
-	0x70, 0xd8, 0x03, 0x7b, 0xe4, 0x77, 0xf0, 0x37, 0xf8, 0x03, 0x1c, 0xb8, 0x72, 0xe0, 0x37, 0x70,
-	0x65, 0x65, 0x8f, 0x53, 0x39, 0xf3, 0xd1, 0xaf, 0xad, 0xd4, 0xdb, 0xd8, 0x7e, 0xfc, 0x3e, 0xcf,
-	0xfb, 0xf8, 0x7d, 0xed, 0x01, 0x1c, 0xce, 0x0f, 0x69, 0xc0, 0x7f, 0xa6, 0x92, 0x87, 0x41, 0x3f,
-	0x9a, 0x87, 0x32, 0xc4, 0x65, 0x1a, 0x71, 0x67, 0xf7, 0x30, 0x0c, 0x0f, 0xa7, 0x6c, 0x40, 0x23,
-	0x3e, 0xa0, 0x41, 0x10, 0x4a, 0x8d, 0x10, 0x09, 0xc4, 0x69, 0x9b, 0x55, 0x3d, 0x7a, 0x1a, 0x1f,
-	0x0c, 0x24, 0x9f, 0x31, 0x21, 0xe9, 0x2c, 0x32, 0x80, 0x9d, 0x34, 0x80, 0xcd, 0x22, 0x79, 0x92,
-	0x2c, 0x92, 0x5f, 0x10, 0x6c, 0x7c, 0x63, 0xf1, 0xe2, 0x3b, 0x50, 0xe2, 0x7e, 0x03, 0x75, 0x50,
-	0xb7, 0xec, 0x96, 0xb8, 0x8f, 0x31, 0x54, 0x02, 0x3a, 0x63, 0x8d, 0x52, 0x07, 0x75, 0xd7, 0x5d,
-	0xfd, 0x8d, 0xef, 0xc3, 0x86, 0xcf, 0x45, 0x34, 0xa5, 0x27, 0x9e, 0x5e, 0x2b, 0xeb, 0xb5, 0x9a,
-	0x99, 0xfb, 0x5a, 0x41, 0x7a, 0xb0, 0x39, 0xa1, 0x81, 0x77, 0x44, 0x8f, 0x99, 0x77, 0x48, 0x25,
-	0x7b, 0x4e, 0x4f, 0x44, 0xa3, 0xd2, 0x41, 0xdd, 0x35, 0xf7, 0xee, 0x84, 0x06, 0x5f, 0xd2, 0x63,
-	0x36, 0x36, 0xd3, 0xe4, 0x7f, 0x04, 0x5b, 0xb6, 0x86, 0xaf, 0xb8, 0x90, 0xfb, 0x92, 0xcd, 0x6e,
-	0x41, 0x0b, 0xfe, 0x14, 0x60, 0x32, 0x67, 0x54, 0x32, 0xdf, 0xa3, 0xb2, 0xb1, 0xd2, 0x41, 0xdd,
-	0xda, 0xd0, 0xe9, 0x27, 0x0e, 0xf6, 0x17, 0x0e, 0xf6, 0xbf, 0x5b, 0x58, 0xec, 0xae, 0x1b, 0xf4,
-	0x48, 0xaa, 0xad, 0x71, 0xe4, 0x2f, 0xb6, 0x56, 0x2f, 0xde, 0x6a, 0xd0, 0x23, 0x49, 0xba, 0xb0,
-	0x3d, 0x66, 0xd2, 0xf6, 0xc0, 0x65, 0xcf, 0x62, 0x26, 0x64, 0xda, 0x02, 0xf2, 0x27, 0x82, 0x7a,
-	0x06, 0x2a, 0xa2, 0x30, 0x10, 0x0c, 0x3f, 0x84, 0x0d, 0xbb, 0x84, 0xf4, 0xae, 0xda, 0x70, 0xb3,
-	0x4f, 0x23, 0xde, 0x5f, 0xda, 0xb0, 0x04, 0x4b, 0xa5, 0x5c, 0xba, 0x7e, 0xca, 0xe5, 0xab, 0xa4,
-	0xec, 0x42, 0xf3, 0xb1, 0x8e, 0x93, 0x97, 0xf5, 0xf5, 0x32, 0x21, 0x0f, 0xc0, 0xc9, 0x8b, 0x69,
-	0xec, 0x49, 0x5b, 0xe9, 0x42, 0xf3, 0x89, 0x96, 0x73, 0x83, 0x0a, 0x3e, 0x80, 0xe6, 0x1e, 0x9b,
-	0xb2, 0xfc, 0x98, 0x69, 0x01, 0x1e, 0xd4, 0x55, 0xa9, 0xe7, 0x41, 0xb7, 0x60, 0x65, 0xca, 0x67,
-	0x5c, 0x1a, 0x74, 0x32, 0xc0, 0xdb, 0x50, 0x0d, 0x0f, 0x0e, 0x04, 0x4b, 0x4e, 0xa9, 0xec, 0x9a,
-	0x91, 0x9a, 0x17, 0x8c, 0xce, 0x27, 0x47, 0xa6, 0xfa, 0xcd, 0x88, 0x04, 0xd0, 0xc8, 0x12, 0x18,
-	0x37, 0xda, 0x50, 0x93, 0xa1, 0xa4, 0x53, 0x6f, 0x12, 0xc6, 0xc1, 0x82, 0x07, 0xf4, 0xd4, 0x63,
-	0x35, 0x83, 0x3f, 0x84, 0xea, 0x9c, 0x89, 0x78, 0xaa, 0xc8, 0xca, 0xdd, 0xda, 0xb0, 0x99, 0xc9,
-	0x7d, 0xd1, 0xa7, 0xae, 0x01, 0x92, 0x57, 0x08, 0xee, 0xd9, 0x80, 0x27, 0x82, 0xcd, 0xf1, 0x7b,
-	0x70, 0xd7, 0xb6, 0xc8, 0x3b, 0xb3, 0xe0, 0x8e, 0x3d, 0xbd, 0xbf, 0x87, 0xeb, 0xb0, 0x1a, 0x0b,
-	0x36, 0x57, 0x00, 0x93, 0x9e, 0x1a, 0xee, 0xef, 0xe1, 0x26, 0xac, 0x71, 0xe1, 0x51, 0x7f, 0xc6,
-	0x03, 0x9d, 0xe0, 0x9a, 0xbb, 0xca, 0xc5, 0x48, 0x0d, 0xb1, 0x03, 0x6b, 0x0a, 0xa4, 0x3b, 0xbf,
-	0xa2, 0x73, 0x3f, 0x1b, 0x93, 0x7f, 0x10, 0x34, 0xd2, 0x6a, 0xce, 0xae, 0x16, 0x8b, 0x0c, 0x2d,
-	0x91, 0xd9, 0x11, 0x4b, 0xcb, 0x11, 0xcf, 0x13, 0xb2, 0xdc, 0x44, 0x95, 0xeb, 0x37, 0xd1, 0xca,
-	0x55, 0x9a, 0xe8, 0x27, 0x70, 0x46, 0xbe, 0x9f, 0x4e, 0x72, 0x51, 0x44, 0x9f, 0xc3, 0xe6, 0x92,
-	0xf3, 0x2a, 0x0f, 0x53, 0xc8, 0x6f, 0x67, 0x0e, 0x53, 0x6f, 0xbc, 0x17, 0xa6, 0x66, 0xc8, 0x04,
-	0x5a, 0xd9, 0x26, 0xb9, 0x69, 0x12, 0x0a, 0xad, 0x6c, 0xd7, 0xd8, 0x24, 0x6f, 0x5c, 0x43, 0x24,
-	0x86, 0xdd, 0x74, 0x2b, 0x28, 0x02, 0x71, 0x65, 0x86, 0xb3, 0xce, 0x54, 0xf1, 0x57, 0xb2, 0x9d,
-	0x59, 0xd6, 0xd3, 0x66, 0x44, 0x9e, 0x43, 0xab, 0x80, 0xf6, 0xb2, 0x6d, 0xf8, 0x30, 0xd5, 0x86,
-	0xad, 0x5c, 0x53, 0x33, 0xad, 0xf8, 0x23, 0x38, 0xa9, 0x67, 0xe2, 0x66, 0xfd, 0xfc, 0x1b, 0xc1,
-	0x4e, 0x2e, 0x81, 0xc9, 0xeb, 0x06, 0xca, 0xe2, 0x76, 0x1e, 0xa6, 0xe1, 0x7f, 0xeb, 0xf0, 0x96,
-	0x2d, 0xee, 0x5b, 0x36, 0x3f, 0xe6, 0x13, 0x86, 0x3d, 0xa8, 0x28, 0x97, 0xf1, 0xae, 0x96, 0x5f,
-	0x70, 0x71, 0x3b, 0xad, 0x82, 0xd5, 0xc4, 0x16, 0xe2, 0xfc, 0xfa, 0xd7, 0xbf, 0xbf, 0x97, 0xb6,
-	0x30, 0xd6, 0x3f, 0x73, 0x76, 0xc6, 0x02, 0x53, 0x28, 0x8f, 0x99, 0xc4, 0x3b, 0x3a, 0x42, 0xfe,
-	0xef, 0x80, 0xb3, 0x9b, 0xbf, 0x68, 0xa2, 0xb7, 0x75, 0xf4, 0x26, 0xae, 0x67, 0xa3, 0x0f, 0x5e,
-	0x70, 0xff, 0x14, 0x1f, 0x41, 0x35, 0x79, 0x20, 0xf1, 0x3b, 0x3a, 0x50, 0xe1, 0x0b, 0xec, 0xb4,
-	0x0b, 0xd7, 0x0d, 0x57, 0x4b, 0x73, 0xd5, 0x49, 0x4e, 0x26, 0x9f, 0xa1, 0x1e, 0x7e, 0x06, 0xd5,
-	0xe4, 0xde, 0x30, 0x4c, 0x85, 0x2f, 0xad, 0xb3, 0x9d, 0x39, 0x96, 0x2f, 0xd4, 0xff, 0x29, 0x19,
-	0x68, 0x82, 0xf7, 0x9d, 0x77, 0xf3, 0x92, 0x59, 0xfa, 0x55, 0xe6, 0xfe, 0xa9, 0xa2, 0xa4, 0x50,
-	0x4d, 0x6e, 0x11, 0x43, 0x59, 0xf8, 0x10, 0x17, 0x52, 0x1a, 0xff, 0x7a, 0x85, 0xfe, 0xbd, 0x44,
-	0xb0, 0xae, 0xce, 0x56, 0xf7, 0x30, 0xbe, 0x9f, 0x7b, 0xd6, 0xf6, 0xb5, 0xe2, 0x90, 0xf3, 0x20,
-	0xc6, 0xc9, 0xa1, 0x66, 0x7d, 0x80, 0x7b, 0x17, 0x25, 0xea, 0x71, 0xff, 0x74, 0x10, 0x6b, 0xea,
-	0xdf, 0x10, 0xac, 0x8e, 0x99, 0xd6, 0x81, 0xdb, 0x79, 0x35, 0x61, 0x75, 0xbb, 0xd3, 0x29, 0x06,
-	0x18, 0x09, 0x8f, 0xb4, 0x84, 0x4f, 0xf0, 0xc7, 0x97, 0x97, 0x30, 0x78, 0x61, 0x2e, 0x86, 0x53,
-	0xfc, 0x0a, 0xc1, 0xea, 0xc8, 0xf7, 0x2d, 0x31, 0xc5, 0x8f, 0x52, 0xa1, 0xf7, 0x63, 0x2d, 0x61,
-	0x44, 0x1e, 0x5d, 0x28, 0x41, 0xf1, 0xf6, 0xf3, 0x45, 0xa9, 0x32, 0xf8, 0x03, 0x01, 0x24, 0xd5,
-	0xa6, 0x05, 0x91, 0x82, 0xf2, 0xbb, 0x8c, 0xa6, 0x89, 0xd6, 0xf4, 0x83, 0xf3, 0xfd, 0x9b, 0x68,
-	0xca, 0x43, 0x2e, 0xac, 0x53, 0x7a, 0x5f, 0x22, 0x80, 0xa4, 0x54, 0x2d, 0xbd, 0xe7, 0x3e, 0x87,
-	0x85, 0x7a, 0xcd, 0x31, 0xf6, 0xae, 0x75, 0x8c, 0x4f, 0xab, 0x3a, 0xda, 0x47, 0xaf, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x3c, 0xad, 0xf9, 0x9b, 0x88, 0x0e, 0x00, 0x00,
+func init() { proto.RegisterFile("organization.proto", fileDescriptor_organization_62a3ff9ba9dd4922) }
+
+var fileDescriptor_organization_62a3ff9ba9dd4922 = []byte{
+	// 2316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x57, 0xcf, 0xf8, 0xf3, 0xf9, 0xbb, 0xd6, 0x9f, 0x9d, 0x38, 0xf6, 0xb6, 0x9d, 0xcd, 0x78,
+	0xe2, 0xf5, 0x24, 0x8e, 0x13, 0x58, 0x13, 0x58, 0xd9, 0xeb, 0x8d, 0xe3, 0x25, 0x09, 0xa6, 0x93,
+	0x08, 0x2e, 0x30, 0xb4, 0xa7, 0xcb, 0xe3, 0x26, 0x33, 0xdd, 0xbd, 0xdd, 0x35, 0xce, 0x86, 0x55,
+	0x0e, 0xac, 0xd0, 0x22, 0xb1, 0x07, 0x84, 0xe0, 0x82, 0x90, 0x10, 0x42, 0x5c, 0x40, 0xe2, 0x0f,
+	0xd8, 0x03, 0x07, 0x2e, 0x1c, 0xb8, 0x72, 0x40, 0xe2, 0xc4, 0x81, 0xff, 0x03, 0x54, 0xaf, 0xaa,
+	0xc7, 0x35, 0xfd, 0x31, 0x9e, 0xf1, 0x78, 0xd9, 0x9b, 0xbb, 0xea, 0xd5, 0x7b, 0xbf, 0xf7, 0xab,
+	0xf7, 0xaa, 0x5e, 0xbd, 0x31, 0x10, 0x2f, 0xa8, 0x5a, 0xae, 0xf3, 0x23, 0x8b, 0x39, 0x9e, 0xbb,
+	0xe1, 0x07, 0x1e, 0xf3, 0x48, 0xde, 0xf2, 0x1d, 0xfd, 0x6a, 0xd5, 0xf3, 0xaa, 0x35, 0x5a, 0xb2,
+	0x7c, 0xa7, 0x64, 0xb9, 0xae, 0xc7, 0x50, 0x22, 0x14, 0x22, 0xfa, 0x92, 0x9c, 0xc5, 0xaf, 0xa3,
+	0xc6, 0x71, 0x89, 0x39, 0x75, 0x1a, 0x32, 0xab, 0xee, 0x4b, 0x81, 0x2b, 0x71, 0x01, 0x5a, 0xf7,
+	0xd9, 0x2b, 0x39, 0x39, 0x65, 0xf9, 0x7e, 0xcd, 0xa9, 0x28, 0x36, 0x8d, 0x1f, 0x6b, 0x30, 0xfa,
+	0x2d, 0x05, 0x0a, 0x19, 0x87, 0x9c, 0x63, 0xcf, 0x6b, 0xcb, 0x5a, 0x21, 0x6f, 0xe6, 0x1c, 0x9b,
+	0x10, 0xe8, 0x73, 0xad, 0x3a, 0x9d, 0xcf, 0x2d, 0x6b, 0x85, 0x61, 0x13, 0xff, 0x26, 0x6f, 0xc2,
+	0xa8, 0xed, 0x84, 0x7e, 0xcd, 0x7a, 0x55, 0xc6, 0xb9, 0x3c, 0xce, 0x8d, 0xc8, 0xb1, 0x27, 0x5c,
+	0xa4, 0x08, 0x53, 0x15, 0xcb, 0x2d, 0x9f, 0x58, 0xa7, 0xb4, 0x5c, 0xb5, 0x18, 0x7d, 0x69, 0xbd,
+	0x0a, 0xe7, 0xfb, 0x96, 0xb5, 0xc2, 0x90, 0x39, 0x51, 0xb1, 0xdc, 0x87, 0xd6, 0x29, 0xdd, 0x97,
+	0xc3, 0xc6, 0x7f, 0x35, 0x98, 0x56, 0x31, 0x3c, 0x72, 0x42, 0x76, 0xc0, 0x68, 0xfd, 0x4b, 0xc0,
+	0x42, 0xde, 0x01, 0xa8, 0x04, 0xd4, 0x62, 0xd4, 0x2e, 0x5b, 0x6c, 0xbe, 0x7f, 0x59, 0x2b, 0x8c,
+	0x6c, 0xea, 0x1b, 0x82, 0xd4, 0x8d, 0x88, 0xd4, 0x8d, 0x67, 0x11, 0xeb, 0xe6, 0xb0, 0x94, 0xde,
+	0x61, 0x7c, 0x69, 0xc3, 0xb7, 0xa3, 0xa5, 0x03, 0xe7, 0x2f, 0x95, 0xd2, 0x3b, 0xcc, 0x28, 0xc0,
+	0xec, 0x3e, 0x65, 0x2a, 0x07, 0x26, 0xfd, 0xb0, 0x41, 0x43, 0x16, 0xa7, 0xc0, 0xf8, 0x9b, 0x06,
+	0x73, 0x09, 0xd1, 0xd0, 0xf7, 0xdc, 0x90, 0x92, 0xbb, 0x30, 0xaa, 0x46, 0x15, 0xae, 0x1a, 0xd9,
+	0x9c, 0xda, 0xb0, 0x7c, 0x67, 0xa3, 0x65, 0x41, 0x8b, 0x58, 0xcc, 0xe5, 0xdc, 0xc5, 0x5d, 0xce,
+	0x77, 0xe3, 0xb2, 0x09, 0x0b, 0xef, 0xa1, 0x9e, 0x34, 0xaf, 0x2f, 0xe6, 0x89, 0xb1, 0x0e, 0x7a,
+	0x9a, 0x4e, 0x49, 0x4f, 0x9c, 0x4a, 0x13, 0x16, 0x9e, 0xfb, 0x76, 0x42, 0xba, 0x27, 0x04, 0x37,
+	0x61, 0x61, 0x8f, 0xd6, 0x68, 0xba, 0xce, 0x38, 0x80, 0x32, 0xcc, 0xf1, 0x50, 0x4f, 0x13, 0x9d,
+	0x86, 0xfe, 0x9a, 0x53, 0x77, 0x98, 0x94, 0x16, 0x1f, 0x64, 0x16, 0x06, 0xbc, 0xe3, 0xe3, 0x90,
+	0x8a, 0x5d, 0xca, 0x9b, 0xf2, 0x8b, 0x8f, 0x87, 0xd4, 0x0a, 0x2a, 0x27, 0x32, 0xfa, 0xe5, 0x97,
+	0xe1, 0xc2, 0x7c, 0xd2, 0x80, 0x64, 0x63, 0x09, 0x46, 0x98, 0xc7, 0xac, 0x5a, 0xb9, 0xe2, 0x35,
+	0xdc, 0xc8, 0x0e, 0xe0, 0xd0, 0x7b, 0x7c, 0x84, 0xdc, 0x86, 0x81, 0x80, 0x86, 0x8d, 0x1a, 0x37,
+	0x96, 0x2f, 0x8c, 0x6c, 0x2e, 0x24, 0x7c, 0x8f, 0xf2, 0xd4, 0x94, 0x82, 0xc6, 0x67, 0x1a, 0x4c,
+	0xaa, 0x02, 0xcf, 0x43, 0x1a, 0x90, 0x1b, 0x30, 0xa1, 0x52, 0x54, 0x6e, 0x52, 0x30, 0xae, 0x0e,
+	0x1f, 0xec, 0x91, 0x39, 0x18, 0x6c, 0x84, 0x34, 0xe0, 0x02, 0xd2, 0x3d, 0xfe, 0x79, 0xb0, 0x47,
+	0x16, 0x60, 0xc8, 0x09, 0xcb, 0x96, 0x5d, 0x77, 0x5c, 0x74, 0x70, 0xc8, 0x1c, 0x74, 0xc2, 0x1d,
+	0xfe, 0x49, 0x74, 0x18, 0xe2, 0x42, 0x98, 0xf9, 0x7d, 0xe8, 0x7b, 0xf3, 0xdb, 0xf8, 0xb7, 0x06,
+	0xf3, 0x71, 0x34, 0xcd, 0xa3, 0x45, 0x31, 0xa6, 0xb5, 0x18, 0x53, 0x35, 0xe6, 0x5a, 0x35, 0xb6,
+	0x03, 0xd2, 0x9a, 0x44, 0x7d, 0x17, 0x4f, 0xa2, 0xfe, 0x6e, 0x92, 0xe8, 0x07, 0xa0, 0xef, 0xd8,
+	0x76, 0xdc, 0xc9, 0x28, 0x88, 0x76, 0x61, 0xaa, 0x85, 0x79, 0xee, 0x87, 0x0c, 0xe4, 0x99, 0xc4,
+	0x66, 0xe2, 0xc2, 0x49, 0x2f, 0x36, 0x62, 0x54, 0x60, 0x31, 0x99, 0x24, 0x97, 0x6d, 0xc4, 0x82,
+	0xc5, 0x64, 0xd6, 0xa8, 0x46, 0x7a, 0x8e, 0x21, 0xa3, 0x01, 0x57, 0xe3, 0xa9, 0xc0, 0x0d, 0x84,
+	0x5d, 0x5b, 0x68, 0x66, 0x26, 0xd7, 0xdf, 0x9f, 0xcc, 0xcc, 0x3c, 0x0e, 0xcb, 0x2f, 0xe3, 0x25,
+	0x2c, 0x66, 0x98, 0xed, 0x34, 0x0d, 0xef, 0xc6, 0xd2, 0x70, 0x31, 0x95, 0xd4, 0x44, 0x2a, 0x7e,
+	0x1f, 0xf4, 0xd8, 0x35, 0x71, 0xb9, 0x7c, 0xfe, 0x5d, 0x83, 0x95, 0x5d, 0x8b, 0x55, 0x4e, 0xd2,
+	0xa3, 0xa3, 0x7b, 0x5e, 0x6f, 0x42, 0x3f, 0x57, 0x1d, 0x4a, 0x37, 0x33, 0x62, 0x47, 0xc8, 0x90,
+	0xb7, 0x60, 0x22, 0xa0, 0x75, 0xef, 0x94, 0x96, 0x25, 0xba, 0x70, 0x3e, 0xbf, 0x9c, 0x2f, 0xe4,
+	0xcd, 0x31, 0x31, 0xfc, 0x1c, 0x41, 0x86, 0xe4, 0x3a, 0x8c, 0x4b, 0xb9, 0xba, 0x13, 0x86, 0x8e,
+	0x5b, 0x95, 0xd7, 0xbe, 0x14, 0x7b, 0x2c, 0x06, 0x8d, 0x4f, 0x34, 0xb8, 0x76, 0x50, 0xf7, 0xbd,
+	0xe0, 0x12, 0xe2, 0x63, 0x12, 0xf2, 0x95, 0xf0, 0x54, 0x1e, 0x1d, 0xfc, 0xcf, 0x14, 0x10, 0xf9,
+	0x34, 0x10, 0xbf, 0xd0, 0x60, 0xb5, 0x3d, 0xa3, 0x67, 0x21, 0x63, 0xd9, 0x36, 0xb5, 0x95, 0x90,
+	0x19, 0x33, 0x01, 0x87, 0x44, 0xc8, 0xac, 0xc0, 0x58, 0x74, 0xa0, 0x08, 0x91, 0x1c, 0x8a, 0x8c,
+	0xca, 0xc1, 0xa6, 0x90, 0xb0, 0x1f, 0x09, 0xe5, 0x85, 0x90, 0x1c, 0x44, 0x21, 0xe3, 0x9f, 0x1a,
+	0x5c, 0x49, 0x0d, 0x23, 0x09, 0xe5, 0x12, 0x92, 0xff, 0x4b, 0x2a, 0x3f, 0x7e, 0xdd, 0x07, 0x57,
+	0x54, 0x70, 0x0f, 0x9f, 0x3d, 0x3b, 0x3c, 0x70, 0x19, 0xad, 0x06, 0xf8, 0xd9, 0xf9, 0x7e, 0xdf,
+	0x80, 0x09, 0xa5, 0xaa, 0xc6, 0x50, 0xcc, 0x61, 0x28, 0x8e, 0x2b, 0xc3, 0x3c, 0x16, 0xb7, 0x60,
+	0xf0, 0x84, 0x5a, 0x36, 0x0d, 0x44, 0xac, 0x72, 0xa4, 0x9c, 0xa1, 0x98, 0xe1, 0x87, 0x28, 0x62,
+	0x46, 0xa2, 0x3c, 0xd2, 0x1b, 0x7e, 0xcd, 0x71, 0x5f, 0x94, 0x6d, 0x8b, 0x59, 0xe5, 0x46, 0x50,
+	0x93, 0xf7, 0xdc, 0x98, 0x18, 0xde, 0xb3, 0x98, 0xf5, 0xdc, 0x7c, 0x44, 0x36, 0x61, 0xe6, 0x87,
+	0x9e, 0xe3, 0x96, 0x5d, 0x8f, 0x39, 0xc7, 0x11, 0x18, 0x2e, 0xdd, 0x8f, 0xd2, 0x6f, 0xf0, 0xc9,
+	0x27, 0xca, 0x1c, 0x5f, 0x73, 0x0b, 0xa6, 0xad, 0xca, 0x8b, 0xe4, 0x92, 0x01, 0x5c, 0x42, 0xac,
+	0xca, 0x8b, 0xf8, 0x8a, 0x2d, 0x98, 0xa5, 0x41, 0xe0, 0x05, 0xc9, 0x35, 0x83, 0xb8, 0x66, 0x1a,
+	0x67, 0xe3, 0xab, 0xee, 0xc1, 0x5c, 0xc8, 0x2c, 0xd6, 0x08, 0x93, 0xcb, 0x86, 0x70, 0xd9, 0x8c,
+	0x98, 0x8e, 0xaf, 0xdb, 0x86, 0x85, 0x9a, 0x27, 0x85, 0x13, 0x2b, 0x87, 0x71, 0xe5, 0x5c, 0x24,
+	0x90, 0x5c, 0x3b, 0x4c, 0x5d, 0xdb, 0xf7, 0x1c, 0x97, 0x85, 0xf3, 0x80, 0x7c, 0x5f, 0x4d, 0xe3,
+	0xfb, 0x7d, 0x29, 0x64, 0x9e, 0x89, 0x1b, 0x2e, 0x14, 0x92, 0x65, 0x64, 0x6c, 0xdd, 0xd9, 0xf5,
+	0x37, 0xe2, 0x9c, 0x8d, 0xca, 0xd8, 0x5f, 0x4e, 0xc4, 0x7e, 0x7c, 0xb5, 0xba, 0xc8, 0x38, 0x84,
+	0xeb, 0xb1, 0x24, 0xcb, 0x30, 0xd6, 0x69, 0x50, 0x1a, 0x35, 0x78, 0xeb, 0x3c, 0x8d, 0xcd, 0x0c,
+	0xee, 0x1d, 0xbf, 0x0b, 0x85, 0xe4, 0x99, 0xf5, 0x05, 0xf2, 0xf5, 0x14, 0x0a, 0xc9, 0x72, 0xa1,
+	0x57, 0xca, 0xfe, 0x9a, 0x83, 0x25, 0x55, 0xdf, 0x81, 0x7b, 0x5c, 0x6b, 0x7c, 0xb4, 0xb7, 0xfb,
+	0xc5, 0x1e, 0x0a, 0x3a, 0x0c, 0x45, 0x71, 0x27, 0x6b, 0xf7, 0xe6, 0x37, 0x7f, 0x2e, 0xd8, 0x47,
+	0x32, 0xdb, 0x73, 0xf6, 0x51, 0x4b, 0x65, 0xda, 0x1f, 0xab, 0x4c, 0x75, 0x18, 0xf2, 0xad, 0x30,
+	0x7c, 0xe9, 0x05, 0xb6, 0x4c, 0xdf, 0xe6, 0x37, 0x3f, 0x1a, 0x02, 0xca, 0xa8, 0x8b, 0x50, 0x7c,
+	0xaf, 0xe6, 0x54, 0xe4, 0x53, 0x59, 0xe4, 0xec, 0x1b, 0xcd, 0xc9, 0x43, 0x9c, 0xc3, 0x27, 0xf3,
+	0x16, 0x0c, 0xfb, 0x01, 0xad, 0x38, 0x21, 0xdf, 0x24, 0x9e, 0xa4, 0xe3, 0x9b, 0xb3, 0xb8, 0x49,
+	0x11, 0x2d, 0x87, 0xd1, 0xac, 0x79, 0x26, 0x68, 0x9c, 0xc2, 0x7a, 0x32, 0x71, 0x52, 0x88, 0x8c,
+	0x36, 0xe7, 0x41, 0x5a, 0x30, 0xac, 0x26, 0x82, 0x21, 0x4d, 0x43, 0x4b, 0x40, 0x3c, 0x83, 0xb5,
+	0x58, 0xb8, 0xb7, 0x31, 0xda, 0x71, 0x44, 0x30, 0x28, 0x76, 0xa2, 0x55, 0x26, 0xd2, 0x65, 0xf9,
+	0x72, 0x0a, 0xeb, 0xc9, 0x64, 0xfa, 0x3f, 0x70, 0xf8, 0x1d, 0x58, 0x4f, 0x26, 0xd5, 0x65, 0xd0,
+	0xf8, 0x18, 0x8c, 0x78, 0x09, 0xdc, 0x8b, 0xba, 0x8f, 0x60, 0xa5, 0xad, 0xba, 0x4e, 0xeb, 0xea,
+	0x5b, 0xb1, 0xba, 0x7a, 0x5e, 0x86, 0x77, 0x53, 0x55, 0xa2, 0xa4, 0xfe, 0x57, 0xec, 0x75, 0xfb,
+	0xd0, 0x0b, 0x19, 0x4f, 0xbc, 0x13, 0x2f, 0x64, 0x98, 0x4f, 0x9a, 0x48, 0xbc, 0xe8, 0x3b, 0xcd,
+	0xa7, 0x5c, 0xea, 0x71, 0xb1, 0x02, 0x63, 0x47, 0x81, 0xe5, 0xda, 0x8e, 0x5b, 0x2d, 0xd7, 0xbc,
+	0xaa, 0x27, 0x8f, 0x82, 0xd1, 0x68, 0xf0, 0x91, 0x57, 0xf5, 0xc8, 0x1d, 0x98, 0x69, 0x0a, 0x05,
+	0xb4, 0xea, 0x84, 0x4c, 0x6e, 0xb9, 0x38, 0x21, 0xa6, 0xa3, 0x49, 0x53, 0x99, 0xe3, 0x10, 0x9a,
+	0x8b, 0x8e, 0x3d, 0x8f, 0xd1, 0x40, 0x1e, 0x1d, 0xe3, 0xd1, 0xf0, 0x03, 0x1c, 0x35, 0x3e, 0x80,
+	0xc5, 0x94, 0x3b, 0xcf, 0x0b, 0x59, 0xb4, 0x41, 0x6b, 0xd0, 0xc7, 0x1d, 0xcb, 0xac, 0xee, 0x50,
+	0x16, 0x45, 0xb8, 0xae, 0x94, 0xfb, 0xe0, 0x62, 0xba, 0xec, 0xb4, 0xa7, 0xa1, 0xaa, 0xab, 0xe3,
+	0x33, 0x59, 0xdd, 0xa9, 0x5c, 0xeb, 0x4e, 0x19, 0xfb, 0xc9, 0xd7, 0x21, 0xb7, 0xd1, 0x75, 0xf5,
+	0x6f, 0x3c, 0x81, 0xc5, 0x0c, 0x45, 0x32, 0x2e, 0xdf, 0x6e, 0x86, 0x9d, 0x96, 0xf1, 0xce, 0x41,
+	0xe7, 0xa2, 0x98, 0xfb, 0xb9, 0xd6, 0x5a, 0xa6, 0xee, 0x36, 0x5c, 0xbb, 0x46, 0xb1, 0xe5, 0x80,
+	0xc5, 0x73, 0xbc, 0x43, 0xda, 0xae, 0x7b, 0x31, 0x0d, 0xfd, 0xb4, 0x6e, 0x39, 0x35, 0x19, 0x5d,
+	0xe2, 0xa3, 0xe5, 0xe6, 0xe8, 0x8b, 0xdd, 0x1c, 0xbc, 0xdf, 0xea, 0xb1, 0xe8, 0xb6, 0xc1, 0xbf,
+	0x8d, 0xdf, 0xe7, 0x60, 0x29, 0x19, 0x29, 0x02, 0x57, 0x6f, 0xcd, 0x33, 0xf2, 0x2e, 0x00, 0xf6,
+	0x56, 0xc4, 0x33, 0x22, 0x97, 0x51, 0x1a, 0xc4, 0x28, 0x30, 0x87, 0xad, 0x26, 0x1b, 0x45, 0x98,
+	0x72, 0x29, 0x7b, 0xe9, 0x05, 0x2f, 0xca, 0x21, 0x0d, 0x4e, 0xc5, 0xbb, 0x35, 0x8f, 0xe4, 0x4c,
+	0xc8, 0x89, 0xa7, 0x38, 0x7e, 0xb0, 0xc7, 0x8b, 0x5f, 0x2e, 0xe3, 0x54, 0x68, 0xd9, 0x0f, 0xbc,
+	0x63, 0xa7, 0x46, 0xcb, 0x4a, 0x17, 0x89, 0xc8, 0xb9, 0x43, 0x31, 0x85, 0x77, 0xe2, 0x1a, 0x4c,
+	0xaa, 0x97, 0xba, 0x72, 0x0f, 0xab, 0x97, 0x3d, 0x17, 0x35, 0x3e, 0xd7, 0x60, 0x39, 0x9b, 0x24,
+	0x19, 0x0a, 0xbd, 0x37, 0xc6, 0xd6, 0x81, 0xc4, 0x7d, 0x90, 0x0e, 0x0f, 0x9b, 0x93, 0xad, 0x1e,
+	0x1c, 0xec, 0xf1, 0x77, 0x68, 0x6b, 0x51, 0x82, 0xbe, 0xe6, 0xcd, 0xb1, 0x96, 0x9a, 0x64, 0xf3,
+	0x8f, 0xd7, 0xe0, 0x0d, 0x15, 0xf5, 0x53, 0xa1, 0x87, 0x94, 0xa1, 0x8f, 0x87, 0x36, 0x11, 0x65,
+	0x74, 0x46, 0xe3, 0x52, 0x5f, 0xcc, 0x98, 0x15, 0x3e, 0x1b, 0xfa, 0x27, 0xff, 0xf8, 0xcf, 0x2f,
+	0x73, 0xd3, 0x84, 0xe0, 0xef, 0x1b, 0xaa, 0x9f, 0x21, 0xb1, 0x20, 0xbf, 0x4f, 0x19, 0xb9, 0x82,
+	0x1a, 0xd2, 0xdb, 0xe1, 0xfa, 0xd5, 0xf4, 0x49, 0xa9, 0x7d, 0x09, 0xb5, 0x2f, 0x90, 0xb9, 0xa4,
+	0xf6, 0xd2, 0xc7, 0x8e, 0xfd, 0x9a, 0x9c, 0xc0, 0x80, 0xd8, 0x16, 0x72, 0x0d, 0x15, 0x65, 0x76,
+	0xa0, 0xf5, 0xa5, 0xcc, 0x79, 0x69, 0x6b, 0x11, 0x6d, 0xcd, 0x19, 0x29, 0x9e, 0x6c, 0x6b, 0x45,
+	0xf2, 0x21, 0x0c, 0x88, 0x33, 0x50, 0x5a, 0xca, 0xec, 0x34, 0xeb, 0xb3, 0x89, 0x07, 0xeb, 0xfb,
+	0xfc, 0x27, 0x1b, 0xa3, 0x84, 0x06, 0xd6, 0xf4, 0xd5, 0x34, 0x67, 0xd4, 0xcf, 0x0d, 0xc7, 0x7e,
+	0xcd, 0x4d, 0x5a, 0x30, 0x20, 0x8e, 0x4a, 0x69, 0x32, 0xb3, 0x11, 0x9d, 0x69, 0x52, 0xf2, 0x57,
+	0xcc, 0xe4, 0xef, 0x53, 0x0d, 0x86, 0xf9, 0xde, 0x62, 0x43, 0x82, 0xbc, 0x99, 0xba, 0xd7, 0x6a,
+	0xdb, 0x44, 0x37, 0xda, 0x89, 0x48, 0x26, 0x37, 0xd1, 0xea, 0x3a, 0x29, 0x9e, 0xe7, 0x68, 0xd9,
+	0xb1, 0x5f, 0x97, 0x44, 0x03, 0xe8, 0x67, 0x1a, 0x0c, 0xee, 0x53, 0xc4, 0x41, 0x96, 0xd2, 0x62,
+	0x42, 0xe9, 0x76, 0xe9, 0xcb, 0xd9, 0x02, 0x12, 0xc2, 0x7d, 0x84, 0x70, 0x8f, 0x6c, 0x75, 0x0e,
+	0xa1, 0xf4, 0xb1, 0xcc, 0xc9, 0xd7, 0xe4, 0x33, 0x0d, 0x06, 0x77, 0x6c, 0x5b, 0x01, 0x93, 0xdd,
+	0x94, 0xcd, 0xe4, 0x7e, 0x1f, 0x21, 0xec, 0x18, 0xf7, 0xcf, 0x85, 0xc0, 0xed, 0x6e, 0xa4, 0x83,
+	0xe2, 0x61, 0xf0, 0x67, 0x0d, 0x40, 0x44, 0x1b, 0x02, 0x32, 0x32, 0xc2, 0xaf, 0x13, 0x4c, 0x15,
+	0xc4, 0xf4, 0x3d, 0xfd, 0xbb, 0xbd, 0x60, 0x4a, 0x93, 0x8c, 0xa8, 0xe3, 0x78, 0x3f, 0xd5, 0x00,
+	0x44, 0xa8, 0x2a, 0x78, 0xdb, 0xb6, 0x83, 0x33, 0xf1, 0xca, 0x6d, 0x2c, 0x5e, 0x6c, 0x1b, 0xff,
+	0xa0, 0xc1, 0xa4, 0xd2, 0x80, 0x13, 0x31, 0x5e, 0x40, 0x38, 0x1d, 0x74, 0x3a, 0xf5, 0xb5, 0x0e,
+	0x24, 0x65, 0xb8, 0x7d, 0x0d, 0x71, 0xde, 0x35, 0x6e, 0x75, 0x81, 0xf3, 0x88, 0x2b, 0xe6, 0x7c,
+	0xfd, 0x46, 0x83, 0x11, 0xd1, 0xac, 0x14, 0x08, 0x57, 0x44, 0xe1, 0xda, 0xb6, 0x7d, 0xd9, 0x0d,
+	0x38, 0x49, 0xa2, 0x71, 0xbb, 0x0b, 0x70, 0x0e, 0x5a, 0x97, 0xd1, 0x37, 0x23, 0x4e, 0xcd, 0x78,
+	0x47, 0xed, 0xed, 0x8c, 0x13, 0x35, 0xfd, 0xe1, 0x9e, 0xb9, 0xc7, 0x8f, 0x11, 0xde, 0xbe, 0xb1,
+	0x9b, 0x7a, 0x46, 0x9d, 0xe9, 0x49, 0x46, 0xa3, 0x32, 0x19, 0x96, 0x4e, 0x18, 0xf3, 0x25, 0x5e,
+	0xb2, 0x4f, 0x59, 0x1c, 0x6c, 0x31, 0xed, 0xc8, 0xc8, 0x40, 0x7a, 0xb3, 0x23, 0x59, 0xc9, 0xee,
+	0x37, 0x10, 0xfe, 0x57, 0xc9, 0xbd, 0x8e, 0xd8, 0x4d, 0x40, 0x46, 0x7e, 0xc5, 0x0e, 0xa6, 0xf3,
+	0xdb, 0x69, 0x23, 0xe6, 0x3c, 0x7e, 0xf5, 0x4b, 0xe2, 0xf7, 0xb7, 0x1a, 0xcc, 0x88, 0x64, 0x4e,
+	0xc7, 0xdb, 0x69, 0x23, 0x27, 0x13, 0xaf, 0x24, 0xb4, 0x78, 0x51, 0x42, 0x3f, 0xd7, 0xa2, 0x1f,
+	0xa2, 0xd3, 0x3a, 0x3e, 0xb7, 0x33, 0x82, 0x36, 0xfb, 0x61, 0x9c, 0x09, 0xf4, 0xdb, 0x08, 0xf4,
+	0x9b, 0xc6, 0x83, 0xde, 0x88, 0x75, 0xd0, 0xb2, 0x7d, 0xc4, 0xc9, 0xfd, 0x8b, 0x86, 0xff, 0x37,
+	0x90, 0x06, 0x7c, 0x23, 0x2d, 0x28, 0xdb, 0xa0, 0x2e, 0x75, 0x2c, 0x2f, 0x03, 0x79, 0x17, 0xdd,
+	0xb9, 0x4f, 0xb6, 0xbb, 0xe7, 0x3d, 0x72, 0x01, 0xb9, 0x17, 0x01, 0x9b, 0xcd, 0x7d, 0x37, 0xcd,
+	0x90, 0xf3, 0xb8, 0xd7, 0x2f, 0x91, 0xfb, 0x3f, 0x69, 0xd1, 0x4f, 0xfd, 0xd9, 0xd8, 0xbb, 0x69,
+	0xa8, 0x64, 0x62, 0x97, 0x44, 0x17, 0x7b, 0x21, 0xfa, 0x77, 0x1a, 0x4c, 0x62, 0x3f, 0x43, 0x99,
+	0x25, 0x37, 0x52, 0x6b, 0xb3, 0x14, 0x64, 0x85, 0xf3, 0x05, 0x65, 0x50, 0xbc, 0x83, 0x58, 0xef,
+	0x90, 0xdb, 0x5d, 0x63, 0x25, 0x3f, 0xd1, 0x00, 0xe4, 0xc5, 0xc1, 0xfb, 0x2a, 0x46, 0xd6, 0x6d,
+	0x71, 0xf6, 0xf4, 0xcf, 0x64, 0x6c, 0x1b, 0x51, 0x6c, 0x19, 0xa5, 0x34, 0x14, 0xfc, 0xcd, 0x9f,
+	0xdc, 0x66, 0x3e, 0x8a, 0xd5, 0xd3, 0xaf, 0x9a, 0xd5, 0x93, 0x02, 0xa3, 0x6d, 0x37, 0x23, 0x13,
+	0xc6, 0x07, 0x08, 0x63, 0x4f, 0x7f, 0xb7, 0x4b, 0x18, 0x72, 0x32, 0x6a, 0x4e, 0x60, 0x91, 0xf4,
+	0xd3, 0x66, 0x91, 0xa4, 0xc0, 0x6a, 0xdb, 0x18, 0xc9, 0x84, 0xf5, 0x75, 0x84, 0xf5, 0x95, 0xe2,
+	0xdd, 0x8e, 0xf6, 0x48, 0x41, 0x84, 0x60, 0x9a, 0x4f, 0x00, 0x6e, 0x2a, 0xeb, 0x09, 0xa0, 0xf6,
+	0x4e, 0x74, 0xa3, 0x9d, 0xc8, 0x85, 0x9e, 0x00, 0x88, 0x89, 0xf0, 0xff, 0x5c, 0x13, 0xf1, 0x21,
+	0xde, 0xd5, 0x64, 0x35, 0x23, 0x64, 0x5a, 0x7a, 0x13, 0xfa, 0xf5, 0x73, 0xa4, 0x24, 0xa2, 0x55,
+	0x44, 0x74, 0xcd, 0x58, 0x48, 0x41, 0x74, 0x84, 0xa2, 0xdb, 0x5a, 0xf1, 0x68, 0x00, 0xb9, 0xbd,
+	0xf3, 0xbf, 0x01, 0x00, 0x5c, 0x43, 0xc1, 0x43, 0xce, 0x27, 0x00, 0x00,
 }
@@ -40,7 +40,7 @@ func (x RatePolicy) String() string {
 	return proto.EnumName(RatePolicy_name, int32(x))
 }
 func (RatePolicy) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_profiles_f841b2f038c61935, []int{0}
+	return fileDescriptor_profiles_03362614d50d1d73, []int{0}
 }
 
 type ServiceProfile struct {
@@ -90,7 +90,16 @@ type ServiceProfile struct {
 	// Target Packet Error Rate.
 	TargetPer uint32 `protobuf:"varint,19,opt,name=target_per,json=targetPER,proto3" json:"target_per,omitempty"`
 	// Minimum number of receiving GWs (informative).
-	MinGwDiversity       uint32   `protobuf:"varint,20,opt,name=min_gw_diversity,json=minGWDiversity,proto3" json:"min_gw_diversity,omitempty"`
+	MinGwDiversity uint32 `protobuf:"varint,20,opt,name=min_gw_diversity,json=minGWDiversity,proto3" json:"min_gw_diversity,omitempty"`
+	// Gateway meta-data fields to drop or redact from the uplink events of
+	// the applications using this service-profile, in addition to the
+	// redact fields of the application. Supported fields are:
+	// rxInfo:          drops the rx-info of the receiving gateways
+	// rxInfo.location: drops the location of the receiving gateways
+	// rxInfo.gateway:  redacts the ID, name and tags of the receiving gateways
+	// rxInfo.time:     drops the (fine-)timestamps of the receiving gateways
+	// txInfo:          drops the tx-info (frequency and data-rate)
+	MetadataRedactFields []string `protobuf:"bytes,25,rep,name=metadata_redact_fields,json=metadataRedactFields,proto3" json:"metadata_redact_fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -100,7 +109,7 @@ func (m *ServiceProfile) Reset()         { *m = ServiceProfile{} }
 func (m *ServiceProfile) String() string { return proto.CompactTextString(m) }
 func (*ServiceProfile) ProtoMessage()    {}
 func (*ServiceProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_profiles_f841b2f038c61935, []int{0}
+	return fileDescriptor_profiles_03362614d50d1d73, []int{0}
 }
 func (m *ServiceProfile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServiceProfile.Unmarshal(m, b)
@@ -281,6 +290,13 @@ func (m *ServiceProfile) GetMinGwDiversity() uint32 {
 	return 0
 }
 
+func (m *ServiceProfile) GetMetadataRedactFields() []string {
+	if m != nil {
+		return m.MetadataRedactFields
+	}
+	return nil
+}
+
 type DeviceProfile struct {
 	// Device-profile ID (UUID string).
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -290,6 +306,18 @@ type DeviceProfile struct {
 	OrganizationId int64 `protobuf:"varint,22,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
 	// Network-server ID on which the service-profile is provisioned.
 	NetworkServerId int64 `protobuf:"varint,23,opt,name=network_server_id,json=networkServerID,proto3" json:"network_server_id,omitempty"`
+	// Device-profile is shared with all organizations.
+	// Shared device-profiles can be used (read-only) by the devices of all
+	// organizations. Only global admin users are allowed to set this flag.
+	IsShared bool `protobuf:"varint,24,opt,name=is_shared,json=isShared,proto3" json:"is_shared,omitempty"`
+	// Max. number of downlinks per device per hour (0 = unlimited).
+	// Enqueueing a downlink exceeding this limit fails with a
+	// DOWNLINK_RATE_LIMITED error.
+	MaxDownlinksPerHour uint32 `protobuf:"varint,25,opt,name=max_downlinks_per_hour,json=maxDownlinksPerHour,proto3" json:"max_downlinks_per_hour,omitempty"`
+	// Max. number of downlinks per device per day (0 = unlimited).
+	// Enqueueing a downlink exceeding this limit fails with a
+	// DOWNLINK_RATE_LIMITED error.
+	MaxDownlinksPerDay uint32 `protobuf:"varint,26,opt,name=max_downlinks_per_day,json=maxDownlinksPerDay,proto3" json:"max_downlinks_per_day,omitempty"`
 	// End-Device supports Class B.
 	SupportsClassB bool `protobuf:"varint,2,opt,name=supports_class_b,json=supportsClassB,proto3" json:"supports_class_b,omitempty"`
 	// Maximum delay for the End-Device to answer a MAC request or a confirmed DL frame (mandatory if class B mode supported).
@@ -337,7 +365,7 @@ func (m *DeviceProfile) Reset()         { *m = DeviceProfile{} }
 func (m *DeviceProfile) String() string { return proto.CompactTextString(m) }
 func (*DeviceProfile) ProtoMessage()    {}
 func (*DeviceProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_profiles_f841b2f038c61935, []int{1}
+	return fileDescriptor_profiles_03362614d50d1d73, []int{1}
 }
 func (m *DeviceProfile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeviceProfile.Unmarshal(m, b)
@@ -385,6 +413,27 @@ func (m *DeviceProfile) GetNetworkServerId() int64 {
 	return 0
 }
 
+func (m *DeviceProfile) GetIsShared() bool {
+	if m != nil {
+		return m.IsShared
+	}
+	return false
+}
+
+func (m *DeviceProfile) GetMaxDownlinksPerHour() uint32 {
+	if m != nil {
+		return m.MaxDownlinksPerHour
+	}
+	return 0
+}
+
+func (m *DeviceProfile) GetMaxDownlinksPerDay() uint32 {
+	if m != nil {
+		return m.MaxDownlinksPerDay
+	}
+	return 0
+}
+
 func (m *DeviceProfile) GetSupportsClassB() bool {
 	if m != nil {
 		return m.SupportsClassB
@@ -524,66 +573,71 @@ func init() {
 	proto.RegisterEnum("api.RatePolicy", RatePolicy_name, RatePolicy_value)
 }
 
-func init() { proto.RegisterFile("profiles.proto", fileDescriptor_profiles_f841b2f038c61935) }
-
-var fileDescriptor_profiles_f841b2f038c61935 = []byte{
-	// 917 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x5d, 0x6f, 0xdb, 0x36,
-	0x14, 0x9d, 0x9b, 0xd4, 0xb1, 0x19, 0x4b, 0x76, 0x98, 0xa4, 0x55, 0xf7, 0xe9, 0xa5, 0xc3, 0x66,
-	0x14, 0x58, 0xb6, 0x38, 0x18, 0x86, 0x3d, 0x36, 0x51, 0x1b, 0x64, 0x5b, 0x50, 0x83, 0x19, 0xd6,
-	0x47, 0xe2, 0x5a, 0xa4, 0x1d, 0xce, 0x92, 0xa8, 0x50, 0x94, 0x2d, 0xe7, 0x8f, 0x6e, 0x3f, 0x67,
-	0xe0, 0x95, 0xfc, 0xd1, 0x14, 0x7b, 0xdf, 0x9b, 0x75, 0xce, 0xb9, 0x3c, 0xbc, 0xbc, 0x3c, 0x34,
-	0xf1, 0x33, 0xa3, 0x27, 0x2a, 0x96, 0xf9, 0x69, 0x66, 0xb4, 0xd5, 0x74, 0x07, 0x32, 0x75, 0xf2,
-	0x77, 0x93, 0xf8, 0xb7, 0xd2, 0xcc, 0x55, 0x24, 0x47, 0x15, 0x4d, 0x7d, 0xf2, 0x44, 0x89, 0xa0,
-	0xd1, 0x6f, 0x0c, 0xda, 0xec, 0x89, 0x12, 0x94, 0x92, 0xdd, 0x14, 0x12, 0x19, 0x1c, 0x23, 0x82,
-	0xbf, 0xe9, 0x77, 0xa4, 0xab, 0xcd, 0x14, 0x52, 0xf5, 0x00, 0x56, 0xe9, 0x94, 0x2b, 0x11, 0x3c,
-	0xeb, 0x37, 0x06, 0x3b, 0xcc, 0xdf, 0x86, 0xaf, 0x43, 0xfa, 0x8a, 0x1c, 0xa4, 0xd2, 0x2e, 0xb4,
-	0x99, 0xf1, 0x5c, 0x9a, 0xb9, 0x34, 0x4e, 0xfa, 0x1c, 0xa5, 0xdd, 0x9a, 0xb8, 0x45, 0xfc, 0x3a,
-	0xa4, 0xcf, 0xc9, 0x5e, 0x11, 0x73, 0x03, 0x56, 0x06, 0x4f, 0xfa, 0x8d, 0x81, 0xc7, 0x9a, 0x45,
-	0xcc, 0xc0, 0x4a, 0xfa, 0x0d, 0xf1, 0x8b, 0x98, 0x8f, 0x8b, 0x68, 0x26, 0x2d, 0xcf, 0xd5, 0x83,
-	0x0c, 0x76, 0x90, 0xef, 0x14, 0xf1, 0x05, 0x82, 0xb7, 0xea, 0x41, 0xd2, 0x9f, 0x50, 0xe5, 0xca,
-	0x79, 0xa6, 0x63, 0x15, 0x2d, 0x83, 0xdd, 0x7e, 0x63, 0xe0, 0x0f, 0xbb, 0xa7, 0x90, 0xa9, 0x53,
-	0xb7, 0xd0, 0x08, 0x61, 0x57, 0xb6, 0xf9, 0x72, 0xae, 0xa2, 0x76, 0x7d, 0x5a, 0xb9, 0x8a, 0xb5,
-	0xab, 0xf8, 0xd0, 0xb5, 0x59, 0xb9, 0x8a, 0x47, 0xae, 0xe2, 0x43, 0xd7, 0xbd, 0xff, 0x70, 0x15,
-	0xdb, 0xae, 0xdf, 0x92, 0x2e, 0x08, 0xc1, 0xa7, 0x0b, 0x9e, 0x48, 0x0b, 0x02, 0x2c, 0x04, 0xad,
-	0x7e, 0x63, 0xd0, 0x62, 0x1e, 0x08, 0x71, 0xf5, 0xfe, 0x46, 0x5a, 0x08, 0xc1, 0x02, 0xfd, 0x9e,
-	0x1c, 0x0a, 0x39, 0xe7, 0xb9, 0x05, 0x5b, 0xe4, 0xdc, 0xc8, 0x7b, 0x3e, 0x31, 0xf2, 0x3e, 0x68,
-	0xe3, 0x4e, 0x7a, 0x42, 0xce, 0x6f, 0x91, 0x61, 0xf2, 0xfe, 0xad, 0x91, 0xf7, 0xf4, 0x17, 0xf2,
-	0xc2, 0xc8, 0x4c, 0x1b, 0xcb, 0xb7, 0xaa, 0xc6, 0x60, 0xad, 0x34, 0xcb, 0x80, 0xa0, 0xc1, 0xb3,
-	0x4a, 0x10, 0xae, 0x4a, 0x2f, 0x2a, 0x96, 0xfe, 0x4c, 0x82, 0x8f, 0x4b, 0x13, 0x30, 0x53, 0x95,
-	0x06, 0xfb, 0x58, 0x79, 0xfc, 0xa8, 0xf2, 0x06, 0x49, 0x7a, 0x4c, 0x9a, 0xc2, 0xf0, 0x44, 0xa5,
-	0x41, 0x07, 0x77, 0xf5, 0x54, 0x98, 0x9b, 0x0d, 0x0c, 0x65, 0xe0, 0xad, 0x61, 0x28, 0xe9, 0xd7,
-	0xa4, 0x13, 0xdd, 0x41, 0x9a, 0xca, 0x98, 0x27, 0x90, 0xcf, 0x02, 0xbf, 0xdf, 0x18, 0x74, 0xd8,
-	0x7e, 0x8d, 0xdd, 0x40, 0x3e, 0xa3, 0x5f, 0x10, 0x92, 0x19, 0x0e, 0x71, 0xac, 0x17, 0x52, 0x04,
-	0x5d, 0xf4, 0x6e, 0x67, 0xe6, 0x75, 0x05, 0x38, 0xfa, 0x6e, 0x43, 0xf7, 0x2a, 0xfa, 0x6e, 0x9b,
-	0x36, 0xb0, 0xa6, 0x0f, 0x2a, 0xda, 0xc0, 0x8a, 0xfe, 0x92, 0xec, 0xa7, 0x8b, 0x19, 0x9f, 0x4a,
-	0xcd, 0x63, 0x1d, 0x05, 0xb4, 0xe2, 0xd3, 0xc5, 0xec, 0x4a, 0xea, 0xdf, 0x75, 0xe4, 0xca, 0x2d,
-	0x98, 0xa9, 0xb4, 0x3c, 0x93, 0x26, 0x38, 0xc4, 0xad, 0xb7, 0x2b, 0x64, 0xf4, 0x86, 0xd1, 0x01,
-	0xe9, 0x25, 0x2a, 0x75, 0x73, 0x13, 0x6a, 0x2e, 0x4d, 0xae, 0xec, 0x32, 0x38, 0x42, 0x91, 0x9f,
-	0xa8, 0xf4, 0xea, 0x7d, 0xb8, 0x42, 0x4f, 0xfe, 0x69, 0x12, 0x2f, 0x94, 0xff, 0x8b, 0x60, 0x0d,
-	0x48, 0x2f, 0x2f, 0x32, 0x37, 0xbb, 0x9c, 0x47, 0x31, 0xe4, 0x39, 0x1f, 0x63, 0xc2, 0x5a, 0xcc,
-	0x5f, 0xe1, 0x97, 0x0e, 0xbe, 0x70, 0xd7, 0xb2, 0x16, 0x70, 0xab, 0x12, 0xa9, 0x0b, 0x5b, 0x47,
-	0xcd, 0x43, 0xf8, 0xe2, 0x8f, 0x0a, 0x74, 0x2b, 0x66, 0x2a, 0x9d, 0xf2, 0x3c, 0xd6, 0x78, 0x50,
-	0x4a, 0x0b, 0x4c, 0x9b, 0xc7, 0x7c, 0x87, 0xdf, 0xc6, 0xda, 0x8e, 0x10, 0xa5, 0x7d, 0xd2, 0xd9,
-	0x28, 0x85, 0xa9, 0x33, 0x46, 0x56, 0xaa, 0x90, 0xb9, 0x9c, 0x6d, 0x14, 0x78, 0xbb, 0xeb, 0x9c,
-	0xad, 0x34, 0x78, 0xb3, 0x3f, 0xee, 0x21, 0xc2, 0xa4, 0x3d, 0xee, 0xe1, 0x72, 0xd3, 0x43, 0xb4,
-	0xee, 0xa1, 0xb5, 0xd5, 0xc3, 0xe5, 0xaa, 0x87, 0xaf, 0xc8, 0x7e, 0x02, 0x11, 0xc7, 0x79, 0xe9,
-	0x14, 0x23, 0xd5, 0x66, 0x24, 0x81, 0xe8, 0xcf, 0x0a, 0xa1, 0xa7, 0xe4, 0xd0, 0xc8, 0x29, 0xcf,
-	0xc0, 0x40, 0xe2, 0xb2, 0x37, 0x57, 0x28, 0x24, 0x28, 0x3c, 0x30, 0x72, 0x3a, 0x42, 0x86, 0xd5,
-	0x04, 0xfd, 0x9c, 0x10, 0x53, 0x72, 0x21, 0x63, 0x58, 0xf2, 0x33, 0xcc, 0x8c, 0xc7, 0x5a, 0xa6,
-	0x0c, 0x1d, 0x70, 0x46, 0x5f, 0x12, 0xdf, 0xb1, 0x86, 0xeb, 0xc9, 0x24, 0x97, 0x96, 0x9f, 0xd5,
-	0x71, 0xd9, 0x37, 0x65, 0xc8, 0xde, 0x21, 0x76, 0x46, 0x4f, 0x88, 0xe7, 0x44, 0x60, 0x01, 0x5f,
-	0x94, 0x61, 0x9d, 0x1d, 0xa7, 0x01, 0x0b, 0xee, 0xfd, 0x18, 0xd2, 0x4f, 0x49, 0xdb, 0x94, 0x78,
-	0x50, 0x7c, 0x88, 0xf1, 0xf1, 0xd8, 0x9e, 0x29, 0xdd, 0x21, 0x0d, 0xe9, 0x8f, 0xe4, 0x68, 0x02,
-	0x91, 0xd5, 0x66, 0xc9, 0x33, 0x23, 0x9d, 0x8d, 0xd3, 0xe5, 0x41, 0xb7, 0xbf, 0x33, 0xf0, 0x18,
-	0xad, 0xb9, 0x11, 0x52, 0xae, 0x22, 0xa7, 0x2f, 0x48, 0x2b, 0x81, 0x92, 0x4b, 0x65, 0x32, 0xcc,
-	0x92, 0xc7, 0xf6, 0x12, 0x28, 0xdf, 0x5c, 0xb3, 0x91, 0x1b, 0x8c, 0xa3, 0x44, 0x61, 0x97, 0x3c,
-	0x5a, 0x46, 0xb1, 0xc4, 0x34, 0x79, 0xac, 0x93, 0x40, 0x19, 0x16, 0x76, 0x79, 0xe9, 0x30, 0xfa,
-	0x92, 0x78, 0xeb, 0xc1, 0xfc, 0xa5, 0x55, 0x5a, 0x47, 0xaa, 0xb3, 0x02, 0x7f, 0xd5, 0x2a, 0xa5,
-	0x9f, 0x91, 0xb6, 0x99, 0x70, 0x23, 0xa7, 0xee, 0x00, 0x0f, 0xf1, 0x00, 0x5b, 0x66, 0xc2, 0xf0,
-	0x9b, 0xfe, 0x40, 0x8e, 0xd6, 0x2b, 0x9c, 0x0f, 0xc7, 0xca, 0xf2, 0x09, 0x8f, 0x52, 0x8b, 0xb9,
-	0x6a, 0xb1, 0x83, 0x15, 0x77, 0x3e, 0xbc, 0x50, 0xf6, 0xed, 0x65, 0x6a, 0x5f, 0xf5, 0x09, 0xd9,
-	0x7a, 0x4a, 0x5b, 0x64, 0x37, 0x64, 0xef, 0x46, 0xbd, 0x4f, 0xdc, 0xaf, 0x9b, 0xd7, 0xec, 0xb7,
-	0x5e, 0x63, 0xdc, 0xc4, 0xbf, 0xb8, 0xf3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xd8, 0xd7,
-	0x9e, 0xf4, 0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("profiles.proto", fileDescriptor_profiles_03362614d50d1d73) }
+
+var fileDescriptor_profiles_03362614d50d1d73 = []byte{
+	// 1008 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x5d, 0x73, 0xe3, 0x34,
+	0x14, 0x25, 0xdb, 0x6e, 0x93, 0xa8, 0xb1, 0xd3, 0xaa, 0x1f, 0xab, 0x2e, 0x5f, 0xa1, 0xcb, 0x40,
+	0x66, 0x67, 0x28, 0x34, 0x85, 0x61, 0x78, 0xdc, 0xd6, 0xdb, 0x52, 0xa0, 0xb3, 0x19, 0x85, 0x61,
+	0x1f, 0x35, 0xaa, 0xa5, 0xa4, 0x22, 0xb6, 0xe5, 0xca, 0x72, 0x12, 0xf7, 0x3f, 0xf0, 0x13, 0xf9,
+	0x2f, 0x8c, 0xae, 0xed, 0x24, 0xdb, 0xc2, 0x3b, 0x6f, 0xf6, 0x39, 0xe7, 0xfa, 0x48, 0x57, 0xba,
+	0xc7, 0xc8, 0x4f, 0x8d, 0x1e, 0xab, 0x48, 0x66, 0x27, 0xa9, 0xd1, 0x56, 0xe3, 0x0d, 0x9e, 0xaa,
+	0xe3, 0xbf, 0x9a, 0xc8, 0x1f, 0x49, 0x33, 0x53, 0xa1, 0x1c, 0x96, 0x34, 0xf6, 0xd1, 0x33, 0x25,
+	0x48, 0xa3, 0xd7, 0xe8, 0xb7, 0xe9, 0x33, 0x25, 0x30, 0x46, 0x9b, 0x09, 0x8f, 0x25, 0x39, 0x00,
+	0x04, 0x9e, 0xf1, 0xd7, 0xa8, 0xab, 0xcd, 0x84, 0x27, 0xea, 0x81, 0x5b, 0xa5, 0x13, 0xa6, 0x04,
+	0x39, 0xec, 0x35, 0xfa, 0x1b, 0xd4, 0x5f, 0x87, 0xaf, 0x03, 0xfc, 0x1a, 0xed, 0x26, 0xd2, 0xce,
+	0xb5, 0x99, 0xb2, 0x4c, 0x9a, 0x99, 0x34, 0x4e, 0xfa, 0x02, 0xa4, 0xdd, 0x8a, 0x18, 0x01, 0x7e,
+	0x1d, 0xe0, 0x17, 0xa8, 0x99, 0x47, 0xcc, 0x70, 0x2b, 0xc9, 0xb3, 0x5e, 0xa3, 0xef, 0xd1, 0xad,
+	0x3c, 0xa2, 0xdc, 0x4a, 0xfc, 0x25, 0xf2, 0xf3, 0x88, 0xdd, 0xe6, 0xe1, 0x54, 0x5a, 0x96, 0xa9,
+	0x07, 0x49, 0x36, 0x80, 0xef, 0xe4, 0xd1, 0x39, 0x80, 0x23, 0xf5, 0x20, 0xf1, 0x0f, 0xc8, 0xaf,
+	0xca, 0x59, 0xaa, 0x23, 0x15, 0x16, 0x64, 0xb3, 0xd7, 0xe8, 0xfb, 0x83, 0xee, 0x09, 0x4f, 0xd5,
+	0x89, 0xfb, 0xd0, 0x10, 0x60, 0x57, 0xb6, 0x7a, 0x73, 0xae, 0xa2, 0x72, 0x7d, 0x5e, 0xba, 0x8a,
+	0xa5, 0xab, 0xf8, 0xd0, 0x75, 0xab, 0x74, 0x15, 0x8f, 0x5c, 0xc5, 0x87, 0xae, 0xcd, 0xff, 0x70,
+	0x15, 0xeb, 0xae, 0x5f, 0xa1, 0x2e, 0x17, 0x82, 0x4d, 0xe6, 0x2c, 0x96, 0x96, 0x0b, 0x6e, 0x39,
+	0x69, 0xf5, 0x1a, 0xfd, 0x16, 0xf5, 0xb8, 0x10, 0x57, 0xef, 0x6f, 0xa4, 0xe5, 0x01, 0xb7, 0x1c,
+	0x7f, 0x83, 0xf6, 0x84, 0x9c, 0xb1, 0xcc, 0x72, 0x9b, 0x67, 0xcc, 0xc8, 0x7b, 0x36, 0x36, 0xf2,
+	0x9e, 0xb4, 0x61, 0x25, 0x3b, 0x42, 0xce, 0x46, 0xc0, 0x50, 0x79, 0x7f, 0x69, 0xe4, 0x3d, 0xfe,
+	0x09, 0x1d, 0x19, 0x99, 0x6a, 0x63, 0xd9, 0x5a, 0xd5, 0x2d, 0xb7, 0x56, 0x9a, 0x82, 0x20, 0x30,
+	0x38, 0x2c, 0x05, 0x41, 0x5d, 0x7a, 0x5e, 0xb2, 0xf8, 0x47, 0x44, 0x9e, 0x96, 0xc6, 0xdc, 0x4c,
+	0x54, 0x42, 0xb6, 0xa1, 0xf2, 0xe0, 0x51, 0xe5, 0x0d, 0x90, 0xf8, 0x00, 0x6d, 0x09, 0xc3, 0x62,
+	0x95, 0x90, 0x0e, 0xac, 0xea, 0xb9, 0x30, 0x37, 0x2b, 0x98, 0x2f, 0x88, 0xb7, 0x84, 0xf9, 0x02,
+	0x7f, 0x81, 0x3a, 0xe1, 0x1d, 0x4f, 0x12, 0x19, 0xb1, 0x98, 0x67, 0x53, 0xe2, 0xf7, 0x1a, 0xfd,
+	0x0e, 0xdd, 0xae, 0xb0, 0x1b, 0x9e, 0x4d, 0xf1, 0xa7, 0x08, 0xa5, 0x86, 0xf1, 0x28, 0xd2, 0x73,
+	0x29, 0x48, 0x17, 0xbc, 0xdb, 0xa9, 0x79, 0x53, 0x02, 0x8e, 0xbe, 0x5b, 0xd1, 0x3b, 0x25, 0x7d,
+	0xb7, 0x4e, 0x1b, 0xbe, 0xa4, 0x77, 0x4b, 0xda, 0xf0, 0x9a, 0xfe, 0x0c, 0x6d, 0x27, 0xf3, 0x29,
+	0x9b, 0x48, 0xcd, 0x22, 0x1d, 0x12, 0x5c, 0xf2, 0xc9, 0x7c, 0x7a, 0x25, 0xf5, 0x6f, 0x3a, 0x74,
+	0xe5, 0x96, 0x9b, 0x89, 0xb4, 0x2c, 0x95, 0x86, 0xec, 0xc1, 0xd2, 0xdb, 0x25, 0x32, 0x7c, 0x4b,
+	0x71, 0x1f, 0xed, 0xc4, 0x2a, 0x71, 0xe7, 0x26, 0xd4, 0x4c, 0x9a, 0x4c, 0xd9, 0x82, 0xec, 0x83,
+	0xc8, 0x8f, 0x55, 0x72, 0xf5, 0x3e, 0xa8, 0x51, 0xfc, 0x3d, 0x3a, 0xac, 0x8f, 0x96, 0x19, 0x29,
+	0x78, 0x68, 0xd9, 0x58, 0xc9, 0x48, 0x64, 0xe4, 0xa8, 0xb7, 0xd1, 0x6f, 0xd3, 0xfd, 0x9a, 0xa5,
+	0x40, 0x5e, 0x02, 0x77, 0xfc, 0x77, 0x13, 0x79, 0x81, 0xfc, 0x5f, 0x8c, 0xe3, 0xc7, 0xa8, 0xad,
+	0x32, 0x96, 0xdd, 0x71, 0x23, 0x05, 0x21, 0xd0, 0xa7, 0x96, 0xca, 0x46, 0xf0, 0x8e, 0xcf, 0xd0,
+	0x61, 0xcc, 0x17, 0x4c, 0xe8, 0x79, 0x12, 0xa9, 0x64, 0x9a, 0xb9, 0x6e, 0xb1, 0x3b, 0x9d, 0x1b,
+	0x72, 0x04, 0xdd, 0xd8, 0x8b, 0xf9, 0x22, 0xa8, 0xc9, 0xa1, 0x34, 0x3f, 0xeb, 0xdc, 0xe0, 0x53,
+	0x74, 0xf0, 0xb4, 0x48, 0xf0, 0x82, 0xbc, 0x84, 0x1a, 0xfc, 0xa8, 0x26, 0xe0, 0x85, 0xeb, 0x77,
+	0x96, 0xa7, 0xee, 0xda, 0x65, 0x2c, 0x8c, 0x78, 0x96, 0xb1, 0x5b, 0x08, 0x87, 0x16, 0xf5, 0x6b,
+	0xfc, 0xc2, 0xc1, 0xe7, 0x6e, 0xa2, 0x2a, 0x01, 0xb3, 0x2a, 0x96, 0x3a, 0xb7, 0x55, 0x4a, 0x78,
+	0x00, 0x9f, 0xff, 0x5e, 0x82, 0xee, 0x8b, 0xa9, 0x4a, 0x26, 0x2c, 0x8b, 0x34, 0x9c, 0xb1, 0xd2,
+	0x02, 0x82, 0xc2, 0xa3, 0xbe, 0xc3, 0x47, 0x91, 0xb6, 0x43, 0x40, 0x71, 0x0f, 0x75, 0x56, 0x4a,
+	0x61, 0xaa, 0x78, 0x40, 0xb5, 0x2a, 0xa0, 0x2e, 0x22, 0x56, 0x0a, 0x18, 0xcc, 0x2a, 0x22, 0x6a,
+	0x0d, 0x0c, 0xe5, 0xd3, 0x3d, 0x84, 0xa4, 0xf9, 0x2f, 0x7b, 0xb8, 0x58, 0xed, 0x21, 0x5c, 0xee,
+	0xa1, 0xb5, 0xb6, 0x87, 0x8b, 0x7a, 0x0f, 0x9f, 0xa3, 0xed, 0x98, 0x87, 0x0c, 0xae, 0x9a, 0x4e,
+	0x20, 0x0d, 0xda, 0x14, 0xc5, 0x3c, 0xfc, 0xa3, 0x44, 0xf0, 0x09, 0xda, 0x33, 0x72, 0xc2, 0x52,
+	0x6e, 0x78, 0xec, 0x62, 0x63, 0xa6, 0x40, 0x88, 0x40, 0xb8, 0x6b, 0xe4, 0x64, 0x08, 0x0c, 0xad,
+	0x08, 0xfc, 0x09, 0x42, 0x66, 0xc1, 0x84, 0x8c, 0x78, 0xc1, 0x4e, 0x61, 0xdc, 0x3d, 0xda, 0x32,
+	0x8b, 0xc0, 0x01, 0xa7, 0xf8, 0x15, 0xf2, 0x1d, 0x6b, 0x98, 0x1e, 0x8f, 0x33, 0x69, 0xd9, 0x69,
+	0x35, 0xe9, 0xdb, 0x66, 0x11, 0xd0, 0x77, 0x80, 0x9d, 0xe2, 0x63, 0xe4, 0x39, 0x11, 0xb7, 0x1c,
+	0xc2, 0x70, 0x40, 0xbc, 0xa5, 0xc6, 0x5d, 0x72, 0x6e, 0xe5, 0x00, 0xbf, 0x44, 0x6d, 0xb3, 0x80,
+	0x46, 0xb1, 0x01, 0x4c, 0xbe, 0x47, 0x9b, 0x66, 0xe1, 0x9a, 0x34, 0xc0, 0xdf, 0xa1, 0xfd, 0x31,
+	0x0f, 0xad, 0x36, 0x05, 0x4b, 0x8d, 0x74, 0x36, 0x4e, 0x97, 0x91, 0x6e, 0x6f, 0xc3, 0xdd, 0x8d,
+	0x8a, 0x1b, 0x02, 0xe5, 0x2a, 0x32, 0x7c, 0x84, 0x5a, 0xee, 0x3a, 0x49, 0x65, 0x52, 0x88, 0x01,
+	0x8f, 0x36, 0x63, 0xbe, 0x78, 0x7b, 0x4d, 0x87, 0xee, 0x60, 0x1c, 0x25, 0x72, 0x5b, 0xb0, 0xb0,
+	0x08, 0x23, 0x09, 0x41, 0xe0, 0xd1, 0x8e, 0xbb, 0x62, 0xb9, 0x2d, 0x2e, 0x1c, 0x86, 0x5f, 0x21,
+	0x6f, 0x79, 0x30, 0x7f, 0x6a, 0x95, 0x54, 0x69, 0xd0, 0xa9, 0xc1, 0x5f, 0xb4, 0x4a, 0xdc, 0x18,
+	0x98, 0x31, 0x33, 0x72, 0xe2, 0x1a, 0xb8, 0x07, 0x0d, 0x6c, 0x99, 0x31, 0x85, 0x77, 0xfc, 0x2d,
+	0xda, 0x5f, 0x7e, 0xe1, 0x6c, 0x70, 0xab, 0x2c, 0x1b, 0xb3, 0x30, 0xb1, 0x10, 0x09, 0x2d, 0xba,
+	0x5b, 0x73, 0x67, 0x83, 0x73, 0x65, 0x2f, 0x2f, 0x12, 0xfb, 0xba, 0x87, 0xd0, 0xda, 0x5f, 0xa0,
+	0x85, 0x36, 0x03, 0xfa, 0x6e, 0xb8, 0xf3, 0x91, 0x7b, 0xba, 0x79, 0x43, 0x7f, 0xdd, 0x69, 0xdc,
+	0x6e, 0xc1, 0xdf, 0xf9, 0xec, 0x9f, 0x01, 0x00, 0xac, 0xe7, 0xdb, 0xbc, 0xaf, 0x07, 0x00, 0x00,
 }
@@ -0,0 +1,806 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: terms.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type TermsVersion struct {
+	// Terms version ID.
+	// This will be automatically assigned on create.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version label (e.g. 2019-01).
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Terms text (HTML).
+	Text                 string   `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TermsVersion) Reset()         { *m = TermsVersion{} }
+func (m *TermsVersion) String() string { return proto.CompactTextString(m) }
+func (*TermsVersion) ProtoMessage()    {}
+func (*TermsVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_terms_8886d0ef99eeeb87, []int{0}
+}
+func (m *TermsVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TermsVersion.Unmarshal(m, b)
+}
+func (m *TermsVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TermsVersion.Marshal(b, m, deterministic)
+}
+func (dst *TermsVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TermsVersion.Merge(dst, src)
+}
+func (m *TermsVersion) XXX_Size() int {
+	return xxx_messageInfo_TermsVersion.Size(m)
+}
+func (m *TermsVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_TermsVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TermsVersion proto.InternalMessageInfo
+
+func (m *TermsVersion) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TermsVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *TermsVersion) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type TermsVersionListItem struct {
+	// Terms version ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version label.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Created at timestamp.
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TermsVersionListItem) Reset()         { *m = TermsVersionListItem{} }
+func (m *TermsVersionListItem) String() string { return proto.CompactTextString(m) }
+func (*TermsVersionListItem) ProtoMessage()    {}
+func (*TermsVersionListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_terms_8886d0ef99eeeb87, []int{1}
+}
+func (m *TermsVersionListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TermsVersionListItem.Unmarshal(m, b)
+}
+func (m *TermsVersionListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TermsVersionListItem.Marshal(b, m, deterministic)
+}
+func (dst *TermsVersionListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TermsVersionListItem.Merge(dst, src)
+}
+func (m *TermsVersionListItem) XXX_Size() int {
+	return xxx_messageInfo_TermsVersionListItem.Size(m)
+}
+func (m *TermsVersionListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_TermsVersionListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TermsVersionListItem proto.InternalMessageInfo
+
+func (m *TermsVersionListItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TermsVersionListItem) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *TermsVersionListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type CreateTermsVersionRequest struct {
+	// Terms version object to create.
+	TermsVersion         *TermsVersion `protobuf:"bytes,1,opt,name=terms_version,json=termsVersion,proto3" json:"terms_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CreateTermsVersionRequest) Reset()         { *m = CreateTermsVersionRequest{} }
+func (m *CreateTermsVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTermsVersionRequest) ProtoMessage()    {}
+func (*CreateTermsVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_terms_8886d0ef99eeeb87, []int{2}
+}
+func (m *CreateTermsVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTermsVersionRequest.Unmarshal(m, b)
+}
+func (m *CreateTermsVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTermsVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateTermsVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTermsVersionRequest.Merge(dst, src)
+}
+func (m *CreateTermsVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTermsVersionRequest.Size(m)
+}
+func (m *CreateTermsVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTermsVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTermsVersionRequest proto.InternalMessageInfo
+
+func (m *CreateTermsVersionRequest) GetTermsVersion() *TermsVersion {
+	if m != nil {
+		return m.TermsVersion
+	}
+	return nil
+}
+
+type CreateTermsVersionResponse struct {
+	// ID of the created terms version.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTermsVersionResponse) Reset()         { *m = CreateTermsVersionResponse{} }
+func (m *CreateTermsVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateTermsVersionResponse) ProtoMessage()    {}
+func (*CreateTermsVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_terms_8886d0ef99eeeb87, []int{3}
+}
+func (m *CreateTermsVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTermsVersionResponse.Unmarshal(m, b)
+}
+func (m *CreateTermsVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTermsVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateTermsVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTermsVersionResponse.Merge(dst, src)
+}
+func (m *CreateTermsVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateTermsVersionResponse.Size(m)
+}
+func (m *CreateTermsVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTermsVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTermsVersionResponse proto.InternalMessageInfo
+
+func (m *CreateTermsVersionResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetCurrentTermsVersionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCurrentTermsVersionRequest) Reset()         { *m = GetCurrentTermsVersionRequest{} }
+func (m *GetCurrentTermsVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCurrentTermsVersionRequest) ProtoMessage()    {}
+func (*GetCurrentTermsVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_terms_8886d0ef99eeeb87, []int{4}
+}
+func (m *GetCurrentTermsVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCurrentTermsVersionRequest.Unmarshal(m, b)
+}
+func (m *GetCurrentTermsVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCurrentTermsVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetCurrentTermsVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCurrentTermsVersionRequest.Merge(dst, src)
+}
+func (m *GetCurrentTermsVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCurrentTermsVersionRequest.Size(m)
+}
+func (m *GetCurrentTermsVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCurrentTermsVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCurrentTermsVersionRequest proto.InternalMessageInfo
+
+type GetTermsVersionResponse struct {
+	// Terms version object.
+	TermsVersion *TermsVersion `protobuf:"bytes,1,opt,name=terms_version,json=termsVersion,proto3" json:"terms_version,omitempty"`
+	// Created at timestamp.
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetTermsVersionResponse) Reset()         { *m = GetTermsVersionResponse{} }
+func (m *GetTermsVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTermsVersionResponse) ProtoMessage()    {}
+func (*GetTermsVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_terms_8886d0ef99eeeb87, []int{5}
+}
+func (m *GetTermsVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTermsVersionResponse.Unmarshal(m, b)
+}
+func (m *GetTermsVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTermsVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetTermsVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTermsVersionResponse.Merge(dst, src)
+}
+func (m *GetTermsVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTermsVersionResponse.Size(m)
+}
+func (m *GetTermsVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTermsVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTermsVersionResponse proto.InternalMessageInfo
+
+func (m *GetTermsVersionResponse) GetTermsVersion() *TermsVersion {
+	if m != nil {
+		return m.TermsVersion
+	}
+	return nil
+}
+
+func (m *GetTermsVersionResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListTermsVersionRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTermsVersionRequest) Reset()         { *m = ListTermsVersionRequest{} }
+func (m *ListTermsVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTermsVersionRequest) ProtoMessage()    {}
+func (*ListTermsVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_terms_8886d0ef99eeeb87, []int{6}
+}
+func (m *ListTermsVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTermsVersionRequest.Unmarshal(m, b)
+}
+func (m *ListTermsVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTermsVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListTermsVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTermsVersionRequest.Merge(dst, src)
+}
+func (m *ListTermsVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTermsVersionRequest.Size(m)
+}
+func (m *ListTermsVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTermsVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTermsVersionRequest proto.InternalMessageInfo
+
+func (m *ListTermsVersionRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListTermsVersionRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListTermsVersionResponse struct {
+	// Total number of terms versions.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Terms versions within the result-set.
+	Result               []*TermsVersionListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ListTermsVersionResponse) Reset()         { *m = ListTermsVersionResponse{} }
+func (m *ListTermsVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTermsVersionResponse) ProtoMessage()    {}
+func (*ListTermsVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_terms_8886d0ef99eeeb87, []int{7}
+}
+func (m *ListTermsVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTermsVersionResponse.Unmarshal(m, b)
+}
+func (m *ListTermsVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTermsVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListTermsVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTermsVersionResponse.Merge(dst, src)
+}
+func (m *ListTermsVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTermsVersionResponse.Size(m)
+}
+func (m *ListTermsVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTermsVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTermsVersionResponse proto.InternalMessageInfo
+
+func (m *ListTermsVersionResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListTermsVersionResponse) GetResult() []*TermsVersionListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type TermsAcceptance struct {
+	// User ID.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userID,proto3" json:"user_id,omitempty"`
+	// Username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// Accepted at timestamp.
+	AcceptedAt           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TermsAcceptance) Reset()         { *m = TermsAcceptance{} }
+func (m *TermsAcceptance) String() string { return proto.CompactTextString(m) }
+func (*TermsAcceptance) ProtoMessage()    {}
+func (*TermsAcceptance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_terms_8886d0ef99eeeb87, []int{8}
+}
+func (m *TermsAcceptance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TermsAcceptance.Unmarshal(m, b)
+}
+func (m *TermsAcceptance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TermsAcceptance.Marshal(b, m, deterministic)
+}
+func (dst *TermsAcceptance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TermsAcceptance.Merge(dst, src)
+}
+func (m *TermsAcceptance) XXX_Size() int {
+	return xxx_messageInfo_TermsAcceptance.Size(m)
+}
+func (m *TermsAcceptance) XXX_DiscardUnknown() {
+	xxx_messageInfo_TermsAcceptance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TermsAcceptance proto.InternalMessageInfo
+
+func (m *TermsAcceptance) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TermsAcceptance) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *TermsAcceptance) GetAcceptedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.AcceptedAt
+	}
+	return nil
+}
+
+type ListTermsAcceptanceRequest struct {
+	// Terms version ID.
+	TermsVersionId int64 `protobuf:"varint,1,opt,name=terms_version_id,json=termsVersionID,proto3" json:"terms_version_id,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTermsAcceptanceRequest) Reset()         { *m = ListTermsAcceptanceRequest{} }
+func (m *ListTermsAcceptanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTermsAcceptanceRequest) ProtoMessage()    {}
+func (*ListTermsAcceptanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_terms_8886d0ef99eeeb87, []int{9}
+}
+func (m *ListTermsAcceptanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTermsAcceptanceRequest.Unmarshal(m, b)
+}
+func (m *ListTermsAcceptanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTermsAcceptanceRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListTermsAcceptanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTermsAcceptanceRequest.Merge(dst, src)
+}
+func (m *ListTermsAcceptanceRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTermsAcceptanceRequest.Size(m)
+}
+func (m *ListTermsAcceptanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTermsAcceptanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTermsAcceptanceRequest proto.InternalMessageInfo
+
+func (m *ListTermsAcceptanceRequest) GetTermsVersionId() int64 {
+	if m != nil {
+		return m.TermsVersionId
+	}
+	return 0
+}
+
+func (m *ListTermsAcceptanceRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListTermsAcceptanceRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListTermsAcceptanceResponse struct {
+	// Total number of acceptances.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Acceptances within the result-set.
+	Result               []*TermsAcceptance `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListTermsAcceptanceResponse) Reset()         { *m = ListTermsAcceptanceResponse{} }
+func (m *ListTermsAcceptanceResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTermsAcceptanceResponse) ProtoMessage()    {}
+func (*ListTermsAcceptanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_terms_8886d0ef99eeeb87, []int{10}
+}
+func (m *ListTermsAcceptanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTermsAcceptanceResponse.Unmarshal(m, b)
+}
+func (m *ListTermsAcceptanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTermsAcceptanceResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListTermsAcceptanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTermsAcceptanceResponse.Merge(dst, src)
+}
+func (m *ListTermsAcceptanceResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTermsAcceptanceResponse.Size(m)
+}
+func (m *ListTermsAcceptanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTermsAcceptanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTermsAcceptanceResponse proto.InternalMessageInfo
+
+func (m *ListTermsAcceptanceResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListTermsAcceptanceResponse) GetResult() []*TermsAcceptance {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TermsVersion)(nil), "api.TermsVersion")
+	proto.RegisterType((*TermsVersionListItem)(nil), "api.TermsVersionListItem")
+	proto.RegisterType((*CreateTermsVersionRequest)(nil), "api.CreateTermsVersionRequest")
+	proto.RegisterType((*CreateTermsVersionResponse)(nil), "api.CreateTermsVersionResponse")
+	proto.RegisterType((*GetCurrentTermsVersionRequest)(nil), "api.GetCurrentTermsVersionRequest")
+	proto.RegisterType((*GetTermsVersionResponse)(nil), "api.GetTermsVersionResponse")
+	proto.RegisterType((*ListTermsVersionRequest)(nil), "api.ListTermsVersionRequest")
+	proto.RegisterType((*ListTermsVersionResponse)(nil), "api.ListTermsVersionResponse")
+	proto.RegisterType((*TermsAcceptance)(nil), "api.TermsAcceptance")
+	proto.RegisterType((*ListTermsAcceptanceRequest)(nil), "api.ListTermsAcceptanceRequest")
+	proto.RegisterType((*ListTermsAcceptanceResponse)(nil), "api.ListTermsAcceptanceResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TermsServiceClient is the client API for TermsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TermsServiceClient interface {
+	// Create creates a new terms version. Users must accept this version
+	// on their next login.
+	// Only global admin users are allowed to use this endpoint.
+	Create(ctx context.Context, in *CreateTermsVersionRequest, opts ...grpc.CallOption) (*CreateTermsVersionResponse, error)
+	// GetCurrent returns the current terms version.
+	// This endpoint does not require authentication, so that the terms can
+	// be displayed on login.
+	GetCurrent(ctx context.Context, in *GetCurrentTermsVersionRequest, opts ...grpc.CallOption) (*GetTermsVersionResponse, error)
+	// List lists the terms versions.
+	// Only global admin users are allowed to use this endpoint.
+	List(ctx context.Context, in *ListTermsVersionRequest, opts ...grpc.CallOption) (*ListTermsVersionResponse, error)
+	// ListAcceptances lists the users which have accepted the given terms
+	// version.
+	// Only global admin users are allowed to use this endpoint.
+	ListAcceptances(ctx context.Context, in *ListTermsAcceptanceRequest, opts ...grpc.CallOption) (*ListTermsAcceptanceResponse, error)
+}
+
+type termsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTermsServiceClient(cc *grpc.ClientConn) TermsServiceClient {
+	return &termsServiceClient{cc}
+}
+
+func (c *termsServiceClient) Create(ctx context.Context, in *CreateTermsVersionRequest, opts ...grpc.CallOption) (*CreateTermsVersionResponse, error) {
+	out := new(CreateTermsVersionResponse)
+	err := c.cc.Invoke(ctx, "/api.TermsService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *termsServiceClient) GetCurrent(ctx context.Context, in *GetCurrentTermsVersionRequest, opts ...grpc.CallOption) (*GetTermsVersionResponse, error) {
+	out := new(GetTermsVersionResponse)
+	err := c.cc.Invoke(ctx, "/api.TermsService/GetCurrent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *termsServiceClient) List(ctx context.Context, in *ListTermsVersionRequest, opts ...grpc.CallOption) (*ListTermsVersionResponse, error) {
+	out := new(ListTermsVersionResponse)
+	err := c.cc.Invoke(ctx, "/api.TermsService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *termsServiceClient) ListAcceptances(ctx context.Context, in *ListTermsAcceptanceRequest, opts ...grpc.CallOption) (*ListTermsAcceptanceResponse, error) {
+	out := new(ListTermsAcceptanceResponse)
+	err := c.cc.Invoke(ctx, "/api.TermsService/ListAcceptances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TermsServiceServer is the server API for TermsService service.
+type TermsServiceServer interface {
+	// Create creates a new terms version. Users must accept this version
+	// on their next login.
+	// Only global admin users are allowed to use this endpoint.
+	Create(context.Context, *CreateTermsVersionRequest) (*CreateTermsVersionResponse, error)
+	// GetCurrent returns the current terms version.
+	// This endpoint does not require authentication, so that the terms can
+	// be displayed on login.
+	GetCurrent(context.Context, *GetCurrentTermsVersionRequest) (*GetTermsVersionResponse, error)
+	// List lists the terms versions.
+	// Only global admin users are allowed to use this endpoint.
+	List(context.Context, *ListTermsVersionRequest) (*ListTermsVersionResponse, error)
+	// ListAcceptances lists the users which have accepted the given terms
+	// version.
+	// Only global admin users are allowed to use this endpoint.
+	ListAcceptances(context.Context, *ListTermsAcceptanceRequest) (*ListTermsAcceptanceResponse, error)
+}
+
+func RegisterTermsServiceServer(s *grpc.Server, srv TermsServiceServer) {
+	s.RegisterService(&_TermsService_serviceDesc, srv)
+}
+
+func _TermsService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTermsVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TermsServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TermsService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TermsServiceServer).Create(ctx, req.(*CreateTermsVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TermsService_GetCurrent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentTermsVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TermsServiceServer).GetCurrent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TermsService/GetCurrent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TermsServiceServer).GetCurrent(ctx, req.(*GetCurrentTermsVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TermsService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTermsVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TermsServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TermsService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TermsServiceServer).List(ctx, req.(*ListTermsVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TermsService_ListAcceptances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTermsAcceptanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TermsServiceServer).ListAcceptances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TermsService/ListAcceptances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TermsServiceServer).ListAcceptances(ctx, req.(*ListTermsAcceptanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TermsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.TermsService",
+	HandlerType: (*TermsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TermsService_Create_Handler,
+		},
+		{
+			MethodName: "GetCurrent",
+			Handler:    _TermsService_GetCurrent_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TermsService_List_Handler,
+		},
+		{
+			MethodName: "ListAcceptances",
+			Handler:    _TermsService_ListAcceptances_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "terms.proto",
+}
+
+func init() { proto.RegisterFile("terms.proto", fileDescriptor_terms_8886d0ef99eeeb87) }
+
+var fileDescriptor_terms_8886d0ef99eeeb87 = []byte{
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x95, 0xed, 0x7e, 0xe9, 0xd7, 0x9b, 0xd2, 0xc2, 0x55, 0xa0, 0xa9, 0x69, 0x49, 0x35, 0xab,
+	0x00, 0x95, 0xa3, 0x16, 0x09, 0x09, 0x58, 0x55, 0xa9, 0x14, 0x45, 0xea, 0xca, 0xad, 0x60, 0x19,
+	0xa6, 0xce, 0xa4, 0x1a, 0x14, 0xff, 0x60, 0x5f, 0x57, 0x48, 0x15, 0x1b, 0x58, 0xb0, 0x61, 0xc7,
+	0x03, 0xf1, 0x10, 0xbc, 0x02, 0x0f, 0x82, 0x3c, 0x1e, 0xc7, 0x4e, 0xe2, 0xa0, 0x96, 0x9d, 0xef,
+	0xcc, 0x99, 0x73, 0xee, 0x39, 0x73, 0xc7, 0xd0, 0x24, 0x11, 0xfb, 0x89, 0x13, 0xc5, 0x21, 0x85,
+	0x68, 0xf1, 0x48, 0xda, 0x7b, 0x57, 0x61, 0x78, 0x35, 0x15, 0x3d, 0x1e, 0xc9, 0x1e, 0x0f, 0x82,
+	0x90, 0x38, 0xc9, 0x30, 0xd0, 0x10, 0xbb, 0xa3, 0x77, 0x55, 0x75, 0x99, 0x4e, 0x7a, 0x24, 0x7d,
+	0x91, 0x10, 0xf7, 0xa3, 0x1c, 0xc0, 0xce, 0x60, 0xf3, 0x22, 0xa3, 0x7c, 0x2b, 0xe2, 0x44, 0x86,
+	0x01, 0x6e, 0x81, 0x29, 0xc7, 0x6d, 0xe3, 0xc0, 0xe8, 0x5a, 0xae, 0x29, 0xc7, 0xd8, 0x86, 0xf5,
+	0xeb, 0x7c, 0xab, 0x6d, 0x1e, 0x18, 0xdd, 0x0d, 0xb7, 0x28, 0x11, 0x61, 0x8d, 0xc4, 0x27, 0x6a,
+	0x5b, 0x6a, 0x59, 0x7d, 0xb3, 0x1b, 0x68, 0x55, 0xd9, 0xce, 0x64, 0x42, 0x43, 0x12, 0xfe, 0x1d,
+	0x58, 0x5f, 0x01, 0x78, 0xb1, 0xe0, 0x24, 0xc6, 0x23, 0x9e, 0x73, 0x37, 0x8f, 0x6d, 0x27, 0x77,
+	0xe1, 0x14, 0x2e, 0x9c, 0x8b, 0xc2, 0x85, 0xbb, 0xa1, 0xd1, 0x27, 0xc4, 0xce, 0x61, 0xb7, 0xaf,
+	0x8a, 0x6a, 0x0b, 0xae, 0xf8, 0x98, 0x8a, 0x84, 0xf0, 0x25, 0xdc, 0x53, 0xd1, 0x8d, 0x0a, 0x5d,
+	0x43, 0x51, 0x3f, 0x70, 0x78, 0x24, 0x9d, 0xb9, 0x03, 0x9b, 0x54, 0xa9, 0xd8, 0x21, 0xd8, 0x75,
+	0xa4, 0x49, 0x14, 0x06, 0x89, 0x58, 0xf4, 0xc5, 0x3a, 0xb0, 0x3f, 0x10, 0xd4, 0x4f, 0xe3, 0x58,
+	0x04, 0x54, 0xd3, 0x06, 0xfb, 0x6e, 0xc0, 0xce, 0x40, 0x50, 0x2d, 0xd9, 0x3f, 0xb6, 0xb8, 0x10,
+	0x99, 0x79, 0x97, 0xc8, 0x06, 0xb0, 0x93, 0xdd, 0x51, 0x5d, 0x60, 0x2d, 0xf8, 0x6f, 0x2a, 0x7d,
+	0x49, 0xda, 0x5d, 0x5e, 0xe0, 0x23, 0x68, 0x84, 0x93, 0x49, 0x22, 0x72, 0x1d, 0xcb, 0xd5, 0x15,
+	0x0b, 0xa0, 0xbd, 0x4c, 0xa4, 0x7d, 0x75, 0xa0, 0x49, 0x21, 0xf1, 0xe9, 0xc8, 0x0b, 0xd3, 0xa0,
+	0xe0, 0x03, 0xb5, 0xd4, 0xcf, 0x56, 0xf0, 0x08, 0x1a, 0xb1, 0x48, 0xd2, 0x69, 0x46, 0x6a, 0x75,
+	0x9b, 0xc7, 0xbb, 0x4b, 0x8e, 0x8b, 0x41, 0x72, 0x35, 0x90, 0x7d, 0x35, 0x60, 0x5b, 0x01, 0x4e,
+	0x3c, 0x4f, 0x44, 0xc4, 0x03, 0x4f, 0xe0, 0x0e, 0xac, 0xa7, 0x89, 0x88, 0x47, 0xb3, 0x1b, 0x69,
+	0x64, 0xe5, 0xf0, 0x14, 0x6d, 0xf8, 0x3f, 0xfb, 0x0a, 0xb8, 0x2f, 0xf4, 0xb8, 0xcd, 0x6a, 0x7c,
+	0x03, 0x4d, 0xae, 0x28, 0x6e, 0x3b, 0x70, 0x50, 0xc0, 0x4f, 0x88, 0x11, 0xd8, 0x33, 0xd7, 0x65,
+	0x23, 0x45, 0x82, 0x5d, 0xb8, 0x3f, 0x77, 0x9f, 0x65, 0x63, 0x5b, 0xd5, 0xfb, 0x1b, 0x9e, 0x96,
+	0x59, 0x9b, 0xf5, 0x59, 0x5b, 0x73, 0x59, 0x4f, 0xe1, 0x71, 0xad, 0xea, 0x6d, 0xe3, 0x3e, 0x5c,
+	0x88, 0xbb, 0x55, 0xc6, 0x5d, 0xa1, 0xd3, 0x98, 0xe3, 0x9f, 0x96, 0xfe, 0x43, 0x9c, 0x8b, 0xf8,
+	0x5a, 0x7a, 0x02, 0xdf, 0x43, 0x23, 0x7f, 0x11, 0xf8, 0x44, 0x1d, 0x5c, 0xf9, 0xe6, 0xec, 0xce,
+	0xca, 0xfd, 0xbc, 0x55, 0xf6, 0xf0, 0xcb, 0xaf, 0xdf, 0x3f, 0xcc, 0x6d, 0x06, 0xea, 0xef, 0xa5,
+	0x42, 0x79, 0x6d, 0x3c, 0xc3, 0x0f, 0x00, 0xe5, 0x2b, 0x42, 0xa6, 0x58, 0xfe, 0xfa, 0xac, 0xec,
+	0xbd, 0x02, 0x53, 0x2b, 0x63, 0x2b, 0x99, 0x16, 0x62, 0x29, 0xd3, 0xf3, 0x34, 0xfb, 0x3b, 0x58,
+	0xcb, 0xc2, 0xc4, 0x9c, 0x61, 0xc5, 0x63, 0xb0, 0xf7, 0x57, 0xec, 0x6a, 0x01, 0x54, 0x02, 0x9b,
+	0x58, 0xf1, 0x81, 0xdf, 0x0c, 0xd8, 0xce, 0x0e, 0x94, 0x91, 0x26, 0xd8, 0x99, 0xa7, 0x59, 0x1a,
+	0x19, 0xfb, 0x60, 0x35, 0x40, 0x4b, 0x1d, 0x29, 0xa9, 0xe7, 0xf8, 0xb4, 0xe2, 0xe5, 0x66, 0x71,
+	0xcc, 0x3e, 0xf7, 0x78, 0xa9, 0x7a, 0xd9, 0x50, 0x53, 0xfc, 0xe2, 0xcf, 0x00, 0xf4, 0xed, 0xa1,
+	0x3c, 0x3c, 0x06, 0x00, 0x00,
+}
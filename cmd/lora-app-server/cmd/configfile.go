@@ -62,6 +62,32 @@ dsn="{{ .PostgreSQL.DSN }}"
 # App Server and / or applying migrations.
 automigrate={{ .PostgreSQL.Automigrate }}
 
+# Slow query threshold.
+#
+# Queries taking longer than this duration are logged (warning level),
+# together with the storage function executing the query. The query
+# arguments are not logged. Set to 0s to disable.
+#
+# Independent of this setting, the query durations are measured per storage
+# function. Global admin users can list the slowest storage functions of an
+# instance using the /api/cluster/storage-operations API endpoint.
+slow_query_threshold="{{ .PostgreSQL.SlowQueryThreshold }}"
+
+# Storage clusters (optional).
+#
+# Additional PostgreSQL databases (name="DSN") for data residency
+# requirements. When an organization is assigned to a storage cluster, the
+# device data (measurements and locations) of its devices is stored in this
+# storage cluster, all the other data is stored in the database configured
+# above (the default storage cluster). Organizations are assigned (and their
+# device data is moved) using the move-organization-storage command.
+#
+# Example:
+# [postgresql.storage_clusters]
+# eu="postgres://localhost/loraserver_as_eu?sslmode=disable"
+{{ if .PostgreSQL.StorageClusters }}[postgresql.storage_clusters]
+{{ range $name, $dsn := .PostgreSQL.StorageClusters }}{{ $name }}="{{ $dsn }}"
+{{ end }}{{ end }}
 
 # Redis settings
 #
@@ -84,6 +110,43 @@ max_idle={{ .Redis.MaxIdle }}
 idle_timeout="{{ .Redis.IdleTimeout }}"
 
 
+# Network-server client settings.
+#
+# These settings apply to the API calls made to the network-server(s).
+[network_server]
+# Timeout of a single network-server API call.
+#
+# When set to 0, no timeout is applied (other than the one of the request
+# that triggered the API call, if any).
+timeout="{{ .NetworkServer.Timeout }}"
+
+# Number of retries of a failed API call.
+#
+# Only the idempotent (Get and List) API calls are retried, and only when
+# the network-server is unavailable or did not respond within the timeout.
+retry_count={{ .NetworkServer.RetryCount }}
+
+# Interval between the retries.
+retry_interval="{{ .NetworkServer.RetryInterval }}"
+
+  # Circuit-breaker settings.
+  #
+  # The circuit-breaker is maintained per network-server. After the
+  # configured number of consecutive failures (network-server unavailable or
+  # timeout), the API calls to the network-server fail immediately until the
+  # open timeout has expired. Then a single trial API call is allowed, which
+  # closes the circuit-breaker when it succeeds. The state of the
+  # circuit-breakers is exposed by the /debug/diagnostics endpoint.
+  [network_server.circuit_breaker]
+  # Consecutive failures after which the circuit-breaker opens.
+  #
+  # Set this to 0 to disable the circuit-breaker.
+  failure_threshold={{ .NetworkServer.CircuitBreaker.FailureThreshold }}
+
+  # Duration the circuit-breaker stays open.
+  open_timeout="{{ .NetworkServer.CircuitBreaker.OpenTimeout }}"
+
+
 # Application-server settings.
 [application_server]
 # Application-server identifier.
@@ -99,6 +162,20 @@ id="{{ .ApplicationServer.ID }}"
   # Maximum execution time.
   max_execution_time="{{ .ApplicationServer.Codec.JS.MaxExecutionTime }}"
 
+  # Maximum number of concurrent executions per organization.
+  #
+  # The codec functions of each organization are executed within their own
+  # execution pool, so that a heavy or buggy codec of one organization does
+  # not degrade the decoding latency of other organizations. Set this to 0
+  # to disable the limit (not recommended).
+  max_concurrency_per_organization={{ .ApplicationServer.Codec.JS.MaxConcurrencyPerOrganization }}
+
+  # Maximum time to wait for an execution slot.
+  #
+  # When all the execution slots of the organization stay occupied for
+  # longer than this duration, the execution is rejected.
+  max_queue_time="{{ .ApplicationServer.Codec.JS.MaxQueueTime }}"
+
 
   # Integration configures the data integration.
   #
@@ -116,6 +193,7 @@ id="{{ .ApplicationServer.ID }}"
   # * aws_sns           - AWS Simple Notification Service (SNS)
   # * azure_service_bus - Azure Service-Bus
   # * gcp_pub_sub       - Google Cloud Pub/Sub
+  # * modbus            - Modbus TCP server
   enabled=[{{ if .ApplicationServer.Integration.Enabled|len }}"{{ end }}{{ range $index, $elm := .ApplicationServer.Integration.Enabled }}{{ if $index }}", "{{ end }}{{ $elm }}{{ end }}{{ if .ApplicationServer.Integration.Enabled|len }}"{{ end }}]
 
 
@@ -139,6 +217,7 @@ id="{{ .ApplicationServer.ID }}"
   error_topic_template="{{ .ApplicationServer.Integration.MQTT.ErrorTopicTemplate }}"
   status_topic_template="{{ .ApplicationServer.Integration.MQTT.StatusTopicTemplate }}"
   location_topic_template="{{ .ApplicationServer.Integration.MQTT.LocationTopicTemplate }}"
+  heartbeat_topic_template="{{ .ApplicationServer.Integration.MQTT.HeartbeatTopicTemplate }}"
 
   # Retained messages configuration.
   #
@@ -151,6 +230,7 @@ id="{{ .ApplicationServer.ID }}"
   error_retained_message={{ .ApplicationServer.Integration.MQTT.ErrorRetainedMessage }}
   status_retained_message={{ .ApplicationServer.Integration.MQTT.StatusRetainedMessage }}
   location_retained_message={{ .ApplicationServer.Integration.MQTT.LocationRetainedMessage }}
+  heartbeat_retained_message={{ .ApplicationServer.Integration.MQTT.HeartbeatRetainedMessage }}
 
   # MQTT server (e.g. scheme://host:port where scheme is tcp, ssl or ws)
   server="{{ .ApplicationServer.Integration.MQTT.Server }}"
@@ -251,6 +331,234 @@ id="{{ .ApplicationServer.ID }}"
   topic_name="{{ .ApplicationServer.Integration.GCPPubSub.TopicName }}"
 
 
+  # Modbus TCP integration.
+  #
+  # This integration exposes the decoded payload variables of the mapped
+  # devices as Modbus registers. Both the holding (function code 3) and
+  # input (function code 4) registers return the last received value.
+  [application_server.integration.modbus]
+  # ip:port to bind the Modbus TCP server to.
+  #
+  # Note: Modbus TCP does not provide authentication, only bind to a
+  # public interface when the network is trusted. Binding to port 502 (the
+  # default Modbus TCP port) might require additional privileges.
+  bind="{{ .ApplicationServer.Integration.Modbus.Bind }}"
+
+  # Register mapping.
+  #
+  # Each device variable is mapped to a static register address. The
+  # field refers to the decoded payload object (nested fields are
+  # separated by a dot). Valid types are int16, uint16, int32, uint32 and
+  # float32. The 32 bit types occupy two registers (high word first).
+  # The value is multiplied by the scale factor before it is stored.
+  #
+  # Example (the [[application_server.integration.modbus.registers]] can be repeated):
+  # [[application_server.integration.modbus.registers]]
+  # dev_eui="0102030405060708"
+  # field="temperature"
+  # address=0
+  # type="int16"
+  # scale=10
+{{ range $index, $element := .ApplicationServer.Integration.Modbus.Registers }}
+  [[application_server.integration.modbus.registers]]
+  dev_eui="{{ $element.DevEUI }}"
+  field="{{ $element.Field }}"
+  address={{ $element.Address }}
+  type="{{ $element.Type }}"
+  scale={{ $element.Scale }}
+{{ end }}
+
+  # HTTP integration downlink callbacks.
+  #
+  # When a callback secret is configured for the HTTP integration of an
+  # application, the posted events are signed using this secret and contain
+  # a signed callback URL to which the downstream system can post downlink
+  # payloads for the device of the event.
+  [application_server.integration.http]
+  # Base URL of the external API as reachable by the downstream systems.
+  #
+  # E.g. https://lora.example.com. When left blank, no callback URLs are
+  # added to the events and the callback endpoint is disabled.
+  callback_base_url="{{ .ApplicationServer.Integration.HTTP.CallbackBaseURL }}"
+
+  # Validity of the callback URLs.
+  callback_ttl="{{ .ApplicationServer.Integration.HTTP.CallbackTTL }}"
+
+
+  # Event journal.
+  #
+  # When enabled, integration events are first stored in the database and
+  # then published by a dispatcher to the integrations. The events are stored
+  # within the same transaction as the device update causing them (e.g. the
+  # uplink, status or location update), so that an event is never lost once
+  # this update has been committed. The dispatcher keeps
+  # track of which integrations acknowledged an event and retries the
+  # failed ones, so that no events are lost on restarts or when an
+  # integration is temporarily unavailable. Note that this adds some latency
+  # (see dispatch_interval) and that an event might be published more than
+  # once in case of a crash.
+  [application_server.integration.journal]
+  # Enable the event journal.
+  enabled={{ .ApplicationServer.Integration.Journal.Enabled }}
+
+  # Interval at which pending events are dispatched.
+  dispatch_interval="{{ .ApplicationServer.Integration.Journal.DispatchInterval }}"
+
+  # Max number of events to dispatch per interval.
+  batch_size={{ .ApplicationServer.Integration.Journal.BatchSize }}
+
+  # Max number of attempts to publish an event.
+  #
+  # An event which could not be published to all integrations within the
+  # given number of attempts is marked as failed and is no longer retried.
+  # An event is attempted at most once per dispatch interval, thus with the
+  # default settings events are retried for about an hour. Set this to 0
+  # to retry events forever.
+  max_attempts={{ .ApplicationServer.Integration.Journal.MaxAttempts }}
+
+  # Retention of published events.
+  #
+  # Published events older than the given duration are removed from the
+  # journal. Set this to 0 to never remove published events.
+  retention="{{ .ApplicationServer.Integration.Journal.Retention }}"
+
+
+  # Cluster.
+  #
+  # Each instance sends a heartbeat to Redis, which is used to report the
+  # running instances. The background loops (gateway ping, reprocessing of
+  # uplinks and campaigns) are only handled by the instance elected as
+  # leader for the loop.
+  [application_server.cluster]
+  # Instance ID.
+  #
+  # When left blank, the hostname followed by a random suffix is used.
+  instance_id="{{ .ApplicationServer.Cluster.InstanceID }}"
+
+  # Heartbeat interval.
+  #
+  # An instance is no longer reported, and gives up its leader roles, when
+  # it did not send a heartbeat for three intervals.
+  heartbeat_interval="{{ .ApplicationServer.Cluster.HeartbeatInterval }}"
+
+
+  # Event bus.
+  #
+  # The uplink and device lifecycle events are published to the internal
+  # event bus, through which the subsystems of the application-server (e.g.
+  # the device webhooks) consume these events.
+  [application_server.event_bus]
+  # Backend.
+  #
+  # Valid options are:
+  # * local: events are only delivered within the same instance
+  # * redis: events are delivered to all instances of the cluster using a
+  #          Redis stream (Redis 5.0+ is required), each subsystem handles
+  #          each event once. Events published while an instance is
+  #          restarting are handled afterwards.
+  backend="{{ .ApplicationServer.EventBus.Backend }}"
+
+  # Queue size (local backend).
+  #
+  # Max number of events queued per subsystem. When the queue is full,
+  # events are dropped.
+  queue_size={{ .ApplicationServer.EventBus.QueueSize }}
+
+  # Max stream length (redis backend).
+  #
+  # The (approximate) max number of events kept in the Redis stream. Events
+  # which have been removed from the stream before they were handled by a
+  # subsystem (e.g. as all instances were down) are skipped.
+  max_len={{ .ApplicationServer.EventBus.MaxLen }}
+
+
+  # Device link statistics.
+  #
+  # For each device, the daily uplink packet-loss is estimated from the gaps
+  # in the uplink frame-counters.
+  [application_server.device_link_stats]
+  # Unhealthy packet-loss threshold (percentage).
+  #
+  # A device link is reported as unhealthy when its estimated packet-loss
+  # exceeds this percentage.
+  unhealthy_packet_loss={{ .ApplicationServer.DeviceLinkStats.UnhealthyPacketLoss }}
+
+
+  # Clock synchronization.
+  #
+  # When enabled, the uplinks received on fPort 202 are handled as LoRaWAN
+  # Application Layer Clock Synchronization commands instead of being sent
+  # to the integrations. AppTimeReq requests are answered with the
+  # correction of the device clock (AppTimeAns).
+  [application_server.clock_sync]
+  enabled={{ .ApplicationServer.ClockSync.Enabled }}
+
+
+  # Raw uplink archive.
+  #
+  # When a bucket is configured, the raw uplinks of the applications that
+  # have archiving enabled are stored in this S3 (compatible) bucket,
+  # partitioned by date (UTC) and application:
+  # [prefix]YYYY/MM/DD/[application id]/[DevEUI]/[timestamp]_[fcnt].json
+  [application_server.archive]
+  # S3 endpoint.
+  #
+  # Leave this blank when using AWS S3. Set this to the URL of the
+  # S3 compatible storage otherwise (e.g. http://localhost:9000 for Minio).
+  endpoint="{{ .ApplicationServer.Archive.Endpoint }}"
+
+  # Region.
+  region="{{ .ApplicationServer.Archive.Region }}"
+
+  # Access key ID.
+  #
+  # When left blank, the default AWS credentials chain is used.
+  access_key_id="{{ .ApplicationServer.Archive.AccessKeyID }}"
+
+  # Secret access key.
+  secret_access_key="{{ .ApplicationServer.Archive.SecretAccessKey }}"
+
+  # Bucket name.
+  #
+  # Leave this blank to disable archiving.
+  bucket="{{ .ApplicationServer.Archive.Bucket }}"
+
+  # Key prefix (optional, e.g. "uplinks/").
+  prefix="{{ .ApplicationServer.Archive.Prefix }}"
+
+  # Use path style addressing.
+  #
+  # This is required by most S3 compatible storage solutions.
+  force_path_style={{ .ApplicationServer.Archive.ForcePathStyle }}
+
+  # Timeout of the requests to the object storage.
+  timeout="{{ .ApplicationServer.Archive.Timeout }}"
+
+  # Archive queue size.
+  #
+  # The uplinks are archived asynchronously so that the uplink handling does
+  # not wait for the object storage. Uplinks are not archived when the queue
+  # is full. Uplinks still in the queue on shutdown are lost.
+  queue_size={{ .ApplicationServer.Archive.QueueSize }}
+
+
+  # Retention of the stored device data.
+  #
+  # The data older than the configured retention is removed hourly.
+  # Set a retention to 0 to keep the data forever.
+  [application_server.retention]
+  # Device measurements.
+  #
+  # The decoded payloads of the uplinks, which are stored when the Grafana
+  # datasource endpoint is enabled.
+  device_measurements="{{ .ApplicationServer.Retention.DeviceMeasurements }}"
+
+  # Device locations.
+  #
+  # The location history of the devices (see the device track API).
+  device_locations="{{ .ApplicationServer.Retention.DeviceLocations }}"
+
+
   # Settings for the "internal api"
   #
   # This is the API used by LoRa Server to communicate with LoRa App Server
@@ -277,6 +585,17 @@ id="{{ .ApplicationServer.ID }}"
   # above.
   public_host="{{ .ApplicationServer.API.PublicHost }}"
 
+  # Client certificate pinning.
+  #
+  # When enabled, only network-servers presenting a client certificate
+  # matching the routing-profile TLS certificate or one of the client
+  # certificate fingerprints configured for the network-server are allowed
+  # to connect. A network-server can only submit data of the devices and
+  # gateways provisioned on it. This requires the ca_cert, tls_cert and
+  # tls_key options to be set. Note that changes to the network-servers can
+  # take up to one minute to be applied.
+  client_cert_pinning={{ .ApplicationServer.API.ClientCertPinning }}
+
 
   # Settings for the "external api"
   #
@@ -305,6 +624,114 @@ id="{{ .ApplicationServer.ID }}"
   # when set, existing users can't be re-assigned (to avoid exposure of all users to an organization admin)"
   disable_assign_existing_users={{ .ApplicationServer.ExternalAPI.DisableAssignExistingUsers }}
 
+  # Enable the diagnostics endpoint.
+  #
+  # When enabled, the pprof profiles (e.g. /debug/pprof/goroutine?debug=2)
+  # and the runtime diagnostics (/debug/diagnostics, including the storage
+  # connection pool statistics, the pending journal events and the codec
+  # execution statistics) are exposed by the API server. Note that the codec
+  # wallTime is the wall-clock time of the executions, not the CPU time.
+  # These endpoints are only accessible by global admin users.
+  enable_diagnostics={{ .ApplicationServer.ExternalAPI.EnableDiagnostics }}
+
+  # Enable the Grafana datasource endpoint.
+  #
+  # When enabled, an endpoint implementing the Grafana SimpleJSON datasource
+  # API is exposed under /grafana. This makes it possible to chart the
+  # decoded payloads (device measurements), the device link statistics and
+  # locations and the gateway statistics in Grafana. When enabled, the
+  # decoded payloads are stored as device measurements (see the retention
+  # settings). Requests must be authenticated using a JWT token
+  # (Authorization: Bearer <token> header).
+  enable_grafana={{ .ApplicationServer.ExternalAPI.EnableGrafana }}
+
+  # Enforce the terms acceptance.
+  #
+  # When enabled, users (except for global admin users) which did not accept
+  # the current terms version can't login until they accept it. Only enable
+  # this when the web-interface (or API client) supports the terms acceptance.
+  enforce_terms={{ .ApplicationServer.ExternalAPI.EnforceTerms }}
+
+  # SCIM bearer token.
+  #
+  # When set, a SCIM 2.0 endpoint is exposed under /scim/v2, so that identity
+  # providers are able to provision users and to map groups to organizations.
+  # The identity provider must authenticate using this bearer token.
+  # You could generate this by executing 'openssl rand -base64 32' for example.
+  scim_bearer_token="{{ .ApplicationServer.ExternalAPI.SCIMBearerToken }}"
+
+  # Gateway log bearer token (secret).
+  #
+  # When set, endpoints are exposed under /gateway-log/{gatewayID} through
+  # which the gateways (e.g. through the gateway bridge) pick up the pending
+  # packet-forwarder log requests and push the log excerpts. Each gateway
+  # must authenticate using its own bearer token, which is derived from this
+  # secret and the gateway ID (see /api/gateways/{gatewayID}/logs/token).
+  gateway_log_bearer_token="{{ .ApplicationServer.ExternalAPI.GatewayLogBearerToken }}"
+
+  # Embed URL template.
+  #
+  # Template of the URL returned when creating a device embed token, e.g.
+  # pointing to the page of an external portal embedding the device view.
+  # The following substitutions can be used:
+  # * "{{ "{{ .DevEUI }}" }}" for the DevEUI of the device.
+  # * "{{ "{{ .View }}" }}" for the embedded view (device or frames).
+  # * "{{ "{{ .Token }}" }}" for the (short-lived) embed token.
+  #
+  # When not set, only the embed token is returned.
+  embed_url_template="{{ .ApplicationServer.ExternalAPI.EmbedURLTemplate }}"
+
+  # Client authentication mode.
+  #
+  # The authentication mode used by the API clients. Valid options are:
+  #  * jwt:  clients authenticate using a JWT token (default)
+  #  * mtls: clients authenticate using a TLS client certificate, JWT
+  #          tokens are not accepted
+  #
+  # In the mtls mode, the client certificate must be signed by the
+  # client_ca_cert certificate and its common name must be mapped to a user
+  # by the client_cert_users mapping. The permissions of this user are used
+  # for authorization. This mode requires the tls_cert, tls_key and
+  # client_ca_cert options to be set. Note that only the gRPC API is
+  # available in this mode, the REST API (and thus the web-interface), the
+  # diagnostics and the Grafana endpoints are disabled.
+  client_auth_mode="{{ .ApplicationServer.ExternalAPI.ClientAuthMode }}"
+
+  # CA certificate used for validating the client certificates (mtls mode).
+  client_ca_cert="{{ .ApplicationServer.ExternalAPI.ClientCACert }}"
+
+  # Client certificate to user mapping (mtls mode).
+  #
+  # The common name is mapped to a username, not to a role. The certificate
+  # has the permissions of this user.
+  #
+  # Example:
+  # [[application_server.external_api.client_cert_users]]
+  # common_name="scada-01"
+  # username="scada"
+{{ range $index, $element := .ApplicationServer.ExternalAPI.ClientCertUsers }}
+  [[application_server.external_api.client_cert_users]]
+  common_name="{{ $element.CommonName }}"
+  username="{{ $element.Username }}"
+{{ end }}
+
+  # Webhooks (third-party network-servers).
+  #
+  # Each webhook exposes the /webhook/{format} endpoint for ingesting uplinks
+  # received by third-party network-servers (formats: helium, ttn) for a
+  # single application. The uplinks are mapped onto the devices of the
+  # application by DevEUI. The network-server must authenticate using the
+  # bearer token of the webhook.
+  #
+  # Example (the [[application_server.external_api.webhooks]] can be repeated):
+  # [[application_server.external_api.webhooks]]
+  # application_id=1
+  # bearer_token="..."
+{{ range $index, $element := .ApplicationServer.ExternalAPI.Webhooks }}
+  [[application_server.external_api.webhooks]]
+  application_id={{ $element.ApplicationID }}
+  bearer_token="{{ $element.BearerToken }}"
+{{ end }}
 {{ if ne .ApplicationServer.Branding.Header  "" }}
   # Branding configuration.
   [application_server.branding]
@@ -317,6 +744,12 @@ id="{{ .ApplicationServer.ID }}"
   # Registration.
   registration="{{ .ApplicationServer.Branding.Registration }}"
 
+  # Login banner.
+  #
+  # This (html) banner is displayed on the login page of all hostnames,
+  # e.g. for a legal notice.
+  login_banner="{{ .ApplicationServer.Branding.LoginBanner }}"
+
 {{ end }}
 
 # Join-server configuration.
@@ -383,6 +816,39 @@ tls_key="{{ .JoinServer.TLSKey }}"
   label="{{ $element.Label }}"
   kek="{{ $element.KEK }}"
 {{ end }}
+
+# Chaos (test) mode.
+#
+# When enabled, failures and latency are injected into the integration
+# publishes and the network-server API calls, so that the retry, event
+# journal and alerting configuration can be validated before relying on it
+# in production. Never enable this in production!
+[chaos]
+# Enable the chaos mode.
+enabled={{ .Chaos.Enabled }}
+
+  # Faults injected into the integration publishes.
+  #
+  # These apply to the global integrations (e.g. MQTT) and to the
+  # application integrations (e.g. HTTP and InfluxDB).
+  [chaos.integration]
+  # Fraction (0 - 1) of the publishes that fail.
+  failure_rate={{ .Chaos.Integration.FailureRate }}
+
+  # Latency added to every publish.
+  latency="{{ .Chaos.Integration.Latency }}"
+
+  # Faults injected into the network-server API calls.
+  #
+  # A failing call returns an Unavailable error, which is retried and counted
+  # by the circuit-breaker like a real network-server outage.
+  [chaos.network_server]
+  # Fraction (0 - 1) of the API calls that fail.
+  failure_rate={{ .Chaos.NetworkServer.FailureRate }}
+
+  # Latency added to every API call. Note that this counts towards the
+  # network_server timeout.
+  latency="{{ .Chaos.NetworkServer.Latency }}"
 `
 
 var configCmd = &cobra.Command{
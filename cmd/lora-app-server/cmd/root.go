@@ -38,14 +38,21 @@ func init() {
 	viper.SetDefault("general.password_hash_iterations", 100000)
 	viper.SetDefault("postgresql.dsn", "postgres://localhost/loraserver_as?sslmode=disable")
 	viper.SetDefault("postgresql.automigrate", true)
+	viper.SetDefault("postgresql.slow_query_threshold", time.Second)
 	viper.SetDefault("redis.url", "redis://localhost:6379")
 	viper.SetDefault("redis.max_idle", 10)
 	viper.SetDefault("redis.idle_timeout", 5*time.Minute)
+	viper.SetDefault("network_server.timeout", 5*time.Second)
+	viper.SetDefault("network_server.retry_count", 2)
+	viper.SetDefault("network_server.retry_interval", 200*time.Millisecond)
+	viper.SetDefault("network_server.circuit_breaker.failure_threshold", 5)
+	viper.SetDefault("network_server.circuit_breaker.open_timeout", 30*time.Second)
 	viper.SetDefault("application_server.integration.mqtt.server", "tcp://localhost:1883")
 	viper.SetDefault("application_server.api.public_host", "localhost:8001")
 	viper.SetDefault("application_server.id", "6d5db27e-4ce2-4b2b-b5d7-91f069397978")
 	viper.SetDefault("application_server.api.bind", "0.0.0.0:8001")
 	viper.SetDefault("application_server.external_api.bind", "0.0.0.0:8080")
+	viper.SetDefault("application_server.external_api.client_auth_mode", "jwt")
 	viper.SetDefault("join_server.bind", "0.0.0.0:8003")
 	viper.SetDefault("application_server.integration.mqtt.uplink_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/rx")
 	viper.SetDefault("application_server.integration.mqtt.downlink_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/tx")
@@ -54,12 +61,31 @@ func init() {
 	viper.SetDefault("application_server.integration.mqtt.error_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/error")
 	viper.SetDefault("application_server.integration.mqtt.status_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/status")
 	viper.SetDefault("application_server.integration.mqtt.location_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/location")
+	viper.SetDefault("application_server.integration.mqtt.heartbeat_topic_template", "application/{{ .ApplicationID }}/heartbeat")
 	viper.SetDefault("application_server.integration.mqtt.clean_session", true)
 	viper.SetDefault("application_server.integration.enabled", []string{"mqtt"})
+	viper.SetDefault("application_server.integration.http.callback_ttl", 24*time.Hour)
+	viper.SetDefault("application_server.integration.modbus.bind", "127.0.0.1:502")
+	viper.SetDefault("application_server.device_link_stats.unhealthy_packet_loss", 10)
+	viper.SetDefault("application_server.cluster.heartbeat_interval", 10*time.Second)
+	viper.SetDefault("application_server.event_bus.backend", "local")
+	viper.SetDefault("application_server.event_bus.queue_size", 1000)
+	viper.SetDefault("application_server.event_bus.max_len", 100000)
+	viper.SetDefault("application_server.integration.journal.dispatch_interval", time.Second)
+	viper.SetDefault("application_server.integration.journal.batch_size", 100)
+	viper.SetDefault("application_server.integration.journal.max_attempts", 3600)
+	viper.SetDefault("application_server.archive.timeout", 10*time.Second)
+	viper.SetDefault("application_server.archive.queue_size", 1000)
+	viper.SetDefault("application_server.integration.journal.retention", 24*time.Hour)
+	viper.SetDefault("application_server.retention.device_measurements", 30*24*time.Hour)
+	viper.SetDefault("application_server.retention.device_locations", 90*24*time.Hour)
 	viper.SetDefault("application_server.codec.js.max_execution_time", 100*time.Millisecond)
+	viper.SetDefault("application_server.codec.js.max_concurrency_per_organization", 4)
+	viper.SetDefault("application_server.codec.js.max_queue_time", time.Second)
 
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(moveOrganizationStorageCmd)
 }
 
 // Execute executes the root command.
@@ -12,15 +12,29 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/brocaar/lora-app-server/internal/api"
+	"github.com/brocaar/lora-app-server/internal/archive"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/campaign"
+	"github.com/brocaar/lora-app-server/internal/chaos"
+	"github.com/brocaar/lora-app-server/internal/clocksync"
+	"github.com/brocaar/lora-app-server/internal/cluster"
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/devicewebhook"
 	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/eventbus"
 	"github.com/brocaar/lora-app-server/internal/gwping"
+	"github.com/brocaar/lora-app-server/internal/heartbeat"
 	"github.com/brocaar/lora-app-server/internal/integration"
 	"github.com/brocaar/lora-app-server/internal/integration/application"
+	httpint "github.com/brocaar/lora-app-server/internal/integration/http"
+	"github.com/brocaar/lora-app-server/internal/integration/journal"
 	"github.com/brocaar/lora-app-server/internal/integration/multi"
+	"github.com/brocaar/lora-app-server/internal/reprocess"
+	"github.com/brocaar/lora-app-server/internal/retention"
+	"github.com/brocaar/lora-app-server/internal/schedule"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/uplink"
 )
 
 func run(cmd *cobra.Command, args []string) error {
@@ -31,12 +45,26 @@ func run(cmd *cobra.Command, args []string) error {
 	tasks := []func() error{
 		setLogLevel,
 		printStartMessage,
+		setupChaos,
 		setupStorage,
+		setupCluster,
+		setupEventBus,
 		setupNetworkServer,
 		setupIntegration,
 		setupCodec,
+		setupArchive,
+		setupClockSync,
+		setupDeviceWebhooks,
+		setupUplink,
+		setupRetention,
 		handleDataDownPayloads,
 		startGatewayPing,
+		startReprocessUplinks,
+		startCampaigns,
+		startScheduler,
+		startResponseWindows,
+		startHeartbeats,
+		startRetention,
 		setupAPI,
 	}
 
@@ -53,6 +81,9 @@ func run(cmd *cobra.Command, args []string) error {
 	go func() {
 		log.Warning("stopping lora-app-server")
 		// todo: handle graceful shutdown?
+		if err := integration.Integration().Close(); err != nil {
+			log.WithError(err).Error("close integration error")
+		}
 		exitChan <- struct{}{}
 	}()
 	select {
@@ -77,6 +108,14 @@ func printStartMessage() error {
 	return nil
 }
 
+func setupChaos() error {
+	if err := chaos.Setup(config.C); err != nil {
+		return errors.Wrap(err, "setup chaos error")
+	}
+
+	return nil
+}
+
 func setupStorage() error {
 	if err := storage.Setup(config.C); err != nil {
 		return errors.Wrap(err, "setup storage error")
@@ -85,8 +124,29 @@ func setupStorage() error {
 	return nil
 }
 
+func setupCluster() error {
+	if err := cluster.Setup(config.C, version); err != nil {
+		return errors.Wrap(err, "setup cluster error")
+	}
+
+	return nil
+}
+
+func setupEventBus() error {
+	if err := eventbus.Setup(config.C); err != nil {
+		return errors.Wrap(err, "setup event bus error")
+	}
+
+	return nil
+}
+
 func setupIntegration() error {
+	if err := httpint.Setup(config.C); err != nil {
+		return errors.Wrap(err, "setup http integration error")
+	}
+
 	var confs []interface{}
+	var names []string
 
 	for _, name := range config.C.ApplicationServer.Integration.Enabled {
 		switch name {
@@ -98,16 +158,42 @@ func setupIntegration() error {
 			confs = append(confs, config.C.ApplicationServer.Integration.MQTT)
 		case "gcp_pub_sub":
 			confs = append(confs, config.C.ApplicationServer.Integration.GCPPubSub)
+		case "modbus":
+			confs = append(confs, config.C.ApplicationServer.Integration.Modbus)
 		default:
 			return fmt.Errorf("unknown integration type: %s", name)
 		}
+		names = append(names, name)
 	}
 
 	mi, err := multi.New(confs)
 	if err != nil {
 		return errors.Wrap(err, "setup integrations error")
 	}
-	mi.Add(application.New())
+	ai := application.New()
+
+	if config.C.ApplicationServer.Integration.Journal.Enabled {
+		// the journal tracks the acknowledgements by integration name
+		integrations := map[string]integration.Integrator{
+			"application": ai,
+		}
+		for i, ii := range mi.Integrations() {
+			if _, ok := integrations[names[i]]; ok {
+				return fmt.Errorf("integration %s is enabled more than once", names[i])
+			}
+			integrations[names[i]] = ii
+		}
+
+		ji, err := journal.New(config.C, integrations)
+		if err != nil {
+			return errors.Wrap(err, "setup event journal error")
+		}
+		integration.SetIntegration(ji)
+
+		return nil
+	}
+
+	mi.Add(ai)
 	integration.SetIntegration(mi)
 
 	return nil
@@ -120,6 +206,41 @@ func setupCodec() error {
 	return nil
 }
 
+func setupArchive() error {
+	if err := archive.Setup(config.C); err != nil {
+		return errors.Wrap(err, "setup archive error")
+	}
+	return nil
+}
+
+func setupClockSync() error {
+	if err := clocksync.Setup(config.C); err != nil {
+		return errors.Wrap(err, "setup clocksync error")
+	}
+	return nil
+}
+
+func setupDeviceWebhooks() error {
+	if err := devicewebhook.Setup(); err != nil {
+		return errors.Wrap(err, "setup device webhooks error")
+	}
+	return nil
+}
+
+func setupUplink() error {
+	if err := uplink.Setup(config.C); err != nil {
+		return errors.Wrap(err, "setup uplink error")
+	}
+	return nil
+}
+
+func setupRetention() error {
+	if err := retention.Setup(config.C); err != nil {
+		return errors.Wrap(err, "setup retention error")
+	}
+	return nil
+}
+
 func setupNetworkServer() error {
 	if err := networkserver.Setup(config.C); err != nil {
 		return errors.Wrap(err, "setup networkserver error")
@@ -144,3 +265,39 @@ func startGatewayPing() error {
 
 	return nil
 }
+
+func startReprocessUplinks() error {
+	go reprocess.ProcessJobsLoop()
+
+	return nil
+}
+
+func startCampaigns() error {
+	go campaign.HandleCampaignsLoop()
+
+	return nil
+}
+
+func startScheduler() error {
+	go schedule.HandleJobsLoop()
+
+	return nil
+}
+
+func startResponseWindows() error {
+	go downlink.HandleResponseTimeoutsLoop()
+
+	return nil
+}
+
+func startHeartbeats() error {
+	go heartbeat.SendHeartbeatsLoop()
+
+	return nil
+}
+
+func startRetention() error {
+	go retention.CleanupLoop()
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+var moveOrganizationID int64
+var moveStorageCluster string
+
+var moveOrganizationStorageCmd = &cobra.Command{
+	Use:   "move-organization-storage",
+	Short: "Move the device data of an organization to an other storage cluster",
+	Long: `Move the device data (measurements and locations) of an organization to
+an other PostgreSQL storage cluster. New device data of the organization is
+stored in the given storage cluster immediately, the existing device data is
+moved in batches. Omit the storage cluster to move the data to the default
+storage cluster.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if moveOrganizationID == 0 {
+			return errors.New("organization-id must be set")
+		}
+
+		if err := storage.Setup(config.C); err != nil {
+			return errors.Wrap(err, "setup storage error")
+		}
+
+		count, err := storage.MoveOrganizationStorage(moveOrganizationID, moveStorageCluster)
+		if err != nil {
+			return errors.Wrap(err, "move organization storage error")
+		}
+
+		log.WithFields(log.Fields{
+			"organization_id": moveOrganizationID,
+			"storage_cluster": moveStorageCluster,
+			"count":           count,
+		}).Info("device data of organization moved")
+
+		return nil
+	},
+}
+
+func init() {
+	moveOrganizationStorageCmd.Flags().Int64Var(&moveOrganizationID, "organization-id", 0, "id of the organization")
+	moveOrganizationStorageCmd.Flags().StringVar(&moveStorageCluster, "storage-cluster", "", "name of the storage cluster (empty for the default storage cluster)")
+}
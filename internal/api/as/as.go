@@ -10,7 +10,6 @@ import (
 	"time"
 
 	keywrap "github.com/NickBall/go-aes-key-wrap"
-	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -18,14 +17,19 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/brocaar/lora-app-server/internal/api/helpers"
-	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/archive"
+	"github.com/brocaar/lora-app-server/internal/clocksync"
+	"github.com/brocaar/lora-app-server/internal/cluster"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/eventbus"
 	"github.com/brocaar/lora-app-server/internal/eventlog"
 	"github.com/brocaar/lora-app-server/internal/gwping"
 	"github.com/brocaar/lora-app-server/internal/integration"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/uplink"
 	"github.com/brocaar/loraserver/api/as"
 	"github.com/brocaar/loraserver/api/common"
 	"github.com/brocaar/lorawan"
@@ -52,13 +56,27 @@ func Setup(conf config.Config) error {
 		"tls_key":  tlsKey,
 	}).Info("api/as: starting application-server api")
 
-	grpcOpts := helpers.GetgRPCLoggingServerOptions()
+	var unary []grpc.UnaryServerInterceptor
+	var allowList clientCertAllowList
+	if conf.ApplicationServer.API.ClientCertPinning {
+		unary = append(unary, allowList.unaryServerInterceptor)
+	}
+
+	grpcOpts := helpers.GetgRPCServerOptions(unary, nil)
 	if caCert != "" && tlsCert != "" && tlsKey != "" {
-		creds, err := helpers.GetTransportCredentials(caCert, tlsCert, tlsKey, true)
+		tlsConfig, err := helpers.GetTLSConfig(caCert, tlsCert, tlsKey, true)
 		if err != nil {
-			return errors.Wrap(err, "get transport credentials error")
+			return errors.Wrap(err, "get tls config error")
+		}
+
+		if conf.ApplicationServer.API.ClientCertPinning {
+			log.Info("api/as: client certificate pinning enabled")
+			tlsConfig.VerifyPeerCertificate = allowList.verifyPeerCertificate
 		}
-		grpcOpts = append(grpcOpts, grpc.Creds(creds))
+
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else if conf.ApplicationServer.API.ClientCertPinning {
+		return errors.New("client certificate pinning requires ca_cert, tls_cert and tls_key to be set")
 	}
 	server := grpc.NewServer(grpcOpts...)
 	as.RegisterApplicationServerServiceServer(server, NewApplicationServerAPI())
@@ -87,12 +105,23 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		return nil, grpc.Errorf(codes.InvalidArgument, "tx_info must not be nil")
 	}
 
+	cluster.Inc(cluster.CounterUplink)
+
 	var err error
 	var d storage.Device
+	var activated bool
 	var appEUI, devEUI lorawan.EUI64
 	copy(appEUI[:], req.JoinEui)
 	copy(devEUI[:], req.DevEui)
 
+	if err := validateNetworkServerForDevEUI(ctx, devEUI); err != nil {
+		return nil, err
+	}
+
+	// the events are sent within the same transaction as the device update
+	// when the integration supports this (event journal)
+	var flush func() error
+
 	err = storage.Transaction(func(tx sqlx.Ext) error {
 		d, err = storage.GetDevice(tx, devEUI, true, true)
 		if err != nil {
@@ -100,176 +129,139 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		}
 
 		now := time.Now()
+		dr := int(req.Dr)
 
 		d.LastSeenAt = &now
+		d.DR = &dr
 		err = storage.UpdateDevice(tx, &d, true)
 		if err != nil {
 			return grpc.Errorf(codes.Internal, "update device error: %s", err)
 		}
 
-		return nil
+		// a provisioned device becomes active on its first uplink
+		if d.LifecycleState == storage.DeviceProvisioned {
+			err = storage.UpdateDeviceLifecycleState(tx, &d, storage.DeviceActive)
+			if err != nil {
+				return grpc.Errorf(codes.Internal, "update device lifecycle state error: %s", err)
+			}
+			activated = true
+		}
+
+		err = storage.UpdateDeviceLinkStat(tx, d.DevEUI, req.FCnt, now)
+		if err != nil {
+			return grpc.Errorf(codes.Internal, "update device link stat error: %s", err)
+		}
+
+		var intg integration.Integrator
+		intg, flush = integration.ForTx(tx)
+
+		return handleUplinkData(tx, intg, d, req)
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	app, err := storage.GetApplication(storage.DB(), d.ApplicationID)
+	if activated {
+		eventbus.Publish(eventbus.DeviceActivated, d.ApplicationID, d.DevEUI, d)
+	}
+
+	if err := flush(); err != nil {
+		log.WithError(err).Error("send uplink data to integration error")
+		return nil, grpc.Errorf(codes.Internal, "send uplink data to integration error: %s", err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// handleUplinkData handles the (activation and) uplink payload of the given
+// device, within the given transaction.
+func handleUplinkData(tx sqlx.Ext, intg integration.Integrator, d storage.Device, req *as.HandleUplinkDataRequest) error {
+	app, err := storage.GetApplication(tx, d.ApplicationID)
 	if err != nil {
 		errStr := fmt.Sprintf("get application error: %s", err)
 		log.WithField("id", d.ApplicationID).Error(errStr)
-		return nil, grpc.Errorf(codes.Internal, errStr)
+		return grpc.Errorf(codes.Internal, errStr)
 	}
 
 	if req.DeviceActivationContext != nil {
-		if err := handleDeviceActivation(d, app, req.DeviceActivationContext); err != nil {
-			return nil, helpers.ErrToRPCError(err)
+		if err := handleDeviceActivation(tx, intg, d, app, req.DeviceActivationContext); err != nil {
+			return helpers.ErrToRPCError(err)
 		}
 	}
 
-	da, err := storage.GetLastDeviceActivationForDevEUI(storage.DB(), d.DevEUI)
+	da, err := storage.GetLastDeviceActivationForDevEUI(tx, d.DevEUI)
 	if err != nil {
 		errStr := fmt.Sprintf("get device-activation error: %s", err)
 		log.WithField("dev_eui", d.DevEUI).Error(errStr)
-		return nil, grpc.Errorf(codes.Internal, errStr)
+		return grpc.Errorf(codes.Internal, errStr)
 	}
 
 	b, err := lorawan.EncryptFRMPayload(da.AppSKey, true, da.DevAddr, req.FCnt, req.Data)
 	if err != nil {
 		log.WithFields(log.Fields{
-			"dev_eui": devEUI,
+			"dev_eui": d.DevEUI,
 			"f_cnt":   req.FCnt,
 		}).Errorf("decrypt payload error: %s", err)
-		return nil, grpc.Errorf(codes.Internal, "decrypt payload error: %s", err)
-	}
-
-	var object interface{}
-	codecPL := codec.NewPayload(app.PayloadCodec, uint8(req.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
-	if codecPL != nil {
-		start := time.Now()
-		if err := codecPL.DecodeBytes(b); err != nil {
-			log.WithFields(log.Fields{
-				"codec":          app.PayloadCodec,
-				"application_id": app.ID,
-				"f_port":         req.FPort,
-				"f_cnt":          req.FCnt,
-				"dev_eui":        d.DevEUI,
-			}).WithError(err).Error("decode payload error")
-
-			errNotification := integration.ErrorNotification{
-				ApplicationID:   d.ApplicationID,
-				ApplicationName: app.Name,
-				DeviceName:      d.Name,
-				DevEUI:          d.DevEUI,
-				Type:            "CODEC",
-				Error:           err.Error(),
-				FCnt:            req.FCnt,
-			}
+		return grpc.Errorf(codes.Internal, "decrypt payload error: %s", err)
+	}
 
-			if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
-				Type:    eventlog.Error,
-				Payload: errNotification,
-			}); err != nil {
-				log.WithError(err).Error("log event for device error")
-			}
+	uplink.Archive(app, archive.Uplink{
+		ReceivedAt:    time.Now(),
+		ApplicationID: app.ID,
+		DevEUI:        d.DevEUI,
+		DevAddr:       da.DevAddr,
+		Data:          b,
+		Request:       req,
+	})
 
-			if err := integration.Integration().SendErrorNotification(errNotification); err != nil {
-				log.WithError(err).Error("send error notification to integration error")
-			}
-		} else {
-			log.WithFields(log.Fields{
-				"application_id": app.ID,
-				"codec":          app.PayloadCodec,
-				"duration":       time.Since(start),
-			}).Debug("payload codec completed Decode execution")
-			object = codecPL.Object()
+	// clock synchronization uplinks are not sent to the integrations
+	if clocksync.Handles(uint8(req.FPort)) {
+		if err := clocksync.HandleUplink(tx, d.DevEUI, req.RxInfo, b); err != nil {
+			log.WithField("dev_eui", d.DevEUI).WithError(err).Error("handle clocksync uplink error")
+			return grpc.Errorf(codes.Internal, "handle clocksync uplink error: %s", err)
 		}
+		return nil
 	}
 
 	pl := integration.DataUpPayload{
 		ApplicationID:   app.ID,
 		ApplicationName: app.Name,
 		DeviceName:      d.Name,
-		DevEUI:          devEUI,
-		RXInfo:          []integration.RXInfo{},
+		DevEUI:          d.DevEUI,
 		TXInfo: integration.TXInfo{
 			Frequency: int(req.TxInfo.Frequency),
 			DR:        int(req.Dr),
 		},
-		ADR:    req.Adr,
-		FCnt:   req.FCnt,
-		FPort:  uint8(req.FPort),
-		Data:   b,
-		Object: object,
-	}
-
-	// collect gateway data of receiving gateways (e.g. gateway name)
-	var macs []lorawan.EUI64
-	for _, rxInfo := range req.RxInfo {
-		var mac lorawan.EUI64
-		copy(mac[:], rxInfo.GatewayId)
-		macs = append(macs, mac)
-	}
-	gws, err := storage.GetGatewaysForMACs(storage.DB(), macs)
-	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "get gateways for macs error: %s", err)
+		ADR:   req.Adr,
+		FCnt:  req.FCnt,
+		FPort: uint8(req.FPort),
+		Data:  b,
 	}
 
-	for _, rxInfo := range req.RxInfo {
-		var mac lorawan.EUI64
-		copy(mac[:], rxInfo.GatewayId)
-
-		row := integration.RXInfo{
-			GatewayID: mac,
-			RSSI:      int(rxInfo.Rssi),
-			LoRaSNR:   rxInfo.LoraSnr,
-		}
-
-		if rxInfo.Location != nil {
-			row.Location = &integration.Location{
-				Latitude:  rxInfo.Location.Latitude,
-				Longitude: rxInfo.Location.Longitude,
-				Altitude:  rxInfo.Location.Altitude,
-			}
-		}
-
-		if gw, ok := gws[mac]; ok {
-			row.Name = gw.Name
-		}
-
-		if rxInfo.Time != nil {
-			ts, err := ptypes.Timestamp(rxInfo.Time)
-			if err != nil {
-				log.WithField("dev_eui", devEUI).WithError(err).Error("parse timestamp error")
-			} else {
-				row.Time = &ts
-			}
-		}
-
-		pl.RXInfo = append(pl.RXInfo, row)
-	}
-
-	err = eventlog.LogEventForDevice(devEUI, eventlog.EventLog{
-		Type:    eventlog.Uplink,
-		Payload: pl,
-	})
+	pl.RXInfo, err = uplink.RXInfo(d.DevEUI, req.RxInfo)
 	if err != nil {
-		log.WithError(err).Error("log event for device error")
+		return grpc.Errorf(codes.Internal, "get rx-info error: %s", err)
 	}
 
-	err = integration.Integration().SendDataUp(pl)
-	if err != nil {
-		log.WithError(err).Error("send uplink data to integration error")
-		return nil, grpc.Errorf(codes.Internal, err.Error())
+	if err := uplink.Handle(tx, intg, d, app, pl); err != nil {
+		log.WithError(err).Error("handle uplink error")
+		return grpc.Errorf(codes.Internal, err.Error())
 	}
 
-	return &empty.Empty{}, nil
+	return nil
 }
 
 // HandleDownlinkACK handles an ack on a downlink transmission.
 func (a *ApplicationServerAPI) HandleDownlinkACK(ctx context.Context, req *as.HandleDownlinkACKRequest) (*empty.Empty, error) {
+	cluster.Inc(cluster.CounterACK)
+
 	var devEUI lorawan.EUI64
 	copy(devEUI[:], req.DevEui)
 
+	if err := validateNetworkServerForDevEUI(ctx, devEUI); err != nil {
+		return nil, err
+	}
+
 	d, err := storage.GetDevice(storage.DB(), devEUI, false, true)
 	if err != nil {
 		errStr := fmt.Sprintf("get device error: %s", err)
@@ -304,9 +296,38 @@ func (a *ApplicationServerAPI) HandleDownlinkACK(ctx context.Context, req *as.Ha
 		log.WithError(err).Error("log event for device error")
 	}
 
-	err = integration.Integration().SendACKNotification(pl)
+	// the campaign confirmation and the event are committed together when
+	// the integration supports this (event journal)
+	var flush func() error
+	err = storage.Transaction(func(tx sqlx.Ext) error {
+		var intg integration.Integrator
+		intg, flush = integration.ForTx(tx)
+
+		if req.Acknowledged {
+			if _, err := storage.ConfirmCampaignDevice(tx, devEUI, req.FCnt); err != nil {
+				return grpc.Errorf(codes.Internal, "confirm campaign device error: %s", err)
+			}
+		}
+
+		if d.LifecycleState.Enabled() {
+			if err := intg.SendACKNotification(pl); err != nil {
+				return grpc.Errorf(codes.Internal, "send ack notification to integration error: %s", err)
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
-		log.Errorf("send ack notification to integration error: %s", err)
+		log.WithField("dev_eui", devEUI).WithError(err).Error("handle downlink ack error")
+		return nil, err
+	}
+
+	if d.LifecycleState.Enabled() {
+		eventbus.Publish(eventbus.ACK, pl.ApplicationID, pl.DevEUI, pl)
+
+		if err := flush(); err != nil {
+			log.Errorf("send ack notification to integration error: %s", err)
+		}
 	}
 
 	return &empty.Empty{}, nil
@@ -317,6 +338,10 @@ func (a *ApplicationServerAPI) HandleError(ctx context.Context, req *as.HandleEr
 	var devEUI lorawan.EUI64
 	copy(devEUI[:], req.DevEui)
 
+	if err := validateNetworkServerForDevEUI(ctx, devEUI); err != nil {
+		return nil, err
+	}
+
 	d, err := storage.GetDevice(storage.DB(), devEUI, false, true)
 	if err != nil {
 		errStr := fmt.Sprintf("get device error: %s", err)
@@ -353,11 +378,15 @@ func (a *ApplicationServerAPI) HandleError(ctx context.Context, req *as.HandleEr
 		log.WithError(err).Error("log event for device error")
 	}
 
-	err = integration.Integration().SendErrorNotification(pl)
-	if err != nil {
-		errStr := fmt.Sprintf("send error notification to integration error: %s", err)
-		log.Error(errStr)
-		return nil, grpc.Errorf(codes.Internal, errStr)
+	if d.LifecycleState.Enabled() {
+		eventbus.Publish(eventbus.Error, pl.ApplicationID, pl.DevEUI, pl)
+
+		err = integration.Integration().SendErrorNotification(pl)
+		if err != nil {
+			errStr := fmt.Sprintf("send error notification to integration error: %s", err)
+			log.Error(errStr)
+			return nil, grpc.Errorf(codes.Internal, errStr)
+		}
 	}
 
 	return &empty.Empty{}, nil
@@ -369,6 +398,15 @@ func (a *ApplicationServerAPI) HandleProprietaryUplink(ctx context.Context, req
 		return nil, grpc.Errorf(codes.InvalidArgument, "tx_info must not be nil")
 	}
 
+	for _, rx := range req.RxInfo {
+		var mac lorawan.EUI64
+		copy(mac[:], rx.GatewayId)
+
+		if err := validateNetworkServerForGatewayMAC(ctx, mac); err != nil {
+			return nil, err
+		}
+	}
+
 	err := gwping.HandleReceivedPing(req)
 	if err != nil {
 		errStr := fmt.Sprintf("handle received ping error: %s", err)
@@ -384,9 +422,17 @@ func (a *ApplicationServerAPI) SetDeviceStatus(ctx context.Context, req *as.SetD
 	var devEUI lorawan.EUI64
 	copy(devEUI[:], req.DevEui)
 
+	if err := validateNetworkServerForDevEUI(ctx, devEUI); err != nil {
+		return nil, err
+	}
+
 	var d storage.Device
+	var pl integration.StatusNotification
+	var flush func() error
 	var err error
 
+	// the event is sent within the same transaction as the device update
+	// when the integration supports this (event journal)
 	err = storage.Transaction(func(tx sqlx.Ext) error {
 		d, err = storage.GetDevice(tx, devEUI, true, true)
 		if err != nil {
@@ -411,28 +457,38 @@ func (a *ApplicationServerAPI) SetDeviceStatus(ctx context.Context, req *as.SetD
 			return helpers.ErrToRPCError(errors.Wrap(err, "update device error"))
 		}
 
+		app, err := storage.GetApplication(tx, d.ApplicationID)
+		if err != nil {
+			return helpers.ErrToRPCError(errors.Wrap(err, "get application error"))
+		}
+
+		pl = integration.StatusNotification{
+			ApplicationID:           app.ID,
+			ApplicationName:         app.Name,
+			DeviceName:              d.Name,
+			DevEUI:                  d.DevEUI,
+			Battery:                 int(req.Battery),
+			Margin:                  int(req.Margin),
+			ExternalPowerSource:     req.ExternalPowerSource,
+			BatteryLevel:            float32(math.Round(float64(req.BatteryLevel*100))) / 100,
+			BatteryLevelUnavailable: req.BatteryLevelUnavailable,
+		}
+
+		var intg integration.Integrator
+		intg, flush = integration.ForTx(tx)
+
+		if d.LifecycleState.Enabled() {
+			if err := intg.SendStatusNotification(pl); err != nil {
+				return helpers.ErrToRPCError(errors.Wrap(err, "send status notification to handler error"))
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	app, err := storage.GetApplication(storage.DB(), d.ApplicationID)
-	if err != nil {
-		return nil, helpers.ErrToRPCError(errors.Wrap(err, "get application error"))
-	}
-
-	pl := integration.StatusNotification{
-		ApplicationID:           app.ID,
-		ApplicationName:         app.Name,
-		DeviceName:              d.Name,
-		DevEUI:                  d.DevEUI,
-		Battery:                 int(req.Battery),
-		Margin:                  int(req.Margin),
-		ExternalPowerSource:     req.ExternalPowerSource,
-		BatteryLevel:            float32(math.Round(float64(req.BatteryLevel*100))) / 100,
-		BatteryLevelUnavailable: req.BatteryLevelUnavailable,
-	}
 	err = eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
 		Type:    eventlog.Status,
 		Payload: pl,
@@ -441,9 +497,12 @@ func (a *ApplicationServerAPI) SetDeviceStatus(ctx context.Context, req *as.SetD
 		log.WithError(err).Error("log event for device error")
 	}
 
-	err = integration.Integration().SendStatusNotification(pl)
-	if err != nil {
-		return nil, helpers.ErrToRPCError(errors.Wrap(err, "send status notification to handler error"))
+	if d.LifecycleState.Enabled() {
+		eventbus.Publish(eventbus.Status, pl.ApplicationID, pl.DevEUI, pl)
+
+		if err := flush(); err != nil {
+			return nil, helpers.ErrToRPCError(errors.Wrap(err, "send status notification to handler error"))
+		}
 	}
 
 	return &empty.Empty{}, nil
@@ -458,11 +517,18 @@ func (a *ApplicationServerAPI) SetDeviceLocation(ctx context.Context, req *as.Se
 	var devEUI lorawan.EUI64
 	copy(devEUI[:], req.DevEui)
 
-	var d storage.Device
-	var err error
+	if err := validateNetworkServerForDevEUI(ctx, devEUI); err != nil {
+		return nil, err
+	}
 
-	err = storage.Transaction(func(tx sqlx.Ext) error {
-		d, err = storage.GetDevice(tx, devEUI, true, true)
+	var pl integration.LocationNotification
+	var flush func() error
+	var enabled bool
+
+	// the event is sent within the same transaction as the location update
+	// when the integration supports this (event journal)
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		d, err := storage.GetDevice(tx, devEUI, true, true)
 		if err != nil {
 			return helpers.ErrToRPCError(errors.Wrap(err, "get device error"))
 		}
@@ -475,30 +541,50 @@ func (a *ApplicationServerAPI) SetDeviceLocation(ctx context.Context, req *as.Se
 			return helpers.ErrToRPCError(errors.Wrap(err, "update device error"))
 		}
 
+		if err = storage.CreateDeviceLocation(tx, &storage.DeviceLocation{
+			DevEUI:    d.DevEUI,
+			Source:    req.Location.Source.String(),
+			Latitude:  req.Location.Latitude,
+			Longitude: req.Location.Longitude,
+			Altitude:  req.Location.Altitude,
+		}); err != nil {
+			return helpers.ErrToRPCError(errors.Wrap(err, "create device location error"))
+		}
+
+		app, err := storage.GetApplication(tx, d.ApplicationID)
+		if err != nil {
+			return helpers.ErrToRPCError(errors.Wrap(err, "get application error"))
+		}
+
+		enabled = d.LifecycleState.Enabled()
+		pl = integration.LocationNotification{
+			ApplicationID:   app.ID,
+			ApplicationName: app.Name,
+			DeviceName:      d.Name,
+			DevEUI:          d.DevEUI,
+			Location: integration.Location{
+				Latitude:  req.Location.Latitude,
+				Longitude: req.Location.Longitude,
+				Altitude:  req.Location.Altitude,
+			},
+		}
+
+		var intg integration.Integrator
+		intg, flush = integration.ForTx(tx)
+
+		if enabled {
+			if err := intg.SendLocationNotification(pl); err != nil {
+				return helpers.ErrToRPCError(errors.Wrap(err, "send location notification to handler error"))
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	app, err := storage.GetApplication(storage.DB(), d.ApplicationID)
-	if err != nil {
-		return nil, helpers.ErrToRPCError(errors.Wrap(err, "get application error"))
-	}
-
-	pl := integration.LocationNotification{
-		ApplicationID:   app.ID,
-		ApplicationName: app.Name,
-		DeviceName:      d.Name,
-		DevEUI:          d.DevEUI,
-		Location: integration.Location{
-			Latitude:  req.Location.Latitude,
-			Longitude: req.Location.Longitude,
-			Altitude:  req.Location.Altitude,
-		},
-	}
-
-	err = eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+	err = eventlog.LogEventForDevice(pl.DevEUI, eventlog.EventLog{
 		Type:    eventlog.Location,
 		Payload: pl,
 	})
@@ -506,9 +592,12 @@ func (a *ApplicationServerAPI) SetDeviceLocation(ctx context.Context, req *as.Se
 		log.WithError(err).Error("log event for device error")
 	}
 
-	err = integration.Integration().SendLocationNotification(pl)
-	if err != nil {
-		return nil, helpers.ErrToRPCError(errors.Wrap(err, "send location notification to handler error"))
+	if enabled {
+		eventbus.Publish(eventbus.Location, pl.ApplicationID, pl.DevEUI, pl)
+
+		if err := flush(); err != nil {
+			return nil, helpers.ErrToRPCError(errors.Wrap(err, "send location notification to handler error"))
+		}
 	}
 
 	return &empty.Empty{}, nil
@@ -562,7 +651,7 @@ func getSKey(typ byte, appkey lorawan.AES128Key, netID lorawan.NetID, appNonce [
 	return key, nil
 }
 
-func handleDeviceActivation(d storage.Device, app storage.Application, daCtx *as.DeviceActivationContext) error {
+func handleDeviceActivation(db sqlx.Ext, intg integration.Integrator, d storage.Device, app storage.Application, daCtx *as.DeviceActivationContext) error {
 	if daCtx.AppSKey == nil {
 		return errors.New("AppSKey must not be nil")
 	}
@@ -578,7 +667,7 @@ func handleDeviceActivation(d storage.Device, app storage.Application, daCtx *as
 	}
 	copy(da.DevAddr[:], daCtx.DevAddr)
 
-	if err = storage.CreateDeviceActivation(storage.DB(), &da); err != nil {
+	if err = storage.CreateDeviceActivation(db, &da); err != nil {
 		return errors.Wrap(err, "create device-activation error")
 	}
 
@@ -598,9 +687,13 @@ func handleDeviceActivation(d storage.Device, app storage.Application, daCtx *as
 		log.WithError(err).Error("log event for device error")
 	}
 
-	err = integration.Integration().SendJoinNotification(pl)
-	if err != nil {
-		return errors.Wrap(err, "send join notification error")
+	if d.LifecycleState.Enabled() {
+		eventbus.Publish(eventbus.Join, pl.ApplicationID, pl.DevEUI, pl)
+
+		err = intg.SendJoinNotification(pl)
+		if err != nil {
+			return errors.Wrap(err, "send join notification error")
+		}
 	}
 
 	return nil
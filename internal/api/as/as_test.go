@@ -2,12 +2,14 @@ package as
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq/hstore"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -103,6 +105,11 @@ func (ts *ASTestSuite) TestApplicationServer() {
 		Description:     "test gateway",
 		OrganizationID:  org.ID,
 		NetworkServerID: n.ID,
+		Tags: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"site": {String: "tower-1", Valid: true},
+			},
+		},
 	}
 	assert.NoError(storage.CreateGateway(storage.DB(), &gw))
 
@@ -262,6 +269,9 @@ func (ts *ASTestSuite) TestApplicationServer() {
 							Time:    &now,
 							RSSI:    -60,
 							LoRaSNR: 5,
+							Tags: map[string]string{
+								"site": "tower-1",
+							},
 						},
 					},
 					TXInfo: integration.TXInfo{
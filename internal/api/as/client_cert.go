@@ -0,0 +1,215 @@
+package as
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// clientCertAllowListTTL defines the duration after which the client
+// certificate allow-list is reloaded from the database.
+const clientCertAllowListTTL = time.Minute
+
+// errClientCertNotAllowed is returned when the client certificate is not
+// in the allow-list of any of the network-servers.
+var errClientCertNotAllowed = errors.New("client certificate is not allowed")
+
+// networkServerIDKey is the context key under which the id of the
+// network-server matching the client certificate is stored.
+type networkServerIDKey struct{}
+
+// clientCertAllowList contains the fingerprints of the client certificates
+// of the network-servers that are allowed to connect to the API.
+type clientCertAllowList struct {
+	sync.RWMutex
+	fingerprints map[string]int64 // fingerprint to network-server id
+	updatedAt    time.Time
+}
+
+// verifyPeerCertificate implements the tls.Config VerifyPeerCertificate
+// callback. It is called after the normal certificate verification.
+func (l *clientCertAllowList) verifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return errClientCertNotAllowed
+	}
+
+	if err := l.refreshIfExpired(); err != nil {
+		log.WithError(err).Error("api/as: refresh client certificate allow-list error")
+	}
+
+	fp := sha256.Sum256(rawCerts[0])
+	fpStr := hex.EncodeToString(fp[:])
+
+	l.RLock()
+	nsID, ok := l.fingerprints[fpStr]
+	l.RUnlock()
+
+	if !ok {
+		log.WithField("fingerprint", fpStr).Warning("api/as: client certificate not allowed")
+		return errClientCertNotAllowed
+	}
+
+	log.WithFields(log.Fields{
+		"fingerprint":       fpStr,
+		"network_server_id": nsID,
+	}).Debug("api/as: client certificate allowed")
+
+	return nil
+}
+
+// unaryServerInterceptor stores the id of the network-server matching the
+// client certificate of the request in the context, so that the handlers
+// can validate that the request concerns a device or gateway of this
+// network-server.
+func (l *clientCertAllowList) unaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, grpc.Errorf(codes.Unauthenticated, errClientCertNotAllowed.Error())
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil, grpc.Errorf(codes.Unauthenticated, errClientCertNotAllowed.Error())
+	}
+
+	// the connection might outlive the allow-list entry
+	if err := l.refreshIfExpired(); err != nil {
+		log.WithError(err).Error("api/as: refresh client certificate allow-list error")
+	}
+
+	fp := sha256.Sum256(tlsInfo.State.PeerCertificates[0].Raw)
+
+	l.RLock()
+	nsID, ok := l.fingerprints[hex.EncodeToString(fp[:])]
+	l.RUnlock()
+
+	if !ok {
+		return nil, grpc.Errorf(codes.Unauthenticated, errClientCertNotAllowed.Error())
+	}
+
+	return handler(context.WithValue(ctx, networkServerIDKey{}, nsID), req)
+}
+
+// validateNetworkServerForDevEUI validates that the given device belongs to
+// the network-server matching the client certificate of the request. When
+// client certificate pinning is disabled, nil is returned.
+func validateNetworkServerForDevEUI(ctx context.Context, devEUI lorawan.EUI64) error {
+	nsID, ok := ctx.Value(networkServerIDKey{}).(int64)
+	if !ok {
+		return nil
+	}
+
+	n, err := storage.GetNetworkServerForDevEUI(storage.DB(), devEUI)
+	if err != nil {
+		return helpers.ErrToRPCError(err)
+	}
+
+	if n.ID != nsID {
+		log.WithFields(log.Fields{
+			"dev_eui":           devEUI,
+			"network_server_id": nsID,
+		}).Warning("api/as: device belongs to an other network-server")
+		return grpc.Errorf(codes.PermissionDenied, "device belongs to an other network-server")
+	}
+
+	return nil
+}
+
+// validateNetworkServerForGatewayMAC validates that the given gateway, when
+// known, belongs to the network-server matching the client certificate of
+// the request. When client certificate pinning is disabled, nil is returned.
+func validateNetworkServerForGatewayMAC(ctx context.Context, mac lorawan.EUI64) error {
+	nsID, ok := ctx.Value(networkServerIDKey{}).(int64)
+	if !ok {
+		return nil
+	}
+
+	n, err := storage.GetNetworkServerForGatewayMAC(storage.DB(), mac)
+	if err != nil {
+		if err == storage.ErrDoesNotExist {
+			return nil
+		}
+		return helpers.ErrToRPCError(err)
+	}
+
+	if n.ID != nsID {
+		log.WithFields(log.Fields{
+			"gateway_id":        mac,
+			"network_server_id": nsID,
+		}).Warning("api/as: gateway belongs to an other network-server")
+		return grpc.Errorf(codes.PermissionDenied, "gateway belongs to an other network-server")
+	}
+
+	return nil
+}
+
+func (l *clientCertAllowList) refreshIfExpired() error {
+	l.RLock()
+	expired := time.Since(l.updatedAt) > clientCertAllowListTTL
+	l.RUnlock()
+
+	if !expired {
+		return nil
+	}
+
+	count, err := storage.GetNetworkServerCount(storage.DB())
+	if err != nil {
+		return err
+	}
+
+	nss, err := storage.GetNetworkServers(storage.DB(), count, 0)
+	if err != nil {
+		return err
+	}
+
+	fingerprints := make(map[string]int64)
+	for _, n := range nss {
+		for _, fp := range n.ClientCertFingerprints {
+			fingerprints[fp] = n.ID
+		}
+
+		if n.RoutingProfileTLSCert == "" {
+			continue
+		}
+
+		fp, err := certFingerprint(n.RoutingProfileTLSCert)
+		if err != nil {
+			log.WithField("network_server_id", n.ID).WithError(err).Error("api/as: get routing-profile certificate fingerprint error")
+			continue
+		}
+		fingerprints[fp] = n.ID
+	}
+
+	l.Lock()
+	l.fingerprints = fingerprints
+	l.updatedAt = time.Now()
+	l.Unlock()
+
+	return nil
+}
+
+// certFingerprint returns the HEX encoded SHA-256 fingerprint of the given
+// PEM encoded certificate.
+func certFingerprint(pemCert string) (string, error) {
+	block, _ := pem.Decode([]byte(pemCert))
+	if block == nil {
+		return "", errors.New("decode pem error")
+	}
+
+	fp := sha256.Sum256(block.Bytes)
+	return hex.EncodeToString(fp[:]), nil
+}
@@ -0,0 +1,156 @@
+package as
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func newTestCert(t *testing.T) []byte {
+	assert := require.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(err)
+
+	tmpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "network-server"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	assert.NoError(err)
+
+	return der
+}
+
+func TestCertFingerprint(t *testing.T) {
+	assert := require.New(t)
+
+	der := newTestCert(t)
+	expected := sha256.Sum256(der)
+
+	fp, err := certFingerprint(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+	assert.NoError(err)
+	assert.Equal(hex.EncodeToString(expected[:]), fp)
+
+	_, err = certFingerprint("invalid")
+	assert.Error(err)
+}
+
+func TestClientCertAllowList(t *testing.T) {
+	allowed := newTestCert(t)
+	notAllowed := newTestCert(t)
+
+	fp := sha256.Sum256(allowed)
+
+	l := clientCertAllowList{
+		fingerprints: map[string]int64{
+			hex.EncodeToString(fp[:]): 1,
+		},
+		updatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		Name          string
+		RawCerts      [][]byte
+		ExpectedError error
+	}{
+		{
+			Name:     "allowed certificate",
+			RawCerts: [][]byte{allowed},
+		},
+		{
+			Name:          "not allowed certificate",
+			RawCerts:      [][]byte{notAllowed},
+			ExpectedError: errClientCertNotAllowed,
+		},
+		{
+			Name:          "no certificate",
+			ExpectedError: errClientCertNotAllowed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.ExpectedError, l.verifyPeerCertificate(test.RawCerts, nil))
+		})
+	}
+}
+
+func TestClientCertUnaryServerInterceptor(t *testing.T) {
+	allowed := newTestCert(t)
+	notAllowed := newTestCert(t)
+
+	fp := sha256.Sum256(allowed)
+
+	l := clientCertAllowList{
+		fingerprints: map[string]int64{
+			hex.EncodeToString(fp[:]): 1,
+		},
+		updatedAt: time.Now(),
+	}
+
+	peerContext := func(t *testing.T, der []byte) context.Context {
+		cert, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{cert},
+				},
+			},
+		})
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return ctx.Value(networkServerIDKey{}), nil
+	}
+
+	t.Run("allowed certificate", func(t *testing.T) {
+		assert := require.New(t)
+
+		nsID, err := l.unaryServerInterceptor(peerContext(t, allowed), nil, &grpc.UnaryServerInfo{}, handler)
+		assert.NoError(err)
+		assert.Equal(int64(1), nsID)
+	})
+
+	t.Run("not allowed certificate", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := l.unaryServerInterceptor(peerContext(t, notAllowed), nil, &grpc.UnaryServerInfo{}, handler)
+		assert.Equal(codes.Unauthenticated, grpc.Code(err))
+	})
+
+	t.Run("no peer", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := l.unaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+		assert.Equal(codes.Unauthenticated, grpc.Code(err))
+	})
+
+	t.Run("pinning disabled", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(validateNetworkServerForDevEUI(context.Background(), [8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	})
+}
@@ -5,9 +5,11 @@ import (
 	"strings"
 
 	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 
 	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -15,11 +17,14 @@ import (
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/external/auth"
 	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/archive"
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/integration"
 	"github.com/brocaar/lora-app-server/internal/integration/http"
 	"github.com/brocaar/lora-app-server/internal/integration/influxdb"
+	"github.com/brocaar/lora-app-server/internal/integration/sandbox"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
 )
 
 // ApplicationAPI exports the Application related functions.
@@ -51,6 +56,11 @@ func (a *ApplicationAPI) Create(ctx context.Context, req *pb.CreateApplicationRe
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	masterKey, err := applicationMasterKeyFromPB(req.Application.MasterKey)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "master_key: %s", err)
+	}
+
 	app := storage.Application{
 		Name:                 req.Application.Name,
 		Description:          req.Application.Description,
@@ -59,6 +69,16 @@ func (a *ApplicationAPI) Create(ctx context.Context, req *pb.CreateApplicationRe
 		PayloadCodec:         codec.Type(req.Application.PayloadCodec),
 		PayloadEncoderScript: req.Application.PayloadEncoderScript,
 		PayloadDecoderScript: req.Application.PayloadDecoderScript,
+		MasterKey:            masterKey,
+		ArchiveUplinks:       req.Application.ArchiveUplinks,
+		RedactFields:         req.Application.RedactFields,
+	}
+
+	if req.Application.HeartbeatInterval != nil {
+		app.HeartbeatInterval, err = ptypes.Duration(req.Application.HeartbeatInterval)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "heartbeat_interval: %s", err)
+		}
 	}
 
 	if err := storage.CreateApplication(storage.DB(), &app); err != nil {
@@ -92,9 +112,18 @@ func (a *ApplicationAPI) Get(ctx context.Context, req *pb.GetApplicationRequest)
 			PayloadCodec:         string(app.PayloadCodec),
 			PayloadEncoderScript: app.PayloadEncoderScript,
 			PayloadDecoderScript: app.PayloadDecoderScript,
+			ArchiveUplinks:       app.ArchiveUplinks,
+			RedactFields:         app.RedactFields,
 		},
 	}
 
+	// the master key is only returned by GetMasterKey
+	resp.Application.HasMasterKey = app.MasterKey != nil
+
+	if app.HeartbeatInterval != 0 {
+		resp.Application.HeartbeatInterval = ptypes.DurationProto(app.HeartbeatInterval)
+	}
+
 	return &resp, nil
 }
 
@@ -120,6 +149,11 @@ func (a *ApplicationAPI) Update(ctx context.Context, req *pb.UpdateApplicationRe
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	masterKey, err := applicationMasterKeyFromPB(req.Application.MasterKey)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "master_key: %s", err)
+	}
+
 	// update the fields
 	app.Name = req.Application.Name
 	app.Description = req.Application.Description
@@ -127,6 +161,18 @@ func (a *ApplicationAPI) Update(ctx context.Context, req *pb.UpdateApplicationRe
 	app.PayloadCodec = codec.Type(req.Application.PayloadCodec)
 	app.PayloadEncoderScript = req.Application.PayloadEncoderScript
 	app.PayloadDecoderScript = req.Application.PayloadDecoderScript
+	if masterKey != nil || req.RemoveMasterKey {
+		app.MasterKey = masterKey
+	}
+	app.ArchiveUplinks = req.Application.ArchiveUplinks
+	app.RedactFields = req.Application.RedactFields
+	app.HeartbeatInterval = 0
+	if req.Application.HeartbeatInterval != nil {
+		app.HeartbeatInterval, err = ptypes.Duration(req.Application.HeartbeatInterval)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "heartbeat_interval: %s", err)
+		}
+	}
 
 	err = storage.UpdateApplication(storage.DB(), app)
 	if err != nil {
@@ -158,8 +204,44 @@ func (a *ApplicationAPI) Delete(ctx context.Context, req *pb.DeleteApplicationRe
 	return &empty.Empty{}, nil
 }
 
+// Clone creates a copy of the application within the given organization.
+func (a *ApplicationAPI) Clone(ctx context.Context, req *pb.CloneApplicationRequest) (*pb.CloneApplicationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationsAccess(auth.Create, req.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	spID, err := uuid.FromString(req.ServiceProfileId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "service_profile_id: %s", err)
+	}
+
+	var app storage.Application
+	err = storage.Transaction(func(tx sqlx.Ext) error {
+		var err error
+		app, err = storage.CloneApplication(tx, req.Id, req.OrganizationId, spID, req.Name, req.MoveDevices)
+		return err
+	})
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.CloneApplicationResponse{
+		Id: app.ID,
+	}, nil
+}
+
 // List lists the available applications.
 func (a *ApplicationAPI) List(ctx context.Context, req *pb.ListApplicationRequest) (*pb.ListApplicationResponse, error) {
+	req.OrganizationId = hostOrganizationFilter(ctx, req.OrganizationId)
+
 	if err := a.validator.Validate(ctx,
 		auth.ValidateApplicationsAccess(auth.List, req.OrganizationId),
 	); err != nil {
@@ -265,6 +347,11 @@ func (a *ApplicationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.Creat
 		ErrorNotificationURL:    in.Integration.ErrorNotificationUrl,
 		StatusNotificationURL:   in.Integration.StatusNotificationUrl,
 		LocationNotificationURL: in.Integration.LocationNotificationUrl,
+		Endpoints:               httpIntegrationEndpointsFromPB(in.Integration.Endpoints),
+		CallbackSecret:          in.Integration.CallbackSecret,
+		Compression:             in.Integration.Compression,
+		BatchSize:               int(in.Integration.BatchSize),
+		BatchIntervalMS:         int(in.Integration.BatchIntervalMs),
 	}
 	if err := conf.Validate(); err != nil {
 		return nil, helpers.ErrToRPCError(err)
@@ -324,6 +411,11 @@ func (a *ApplicationAPI) GetHTTPIntegration(ctx context.Context, in *pb.GetHTTPI
 			ErrorNotificationUrl:    conf.ErrorNotificationURL,
 			StatusNotificationUrl:   conf.StatusNotificationURL,
 			LocationNotificationUrl: conf.LocationNotificationURL,
+			Endpoints:               httpIntegrationEndpointsToPB(conf.Endpoints),
+			CallbackSecret:          conf.CallbackSecret,
+			Compression:             conf.Compression,
+			BatchSize:               uint32(conf.BatchSize),
+			BatchIntervalMs:         uint32(conf.BatchIntervalMS),
 		},
 	}, nil
 }
@@ -358,6 +450,11 @@ func (a *ApplicationAPI) UpdateHTTPIntegration(ctx context.Context, in *pb.Updat
 		ErrorNotificationURL:    in.Integration.ErrorNotificationUrl,
 		StatusNotificationURL:   in.Integration.StatusNotificationUrl,
 		LocationNotificationURL: in.Integration.LocationNotificationUrl,
+		Endpoints:               httpIntegrationEndpointsFromPB(in.Integration.Endpoints),
+		CallbackSecret:          in.Integration.CallbackSecret,
+		Compression:             in.Integration.Compression,
+		BatchSize:               int(in.Integration.BatchSize),
+		BatchIntervalMS:         int(in.Integration.BatchIntervalMs),
 	}
 	if err := conf.Validate(); err != nil {
 		return nil, helpers.ErrToRPCError(err)
@@ -562,3 +659,256 @@ func (a *ApplicationAPI) ListIntegrations(ctx context.Context, in *pb.ListIntegr
 
 	return &out, nil
 }
+
+// TestIntegration replays canned events against the configured integration
+// of the given kind and returns the outbound requests made by the integration.
+func (a *ApplicationAPI) TestIntegration(ctx context.Context, in *pb.TestIntegrationRequest) (*pb.TestIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	app, err := storage.GetApplication(storage.DB(), in.ApplicationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var intgr integration.HTTPClientIntegrator
+
+	switch in.Kind {
+	case pb.IntegrationKind_HTTP:
+		appint, err := storage.GetIntegrationByApplicationID(storage.DB(), in.ApplicationId, integration.HTTP)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		var conf http.Config
+		if err := json.Unmarshal(appint.Settings, &conf); err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		intgr, err = http.New(conf)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+	case pb.IntegrationKind_INFLUXDB:
+		appint, err := storage.GetIntegrationByApplicationID(storage.DB(), in.ApplicationId, integration.InfluxDB)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		var conf influxdb.Config
+		if err := json.Unmarshal(appint.Settings, &conf); err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		intgr, err = influxdb.New(conf)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "unknown integration kind: %s", in.Kind)
+	}
+
+	results, err := sandbox.Replay(intgr, app.ID, app.Name, in.Events, in.DryRun)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	var out pb.TestIntegrationResponse
+
+	for _, res := range results {
+		item := pb.IntegrationTestResult{
+			Event: res.Event,
+			Error: res.Error,
+		}
+
+		for _, req := range res.Requests {
+			r := pb.IntegrationTestRequest{
+				Method:     req.Method,
+				Url:        req.URL,
+				Headers:    make(map[string]string),
+				Body:       req.Body,
+				StatusCode: uint32(req.StatusCode),
+				Error:      req.Error,
+			}
+
+			for k, v := range req.Header {
+				r.Headers[k] = strings.Join(v, ", ")
+			}
+
+			item.Requests = append(item.Requests, &r)
+		}
+
+		out.Result = append(out.Result, &item)
+	}
+
+	return &out, nil
+}
+
+// ReprocessUplinks creates a job re-processing the archived uplinks of the
+// application within the given time range.
+func (a *ApplicationAPI) ReprocessUplinks(ctx context.Context, in *pb.ReprocessUplinksRequest) (*pb.ReprocessUplinksResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if !archive.Enabled() {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "uplink archive is not configured")
+	}
+
+	if in.StartTimestamp == nil || in.EndTimestamp == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "start_timestamp and end_timestamp must be set")
+	}
+
+	job := storage.ReprocessUplinksJob{
+		ApplicationID: in.ApplicationId,
+	}
+
+	var err error
+	job.Start, err = ptypes.Timestamp(in.StartTimestamp)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "start_timestamp: %s", err)
+	}
+	job.End, err = ptypes.Timestamp(in.EndTimestamp)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "end_timestamp: %s", err)
+	}
+
+	if err := storage.CreateReprocessUplinksJob(storage.DB(), &job); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.ReprocessUplinksResponse{
+		Id: job.ID.String(),
+	}, nil
+}
+
+// ListReprocessUplinksJobs lists the reprocess uplinks jobs of the application.
+func (a *ApplicationAPI) ListReprocessUplinksJobs(ctx context.Context, in *pb.ListReprocessUplinksJobsRequest) (*pb.ListReprocessUplinksJobsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetReprocessUplinksJobCountForApplicationID(storage.DB(), in.ApplicationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	jobs, err := storage.GetReprocessUplinksJobsForApplicationID(storage.DB(), in.ApplicationId, int(in.Limit), int(in.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out := pb.ListReprocessUplinksJobsResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, job := range jobs {
+		item := pb.ReprocessUplinksJob{
+			Id:          job.ID.String(),
+			State:       job.State,
+			UplinkCount: uint32(job.UplinkCount),
+			ErrorCount:  uint32(job.ErrorCount),
+			Error:       job.Error,
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(job.CreatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+		item.UpdatedAt, err = ptypes.TimestampProto(job.UpdatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+		item.StartTimestamp, err = ptypes.TimestampProto(job.Start)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+		item.EndTimestamp, err = ptypes.TimestampProto(job.End)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		out.Result = append(out.Result, &item)
+	}
+
+	return &out, nil
+}
+
+// GetMasterKey returns the master key of the given application. As the
+// device keys can be derived from the master key, this is restricted to
+// organization and global admin users and every request is logged.
+func (a *ApplicationAPI) GetMasterKey(ctx context.Context, req *pb.GetApplicationMasterKeyRequest) (*pb.GetApplicationMasterKeyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	username, err := a.validator.GetUsername(ctx)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	app, err := storage.GetApplication(storage.DB(), req.Id)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if app.MasterKey == nil {
+		return nil, grpc.Errorf(codes.NotFound, "the application has no master key")
+	}
+
+	log.WithFields(log.Fields{
+		"application_id": app.ID,
+		"username":       username,
+	}).Info("application master key retrieved")
+
+	return &pb.GetApplicationMasterKeyResponse{
+		MasterKey: app.MasterKey.String(),
+	}, nil
+}
+
+func httpIntegrationEndpointsFromPB(in []*pb.HTTPIntegrationEndpoint) []http.Endpoint {
+	var out []http.Endpoint
+	for _, ep := range in {
+		out = append(out, http.Endpoint{
+			Event:  ep.Event,
+			URL:    ep.Url,
+			Filter: ep.Filter,
+		})
+	}
+	return out
+}
+
+func httpIntegrationEndpointsToPB(in []http.Endpoint) []*pb.HTTPIntegrationEndpoint {
+	var out []*pb.HTTPIntegrationEndpoint
+	for _, ep := range in {
+		out = append(out, &pb.HTTPIntegrationEndpoint{
+			Event:  ep.Event,
+			Url:    ep.URL,
+			Filter: ep.Filter,
+		})
+	}
+	return out
+}
+
+// applicationMasterKeyFromPB parses the given HEX encoded master key. An
+// empty string means that no master key is set.
+func applicationMasterKeyFromPB(s string) (*lorawan.AES128Key, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var key lorawan.AES128Key
+	if err := key.UnmarshalText([]byte(s)); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
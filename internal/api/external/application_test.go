@@ -188,6 +188,69 @@ func TestApplicationAPI(t *testing.T) {
 				})
 			})
 
+			Convey("When setting a master key", func() {
+				_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+					Application: &pb.Application{
+						Id:               createResp.Id,
+						Name:             "test-app",
+						ServiceProfileId: spID.String(),
+						MasterKey:        "0102030405060708090a0b0c0d0e0f10",
+					},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the master key is not returned by Get", func() {
+					app, err := api.Get(ctx, &pb.GetApplicationRequest{
+						Id: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(app.Application.MasterKey, ShouldEqual, "")
+					So(app.Application.HasMasterKey, ShouldBeTrue)
+				})
+
+				Convey("Then the master key is returned by GetMasterKey", func() {
+					validator.returnUsername = "admin"
+					resp, err := api.GetMasterKey(ctx, &pb.GetApplicationMasterKeyRequest{
+						Id: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(resp.MasterKey, ShouldEqual, "0102030405060708090a0b0c0d0e0f10")
+				})
+
+				Convey("Then an update without master key keeps the master key", func() {
+					_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+						Application: &pb.Application{
+							Id:               createResp.Id,
+							Name:             "test-app",
+							ServiceProfileId: spID.String(),
+						},
+					})
+					So(err, ShouldBeNil)
+
+					app, err := storage.GetApplication(storage.DB(), createResp.Id)
+					So(err, ShouldBeNil)
+					So(app.MasterKey, ShouldNotBeNil)
+				})
+
+				Convey("Then the master key can be removed", func() {
+					_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+						Application: &pb.Application{
+							Id:               createResp.Id,
+							Name:             "test-app",
+							ServiceProfileId: spID.String(),
+						},
+						RemoveMasterKey: true,
+					})
+					So(err, ShouldBeNil)
+
+					_, err = api.GetMasterKey(ctx, &pb.GetApplicationMasterKeyRequest{
+						Id: createResp.Id,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				})
+			})
+
 			Convey("When deleting the application", func() {
 				_, err := api.Delete(ctx, &pb.DeleteApplicationRequest{
 					Id: createResp.Id,
@@ -216,6 +279,17 @@ func TestApplicationAPI(t *testing.T) {
 						ErrorNotificationUrl:    "http://error",
 						StatusNotificationUrl:   "http://status",
 						LocationNotificationUrl: "http://location",
+						Endpoints: []*pb.HTTPIntegrationEndpoint{
+							{
+								Event:  "uplink",
+								Url:    "http://up/temperature",
+								Filter: "event.object.temperature > 20",
+							},
+						},
+						CallbackSecret:  "secret",
+						Compression:     "gzip",
+						BatchSize:       10,
+						BatchIntervalMs: 1000,
 					},
 				}
 				_, err := api.CreateHTTPIntegration(ctx, &req)
@@ -238,6 +312,39 @@ func TestApplicationAPI(t *testing.T) {
 					})
 				})
 
+				Convey("Then the integration can be tested in dry-run mode", func() {
+					resp, err := api.TestIntegration(ctx, &pb.TestIntegrationRequest{
+						ApplicationId: createResp.Id,
+						Kind:          pb.IntegrationKind_HTTP,
+						Events:        []string{"uplink", "join"},
+						DryRun:        true,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(resp.Result, ShouldHaveLength, 2)
+
+					So(resp.Result[0].Event, ShouldEqual, "uplink")
+					So(resp.Result[0].Requests, ShouldHaveLength, 2)
+					So(resp.Result[0].Requests[0].Url, ShouldEqual, "http://up")
+					So(resp.Result[0].Requests[0].Headers["Foo"], ShouldEqual, "bar")
+					So(resp.Result[0].Requests[0].StatusCode, ShouldEqual, 200)
+					So(resp.Result[0].Requests[1].Url, ShouldEqual, "http://up/temperature")
+
+					So(resp.Result[1].Event, ShouldEqual, "join")
+					So(resp.Result[1].Requests, ShouldHaveLength, 1)
+					So(resp.Result[1].Requests[0].Url, ShouldEqual, "http://join")
+				})
+
+				Convey("Then testing an unknown event returns an error", func() {
+					_, err := api.TestIntegration(ctx, &pb.TestIntegrationRequest{
+						ApplicationId: createResp.Id,
+						Kind:          pb.IntegrationKind_HTTP,
+						Events:        []string{"foo"},
+						DryRun:        true,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+
 				Convey("Then the integration can be updated", func() {
 					req := pb.UpdateHTTPIntegrationRequest{
 						Integration: &pb.HTTPIntegration{
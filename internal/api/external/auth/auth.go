@@ -21,6 +21,16 @@ type Claims struct {
 
 	// Username defines the identity of the user.
 	Username string `json:"username"`
+
+	// DevEUI and EmbedView are set for embed tokens, which give read-only
+	// access to a single view of a single device.
+	DevEUI    string `json:"devEUI,omitempty"`
+	EmbedView string `json:"embedView,omitempty"`
+
+	// HostOrganizationID is set when the request is scoped to the
+	// organization mapped to the hostname of the request. It is not part of
+	// the token.
+	HostOrganizationID int64 `json:"-"`
 }
 
 // Validator defines the interface a validator needs to implement.
@@ -70,6 +80,10 @@ func (v JWTValidator) Validate(ctx context.Context, funcs ...ValidatorFunc) erro
 		return err
 	}
 
+	if err := validateHostOrganization(ctx, v.db, claims); err != nil {
+		return err
+	}
+
 	for _, f := range funcs {
 		ok, err := f(v.db, claims)
 		if err != nil {
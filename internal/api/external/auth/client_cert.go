@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// ClientCertValidator validates the (verified) TLS client certificate of
+// the client. The common name of the certificate is mapped to a user, of
+// which the permissions are used for the validation.
+type ClientCertValidator struct {
+	db    sqlx.Ext
+	users map[string]string
+}
+
+// NewClientCertValidator creates a new ClientCertValidator. The users map
+// contains the certificate common name to username mapping.
+func NewClientCertValidator(db sqlx.Ext, users map[string]string) *ClientCertValidator {
+	return &ClientCertValidator{
+		db:    db,
+		users: users,
+	}
+}
+
+// Validate validates the client certificate from the given context against
+// the given validator funcs.
+func (v ClientCertValidator) Validate(ctx context.Context, funcs ...ValidatorFunc) error {
+	claims, err := v.getClaims(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := validateHostOrganization(ctx, v.db, claims); err != nil {
+		return err
+	}
+
+	for _, f := range funcs {
+		ok, err := f(v.db, claims)
+		if err != nil {
+			return errors.Wrap(err, "validator func error")
+		}
+		if ok {
+			return nil
+		}
+	}
+
+	return ErrNotAuthorized
+}
+
+// GetUsername returns the username of the authenticated user.
+func (v ClientCertValidator) GetUsername(ctx context.Context) (string, error) {
+	claims, err := v.getClaims(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return claims.Username, nil
+}
+
+// GetIsAdmin returns if the authenticated user is a global admin.
+func (v ClientCertValidator) GetIsAdmin(ctx context.Context) (bool, error) {
+	claims, err := v.getClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	user, err := storage.GetUserByUsername(v.db, claims.Username)
+	if err != nil {
+		return false, errors.Wrap(err, "get user by username error")
+	}
+
+	return user.IsAdmin, nil
+}
+
+func (v ClientCertValidator) getClaims(ctx context.Context) (*Claims, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, ErrNoClientCertificate
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, ErrNoClientCertificate
+	}
+
+	cn := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	username, ok := v.users[cn]
+	if !ok {
+		return nil, errors.Wrap(ErrUnknownClientCertificate, cn)
+	}
+
+	return &Claims{Username: username}, nil
+}
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/jmoiron/sqlx"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClientCertValidator(t *testing.T) {
+	Convey("Given a client certificate validator", t, func() {
+		v := NewClientCertValidator(nil, map[string]string{
+			"scada-01": "scada",
+		})
+
+		peerWithCN := func(cn string) *peer.Peer {
+			return &peer.Peer{
+				AuthInfo: credentials.TLSInfo{
+					State: tls.ConnectionState{
+						VerifiedChains: [][]*x509.Certificate{
+							{
+								{Subject: pkix.Name{CommonName: cn}},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		testTable := []struct {
+			Description   string
+			Peer          *peer.Peer
+			ValidatorFunc ValidatorFunc
+			Error         string
+		}{
+			{
+				Description: "known common name and passing validation",
+				Peer:        peerWithCN("scada-01"),
+				ValidatorFunc: func(db sqlx.Queryer, claims *Claims) (bool, error) {
+					return claims.Username == "scada", nil
+				},
+			},
+			{
+				Description:   "known common name but failing validation",
+				Peer:          peerWithCN("scada-01"),
+				ValidatorFunc: testValidator(false, nil),
+				Error:         "not authorized",
+			},
+			{
+				Description:   "unknown common name",
+				Peer:          peerWithCN("scada-02"),
+				ValidatorFunc: testValidator(true, nil),
+				Error:         "scada-02: unknown client certificate common name",
+			},
+			{
+				Description:   "no verified client certificate",
+				Peer:          &peer.Peer{AuthInfo: credentials.TLSInfo{}},
+				ValidatorFunc: testValidator(true, nil),
+				Error:         "no verified client certificate",
+			},
+			{
+				Description:   "no peer",
+				ValidatorFunc: testValidator(true, nil),
+				Error:         "no verified client certificate",
+			},
+		}
+
+		for _, test := range testTable {
+			Convey("Test: "+test.Description, func() {
+				ctx := context.Background()
+				if test.Peer != nil {
+					ctx = peer.NewContext(ctx, test.Peer)
+				}
+
+				err := v.Validate(ctx, test.ValidatorFunc)
+				if test.Error != "" {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, test.Error)
+				} else {
+					So(err, ShouldBeNil)
+				}
+			})
+		}
+	})
+}
@@ -9,4 +9,7 @@ var (
 	ErrInvalidAlgorithm          = errors.New("invalid algorithm")
 	ErrInvalidToken              = errors.New("invalid token")
 	ErrNotAuthorized             = errors.New("not authorized")
+	ErrNoClientCertificate       = errors.New("no verified client certificate")
+	ErrUnknownClientCertificate  = errors.New("unknown client certificate common name")
+	ErrHostNotAuthorized         = errors.New("not authorized for the organization of this host")
 )
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+type hostOrganizationIDKey struct{}
+
+// NewContextWithHostOrganizationID returns a new context holding the ID of
+// the organization mapped to the hostname of the request.
+func NewContextWithHostOrganizationID(ctx context.Context, organizationID int64) context.Context {
+	return context.WithValue(ctx, hostOrganizationIDKey{}, organizationID)
+}
+
+// HostOrganizationIDFromContext returns the ID of the organization mapped
+// to the hostname of the request (if any).
+func HostOrganizationIDFromContext(ctx context.Context) (int64, bool) {
+	id, ok := ctx.Value(hostOrganizationIDKey{}).(int64)
+	return id, ok
+}
+
+// validateHostOrganization validates that the client has access to the
+// organization mapped to the hostname of the request and scopes the claims
+// to this organization, so that the validator funcs only give access to
+// the resources of this organization. It returns nil when the hostname is
+// not mapped to an organization.
+func validateHostOrganization(ctx context.Context, db sqlx.Queryer, claims *Claims) error {
+	id, ok := HostOrganizationIDFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	var err error
+	if claims.Subject == storage.EmbedTokenSubject {
+		// embed tokens are not bound to a user, but to a device
+		err = sqlx.Get(db, &ok, `
+			select count(*) > 0
+			from device d
+			inner join application a
+				on a.id = d.application_id
+			where
+				encode(d.dev_eui, 'hex') = $1
+				and a.organization_id = $2`,
+			claims.DevEUI,
+			id,
+		)
+	} else {
+		ok, err = ValidateOrganizationAccess(Read, id)(db, claims)
+	}
+	if err != nil {
+		return errors.Wrap(err, "validate host organization error")
+	}
+	if !ok {
+		return ErrHostNotAuthorized
+	}
+
+	claims.HostOrganizationID = id
+
+	return nil
+}
@@ -1,10 +1,12 @@
 package auth
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/gofrs/uuid"
 
+	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lorawan"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -59,7 +61,7 @@ func ValidateActiveUser() ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username)
+		return executeUserQuery(db, claims, where)
 	}
 }
 
@@ -95,7 +97,7 @@ func ValidateUsersAccess(flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username)
+		return executeUserQuery(db, claims, where)
 	}
 }
 
@@ -129,7 +131,7 @@ func ValidateUserAccess(userID int64, flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, userID)
+		return executeUserQuery(db, claims, where, userID)
 	}
 }
 
@@ -144,7 +146,7 @@ func ValidateIsApplicationAdmin(applicationID int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, applicationID)
+		return executeUserQuery(db, claims, where, applicationID)
 	}
 }
 
@@ -175,7 +177,7 @@ func ValidateApplicationsAccess(flag Flag, organizationID int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+		return executeUserQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -211,7 +213,7 @@ func ValidateApplicationAccess(applicationID int64, flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, applicationID)
+		return executeUserQuery(db, claims, where, applicationID)
 	}
 }
 
@@ -247,7 +249,7 @@ func ValidateApplicationUsersAccess(applicationID int64, flag Flag) ValidatorFun
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, applicationID)
+		return executeUserQuery(db, claims, where, applicationID)
 	}
 }
 
@@ -285,7 +287,7 @@ func ValidateApplicationUserAccess(applicationID, userID int64, flag Flag) Valid
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, applicationID, userID)
+		return executeUserQuery(db, claims, where, applicationID, userID)
 	}
 }
 
@@ -314,7 +316,7 @@ func ValidateNodesAccess(applicationID int64, flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, applicationID)
+		return executeUserQuery(db, claims, where, applicationID)
 	}
 }
 
@@ -349,7 +351,7 @@ func ValidateNodeAccess(devEUI lorawan.EUI64, flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, devEUI[:])
+		return executeUserQuery(db, claims, where, devEUI[:])
 	}
 }
 
@@ -371,7 +373,27 @@ func ValidateDeviceQueueAccess(devEUI lorawan.EUI64, flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, devEUI[:])
+		return executeUserQuery(db, claims, where, devEUI[:])
+	}
+}
+
+// ValidateDeviceHistoryAccess validates if the client has access to the
+// device-history (all applications in which a DevEUI exists or existed).
+func ValidateDeviceHistoryAccess(flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeUserQuery(db, claims, where)
 	}
 }
 
@@ -401,7 +423,7 @@ func ValidateGatewaysAccess(flag Flag, organizationID int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+		return executeUserQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -429,7 +451,7 @@ func ValidateGatewayAccess(flag Flag, mac lorawan.EUI64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, mac[:])
+		return executeUserQuery(db, claims, where, mac[:])
 	}
 }
 
@@ -444,7 +466,7 @@ func ValidateIsOrganizationAdmin(organizationID int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+		return executeUserQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -469,7 +491,7 @@ func ValidateOrganizationsAccess(flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username)
+		return executeUserQuery(db, claims, where)
 	}
 }
 
@@ -504,7 +526,7 @@ func ValidateOrganizationAccess(flag Flag, id int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, id)
+		return executeUserQuery(db, claims, where, id)
 	}
 }
 
@@ -540,7 +562,7 @@ func ValidateOrganizationUsersAccess(flag Flag, id int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, id)
+		return executeUserQuery(db, claims, where, id)
 	}
 }
 
@@ -578,7 +600,7 @@ func ValidateOrganizationUserAccess(flag Flag, organizationID, userID int64) Val
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID, userID)
+		return executeUserQuery(db, claims, where, organizationID, userID)
 	}
 }
 
@@ -601,7 +623,7 @@ func ValidateGatewayProfileAccess(flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username)
+		return executeUserQuery(db, claims, where)
 	}
 }
 
@@ -626,7 +648,7 @@ func ValidateNetworkServersAccess(flag Flag, organizationID int64) ValidatorFunc
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+		return executeUserQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -651,7 +673,7 @@ func ValidateNetworkServerAccess(flag Flag, id int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, id)
+		return executeUserQuery(db, claims, where, id)
 	}
 }
 
@@ -673,7 +695,7 @@ func ValidateOrganizationNetworkServerAccess(flag Flag, organizationID, networkS
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID, networkServerID)
+		return executeUserQuery(db, claims, where, organizationID, networkServerID)
 	}
 }
 
@@ -700,7 +722,7 @@ func ValidateServiceProfilesAccess(flag Flag, organizationID int64) ValidatorFun
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+		return executeUserQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -725,7 +747,7 @@ func ValidateServiceProfileAccess(flag Flag, id uuid.UUID) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, id)
+		return executeUserQuery(db, claims, where, id)
 	}
 }
 
@@ -756,7 +778,7 @@ func ValidateDeviceProfilesAccess(flag Flag, organizationID, applicationID int64
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID, applicationID)
+		return executeUserQuery(db, claims, where, organizationID, applicationID)
 	}
 }
 
@@ -769,21 +791,23 @@ func ValidateDeviceProfileAccess(flag Flag, id uuid.UUID) ValidatorFunc {
 	case Read:
 		// gloabal admin
 		// organization users
+		// any active user (shared device-profile)
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "dp.device_profile_id = $2"},
+			{"u.username = $1", "u.is_active = true", "exists (select 1 from device_profile sdp where sdp.device_profile_id = $2 and sdp.is_shared = true)"},
 		}
 	case Update, Delete:
 		// global admin
-		// organization admin users
+		// organization admin users (not shared)
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
-			{"u.username = $1", "u.is_active = true", "ou.is_admin=true", "dp.device_profile_id = $2"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin=true", "dp.device_profile_id = $2", "dp.is_shared = false"},
 		}
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, id)
+		return executeUserQuery(db, claims, where, id)
 	}
 }
 
@@ -811,7 +835,7 @@ func ValidateMulticastGroupsAccess(flag Flag, organizationID int64) ValidatorFun
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+		return executeUserQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -838,7 +862,7 @@ func ValidateMulticastGroupAccess(flag Flag, multicastGroupID uuid.UUID) Validat
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, multicastGroupID)
+		return executeUserQuery(db, claims, where, multicastGroupID)
 	}
 }
 
@@ -858,10 +882,299 @@ func ValidateMulticastGroupQueueAccess(flag Flag, multicastGroupID uuid.UUID) Va
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, multicastGroupID)
+		return executeUserQuery(db, claims, where, multicastGroupID)
+	}
+}
+
+// ValidateDeviceFiltersAccess validates if the client has access to the
+// device filters of the given organization.
+func ValidateDeviceFiltersAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2", "ou.is_admin = true"},
+		}
+	case List:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeUserQuery(db, claims, where, organizationID)
+	}
+}
+
+// ValidateDeviceFilterAccess validates if the client has access to the given
+// device filter.
+func ValidateDeviceFilterAccess(flag Flag, id int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = (select organization_id from device_filter where id = $2)"},
+		}
+	case Update, Delete:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "o.id = (select organization_id from device_filter where id = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeUserQuery(db, claims, where, id)
+	}
+}
+
+// ValidateCampaignsAccess validates if the client has access to the
+// campaigns of the given application.
+func ValidateCampaignsAccess(flag Flag, applicationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+		}
+	case List:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "a.id = $2"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeUserQuery(db, claims, where, applicationID)
 	}
 }
 
+// ValidateCampaignAccess validates if the client has access to the given
+// campaign.
+func ValidateCampaignAccess(flag Flag, id uuid.UUID) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "a.id = (select application_id from campaign where id = $2)"},
+		}
+	case Delete:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = (select application_id from campaign where id = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeUserQuery(db, claims, where, id)
+	}
+}
+
+// ValidateClusterAccess validates if the client has access to the cluster
+// status.
+func ValidateClusterAccess(flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case List:
+		// global admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeUserQuery(db, claims, where)
+	}
+}
+
+// ValidateDeviceWebhooksAccess validates if the client has access to the
+// device webhooks of the given organization.
+func ValidateDeviceWebhooksAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create, List:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2", "ou.is_admin = true"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeUserQuery(db, claims, where, organizationID)
+	}
+}
+
+// ValidateDeviceWebhookAccess validates if the client has access to the
+// given device webhook.
+func ValidateDeviceWebhookAccess(flag Flag, id int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read, Update, Delete:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "o.id = (select organization_id from device_webhook where id = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeUserQuery(db, claims, where, id)
+	}
+}
+
+// ValidateDeviceConflictsAccess validates if the client has access to the
+// device conflicts of the given organization. An organization id of 0
+// means all organizations.
+func ValidateDeviceConflictsAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case List:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "$2 > 0", "o.id = $2", "ou.is_admin = true"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeUserQuery(db, claims, where, organizationID)
+	}
+}
+
+// ValidateDeviceConflictAccess validates if the client has access to the
+// given device conflict.
+func ValidateDeviceConflictAccess(flag Flag, id int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "o.id = (select organization_id from device_conflict where id = $2)"},
+		}
+	case Update:
+		// global admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true", "$2 > 0"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeUserQuery(db, claims, where, id)
+	}
+}
+
+// ValidateTermsAccess validates if the client has access to the terms
+// versions and their acceptances.
+func ValidateTermsAccess(flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create, List:
+		// global admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeUserQuery(db, claims, where)
+	}
+}
+
+// ValidateDeviceEmbedAccess validates if the client has been given access
+// to (one of) the given views of the given device, using an embed token.
+func ValidateDeviceEmbedAccess(devEUI lorawan.EUI64, views ...string) ValidatorFunc {
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		if claims.Subject != storage.EmbedTokenSubject || claims.DevEUI != devEUI.String() {
+			return false, nil
+		}
+
+		for _, view := range views {
+			if claims.EmbedView == view {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+}
+
+// executeUserQuery executes the userQuery for the user of the given claims.
+// When the request is scoped to a host organization, the access of users
+// which are not a global admin is limited to the resources of this
+// organization.
+func executeUserQuery(db sqlx.Queryer, claims *Claims, where [][]string, args ...interface{}) (bool, error) {
+	args = append([]interface{}{claims.Username}, args...)
+
+	if claims.HostOrganizationID != 0 {
+		args = append(args, claims.HostOrganizationID)
+		scope := fmt.Sprintf("u.is_admin = true or o.id = $%d", len(args))
+
+		var scoped [][]string
+		for _, ands := range where {
+			scoped = append(scoped, append(append([]string{}, ands...), scope))
+		}
+		where = scoped
+	}
+
+	return executeQuery(db, userQuery, where, args...)
+}
+
 func executeQuery(db sqlx.Queryer, query string, where [][]string, args ...interface{}) (bool, error) {
 	var ors []string
 	for _, ands := range where {
@@ -3,6 +3,7 @@ package auth
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/lorawan"
+	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/jmoiron/sqlx"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -42,6 +44,7 @@ func TestValidators(t *testing.T) {
 	   10: admin of organization 1
 	   11: member of organization 1 (but is_active=false)
 	   12: admin of organization 2
+	   13: member of organization 1 and 2
 
 	   Organizations:
 	   1: organization 1 (can have gateways)
@@ -109,6 +112,7 @@ func TestValidators(t *testing.T) {
 	deviceProfiles := []storage.DeviceProfile{
 		{Name: "test-dp-1", OrganizationID: organizations[0].ID, NetworkServerID: networkServers[0].ID},
 		{Name: "test-dp-2", OrganizationID: organizations[1].ID, NetworkServerID: networkServers[0].ID},
+		{Name: "test-dp-3", OrganizationID: organizations[1].ID, NetworkServerID: networkServers[0].ID, IsShared: true},
 	}
 	var deviceProfilesIDs []uuid.UUID
 	for i := range deviceProfiles {
@@ -158,6 +162,7 @@ func TestValidators(t *testing.T) {
 		{ID: 20, Username: "user10", IsActive: true},
 		{ID: 21, Username: "user11", IsActive: false},
 		{ID: 22, Username: "user12", IsActive: true},
+		{ID: 23, Username: "user13", IsActive: true},
 	}
 	for _, user := range users {
 		_, err := storage.DB().Exec(`insert into "user" (id, created_at, updated_at, username, password_hash, session_ttl, is_active, is_admin) values ($1, now(), now(), $2, '', 0, $3, $4)`, user.ID, user.Username, user.IsActive, user.IsAdmin)
@@ -175,6 +180,8 @@ func TestValidators(t *testing.T) {
 		{UserID: users[9].ID, OrganizationID: organizations[0].ID, IsAdmin: true},
 		{UserID: users[10].ID, OrganizationID: organizations[0].ID, IsAdmin: false},
 		{UserID: users[11].ID, OrganizationID: organizations[1].ID, IsAdmin: true},
+		{UserID: users[12].ID, OrganizationID: organizations[0].ID, IsAdmin: false},
+		{UserID: users[12].ID, OrganizationID: organizations[1].ID, IsAdmin: false},
 	}
 	for _, orgUser := range orgUsers {
 		if err := storage.CreateOrganizationUser(storage.DB(), orgUser.OrganizationID, orgUser.UserID, orgUser.IsAdmin); err != nil {
@@ -192,6 +199,51 @@ func TestValidators(t *testing.T) {
 		}
 	}
 
+	deviceFilters := []storage.DeviceFilter{
+		{Name: "device-filter-1", OrganizationID: organizations[0].ID},
+	}
+	for i := range deviceFilters {
+		if err := storage.CreateDeviceFilter(storage.DB(), &deviceFilters[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deviceWebhooks := []storage.DeviceWebhook{
+		{Name: "device-webhook-1", OrganizationID: organizations[0].ID, URL: "http://localhost:1234/"},
+	}
+	for i := range deviceWebhooks {
+		if err := storage.CreateDeviceWebhook(storage.DB(), &deviceWebhooks[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deviceConflict, err := storage.CreateDeviceConflict(storage.DB(), storage.Device{
+		DevEUI:          devices[0].DevEUI,
+		ApplicationID:   applications[0].ID,
+		DeviceProfileID: deviceProfilesIDs[0],
+		Name:            "conflicting-device",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	campaigns := []storage.Campaign{
+		{
+			ApplicationID: applications[0].ID,
+			Name:          "campaign-1",
+			Commands:      storage.CampaignCommands{{FPort: 10, Data: []byte{1, 2, 3}}},
+			StartAt:       time.Now(),
+			EndAt:         time.Now().Add(time.Hour),
+			RetryInterval: time.Minute,
+			MaxAttempts:   1,
+		},
+	}
+	for i := range campaigns {
+		if err := storage.CreateCampaign(storage.DB(), &campaigns[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
 	Convey("Given a set of test users, applications and devices", t, func() {
 
 		Convey("When testing ValidateUsersAccess (DisableAssignExistingUsers=false)", func() {
@@ -473,6 +525,37 @@ func TestValidators(t *testing.T) {
 			runTests(tests, storage.DB())
 		})
 
+		Convey("When testing ValidateDeviceHistoryAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read",
+					Validators: []ValidatorFunc{ValidateDeviceHistoryAccess(Read)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "inactive global admin users can not read",
+					Validators: []ValidatorFunc{ValidateDeviceHistoryAccess(Read)},
+					Claims:     Claims{Username: "user8"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization admin users can not read",
+					Validators: []ValidatorFunc{ValidateDeviceHistoryAccess(Read)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "normal users can not read",
+					Validators: []ValidatorFunc{ValidateDeviceHistoryAccess(Read)},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
 		Convey("When testing ValidateGatewaysAccess", func() {
 			tests := []validatorTest{
 				{
@@ -1076,6 +1159,30 @@ func TestValidators(t *testing.T) {
 					Claims:     Claims{Username: "user12"},
 					ExpectedOK: false,
 				},
+				{
+					Name:       "global admin users can read, update and delete shared device-profiles",
+					Validators: []ValidatorFunc{ValidateDeviceProfileAccess(Read, deviceProfilesIDs[2]), ValidateDeviceProfileAccess(Update, deviceProfilesIDs[2]), ValidateDeviceProfileAccess(Delete, deviceProfilesIDs[2])},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users of other organizations can read shared device-profiles",
+					Validators: []ValidatorFunc{ValidateDeviceProfileAccess(Read, deviceProfilesIDs[2])},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users of other organizations can not update and delete shared device-profiles",
+					Validators: []ValidatorFunc{ValidateDeviceProfileAccess(Update, deviceProfilesIDs[2]), ValidateDeviceProfileAccess(Delete, deviceProfilesIDs[2])},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can read shared device-profiles",
+					Validators: []ValidatorFunc{ValidateDeviceProfileAccess(Read, deviceProfilesIDs[2])},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: true,
+				},
 			}
 
 			runTests(tests, storage.DB())
@@ -1185,6 +1292,391 @@ func TestValidators(t *testing.T) {
 
 			runTests(tests, storage.DB())
 		})
+
+		Convey("When testing ValidateDeviceFiltersAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateDeviceFiltersAccess(Create, organizations[0].ID), ValidateDeviceFiltersAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateDeviceFiltersAccess(Create, organizations[0].ID), ValidateDeviceFiltersAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateDeviceFiltersAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create",
+					Validators: []ValidatorFunc{ValidateDeviceFiltersAccess(Create, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not create or list",
+					Validators: []ValidatorFunc{ValidateDeviceFiltersAccess(Create, organizations[0].ID), ValidateDeviceFiltersAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
+		Convey("When testing ValidateDeviceFilterAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateDeviceFilterAccess(Read, deviceFilters[0].ID), ValidateDeviceFilterAccess(Update, deviceFilters[0].ID), ValidateDeviceFilterAccess(Delete, deviceFilters[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateDeviceFilterAccess(Read, deviceFilters[0].ID), ValidateDeviceFilterAccess(Update, deviceFilters[0].ID), ValidateDeviceFilterAccess(Delete, deviceFilters[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateDeviceFilterAccess(Read, deviceFilters[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not update and delete",
+					Validators: []ValidatorFunc{ValidateDeviceFilterAccess(Update, deviceFilters[0].ID), ValidateDeviceFilterAccess(Delete, deviceFilters[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateDeviceFilterAccess(Read, deviceFilters[0].ID), ValidateDeviceFilterAccess(Update, deviceFilters[0].ID), ValidateDeviceFilterAccess(Delete, deviceFilters[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
+		Convey("When testing ValidateCampaignsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateCampaignsAccess(Create, applications[0].ID), ValidateCampaignsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateCampaignsAccess(Create, applications[0].ID), ValidateCampaignsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateCampaignsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create",
+					Validators: []ValidatorFunc{ValidateCampaignsAccess(Create, applications[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not create or list",
+					Validators: []ValidatorFunc{ValidateCampaignsAccess(Create, applications[0].ID), ValidateCampaignsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
+		Convey("When testing ValidateCampaignAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read and delete",
+					Validators: []ValidatorFunc{ValidateCampaignAccess(Read, campaigns[0].ID), ValidateCampaignAccess(Delete, campaigns[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read and delete",
+					Validators: []ValidatorFunc{ValidateCampaignAccess(Read, campaigns[0].ID), ValidateCampaignAccess(Delete, campaigns[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateCampaignAccess(Read, campaigns[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not delete",
+					Validators: []ValidatorFunc{ValidateCampaignAccess(Delete, campaigns[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read or delete",
+					Validators: []ValidatorFunc{ValidateCampaignAccess(Read, campaigns[0].ID), ValidateCampaignAccess(Delete, campaigns[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
+		Convey("When testing ValidateClusterAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can list",
+					Validators: []ValidatorFunc{ValidateClusterAccess(List)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "inactive global admin users can not list",
+					Validators: []ValidatorFunc{ValidateClusterAccess(List)},
+					Claims:     Claims{Username: "user8"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization admin users can not list",
+					Validators: []ValidatorFunc{ValidateClusterAccess(List)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
+		Convey("When testing ValidateDeviceWebhooksAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateDeviceWebhooksAccess(Create, organizations[0].ID), ValidateDeviceWebhooksAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateDeviceWebhooksAccess(Create, organizations[0].ID), ValidateDeviceWebhooksAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create or list",
+					Validators: []ValidatorFunc{ValidateDeviceWebhooksAccess(Create, organizations[0].ID), ValidateDeviceWebhooksAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not create or list",
+					Validators: []ValidatorFunc{ValidateDeviceWebhooksAccess(Create, organizations[0].ID), ValidateDeviceWebhooksAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
+		Convey("When testing ValidateDeviceWebhookAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateDeviceWebhookAccess(Read, deviceWebhooks[0].ID), ValidateDeviceWebhookAccess(Update, deviceWebhooks[0].ID), ValidateDeviceWebhookAccess(Delete, deviceWebhooks[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateDeviceWebhookAccess(Read, deviceWebhooks[0].ID), ValidateDeviceWebhookAccess(Update, deviceWebhooks[0].ID), ValidateDeviceWebhookAccess(Delete, deviceWebhooks[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateDeviceWebhookAccess(Read, deviceWebhooks[0].ID), ValidateDeviceWebhookAccess(Update, deviceWebhooks[0].ID), ValidateDeviceWebhookAccess(Delete, deviceWebhooks[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateDeviceWebhookAccess(Read, deviceWebhooks[0].ID), ValidateDeviceWebhookAccess(Update, deviceWebhooks[0].ID), ValidateDeviceWebhookAccess(Delete, deviceWebhooks[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
+		Convey("When testing ValidateDeviceConflictsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can list all and per organization",
+					Validators: []ValidatorFunc{ValidateDeviceConflictsAccess(List, 0), ValidateDeviceConflictsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can list for their organization",
+					Validators: []ValidatorFunc{ValidateDeviceConflictsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can not list all",
+					Validators: []ValidatorFunc{ValidateDeviceConflictsAccess(List, 0)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization users can not list",
+					Validators: []ValidatorFunc{ValidateDeviceConflictsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
+		Convey("When testing ValidateDeviceConflictAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read and update",
+					Validators: []ValidatorFunc{ValidateDeviceConflictAccess(Read, deviceConflict.ID), ValidateDeviceConflictAccess(Update, deviceConflict.ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read",
+					Validators: []ValidatorFunc{ValidateDeviceConflictAccess(Read, deviceConflict.ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can not update",
+					Validators: []ValidatorFunc{ValidateDeviceConflictAccess(Update, deviceConflict.ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read",
+					Validators: []ValidatorFunc{ValidateDeviceConflictAccess(Read, deviceConflict.ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
+		Convey("When testing the host organization scope", func() {
+			tests := []validatorTest{
+				{
+					Name:       "user has access to the application of the host organization",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[0].ID, Read), ValidateNodeAccess(devices[0].DevEUI, Read), ValidateGatewayAccess(Read, gateways[0].MAC)},
+					Claims:     Claims{Username: "user13", HostOrganizationID: organizations[0].ID},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "user has access to the application of the other organization without host scope",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[1].ID, Read), ValidateNodeAccess(devices[1].DevEUI, Read), ValidateGatewayAccess(Read, gateways[1].MAC)},
+					Claims:     Claims{Username: "user13"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "user has no access to the application of the other organization",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[1].ID, Read), ValidateOrganizationAccess(Read, organizations[1].ID)},
+					Claims:     Claims{Username: "user13", HostOrganizationID: organizations[0].ID},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "user has no access to the device of the other organization",
+					Validators: []ValidatorFunc{ValidateNodeAccess(devices[1].DevEUI, Read), ValidateGatewaysAccess(List, organizations[1].ID)},
+					Claims:     Claims{Username: "user13", HostOrganizationID: organizations[0].ID},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "user has no access to the gateway of the other organization",
+					Validators: []ValidatorFunc{ValidateGatewayAccess(Read, gateways[1].MAC)},
+					Claims:     Claims{Username: "user13", HostOrganizationID: organizations[0].ID},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "global admin user has access to the application of the other organization",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[1].ID, Read)},
+					Claims:     Claims{Username: "user1", HostOrganizationID: organizations[0].ID},
+					ExpectedOK: true,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
+
+		Convey("When testing ValidateDeviceEmbedAccess", func() {
+			embedClaims := func(devEUI lorawan.EUI64, view string) Claims {
+				return Claims{
+					StandardClaims: jwt.StandardClaims{Subject: storage.EmbedTokenSubject},
+					DevEUI:         devEUI.String(),
+					EmbedView:      view,
+				}
+			}
+
+			tests := []validatorTest{
+				{
+					Name:       "embed tokens give access to the embedded view of the device",
+					Validators: []ValidatorFunc{ValidateDeviceEmbedAccess(devices[0].DevEUI, storage.EmbedViewFrames), ValidateDeviceEmbedAccess(devices[0].DevEUI, storage.EmbedViewDevice, storage.EmbedViewFrames)},
+					Claims:     embedClaims(devices[0].DevEUI, storage.EmbedViewFrames),
+					ExpectedOK: true,
+				},
+				{
+					Name:       "embed tokens do not give access to other views",
+					Validators: []ValidatorFunc{ValidateDeviceEmbedAccess(devices[0].DevEUI, storage.EmbedViewDevice)},
+					Claims:     embedClaims(devices[0].DevEUI, storage.EmbedViewFrames),
+					ExpectedOK: false,
+				},
+				{
+					Name:       "embed tokens do not give access to other devices",
+					Validators: []ValidatorFunc{ValidateDeviceEmbedAccess(devices[1].DevEUI, storage.EmbedViewFrames)},
+					Claims:     embedClaims(devices[0].DevEUI, storage.EmbedViewFrames),
+					ExpectedOK: false,
+				},
+				{
+					Name:       "embed tokens do not give access as user",
+					Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, Read)},
+					Claims:     embedClaims(devices[0].DevEUI, storage.EmbedViewDevice),
+					ExpectedOK: false,
+				},
+				{
+					Name:       "user tokens do not give embed access",
+					Validators: []ValidatorFunc{ValidateDeviceEmbedAccess(devices[0].DevEUI, storage.EmbedViewDevice)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, storage.DB())
+		})
 	})
 }
 
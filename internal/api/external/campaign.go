@@ -0,0 +1,304 @@
+package external
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// CampaignAPI implements the campaign api.
+type CampaignAPI struct {
+	validator auth.Validator
+}
+
+// NewCampaignAPI creates a new CampaignAPI.
+func NewCampaignAPI(validator auth.Validator) *CampaignAPI {
+	return &CampaignAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given campaign.
+func (a *CampaignAPI) Create(ctx context.Context, req *pb.CreateCampaignRequest) (*pb.CreateCampaignResponse, error) {
+	if req.Campaign == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "campaign must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCampaignsAccess(auth.Create, req.Campaign.ApplicationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	c := storage.Campaign{
+		ApplicationID: req.Campaign.ApplicationId,
+		Name:          req.Campaign.Name,
+		MaxAttempts:   int(req.Campaign.MaxAttempts),
+	}
+
+	if req.Campaign.DeviceFilterId != 0 {
+		c.DeviceFilterID = &req.Campaign.DeviceFilterId
+	}
+
+	for _, cmd := range req.Campaign.Commands {
+		if cmd.FPort > 255 {
+			return nil, grpc.Errorf(codes.InvalidArgument, "f_port must be between 1 and 255")
+		}
+
+		c.Commands = append(c.Commands, storage.CampaignCommand{
+			FPort:      uint8(cmd.FPort),
+			Data:       cmd.Data,
+			JSONObject: cmd.JsonObject,
+		})
+	}
+
+	var err error
+	if req.Campaign.StartAt != nil {
+		c.StartAt, err = ptypes.Timestamp(req.Campaign.StartAt)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "start_at: %s", err)
+		}
+	}
+
+	if req.Campaign.EndAt != nil {
+		c.EndAt, err = ptypes.Timestamp(req.Campaign.EndAt)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "end_at: %s", err)
+		}
+	}
+
+	if req.Campaign.PacingInterval != nil {
+		c.PacingInterval, err = ptypes.Duration(req.Campaign.PacingInterval)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "pacing_interval: %s", err)
+		}
+	}
+
+	if req.Campaign.RetryInterval != nil {
+		c.RetryInterval, err = ptypes.Duration(req.Campaign.RetryInterval)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "retry_interval: %s", err)
+		}
+	}
+
+	err = storage.Transaction(func(tx sqlx.Ext) error {
+		return storage.CreateCampaign(tx, &c)
+	})
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.CreateCampaignResponse{
+		Id: c.ID.String(),
+	}, nil
+}
+
+// Get returns the campaign matching the given id.
+func (a *CampaignAPI) Get(ctx context.Context, req *pb.GetCampaignRequest) (*pb.GetCampaignResponse, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCampaignAccess(auth.Read, id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	c, err := storage.GetCampaign(storage.DB(), id, false)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	count, err := storage.GetCampaignDeviceCount(storage.DB(), id)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out := pb.GetCampaignResponse{
+		Campaign: &pb.Campaign{
+			Id:             c.ID.String(),
+			ApplicationId:  c.ApplicationID,
+			Name:           c.Name,
+			PacingInterval: ptypes.DurationProto(c.PacingInterval),
+			RetryInterval:  ptypes.DurationProto(c.RetryInterval),
+			MaxAttempts:    uint32(c.MaxAttempts),
+		},
+		State:          c.State,
+		PendingCount:   uint32(count.Pending),
+		EnqueuedCount:  uint32(count.Enqueued),
+		ConfirmedCount: uint32(count.Confirmed),
+		FailedCount:    uint32(count.Failed),
+	}
+
+	if c.DeviceFilterID != nil {
+		out.Campaign.DeviceFilterId = *c.DeviceFilterID
+	}
+
+	for _, cmd := range c.Commands {
+		out.Campaign.Commands = append(out.Campaign.Commands, &pb.CampaignCommand{
+			FPort:      uint32(cmd.FPort),
+			Data:       cmd.Data,
+			JsonObject: cmd.JSONObject,
+		})
+	}
+
+	out.Campaign.StartAt, err = ptypes.TimestampProto(c.StartAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out.Campaign.EndAt, err = ptypes.TimestampProto(c.EndAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out.CreatedAt, err = ptypes.TimestampProto(c.CreatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out.UpdatedAt, err = ptypes.TimestampProto(c.UpdatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &out, nil
+}
+
+// Delete deletes the campaign matching the given id.
+func (a *CampaignAPI) Delete(ctx context.Context, req *pb.DeleteCampaignRequest) (*empty.Empty, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCampaignAccess(auth.Delete, id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteCampaign(storage.DB(), id); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// List lists the campaigns of the given application.
+func (a *CampaignAPI) List(ctx context.Context, req *pb.ListCampaignRequest) (*pb.ListCampaignResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCampaignsAccess(auth.List, req.ApplicationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetCampaignCount(storage.DB(), req.ApplicationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	campaigns, err := storage.GetCampaigns(storage.DB(), req.ApplicationId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out := pb.ListCampaignResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, c := range campaigns {
+		item := pb.CampaignListItem{
+			Id:    c.ID.String(),
+			Name:  c.Name,
+			State: c.State,
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(c.CreatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		item.UpdatedAt, err = ptypes.TimestampProto(c.UpdatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		item.StartAt, err = ptypes.TimestampProto(c.StartAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		item.EndAt, err = ptypes.TimestampProto(c.EndAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		out.Result = append(out.Result, &item)
+	}
+
+	return &out, nil
+}
+
+// ListDevices lists the devices of the given campaign and their state.
+func (a *CampaignAPI) ListDevices(ctx context.Context, req *pb.ListCampaignDevicesRequest) (*pb.ListCampaignDevicesResponse, error) {
+	id, err := uuid.FromString(req.CampaignId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "campaign_id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCampaignAccess(auth.Read, id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetCampaignDeviceCount(storage.DB(), id)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	devices, err := storage.GetCampaignDevices(storage.DB(), id, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out := pb.ListCampaignDevicesResponse{
+		TotalCount: int64(count.Pending + count.Enqueued + count.Confirmed + count.Failed),
+	}
+
+	for _, d := range devices {
+		item := pb.CampaignDevice{
+			DevEui:   d.DevEUI.String(),
+			State:    d.State,
+			Attempts: uint32(d.Attempts),
+			Error:    d.Error,
+		}
+
+		if len(d.FCnts) != 0 {
+			item.FCnt = uint32(d.FCnts[len(d.FCnts)-1])
+		}
+
+		if d.EnqueuedAt != nil {
+			item.EnqueuedAt, err = ptypes.TimestampProto(*d.EnqueuedAt)
+			if err != nil {
+				return nil, helpers.ErrToRPCError(err)
+			}
+		}
+
+		item.UpdatedAt, err = ptypes.TimestampProto(d.UpdatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		out.Result = append(out.Result, &item)
+	}
+
+	return &out, nil
+}
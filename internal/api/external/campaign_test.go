@@ -0,0 +1,173 @@
+package external
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *APITestSuite) TestCampaign() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	validator := &TestValidator{}
+	api := NewCampaignAPI(validator)
+
+	n := storage.NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(storage.CreateNetworkServer(storage.DB(), &n))
+
+	org := storage.Organization{
+		Name: "test-org",
+	}
+	assert.NoError(storage.CreateOrganization(storage.DB(), &org))
+
+	sp := storage.ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(storage.CreateServiceProfile(storage.DB(), &sp))
+
+	app := storage.Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(storage.CreateApplication(storage.DB(), &app))
+
+	dp := storage.DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(storage.CreateDeviceProfile(storage.DB(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := storage.Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		Name:            "test-device",
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+	}
+	assert.NoError(storage.CreateDevice(storage.DB(), &d))
+
+	startAt, err := ptypes.TimestampProto(time.Now().Truncate(time.Second).Add(time.Hour))
+	assert.NoError(err)
+	endAt, err := ptypes.TimestampProto(time.Now().Truncate(time.Second).Add(2 * time.Hour))
+	assert.NoError(err)
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		createReq := pb.CreateCampaignRequest{
+			Campaign: &pb.Campaign{
+				ApplicationId: app.ID,
+				Name:          "set reporting interval",
+				Commands: []*pb.CampaignCommand{
+					{FPort: 10, Data: []byte{1, 2, 3}},
+				},
+				StartAt:        startAt,
+				EndAt:          endAt,
+				PacingInterval: ptypes.DurationProto(time.Second),
+				RetryInterval:  ptypes.DurationProto(time.Hour),
+				MaxAttempts:    3,
+			},
+		}
+
+		createResp, err := api.Create(context.Background(), &createReq)
+		assert.NoError(err)
+		createReq.Campaign.Id = createResp.Id
+
+		t.Run("Invalid schedule", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.Create(context.Background(), &pb.CreateCampaignRequest{
+				Campaign: &pb.Campaign{
+					ApplicationId: app.ID,
+					Name:          "invalid",
+					Commands: []*pb.CampaignCommand{
+						{FPort: 10, Data: []byte{1, 2, 3}},
+					},
+					StartAt: startAt,
+					EndAt:   endAt,
+				},
+			})
+			assert.Equal(codes.InvalidArgument, grpc.Code(err))
+		})
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			getResp, err := api.Get(context.Background(), &pb.GetCampaignRequest{
+				Id: createResp.Id,
+			})
+			assert.NoError(err)
+			assert.Equal(createReq.Campaign, getResp.Campaign)
+			assert.Equal(storage.CampaignPending, getResp.State)
+			assert.EqualValues(1, getResp.PendingCount)
+			assert.NotNil(getResp.CreatedAt)
+			assert.NotNil(getResp.UpdatedAt)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			listResp, err := api.List(context.Background(), &pb.ListCampaignRequest{
+				ApplicationId: app.ID,
+				Limit:         10,
+			})
+			assert.NoError(err)
+			assert.EqualValues(1, listResp.TotalCount)
+			assert.Len(listResp.Result, 1)
+			assert.Equal(createResp.Id, listResp.Result[0].Id)
+			assert.Equal(storage.CampaignPending, listResp.Result[0].State)
+		})
+
+		t.Run("ListDevices", func(t *testing.T) {
+			assert := require.New(t)
+
+			listResp, err := api.ListDevices(context.Background(), &pb.ListCampaignDevicesRequest{
+				CampaignId: createResp.Id,
+				Limit:      10,
+			})
+			assert.NoError(err)
+			assert.EqualValues(1, listResp.TotalCount)
+			assert.Len(listResp.Result, 1)
+			assert.Equal(d.DevEUI.String(), listResp.Result[0].DevEui)
+			assert.Equal(storage.CampaignDevicePending, listResp.Result[0].State)
+			assert.Nil(listResp.Result[0].EnqueuedAt)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.Delete(context.Background(), &pb.DeleteCampaignRequest{
+				Id: createResp.Id,
+			})
+			assert.NoError(err)
+
+			_, err = api.Get(context.Background(), &pb.GetCampaignRequest{
+				Id: createResp.Id,
+			})
+			assert.Equal(codes.NotFound, grpc.Code(err))
+		})
+	})
+}
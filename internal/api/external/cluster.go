@@ -0,0 +1,133 @@
+package external
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/cluster"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// ClusterAPI implements the cluster api.
+type ClusterAPI struct {
+	validator auth.Validator
+}
+
+// NewClusterAPI creates a new ClusterAPI.
+func NewClusterAPI(validator auth.Validator) *ClusterAPI {
+	return &ClusterAPI{
+		validator: validator,
+	}
+}
+
+// ListInstances lists the running application-server instances.
+func (a *ClusterAPI) ListInstances(ctx context.Context, req *empty.Empty) (*pb.ListClusterInstancesResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateClusterAccess(auth.List)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	instances, err := cluster.GetInstances()
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	leaders, err := cluster.GetLeaders()
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var out pb.ListClusterInstancesResponse
+
+	for _, i := range instances {
+		item := pb.ClusterInstance{
+			Id:       i.ID,
+			Hostname: i.Hostname,
+			Version:  i.Version,
+			Roles:    i.Roles,
+		}
+
+		item.StartedAt, err = ptypes.TimestampProto(i.StartedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		item.HeartbeatAt, err = ptypes.TimestampProto(i.HeartbeatAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		for _, name := range cluster.Counters {
+			item.Throughput = append(item.Throughput, &pb.ClusterThroughput{
+				Name:  name,
+				Count: i.Counters[name],
+				Rate:  i.Rates[name],
+			})
+		}
+
+		out.Result = append(out.Result, &item)
+	}
+
+	for role, id := range leaders {
+		out.Leaders = append(out.Leaders, &pb.ClusterLeader{
+			Role:       role,
+			InstanceId: id,
+		})
+	}
+
+	sort.Slice(out.Leaders, func(i, j int) bool {
+		return out.Leaders[i].Role < out.Leaders[j].Role
+	})
+
+	return &out, nil
+}
+
+// ListSlowStorageOperations lists the storage operations with the highest
+// max. query duration.
+func (a *ClusterAPI) ListSlowStorageOperations(ctx context.Context, req *pb.ListSlowStorageOperationsRequest) (*pb.ListSlowStorageOperationsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateClusterAccess(auth.List)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+
+	out := pb.ListSlowStorageOperationsResponse{
+		InstanceId: cluster.InstanceID(),
+	}
+
+	for _, s := range storage.GetSlowestQueryStats(limit) {
+		item := pb.StorageOperation{
+			Function:     s.Function,
+			Count:        uint64(s.Count),
+			SlowCount:    uint64(s.SlowCount),
+			MeanDuration: ptypes.DurationProto(s.MeanDuration()),
+			MaxDuration:  ptypes.DurationProto(s.MaxDuration),
+		}
+
+		for i, count := range s.Buckets {
+			bucket := pb.StorageDurationBucket{
+				Count: uint64(count),
+			}
+			if i < len(storage.QueryDurationBuckets) {
+				bucket.Le = ptypes.DurationProto(storage.QueryDurationBuckets[i])
+			}
+			item.Buckets = append(item.Buckets, &bucket)
+		}
+
+		out.Result = append(out.Result, &item)
+	}
+
+	return &out, nil
+}
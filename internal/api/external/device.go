@@ -1,8 +1,10 @@
 package external
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes"
@@ -18,14 +20,35 @@ import (
 	"github.com/brocaar/lora-app-server/internal/api/external/auth"
 	"github.com/brocaar/lora-app-server/internal/api/helpers"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/eventbus"
 	"github.com/brocaar/lora-app-server/internal/eventlog"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/track"
 	"github.com/brocaar/loraserver/api/common"
 	"github.com/brocaar/loraserver/api/gw"
 	"github.com/brocaar/loraserver/api/ns"
 	"github.com/brocaar/lorawan"
 )
 
+var deviceLifecycleStateToPB = map[storage.DeviceLifecycleState]pb.DeviceLifecycleState{
+	storage.DeviceProvisioned: pb.DeviceLifecycleState_PROVISIONED,
+	storage.DeviceActive:      pb.DeviceLifecycleState_ACTIVE,
+	storage.DeviceSuspended:   pb.DeviceLifecycleState_SUSPENDED,
+	storage.DeviceRetired:     pb.DeviceLifecycleState_RETIRED,
+}
+
+var deviceEmbedViewFromPB = map[pb.DeviceEmbedView]string{
+	pb.DeviceEmbedView_DEVICE_DETAIL: storage.EmbedViewDevice,
+	pb.DeviceEmbedView_LIVE_FRAMES:   storage.EmbedViewFrames,
+}
+
+var deviceLifecycleStateFromPB = map[pb.DeviceLifecycleState]storage.DeviceLifecycleState{
+	pb.DeviceLifecycleState_PROVISIONED: storage.DeviceProvisioned,
+	pb.DeviceLifecycleState_ACTIVE:      storage.DeviceActive,
+	pb.DeviceLifecycleState_SUSPENDED:   storage.DeviceSuspended,
+	pb.DeviceLifecycleState_RETIRED:     storage.DeviceRetired,
+}
+
 // DeviceAPI exports the Node related functions.
 type DeviceAPI struct {
 	validator auth.Validator
@@ -80,9 +103,15 @@ func (a *DeviceAPI) Create(ctx context.Context, req *pb.CreateDeviceRequest) (*e
 		return storage.CreateDevice(tx, &d)
 	})
 	if err != nil {
+		// the device might exist in the database or on the network-server
+		if cause := errors.Cause(err); cause == storage.ErrAlreadyExists || grpc.Code(cause) == codes.AlreadyExists {
+			return nil, deviceConflictError(d)
+		}
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	eventbus.Publish(eventbus.DeviceCreated, d.ApplicationID, d.DevEUI, d)
+
 	return &empty.Empty{}, nil
 }
 
@@ -94,7 +123,8 @@ func (a *DeviceAPI) Get(ctx context.Context, req *pb.GetDeviceRequest) (*pb.GetD
 	}
 
 	if err := a.validator.Validate(ctx,
-		auth.ValidateNodeAccess(eui, auth.Read)); err != nil {
+		auth.ValidateNodeAccess(eui, auth.Read),
+		auth.ValidateDeviceEmbedAccess(eui, storage.EmbedViewDevice, storage.EmbedViewFrames)); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
@@ -116,6 +146,7 @@ func (a *DeviceAPI) Get(ctx context.Context, req *pb.GetDeviceRequest) (*pb.GetD
 
 		DeviceStatusBattery: 256,
 		DeviceStatusMargin:  256,
+		LifecycleState:      deviceLifecycleStateToPB[d.LifecycleState],
 	}
 
 	if d.DeviceStatusBattery != nil {
@@ -169,6 +200,10 @@ func (a *DeviceAPI) List(ctx context.Context, req *pb.ListDeviceRequest) (*pb.Li
 		}
 	}
 
+	for _, state := range req.LifecycleStates {
+		filters.LifecycleStates = append(filters.LifecycleStates, string(deviceLifecycleStateFromPB[state]))
+	}
+
 	if filters.ApplicationID != 0 {
 		idFilter = true
 
@@ -203,6 +238,36 @@ func (a *DeviceAPI) List(ctx context.Context, req *pb.ListDeviceRequest) (*pb.Li
 		}
 	}
 
+	if req.DeviceFilterId != 0 {
+		idFilter = true
+
+		// validate that the client has access to the given device filter
+		if err := a.validator.Validate(ctx,
+			auth.ValidateDeviceFilterAccess(auth.Read, req.DeviceFilterId),
+		); err != nil {
+			return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		}
+
+		df, err := storage.GetDeviceFilter(storage.DB(), req.DeviceFilterId)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		// the application and search filters of the request take
+		// precedence over the ones stored in the device filter
+		dff := df.DeviceFilters(time.Now())
+		filters.OrganizationID = dff.OrganizationID
+		filters.DeviceProfileID = dff.DeviceProfileID
+		filters.LastSeenBefore = dff.LastSeenBefore
+		filters.BatteryBelow = dff.BatteryBelow
+		if filters.ApplicationID == 0 {
+			filters.ApplicationID = dff.ApplicationID
+		}
+		if filters.Search == "" {
+			filters.Search = dff.Search
+		}
+	}
+
 	if !idFilter {
 		isAdmin, err := a.validator.GetIsAdmin(ctx)
 		if err != nil {
@@ -254,6 +319,10 @@ func (a *DeviceAPI) Update(ctx context.Context, req *pb.UpdateDeviceRequest) (*e
 			return helpers.ErrToRPCError(err)
 		}
 
+		if d.LifecycleState == storage.DeviceRetired {
+			return helpers.ErrToRPCError(storage.ErrDeviceRetired)
+		}
+
 		d.DeviceProfileID = dpID
 		d.Name = req.Device.Name
 		d.Description = req.Device.Description
@@ -285,19 +354,30 @@ func (a *DeviceAPI) Delete(ctx context.Context, req *pb.DeleteDeviceRequest) (*e
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	d, err := storage.GetDevice(storage.DB(), eui, false, true)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	// as this also performs a remote call to delete the node from the
 	// network-server, wrap it in a transaction
-	err := storage.Transaction(func(tx sqlx.Ext) error {
+	err = storage.Transaction(func(tx sqlx.Ext) error {
 		return storage.DeleteDevice(tx, eui)
 	})
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	// a retired device has already been decommissioned
+	if d.LifecycleState != storage.DeviceRetired {
+		eventbus.Publish(eventbus.DeviceDecommissioned, d.ApplicationID, d.DevEUI, d)
+	}
+
 	return &empty.Empty{}, nil
 }
 
-// CreateKeys creates the given device-keys.
+// CreateKeys creates the given device-keys. When the application has a
+// master-key, the device-keys derived from it are overridden.
 func (a *DeviceAPI) CreateKeys(ctx context.Context, req *pb.CreateDeviceKeysRequest) (*empty.Empty, error) {
 	if req.DeviceKeys == nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "device_keys must not be nil")
@@ -327,10 +407,16 @@ func (a *DeviceAPI) CreateKeys(ctx context.Context, req *pb.CreateDeviceKeysRequ
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	err := storage.CreateDeviceKeys(storage.DB(), &storage.DeviceKeys{
-		DevEUI: eui,
-		NwkKey: nwkKey,
-		AppKey: appKey,
+	if err := validateDeviceNotRetired(eui); err != nil {
+		return nil, err
+	}
+
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		return storage.CreateOrOverrideDerivedDeviceKeys(tx, &storage.DeviceKeys{
+			DevEUI: eui,
+			NwkKey: nwkKey,
+			AppKey: appKey,
+		})
 	})
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
@@ -352,6 +438,10 @@ func (a *DeviceAPI) GetKeys(ctx context.Context, req *pb.GetDeviceKeysRequest) (
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if err := validateDeviceNotRetired(eui); err != nil {
+		return nil, err
+	}
+
 	dk, err := storage.GetDeviceKeys(storage.DB(), eui)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
@@ -424,6 +514,10 @@ func (a *DeviceAPI) DeleteKeys(ctx context.Context, req *pb.DeleteDeviceKeysRequ
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if err := validateDeviceNotRetired(eui); err != nil {
+		return nil, err
+	}
+
 	if err := storage.DeleteDeviceKeys(storage.DB(), eui); err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
@@ -449,6 +543,10 @@ func (a *DeviceAPI) Deactivate(ctx context.Context, req *pb.DeactivateDeviceRequ
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	if d.LifecycleState == storage.DeviceRetired {
+		return nil, helpers.ErrToRPCError(storage.ErrDeviceRetired)
+	}
+
 	n, err := storage.GetNetworkServerForDevEUI(storage.DB(), d.DevEUI)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
@@ -472,42 +570,25 @@ func (a *DeviceAPI) Activate(ctx context.Context, req *pb.ActivateDeviceRequest)
 		return nil, grpc.Errorf(codes.InvalidArgument, "device_activation must not be nil")
 	}
 
-	var devAddr lorawan.DevAddr
-	var devEUI lorawan.EUI64
-	var appSKey lorawan.AES128Key
-	var nwkSEncKey lorawan.AES128Key
-	var sNwkSIntKey lorawan.AES128Key
-	var fNwkSIntKey lorawan.AES128Key
-
-	if err := devAddr.UnmarshalText([]byte(req.DeviceActivation.DevAddr)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "devAddr: %s", err)
-	}
-	if err := devEUI.UnmarshalText([]byte(req.DeviceActivation.DevEui)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
-	}
-	if err := appSKey.UnmarshalText([]byte(req.DeviceActivation.AppSKey)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "appSKey: %s", err)
-	}
-	if err := nwkSEncKey.UnmarshalText([]byte(req.DeviceActivation.NwkSEncKey)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "nwkSEncKey: %s", err)
-	}
-	if err := sNwkSIntKey.UnmarshalText([]byte(req.DeviceActivation.SNwkSIntKey)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "sNwkSIntKey: %s", err)
-	}
-	if err := fNwkSIntKey.UnmarshalText([]byte(req.DeviceActivation.FNwkSIntKey)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "fNwkSIntKey: %s", err)
+	act, err := deviceActivationFromPB(req.DeviceActivation)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := a.validator.Validate(ctx,
-		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		auth.ValidateNodeAccess(act.devEUI, auth.Update)); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	d, err := storage.GetDevice(storage.DB(), devEUI, false, true)
+	d, err := storage.GetDevice(storage.DB(), act.devEUI, false, true)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	if d.LifecycleState == storage.DeviceRetired {
+		return nil, helpers.ErrToRPCError(storage.ErrDeviceRetired)
+	}
+
 	dp, err := storage.GetDeviceProfile(storage.DB(), d.DeviceProfileID)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
@@ -517,63 +598,117 @@ func (a *DeviceAPI) Activate(ctx context.Context, req *pb.ActivateDeviceRequest)
 		return nil, grpc.Errorf(codes.FailedPrecondition, "node must be an ABP node")
 	}
 
-	n, err := storage.GetNetworkServerForDevEUI(storage.DB(), devEUI)
-	if err != nil {
+	if err := activateDevice(d, act); err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	nsClient, err := networkserver.GetPool().Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
-	if err != nil {
-		return nil, helpers.ErrToRPCError(err)
+	log.WithFields(log.Fields{
+		"dev_addr": act.devAddr,
+		"dev_eui":  d.DevEUI,
+	}).Info("device activated")
+
+	return &empty.Empty{}, nil
+}
+
+// ImportActivation (re)activates the device (OTAA and ABP) using the given
+// activation context. Unlike Activate, this does not require the device to
+// be an ABP device, as the context contains the session of a (joined) device
+// which has been exported from an other instance.
+func (a *DeviceAPI) ImportActivation(ctx context.Context, req *pb.ImportDeviceActivationRequest) (*empty.Empty, error) {
+	if req.ActivationContext == nil || req.ActivationContext.DeviceActivation == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "activation_context.device_activation must not be nil")
 	}
 
-	_, _ = nsClient.DeactivateDevice(context.Background(), &ns.DeactivateDeviceRequest{
-		DevEui: d.DevEUI[:],
-	})
+	act, err := deviceActivationFromPB(req.ActivationContext.DeviceActivation)
+	if err != nil {
+		return nil, err
+	}
 
-	actReq := ns.ActivateDeviceRequest{
-		DeviceActivation: &ns.DeviceActivation{
-			DevEui:      d.DevEUI[:],
-			DevAddr:     devAddr[:],
-			NwkSEncKey:  nwkSEncKey[:],
-			SNwkSIntKey: sNwkSIntKey[:],
-			FNwkSIntKey: fNwkSIntKey[:],
-			FCntUp:      req.DeviceActivation.FCntUp,
-			NFCntDown:   req.DeviceActivation.NFCntDown,
-			AFCntDown:   req.DeviceActivation.AFCntDown,
-		},
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(act.devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	_, err = nsClient.ActivateDevice(context.Background(), &actReq)
+	d, err := storage.GetDevice(storage.DB(), act.devEUI, false, true)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	err = storage.CreateDeviceActivation(storage.DB(), &storage.DeviceActivation{
-		DevEUI:  d.DevEUI,
-		DevAddr: devAddr,
-		AppSKey: appSKey,
-	})
-	if err != nil {
+	if d.LifecycleState == storage.DeviceRetired {
+		return nil, helpers.ErrToRPCError(storage.ErrDeviceRetired)
+	}
+
+	// Make sure that a future join-accept does not re-use a join-nonce which
+	// has already been used by the instance from which the context has been
+	// exported.
+	dk, err := storage.GetDeviceKeys(storage.DB(), d.DevEUI)
+	if err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	if err == nil && int(req.ActivationContext.JoinNonce) > dk.JoinNonce {
+		dk.JoinNonce = int(req.ActivationContext.JoinNonce)
+		if err := storage.UpdateDeviceKeys(storage.DB(), &dk); err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+	}
+
+	if err := activateDevice(d, act); err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
 
 	log.WithFields(log.Fields{
-		"dev_addr": devAddr,
+		"dev_addr": act.devAddr,
 		"dev_eui":  d.DevEUI,
-	}).Info("device activated")
+	}).Info("device activation imported")
+
+	return &empty.Empty{}, nil
+}
+
+// UpdateLifecycleState updates the lifecycle state of the device.
+func (a *DeviceAPI) UpdateLifecycleState(ctx context.Context, req *pb.UpdateDeviceLifecycleStateRequest) (*empty.Empty, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	state, ok := deviceLifecycleStateFromPB[req.LifecycleState]
+	if !ok {
+		return nil, helpers.ErrToRPCError(storage.ErrDeviceLifecycleStateInvalid)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var d storage.Device
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		var err error
+		d, err = storage.GetDevice(tx, devEUI, true, true)
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+
+		if err := storage.UpdateDeviceLifecycleState(tx, &d, state); err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if state == storage.DeviceRetired {
+		eventbus.Publish(eventbus.DeviceDecommissioned, d.ApplicationID, d.DevEUI, d)
+	}
 
 	return &empty.Empty{}, nil
 }
 
 // GetActivation returns the device activation for the given DevEUI.
 func (a *DeviceAPI) GetActivation(ctx context.Context, req *pb.GetDeviceActivationRequest) (*pb.GetDeviceActivationResponse, error) {
-	var devAddr lorawan.DevAddr
 	var devEUI lorawan.EUI64
-	var sNwkSIntKey lorawan.AES128Key
-	var fNwkSIntKey lorawan.AES128Key
-	var nwkSEncKey lorawan.AES128Key
-
 	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
 	}
@@ -583,51 +718,198 @@ func (a *DeviceAPI) GetActivation(ctx context.Context, req *pb.GetDeviceActivati
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	d, err := storage.GetDevice(storage.DB(), devEUI, false, true)
+	da, err := getDeviceActivation(devEUI)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	da, err := storage.GetLastDeviceActivationForDevEUI(storage.DB(), devEUI)
+	return &pb.GetDeviceActivationResponse{
+		DeviceActivation: da,
+	}, nil
+}
+
+// ExportActivation exports the activation context of the device for the
+// given DevEUI.
+func (a *DeviceAPI) ExportActivation(ctx context.Context, req *pb.ExportDeviceActivationRequest) (*pb.ExportDeviceActivationResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	// the context contains the session-keys, therefore the same permissions
+	// are required as for updating the device
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	da, err := getDeviceActivation(devEUI)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	n, err := storage.GetNetworkServerForDevEUI(storage.DB(), devEUI)
+	resp := pb.ExportDeviceActivationResponse{
+		ActivationContext: &pb.DeviceActivationContext{
+			DeviceActivation: da,
+		},
+	}
+
+	dk, err := storage.GetDeviceKeys(storage.DB(), devEUI)
+	if err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	if err == nil {
+		resp.ActivationContext.JoinNonce = uint32(dk.JoinNonce)
+	}
+
+	resp.ActivationContext.ExportedAt, err = ptypes.TimestampProto(time.Now())
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	nsClient, err := networkserver.GetPool().Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	return &resp, nil
+}
+
+// GetLocationTrack returns the location history of the device within the given time-range.
+func (a *DeviceAPI) GetLocationTrack(ctx context.Context, req *pb.GetDeviceLocationTrackRequest) (*pb.GetDeviceLocationTrackResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read),
+		auth.ValidateDeviceEmbedAccess(devEUI, storage.EmbedViewDevice)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if req.Tolerance < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "tolerance must be >= 0")
+	}
+
+	filters := storage.DeviceLocationFilters{
+		DevEUI: devEUI,
+	}
+
+	if req.StartTimestamp != nil {
+		start, err := ptypes.Timestamp(req.StartTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "start_timestamp: %s", err)
+		}
+		filters.Start = &start
+	}
+
+	if req.EndTimestamp != nil {
+		end, err := ptypes.Timestamp(req.EndTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "end_timestamp: %s", err)
+		}
+		filters.End = &end
+	}
+
+	locations, err := storage.GetDeviceLocations(storage.DB(), filters)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	devAct, err := nsClient.GetDeviceActivation(context.Background(), &ns.GetDeviceActivationRequest{
-		DevEui: d.DevEUI[:],
+	var points []track.Point
+	for _, l := range locations {
+		points = append(points, track.Point{
+			Time:      l.CreatedAt,
+			Source:    l.Source,
+			Latitude:  l.Latitude,
+			Longitude: l.Longitude,
+			Altitude:  l.Altitude,
+		})
+	}
+
+	points = track.Simplify(points, req.Tolerance)
+	points = track.Limit(points, int(req.MaxPoints))
+
+	geoJSON, err := track.GeoJSON(points, map[string]interface{}{
+		"devEUI": devEUI.String(),
 	})
 	if err != nil {
-		return nil, err
+		return nil, helpers.ErrToRPCError(err)
 	}
 
-	copy(devAddr[:], devAct.DeviceActivation.DevAddr)
-	copy(nwkSEncKey[:], devAct.DeviceActivation.NwkSEncKey)
-	copy(sNwkSIntKey[:], devAct.DeviceActivation.SNwkSIntKey)
-	copy(fNwkSIntKey[:], devAct.DeviceActivation.FNwkSIntKey)
+	resp := pb.GetDeviceLocationTrackResponse{
+		GeoJson: string(geoJSON),
+	}
 
-	return &pb.GetDeviceActivationResponse{
-		DeviceActivation: &pb.DeviceActivation{
-			DevEui:      da.DevEUI.String(),
-			DevAddr:     devAddr.String(),
-			AppSKey:     da.AppSKey.String(),
-			NwkSEncKey:  nwkSEncKey.String(),
-			SNwkSIntKey: sNwkSIntKey.String(),
-			FNwkSIntKey: fNwkSIntKey.String(),
-			FCntUp:      devAct.DeviceActivation.FCntUp,
-			NFCntDown:   devAct.DeviceActivation.NFCntDown,
-			AFCntDown:   devAct.DeviceActivation.AFCntDown,
-		},
-	}, nil
+	for _, p := range points {
+		ts, err := ptypes.TimestampProto(p.Time)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		resp.Points = append(resp.Points, &pb.DeviceLocationTrackPoint{
+			Timestamp: ts,
+			Source:    common.LocationSource(common.LocationSource_value[p.Source]),
+			Latitude:  p.Latitude,
+			Longitude: p.Longitude,
+			Altitude:  p.Altitude,
+		})
+	}
+
+	return &resp, nil
+}
+
+// GetLinkStats returns the daily uplink packet-loss of the device.
+func (a *DeviceAPI) GetLinkStats(ctx context.Context, req *pb.GetDeviceLinkStatsRequest) (*pb.GetDeviceLinkStatsResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read),
+		auth.ValidateDeviceEmbedAccess(devEUI, storage.EmbedViewDevice)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	end := time.Now()
+	if req.EndTimestamp != nil {
+		var err error
+		end, err = ptypes.Timestamp(req.EndTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "end_timestamp: %s", err)
+		}
+	}
+
+	start := end.AddDate(0, 0, -7)
+	if req.StartTimestamp != nil {
+		var err error
+		start, err = ptypes.Timestamp(req.StartTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "start_timestamp: %s", err)
+		}
+	}
+
+	stats, err := storage.GetDeviceLinkStats(storage.DB(), devEUI, start, end)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var resp pb.GetDeviceLinkStatsResponse
+	var total storage.DeviceLinkStat
+
+	for _, s := range stats {
+		total.Uplinks += s.Uplinks
+		total.Lost += s.Lost
+
+		resp.Result = append(resp.Result, &pb.DeviceLinkStats{
+			Date:       s.Date.Format("2006-01-02"),
+			Uplinks:    uint32(s.Uplinks),
+			Lost:       uint32(s.Lost),
+			PacketLoss: s.PacketLoss(),
+		})
+	}
+
+	resp.PacketLoss = total.PacketLoss()
+	resp.UnhealthyLink = resp.PacketLoss > unhealthyPacketLoss
+
+	return &resp, nil
 }
 
 // StreamFrameLogs streams the uplink and downlink frame-logs for the given DevEUI.
@@ -640,7 +922,8 @@ func (a *DeviceAPI) StreamFrameLogs(req *pb.StreamDeviceFrameLogsRequest, srv pb
 	}
 
 	if err := a.validator.Validate(srv.Context(),
-		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		auth.ValidateNodeAccess(devEUI, auth.Read),
+		auth.ValidateDeviceEmbedAccess(devEUI, storage.EmbedViewFrames)); err != nil {
 		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
@@ -658,7 +941,7 @@ func (a *DeviceAPI) StreamFrameLogs(req *pb.StreamDeviceFrameLogsRequest, srv pb
 		DevEui: devEUI[:],
 	})
 	if err != nil {
-		return err
+		return helpers.ErrToRPCError(err)
 	}
 
 	for {
@@ -756,7 +1039,7 @@ func (a *DeviceAPI) GetRandomDevAddr(ctx context.Context, req *pb.GetRandomDevAd
 
 	resp, err := nsClient.GetRandomDevAddr(context.Background(), &empty.Empty{})
 	if err != nil {
-		return nil, err
+		return nil, helpers.ErrToRPCError(err)
 	}
 
 	var devAddr lorawan.DevAddr
@@ -782,6 +1065,7 @@ func (a *DeviceAPI) returnList(count int, devices []storage.DeviceListItem) (*pb
 			DeviceStatusBattery:             256,
 			DeviceStatusMargin:              256,
 			DeviceStatusExternalPowerSource: device.DeviceStatusExternalPower,
+			LifecycleState:                  deviceLifecycleStateToPB[device.LifecycleState],
 		}
 
 		if !device.DeviceStatusExternalPower && device.DeviceStatusBattery == nil {
@@ -817,6 +1101,159 @@ func (a *DeviceAPI) returnList(count int, devices []storage.DeviceListItem) (*pb
 	return &resp, nil
 }
 
+// validateDeviceNotRetired returns an error when the device is retired, as
+// retired devices are read-only.
+func validateDeviceNotRetired(devEUI lorawan.EUI64) error {
+	d, err := storage.GetDevice(storage.DB(), devEUI, false, true)
+	if err != nil {
+		return helpers.ErrToRPCError(err)
+	}
+
+	if d.LifecycleState == storage.DeviceRetired {
+		return helpers.ErrToRPCError(storage.ErrDeviceRetired)
+	}
+
+	return nil
+}
+
+// deviceActivation contains the decoded fields of a pb.DeviceActivation.
+type deviceActivation struct {
+	devEUI      lorawan.EUI64
+	devAddr     lorawan.DevAddr
+	appSKey     lorawan.AES128Key
+	nwkSEncKey  lorawan.AES128Key
+	sNwkSIntKey lorawan.AES128Key
+	fNwkSIntKey lorawan.AES128Key
+	fCntUp      uint32
+	nFCntDown   uint32
+	aFCntDown   uint32
+}
+
+func deviceActivationFromPB(da *pb.DeviceActivation) (deviceActivation, error) {
+	act := deviceActivation{
+		fCntUp:    da.FCntUp,
+		nFCntDown: da.NFCntDown,
+		aFCntDown: da.AFCntDown,
+	}
+
+	if err := act.devAddr.UnmarshalText([]byte(da.DevAddr)); err != nil {
+		return act, grpc.Errorf(codes.InvalidArgument, "devAddr: %s", err)
+	}
+	if err := act.devEUI.UnmarshalText([]byte(da.DevEui)); err != nil {
+		return act, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+	if err := act.appSKey.UnmarshalText([]byte(da.AppSKey)); err != nil {
+		return act, grpc.Errorf(codes.InvalidArgument, "appSKey: %s", err)
+	}
+	if err := act.nwkSEncKey.UnmarshalText([]byte(da.NwkSEncKey)); err != nil {
+		return act, grpc.Errorf(codes.InvalidArgument, "nwkSEncKey: %s", err)
+	}
+	if err := act.sNwkSIntKey.UnmarshalText([]byte(da.SNwkSIntKey)); err != nil {
+		return act, grpc.Errorf(codes.InvalidArgument, "sNwkSIntKey: %s", err)
+	}
+	if err := act.fNwkSIntKey.UnmarshalText([]byte(da.FNwkSIntKey)); err != nil {
+		return act, grpc.Errorf(codes.InvalidArgument, "fNwkSIntKey: %s", err)
+	}
+
+	return act, nil
+}
+
+// activateDevice replaces the device-session of the given device on the
+// network-server by the given activation and stores the activation.
+func activateDevice(d storage.Device, act deviceActivation) error {
+	n, err := storage.GetNetworkServerForDevEUI(storage.DB(), d.DevEUI)
+	if err != nil {
+		return err
+	}
+
+	nsClient, err := networkserver.GetPool().Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return err
+	}
+
+	_, _ = nsClient.DeactivateDevice(context.Background(), &ns.DeactivateDeviceRequest{
+		DevEui: d.DevEUI[:],
+	})
+
+	actReq := ns.ActivateDeviceRequest{
+		DeviceActivation: &ns.DeviceActivation{
+			DevEui:      d.DevEUI[:],
+			DevAddr:     act.devAddr[:],
+			NwkSEncKey:  act.nwkSEncKey[:],
+			SNwkSIntKey: act.sNwkSIntKey[:],
+			FNwkSIntKey: act.fNwkSIntKey[:],
+			FCntUp:      act.fCntUp,
+			NFCntDown:   act.nFCntDown,
+			AFCntDown:   act.aFCntDown,
+		},
+	}
+
+	_, err = nsClient.ActivateDevice(context.Background(), &actReq)
+	if err != nil {
+		return err
+	}
+
+	return storage.CreateDeviceActivation(storage.DB(), &storage.DeviceActivation{
+		DevEUI:  d.DevEUI,
+		DevAddr: act.devAddr,
+		AppSKey: act.appSKey,
+	})
+}
+
+// getDeviceActivation returns the current activation of the given device,
+// combining the AppSKey stored by LoRa App Server with the device-session of
+// the network-server.
+func getDeviceActivation(devEUI lorawan.EUI64) (*pb.DeviceActivation, error) {
+	var devAddr lorawan.DevAddr
+	var sNwkSIntKey lorawan.AES128Key
+	var fNwkSIntKey lorawan.AES128Key
+	var nwkSEncKey lorawan.AES128Key
+
+	d, err := storage.GetDevice(storage.DB(), devEUI, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	da, err := storage.GetLastDeviceActivationForDevEUI(storage.DB(), devEUI)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := storage.GetNetworkServerForDevEUI(storage.DB(), devEUI)
+	if err != nil {
+		return nil, err
+	}
+
+	nsClient, err := networkserver.GetPool().Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return nil, err
+	}
+
+	devAct, err := nsClient.GetDeviceActivation(context.Background(), &ns.GetDeviceActivationRequest{
+		DevEui: d.DevEUI[:],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	copy(devAddr[:], devAct.DeviceActivation.DevAddr)
+	copy(nwkSEncKey[:], devAct.DeviceActivation.NwkSEncKey)
+	copy(sNwkSIntKey[:], devAct.DeviceActivation.SNwkSIntKey)
+	copy(fNwkSIntKey[:], devAct.DeviceActivation.FNwkSIntKey)
+
+	return &pb.DeviceActivation{
+		DevEui:      da.DevEUI.String(),
+		DevAddr:     devAddr.String(),
+		AppSKey:     da.AppSKey.String(),
+		NwkSEncKey:  nwkSEncKey.String(),
+		SNwkSIntKey: sNwkSIntKey.String(),
+		FNwkSIntKey: fNwkSIntKey.String(),
+		FCntUp:      devAct.DeviceActivation.FCntUp,
+		NFCntDown:   devAct.DeviceActivation.NFCntDown,
+		AFCntDown:   devAct.DeviceActivation.AFCntDown,
+	}, nil
+}
+
 func convertUplinkAndDownlinkFrames(up *gw.UplinkFrameSet, down *gw.DownlinkFrame, decodeMACCommands bool) (*pb.UplinkFrameLog, *pb.DownlinkFrameLog, error) {
 	var phy lorawan.PHYPayload
 
@@ -943,3 +1380,71 @@ func convertUplinkAndDownlinkFrames(up *gw.UplinkFrameSet, down *gw.DownlinkFram
 
 	return nil, nil, nil
 }
+
+// CreateEmbedToken creates a short-lived token giving read-only access to
+// the given view of the device.
+func (a *DeviceAPI) CreateEmbedToken(ctx context.Context, req *pb.CreateDeviceEmbedTokenRequest) (*pb.CreateDeviceEmbedTokenResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	view, ok := deviceEmbedViewFromPB[req.View]
+	if !ok {
+		return nil, helpers.ErrToRPCError(storage.ErrEmbedTokenInvalidView)
+	}
+
+	var ttl time.Duration
+	if req.Ttl != nil {
+		var err error
+		ttl, err = ptypes.Duration(req.Ttl)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+	}
+
+	// make sure the device exists
+	if _, err := storage.GetDevice(storage.DB(), devEUI, false, true); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	token, expiresAt, err := storage.CreateEmbedToken(devEUI, view, ttl)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.CreateDeviceEmbedTokenResponse{
+		Token: token,
+	}
+
+	resp.ExpiresAt, err = ptypes.TimestampProto(expiresAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if embedURLTemplate != nil {
+		var url bytes.Buffer
+		err := embedURLTemplate.Execute(&url, struct {
+			DevEUI lorawan.EUI64
+			View   string
+			Token  string
+		}{devEUI, view, token})
+		if err != nil {
+			return nil, helpers.ErrToRPCError(errors.Wrap(err, "execute embed url template error"))
+		}
+		resp.Url = url.String()
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":    devEUI,
+		"view":       view,
+		"expires_at": expiresAt,
+	}).Info("device embed token created")
+
+	return &resp, nil
+}
@@ -0,0 +1,245 @@
+package external
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/eventbus"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+var deviceConflictStatusToPB = map[storage.DeviceConflictStatus]pb.DeviceConflictStatus{
+	storage.DeviceConflictPending:  pb.DeviceConflictStatus_PENDING,
+	storage.DeviceConflictResolved: pb.DeviceConflictStatus_RESOLVED,
+	storage.DeviceConflictRejected: pb.DeviceConflictStatus_REJECTED,
+}
+
+var deviceConflictResolutionToPB = map[storage.DeviceConflictResolution]pb.DeviceConflictResolution{
+	storage.DeviceConflictReject:    pb.DeviceConflictResolution_REJECT,
+	storage.DeviceConflictForceTake: pb.DeviceConflictResolution_FORCE_TAKE,
+	storage.DeviceConflictLink:      pb.DeviceConflictResolution_LINK,
+}
+
+var deviceConflictResolutionFromPB = map[pb.DeviceConflictResolution]storage.DeviceConflictResolution{
+	pb.DeviceConflictResolution_REJECT:     storage.DeviceConflictReject,
+	pb.DeviceConflictResolution_FORCE_TAKE: storage.DeviceConflictForceTake,
+	pb.DeviceConflictResolution_LINK:       storage.DeviceConflictLink,
+}
+
+// DeviceConflictAPI implements the device conflict api.
+type DeviceConflictAPI struct {
+	validator auth.Validator
+}
+
+// NewDeviceConflictAPI creates a new DeviceConflictAPI.
+func NewDeviceConflictAPI(validator auth.Validator) *DeviceConflictAPI {
+	return &DeviceConflictAPI{
+		validator: validator,
+	}
+}
+
+// Get returns the device conflict matching the given id.
+func (a *DeviceConflictAPI) Get(ctx context.Context, req *pb.GetDeviceConflictRequest) (*pb.GetDeviceConflictResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceConflictAccess(auth.Read, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	isAdmin, err := a.validator.GetIsAdmin(ctx)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	c, err := storage.GetDeviceConflict(storage.DB(), req.Id, false)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out, err := deviceConflictToPB(c, isAdmin)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.GetDeviceConflictResponse{
+		DeviceConflict: out,
+	}, nil
+}
+
+// List lists the device conflicts.
+func (a *DeviceConflictAPI) List(ctx context.Context, req *pb.ListDeviceConflictRequest) (*pb.ListDeviceConflictResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceConflictsAccess(auth.List, req.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	isAdmin, err := a.validator.GetIsAdmin(ctx)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	filters := storage.DeviceConflictFilters{
+		OrganizationID: req.OrganizationId,
+		Limit:          int(req.Limit),
+		Offset:         int(req.Offset),
+	}
+	if !req.IncludeResolved {
+		filters.Status = storage.DeviceConflictPending
+	}
+
+	count, err := storage.GetDeviceConflictCount(storage.DB(), filters)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	conflicts, err := storage.GetDeviceConflicts(storage.DB(), filters)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out := pb.ListDeviceConflictResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, c := range conflicts {
+		item, err := deviceConflictToPB(c, isAdmin)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+		out.Result = append(out.Result, item)
+	}
+
+	return &out, nil
+}
+
+// Resolve resolves the given device conflict.
+func (a *DeviceConflictAPI) Resolve(ctx context.Context, req *pb.ResolveDeviceConflictRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceConflictAccess(auth.Update, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	resolution, ok := deviceConflictResolutionFromPB[req.Resolution]
+	if !ok {
+		return nil, helpers.ErrToRPCError(storage.ErrDeviceConflictInvalidResolution)
+	}
+
+	username, err := a.validator.GetUsername(ctx)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var d *storage.Device
+
+	// as force-take and link perform remote calls to the network-server,
+	// wrap it in a transaction
+	err = storage.Transaction(func(tx sqlx.Ext) error {
+		c, err := storage.GetDeviceConflict(tx, req.Id, true)
+		if err != nil {
+			return err
+		}
+
+		d, err = storage.ResolveDeviceConflict(tx, &c, resolution, username)
+		return err
+	})
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if d != nil {
+		eventbus.Publish(eventbus.DeviceCreated, d.ApplicationID, d.DevEUI, *d)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// deviceConflictSourceToPB returns the conflict source, as seen from the
+// organization of the requested device.
+func deviceConflictSourceToPB(c storage.DeviceConflict) pb.DeviceConflictSource {
+	switch {
+	case c.Source == storage.DeviceConflictNetworkServer:
+		return pb.DeviceConflictSource_CONFLICT_NETWORK_SERVER
+	case c.ExistingOrganizationID != nil && *c.ExistingOrganizationID == c.OrganizationID:
+		return pb.DeviceConflictSource_CONFLICT_SAME_ORGANIZATION
+	default:
+		return pb.DeviceConflictSource_CONFLICT_OTHER_ORGANIZATION
+	}
+}
+
+// deviceConflictDetails returns the conflict details for the device create
+// error. The existing application is only exposed within the same
+// organization.
+func deviceConflictDetails(c storage.DeviceConflict) *pb.DeviceConflictDetails {
+	out := pb.DeviceConflictDetails{
+		ConflictId: c.ID,
+		DevEui:     c.DevEUI.String(),
+		Source:     deviceConflictSourceToPB(c),
+	}
+
+	if out.Source == pb.DeviceConflictSource_CONFLICT_SAME_ORGANIZATION && c.ExistingApplicationID != nil {
+		out.ExistingApplicationId = *c.ExistingApplicationID
+	}
+
+	return &out
+}
+
+// deviceConflictError records the conflict for the given device, which
+// could not be created as its DevEUI is already in use, and returns the
+// error including the conflict details.
+func deviceConflictError(d storage.Device) error {
+	c, err := storage.CreateDeviceConflict(storage.DB(), d)
+	if err != nil {
+		log.WithError(err).WithField("dev_eui", d.DevEUI).Error("create device conflict error")
+		return helpers.ErrorWithCode(codes.AlreadyExists, pb.ErrorCode_DUPLICATE_DEV_EUI, "device with DevEUI %s already exists", d.DevEUI)
+	}
+
+	return helpers.ErrorWithDetails(codes.AlreadyExists, &pb.ErrorDetails{
+		Code:           pb.ErrorCode_DUPLICATE_DEV_EUI,
+		DeviceConflict: deviceConflictDetails(c),
+	}, "device with DevEUI %s already exists (conflict id: %d)", d.DevEUI, c.ID)
+}
+
+// deviceConflictToPB returns the given device conflict. As the existing
+// device might belong to an other organization, its organization and
+// application are only exposed to global admin users.
+func deviceConflictToPB(c storage.DeviceConflict, isAdmin bool) (*pb.DeviceConflict, error) {
+	out := pb.DeviceConflict{
+		Id:              c.ID,
+		DevEui:          c.DevEUI.String(),
+		OrganizationId:  c.OrganizationID,
+		ApplicationId:   c.ApplicationID,
+		DeviceProfileId: c.DeviceProfileID.String(),
+		Name:            c.Name,
+		Description:     c.Description,
+		Source:          deviceConflictSourceToPB(c),
+		Status:          deviceConflictStatusToPB[c.Status],
+		Resolution:      deviceConflictResolutionToPB[c.Resolution],
+		ResolvedBy:      c.ResolvedBy,
+	}
+
+	if isAdmin && c.ExistingOrganizationID != nil {
+		out.ExistingOrganizationId = *c.ExistingOrganizationID
+	}
+	if isAdmin && c.ExistingApplicationID != nil {
+		out.ExistingApplicationId = *c.ExistingApplicationID
+	}
+
+	var err error
+	out.CreatedAt, err = ptypes.TimestampProto(c.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	out.UpdatedAt, err = ptypes.TimestampProto(c.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,218 @@
+package external
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// DeviceFilterAPI implements the device filter api.
+type DeviceFilterAPI struct {
+	validator auth.Validator
+}
+
+// NewDeviceFilterAPI creates a new DeviceFilterAPI.
+func NewDeviceFilterAPI(validator auth.Validator) *DeviceFilterAPI {
+	return &DeviceFilterAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given device filter.
+func (a *DeviceFilterAPI) Create(ctx context.Context, req *pb.CreateDeviceFilterRequest) (*pb.CreateDeviceFilterResponse, error) {
+	if req.DeviceFilter == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_filter must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceFiltersAccess(auth.Create, req.DeviceFilter.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	f := storage.DeviceFilter{
+		OrganizationID: req.DeviceFilter.OrganizationId,
+	}
+	if err := deviceFilterFromPB(req.DeviceFilter, &f); err != nil {
+		return nil, err
+	}
+
+	if err := storage.CreateDeviceFilter(storage.DB(), &f); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.CreateDeviceFilterResponse{
+		Id: f.ID,
+	}, nil
+}
+
+// Get returns the device filter matching the given id.
+func (a *DeviceFilterAPI) Get(ctx context.Context, req *pb.GetDeviceFilterRequest) (*pb.GetDeviceFilterResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceFilterAccess(auth.Read, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	f, err := storage.GetDeviceFilter(storage.DB(), req.Id)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out := pb.GetDeviceFilterResponse{
+		DeviceFilter: &pb.DeviceFilter{
+			Id:             f.ID,
+			OrganizationId: f.OrganizationID,
+			Name:           f.Name,
+			Search:         f.Search,
+		},
+	}
+
+	if f.ApplicationID != nil {
+		out.DeviceFilter.ApplicationId = *f.ApplicationID
+	}
+	if f.DeviceProfileID != nil {
+		out.DeviceFilter.DeviceProfileId = f.DeviceProfileID.String()
+	}
+	if f.LastSeenOlderThan != nil {
+		out.DeviceFilter.LastSeenOlderThan = *f.LastSeenOlderThan
+	}
+	if f.BatteryBelow != nil {
+		out.DeviceFilter.BatteryBelow = *f.BatteryBelow
+	}
+
+	out.CreatedAt, err = ptypes.TimestampProto(f.CreatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out.UpdatedAt, err = ptypes.TimestampProto(f.UpdatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &out, nil
+}
+
+// Update updates the given device filter.
+func (a *DeviceFilterAPI) Update(ctx context.Context, req *pb.UpdateDeviceFilterRequest) (*empty.Empty, error) {
+	if req.DeviceFilter == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_filter must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceFilterAccess(auth.Update, req.DeviceFilter.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	f, err := storage.GetDeviceFilter(storage.DB(), req.DeviceFilter.Id)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if err := deviceFilterFromPB(req.DeviceFilter, &f); err != nil {
+		return nil, err
+	}
+
+	if err := storage.UpdateDeviceFilter(storage.DB(), &f); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// Delete deletes the device filter matching the given id.
+func (a *DeviceFilterAPI) Delete(ctx context.Context, req *pb.DeleteDeviceFilterRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceFilterAccess(auth.Delete, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteDeviceFilter(storage.DB(), req.Id); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// List lists the device filters of the given organization.
+func (a *DeviceFilterAPI) List(ctx context.Context, req *pb.ListDeviceFilterRequest) (*pb.ListDeviceFilterResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceFiltersAccess(auth.List, req.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetDeviceFilterCount(storage.DB(), req.OrganizationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	filters, err := storage.GetDeviceFilters(storage.DB(), req.OrganizationId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out := pb.ListDeviceFilterResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, f := range filters {
+		item := pb.DeviceFilterListItem{
+			Id:   f.ID,
+			Name: f.Name,
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(f.CreatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		item.UpdatedAt, err = ptypes.TimestampProto(f.UpdatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		out.Result = append(out.Result, &item)
+	}
+
+	return &out, nil
+}
+
+// deviceFilterFromPB sets the (updatable) fields of the given device filter
+// from the API object. Zero values are stored as unset filter conditions.
+func deviceFilterFromPB(in *pb.DeviceFilter, f *storage.DeviceFilter) error {
+	f.Name = in.Name
+	f.Search = in.Search
+	f.ApplicationID = nil
+	f.DeviceProfileID = nil
+	f.LastSeenOlderThan = nil
+	f.BatteryBelow = nil
+
+	if in.ApplicationId != 0 {
+		f.ApplicationID = &in.ApplicationId
+	}
+
+	if in.DeviceProfileId != "" {
+		dpID, err := uuid.FromString(in.DeviceProfileId)
+		if err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "device_profile_id: %s", err)
+		}
+		f.DeviceProfileID = &dpID
+	}
+
+	if in.LastSeenOlderThan != 0 {
+		f.LastSeenOlderThan = &in.LastSeenOlderThan
+	}
+
+	if in.BatteryBelow != 0 {
+		f.BatteryBelow = &in.BatteryBelow
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package external
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *APITestSuite) TestDeviceFilter() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	validator := &TestValidator{}
+	api := NewDeviceFilterAPI(validator)
+	deviceAPI := NewDeviceAPI(validator)
+
+	n := storage.NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(storage.CreateNetworkServer(storage.DB(), &n))
+
+	org := storage.Organization{
+		Name: "test-org",
+	}
+	assert.NoError(storage.CreateOrganization(storage.DB(), &org))
+
+	sp := storage.ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(storage.CreateServiceProfile(storage.DB(), &sp))
+
+	app := storage.Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(storage.CreateApplication(storage.DB(), &app))
+
+	dp := storage.DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(storage.CreateDeviceProfile(storage.DB(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	batteryLow := float32(10)
+	batteryHigh := float32(90)
+	devices := []storage.Device{
+		{DevEUI: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, Name: "device-1", ApplicationID: app.ID, DeviceProfileID: dpID, DeviceStatusBattery: &batteryLow},
+		{DevEUI: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, Name: "device-2", ApplicationID: app.ID, DeviceProfileID: dpID, DeviceStatusBattery: &batteryHigh},
+	}
+	for i := range devices {
+		assert.NoError(storage.CreateDevice(storage.DB(), &devices[i]))
+	}
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		createReq := pb.CreateDeviceFilterRequest{
+			DeviceFilter: &pb.DeviceFilter{
+				OrganizationId:  org.ID,
+				Name:            "low battery",
+				ApplicationId:   app.ID,
+				DeviceProfileId: dpID.String(),
+				BatteryBelow:    25,
+			},
+		}
+
+		createResp, err := api.Create(context.Background(), &createReq)
+		assert.NoError(err)
+		assert.NotEqual(0, createResp.Id)
+		createReq.DeviceFilter.Id = createResp.Id
+
+		t.Run("Invalid battery", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.Create(context.Background(), &pb.CreateDeviceFilterRequest{
+				DeviceFilter: &pb.DeviceFilter{
+					OrganizationId: org.ID,
+					Name:           "invalid",
+					BatteryBelow:   150,
+				},
+			})
+			assert.Equal(codes.InvalidArgument, grpc.Code(err))
+		})
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			getResp, err := api.Get(context.Background(), &pb.GetDeviceFilterRequest{
+				Id: createResp.Id,
+			})
+			assert.NoError(err)
+			assert.Equal(createReq.DeviceFilter, getResp.DeviceFilter)
+			assert.NotNil(getResp.CreatedAt)
+			assert.NotNil(getResp.UpdatedAt)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			listResp, err := api.List(context.Background(), &pb.ListDeviceFilterRequest{
+				OrganizationId: org.ID,
+				Limit:          10,
+			})
+			assert.NoError(err)
+			assert.EqualValues(1, listResp.TotalCount)
+			assert.Len(listResp.Result, 1)
+			assert.Equal("low battery", listResp.Result[0].Name)
+		})
+
+		t.Run("List devices", func(t *testing.T) {
+			assert := require.New(t)
+
+			listResp, err := deviceAPI.List(context.Background(), &pb.ListDeviceRequest{
+				DeviceFilterId: createResp.Id,
+				Limit:          10,
+			})
+			assert.NoError(err)
+			assert.EqualValues(1, listResp.TotalCount)
+			assert.Len(listResp.Result, 1)
+			assert.Equal("device-1", listResp.Result[0].Name)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			updateReq := pb.UpdateDeviceFilterRequest{
+				DeviceFilter: &pb.DeviceFilter{
+					Id:                createResp.Id,
+					OrganizationId:    org.ID,
+					Name:              "not seen",
+					LastSeenOlderThan: 3600,
+				},
+			}
+			_, err := api.Update(context.Background(), &updateReq)
+			assert.NoError(err)
+
+			getResp, err := api.Get(context.Background(), &pb.GetDeviceFilterRequest{
+				Id: createResp.Id,
+			})
+			assert.NoError(err)
+			assert.Equal(updateReq.DeviceFilter, getResp.DeviceFilter)
+
+			// both devices have never been seen
+			listResp, err := deviceAPI.List(context.Background(), &pb.ListDeviceRequest{
+				DeviceFilterId: createResp.Id,
+				Limit:          10,
+			})
+			assert.NoError(err)
+			assert.EqualValues(2, listResp.TotalCount)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.Delete(context.Background(), &pb.DeleteDeviceFilterRequest{
+				Id: createResp.Id,
+			})
+			assert.NoError(err)
+
+			_, err = api.Delete(context.Background(), &pb.DeleteDeviceFilterRequest{
+				Id: createResp.Id,
+			})
+			assert.Equal(codes.NotFound, grpc.Code(err))
+		})
+	})
+}
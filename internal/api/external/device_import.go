@@ -0,0 +1,299 @@
+package external
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/eventbus"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+const (
+	// maxDeviceImportRows defines the max number of devices per import.
+	maxDeviceImportRows = 10000
+
+	// deviceImportBatchSize defines the number of devices created per
+	// transaction.
+	deviceImportBatchSize = 100
+)
+
+// deviceImportRow contains the (raw) columns of a device import row.
+type deviceImportRow struct {
+	Row             int
+	DevEUI          string
+	Name            string
+	Description     string
+	DeviceProfileID string
+	AppKey          string
+}
+
+// deviceImportItem contains a validated device import row.
+type deviceImportItem struct {
+	row        deviceImportRow
+	device     storage.Device
+	deviceKeys *storage.DeviceKeys
+	err        error
+}
+
+// Import creates the devices and their keys from the given CSV document, in
+// transactions of deviceImportBatchSize devices. Invalid rows and rows for
+// which the device could not be created are returned in the error report.
+// When a transaction can not be committed, the import is aborted and the
+// report of the rows processed so far is returned.
+func (a *DeviceAPI) Import(ctx context.Context, req *pb.ImportDevicesRequest) (*pb.ImportDevicesResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodesAccess(req.ApplicationId, auth.Create)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	rows, err := deviceImportRowsFromCSV(req.Csv)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "parse csv error: %s", err)
+	}
+	if len(rows) > maxDeviceImportRows {
+		return nil, grpc.Errorf(codes.InvalidArgument, "the csv document must not contain more than %d rows", maxDeviceImportRows)
+	}
+
+	app, err := storage.GetApplication(storage.DB(), req.ApplicationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	items := validateDeviceImportRows(app, req.DeviceProfileId, rows)
+	var valid []*deviceImportItem
+	for i := range items {
+		if items[i].err == nil {
+			valid = append(valid, &items[i])
+		}
+	}
+
+	var resp pb.ImportDevicesResponse
+
+	for start := 0; start < len(valid); start += deviceImportBatchSize {
+		end := start + deviceImportBatchSize
+		if end > len(valid) {
+			end = len(valid)
+		}
+		batch := valid[start:end]
+
+		err := storage.Transaction(func(tx sqlx.Ext) error {
+			for _, item := range batch {
+				item.err = storage.ImportDevice(tx, &item.device, item.deviceKeys)
+			}
+			return nil
+		})
+		if err != nil {
+			// the devices of this batch might have been created on the
+			// network-server, the devices of the next batches are skipped
+			deleteDeviceImportBatch(batch, err)
+			for _, item := range valid[end:] {
+				item.err = grpc.Errorf(codes.Aborted, "import aborted, an earlier batch failed")
+			}
+			break
+		}
+
+		for _, item := range batch {
+			if item.err == nil {
+				resp.CreatedCount++
+				eventbus.Publish(eventbus.DeviceCreated, item.device.ApplicationID, item.device.DevEUI, item.device)
+				continue
+			}
+
+			// the device might exist in the database or on the network-server
+			if cause := errors.Cause(item.err); cause == storage.ErrAlreadyExists || grpc.Code(cause) == codes.AlreadyExists {
+				item.err = deviceConflictError(item.device)
+			}
+		}
+	}
+
+	for _, item := range items {
+		if item.err == nil {
+			continue
+		}
+
+		resp.Errors = append(resp.Errors, &pb.ImportDeviceError{
+			Row:    uint32(item.row.Row),
+			DevEui: item.row.DevEUI,
+			Error:  grpc.ErrorDesc(helpers.ErrToRPCError(item.err)),
+		})
+	}
+
+	return &resp, nil
+}
+
+// deleteDeviceImportBatch deletes the devices of the given batch, of which
+// the transaction could not be committed, from the network-server and sets
+// the given error on the items that were created.
+func deleteDeviceImportBatch(batch []*deviceImportItem, commitErr error) {
+	for _, item := range batch {
+		if item.err != nil {
+			continue
+		}
+		item.err = commitErr
+
+		n, err := storage.GetNetworkServerForDeviceProfileID(storage.DB(), item.device.DeviceProfileID)
+		if err != nil {
+			log.WithError(err).WithField("dev_eui", item.device.DevEUI).Error("get network-server error")
+			continue
+		}
+
+		nsClient, err := networkserver.GetPool().Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+		if err != nil {
+			log.WithError(err).WithField("dev_eui", item.device.DevEUI).Error("get network-server client error")
+			continue
+		}
+
+		_, err = nsClient.DeleteDevice(context.Background(), &ns.DeleteDeviceRequest{
+			DevEui: item.device.DevEUI[:],
+		})
+		if err != nil && grpc.Code(err) != codes.NotFound {
+			log.WithError(err).WithField("dev_eui", item.device.DevEUI).Error("network-server delete device api error")
+		}
+	}
+}
+
+// validateDeviceImportRows validates the given rows and returns the devices
+// to create for the given application. The error of an invalid row is set
+// on its item.
+func validateDeviceImportRows(app storage.Application, defaultDeviceProfileID string, rows []deviceImportRow) []deviceImportItem {
+	// device-profile id => error
+	deviceProfiles := make(map[uuid.UUID]error)
+	devEUIs := make(map[lorawan.EUI64]int)
+
+	out := make([]deviceImportItem, len(rows))
+	for i, row := range rows {
+		out[i].row = row
+		item := &out[i]
+
+		if err := item.device.DevEUI.UnmarshalText([]byte(row.DevEUI)); err != nil {
+			item.err = grpc.Errorf(codes.InvalidArgument, "invalid dev_eui: %s", err)
+			continue
+		}
+		if prev, ok := devEUIs[item.device.DevEUI]; ok {
+			item.err = grpc.Errorf(codes.InvalidArgument, "duplicate dev_eui, already at row %d", prev)
+			continue
+		}
+		devEUIs[item.device.DevEUI] = row.Row
+
+		dpID := row.DeviceProfileID
+		if dpID == "" {
+			dpID = defaultDeviceProfileID
+		}
+		var err error
+		item.device.DeviceProfileID, err = uuid.FromString(dpID)
+		if err != nil {
+			item.err = grpc.Errorf(codes.InvalidArgument, "invalid device_profile_id: %s", err)
+			continue
+		}
+
+		dpErr, ok := deviceProfiles[item.device.DeviceProfileID]
+		if !ok {
+			dp, err := storage.GetDeviceProfile(storage.DB(), item.device.DeviceProfileID)
+			if err != nil {
+				dpErr = err
+			} else if dp.OrganizationID != app.OrganizationID && !dp.IsShared {
+				dpErr = grpc.Errorf(codes.InvalidArgument, "device-profile does not belong to the organization of the application")
+			}
+			deviceProfiles[item.device.DeviceProfileID] = dpErr
+		}
+		if dpErr != nil {
+			item.err = dpErr
+			continue
+		}
+
+		if row.AppKey != "" {
+			if app.MasterKey != nil {
+				item.err = grpc.Errorf(codes.InvalidArgument, "app_key must not be set, the device-keys are derived from the application master-key")
+				continue
+			}
+
+			var key lorawan.AES128Key
+			if err := key.UnmarshalText([]byte(row.AppKey)); err != nil {
+				item.err = grpc.Errorf(codes.InvalidArgument, "invalid app_key: %s", err)
+				continue
+			}
+
+			// the LoRaWAN 1.0.x AppKey is stored as network root key
+			item.deviceKeys = &storage.DeviceKeys{
+				NwkKey: key,
+			}
+		}
+
+		item.device.ApplicationID = app.ID
+		item.device.Name = row.Name
+		item.device.Description = row.Description
+
+		// if Name is "", set it to the DevEUI
+		if item.device.Name == "" {
+			item.device.Name = item.device.DevEUI.String()
+		}
+	}
+
+	return out
+}
+
+// deviceImportRowsFromCSV parses the given CSV document. Missing columns
+// are left blank, unknown columns are ignored.
+func deviceImportRowsFromCSV(s string) ([]deviceImportRow, error) {
+	r := csv.NewReader(strings.NewReader(s))
+	r.TrimLeadingSpace = true
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "read header error")
+	}
+
+	cols := make(map[string]int)
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := cols["dev_eui"]; !ok {
+		return nil, errors.New("dev_eui column is missing")
+	}
+
+	var out []deviceImportRow
+	for row := 2; ; row++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		get := func(name string) string {
+			i, ok := cols[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		out = append(out, deviceImportRow{
+			Row:             row,
+			DevEUI:          get("dev_eui"),
+			Name:            get("name"),
+			Description:     get("description"),
+			DeviceProfileID: get("device_profile_id"),
+			AppKey:          get("app_key"),
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,232 @@
+package external
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/provisioning"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// minExportPassphraseLength defines the min length of the passphrase used
+// for encrypting the exported keys.
+const minExportPassphraseLength = 8
+
+// DeviceKeyBatchAPI implements the device-key batch api.
+type DeviceKeyBatchAPI struct {
+	validator auth.Validator
+}
+
+// NewDeviceKeyBatchAPI creates a new DeviceKeyBatchAPI.
+func NewDeviceKeyBatchAPI(validator auth.Validator) *DeviceKeyBatchAPI {
+	return &DeviceKeyBatchAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given batch.
+func (a *DeviceKeyBatchAPI) Create(ctx context.Context, req *pb.CreateDeviceKeyBatchRequest) (*pb.CreateDeviceKeyBatchResponse, error) {
+	if req.Batch == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "batch must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateIsOrganizationAdmin(req.Batch.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	b := storage.DeviceKeyBatch{
+		OrganizationID: req.Batch.OrganizationId,
+		Name:           req.Batch.Name,
+		Count:          int(req.Batch.Count),
+	}
+
+	if err := b.JoinEUI.UnmarshalText([]byte(req.Batch.JoinEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "join_eui: %s", err)
+	}
+	if err := b.DevEUIBlockStart.UnmarshalText([]byte(req.Batch.DevEuiBlockStart)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "dev_eui_block_start: %s", err)
+	}
+	if err := b.DevEUIBlockEnd.UnmarshalText([]byte(req.Batch.DevEuiBlockEnd)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "dev_eui_block_end: %s", err)
+	}
+
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		return storage.CreateDeviceKeyBatch(tx, &b)
+	})
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.CreateDeviceKeyBatchResponse{
+		Id: b.ID,
+	}, nil
+}
+
+// Get returns the batch for the given id.
+func (a *DeviceKeyBatchAPI) Get(ctx context.Context, req *pb.GetDeviceKeyBatchRequest) (*pb.GetDeviceKeyBatchResponse, error) {
+	b, err := a.getBatch(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := pb.GetDeviceKeyBatchResponse{
+		Batch: &pb.DeviceKeyBatch{
+			Id:               b.ID,
+			OrganizationId:   b.OrganizationID,
+			Name:             b.Name,
+			JoinEui:          b.JoinEUI.String(),
+			DevEuiBlockStart: b.DevEUIBlockStart.String(),
+			DevEuiBlockEnd:   b.DevEUIBlockEnd.String(),
+			Count:            uint32(b.Count),
+		},
+	}
+
+	resp.CreatedAt, err = ptypes.TimestampProto(b.CreatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	resp.UpdatedAt, err = ptypes.TimestampProto(b.UpdatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// Delete deletes the batch for the given id.
+func (a *DeviceKeyBatchAPI) Delete(ctx context.Context, req *pb.DeleteDeviceKeyBatchRequest) (*empty.Empty, error) {
+	if _, err := a.getBatch(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := storage.DeleteDeviceKeyBatch(storage.DB(), req.Id); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// List lists the batches of the given organization.
+func (a *DeviceKeyBatchAPI) List(ctx context.Context, req *pb.ListDeviceKeyBatchRequest) (*pb.ListDeviceKeyBatchResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateIsOrganizationAdmin(req.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetDeviceKeyBatchCount(storage.DB(), req.OrganizationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	items, err := storage.GetDeviceKeyBatches(storage.DB(), req.OrganizationId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.ListDeviceKeyBatchResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, item := range items {
+		row := pb.DeviceKeyBatchListItem{
+			Id:            item.ID,
+			Name:          item.Name,
+			JoinEui:       item.JoinEUI.String(),
+			Count:         uint32(item.Count),
+			ConsumedCount: uint32(item.ConsumedCount),
+		}
+
+		row.CreatedAt, err = ptypes.TimestampProto(item.CreatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &row)
+	}
+
+	return &resp, nil
+}
+
+// Export returns the keys of the batch as encrypted CSV.
+func (a *DeviceKeyBatchAPI) Export(ctx context.Context, req *pb.ExportDeviceKeyBatchRequest) (*pb.ExportDeviceKeyBatchResponse, error) {
+	b, err := a.getBatch(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Passphrase) < minExportPassphraseLength {
+		return nil, grpc.Errorf(codes.InvalidArgument, "passphrase must be at least %d characters long", minExportPassphraseLength)
+	}
+
+	keys, err := storage.GetDeviceKeysForBatch(storage.DB(), b.ID)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	csv, err := provisioning.ExportCSV(b, keys)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	data, err := provisioning.Encrypt(req.Passphrase, csv)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.ExportDeviceKeyBatchResponse{
+		Data: data,
+	}, nil
+}
+
+// GetReconciliation returns the reconciliation report of the batch.
+func (a *DeviceKeyBatchAPI) GetReconciliation(ctx context.Context, req *pb.GetDeviceKeyBatchReconciliationRequest) (*pb.GetDeviceKeyBatchReconciliationResponse, error) {
+	b, err := a.getBatch(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := storage.GetDeviceKeyBatchReconciliation(storage.DB(), b.ID)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.GetDeviceKeyBatchReconciliationResponse{
+		TotalCount:       uint32(r.TotalCount),
+		UnusedCount:      uint32(r.UnusedCount),
+		ProvisionedCount: uint32(r.ProvisionedCount),
+		ConsumedCount:    uint32(r.ConsumedCount),
+	}
+
+	for _, devEUI := range r.NotJoined {
+		resp.NotJoinedDevEuis = append(resp.NotJoinedDevEuis, devEUI.String())
+	}
+	for _, devEUI := range r.KeyMismatch {
+		resp.KeyMismatchDevEuis = append(resp.KeyMismatchDevEuis, devEUI.String())
+	}
+
+	return &resp, nil
+}
+
+// getBatch returns the batch for the given id, after validating that the
+// client is an admin of the organization of the batch.
+func (a *DeviceKeyBatchAPI) getBatch(ctx context.Context, id int64) (storage.DeviceKeyBatch, error) {
+	b, err := storage.GetDeviceKeyBatch(storage.DB(), id)
+	if err != nil {
+		return b, helpers.ErrToRPCError(err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateIsOrganizationAdmin(b.OrganizationID)); err != nil {
+		return b, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,147 @@
+package external
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/provisioning"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func (ts *APITestSuite) TestDeviceKeyBatch() {
+	assert := require.New(ts.T())
+
+	validator := &TestValidator{}
+	api := NewDeviceKeyBatchAPI(validator)
+
+	org := storage.Organization{
+		Name: "test-org",
+	}
+	assert.NoError(storage.CreateOrganization(storage.DB(), &org))
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		createReq := pb.CreateDeviceKeyBatchRequest{
+			Batch: &pb.DeviceKeyBatch{
+				OrganizationId:   org.ID,
+				Name:             "batch-1",
+				JoinEui:          "0807060504030201",
+				DevEuiBlockStart: "0000000000000001",
+				DevEuiBlockEnd:   "00000000000000ff",
+				Count:            2,
+			},
+		}
+
+		createResp, err := api.Create(context.Background(), &createReq)
+		assert.NoError(err)
+		assert.NotEqual(0, createResp.Id)
+		createReq.Batch.Id = createResp.Id
+
+		t.Run("Invalid count", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.Create(context.Background(), &pb.CreateDeviceKeyBatchRequest{
+				Batch: &pb.DeviceKeyBatch{
+					OrganizationId:   org.ID,
+					JoinEui:          "0807060504030201",
+					DevEuiBlockStart: "0000000000000001",
+					DevEuiBlockEnd:   "00000000000000ff",
+				},
+			})
+			assert.Equal(codes.InvalidArgument, grpc.Code(err))
+		})
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			getResp, err := api.Get(context.Background(), &pb.GetDeviceKeyBatchRequest{
+				Id: createResp.Id,
+			})
+			assert.NoError(err)
+			assert.Equal(createReq.Batch, getResp.Batch)
+			assert.NotNil(getResp.CreatedAt)
+			assert.NotNil(getResp.UpdatedAt)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			listResp, err := api.List(context.Background(), &pb.ListDeviceKeyBatchRequest{
+				OrganizationId: org.ID,
+				Limit:          10,
+			})
+			assert.NoError(err)
+			assert.EqualValues(1, listResp.TotalCount)
+			assert.Len(listResp.Result, 1)
+			assert.Equal("batch-1", listResp.Result[0].Name)
+			assert.EqualValues(2, listResp.Result[0].Count)
+		})
+
+		t.Run("Export", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.Export(context.Background(), &pb.ExportDeviceKeyBatchRequest{
+				Id:         createResp.Id,
+				Passphrase: "short",
+			})
+			assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+			exportResp, err := api.Export(context.Background(), &pb.ExportDeviceKeyBatchRequest{
+				Id:         createResp.Id,
+				Passphrase: "factory-secret",
+			})
+			assert.NoError(err)
+
+			keys, err := storage.GetDeviceKeysForBatch(storage.DB(), createResp.Id)
+			assert.NoError(err)
+
+			b, err := storage.GetDeviceKeyBatch(storage.DB(), createResp.Id)
+			assert.NoError(err)
+
+			expected, err := provisioning.ExportCSV(b, keys)
+			assert.NoError(err)
+
+			csv, err := provisioning.Decrypt("factory-secret", exportResp.Data)
+			assert.NoError(err)
+			assert.Equal(expected, csv)
+		})
+
+		t.Run("GetReconciliation", func(t *testing.T) {
+			assert := require.New(t)
+
+			keys, err := storage.GetDeviceKeysForBatch(storage.DB(), createResp.Id)
+			assert.NoError(err)
+			assert.NoError(storage.ConsumeDeviceKey(storage.DB(), keys[0].DevEUI))
+
+			resp, err := api.GetReconciliation(context.Background(), &pb.GetDeviceKeyBatchReconciliationRequest{
+				Id: createResp.Id,
+			})
+			assert.NoError(err)
+			assert.Equal(pb.GetDeviceKeyBatchReconciliationResponse{
+				TotalCount:    2,
+				UnusedCount:   1,
+				ConsumedCount: 1,
+			}, *resp)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.Delete(context.Background(), &pb.DeleteDeviceKeyBatchRequest{
+				Id: createResp.Id,
+			})
+			assert.NoError(err)
+
+			_, err = api.Get(context.Background(), &pb.GetDeviceKeyBatchRequest{
+				Id: createResp.Id,
+			})
+			assert.Equal(codes.NotFound, grpc.Code(err))
+		})
+	})
+}
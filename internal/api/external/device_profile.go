@@ -1,6 +1,8 @@
 package external
 
 import (
+	"encoding/json"
+
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -15,6 +17,7 @@ import (
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/external/auth"
 	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/storage"
 )
 
@@ -42,10 +45,17 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	isAdmin, err := a.validator.GetIsAdmin(ctx)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	dp := storage.DeviceProfile{
-		OrganizationID:  req.DeviceProfile.OrganizationId,
-		NetworkServerID: req.DeviceProfile.NetworkServerId,
-		Name:            req.DeviceProfile.Name,
+		OrganizationID:      req.DeviceProfile.OrganizationId,
+		NetworkServerID:     req.DeviceProfile.NetworkServerId,
+		Name:                req.DeviceProfile.Name,
+		MaxDownlinksPerHour: int(req.DeviceProfile.MaxDownlinksPerHour),
+		MaxDownlinksPerDay:  int(req.DeviceProfile.MaxDownlinksPerDay),
 		DeviceProfile: ns.DeviceProfile{
 			SupportsClassB:     req.DeviceProfile.SupportsClassB,
 			ClassBTimeout:      req.DeviceProfile.ClassBTimeout,
@@ -69,9 +79,14 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 		},
 	}
 
+	// only global admin users are allowed to share device-profiles
+	if isAdmin {
+		dp.IsShared = req.DeviceProfile.IsShared
+	}
+
 	// as this also performs a remote call to create the device-profile
 	// on the network-server, wrap it in a transaction
-	err := storage.Transaction(func(tx sqlx.Ext) error {
+	err = storage.Transaction(func(tx sqlx.Ext) error {
 		return storage.CreateDeviceProfile(tx, &dp)
 	})
 	if err != nil {
@@ -108,29 +123,32 @@ func (a *DeviceProfileServiceAPI) Get(ctx context.Context, req *pb.GetDeviceProf
 
 	resp := pb.GetDeviceProfileResponse{
 		DeviceProfile: &pb.DeviceProfile{
-			Id:                 dpID.String(),
-			Name:               dp.Name,
-			OrganizationId:     dp.OrganizationID,
-			NetworkServerId:    dp.NetworkServerID,
-			SupportsClassB:     dp.DeviceProfile.SupportsClassB,
-			ClassBTimeout:      dp.DeviceProfile.ClassBTimeout,
-			PingSlotPeriod:     dp.DeviceProfile.PingSlotPeriod,
-			PingSlotDr:         dp.DeviceProfile.PingSlotDr,
-			PingSlotFreq:       dp.DeviceProfile.PingSlotFreq,
-			SupportsClassC:     dp.DeviceProfile.SupportsClassC,
-			ClassCTimeout:      dp.DeviceProfile.ClassCTimeout,
-			MacVersion:         dp.DeviceProfile.MacVersion,
-			RegParamsRevision:  dp.DeviceProfile.RegParamsRevision,
-			RxDelay_1:          dp.DeviceProfile.RxDelay_1,
-			RxDrOffset_1:       dp.DeviceProfile.RxDrOffset_1,
-			RxDatarate_2:       dp.DeviceProfile.RxDatarate_2,
-			RxFreq_2:           dp.DeviceProfile.RxFreq_2,
-			MaxEirp:            dp.DeviceProfile.MaxEirp,
-			MaxDutyCycle:       dp.DeviceProfile.MaxDutyCycle,
-			SupportsJoin:       dp.DeviceProfile.SupportsJoin,
-			RfRegion:           dp.DeviceProfile.RfRegion,
-			Supports_32BitFCnt: dp.DeviceProfile.Supports_32BitFCnt,
-			FactoryPresetFreqs: dp.DeviceProfile.FactoryPresetFreqs,
+			Id:                  dpID.String(),
+			Name:                dp.Name,
+			OrganizationId:      dp.OrganizationID,
+			NetworkServerId:     dp.NetworkServerID,
+			IsShared:            dp.IsShared,
+			MaxDownlinksPerHour: uint32(dp.MaxDownlinksPerHour),
+			MaxDownlinksPerDay:  uint32(dp.MaxDownlinksPerDay),
+			SupportsClassB:      dp.DeviceProfile.SupportsClassB,
+			ClassBTimeout:       dp.DeviceProfile.ClassBTimeout,
+			PingSlotPeriod:      dp.DeviceProfile.PingSlotPeriod,
+			PingSlotDr:          dp.DeviceProfile.PingSlotDr,
+			PingSlotFreq:        dp.DeviceProfile.PingSlotFreq,
+			SupportsClassC:      dp.DeviceProfile.SupportsClassC,
+			ClassCTimeout:       dp.DeviceProfile.ClassCTimeout,
+			MacVersion:          dp.DeviceProfile.MacVersion,
+			RegParamsRevision:   dp.DeviceProfile.RegParamsRevision,
+			RxDelay_1:           dp.DeviceProfile.RxDelay_1,
+			RxDrOffset_1:        dp.DeviceProfile.RxDrOffset_1,
+			RxDatarate_2:        dp.DeviceProfile.RxDatarate_2,
+			RxFreq_2:            dp.DeviceProfile.RxFreq_2,
+			MaxEirp:             dp.DeviceProfile.MaxEirp,
+			MaxDutyCycle:        dp.DeviceProfile.MaxDutyCycle,
+			SupportsJoin:        dp.DeviceProfile.SupportsJoin,
+			RfRegion:            dp.DeviceProfile.RfRegion,
+			Supports_32BitFCnt:  dp.DeviceProfile.Supports_32BitFCnt,
+			FactoryPresetFreqs:  dp.DeviceProfile.FactoryPresetFreqs,
 		},
 	}
 
@@ -163,12 +181,22 @@ func (a *DeviceProfileServiceAPI) Update(ctx context.Context, req *pb.UpdateDevi
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	isAdmin, err := a.validator.GetIsAdmin(ctx)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	dp, err := storage.GetDeviceProfile(storage.DB(), dpID)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
 
 	dp.Name = req.DeviceProfile.Name
+	dp.MaxDownlinksPerHour = int(req.DeviceProfile.MaxDownlinksPerHour)
+	dp.MaxDownlinksPerDay = int(req.DeviceProfile.MaxDownlinksPerDay)
+	if isAdmin {
+		dp.IsShared = req.DeviceProfile.IsShared
+	}
 	dp.DeviceProfile = ns.DeviceProfile{
 		Id:                 dpID.Bytes(),
 		SupportsClassB:     req.DeviceProfile.SupportsClassB,
@@ -231,6 +259,10 @@ func (a *DeviceProfileServiceAPI) Delete(ctx context.Context, req *pb.DeleteDevi
 
 // List lists the available device-profiles.
 func (a *DeviceProfileServiceAPI) List(ctx context.Context, req *pb.ListDeviceProfileRequest) (*pb.ListDeviceProfileResponse, error) {
+	if req.ApplicationId == 0 {
+		req.OrganizationId = hostOrganizationFilter(ctx, req.OrganizationId)
+	}
+
 	if req.ApplicationId != 0 {
 		if err := a.validator.Validate(ctx,
 			auth.ValidateDeviceProfilesAccess(auth.List, 0, req.ApplicationId),
@@ -312,6 +344,7 @@ func (a *DeviceProfileServiceAPI) List(ctx context.Context, req *pb.ListDevicePr
 			Name:            dp.Name,
 			OrganizationId:  dp.OrganizationID,
 			NetworkServerId: dp.NetworkServerID,
+			IsShared:        dp.IsShared,
 		}
 
 		row.CreatedAt, err = ptypes.TimestampProto(dp.CreatedAt)
@@ -328,3 +361,219 @@ func (a *DeviceProfileServiceAPI) List(ctx context.Context, req *pb.ListDevicePr
 
 	return &resp, nil
 }
+
+// Copy creates an organization owned copy of the given device-profile.
+func (a *DeviceProfileServiceAPI) Copy(ctx context.Context, req *pb.CopyDeviceProfileRequest) (*pb.CopyDeviceProfileResponse, error) {
+	dpID, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "uuid error: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceProfileAccess(auth.Read, dpID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceProfilesAccess(auth.Create, req.OrganizationId, 0),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var copyID uuid.UUID
+
+	// as this also performs a remote call to create the device-profile
+	// on the network-server, wrap it in a transaction
+	err = storage.Transaction(func(tx sqlx.Ext) error {
+		dp, err := storage.CopyDeviceProfile(tx, dpID, req.OrganizationId, req.Name)
+		if err != nil {
+			return err
+		}
+
+		copyID, err = uuid.FromBytes(dp.DeviceProfile.Id)
+		return err
+	})
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.CopyDeviceProfileResponse{
+		Id: copyID.String(),
+	}, nil
+}
+
+// GetPayloadCodecChain returns the payload codec chain of the given
+// device-profile.
+func (a *DeviceProfileServiceAPI) GetPayloadCodecChain(ctx context.Context, req *pb.GetPayloadCodecChainRequest) (*pb.GetPayloadCodecChainResponse, error) {
+	dpID, err := uuid.FromString(req.DeviceProfileId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "uuid error: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceProfileAccess(auth.Read, dpID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	chain, err := storage.GetDeviceProfileCodecChain(storage.DB(), dpID)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.GetPayloadCodecChainResponse{
+		Chain: &pb.PayloadCodecChain{},
+	}
+	for _, s := range chain.PreProcessors {
+		resp.Chain.PreProcessors = append(resp.Chain.PreProcessors, codecStageToPB(s))
+	}
+	for _, s := range chain.PostProcessors {
+		resp.Chain.PostProcessors = append(resp.Chain.PostProcessors, codecStageToPB(s))
+	}
+
+	return &resp, nil
+}
+
+// UpdatePayloadCodecChain updates the payload codec chain of the given
+// device-profile.
+func (a *DeviceProfileServiceAPI) UpdatePayloadCodecChain(ctx context.Context, req *pb.UpdatePayloadCodecChainRequest) (*empty.Empty, error) {
+	dpID, err := uuid.FromString(req.DeviceProfileId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "uuid error: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceProfileAccess(auth.Update, dpID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var chain codec.Chain
+	if req.Chain != nil {
+		for _, s := range req.Chain.PreProcessors {
+			chain.PreProcessors = append(chain.PreProcessors, codecStageFromPB(s))
+		}
+		for _, s := range req.Chain.PostProcessors {
+			chain.PostProcessors = append(chain.PostProcessors, codecStageFromPB(s))
+		}
+	}
+
+	// validate first so that the error includes the failing stage
+	if err := chain.Validate(); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	if err := storage.UpdateDeviceProfileCodecChain(storage.DB(), dpID, chain); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// TestPayloadCodecStage executes the given codec chain stage on the given
+// input.
+func (a *DeviceProfileServiceAPI) TestPayloadCodecStage(ctx context.Context, req *pb.TestPayloadCodecStageRequest) (*pb.TestPayloadCodecStageResponse, error) {
+	if req.Stage == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "stage must not be nil")
+	}
+
+	dpID, err := uuid.FromString(req.DeviceProfileId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "uuid error: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceProfileAccess(auth.Update, dpID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if req.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "f_port must be <= 255")
+	}
+
+	stage := codecStageFromPB(req.Stage)
+	if err := stage.Validate(); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	dp, err := storage.GetDeviceProfile(storage.DB(), dpID)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var resp pb.TestPayloadCodecStageResponse
+
+	if req.PreProcessor {
+		resp.Data, err = stage.ProcessBytes(dp.OrganizationID, uint8(req.FPort), req.Data)
+		if err != nil {
+			return nil, helpers.ErrorWithCode(codes.InvalidArgument, pb.ErrorCode_CODEC_ERROR, "process stage error: %s", err)
+		}
+		return &resp, nil
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(req.ObjectJson), &obj); err != nil || obj == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "object_json must contain a JSON object")
+	}
+
+	obj, err = stage.ProcessObject(dp.OrganizationID, uint8(req.FPort), obj)
+	if err != nil {
+		return nil, helpers.ErrorWithCode(codes.InvalidArgument, pb.ErrorCode_CODEC_ERROR, "process stage error: %s", err)
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	resp.ObjectJson = string(b)
+
+	return &resp, nil
+}
+
+var codecStageTypeToPB = map[codec.StageType]pb.PayloadCodecStageType{
+	codec.JSStageType:     pb.PayloadCodecStageType_CODEC_STAGE_CUSTOM_JS,
+	codec.RenameStageType: pb.PayloadCodecStageType_CODEC_STAGE_RENAME_FIELDS,
+	codec.ScaleStageType:  pb.PayloadCodecStageType_CODEC_STAGE_SCALE_FIELDS,
+}
+
+var codecStageTypeFromPB = map[pb.PayloadCodecStageType]codec.StageType{
+	pb.PayloadCodecStageType_CODEC_STAGE_CUSTOM_JS:     codec.JSStageType,
+	pb.PayloadCodecStageType_CODEC_STAGE_RENAME_FIELDS: codec.RenameStageType,
+	pb.PayloadCodecStageType_CODEC_STAGE_SCALE_FIELDS:  codec.ScaleStageType,
+}
+
+func codecStageToPB(s codec.Stage) *pb.PayloadCodecStage {
+	out := pb.PayloadCodecStage{
+		Name:    s.Name,
+		Type:    codecStageTypeToPB[s.Type],
+		Script:  s.Script,
+		Renames: s.Renames,
+	}
+	for _, sc := range s.Scales {
+		out.Scales = append(out.Scales, &pb.PayloadCodecFieldScale{
+			Field:  sc.Field,
+			Factor: sc.Factor,
+			Offset: sc.Offset,
+		})
+	}
+	return &out
+}
+
+func codecStageFromPB(s *pb.PayloadCodecStage) codec.Stage {
+	out := codec.Stage{
+		Name:    s.Name,
+		Type:    codecStageTypeFromPB[s.Type],
+		Script:  s.Script,
+		Renames: s.Renames,
+	}
+	for _, sc := range s.Scales {
+		out.Scales = append(out.Scales, codec.FieldScale{
+			Field:  sc.Field,
+			Factor: sc.Factor,
+			Offset: sc.Offset,
+		})
+	}
+	return out
+}
@@ -96,6 +96,70 @@ func TestDeviceProfileServiceAPI(t *testing.T) {
 				So(getResp.DeviceProfile, ShouldResemble, createReq.DeviceProfile)
 			})
 
+			Convey("Then UpdatePayloadCodecChain updates the codec chain", func() {
+				chain := pb.PayloadCodecChain{
+					PostProcessors: []*pb.PayloadCodecStage{
+						{
+							Name:    "rename",
+							Type:    pb.PayloadCodecStageType_CODEC_STAGE_RENAME_FIELDS,
+							Renames: map[string]string{"t": "temperature"},
+						},
+						{
+							Name:   "scale",
+							Type:   pb.PayloadCodecStageType_CODEC_STAGE_SCALE_FIELDS,
+							Scales: []*pb.PayloadCodecFieldScale{{Field: "temperature", Factor: 0.1}},
+						},
+					},
+				}
+
+				_, err := api.UpdatePayloadCodecChain(ctx, &pb.UpdatePayloadCodecChainRequest{
+					DeviceProfileId: createResp.Id,
+					Chain:           &chain,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				resp, err := api.GetPayloadCodecChain(ctx, &pb.GetPayloadCodecChainRequest{
+					DeviceProfileId: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(resp.Chain.PreProcessors, ShouldHaveLength, 0)
+				So(resp.Chain.PostProcessors, ShouldResemble, chain.PostProcessors)
+
+				Convey("Then an invalid chain returns an error including the stage", func() {
+					_, err := api.UpdatePayloadCodecChain(ctx, &pb.UpdatePayloadCodecChainRequest{
+						DeviceProfileId: createResp.Id,
+						Chain: &pb.PayloadCodecChain{
+							PreProcessors: []*pb.PayloadCodecStage{chain.PostProcessors[0]},
+						},
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+					So(grpc.ErrorDesc(err), ShouldContainSubstring, "pre-processor 0")
+				})
+
+				Convey("Then TestPayloadCodecStage executes a single stage", func() {
+					resp, err := api.TestPayloadCodecStage(ctx, &pb.TestPayloadCodecStageRequest{
+						DeviceProfileId: createResp.Id,
+						Stage:           chain.PostProcessors[1],
+						ObjectJson:      `{"temperature": 215}`,
+					})
+					So(err, ShouldBeNil)
+					So(resp.ObjectJson, ShouldEqual, `{"temperature":21.5}`)
+
+					resp, err = api.TestPayloadCodecStage(ctx, &pb.TestPayloadCodecStageRequest{
+						DeviceProfileId: createResp.Id,
+						Stage: &pb.PayloadCodecStage{
+							Type:   pb.PayloadCodecStageType_CODEC_STAGE_CUSTOM_JS,
+							Script: "function Process(fPort, data) { return data.slice(1); }",
+						},
+						PreProcessor: true,
+						Data:         []byte{1, 2, 3},
+					})
+					So(err, ShouldBeNil)
+					So(resp.Data, ShouldResemble, []byte{2, 3})
+				})
+			})
+
 			Convey("Then Update updates the device-profile", func() {
 				updateReq := pb.UpdateDeviceProfileRequest{
 					DeviceProfile: &pb.DeviceProfile{
@@ -141,6 +205,71 @@ func TestDeviceProfileServiceAPI(t *testing.T) {
 				So(getResp.DeviceProfile, ShouldResemble, updateReq.DeviceProfile)
 			})
 
+			Convey("Then Update as a non-admin user does not share the device-profile", func() {
+				getResp, err := api.Get(ctx, &pb.GetDeviceProfileRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+
+				getResp.DeviceProfile.IsShared = true
+				_, err = api.Update(ctx, &pb.UpdateDeviceProfileRequest{
+					DeviceProfile: getResp.DeviceProfile,
+				})
+				So(err, ShouldBeNil)
+
+				dp, err := storage.GetDeviceProfile(storage.DB(), uuid.Must(uuid.FromString(createResp.Id)))
+				So(err, ShouldBeNil)
+				So(dp.IsShared, ShouldBeFalse)
+			})
+
+			Convey("Given a global admin user sharing the device-profile", func() {
+				validator.returnIsAdmin = true
+
+				getResp, err := api.Get(ctx, &pb.GetDeviceProfileRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+
+				getResp.DeviceProfile.IsShared = true
+				_, err = api.Update(ctx, &pb.UpdateDeviceProfileRequest{
+					DeviceProfile: getResp.DeviceProfile,
+				})
+				So(err, ShouldBeNil)
+
+				org2 := storage.Organization{
+					Name: "test-org-2",
+				}
+				So(storage.CreateOrganization(storage.DB(), &org2), ShouldBeNil)
+
+				Convey("Then List for an other organization returns the shared device-profile", func() {
+					listResp, err := api.List(ctx, &pb.ListDeviceProfileRequest{
+						OrganizationId: org2.ID,
+						Limit:          10,
+					})
+					So(err, ShouldBeNil)
+					So(listResp.TotalCount, ShouldEqual, 1)
+					So(listResp.Result, ShouldHaveLength, 1)
+					So(listResp.Result[0].Id, ShouldEqual, createResp.Id)
+					So(listResp.Result[0].IsShared, ShouldBeTrue)
+				})
+
+				Convey("Then Copy creates a copy owned by the other organization", func() {
+					copyResp, err := api.Copy(ctx, &pb.CopyDeviceProfileRequest{
+						Id:             createResp.Id,
+						OrganizationId: org2.ID,
+						Name:           "test-dp-copy",
+					})
+					So(err, ShouldBeNil)
+					So(copyResp.Id, ShouldNotEqual, createResp.Id)
+
+					dp, err := storage.GetDeviceProfile(storage.DB(), uuid.Must(uuid.FromString(copyResp.Id)))
+					So(err, ShouldBeNil)
+					So(dp.OrganizationID, ShouldEqual, org2.ID)
+					So(dp.Name, ShouldEqual, "test-dp-copy")
+					So(dp.IsShared, ShouldBeFalse)
+				})
+			})
+
 			Convey("Then Delete deletes the device-profile", func() {
 				_, err := api.Delete(ctx, &pb.DeleteDeviceProfileRequest{
 					Id: createResp.Id,
@@ -2,9 +2,13 @@ package external
 
 import (
 	"encoding/json"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -44,6 +48,19 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 		return nil, grpc.Errorf(codes.InvalidArgument, "f_port must be > 0")
 	}
 
+	var responseTimeout time.Duration
+	if req.ResponseFPort != 0 {
+		if req.ResponseFPort > 255 || req.ResponseTimeout == nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "%s", downlink.ErrInvalidResponseWindow)
+		}
+
+		var err error
+		responseTimeout, err = ptypes.Duration(req.ResponseTimeout)
+		if err != nil || responseTimeout <= 0 || responseTimeout > downlink.MaxResponseTimeout {
+			return nil, grpc.Errorf(codes.InvalidArgument, "%s", downlink.ErrInvalidResponseWindow)
+		}
+	}
+
 	var devEUI lorawan.EUI64
 	if err := devEUI.UnmarshalText([]byte(req.DeviceQueueItem.DevEui)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
@@ -62,6 +79,10 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 			return helpers.ErrToRPCError(err)
 		}
 
+		if !dev.LifecycleState.Enabled() {
+			return helpers.ErrToRPCError(dev.LifecycleState.Err())
+		}
+
 		// if JSON object is set, try to encode it to bytes
 		if req.DeviceQueueItem.JsonObject != "" {
 			app, err := storage.GetApplication(storage.DB(), dev.ApplicationID)
@@ -70,7 +91,7 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 			}
 
 			// get codec payload configured for the application
-			codecPL := codec.NewPayload(app.PayloadCodec, uint8(req.DeviceQueueItem.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
+			codecPL := codec.NewPayload(app.PayloadCodec, app.OrganizationID, uint8(req.DeviceQueueItem.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
 			if codecPL == nil {
 				return grpc.Errorf(codes.FailedPrecondition, "no or invalid codec configured for application")
 			}
@@ -82,12 +103,18 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 
 			req.DeviceQueueItem.Data, err = codecPL.EncodeToBytes()
 			if err != nil {
-				return helpers.ErrToRPCError(err)
+				return helpers.ErrorWithCode(codes.Unknown, pb.ErrorCode_CODEC_ERROR, "encode payload error: %s", err)
 			}
 		}
 
 		fCnt, err = downlink.EnqueueDownlinkPayload(tx, devEUI, req.DeviceQueueItem.Confirmed, uint8(req.DeviceQueueItem.FPort), req.DeviceQueueItem.Data)
 		if err != nil {
+			if errors.Cause(err) == downlink.ErrMaxPayloadSizeExceeded {
+				return helpers.ErrorWithCode(codes.InvalidArgument, pb.ErrorCode_PAYLOAD_TOO_LARGE, "%s", err)
+			}
+			if rlErr, ok := errors.Cause(err).(*downlink.RateLimitError); ok {
+				return downlinkRateLimitError(rlErr)
+			}
 			return grpc.Errorf(codes.Internal, "enqueue downlink payload error: %s", err)
 		}
 
@@ -96,9 +123,26 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 		return nil, err
 	}
 
-	return &pb.EnqueueDeviceQueueItemResponse{
+	resp := pb.EnqueueDeviceQueueItemResponse{
 		FCnt: fCnt,
-	}, nil
+	}
+
+	// The downlink has been enqueued at this point. Returning an error would
+	// make the client retry and enqueue the downlink twice, therefore the
+	// failure to open the response window is only logged.
+	if req.ResponseFPort != 0 {
+		id, err := downlink.ExpectResponse(devEUI, fCnt, uint8(req.ResponseFPort), responseTimeout)
+		if err != nil {
+			log.WithError(err).WithFields(log.Fields{
+				"dev_eui": devEUI,
+				"f_cnt":   fCnt,
+			}).Error("open response window error")
+		} else {
+			resp.CorrelationId = id.String()
+		}
+	}
+
+	return &resp, nil
 }
 
 // Flush flushes the downlink device-queue.
@@ -127,7 +171,7 @@ func (d *DeviceQueueAPI) Flush(ctx context.Context, req *pb.FlushDeviceQueueRequ
 		DevEui: devEUI[:],
 	})
 	if err != nil {
-		return nil, err
+		return nil, helpers.ErrToRPCError(err)
 	}
 
 	return &empty.Empty{}, nil
@@ -164,7 +208,7 @@ func (d *DeviceQueueAPI) List(ctx context.Context, req *pb.ListDeviceQueueItemsR
 		DevEui: devEUI[:],
 	})
 	if err != nil {
-		return nil, err
+		return nil, helpers.ErrToRPCError(err)
 	}
 
 	var resp pb.ListDeviceQueueItemsResponse
@@ -185,3 +229,20 @@ func (d *DeviceQueueAPI) List(ctx context.Context, req *pb.ListDeviceQueueItemsR
 
 	return &resp, nil
 }
+
+var downlinkRateLimitPeriodToPB = map[downlink.RateLimitPeriod]pb.DownlinkRateLimitPeriod{
+	downlink.RateLimitHour: pb.DownlinkRateLimitPeriod_RATE_LIMIT_HOUR,
+	downlink.RateLimitDay:  pb.DownlinkRateLimitPeriod_RATE_LIMIT_DAY,
+}
+
+// downlinkRateLimitError returns the error including the rate-limit details.
+func downlinkRateLimitError(e *downlink.RateLimitError) error {
+	return helpers.ErrorWithDetails(codes.ResourceExhausted, &pb.ErrorDetails{
+		Code: pb.ErrorCode_DOWNLINK_RATE_LIMITED,
+		DownlinkRateLimit: &pb.DownlinkRateLimitDetails{
+			Period:       downlinkRateLimitPeriodToPB[e.Period],
+			MaxDownlinks: uint32(e.MaxDownlinks),
+			RetryAfter:   ptypes.DurationProto(e.RetryAfter),
+		},
+	}, "%s", e)
+}
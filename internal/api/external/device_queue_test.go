@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/gofrs/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
@@ -167,6 +170,36 @@ func TestDownlinkQueueAPI(t *testing.T) {
 			})
 		})
 
+		Convey("Given the device-profile has a downlink rate-limit of one downlink per day", func() {
+			test.MustFlushRedis(storage.RedisPool())
+
+			dp.MaxDownlinksPerDay = 1
+			So(storage.UpdateDeviceProfile(storage.DB(), &dp), ShouldBeNil)
+
+			req := pb.EnqueueDeviceQueueItemRequest{
+				DeviceQueueItem: &pb.DeviceQueueItem{
+					DevEui: d.DevEUI.String(),
+					FPort:  10,
+					Data:   []byte{1, 2, 3, 4},
+				},
+			}
+			_, err := api.Enqueue(ctx, &req)
+			So(err, ShouldBeNil)
+
+			Convey("Then enqueueing a second downlink returns a rate-limited error", func() {
+				_, err := api.Enqueue(ctx, &req)
+				So(grpc.Code(err), ShouldEqual, codes.ResourceExhausted)
+
+				s, _ := status.FromError(err)
+				So(s.Details(), ShouldHaveLength, 1)
+				details := s.Details()[0].(*pb.ErrorDetails)
+				So(details.Code, ShouldEqual, pb.ErrorCode_DOWNLINK_RATE_LIMITED)
+				So(details.DownlinkRateLimit.Period, ShouldEqual, pb.DownlinkRateLimitPeriod_RATE_LIMIT_DAY)
+				So(details.DownlinkRateLimit.MaxDownlinks, ShouldEqual, 1)
+				So(details.DownlinkRateLimit.RetryAfter, ShouldNotBeNil)
+			})
+		})
+
 		Convey("Given a mocked device-queue item", func() {
 			nsClient.GetDeviceQueueItemsForDevEUIResponse = ns.GetDeviceQueueItemsForDevEUIResponse{
 				Items: []*ns.DeviceQueueItem{
@@ -1,15 +1,19 @@
 package external
 
 import (
+	"fmt"
 	"net"
 	"testing"
+	"text/template"
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
@@ -116,6 +120,54 @@ func TestNodeAPI(t *testing.T) {
 			})
 		})
 
+		Convey("When importing devices from a CSV document", func() {
+			resp, err := api.Import(ctx, &pb.ImportDevicesRequest{
+				ApplicationId:   app.ID,
+				DeviceProfileId: dpID.String(),
+				Csv: "dev_eui,name,app_key,tags\n" +
+					"0102030405060708,device-1,01020304050607080102030405060708,foo\n" +
+					"0102030405060709,,\n" +
+					"invalid,device-3,\n" +
+					"0102030405060708,device-4,\n",
+			})
+			So(err, ShouldBeNil)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+			So(resp.CreatedCount, ShouldEqual, 2)
+			So(resp.Errors, ShouldHaveLength, 2)
+			So(resp.Errors[0].Row, ShouldEqual, 4)
+			So(resp.Errors[0].DevEui, ShouldEqual, "invalid")
+			So(resp.Errors[1].Row, ShouldEqual, 5)
+			So(resp.Errors[1].Error, ShouldEqual, "duplicate dev_eui, already at row 2")
+			So(nsClient.CreateDeviceChan, ShouldHaveLength, 2)
+
+			Convey("Then the devices and keys were created", func() {
+				d, err := storage.GetDevice(storage.DB(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, false, true)
+				So(err, ShouldBeNil)
+				So(d.Name, ShouldEqual, "device-1")
+
+				dk, err := storage.GetDeviceKeys(storage.DB(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+				So(err, ShouldBeNil)
+				So(dk.NwkKey, ShouldEqual, lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8})
+
+				d, err = storage.GetDevice(storage.DB(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 9}, false, true)
+				So(err, ShouldBeNil)
+				So(d.Name, ShouldEqual, "0102030405060709")
+			})
+
+			Convey("Then importing an existing device returns an error for the row", func() {
+				resp, err := api.Import(ctx, &pb.ImportDevicesRequest{
+					ApplicationId:   app.ID,
+					DeviceProfileId: dpID.String(),
+					Csv:             "dev_eui\n0102030405060708\n0102030405060710\n",
+				})
+				So(err, ShouldBeNil)
+				So(resp.CreatedCount, ShouldEqual, 1)
+				So(resp.Errors, ShouldHaveLength, 1)
+				So(resp.Errors[0].Row, ShouldEqual, 2)
+				So(resp.Errors[0].Error, ShouldEqual, "object already exists")
+			})
+		})
+
 		Convey("When creating a device", func() {
 			createReq := pb.CreateDeviceRequest{
 				Device: &pb.Device{
@@ -139,12 +191,98 @@ func TestNodeAPI(t *testing.T) {
 				Device: nsReq.Device,
 			}
 
+			Convey("Then creating a device with the same DevEUI returns a duplicate DevEUI error", func() {
+				_, err := api.Create(ctx, &createReq)
+				So(grpc.Code(err), ShouldEqual, codes.AlreadyExists)
+
+				s, _ := status.FromError(err)
+				So(s.Details(), ShouldHaveLength, 1)
+				So(s.Details()[0].(*pb.ErrorDetails).Code, ShouldEqual, pb.ErrorCode_DUPLICATE_DEV_EUI)
+
+				conflict := s.Details()[0].(*pb.ErrorDetails).DeviceConflict
+				So(conflict, ShouldNotBeNil)
+				So(conflict.ConflictId, ShouldBeGreaterThan, 0)
+				So(conflict.DevEui, ShouldEqual, "0807060504030201")
+				So(conflict.Source, ShouldEqual, pb.DeviceConflictSource_CONFLICT_SAME_ORGANIZATION)
+				So(conflict.ExistingApplicationId, ShouldEqual, app.ID)
+
+				Convey("Then the conflict can be listed and rejected", func() {
+					conflictAPI := NewDeviceConflictAPI(validator)
+					validator.returnUsername = "admin"
+
+					list, err := conflictAPI.List(ctx, &pb.ListDeviceConflictRequest{
+						OrganizationId: org.ID,
+						Limit:          10,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(list.TotalCount, ShouldEqual, 1)
+					So(list.Result[0].Id, ShouldEqual, conflict.ConflictId)
+					So(list.Result[0].Status, ShouldEqual, pb.DeviceConflictStatus_PENDING)
+					So(list.Result[0].ExistingApplicationId, ShouldEqual, 0)
+
+					validator.returnIsAdmin = true
+					list, err = conflictAPI.List(ctx, &pb.ListDeviceConflictRequest{
+						OrganizationId: org.ID,
+						Limit:          10,
+					})
+					So(err, ShouldBeNil)
+					So(list.Result[0].ExistingOrganizationId, ShouldEqual, org.ID)
+					So(list.Result[0].ExistingApplicationId, ShouldEqual, app.ID)
+					validator.returnIsAdmin = false
+
+					_, err = conflictAPI.Resolve(ctx, &pb.ResolveDeviceConflictRequest{
+						Id:         conflict.ConflictId,
+						Resolution: pb.DeviceConflictResolution_REJECT,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					resp, err := conflictAPI.Get(ctx, &pb.GetDeviceConflictRequest{
+						Id: conflict.ConflictId,
+					})
+					So(err, ShouldBeNil)
+					So(resp.DeviceConflict.Status, ShouldEqual, pb.DeviceConflictStatus_REJECTED)
+					So(resp.DeviceConflict.ResolvedBy, ShouldEqual, "admin")
+
+					_, err = conflictAPI.Resolve(ctx, &pb.ResolveDeviceConflictRequest{
+						Id:         conflict.ConflictId,
+						Resolution: pb.DeviceConflictResolution_FORCE_TAKE,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+				})
+			})
+
+			Convey("Then CreateEmbedToken returns a token and url", func() {
+				embedURLTemplate = template.Must(template.New("embed_url").Parse("https://portal.example.com/{{ .DevEUI }}/{{ .View }}?token={{ .Token }}"))
+				defer func() {
+					embedURLTemplate = nil
+				}()
+
+				resp, err := api.CreateEmbedToken(ctx, &pb.CreateDeviceEmbedTokenRequest{
+					DevEui: "0807060504030201",
+					View:   pb.DeviceEmbedView_LIVE_FRAMES,
+					Ttl:    ptypes.DurationProto(time.Minute),
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.Token, ShouldNotEqual, "")
+				So(resp.Url, ShouldEqual, "https://portal.example.com/0807060504030201/frames?token="+resp.Token)
+				So(resp.ExpiresAt, ShouldNotBeNil)
+
+				_, err = api.CreateEmbedToken(ctx, &pb.CreateDeviceEmbedTokenRequest{
+					DevEui: "0807060504030201",
+					Ttl:    ptypes.DurationProto(48 * time.Hour),
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+
 			Convey("The device has been created", func() {
 				d, err := api.Get(ctx, &pb.GetDeviceRequest{
 					DevEui: "0807060504030201",
 				})
 				So(err, ShouldBeNil)
-				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(validator.validatorFuncs, ShouldHaveLength, 2)
 				So(d.Device, ShouldResemble, createReq.Device)
 				So(d.LastSeenAt, ShouldBeNil)
 				So(d.DeviceStatusBattery, ShouldEqual, 256)
@@ -212,6 +350,81 @@ func TestNodeAPI(t *testing.T) {
 						})
 					})
 				})
+
+				Convey("When storing the device location history", func() {
+					now := time.Now().UTC().Truncate(time.Second)
+					locations := []storage.DeviceLocation{
+						{CreatedAt: now.Add(-3 * time.Minute), Source: "GPS", Latitude: 0, Longitude: 0},
+						{CreatedAt: now.Add(-2 * time.Minute), Source: "GPS", Latitude: 0.0001, Longitude: 0.001},
+						{CreatedAt: now.Add(-time.Minute), Source: "GEO_RESOLVER", Latitude: 0, Longitude: 0.002},
+					}
+					for i := range locations {
+						locations[i].DevEUI = lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+						So(storage.CreateDeviceLocation(storage.DB(), &locations[i]), ShouldBeNil)
+					}
+
+					Convey("Then GetLocationTrack returns the full track", func() {
+						resp, err := api.GetLocationTrack(ctx, &pb.GetDeviceLocationTrackRequest{
+							DevEui: "0807060504030201",
+						})
+						So(err, ShouldBeNil)
+						So(resp.Points, ShouldHaveLength, 3)
+						So(resp.Points[2].Source, ShouldEqual, common.LocationSource_GEO_RESOLVER)
+						So(resp.GeoJson, ShouldContainSubstring, `"LineString"`)
+					})
+
+					Convey("Then GetLocationTrack returns the simplified track", func() {
+						resp, err := api.GetLocationTrack(ctx, &pb.GetDeviceLocationTrackRequest{
+							DevEui:    "0807060504030201",
+							Tolerance: 20,
+						})
+						So(err, ShouldBeNil)
+						So(resp.Points, ShouldHaveLength, 2)
+					})
+				})
+
+				Convey("When receiving uplinks with frame-counter gaps", func() {
+					devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+					now := time.Now()
+					for _, uplink := range []struct {
+						fCnt       uint32
+						receivedAt time.Time
+					}{
+						{10, now.AddDate(0, 0, -1)},
+						{11, now.AddDate(0, 0, -1)},
+						{15, now.AddDate(0, 0, -1)},
+						{16, now},
+						{20, now},
+						{0, now}, // frame-counter reset
+					} {
+						So(storage.UpdateDeviceLinkStat(storage.DB(), devEUI, uplink.fCnt, uplink.receivedAt), ShouldBeNil)
+					}
+
+					Convey("Then GetLinkStats returns the packet-loss", func() {
+						unhealthyPacketLoss = 30
+
+						resp, err := api.GetLinkStats(ctx, &pb.GetDeviceLinkStatsRequest{
+							DevEui: "0807060504030201",
+						})
+						So(err, ShouldBeNil)
+						So(resp.Result, ShouldResemble, []*pb.DeviceLinkStats{
+							{
+								Date:       now.AddDate(0, 0, -1).UTC().Format("2006-01-02"),
+								Uplinks:    3,
+								Lost:       3,
+								PacketLoss: 50,
+							},
+							{
+								Date:       now.UTC().Format("2006-01-02"),
+								Uplinks:    3,
+								Lost:       3,
+								PacketLoss: 50,
+							},
+						})
+						So(resp.PacketLoss, ShouldEqual, 50)
+						So(resp.UnhealthyLink, ShouldBeTrue)
+					})
+				})
 			})
 
 			Convey("Testing the List method", func() {
@@ -294,6 +507,78 @@ func TestNodeAPI(t *testing.T) {
 				})
 			})
 
+			Convey("When updating the lifecycle state of the device", func() {
+				d, err := api.Get(ctx, &pb.GetDeviceRequest{
+					DevEui: "0807060504030201",
+				})
+				So(err, ShouldBeNil)
+				So(d.LifecycleState, ShouldEqual, pb.DeviceLifecycleState_PROVISIONED)
+
+				_, err = api.UpdateLifecycleState(ctx, &pb.UpdateDeviceLifecycleStateRequest{
+					DevEui:         "0807060504030201",
+					LifecycleState: pb.DeviceLifecycleState_SUSPENDED,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				Convey("Then Get returns the lifecycle state", func() {
+					d, err := api.Get(ctx, &pb.GetDeviceRequest{
+						DevEui: "0807060504030201",
+					})
+					So(err, ShouldBeNil)
+					So(d.LifecycleState, ShouldEqual, pb.DeviceLifecycleState_SUSPENDED)
+				})
+
+				Convey("Then the device can be listed by lifecycle state", func() {
+					devices, err := api.List(ctx, &pb.ListDeviceRequest{
+						ApplicationId:   app.ID,
+						LifecycleStates: []pb.DeviceLifecycleState{pb.DeviceLifecycleState_SUSPENDED},
+						Limit:           10,
+					})
+					So(err, ShouldBeNil)
+					So(devices.TotalCount, ShouldEqual, 1)
+					So(devices.Result[0].LifecycleState, ShouldEqual, pb.DeviceLifecycleState_SUSPENDED)
+				})
+
+				Convey("Then the device can not transition back to provisioned", func() {
+					_, err := api.UpdateLifecycleState(ctx, &pb.UpdateDeviceLifecycleStateRequest{
+						DevEui:         "0807060504030201",
+						LifecycleState: pb.DeviceLifecycleState_PROVISIONED,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+				})
+
+				Convey("When retiring the device", func() {
+					_, err := api.UpdateLifecycleState(ctx, &pb.UpdateDeviceLifecycleStateRequest{
+						DevEui:         "0807060504030201",
+						LifecycleState: pb.DeviceLifecycleState_RETIRED,
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then the device is read-only", func() {
+						_, err := api.Update(ctx, &pb.UpdateDeviceRequest{
+							Device: createReq.Device,
+						})
+						So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+
+						_, err = api.CreateKeys(ctx, &pb.CreateDeviceKeysRequest{
+							DeviceKeys: &pb.DeviceKeys{
+								DevEui: "0807060504030201",
+								NwkKey: "01020304050607080102030405060708",
+							},
+						})
+						So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+					})
+
+					Convey("Then the device can still be deleted", func() {
+						_, err := api.Delete(ctx, &pb.DeleteDeviceRequest{
+							DevEui: "0807060504030201",
+						})
+						So(err, ShouldBeNil)
+					})
+				})
+			})
+
 			Convey("After deleting the device", func() {
 				_, err := api.Delete(ctx, &pb.DeleteDeviceRequest{
 					DevEui: "0807060504030201",
@@ -437,6 +722,94 @@ func TestNodeAPI(t *testing.T) {
 				})
 			})
 
+			Convey("Given device-keys", func() {
+				So(storage.CreateDeviceKeys(storage.DB(), &storage.DeviceKeys{
+					DevEUI:    lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+					JoinNonce: 5,
+				}), ShouldBeNil)
+
+				Convey("When importing an activation context", func() {
+					_, err := api.ImportActivation(ctx, &pb.ImportDeviceActivationRequest{
+						ActivationContext: &pb.DeviceActivationContext{
+							DeviceActivation: &pb.DeviceActivation{
+								DevEui:      "0807060504030201",
+								DevAddr:     "01020304",
+								AppSKey:     "01020304050607080102030405060708",
+								NwkSEncKey:  "08070605040302010807060504030201",
+								SNwkSIntKey: "08070605040302010807060504030202",
+								FNwkSIntKey: "08070605040302010807060504030203",
+								FCntUp:      10,
+								NFCntDown:   11,
+								AFCntDown:   12,
+							},
+							JoinNonce: 10,
+						},
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the device-session was replaced", func() {
+						So(nsClient.DeactivateDeviceChan, ShouldHaveLength, 1)
+						<-nsClient.DeactivateDeviceChan
+
+						So(nsClient.ActivateDeviceChan, ShouldHaveLength, 1)
+						So(<-nsClient.ActivateDeviceChan, ShouldResemble, ns.ActivateDeviceRequest{
+							DeviceActivation: &ns.DeviceActivation{
+								DevEui:      []uint8{8, 7, 6, 5, 4, 3, 2, 1},
+								DevAddr:     []uint8{1, 2, 3, 4},
+								NwkSEncKey:  []uint8{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+								SNwkSIntKey: []uint8{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 2},
+								FNwkSIntKey: []uint8{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 3},
+								FCntUp:      10,
+								NFCntDown:   11,
+								AFCntDown:   12,
+							},
+						})
+					})
+
+					Convey("Then the join-nonce of the device-keys was updated", func() {
+						dk, err := storage.GetDeviceKeys(storage.DB(), lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1})
+						So(err, ShouldBeNil)
+						So(dk.JoinNonce, ShouldEqual, 10)
+					})
+
+					Convey("Then ExportActivation returns the activation context", func() {
+						nsClient.GetDeviceActivationResponse = ns.GetDeviceActivationResponse{
+							DeviceActivation: &ns.DeviceActivation{
+								DevEui:      []uint8{8, 7, 6, 5, 4, 3, 2, 1},
+								DevAddr:     []uint8{1, 2, 3, 4},
+								NwkSEncKey:  []uint8{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+								SNwkSIntKey: []uint8{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 2},
+								FNwkSIntKey: []uint8{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 3},
+								FCntUp:      20,
+								NFCntDown:   21,
+								AFCntDown:   22,
+							},
+						}
+
+						resp, err := api.ExportActivation(ctx, &pb.ExportDeviceActivationRequest{
+							DevEui: "0807060504030201",
+						})
+						So(err, ShouldBeNil)
+						So(resp.ActivationContext.JoinNonce, ShouldEqual, 10)
+						So(resp.ActivationContext.ExportedAt, ShouldNotBeNil)
+
+						resp.ActivationContext.DeviceActivation.XXX_sizecache = 0
+						So(resp.ActivationContext.DeviceActivation, ShouldResemble, &pb.DeviceActivation{
+							DevEui:      "0807060504030201",
+							DevAddr:     "01020304",
+							AppSKey:     "01020304050607080102030405060708",
+							NwkSEncKey:  "08070605040302010807060504030201",
+							SNwkSIntKey: "08070605040302010807060504030202",
+							FNwkSIntKey: "08070605040302010807060504030203",
+							FCntUp:      20,
+							NFCntDown:   21,
+							AFCntDown:   22,
+						})
+					})
+				})
+			})
+
 			Convey("When calling StreamEventLogs", func() {
 				respChan := make(chan *pb.StreamDeviceEventLogsResponse)
 
@@ -472,3 +845,48 @@ func TestNodeAPI(t *testing.T) {
 		})
 	})
 }
+
+func TestDeviceImportRowsFromCSV(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name          string
+			CSV           string
+			Expected      []deviceImportRow
+			ExpectedError string
+		}{
+			{
+				Name:          "missing dev_eui column",
+				CSV:           "DevEUI,name\n0102030405060708,dev-1\n",
+				ExpectedError: "dev_eui column is missing",
+			},
+			{
+				Name: "dev_eui and name",
+				CSV:  "dev_eui,name,tags\n0102030405060708, dev-1, foo\n0102030405060709\n",
+				Expected: []deviceImportRow{
+					{Row: 2, DevEUI: "0102030405060708", Name: "dev-1"},
+					{Row: 3, DevEUI: "0102030405060709"},
+				},
+			},
+			{
+				Name: "all columns",
+				CSV:  "dev_eui,name,description,device_profile_id,app_key\n0102030405060708,dev-1,test,2ac8d27c-c4b5-4b36-a3ed-3cd7b4cf0f8c,01020304050607080102030405060708\n",
+				Expected: []deviceImportRow{
+					{Row: 2, DevEUI: "0102030405060708", Name: "dev-1", Description: "test", DeviceProfileID: "2ac8d27c-c4b5-4b36-a3ed-3cd7b4cf0f8c", AppKey: "01020304050607080102030405060708"},
+				},
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				rows, err := deviceImportRowsFromCSV(test.CSV)
+				if test.ExpectedError != "" {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, test.ExpectedError)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(rows, ShouldResemble, test.Expected)
+			})
+		}
+	})
+}
@@ -0,0 +1,233 @@
+package external
+
+import (
+	"database/sql"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/lib/pq"
+	"github.com/lib/pq/hstore"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+var deviceWebhookEventToPB = map[storage.DeviceWebhookEvent]pb.DeviceWebhookEvent{
+	storage.DeviceWebhookCreated:        pb.DeviceWebhookEvent_CREATED,
+	storage.DeviceWebhookActivated:      pb.DeviceWebhookEvent_ACTIVATED,
+	storage.DeviceWebhookDecommissioned: pb.DeviceWebhookEvent_DECOMMISSIONED,
+}
+
+var deviceWebhookEventFromPB = map[pb.DeviceWebhookEvent]storage.DeviceWebhookEvent{
+	pb.DeviceWebhookEvent_CREATED:        storage.DeviceWebhookCreated,
+	pb.DeviceWebhookEvent_ACTIVATED:      storage.DeviceWebhookActivated,
+	pb.DeviceWebhookEvent_DECOMMISSIONED: storage.DeviceWebhookDecommissioned,
+}
+
+// DeviceWebhookAPI implements the device webhook api.
+type DeviceWebhookAPI struct {
+	validator auth.Validator
+}
+
+// NewDeviceWebhookAPI creates a new DeviceWebhookAPI.
+func NewDeviceWebhookAPI(validator auth.Validator) *DeviceWebhookAPI {
+	return &DeviceWebhookAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given device webhook.
+func (a *DeviceWebhookAPI) Create(ctx context.Context, req *pb.CreateDeviceWebhookRequest) (*pb.CreateDeviceWebhookResponse, error) {
+	if req.DeviceWebhook == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_webhook must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceWebhooksAccess(auth.Create, req.DeviceWebhook.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	w := storage.DeviceWebhook{
+		OrganizationID: req.DeviceWebhook.OrganizationId,
+	}
+	if err := deviceWebhookFromPB(req.DeviceWebhook, &w); err != nil {
+		return nil, err
+	}
+
+	if err := storage.CreateDeviceWebhook(storage.DB(), &w); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.CreateDeviceWebhookResponse{
+		Id: w.ID,
+	}, nil
+}
+
+// Get returns the device webhook matching the given id.
+func (a *DeviceWebhookAPI) Get(ctx context.Context, req *pb.GetDeviceWebhookRequest) (*pb.GetDeviceWebhookResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceWebhookAccess(auth.Read, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	w, err := storage.GetDeviceWebhook(storage.DB(), req.Id)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out := pb.GetDeviceWebhookResponse{
+		DeviceWebhook: &pb.DeviceWebhook{
+			Id:             w.ID,
+			OrganizationId: w.OrganizationID,
+			Name:           w.Name,
+			Url:            w.URL,
+			Secret:         w.Secret,
+		},
+	}
+
+	for k, v := range w.Headers.Map {
+		out.DeviceWebhook.Headers = append(out.DeviceWebhook.Headers, &pb.DeviceWebhookHeader{
+			Key:   k,
+			Value: v.String,
+		})
+	}
+	sort.Slice(out.DeviceWebhook.Headers, func(i, j int) bool {
+		return out.DeviceWebhook.Headers[i].Key < out.DeviceWebhook.Headers[j].Key
+	})
+
+	for _, e := range w.Events {
+		out.DeviceWebhook.Events = append(out.DeviceWebhook.Events, deviceWebhookEventToPB[storage.DeviceWebhookEvent(e)])
+	}
+
+	out.CreatedAt, err = ptypes.TimestampProto(w.CreatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out.UpdatedAt, err = ptypes.TimestampProto(w.UpdatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &out, nil
+}
+
+// Update updates the given device webhook.
+func (a *DeviceWebhookAPI) Update(ctx context.Context, req *pb.UpdateDeviceWebhookRequest) (*empty.Empty, error) {
+	if req.DeviceWebhook == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_webhook must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceWebhookAccess(auth.Update, req.DeviceWebhook.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	w, err := storage.GetDeviceWebhook(storage.DB(), req.DeviceWebhook.Id)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if err := deviceWebhookFromPB(req.DeviceWebhook, &w); err != nil {
+		return nil, err
+	}
+
+	if err := storage.UpdateDeviceWebhook(storage.DB(), &w); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// Delete deletes the device webhook matching the given id.
+func (a *DeviceWebhookAPI) Delete(ctx context.Context, req *pb.DeleteDeviceWebhookRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceWebhookAccess(auth.Delete, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteDeviceWebhook(storage.DB(), req.Id); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// List lists the device webhooks of the given organization.
+func (a *DeviceWebhookAPI) List(ctx context.Context, req *pb.ListDeviceWebhookRequest) (*pb.ListDeviceWebhookResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceWebhooksAccess(auth.List, req.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetDeviceWebhookCount(storage.DB(), req.OrganizationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	webhooks, err := storage.GetDeviceWebhooks(storage.DB(), req.OrganizationId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out := pb.ListDeviceWebhookResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, w := range webhooks {
+		item := pb.DeviceWebhookListItem{
+			Id:   w.ID,
+			Name: w.Name,
+			Url:  w.URL,
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(w.CreatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		item.UpdatedAt, err = ptypes.TimestampProto(w.UpdatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		out.Result = append(out.Result, &item)
+	}
+
+	return &out, nil
+}
+
+// deviceWebhookFromPB sets the (updatable) fields of the given device
+// webhook from the API object.
+func deviceWebhookFromPB(in *pb.DeviceWebhook, w *storage.DeviceWebhook) error {
+	w.Name = in.Name
+	w.URL = in.Url
+	w.Secret = in.Secret
+	w.Headers = hstore.Hstore{
+		Map: make(map[string]sql.NullString),
+	}
+	w.Events = pq.StringArray{}
+
+	for _, h := range in.Headers {
+		if h.Key == "" {
+			return grpc.Errorf(codes.InvalidArgument, "header key must not be empty")
+		}
+		w.Headers.Map[h.Key] = sql.NullString{String: h.Value, Valid: true}
+	}
+
+	for _, e := range in.Events {
+		event, ok := deviceWebhookEventFromPB[e]
+		if !ok {
+			return helpers.ErrToRPCError(storage.ErrDeviceWebhookInvalidEvent)
+		}
+		w.Events = append(w.Events, string(event))
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package external
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/devicewebhook"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func (ts *APITestSuite) TestDeviceWebhook() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	assert.NoError(devicewebhook.Setup())
+
+	validator := &TestValidator{}
+	api := NewDeviceWebhookAPI(validator)
+	deviceAPI := NewDeviceAPI(validator)
+
+	payloads := make(chan devicewebhook.Payload, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var pl devicewebhook.Payload
+		if err := json.NewDecoder(r.Body).Decode(&pl); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		payloads <- pl
+	}))
+	defer server.Close()
+
+	n := storage.NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(storage.CreateNetworkServer(storage.DB(), &n))
+
+	org := storage.Organization{
+		Name: "test-org",
+	}
+	assert.NoError(storage.CreateOrganization(storage.DB(), &org))
+
+	sp := storage.ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(storage.CreateServiceProfile(storage.DB(), &sp))
+
+	app := storage.Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(storage.CreateApplication(storage.DB(), &app))
+
+	dp := storage.DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(storage.CreateDeviceProfile(storage.DB(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		createReq := pb.CreateDeviceWebhookRequest{
+			DeviceWebhook: &pb.DeviceWebhook{
+				OrganizationId: org.ID,
+				Name:           "inventory",
+				Url:            server.URL,
+				Secret:         "secret",
+				Headers: []*pb.DeviceWebhookHeader{
+					{Key: "Authorization", Value: "Bearer token"},
+				},
+				Events: []pb.DeviceWebhookEvent{pb.DeviceWebhookEvent_CREATED, pb.DeviceWebhookEvent_DECOMMISSIONED},
+			},
+		}
+
+		createResp, err := api.Create(context.Background(), &createReq)
+		assert.NoError(err)
+		assert.NotEqual(0, createResp.Id)
+
+		t.Run("Invalid url", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.Create(context.Background(), &pb.CreateDeviceWebhookRequest{
+				DeviceWebhook: &pb.DeviceWebhook{
+					OrganizationId: org.ID,
+					Name:           "invalid",
+					Url:            "localhost:1234",
+				},
+			})
+			assert.Equal(codes.InvalidArgument, grpc.Code(err))
+		})
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			resp, err := api.Get(context.Background(), &pb.GetDeviceWebhookRequest{Id: createResp.Id})
+			assert.NoError(err)
+
+			createReq.DeviceWebhook.Id = createResp.Id
+			assert.Equal(createReq.DeviceWebhook, resp.DeviceWebhook)
+			assert.NotNil(resp.CreatedAt)
+			assert.NotNil(resp.UpdatedAt)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			resp, err := api.List(context.Background(), &pb.ListDeviceWebhookRequest{
+				OrganizationId: org.ID,
+				Limit:          10,
+			})
+			assert.NoError(err)
+			assert.EqualValues(1, resp.TotalCount)
+			assert.Len(resp.Result, 1)
+			assert.Equal("inventory", resp.Result[0].Name)
+			assert.Equal(server.URL, resp.Result[0].Url)
+		})
+
+		t.Run("Device events", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := deviceAPI.Create(context.Background(), &pb.CreateDeviceRequest{
+				Device: &pb.Device{
+					DevEui:          "0102030405060708",
+					Name:            "test-device",
+					ApplicationId:   app.ID,
+					DeviceProfileId: dpID.String(),
+				},
+			})
+			assert.NoError(err)
+
+			select {
+			case pl := <-payloads:
+				assert.Equal(storage.DeviceWebhookCreated, pl.Event)
+				assert.Equal(org.ID, pl.OrganizationID)
+				assert.Equal(app.ID, pl.ApplicationID)
+				assert.Equal("0102030405060708", pl.Device.DevEUI.String())
+				assert.Equal("test-device", pl.Device.Name)
+				assert.Equal(dpID.String(), pl.Device.DeviceProfileID)
+			case <-time.After(5 * time.Second):
+				t.Fatal("timeout waiting for created event")
+			}
+
+			_, err = deviceAPI.Delete(context.Background(), &pb.DeleteDeviceRequest{
+				DevEui: "0102030405060708",
+			})
+			assert.NoError(err)
+
+			select {
+			case pl := <-payloads:
+				assert.Equal(storage.DeviceWebhookDecommissioned, pl.Event)
+				assert.Equal("0102030405060708", pl.Device.DevEUI.String())
+			case <-time.After(5 * time.Second):
+				t.Fatal("timeout waiting for decommissioned event")
+			}
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			updateReq := pb.UpdateDeviceWebhookRequest{
+				DeviceWebhook: &pb.DeviceWebhook{
+					Id:             createResp.Id,
+					OrganizationId: org.ID,
+					Name:           "erp",
+					Url:            server.URL + "/devices",
+				},
+			}
+			_, err := api.Update(context.Background(), &updateReq)
+			assert.NoError(err)
+
+			resp, err := api.Get(context.Background(), &pb.GetDeviceWebhookRequest{Id: createResp.Id})
+			assert.NoError(err)
+			assert.Equal(updateReq.DeviceWebhook, resp.DeviceWebhook)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.Delete(context.Background(), &pb.DeleteDeviceWebhookRequest{Id: createResp.Id})
+			assert.NoError(err)
+
+			_, err = api.Delete(context.Background(), &pb.DeleteDeviceWebhookRequest{Id: createResp.Id})
+			assert.Equal(codes.NotFound, grpc.Code(err))
+		})
+	})
+}
@@ -0,0 +1,202 @@
+package external
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// diagnosticsResponse contains the runtime diagnostics of the
+// application-server.
+type diagnosticsResponse struct {
+	GoVersion     string                              `json:"goVersion"`
+	NumCPU        int                                 `json:"numCPU"`
+	NumGoroutine  int                                 `json:"numGoroutine"`
+	Memory        diagnosticsMemory                   `json:"memory"`
+	PostgreSQL    diagnosticsPostgreSQL               `json:"postgresql"`
+	Redis         diagnosticsRedis                    `json:"redis"`
+	Integration   diagnosticsIntegration              `json:"integration"`
+	Codec         map[string]diagnosticsCodec         `json:"codec"`
+	NetworkServer map[string]diagnosticsNetworkServer `json:"networkServer"`
+}
+
+// diagnosticsMemory contains the memory statistics of the Go runtime.
+type diagnosticsMemory struct {
+	Alloc        uint64 `json:"alloc"`
+	TotalAlloc   uint64 `json:"totalAlloc"`
+	Sys          uint64 `json:"sys"`
+	HeapObjects  uint64 `json:"heapObjects"`
+	NumGC        uint32 `json:"numGC"`
+	PauseTotal   string `json:"pauseTotal"`
+	LastGCPause  string `json:"lastGCPause"`
+	NextGCTarget uint64 `json:"nextGCTarget"`
+}
+
+// diagnosticsPostgreSQL contains the PostgreSQL connection pool statistics.
+type diagnosticsPostgreSQL struct {
+	MaxOpenConnections int    `json:"maxOpenConnections"`
+	OpenConnections    int    `json:"openConnections"`
+	InUse              int    `json:"inUse"`
+	Idle               int    `json:"idle"`
+	WaitCount          int64  `json:"waitCount"`
+	WaitDuration       string `json:"waitDuration"`
+}
+
+// diagnosticsRedis contains the Redis connection pool statistics.
+type diagnosticsRedis struct {
+	ActiveCount int `json:"activeCount"`
+	IdleCount   int `json:"idleCount"`
+}
+
+// diagnosticsIntegration contains the integration queue statistics.
+type diagnosticsIntegration struct {
+	// Number of events in the event journal which have not yet been
+	// published to all the integrations.
+	JournalPendingEvents int `json:"journalPendingEvents"`
+
+	// Number of events in the event journal which could not be published
+	// within the max number of attempts.
+	JournalFailedEvents int `json:"journalFailedEvents"`
+}
+
+// diagnosticsCodec contains the codec execution statistics of an organization.
+type diagnosticsCodec struct {
+	Executions uint64 `json:"executions"`
+	Errors     uint64 `json:"errors"`
+	Rejected   uint64 `json:"rejected"`
+	WallTime   string `json:"wallTime"` // total wall-clock (not CPU) time
+}
+
+// diagnosticsNetworkServer contains the circuit-breaker state and statistics
+// of a network-server.
+type diagnosticsNetworkServer struct {
+	CircuitBreaker string `json:"circuitBreaker"`
+	Failures       int    `json:"failures"`
+	Opened         uint64 `json:"opened"`
+	Rejected       uint64 `json:"rejected"`
+	Retries        uint64 `json:"retries"`
+}
+
+// newDiagnosticsHandler returns the handler serving the pprof profiles and
+// the runtime diagnostics under the /debug prefix. Only global admin users
+// are allowed to access these.
+func newDiagnosticsHandler(validator auth.Validator) http.Handler {
+	r := mux.NewRouter()
+
+	r.HandleFunc("/debug/diagnostics", diagnosticsHandlerFunc).Methods("get")
+	r.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	r.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	r.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	r.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	r.PathPrefix("/debug/pprof/").HandlerFunc(pprof.Index)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token := req.Header.Get("Authorization")
+		if token == "" {
+			token = req.Header.Get("Grpc-Metadata-Authorization")
+		}
+
+		ctx := metadata.NewIncomingContext(req.Context(), metadata.Pairs("authorization", token))
+		isAdmin, err := validator.GetIsAdmin(ctx)
+		if err != nil {
+			http.Error(w, "authentication failed: "+err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if !isAdmin {
+			http.Error(w, "permission denied", http.StatusForbidden)
+			return
+		}
+
+		r.ServeHTTP(w, req)
+	})
+}
+
+func diagnosticsHandlerFunc(w http.ResponseWriter, r *http.Request) {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+
+	resp := diagnosticsResponse{
+		GoVersion:    runtime.Version(),
+		NumCPU:       runtime.NumCPU(),
+		NumGoroutine: runtime.NumGoroutine(),
+		Memory: diagnosticsMemory{
+			Alloc:        ms.Alloc,
+			TotalAlloc:   ms.TotalAlloc,
+			Sys:          ms.Sys,
+			HeapObjects:  ms.HeapObjects,
+			NumGC:        ms.NumGC,
+			PauseTotal:   time.Duration(ms.PauseTotalNs).String(),
+			LastGCPause:  time.Duration(ms.PauseNs[(ms.NumGC+255)%256]).String(),
+			NextGCTarget: ms.NextGC,
+		},
+		Codec:         make(map[string]diagnosticsCodec),
+		NetworkServer: make(map[string]diagnosticsNetworkServer),
+	}
+
+	dbStats := storage.DB().Stats()
+	resp.PostgreSQL = diagnosticsPostgreSQL{
+		MaxOpenConnections: dbStats.MaxOpenConnections,
+		OpenConnections:    dbStats.OpenConnections,
+		InUse:              dbStats.InUse,
+		Idle:               dbStats.Idle,
+		WaitCount:          dbStats.WaitCount,
+		WaitDuration:       dbStats.WaitDuration.String(),
+	}
+
+	if p := storage.RedisPool(); p != nil {
+		resp.Redis = diagnosticsRedis{
+			ActiveCount: p.ActiveCount(),
+			IdleCount:   p.IdleCount(),
+		}
+	}
+
+	count, err := storage.GetPendingIntegrationEventCount(storage.DB())
+	if err != nil {
+		log.WithError(err).Error("api/external: get pending integration event count error")
+	}
+	resp.Integration.JournalPendingEvents = count
+
+	count, err = storage.GetFailedIntegrationEventCount(storage.DB())
+	if err != nil {
+		log.WithError(err).Error("api/external: get failed integration event count error")
+	}
+	resp.Integration.JournalFailedEvents = count
+
+	for orgID, s := range codec.GetStats() {
+		resp.Codec[strconv.FormatInt(orgID, 10)] = diagnosticsCodec{
+			Executions: s.Executions,
+			Errors:     s.Errors,
+			Rejected:   s.Rejected,
+			WallTime:   s.WallTime.String(),
+		}
+	}
+
+	for hostname, s := range networkserver.GetStats() {
+		resp.NetworkServer[hostname] = diagnosticsNetworkServer{
+			CircuitBreaker: s.State,
+			Failures:       s.Failures,
+			Opened:         s.Opened,
+			Rejected:       s.Rejected,
+			Retries:        s.Retries,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(resp); err != nil {
+		log.WithError(err).Error("api/external: encode diagnostics error")
+	}
+}
@@ -0,0 +1,61 @@
+package external
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func (ts *APITestSuite) TestDiagnostics() {
+	validator := &TestValidator{}
+	handler := newDiagnosticsHandler(validator)
+
+	ts.T().Run("Not authenticated", func(t *testing.T) {
+		assert := require.New(t)
+		validator.returnError = errors.New("invalid token")
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/debug/diagnostics", nil))
+		assert.Equal(http.StatusUnauthorized, rec.Code)
+		validator.returnError = nil
+	})
+
+	ts.T().Run("Not admin", func(t *testing.T) {
+		assert := require.New(t)
+		validator.returnIsAdmin = false
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/debug/pprof/", nil))
+		assert.Equal(http.StatusForbidden, rec.Code)
+	})
+
+	ts.T().Run("Admin", func(t *testing.T) {
+		validator.returnIsAdmin = true
+
+		t.Run("Diagnostics", func(t *testing.T) {
+			assert := require.New(t)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest("GET", "/debug/diagnostics", nil))
+			assert.Equal(http.StatusOK, rec.Code)
+
+			var resp diagnosticsResponse
+			assert.NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.NotEqual(0, resp.NumGoroutine)
+			assert.Equal(0, resp.Integration.JournalPendingEvents)
+		})
+
+		t.Run("Goroutine dump", func(t *testing.T) {
+			assert := require.New(t)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest("GET", "/debug/pprof/goroutine?debug=2", nil))
+			assert.Equal(http.StatusOK, rec.Code)
+			assert.Contains(rec.Body.String(), "goroutine")
+		})
+	})
+}
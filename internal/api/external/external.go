@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"text/template"
 	"time"
 
 	assetfs "github.com/elazarl/go-bindata-assetfs"
@@ -26,21 +27,50 @@ import (
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/external/auth"
 	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/api/scim"
+	"github.com/brocaar/lora-app-server/internal/api/webhook"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/gwlog"
+	httpint "github.com/brocaar/lora-app-server/internal/integration/http"
 	"github.com/brocaar/lora-app-server/internal/static"
 	"github.com/brocaar/lora-app-server/internal/storage"
 )
 
+// Client authentication modes.
+const (
+	clientAuthModeJWT  = "jwt"
+	clientAuthModeMTLS = "mtls"
+)
+
 var (
 	brandingHeader       string
 	brandingRegistration string
 	brandingFooter       string
+	brandingLoginBanner  string
 
 	bind            string
 	tlsCert         string
 	tlsKey          string
 	jwtSecret       string
 	corsAllowOrigin string
+
+	// client authentication mode (jwt or mtls)
+	clientAuthMode  string
+	clientCACert    string
+	clientCertUsers map[string]string
+
+	// packet-loss percentage above which a device link is unhealthy
+	unhealthyPacketLoss float64
+
+	// template of the url returned with the device embed tokens (optional)
+	embedURLTemplate *template.Template
+
+	// reject the login of users which did not accept the current terms
+	enforceTerms bool
+
+	// secret from which the bearer tokens of the gateway log endpoints are
+	// derived (optional)
+	gatewayLogSecret string
 )
 
 // Setup configures the API package.
@@ -52,12 +82,42 @@ func Setup(conf config.Config) error {
 	brandingHeader = conf.ApplicationServer.Branding.Header
 	brandingRegistration = conf.ApplicationServer.Branding.Registration
 	brandingFooter = conf.ApplicationServer.Branding.Footer
+	brandingLoginBanner = conf.ApplicationServer.Branding.LoginBanner
 
 	bind = conf.ApplicationServer.ExternalAPI.Bind
 	tlsCert = conf.ApplicationServer.ExternalAPI.TLSCert
 	tlsKey = conf.ApplicationServer.ExternalAPI.TLSKey
 	jwtSecret = conf.ApplicationServer.ExternalAPI.JWTSecret
 	corsAllowOrigin = conf.ApplicationServer.ExternalAPI.CORSAllowOrigin
+	unhealthyPacketLoss = conf.ApplicationServer.DeviceLinkStats.UnhealthyPacketLoss
+	enforceTerms = conf.ApplicationServer.ExternalAPI.EnforceTerms
+	gatewayLogSecret = conf.ApplicationServer.ExternalAPI.GatewayLogBearerToken
+
+	embedURLTemplate = nil
+	if t := conf.ApplicationServer.ExternalAPI.EmbedURLTemplate; t != "" {
+		var err error
+		embedURLTemplate, err = template.New("embed_url").Parse(t)
+		if err != nil {
+			return errors.Wrap(err, "parse embed_url_template error")
+		}
+	}
+
+	clientAuthMode = conf.ApplicationServer.ExternalAPI.ClientAuthMode
+	clientCACert = conf.ApplicationServer.ExternalAPI.ClientCACert
+	clientCertUsers = make(map[string]string)
+	for _, u := range conf.ApplicationServer.ExternalAPI.ClientCertUsers {
+		clientCertUsers[u.CommonName] = u.Username
+	}
+
+	switch clientAuthMode {
+	case clientAuthModeJWT:
+	case clientAuthModeMTLS:
+		if tlsCert == "" || tlsKey == "" || clientCACert == "" {
+			return errors.New("tls_cert, tls_key and client_ca_cert must be set for client_auth_mode mtls")
+		}
+	default:
+		return fmt.Errorf("unknown client_auth_mode: %s", clientAuthMode)
+	}
 
 	auth.DisableAssignExistingUsers = conf.ApplicationServer.ExternalAPI.DisableAssignExistingUsers
 
@@ -65,13 +125,34 @@ func Setup(conf config.Config) error {
 }
 
 func setupAPI(conf config.Config) error {
-	validator := auth.NewJWTValidator(storage.DB(), "HS256", jwtSecret)
+	var validator auth.Validator
+	var tlsConfig *tls.Config
+
+	if clientAuthMode == clientAuthModeMTLS {
+		validator = auth.NewClientCertValidator(storage.DB(), clientCertUsers)
+
+		var err error
+		tlsConfig, err = helpers.GetTLSConfig(clientCACert, tlsCert, tlsKey, true)
+		if err != nil {
+			return errors.Wrap(err, "get tls config error")
+		}
+
+		// the client certificate is verified when given, the validator
+		// rejects the API requests without a verified client certificate
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	} else {
+		validator = auth.NewJWTValidator(storage.DB(), "HS256", jwtSecret)
+	}
+
 	rpID, err := uuid.FromString(conf.ApplicationServer.ID)
 	if err != nil {
 		return errors.Wrap(err, "application-server id to uuid error")
 	}
 
-	grpcOpts := helpers.GetgRPCLoggingServerOptions()
+	grpcOpts := helpers.GetgRPCServerOptions(
+		[]grpc.UnaryServerInterceptor{hostOrganizationUnaryInterceptor},
+		[]grpc.StreamServerInterceptor{hostOrganizationStreamInterceptor},
+	)
 	grpcServer := grpc.NewServer(grpcOpts...)
 	api.RegisterApplicationServiceServer(grpcServer, NewApplicationAPI(validator))
 	api.RegisterDeviceQueueServiceServer(grpcServer, NewDeviceQueueAPI(validator))
@@ -85,6 +166,13 @@ func setupAPI(conf config.Config) error {
 	api.RegisterServiceProfileServiceServer(grpcServer, NewServiceProfileServiceAPI(validator))
 	api.RegisterDeviceProfileServiceServer(grpcServer, NewDeviceProfileServiceAPI(validator))
 	api.RegisterMulticastGroupServiceServer(grpcServer, NewMulticastGroupAPI(validator, rpID))
+	api.RegisterDeviceKeyBatchServiceServer(grpcServer, NewDeviceKeyBatchAPI(validator))
+	api.RegisterDeviceFilterServiceServer(grpcServer, NewDeviceFilterAPI(validator))
+	api.RegisterDeviceWebhookServiceServer(grpcServer, NewDeviceWebhookAPI(validator))
+	api.RegisterDeviceConflictServiceServer(grpcServer, NewDeviceConflictAPI(validator))
+	api.RegisterTermsServiceServer(grpcServer, NewTermsAPI(validator))
+	api.RegisterCampaignServiceServer(grpcServer, NewCampaignAPI(validator))
+	api.RegisterClusterServiceServer(grpcServer, NewClusterAPI(validator))
 
 	// setup the client http interface variable
 	// we need to start the gRPC service first, as it is used by the
@@ -123,7 +211,14 @@ func setupAPI(conf config.Config) error {
 			"tls-key":  tlsKey,
 		}).Info("api/external: starting api server")
 
-		if tlsCert == "" || tlsKey == "" {
+		if tlsConfig != nil {
+			server := http.Server{
+				Addr:      bind,
+				Handler:   h2c.NewHandler(handler, &http2.Server{}),
+				TLSConfig: tlsConfig,
+			}
+			log.Fatal(server.ListenAndServeTLS("", ""))
+		} else if tlsCert == "" || tlsKey == "" {
 			log.Fatal(http.ListenAndServe(bind, h2c.NewHandler(handler, &http2.Server{})))
 		} else {
 			log.Fatal(http.ListenAndServeTLS(
@@ -139,7 +234,7 @@ func setupAPI(conf config.Config) error {
 	time.Sleep(time.Millisecond * 100)
 
 	// setup the HTTP handler
-	clientHTTPHandler, err = setupHTTPAPI(conf)
+	clientHTTPHandler, err = setupHTTPAPI(conf, validator)
 	if err != nil {
 		return err
 	}
@@ -147,26 +242,79 @@ func setupAPI(conf config.Config) error {
 	return nil
 }
 
-func setupHTTPAPI(conf config.Config) (http.Handler, error) {
+func setupHTTPAPI(conf config.Config, validator auth.Validator) (http.Handler, error) {
 	r := mux.NewRouter()
 
-	// setup json api handler
-	jsonHandler, err := getJSONGateway(context.Background())
-	if err != nil {
-		return nil, err
-	}
+	// in the mtls mode, the validator authenticates the client certificate
+	// of the gRPC connection. The grpc-gateway connects over localhost
+	// without client certificate and the http handlers below do not have
+	// access to the gRPC peer, therefore these would reject every request.
+	mtls := clientAuthMode == clientAuthModeMTLS
 
-	log.WithField("path", "/api").Info("api/external: registering rest api handler and documentation endpoint")
-	r.HandleFunc("/api", func(w http.ResponseWriter, r *http.Request) {
-		data, err := static.Asset("swagger/index.html")
+	if mtls {
+		log.WithField("path", "/api").Warning("api/external: rest api is not available in client_auth_mode mtls")
+	} else {
+		// setup json api handler
+		jsonHandler, err := getJSONGateway(context.Background())
 		if err != nil {
-			log.WithError(err).Error("get swagger template error")
-			w.WriteHeader(http.StatusInternalServerError)
-			return
+			return nil, err
+		}
+
+		log.WithField("path", "/api").Info("api/external: registering rest api handler and documentation endpoint")
+		r.HandleFunc("/api", func(w http.ResponseWriter, r *http.Request) {
+			data, err := static.Asset("swagger/index.html")
+			if err != nil {
+				log.WithError(err).Error("get swagger template error")
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Write(data)
+		}).Methods("get")
+		r.PathPrefix("/api").Handler(jsonHandler)
+	}
+
+	if token := conf.ApplicationServer.ExternalAPI.SCIMBearerToken; token != "" {
+		log.WithField("path", scim.BasePath).Info("api/external: registering scim endpoint")
+		r.PathPrefix(scim.BasePath).Handler(scim.NewHandler(token))
+	}
+
+	if webhooks := conf.ApplicationServer.ExternalAPI.Webhooks; len(webhooks) != 0 {
+		tokens := make(map[int64]string)
+		for _, wh := range webhooks {
+			tokens[wh.ApplicationID] = wh.BearerToken
+		}
+
+		log.WithField("path", webhook.BasePath).Info("api/external: registering webhook endpoint")
+		r.PathPrefix(webhook.BasePath).Handler(webhook.NewHandler(tokens))
+	}
+
+	if token := conf.ApplicationServer.ExternalAPI.GatewayLogBearerToken; token != "" {
+		log.WithField("path", gwlog.BasePath).Info("api/external: registering gateway log endpoint")
+		r.PathPrefix(gwlog.BasePath).Handler(gwlog.NewHandler(token))
+	}
+
+	if conf.ApplicationServer.Integration.HTTP.CallbackBaseURL != "" {
+		log.WithField("path", httpint.CallbackPath).Info("api/external: registering http integration callback endpoint")
+		r.PathPrefix(httpint.CallbackPath).Handler(httpint.NewCallbackHandler())
+	}
+
+	if conf.ApplicationServer.ExternalAPI.EnableDiagnostics {
+		if mtls {
+			log.WithField("path", "/debug").Warning("api/external: diagnostics endpoint is not available in client_auth_mode mtls")
+		} else {
+			log.WithField("path", "/debug").Info("api/external: registering diagnostics endpoint")
+			r.PathPrefix("/debug").Handler(newDiagnosticsHandler(validator))
 		}
-		w.Write(data)
-	}).Methods("get")
-	r.PathPrefix("/api").Handler(jsonHandler)
+	}
+
+	if conf.ApplicationServer.ExternalAPI.EnableGrafana {
+		if mtls {
+			log.WithField("path", grafanaBasePath).Warning("api/external: grafana datasource endpoint is not available in client_auth_mode mtls")
+		} else {
+			log.WithField("path", grafanaBasePath).Info("api/external: registering grafana datasource endpoint")
+			r.PathPrefix(grafanaBasePath).Handler(newGrafanaHandler(validator))
+		}
+	}
 
 	// setup static file server
 	r.PathPrefix("/").Handler(http.FileServer(&assetfs.AssetFS{
@@ -252,6 +400,27 @@ func getJSONGateway(ctx context.Context) (http.Handler, error) {
 	if err := pb.RegisterMulticastGroupServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
 		return nil, errors.Wrap(err, "register multicast-group handler error")
 	}
+	if err := pb.RegisterDeviceKeyBatchServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register device-key batch handler error")
+	}
+	if err := pb.RegisterDeviceFilterServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register device filter handler error")
+	}
+	if err := pb.RegisterDeviceWebhookServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register device webhook handler error")
+	}
+	if err := pb.RegisterDeviceConflictServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register device conflict handler error")
+	}
+	if err := pb.RegisterTermsServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register terms handler error")
+	}
+	if err := pb.RegisterCampaignServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register campaign handler error")
+	}
+	if err := pb.RegisterClusterServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register cluster handler error")
+	}
 
 	return mux, nil
 }
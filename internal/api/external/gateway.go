@@ -1,12 +1,14 @@
 package external
 
 import (
+	"database/sql"
 	"strings"
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq/hstore"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -15,6 +17,7 @@ import (
 	"github.com/brocaar/lora-app-server/internal/api/external/auth"
 	"github.com/brocaar/lora-app-server/internal/api/helpers"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/gwlog"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/ns"
 	"github.com/brocaar/lorawan"
@@ -103,6 +106,7 @@ func (a *GatewayAPI) Create(ctx context.Context, req *pb.CreateGatewayRequest) (
 			OrganizationID:  req.Gateway.OrganizationId,
 			Ping:            req.Gateway.DiscoveryEnabled,
 			NetworkServerID: req.Gateway.NetworkServerId,
+			Tags:            gatewayTagsToHstore(req.Gateway.Tags),
 		})
 		if err != nil {
 			return helpers.ErrToRPCError(err)
@@ -120,7 +124,7 @@ func (a *GatewayAPI) Create(ctx context.Context, req *pb.CreateGatewayRequest) (
 
 		_, err = nsClient.CreateGateway(ctx, &createReq)
 		if err != nil && grpc.Code(err) != codes.AlreadyExists {
-			return err
+			return helpers.ErrToRPCError(err)
 		}
 
 		return nil
@@ -163,7 +167,7 @@ func (a *GatewayAPI) Get(ctx context.Context, req *pb.GetGatewayRequest) (*pb.Ge
 		Id: mac[:],
 	})
 	if err != nil {
-		return nil, err
+		return nil, helpers.ErrToRPCError(err)
 	}
 
 	resp := pb.GetGatewayResponse{
@@ -175,6 +179,7 @@ func (a *GatewayAPI) Get(ctx context.Context, req *pb.GetGatewayRequest) (*pb.Ge
 			DiscoveryEnabled: gw.Ping,
 			Location:         getResp.Gateway.Location,
 			NetworkServerId:  gw.NetworkServerID,
+			Tags:             gatewayTagsFromHstore(gw.Tags),
 		},
 		FirstSeenAt: getResp.FirstSeenAt,
 		LastSeenAt:  getResp.LastSeenAt,
@@ -220,6 +225,8 @@ func (a *GatewayAPI) Get(ctx context.Context, req *pb.GetGatewayRequest) (*pb.Ge
 
 // List lists the gateways.
 func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.ListGatewayResponse, error) {
+	req.OrganizationId = hostOrganizationFilter(ctx, req.OrganizationId)
+
 	err := a.validator.Validate(ctx, auth.ValidateGatewaysAccess(auth.List, req.OrganizationId))
 	if err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
@@ -236,12 +243,12 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 
 		if isAdmin {
 			// in case of admin user list all gateways
-			count, err = storage.GetGatewayCount(storage.DB(), req.Search)
+			count, err = storage.GetGatewayCount(storage.DB(), req.Search, req.Tags)
 			if err != nil {
 				return nil, helpers.ErrToRPCError(err)
 			}
 
-			gws, err = storage.GetGateways(storage.DB(), int(req.Limit), int(req.Offset), req.Search)
+			gws, err = storage.GetGateways(storage.DB(), int(req.Limit), int(req.Offset), req.Search, req.Tags)
 			if err != nil {
 				return nil, helpers.ErrToRPCError(err)
 			}
@@ -251,21 +258,21 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 			if err != nil {
 				return nil, helpers.ErrToRPCError(err)
 			}
-			count, err = storage.GetGatewayCountForUser(storage.DB(), username, req.Search)
+			count, err = storage.GetGatewayCountForUser(storage.DB(), username, req.Search, req.Tags)
 			if err != nil {
 				return nil, helpers.ErrToRPCError(err)
 			}
-			gws, err = storage.GetGatewaysForUser(storage.DB(), username, int(req.Limit), int(req.Offset), req.Search)
+			gws, err = storage.GetGatewaysForUser(storage.DB(), username, int(req.Limit), int(req.Offset), req.Search, req.Tags)
 			if err != nil {
 				return nil, helpers.ErrToRPCError(err)
 			}
 		}
 	} else {
-		count, err = storage.GetGatewayCountForOrganizationID(storage.DB(), req.OrganizationId, req.Search)
+		count, err = storage.GetGatewayCountForOrganizationID(storage.DB(), req.OrganizationId, req.Search, req.Tags)
 		if err != nil {
 			return nil, helpers.ErrToRPCError(err)
 		}
-		gws, err = storage.GetGatewaysForOrganizationID(storage.DB(), req.OrganizationId, int(req.Limit), int(req.Offset), req.Search)
+		gws, err = storage.GetGatewaysForOrganizationID(storage.DB(), req.OrganizationId, int(req.Limit), int(req.Offset), req.Search, req.Tags)
 		if err != nil {
 			return nil, helpers.ErrToRPCError(err)
 		}
@@ -282,6 +289,7 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 			Description:     gw.Description,
 			OrganizationId:  gw.OrganizationID,
 			NetworkServerId: gw.NetworkServerID,
+			Tags:            gatewayTagsFromHstore(gw.Tags),
 		}
 
 		row.CreatedAt, err = ptypes.TimestampProto(gw.CreatedAt)
@@ -328,6 +336,7 @@ func (a *GatewayAPI) Update(ctx context.Context, req *pb.UpdateGatewayRequest) (
 		gw.Name = req.Gateway.Name
 		gw.Description = req.Gateway.Description
 		gw.Ping = req.Gateway.DiscoveryEnabled
+		gw.Tags = gatewayTagsToHstore(req.Gateway.Tags)
 
 		err = storage.UpdateGateway(tx, &gw)
 		if err != nil {
@@ -383,7 +392,7 @@ func (a *GatewayAPI) Update(ctx context.Context, req *pb.UpdateGatewayRequest) (
 
 		_, err = nsClient.UpdateGateway(ctx, &updateReq)
 		if err != nil {
-			return err
+			return helpers.ErrToRPCError(err)
 		}
 		return nil
 	})
@@ -461,7 +470,7 @@ func (a *GatewayAPI) GetStats(ctx context.Context, req *pb.GetGatewayStatsReques
 	}
 	stats, err := nsClient.GetGatewayStats(ctx, &statsReq)
 	if err != nil {
-		return nil, err
+		return nil, helpers.ErrToRPCError(err)
 	}
 
 	result := make([]*pb.GatewayStats, len(stats.Result))
@@ -549,7 +558,7 @@ func (a *GatewayAPI) StreamFrameLogs(req *pb.StreamGatewayFrameLogsRequest, srv
 		GatewayId: mac[:],
 	})
 	if err != nil {
-		return err
+		return helpers.ErrToRPCError(err)
 	}
 
 	for {
@@ -582,3 +591,124 @@ func (a *GatewayAPI) StreamFrameLogs(req *pb.StreamGatewayFrameLogsRequest, srv
 		}
 	}
 }
+
+// RequestLog requests an excerpt of the packet-forwarder log of the given
+// gateway.
+func (a *GatewayAPI) RequestLog(ctx context.Context, req *pb.RequestGatewayLogRequest) (*pb.RequestGatewayLogResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	err := a.validator.Validate(ctx, auth.ValidateGatewayAccess(auth.Update, mac))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	logReq, err := gwlog.CreateRequest(mac, int(req.Lines))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.RequestGatewayLogResponse{
+		Id: logReq.ID.String(),
+	}
+
+	resp.ExpiresAt, err = ptypes.TimestampProto(logReq.RequestedAt.Add(gwlog.RequestTTL))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// ListLogs lists the packet-forwarder log excerpts pushed by the given
+// gateway.
+func (a *GatewayAPI) ListLogs(ctx context.Context, req *pb.ListGatewayLogsRequest) (*pb.ListGatewayLogsResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	err := a.validator.Validate(ctx, auth.ValidateGatewayAccess(auth.Update, mac))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var resp pb.ListGatewayLogsResponse
+
+	pending, err := gwlog.GetPendingRequest(mac)
+	if err == nil {
+		resp.PendingRequestId = pending.ID.String()
+	} else if err != gwlog.ErrNoPendingRequest {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	excerpts, err := gwlog.GetExcerpts(mac)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	for _, e := range excerpts {
+		item := pb.GatewayLogExcerpt{
+			Lines: e.Lines,
+		}
+
+		if e.RequestID != uuid.Nil {
+			item.RequestId = e.RequestID.String()
+		}
+
+		item.ReceivedAt, err = ptypes.TimestampProto(e.ReceivedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// GetLogToken returns the bearer token of the given gateway for the gateway
+// log endpoints.
+func (a *GatewayAPI) GetLogToken(ctx context.Context, req *pb.GetGatewayLogTokenRequest) (*pb.GetGatewayLogTokenResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	err := a.validator.Validate(ctx, auth.ValidateGatewayAccess(auth.Update, mac))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if gatewayLogSecret == "" {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "the gateway log endpoints are not enabled")
+	}
+
+	return &pb.GetGatewayLogTokenResponse{
+		Token: gwlog.GatewayToken(gatewayLogSecret, mac),
+	}, nil
+}
+
+func gatewayTagsToHstore(tags map[string]string) hstore.Hstore {
+	h := hstore.Hstore{
+		Map: make(map[string]sql.NullString),
+	}
+	for k, v := range tags {
+		h.Map[k] = sql.NullString{String: v, Valid: true}
+	}
+	return h
+}
+
+func gatewayTagsFromHstore(h hstore.Hstore) map[string]string {
+	if len(h.Map) == 0 {
+		return nil
+	}
+
+	tags := make(map[string]string)
+	for k, v := range h.Map {
+		tags[k] = v.String
+	}
+	return tags
+}
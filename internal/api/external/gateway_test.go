@@ -64,6 +64,10 @@ func (ts *APITestSuite) TestGateway() {
 						FineTimestampKey: "01020304050607080102030405060708",
 					},
 				},
+				Tags: map[string]string{
+					"site":    "tower-1",
+					"antenna": "omni",
+				},
 			},
 		}
 		_, err := api.Create(ctx, &createReq)
@@ -142,6 +146,23 @@ func (ts *APITestSuite) TestGateway() {
 				assert.Equal(createReq.Gateway.Id, gws.Result[0].Id)
 			})
 
+			t.Run("List by tags", func(t *testing.T) {
+				assert := require.New(t)
+
+				validator.returnIsAdmin = true
+				gws, err := api.List(ctx, &pb.ListGatewayRequest{
+					Limit: 10,
+					Tags: map[string]string{
+						"site": "tower-1",
+					},
+				})
+				assert.NoError(err)
+				assert.EqualValues(1, gws.TotalCount)
+				assert.Len(gws.Result, 1)
+				assert.Equal(createReq.Gateway.Id, gws.Result[0].Id)
+				assert.Equal(createReq.Gateway.Tags, gws.Result[0].Tags)
+			})
+
 			t.Run("List for organization", func(t *testing.T) {
 				assert := require.New(t)
 
@@ -0,0 +1,397 @@
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+// grafanaBasePath defines the path under which the Grafana (SimpleJSON)
+// datasource endpoints are served.
+const grafanaBasePath = "/grafana"
+
+const maxGrafanaBodySize = 1 << 20
+
+// grafanaObjectPrefix is the metric prefix of the decoded payload values.
+const grafanaObjectPrefix = "object."
+
+// grafanaDeviceMetrics contains the device metrics, next to the decoded
+// payload values.
+var grafanaDeviceMetrics = []string{"uplinks", "lost", "latitude", "longitude", "altitude"}
+
+// grafanaGatewayMetrics contains the gateway metrics.
+var grafanaGatewayMetrics = []string{"rxPacketsReceived", "rxPacketsReceivedOK", "txPacketsReceived", "txPacketsEmitted"}
+
+// grafanaTarget defines a time-series target, formatted as
+// device:<DevEUI>:<metric> or gateway:<MAC>:<metric>.
+type grafanaTarget struct {
+	Kind   string
+	ID     lorawan.EUI64
+	Metric string
+}
+
+// grafanaSearchRequest contains the search request of the datasource.
+type grafanaSearchRequest struct {
+	Target string `json:"target"`
+}
+
+// grafanaQueryRequest contains the query request of the datasource.
+type grafanaQueryRequest struct {
+	Range struct {
+		From time.Time `json:"from"`
+		To   time.Time `json:"to"`
+	} `json:"range"`
+	Targets []struct {
+		Target string `json:"target"`
+		RefID  string `json:"refId"`
+	} `json:"targets"`
+}
+
+// grafanaTimeSeries contains the datapoints of a target, each datapoint is
+// formatted as [value, unix timestamp in milliseconds].
+type grafanaTimeSeries struct {
+	Target     string       `json:"target"`
+	Datapoints [][2]float64 `json:"datapoints"`
+}
+
+// newGrafanaHandler returns the handler implementing the SimpleJSON
+// datasource API under the /grafana prefix, so that the stored device and
+// gateway data can be charted by Grafana. Each target is validated against
+// the permissions of the authenticated user.
+func newGrafanaHandler(validator auth.Validator) http.Handler {
+	h := grafanaHandler{validator: validator}
+
+	r := mux.NewRouter()
+	r.HandleFunc(grafanaBasePath, h.test).Methods("get")
+	r.HandleFunc(grafanaBasePath+"/", h.test).Methods("get")
+	r.HandleFunc(grafanaBasePath+"/search", h.search).Methods("post")
+	r.HandleFunc(grafanaBasePath+"/query", h.query).Methods("post")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token := req.Header.Get("Authorization")
+		if token == "" {
+			token = req.Header.Get("Grpc-Metadata-Authorization")
+		}
+
+		ctx := metadata.NewIncomingContext(req.Context(), metadata.Pairs("authorization", token))
+		if _, err := validator.GetIsAdmin(ctx); err != nil {
+			http.Error(w, "authentication failed: "+err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		r.ServeHTTP(w, req.WithContext(ctx))
+	})
+}
+
+type grafanaHandler struct {
+	validator auth.Validator
+}
+
+// test is used by Grafana to test the datasource.
+func (h grafanaHandler) test(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// search returns the targets of the device or gateway given by the
+// device:<DevEUI> or gateway:<MAC> search term.
+func (h grafanaHandler) search(w http.ResponseWriter, r *http.Request) {
+	var req grafanaSearchRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGrafanaBodySize)).Decode(&req); err != nil {
+		http.Error(w, "decode request error: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	targets := []string{}
+
+	t, err := parseGrafanaTarget(strings.TrimSuffix(req.Target, ":") + ":")
+	if err == nil {
+		if err := h.validate(r.Context(), t); err != nil {
+			http.Error(w, "permission denied", http.StatusForbidden)
+			return
+		}
+
+		metrics := grafanaGatewayMetrics
+		if t.Kind == "device" {
+			metrics = append([]string(nil), grafanaDeviceMetrics...)
+
+			obj, err := storage.GetLastDeviceObject(storage.DB(), t.ID)
+			if err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+				log.WithError(err).Error("api/external: get last device object error")
+			}
+			metrics = append(metrics, grafanaObjectMetrics(obj)...)
+		}
+
+		for _, m := range metrics {
+			targets = append(targets, fmt.Sprintf("%s:%s:%s", t.Kind, t.ID, m))
+		}
+	}
+
+	writeGrafanaResponse(w, targets)
+}
+
+// query returns the time-series of the requested targets.
+func (h grafanaHandler) query(w http.ResponseWriter, r *http.Request) {
+	var req grafanaQueryRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGrafanaBodySize)).Decode(&req); err != nil {
+		http.Error(w, "decode request error: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	out := []grafanaTimeSeries{}
+	for _, rt := range req.Targets {
+		if rt.Target == "" {
+			continue
+		}
+
+		t, err := parseGrafanaTarget(rt.Target)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := h.validate(r.Context(), t); err != nil {
+			http.Error(w, "permission denied: "+rt.Target, http.StatusForbidden)
+			return
+		}
+
+		ts := grafanaTimeSeries{
+			Target:     rt.Target,
+			Datapoints: [][2]float64{},
+		}
+
+		switch t.Kind {
+		case "device":
+			err = getGrafanaDeviceDatapoints(t, req.Range.From, req.Range.To, &ts)
+		case "gateway":
+			err = getGrafanaGatewayDatapoints(r.Context(), t, req.Range.From, req.Range.To, &ts)
+		}
+		if err != nil {
+			log.WithError(err).WithField("target", rt.Target).Error("api/external: grafana query error")
+			http.Error(w, "query error: "+rt.Target, http.StatusInternalServerError)
+			return
+		}
+
+		out = append(out, ts)
+	}
+
+	writeGrafanaResponse(w, out)
+}
+
+func (h grafanaHandler) validate(ctx context.Context, t grafanaTarget) error {
+	if t.Kind == "device" {
+		return h.validator.Validate(ctx, auth.ValidateNodeAccess(t.ID, auth.Read))
+	}
+	return h.validator.Validate(ctx, auth.ValidateGatewayAccess(auth.Read, t.ID))
+}
+
+func getGrafanaDeviceDatapoints(t grafanaTarget, start, end time.Time, ts *grafanaTimeSeries) error {
+	switch t.Metric {
+	case "uplinks", "lost":
+		stats, err := storage.GetDeviceLinkStats(storage.DB(), t.ID, start, end)
+		if err != nil {
+			return errors.Wrap(err, "get device link stats error")
+		}
+		for _, s := range stats {
+			v := s.Uplinks
+			if t.Metric == "lost" {
+				v = s.Lost
+			}
+			ts.Datapoints = append(ts.Datapoints, grafanaDatapoint(float64(v), s.Date))
+		}
+	case "latitude", "longitude", "altitude":
+		locations, err := storage.GetDeviceLocations(storage.DB(), storage.DeviceLocationFilters{
+			DevEUI: t.ID,
+			Start:  &start,
+			End:    &end,
+		})
+		if err != nil {
+			return errors.Wrap(err, "get device locations error")
+		}
+		for _, l := range locations {
+			v := l.Latitude
+			switch t.Metric {
+			case "longitude":
+				v = l.Longitude
+			case "altitude":
+				v = l.Altitude
+			}
+			ts.Datapoints = append(ts.Datapoints, grafanaDatapoint(v, l.CreatedAt))
+		}
+	default:
+		values, err := storage.GetDeviceObjectValues(storage.DB(), t.ID, strings.Split(strings.TrimPrefix(t.Metric, grafanaObjectPrefix), "."), start, end)
+		if err != nil {
+			return errors.Wrap(err, "get device object values error")
+		}
+		for _, v := range values {
+			ts.Datapoints = append(ts.Datapoints, grafanaDatapoint(v.Value, v.CreatedAt))
+		}
+	}
+
+	return nil
+}
+
+func getGrafanaGatewayDatapoints(ctx context.Context, t grafanaTarget, start, end time.Time, ts *grafanaTimeSeries) error {
+	gw, err := storage.GetGateway(storage.DB(), t.ID, false)
+	if err != nil {
+		return errors.Wrap(err, "get gateway error")
+	}
+
+	n, err := storage.GetNetworkServer(storage.DB(), gw.NetworkServerID)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := networkserver.GetPool().Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errors.Wrap(err, "get network-server client error")
+	}
+
+	startTS, err := ptypes.TimestampProto(start)
+	if err != nil {
+		return errors.Wrap(err, "timestamp proto error")
+	}
+	endTS, err := ptypes.TimestampProto(end)
+	if err != nil {
+		return errors.Wrap(err, "timestamp proto error")
+	}
+
+	stats, err := nsClient.GetGatewayStats(ctx, &ns.GetGatewayStatsRequest{
+		GatewayId:      t.ID[:],
+		Interval:       grafanaStatsInterval(start, end),
+		StartTimestamp: startTS,
+		EndTimestamp:   endTS,
+	})
+	if err != nil {
+		return errors.Wrap(err, "get gateway stats error")
+	}
+
+	for _, s := range stats.Result {
+		tt, err := ptypes.Timestamp(s.Timestamp)
+		if err != nil {
+			return errors.Wrap(err, "timestamp error")
+		}
+
+		var v int32
+		switch t.Metric {
+		case "rxPacketsReceived":
+			v = s.RxPacketsReceived
+		case "rxPacketsReceivedOK":
+			v = s.RxPacketsReceivedOk
+		case "txPacketsReceived":
+			v = s.TxPacketsReceived
+		case "txPacketsEmitted":
+			v = s.TxPacketsEmitted
+		}
+		ts.Datapoints = append(ts.Datapoints, grafanaDatapoint(float64(v), tt))
+	}
+
+	return nil
+}
+
+// parseGrafanaTarget parses the given target string.
+func parseGrafanaTarget(s string) (grafanaTarget, error) {
+	var t grafanaTarget
+
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 {
+		return t, fmt.Errorf("invalid target: %s", s)
+	}
+
+	t.Kind = parts[0]
+	t.Metric = parts[2]
+	if err := t.ID.UnmarshalText([]byte(parts[1])); err != nil {
+		return t, fmt.Errorf("invalid target id: %s", s)
+	}
+
+	var metrics []string
+	switch t.Kind {
+	case "device":
+		metrics = grafanaDeviceMetrics
+		if strings.HasPrefix(t.Metric, grafanaObjectPrefix) && len(t.Metric) > len(grafanaObjectPrefix) {
+			return t, nil
+		}
+	case "gateway":
+		metrics = grafanaGatewayMetrics
+	default:
+		return t, fmt.Errorf("invalid target type: %s", s)
+	}
+
+	// an empty metric is used for search
+	if t.Metric == "" {
+		return t, nil
+	}
+
+	for _, m := range metrics {
+		if m == t.Metric {
+			return t, nil
+		}
+	}
+
+	return t, fmt.Errorf("invalid target metric: %s", s)
+}
+
+// grafanaObjectMetrics returns the metrics of the number and boolean values
+// of the given decoded payload, sorted by name.
+func grafanaObjectMetrics(obj json.RawMessage) []string {
+	var v interface{}
+	if len(obj) == 0 || json.Unmarshal(obj, &v) != nil {
+		return nil
+	}
+
+	var out []string
+	var walk func(prefix string, v interface{})
+	walk = func(prefix string, v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, vv := range v {
+				walk(prefix+"."+k, vv)
+			}
+		case float64, bool:
+			out = append(out, "object"+prefix)
+		}
+	}
+	walk("", v)
+
+	sort.Strings(out)
+	return out
+}
+
+// grafanaStatsInterval returns the gateway stats aggregation interval
+// for the given time range.
+func grafanaStatsInterval(start, end time.Time) ns.AggregationInterval {
+	switch d := end.Sub(start); {
+	case d <= 6*time.Hour:
+		return ns.AggregationInterval_MINUTE
+	case d <= 14*24*time.Hour:
+		return ns.AggregationInterval_HOUR
+	default:
+		return ns.AggregationInterval_DAY
+	}
+}
+
+func grafanaDatapoint(v float64, t time.Time) [2]float64 {
+	return [2]float64{v, float64(t.UnixNano() / int64(time.Millisecond))}
+}
+
+func writeGrafanaResponse(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Error("api/external: encode grafana response error")
+	}
+}
@@ -0,0 +1,148 @@
+package external
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func TestParseGrafanaTarget(t *testing.T) {
+	tests := []struct {
+		Target   string
+		Expected grafanaTarget
+		Error    bool
+	}{
+		{
+			Target:   "device:0102030405060708:uplinks",
+			Expected: grafanaTarget{Kind: "device", ID: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, Metric: "uplinks"},
+		},
+		{
+			Target:   "device:0102030405060708:object.door.open",
+			Expected: grafanaTarget{Kind: "device", ID: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, Metric: "object.door.open"},
+		},
+		{
+			Target:   "gateway:0102030405060708:rxPacketsReceivedOK",
+			Expected: grafanaTarget{Kind: "gateway", ID: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, Metric: "rxPacketsReceivedOK"},
+		},
+		{
+			Target: "device:0102030405060708:object.",
+			Error:  true,
+		},
+		{
+			Target: "gateway:0102030405060708:uplinks",
+			Error:  true,
+		},
+		{
+			Target: "device:0102:uplinks",
+			Error:  true,
+		},
+		{
+			Target: "application:0102030405060708:uplinks",
+			Error:  true,
+		},
+		{
+			Target: "device:0102030405060708",
+			Error:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Target, func(t *testing.T) {
+			assert := require.New(t)
+
+			target, err := parseGrafanaTarget(test.Target)
+			if test.Error {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(test.Expected, target)
+		})
+	}
+}
+
+func TestGrafanaObjectMetrics(t *testing.T) {
+	assert := require.New(t)
+
+	assert.Equal([]string{"object.door.open", "object.temperature"}, grafanaObjectMetrics(json.RawMessage(`{"temperature": 20.5, "label": "kitchen", "door": {"open": true}, "values": [1, 2]}`)))
+	assert.Nil(grafanaObjectMetrics(nil))
+}
+
+func (ts *APITestSuite) TestGrafana() {
+	validator := &TestValidator{}
+	handler := newGrafanaHandler(validator)
+
+	assert := require.New(ts.T())
+	assert.NoError(storage.CreateIntegrationEvent(storage.DB(), &storage.IntegrationEvent{
+		Type:    "up",
+		Payload: json.RawMessage(`{"devEUI": "0102030405060708", "object": {"temperature": 20.5}}`),
+	}))
+
+	ts.T().Run("Not authenticated", func(t *testing.T) {
+		assert := require.New(t)
+		validator.returnError = errors.New("invalid token")
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/grafana/", nil))
+		assert.Equal(http.StatusUnauthorized, rec.Code)
+		validator.returnError = nil
+	})
+
+	ts.T().Run("Test", func(t *testing.T) {
+		assert := require.New(t)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/grafana/", nil))
+		assert.Equal(http.StatusOK, rec.Code)
+	})
+
+	ts.T().Run("Search", func(t *testing.T) {
+		assert := require.New(t)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("POST", "/grafana/search", strings.NewReader(`{"target": "device:0102030405060708"}`)))
+		assert.Equal(http.StatusOK, rec.Code)
+
+		var targets []string
+		assert.NoError(json.Unmarshal(rec.Body.Bytes(), &targets))
+		assert.Contains(targets, "device:0102030405060708:uplinks")
+		assert.Contains(targets, "device:0102030405060708:object.temperature")
+	})
+
+	ts.T().Run("Query", func(t *testing.T) {
+		assert := require.New(t)
+
+		body := `{
+			"range": {"from": "` + time.Now().Add(-time.Hour).Format(time.RFC3339) + `", "to": "` + time.Now().Add(time.Hour).Format(time.RFC3339) + `"},
+			"targets": [{"target": "device:0102030405060708:object.temperature", "refId": "A"}]
+		}`
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("POST", "/grafana/query", strings.NewReader(body)))
+		assert.Equal(http.StatusOK, rec.Code)
+
+		var resp []grafanaTimeSeries
+		assert.NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Len(resp, 1)
+		assert.Equal("device:0102030405060708:object.temperature", resp[0].Target)
+		assert.Len(resp[0].Datapoints, 1)
+		assert.Equal(20.5, resp[0].Datapoints[0][0])
+	})
+
+	ts.T().Run("Query invalid target", func(t *testing.T) {
+		assert := require.New(t)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("POST", "/grafana/query", strings.NewReader(`{"targets": [{"target": "device:0102030405060708:foo"}]}`)))
+		assert.Equal(http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,162 @@
+package external
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// hostMetadataKeys contains the metadata keys containing the hostname of
+// the request, in order of precedence. The :authority key is set for the
+// gRPC requests, the x-forwarded-host key is set by the grpc-gateway for
+// the REST requests (which connects to the gRPC API over localhost).
+var hostMetadataKeys = []string{":authority", "x-forwarded-host"}
+
+// organizationHostCacheTTL defines how long the hostname to organization
+// mapping is cached. Changes made through an other instance are picked up
+// after this duration.
+const organizationHostCacheTTL = time.Minute
+
+// organizationHostCache caches the hostname to organization mapping, as it
+// is needed for every API call. Hostnames which are not mapped to an
+// organization are cached as well.
+var organizationHostCache = struct {
+	sync.RWMutex
+	hosts map[string]organizationHostCacheItem
+}{
+	hosts: make(map[string]organizationHostCacheItem),
+}
+
+type organizationHostCacheItem struct {
+	host      storage.OrganizationHost
+	ok        bool
+	expiresAt time.Time
+}
+
+// hostOrganizationUnaryInterceptor scopes the unary API calls to the
+// organization mapped to the hostname of the request.
+func hostOrganizationUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := contextWithHostOrganization(ctx)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return handler(ctx, req)
+}
+
+// hostOrganizationStreamInterceptor scopes the streaming API calls to the
+// organization mapped to the hostname of the request.
+func hostOrganizationStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := contextWithHostOrganization(ss.Context())
+	if err != nil {
+		return helpers.ErrToRPCError(err)
+	}
+
+	wrapped := grpc_middleware.WrapServerStream(ss)
+	wrapped.WrappedContext = ctx
+
+	return handler(srv, wrapped)
+}
+
+// contextWithHostOrganization returns the context holding the ID of the
+// organization mapped to the hostname of the request. The context is
+// returned as-is when the hostname is not mapped to an organization.
+func contextWithHostOrganization(ctx context.Context) (context.Context, error) {
+	h, ok, err := getOrganizationHost(ctx)
+	if err != nil || !ok {
+		return ctx, err
+	}
+
+	return auth.NewContextWithHostOrganizationID(ctx, h.OrganizationID), nil
+}
+
+// hostOrganizationFilter returns the organization mapped to the hostname of
+// the request when the given organization filter is not set (0), so that
+// lists are scoped to the host organization.
+func hostOrganizationFilter(ctx context.Context, organizationID int64) int64 {
+	if organizationID != 0 {
+		return organizationID
+	}
+
+	id, _ := auth.HostOrganizationIDFromContext(ctx)
+	return id
+}
+
+// getOrganizationHost returns the organization host matching the hostname
+// of the request. It returns false when the hostname is not mapped to an
+// organization.
+func getOrganizationHost(ctx context.Context) (storage.OrganizationHost, bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return storage.OrganizationHost{}, false, nil
+	}
+
+	for _, key := range hostMetadataKeys {
+		for _, value := range md[key] {
+			// the x-forwarded-host header can contain a list of hosts
+			// when multiple proxies are used, the first one is the
+			// host requested by the client
+			host := strings.TrimSpace(strings.Split(value, ",")[0])
+
+			h, ok, err := getCachedOrganizationHost(host)
+			if err != nil {
+				return h, false, err
+			}
+			if !ok {
+				continue
+			}
+
+			return h, true, nil
+		}
+	}
+
+	return storage.OrganizationHost{}, false, nil
+}
+
+// getCachedOrganizationHost returns the organization host for the given
+// hostname from the cache, or from the database when not cached or expired.
+func getCachedOrganizationHost(hostname string) (storage.OrganizationHost, bool, error) {
+	hostname = storage.NormalizeHostname(hostname)
+
+	organizationHostCache.RLock()
+	item, cached := organizationHostCache.hosts[hostname]
+	organizationHostCache.RUnlock()
+
+	if cached && time.Now().Before(item.expiresAt) {
+		return item.host, item.ok, nil
+	}
+
+	h, err := storage.GetOrganizationHost(storage.DB(), hostname)
+	if err != nil && err != storage.ErrDoesNotExist {
+		return h, false, errors.Wrap(err, "get organization host error")
+	}
+
+	item = organizationHostCacheItem{
+		host:      h,
+		ok:        err == nil,
+		expiresAt: time.Now().Add(organizationHostCacheTTL),
+	}
+
+	organizationHostCache.Lock()
+	organizationHostCache.hosts[hostname] = item
+	organizationHostCache.Unlock()
+
+	return item.host, item.ok, nil
+}
+
+// invalidateOrganizationHost removes the given hostname from the cache.
+func invalidateOrganizationHost(hostname string) {
+	organizationHostCache.Lock()
+	delete(organizationHostCache.hosts, storage.NormalizeHostname(hostname))
+	organizationHostCache.Unlock()
+}
@@ -0,0 +1,202 @@
+package external
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func (ts *APITestSuite) TestOrganizationHost() {
+	assert := require.New(ts.T())
+
+	validator := &TestValidator{}
+	api := NewOrganizationAPI(validator)
+	apiInternal := NewInternalUserAPI(validator)
+
+	org := storage.Organization{
+		Name: "tenant-org",
+	}
+	assert.NoError(storage.CreateOrganization(storage.DB(), &org))
+
+	user := storage.User{
+		Username: "otheruser",
+		IsActive: true,
+		Email:    "other@example.com",
+	}
+	_, err := storage.CreateUser(storage.DB(), &user, "password123")
+	assert.NoError(err)
+
+	hostCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		":authority", "localhost:8080",
+		"x-forwarded-host", "Tenant.Example.com",
+	))
+
+	ts.T().Run("CreateHost", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := api.CreateHost(context.Background(), &pb.CreateOrganizationHostRequest{
+			Host: &pb.OrganizationHost{
+				Hostname:       "tenant.example.com",
+				OrganizationId: org.ID,
+				BrandingFooter: "Tenant Inc.",
+			},
+		})
+		assert.NoError(err)
+
+		_, err = api.CreateHost(context.Background(), &pb.CreateOrganizationHostRequest{
+			Host: &pb.OrganizationHost{
+				Hostname:       "invalid_host",
+				OrganizationId: org.ID,
+			},
+		})
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+		t.Run("ListHosts", func(t *testing.T) {
+			assert := require.New(t)
+
+			resp, err := api.ListHosts(context.Background(), &pb.ListOrganizationHostsRequest{
+				OrganizationId: org.ID,
+			})
+			assert.NoError(err)
+			assert.Equal([]*pb.OrganizationHost{
+				{
+					Hostname:       "tenant.example.com",
+					OrganizationId: org.ID,
+					BrandingFooter: "Tenant Inc.",
+				},
+			}, resp.Result)
+		})
+
+		t.Run("Host organization context", func(t *testing.T) {
+			assert := require.New(t)
+
+			ctx, err := contextWithHostOrganization(hostCtx)
+			assert.NoError(err)
+			id, ok := auth.HostOrganizationIDFromContext(ctx)
+			assert.True(ok)
+			assert.Equal(org.ID, id)
+
+			ctx, err = contextWithHostOrganization(context.Background())
+			assert.NoError(err)
+			_, ok = auth.HostOrganizationIDFromContext(ctx)
+			assert.False(ok)
+		})
+
+		t.Run("Branding", func(t *testing.T) {
+			assert := require.New(t)
+
+			resp, err := apiInternal.Branding(hostCtx, nil)
+			assert.NoError(err)
+			assert.Equal("Tenant Inc.", resp.Footer)
+		})
+
+		t.Run("Login", func(t *testing.T) {
+			assert := require.New(t)
+
+			ctx, err := contextWithHostOrganization(hostCtx)
+			assert.NoError(err)
+
+			// the user is not part of the organization
+			_, err = apiInternal.Login(ctx, &pb.LoginRequest{
+				Username: "otheruser",
+				Password: "password123",
+			})
+			assert.Equal(codes.Unauthenticated, grpc.Code(err))
+
+			// global admin
+			_, err = apiInternal.Login(ctx, &pb.LoginRequest{
+				Username: "admin",
+				Password: "admin",
+			})
+			assert.NoError(err)
+
+			// on other hosts, the user is able to login
+			_, err = apiInternal.Login(context.Background(), &pb.LoginRequest{
+				Username: "otheruser",
+				Password: "password123",
+			})
+			assert.NoError(err)
+		})
+
+		t.Run("UpdateHost", func(t *testing.T) {
+			assert := require.New(t)
+
+			// organization admin users can only change the logo
+			_, err := api.UpdateHost(context.Background(), &pb.UpdateOrganizationHostRequest{
+				Host: &pb.OrganizationHost{
+					Hostname:       "tenant.example.com",
+					OrganizationId: org.ID,
+					BrandingLogo:   "Tenant",
+					BrandingFooter: "<script>alert(1)</script>",
+				},
+			})
+			assert.Equal(codes.PermissionDenied, grpc.Code(err))
+
+			_, err = api.UpdateHost(context.Background(), &pb.UpdateOrganizationHostRequest{
+				Host: &pb.OrganizationHost{
+					Hostname:       "tenant.example.com",
+					OrganizationId: org.ID,
+					BrandingLogo:   "Tenant",
+					BrandingFooter: "Tenant Inc.",
+				},
+			})
+			assert.NoError(err)
+
+			h, err := storage.GetOrganizationHost(storage.DB(), "tenant.example.com")
+			assert.NoError(err)
+			assert.Equal("Tenant", h.BrandingLogo)
+			assert.Equal("Tenant Inc.", h.BrandingFooter)
+
+			validator.returnIsAdmin = true
+			_, err = api.UpdateHost(context.Background(), &pb.UpdateOrganizationHostRequest{
+				Host: &pb.OrganizationHost{
+					Hostname:       "tenant.example.com",
+					OrganizationId: org.ID,
+					BrandingLogo:   "Tenant",
+				},
+			})
+			assert.NoError(err)
+			validator.returnIsAdmin = false
+
+			h, err = storage.GetOrganizationHost(storage.DB(), "tenant.example.com")
+			assert.NoError(err)
+			assert.Equal("", h.BrandingFooter)
+
+			resp, err := apiInternal.Branding(hostCtx, nil)
+			assert.NoError(err)
+			assert.Equal("Tenant", resp.Logo)
+		})
+
+		t.Run("DeleteHost", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.DeleteHost(context.Background(), &pb.DeleteOrganizationHostRequest{
+				OrganizationId: org.ID + 1,
+				Hostname:       "tenant.example.com",
+			})
+			assert.Equal(codes.NotFound, grpc.Code(err))
+
+			_, err = api.DeleteHost(context.Background(), &pb.DeleteOrganizationHostRequest{
+				OrganizationId: org.ID,
+				Hostname:       "tenant.example.com",
+			})
+			assert.NoError(err)
+
+			_, err = storage.GetOrganizationHost(storage.DB(), "tenant.example.com")
+			assert.Equal(storage.ErrDoesNotExist, err)
+
+			ctx, err := contextWithHostOrganization(hostCtx)
+			assert.NoError(err)
+			_, ok := auth.HostOrganizationIDFromContext(ctx)
+			assert.False(ok)
+		})
+	})
+}
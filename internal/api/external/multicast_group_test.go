@@ -428,6 +428,74 @@ func (ts *APITestSuite) TestMulticastGroup() {
 			})
 		})
 
+		t.Run("CreateQueueSchedule", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := api.CreateQueueSchedule(context.Background(), &pb.CreateMulticastQueueScheduleRequest{
+				MulticastQueueSchedule: &pb.MulticastQueueSchedule{
+					MulticastGroupId: createResp.Id,
+					Cron:             "0 9 * * *",
+					Timezone:         "Invalid/Zone",
+					FPort:            10,
+					Data:             []byte{1, 2, 3},
+				},
+			})
+			assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+			schedResp, err := api.CreateQueueSchedule(context.Background(), &pb.CreateMulticastQueueScheduleRequest{
+				MulticastQueueSchedule: &pb.MulticastQueueSchedule{
+					MulticastGroupId: createResp.Id,
+					Cron:             "0 9 * * *",
+					Timezone:         "Europe/Amsterdam",
+					FPort:            10,
+					Data:             []byte{1, 2, 3},
+				},
+			})
+			assert.NoError(err)
+
+			t.Run("ListQueueSchedules", func(t *testing.T) {
+				assert := require.New(t)
+
+				listResp, err := api.ListQueueSchedules(context.Background(), &pb.ListMulticastQueueSchedulesRequest{
+					MulticastGroupId: createResp.Id,
+				})
+				assert.NoError(err)
+				assert.Len(listResp.MulticastQueueSchedules, 1)
+
+				item := listResp.MulticastQueueSchedules[0]
+				assert.Equal(schedResp.Id, item.Id)
+				assert.Equal(createResp.Id, item.MulticastGroupId)
+				assert.Equal("0 9 * * *", item.Cron)
+				assert.Equal("Europe/Amsterdam", item.Timezone)
+				assert.EqualValues(10, item.FPort)
+				assert.Equal([]byte{1, 2, 3}, item.Data)
+				assert.NotNil(item.NextRunAt)
+				assert.Nil(item.LastRunAt)
+			})
+
+			t.Run("DeleteQueueSchedule", func(t *testing.T) {
+				assert := require.New(t)
+
+				_, err := api.DeleteQueueSchedule(context.Background(), &pb.DeleteMulticastQueueScheduleRequest{
+					MulticastGroupId: createResp.Id,
+					Id:               schedResp.Id,
+				})
+				assert.NoError(err)
+
+				_, err = api.DeleteQueueSchedule(context.Background(), &pb.DeleteMulticastQueueScheduleRequest{
+					MulticastGroupId: createResp.Id,
+					Id:               schedResp.Id,
+				})
+				assert.Equal(codes.NotFound, grpc.Code(err))
+
+				listResp, err := api.ListQueueSchedules(context.Background(), &pb.ListMulticastQueueSchedulesRequest{
+					MulticastGroupId: createResp.Id,
+				})
+				assert.NoError(err)
+				assert.Len(listResp.MulticastQueueSchedules, 0)
+			})
+		})
+
 		t.Run("Delete", func(t *testing.T) {
 			assert := require.New(t)
 
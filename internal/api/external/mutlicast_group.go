@@ -223,6 +223,9 @@ func (a *MulticastGroupAPI) Delete(ctx context.Context, req *pb.DeleteMulticastG
 	}
 
 	if err = storage.Transaction(func(tx sqlx.Ext) error {
+		if err := multicast.DeleteQueueSchedules(tx, mgID); err != nil {
+			return helpers.ErrToRPCError(err)
+		}
 		if err := storage.DeleteMulticastGroup(tx, mgID); err != nil {
 			return helpers.ErrToRPCError(err)
 		}
@@ -346,6 +349,10 @@ func (a *MulticastGroupAPI) AddDevice(ctx context.Context, req *pb.AddDeviceToMu
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	if dev.LifecycleState == storage.DeviceRetired {
+		return nil, helpers.ErrToRPCError(storage.ErrDeviceRetired)
+	}
+
 	app, err := storage.GetApplication(storage.DB(), dev.ApplicationID)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
@@ -466,7 +473,7 @@ func (a *MulticastGroupAPI) FlushQueue(ctx context.Context, req *pb.FlushMultica
 		MulticastGroupId: mgID.Bytes(),
 	})
 	if err != nil {
-		return nil, err
+		return nil, helpers.ErrToRPCError(err)
 	}
 
 	return &empty.Empty{}, nil
@@ -503,7 +510,7 @@ func (a *MulticastGroupAPI) ListQueue(ctx context.Context, req *pb.ListMulticast
 		MulticastGroupId: mgID.Bytes(),
 	})
 	if err != nil {
-		return nil, err
+		return nil, helpers.ErrToRPCError(err)
 	}
 
 	var resp pb.ListMulticastGroupQueueItemsResponse
@@ -526,3 +533,119 @@ func (a *MulticastGroupAPI) ListQueue(ctx context.Context, req *pb.ListMulticast
 
 	return &resp, nil
 }
+
+// CreateQueueSchedule creates the given multicast-group queue schedule.
+func (a *MulticastGroupAPI) CreateQueueSchedule(ctx context.Context, req *pb.CreateMulticastQueueScheduleRequest) (*pb.CreateMulticastQueueScheduleResponse, error) {
+	if req.MulticastQueueSchedule == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "multicast_queue_schedule must not be nil")
+	}
+
+	if req.MulticastQueueSchedule.FPort == 0 || req.MulticastQueueSchedule.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "f_port must be > 0 and < 256")
+	}
+
+	mgID, err := uuid.FromString(req.MulticastQueueSchedule.MulticastGroupId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "multicast_group_id: %s", err)
+	}
+
+	if err = a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupQueueAccess(auth.Create, mgID)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	// make sure the multicast-group exists
+	if _, err := storage.GetMulticastGroup(storage.DB(), mgID, false, true); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	j, err := multicast.CreateQueueSchedule(storage.DB(), req.MulticastQueueSchedule.Cron, req.MulticastQueueSchedule.Timezone, multicast.QueueSchedulePayload{
+		MulticastGroupID: mgID,
+		FPort:            uint8(req.MulticastQueueSchedule.FPort),
+		Data:             req.MulticastQueueSchedule.Data,
+	})
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.CreateMulticastQueueScheduleResponse{
+		Id: j.ID.String(),
+	}, nil
+}
+
+// ListQueueSchedules lists the multicast-group queue schedules.
+func (a *MulticastGroupAPI) ListQueueSchedules(ctx context.Context, req *pb.ListMulticastQueueSchedulesRequest) (*pb.ListMulticastQueueSchedulesResponse, error) {
+	mgID, err := uuid.FromString(req.MulticastGroupId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "multicast_group_id: %s", err)
+	}
+
+	if err = a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupQueueAccess(auth.Read, mgID)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	jobs, err := multicast.GetQueueSchedules(storage.DB(), mgID)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var resp pb.ListMulticastQueueSchedulesResponse
+	for _, j := range jobs {
+		p, err := multicast.GetQueueSchedulePayload(j)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		item := pb.MulticastQueueSchedule{
+			Id:               j.ID.String(),
+			MulticastGroupId: mgID.String(),
+			Cron:             j.Cron,
+			Timezone:         j.Timezone,
+			FPort:            uint32(p.FPort),
+			Data:             p.Data,
+			LastError:        j.LastError,
+		}
+
+		item.NextRunAt, err = ptypes.TimestampProto(j.NextRunAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+		if j.LastRunAt != nil {
+			item.LastRunAt, err = ptypes.TimestampProto(*j.LastRunAt)
+			if err != nil {
+				return nil, helpers.ErrToRPCError(err)
+			}
+		}
+
+		resp.MulticastQueueSchedules = append(resp.MulticastQueueSchedules, &item)
+	}
+
+	return &resp, nil
+}
+
+// DeleteQueueSchedule deletes the given multicast-group queue schedule.
+func (a *MulticastGroupAPI) DeleteQueueSchedule(ctx context.Context, req *pb.DeleteMulticastQueueScheduleRequest) (*empty.Empty, error) {
+	mgID, err := uuid.FromString(req.MulticastGroupId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "multicast_group_id: %s", err)
+	}
+
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "id: %s", err)
+	}
+
+	if err = a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupQueueAccess(auth.Delete, mgID)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err = storage.Transaction(func(tx sqlx.Ext) error {
+		return multicast.DeleteQueueSchedule(tx, mgID, id)
+	}); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -52,6 +52,7 @@ func (a *NetworkServerAPI) Create(ctx context.Context, req *pb.CreateNetworkServ
 		GatewayDiscoveryInterval:    int(req.NetworkServer.GatewayDiscoveryInterval),
 		GatewayDiscoveryTXFrequency: int(req.NetworkServer.GatewayDiscoveryTxFrequency),
 		GatewayDiscoveryDR:          int(req.NetworkServer.GatewayDiscoveryDr),
+		ClientCertFingerprints:      req.NetworkServer.ClientCertFingerprints,
 	}
 
 	err := storage.Transaction(func(tx sqlx.Ext) error {
@@ -104,6 +105,7 @@ func (a *NetworkServerAPI) Get(ctx context.Context, req *pb.GetNetworkServerRequ
 			GatewayDiscoveryInterval:    uint32(n.GatewayDiscoveryInterval),
 			GatewayDiscoveryTxFrequency: uint32(n.GatewayDiscoveryTXFrequency),
 			GatewayDiscoveryDr:          uint32(n.GatewayDiscoveryDR),
+			ClientCertFingerprints:      n.ClientCertFingerprints,
 		},
 		Region:  region,
 		Version: version,
@@ -148,6 +150,7 @@ func (a *NetworkServerAPI) Update(ctx context.Context, req *pb.UpdateNetworkServ
 	ns.GatewayDiscoveryInterval = int(req.NetworkServer.GatewayDiscoveryInterval)
 	ns.GatewayDiscoveryTXFrequency = int(req.NetworkServer.GatewayDiscoveryTxFrequency)
 	ns.GatewayDiscoveryDR = int(req.NetworkServer.GatewayDiscoveryDr)
+	ns.ClientCertFingerprints = req.NetworkServer.ClientCertFingerprints
 
 	if req.NetworkServer.TlsKey != "" {
 		ns.TLSKey = req.NetworkServer.TlsKey
@@ -1,9 +1,17 @@
 package external
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -11,7 +19,11 @@ import (
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/external/auth"
 	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/http"
+	"github.com/brocaar/lora-app-server/internal/integration/influxdb"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/ns"
 )
 
 // OrganizationAPI exports the organization related functions.
@@ -53,6 +65,108 @@ func (a *OrganizationAPI) Create(ctx context.Context, req *pb.CreateOrganization
 	}, nil
 }
 
+// CreateBundle creates the given organization, its initial admin user, a
+// service-profile on the given network-server and a default application.
+// Either all or none of these are created.
+func (a *OrganizationAPI) CreateBundle(ctx context.Context, req *pb.CreateOrganizationBundleRequest) (*pb.CreateOrganizationBundleResponse, error) {
+	if req.Organization == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "organization must not be nil")
+	}
+	if req.AdminUser == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "admin_user must not be nil")
+	}
+
+	// both creating organizations and service-profiles require global
+	// admin permissions
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationsAccess(auth.Create)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	org := storage.Organization{
+		Name:            req.Organization.Name,
+		DisplayName:     req.Organization.DisplayName,
+		CanHaveGateways: req.Organization.CanHaveGateways,
+	}
+
+	sp := storage.ServiceProfile{
+		NetworkServerID: req.NetworkServerId,
+		Name:            req.ServiceProfileName,
+		ServiceProfile: ns.ServiceProfile{
+			AddGwMetadata:          true,
+			ReportDevStatusBattery: true,
+			ReportDevStatusMargin:  true,
+		},
+	}
+	if sp.Name == "" {
+		sp.Name = org.Name
+	}
+
+	app := storage.Application{
+		Name:        req.ApplicationName,
+		Description: "Default application",
+	}
+	if app.Name == "" {
+		app.Name = "default"
+	}
+
+	var userID int64
+
+	// as this also performs a remote call to create the service-profile
+	// on the network-server, wrap it in a transaction
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		if err := storage.CreateOrganization(tx, &org); err != nil {
+			return helpers.ErrToRPCError(errors.Wrap(err, "create organization error"))
+		}
+
+		if req.AdminUser.Id != 0 {
+			user, err := storage.GetUser(tx, req.AdminUser.Id)
+			if err != nil {
+				return helpers.ErrToRPCError(errors.Wrap(err, "get user error"))
+			}
+			userID = user.ID
+		} else {
+			var err error
+			userID, err = storage.CreateUser(tx, &storage.User{
+				Username: req.AdminUser.Username,
+				Email:    req.AdminUser.Email,
+				Note:     req.AdminUser.Note,
+				IsActive: true,
+			}, req.AdminUser.Password)
+			if err != nil {
+				return helpers.ErrToRPCError(errors.Wrap(err, "create user error"))
+			}
+		}
+
+		if err := storage.CreateOrganizationUser(tx, org.ID, userID, true); err != nil {
+			return helpers.ErrToRPCError(errors.Wrap(err, "create organization user error"))
+		}
+
+		sp.OrganizationID = org.ID
+		if err := storage.CreateServiceProfile(tx, &sp); err != nil {
+			return helpers.ErrToRPCError(errors.Wrap(err, "create service-profile error"))
+		}
+
+		app.OrganizationID = org.ID
+		copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+		if err := storage.CreateApplication(tx, &app); err != nil {
+			return helpers.ErrToRPCError(errors.Wrap(err, "create application error"))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateOrganizationBundleResponse{
+		OrganizationId:   org.ID,
+		UserId:           userID,
+		ServiceProfileId: app.ServiceProfileID.String(),
+		ApplicationId:    app.ID,
+	}, nil
+}
+
 // Get returns the organization matching the given ID.
 func (a *OrganizationAPI) Get(ctx context.Context, req *pb.GetOrganizationRequest) (*pb.GetOrganizationResponse, error) {
 	if err := a.validator.Validate(ctx,
@@ -101,7 +215,21 @@ func (a *OrganizationAPI) List(ctx context.Context, req *pb.ListOrganizationRequ
 	var count int
 	var orgs []storage.Organization
 
-	if isAdmin {
+	if id, ok := auth.HostOrganizationIDFromContext(ctx); ok {
+		// on a hostname mapped to an organization, only this organization
+		// is listed (the access has been validated by the validator)
+		org, err := storage.GetOrganization(storage.DB(), id)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		if strings.Contains(strings.ToLower(org.Name), strings.ToLower(req.Search)) {
+			count = 1
+			if req.Offset == 0 {
+				orgs = append(orgs, org)
+			}
+		}
+	} else if isAdmin {
 		count, err = storage.GetOrganizationCount(storage.DB(), req.Search)
 		if err != nil {
 			return nil, helpers.ErrToRPCError(err)
@@ -309,6 +437,49 @@ func (a *OrganizationAPI) DeleteUser(ctx context.Context, req *pb.DeleteOrganiza
 	return &empty.Empty{}, nil
 }
 
+// BatchUpdateUsers adds, updates and removes the given organization users
+// within a single transaction.
+func (a *OrganizationAPI) BatchUpdateUsers(ctx context.Context, req *pb.BatchUpdateOrganizationUsersRequest) (*pb.BatchUpdateOrganizationUsersResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationUsersAccess(auth.Create, req.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	for _, u := range req.Users {
+		if u == nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "users must not contain nil items")
+		}
+	}
+
+	username, err := a.validator.GetUsername(ctx)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return batchUpdateOrganizationUsers(username, req.OrganizationId, req.Users, req.RemoveUserIds, req.RemoveMissing)
+}
+
+// ImportUsers adds and updates the organization users from the given CSV
+// document within a single transaction.
+func (a *OrganizationAPI) ImportUsers(ctx context.Context, req *pb.ImportOrganizationUsersRequest) (*pb.BatchUpdateOrganizationUsersResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationUsersAccess(auth.Create, req.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	users, err := organizationUsersFromCSV(req.Csv)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "parse csv error: %s", err)
+	}
+
+	username, err := a.validator.GetUsername(ctx)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return batchUpdateOrganizationUsers(username, req.OrganizationId, users, nil, req.RemoveMissing)
+}
+
 // GetUser returns the user details for the given user ID.
 func (a *OrganizationAPI) GetUser(ctx context.Context, req *pb.GetOrganizationUserRequest) (*pb.GetOrganizationUserResponse, error) {
 	if err := a.validator.Validate(ctx,
@@ -341,3 +512,626 @@ func (a *OrganizationAPI) GetUser(ctx context.Context, req *pb.GetOrganizationUs
 
 	return &resp, nil
 }
+
+// CreateHTTPIntegration creates an HTTP organization-integration.
+func (a *OrganizationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.CreateOrganizationHTTPIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.Integration.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	confJSON, err := organizationHTTPIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	integration := storage.OrganizationIntegration{
+		OrganizationID: in.Integration.OrganizationId,
+		ApplicationIDs: pq.Int64Array(in.Integration.ApplicationIds),
+		Kind:           integration.HTTP,
+		Settings:       confJSON,
+	}
+	if err = storage.CreateOrganizationIntegration(storage.DB(), &integration); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetHTTPIntegration returns the HTTP organization-integration.
+func (a *OrganizationAPI) GetHTTPIntegration(ctx context.Context, in *pb.GetOrganizationHTTPIntegrationRequest) (*pb.GetOrganizationHTTPIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(storage.DB(), in.OrganizationId, integration.HTTP)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var conf http.Config
+	if err = json.Unmarshal(integration.Settings, &conf); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var headers []*pb.HTTPIntegrationHeader
+	for k, v := range conf.Headers {
+		headers = append(headers, &pb.HTTPIntegrationHeader{
+			Key:   k,
+			Value: v,
+		})
+	}
+
+	return &pb.GetOrganizationHTTPIntegrationResponse{
+		Integration: &pb.OrganizationHTTPIntegration{
+			OrganizationId:          integration.OrganizationID,
+			ApplicationIds:          []int64(integration.ApplicationIDs),
+			Headers:                 headers,
+			UplinkDataUrl:           conf.DataUpURL,
+			JoinNotificationUrl:     conf.JoinNotificationURL,
+			AckNotificationUrl:      conf.ACKNotificationURL,
+			ErrorNotificationUrl:    conf.ErrorNotificationURL,
+			StatusNotificationUrl:   conf.StatusNotificationURL,
+			LocationNotificationUrl: conf.LocationNotificationURL,
+			Endpoints:               httpIntegrationEndpointsToPB(conf.Endpoints),
+		},
+	}, nil
+}
+
+// UpdateHTTPIntegration updates the HTTP organization-integration.
+func (a *OrganizationAPI) UpdateHTTPIntegration(ctx context.Context, in *pb.UpdateOrganizationHTTPIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.Integration.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(storage.DB(), in.Integration.OrganizationId, integration.HTTP)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	confJSON, err := organizationHTTPIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	integration.ApplicationIDs = pq.Int64Array(in.Integration.ApplicationIds)
+	integration.Settings = confJSON
+
+	if err = storage.UpdateOrganizationIntegration(storage.DB(), &integration); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteHTTPIntegration deletes the HTTP organization-integration.
+func (a *OrganizationAPI) DeleteHTTPIntegration(ctx context.Context, in *pb.DeleteOrganizationHTTPIntegrationRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(storage.DB(), in.OrganizationId, integration.HTTP)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if err = storage.DeleteOrganizationIntegration(storage.DB(), integration.ID); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// CreateInfluxDBIntegration creates an InfluxDB organization-integration.
+func (a *OrganizationAPI) CreateInfluxDBIntegration(ctx context.Context, in *pb.CreateOrganizationInfluxDBIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.Integration.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	confJSON, err := organizationInfluxDBIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	integration := storage.OrganizationIntegration{
+		OrganizationID: in.Integration.OrganizationId,
+		ApplicationIDs: pq.Int64Array(in.Integration.ApplicationIds),
+		Kind:           integration.InfluxDB,
+		Settings:       confJSON,
+	}
+	if err = storage.CreateOrganizationIntegration(storage.DB(), &integration); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetInfluxDBIntegration returns the InfluxDB organization-integration.
+func (a *OrganizationAPI) GetInfluxDBIntegration(ctx context.Context, in *pb.GetOrganizationInfluxDBIntegrationRequest) (*pb.GetOrganizationInfluxDBIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(storage.DB(), in.OrganizationId, integration.InfluxDB)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var conf influxdb.Config
+	if err = json.Unmarshal(integration.Settings, &conf); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	prec, _ := pb.InfluxDBPrecision_value[strings.ToUpper(conf.Precision)]
+
+	return &pb.GetOrganizationInfluxDBIntegrationResponse{
+		Integration: &pb.OrganizationInfluxDBIntegration{
+			OrganizationId:      integration.OrganizationID,
+			ApplicationIds:      []int64(integration.ApplicationIDs),
+			Endpoint:            conf.Endpoint,
+			Db:                  conf.DB,
+			Username:            conf.Username,
+			Password:            conf.Password,
+			RetentionPolicyName: conf.RetentionPolicyName,
+			Precision:           pb.InfluxDBPrecision(prec),
+		},
+	}, nil
+}
+
+// UpdateInfluxDBIntegration updates the InfluxDB organization-integration.
+func (a *OrganizationAPI) UpdateInfluxDBIntegration(ctx context.Context, in *pb.UpdateOrganizationInfluxDBIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.Integration.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(storage.DB(), in.Integration.OrganizationId, integration.InfluxDB)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	confJSON, err := organizationInfluxDBIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	integration.ApplicationIDs = pq.Int64Array(in.Integration.ApplicationIds)
+	integration.Settings = confJSON
+
+	if err = storage.UpdateOrganizationIntegration(storage.DB(), &integration); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteInfluxDBIntegration deletes the InfluxDB organization-integration.
+func (a *OrganizationAPI) DeleteInfluxDBIntegration(ctx context.Context, in *pb.DeleteOrganizationInfluxDBIntegrationRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(storage.DB(), in.OrganizationId, integration.InfluxDB)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if err = storage.DeleteOrganizationIntegration(storage.DB(), integration.ID); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ListIntegrations lists all configured organization-integrations.
+func (a *OrganizationAPI) ListIntegrations(ctx context.Context, in *pb.ListOrganizationIntegrationRequest) (*pb.ListOrganizationIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integrations, err := storage.GetOrganizationIntegrationsForOrganizationID(storage.DB(), in.OrganizationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	out := pb.ListOrganizationIntegrationResponse{
+		TotalCount: int64(len(integrations)),
+	}
+
+	for _, intgr := range integrations {
+		switch intgr.Kind {
+		case integration.HTTP:
+			out.Result = append(out.Result, &pb.IntegrationListItem{Kind: pb.IntegrationKind_HTTP})
+		case integration.InfluxDB:
+			out.Result = append(out.Result, &pb.IntegrationListItem{Kind: pb.IntegrationKind_INFLUXDB})
+		default:
+			return nil, grpc.Errorf(codes.Internal, "unknown integration kind: %s", intgr.Kind)
+		}
+	}
+
+	return &out, nil
+}
+
+// CreateHost maps the given hostname to the organization.
+func (a *OrganizationAPI) CreateHost(ctx context.Context, in *pb.CreateOrganizationHostRequest) (*empty.Empty, error) {
+	if in.Host == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "host must not be nil")
+	}
+
+	// the hostname mapping is managed by the global admin, as it requires
+	// the (DNS) configuration of the deployment
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Delete, in.Host.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	h := storage.OrganizationHost{
+		Hostname:             in.Host.Hostname,
+		OrganizationID:       in.Host.OrganizationId,
+		BrandingLogo:         in.Host.BrandingLogo,
+		BrandingRegistration: in.Host.BrandingRegistration,
+		BrandingFooter:       in.Host.BrandingFooter,
+	}
+
+	if err := storage.CreateOrganizationHost(storage.DB(), &h); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	invalidateOrganizationHost(h.Hostname)
+
+	return &empty.Empty{}, nil
+}
+
+// UpdateHost updates the given organization host.
+func (a *OrganizationAPI) UpdateHost(ctx context.Context, in *pb.UpdateOrganizationHostRequest) (*empty.Empty, error) {
+	if in.Host == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "host must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.Host.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	h, err := getOrganizationHostForOrganizationID(in.Host.OrganizationId, in.Host.Hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	// the registration and footer branding are rendered as HTML by the
+	// web-interface, therefore only global admin users are able to change these
+	if in.Host.BrandingRegistration != h.BrandingRegistration || in.Host.BrandingFooter != h.BrandingFooter {
+		isAdmin, err := a.validator.GetIsAdmin(ctx)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+		if !isAdmin {
+			return nil, grpc.Errorf(codes.PermissionDenied, "the registration and footer branding can only be changed by global admin users")
+		}
+	}
+
+	h.BrandingLogo = in.Host.BrandingLogo
+	h.BrandingRegistration = in.Host.BrandingRegistration
+	h.BrandingFooter = in.Host.BrandingFooter
+
+	if err := storage.UpdateOrganizationHost(storage.DB(), &h); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	invalidateOrganizationHost(h.Hostname)
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteHost deletes the given organization host.
+func (a *OrganizationAPI) DeleteHost(ctx context.Context, in *pb.DeleteOrganizationHostRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Delete, in.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	h, err := getOrganizationHostForOrganizationID(in.OrganizationId, in.Hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := storage.DeleteOrganizationHost(storage.DB(), h.Hostname); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	invalidateOrganizationHost(h.Hostname)
+
+	return &empty.Empty{}, nil
+}
+
+// ListHosts lists the hosts of the organization.
+func (a *OrganizationAPI) ListHosts(ctx context.Context, in *pb.ListOrganizationHostsRequest) (*pb.ListOrganizationHostsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	hosts, err := storage.GetOrganizationHostsForOrganizationID(storage.DB(), in.OrganizationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var out pb.ListOrganizationHostsResponse
+	for _, h := range hosts {
+		out.Result = append(out.Result, &pb.OrganizationHost{
+			Hostname:             h.Hostname,
+			OrganizationId:       h.OrganizationID,
+			BrandingLogo:         h.BrandingLogo,
+			BrandingRegistration: h.BrandingRegistration,
+			BrandingFooter:       h.BrandingFooter,
+		})
+	}
+
+	return &out, nil
+}
+
+// getOrganizationHostForOrganizationID returns the organization host for the
+// given hostname, after validating that it belongs to the given organization.
+func getOrganizationHostForOrganizationID(organizationID int64, hostname string) (storage.OrganizationHost, error) {
+	h, err := storage.GetOrganizationHost(storage.DB(), hostname)
+	if err != nil {
+		return h, helpers.ErrToRPCError(err)
+	}
+
+	if h.OrganizationID != organizationID {
+		return h, helpers.ErrToRPCError(storage.ErrDoesNotExist)
+	}
+
+	return h, nil
+}
+
+func organizationHTTPIntegrationSettings(in *pb.OrganizationHTTPIntegration) ([]byte, error) {
+	headers := make(map[string]string)
+	for _, h := range in.Headers {
+		headers[h.Key] = h.Value
+	}
+
+	conf := http.Config{
+		Headers:                 headers,
+		DataUpURL:               in.UplinkDataUrl,
+		JoinNotificationURL:     in.JoinNotificationUrl,
+		ACKNotificationURL:      in.AckNotificationUrl,
+		ErrorNotificationURL:    in.ErrorNotificationUrl,
+		StatusNotificationURL:   in.StatusNotificationUrl,
+		LocationNotificationURL: in.LocationNotificationUrl,
+		Endpoints:               httpIntegrationEndpointsFromPB(in.Endpoints),
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(conf)
+}
+
+func organizationInfluxDBIntegrationSettings(in *pb.OrganizationInfluxDBIntegration) ([]byte, error) {
+	conf := influxdb.Config{
+		Endpoint:            in.Endpoint,
+		DB:                  in.Db,
+		Username:            in.Username,
+		Password:            in.Password,
+		RetentionPolicyName: in.RetentionPolicyName,
+		Precision:           strings.ToLower(in.Precision.String()),
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(conf)
+}
+
+// batchUpdateOrganizationUsers adds or updates the given users and removes
+// the given user ids (and optionally all the users which are not in users)
+// from the organization. Either all changes are applied or none.
+// The calling user is never removed by removeMissing and can not be removed
+// explicitly. The changes are rejected when they would remove the last
+// admin user of the organization.
+func batchUpdateOrganizationUsers(username string, organizationID int64, users []*pb.OrganizationUser, removeUserIDs []int64, removeMissing bool) (*pb.BatchUpdateOrganizationUsersResponse, error) {
+	var resp pb.BatchUpdateOrganizationUsersResponse
+
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		caller, err := storage.GetUserByUsername(tx, username)
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+
+		for _, userID := range removeUserIDs {
+			if userID == caller.ID {
+				return grpc.Errorf(codes.FailedPrecondition, "the calling user can not be removed")
+			}
+		}
+
+		hadAdmin, err := organizationHasAdminUser(tx, organizationID)
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+
+		keep := map[int64]struct{}{
+			caller.ID: {},
+		}
+
+		for _, u := range users {
+			userID := u.UserId
+			if userID == 0 {
+				user, err := storage.GetUserByUsername(tx, u.Username)
+				if err != nil {
+					if errors.Cause(err) == storage.ErrDoesNotExist {
+						return grpc.Errorf(codes.NotFound, "user %s does not exist", u.Username)
+					}
+					return helpers.ErrToRPCError(err)
+				}
+				userID = user.ID
+			}
+			keep[userID] = struct{}{}
+
+			ou, err := storage.GetOrganizationUser(tx, organizationID, userID)
+			if err != nil {
+				if errors.Cause(err) != storage.ErrDoesNotExist {
+					return helpers.ErrToRPCError(err)
+				}
+
+				if err := storage.CreateOrganizationUser(tx, organizationID, userID, u.IsAdmin); err != nil {
+					return helpers.ErrToRPCError(err)
+				}
+				resp.AddedCount++
+				continue
+			}
+
+			if ou.IsAdmin != u.IsAdmin {
+				if err := storage.UpdateOrganizationUser(tx, organizationID, userID, u.IsAdmin); err != nil {
+					return helpers.ErrToRPCError(err)
+				}
+				resp.UpdatedCount++
+			}
+		}
+
+		if removeMissing {
+			count, err := storage.GetOrganizationUserCount(tx, organizationID)
+			if err != nil {
+				return helpers.ErrToRPCError(err)
+			}
+			ous, err := storage.GetOrganizationUsers(tx, organizationID, count, 0)
+			if err != nil {
+				return helpers.ErrToRPCError(err)
+			}
+			for _, ou := range ous {
+				if _, ok := keep[ou.UserID]; !ok {
+					removeUserIDs = append(removeUserIDs, ou.UserID)
+				}
+			}
+		}
+
+		for _, userID := range removeUserIDs {
+			if err := storage.DeleteOrganizationUser(tx, organizationID, userID); err != nil {
+				return helpers.ErrToRPCError(err)
+			}
+			resp.RemovedCount++
+		}
+
+		hasAdmin, err := organizationHasAdminUser(tx, organizationID)
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+		if hadAdmin && !hasAdmin {
+			return grpc.Errorf(codes.FailedPrecondition, "the last admin user of the organization can not be removed")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// organizationHasAdminUser returns if the given organization has at least
+// one admin user.
+func organizationHasAdminUser(db sqlx.Queryer, organizationID int64) (bool, error) {
+	count, err := storage.GetOrganizationUserCount(db, organizationID)
+	if err != nil {
+		return false, err
+	}
+
+	ous, err := storage.GetOrganizationUsers(db, organizationID, count, 0)
+	if err != nil {
+		return false, err
+	}
+
+	for _, ou := range ous {
+		if ou.IsAdmin {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// organizationUsersFromCSV parses the given CSV document. The first row
+// must contain the column names.
+func organizationUsersFromCSV(s string) ([]*pb.OrganizationUser, error) {
+	r := csv.NewReader(strings.NewReader(s))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "read header error")
+	}
+
+	usernameCol, isAdminCol := -1, -1
+	for i, name := range header {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "username":
+			usernameCol = i
+		case "is_admin":
+			isAdminCol = i
+		}
+	}
+	if usernameCol == -1 {
+		return nil, errors.New("username column is missing")
+	}
+
+	var out []*pb.OrganizationUser
+	for row := 2; ; row++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		u := pb.OrganizationUser{
+			Username: strings.TrimSpace(record[usernameCol]),
+		}
+		if u.Username == "" {
+			return nil, errors.Errorf("row %d: username must not be empty", row)
+		}
+
+		if isAdminCol != -1 && strings.TrimSpace(record[isAdminCol]) != "" {
+			u.IsAdmin, err = strconv.ParseBool(strings.TrimSpace(record[isAdminCol]))
+			if err != nil {
+				return nil, errors.Errorf("row %d: invalid is_admin value: %s", row, record[isAdminCol])
+			}
+		}
+
+		out = append(out, &u)
+	}
+
+	return out, nil
+}
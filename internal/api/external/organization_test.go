@@ -1,12 +1,18 @@
 package external
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 )
@@ -103,6 +109,73 @@ func TestOrganizationAPI(t *testing.T) {
 
 				})
 
+				Convey("When creating a HTTP integration", func() {
+					req := pb.CreateOrganizationHTTPIntegrationRequest{
+						Integration: &pb.OrganizationHTTPIntegration{
+							OrganizationId: createResp.Id,
+							Headers: []*pb.HTTPIntegrationHeader{
+								{Key: "Foo", Value: "bar"},
+							},
+							UplinkDataUrl:           "http://up",
+							JoinNotificationUrl:     "http://join",
+							AckNotificationUrl:      "http://ack",
+							ErrorNotificationUrl:    "http://error",
+							StatusNotificationUrl:   "http://status",
+							LocationNotificationUrl: "http://location",
+						},
+					}
+					_, err := api.CreateHTTPIntegration(ctx, &req)
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the integration can be retrieved", func() {
+						i, err := api.GetHTTPIntegration(ctx, &pb.GetOrganizationHTTPIntegrationRequest{OrganizationId: createResp.Id})
+						So(err, ShouldBeNil)
+						So(i.Integration, ShouldResemble, req.Integration)
+						So(validator.validatorFuncs, ShouldHaveLength, 1)
+					})
+
+					Convey("Then the integrations can be listed", func() {
+						resp, err := api.ListIntegrations(ctx, &pb.ListOrganizationIntegrationRequest{OrganizationId: createResp.Id})
+						So(err, ShouldBeNil)
+						So(resp.TotalCount, ShouldEqual, 1)
+						So(resp.Result[0], ShouldResemble, &pb.IntegrationListItem{
+							Kind: pb.IntegrationKind_HTTP,
+						})
+					})
+
+					Convey("Then the integration can be updated", func() {
+						req := pb.UpdateOrganizationHTTPIntegrationRequest{
+							Integration: &pb.OrganizationHTTPIntegration{
+								OrganizationId:          createResp.Id,
+								UplinkDataUrl:           "http://up2",
+								JoinNotificationUrl:     "http://join2",
+								AckNotificationUrl:      "http://ack2",
+								ErrorNotificationUrl:    "http://error",
+								StatusNotificationUrl:   "http://status2",
+								LocationNotificationUrl: "http://location2",
+							},
+						}
+						_, err := api.UpdateHTTPIntegration(ctx, &req)
+						So(err, ShouldBeNil)
+						So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+						i, err := api.GetHTTPIntegration(ctx, &pb.GetOrganizationHTTPIntegrationRequest{OrganizationId: createResp.Id})
+						So(err, ShouldBeNil)
+						So(i.Integration, ShouldResemble, req.Integration)
+					})
+
+					Convey("Then the integration can be deleted", func() {
+						_, err := api.DeleteHTTPIntegration(ctx, &pb.DeleteOrganizationHTTPIntegrationRequest{OrganizationId: createResp.Id})
+						So(err, ShouldBeNil)
+						So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+						_, err = api.GetHTTPIntegration(ctx, &pb.GetOrganizationHTTPIntegrationRequest{OrganizationId: createResp.Id})
+						So(err, ShouldNotBeNil)
+						So(grpc.Code(err), ShouldEqual, codes.NotFound)
+					})
+				})
+
 				// Add a new user for adding to the organization.
 				Convey("When adding a user", func() {
 					userReq := &pb.CreateUserRequest{
@@ -218,6 +291,119 @@ func TestOrganizationAPI(t *testing.T) {
 						})
 					})
 
+					Convey("When importing the organization users from CSV", func() {
+						user2Resp, err := userAPI.Create(ctx, &pb.CreateUserRequest{
+							User: &pb.User{
+								Username:   "username2",
+								IsActive:   true,
+								SessionTtl: 180,
+								Email:      "foo2@bar.com",
+							},
+							Password: "pass^^ord",
+						})
+						So(err, ShouldBeNil)
+
+						resp, err := api.ImportUsers(ctx, &pb.ImportOrganizationUsersRequest{
+							OrganizationId: createResp.Id,
+							Csv:            "username,is_admin\nusername,true\nusername2,\n",
+						})
+						So(err, ShouldBeNil)
+						So(resp.AddedCount, ShouldEqual, 2)
+
+						Convey("Then the users are part of the organization", func() {
+							ou, err := api.GetUser(ctx, &pb.GetOrganizationUserRequest{
+								OrganizationId: createResp.Id,
+								UserId:         userResp.Id,
+							})
+							So(err, ShouldBeNil)
+							So(ou.OrganizationUser.IsAdmin, ShouldBeTrue)
+
+							ou, err = api.GetUser(ctx, &pb.GetOrganizationUserRequest{
+								OrganizationId: createResp.Id,
+								UserId:         user2Resp.Id,
+							})
+							So(err, ShouldBeNil)
+							So(ou.OrganizationUser.IsAdmin, ShouldBeFalse)
+						})
+
+						Convey("When importing a CSV referring to an unknown user", func() {
+							_, err := api.ImportUsers(ctx, &pb.ImportOrganizationUsersRequest{
+								OrganizationId: createResp.Id,
+								Csv:            "username\nusername\nunknown\n",
+								RemoveMissing:  true,
+							})
+
+							Convey("Then nothing has been changed", func() {
+								So(grpc.Code(err), ShouldEqual, codes.NotFound)
+
+								orgUsers, err := api.ListUsers(ctx, &pb.ListOrganizationUsersRequest{
+									OrganizationId: createResp.Id,
+									Limit:          10,
+								})
+								So(err, ShouldBeNil)
+								So(orgUsers.TotalCount, ShouldEqual, 2)
+							})
+						})
+
+						Convey("When batch updating the users with remove missing", func() {
+							resp, err := api.BatchUpdateUsers(ctx, &pb.BatchUpdateOrganizationUsersRequest{
+								OrganizationId: createResp.Id,
+								Users: []*pb.OrganizationUser{
+									{UserId: user2Resp.Id, IsAdmin: true},
+								},
+								RemoveMissing: true,
+							})
+							So(err, ShouldBeNil)
+							So(resp.AddedCount, ShouldEqual, 0)
+							So(resp.UpdatedCount, ShouldEqual, 1)
+							So(resp.RemovedCount, ShouldEqual, 0)
+
+							Convey("Then the calling user has not been removed", func() {
+								orgUsers, err := api.ListUsers(ctx, &pb.ListOrganizationUsersRequest{
+									OrganizationId: createResp.Id,
+									Limit:          10,
+								})
+								So(err, ShouldBeNil)
+								So(orgUsers.Result, ShouldHaveLength, 2)
+							})
+						})
+
+						Convey("When batch updating the users removing the calling user", func() {
+							_, err := api.BatchUpdateUsers(ctx, &pb.BatchUpdateOrganizationUsersRequest{
+								OrganizationId: createResp.Id,
+								Users: []*pb.OrganizationUser{
+									{UserId: user2Resp.Id, IsAdmin: true},
+								},
+								RemoveUserIds: []int64{userResp.Id},
+							})
+
+							Convey("Then an error is returned", func() {
+								So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+							})
+						})
+
+						Convey("When batch updating the users removing the last admin", func() {
+							_, err := api.BatchUpdateUsers(ctx, &pb.BatchUpdateOrganizationUsersRequest{
+								OrganizationId: createResp.Id,
+								Users: []*pb.OrganizationUser{
+									{UserId: userResp.Id, IsAdmin: false},
+								},
+								RemoveMissing: true,
+							})
+
+							Convey("Then an error is returned and nothing has been changed", func() {
+								So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+
+								ou, err := api.GetUser(ctx, &pb.GetOrganizationUserRequest{
+									OrganizationId: createResp.Id,
+									UserId:         userResp.Id,
+								})
+								So(err, ShouldBeNil)
+								So(ou.OrganizationUser.IsAdmin, ShouldBeTrue)
+							})
+						})
+					})
+
 					Convey("When deleting the organization", func() {
 						validator.returnIsAdmin = true
 
@@ -240,5 +426,128 @@ func TestOrganizationAPI(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("Given a network-server", func() {
+			nsClient := mock.NewClient()
+			networkserver.SetPool(mock.NewPool(nsClient))
+
+			n := storage.NetworkServer{
+				Name:   "test-ns",
+				Server: "test-ns:1234",
+			}
+			So(storage.CreateNetworkServer(storage.DB(), &n), ShouldBeNil)
+
+			createReq := pb.CreateOrganizationBundleRequest{
+				Organization: &pb.Organization{
+					Name:        "bundle-org",
+					DisplayName: "Bundle Organization",
+				},
+				AdminUser: &pb.OrganizationBundleAdminUser{
+					Username: "bundle-admin",
+					Email:    "admin@example.com",
+					Password: "password123",
+				},
+				NetworkServerId: n.ID,
+			}
+
+			Convey("When creating an organization bundle", func() {
+				validator.returnIsAdmin = true
+				resp, err := api.CreateBundle(ctx, &createReq)
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				Convey("Then the organization, admin user, service-profile and application have been created", func() {
+					org, err := storage.GetOrganization(storage.DB(), resp.OrganizationId)
+					So(err, ShouldBeNil)
+					So(org.Name, ShouldEqual, "bundle-org")
+
+					orgUser, err := storage.GetOrganizationUser(storage.DB(), resp.OrganizationId, resp.UserId)
+					So(err, ShouldBeNil)
+					So(orgUser.Username, ShouldEqual, "bundle-admin")
+					So(orgUser.IsAdmin, ShouldBeTrue)
+
+					spReq := <-nsClient.CreateServiceProfileChan
+					So(spReq.ServiceProfile.AddGwMetadata, ShouldBeTrue)
+
+					app, err := storage.GetApplication(storage.DB(), resp.ApplicationId)
+					So(err, ShouldBeNil)
+					So(app.Name, ShouldEqual, "default")
+					So(app.OrganizationID, ShouldEqual, resp.OrganizationId)
+					So(app.ServiceProfileID.String(), ShouldEqual, resp.ServiceProfileId)
+				})
+			})
+
+			Convey("When creating an organization bundle with an invalid application name", func() {
+				validator.returnIsAdmin = true
+				createReq.ApplicationName = "invalid name"
+				_, err := api.CreateBundle(ctx, &createReq)
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+
+				Convey("Then nothing has been created", func() {
+					count, err := storage.GetOrganizationCount(storage.DB(), "bundle-org")
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+
+					_, err = storage.GetUserByUsername(storage.DB(), "bundle-admin")
+					So(errors.Cause(err), ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+		})
+	})
+}
+
+func TestOrganizationUsersFromCSV(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name          string
+			CSV           string
+			Expected      []*pb.OrganizationUser
+			ExpectedError string
+		}{
+			{
+				Name: "username and is_admin",
+				CSV:  "username, is_admin\nuser-a, true\nuser-b, false\nuser-c,\n",
+				Expected: []*pb.OrganizationUser{
+					{Username: "user-a", IsAdmin: true},
+					{Username: "user-b"},
+					{Username: "user-c"},
+				},
+			},
+			{
+				Name: "username only",
+				CSV:  "Username\nuser-a\n",
+				Expected: []*pb.OrganizationUser{
+					{Username: "user-a"},
+				},
+			},
+			{
+				Name:          "missing username column",
+				CSV:           "email,is_admin\nfoo@example.com,true\n",
+				ExpectedError: "username column is missing",
+			},
+			{
+				Name:          "invalid is_admin",
+				CSV:           "username,is_admin\nuser-a,yes\n",
+				ExpectedError: "row 2: invalid is_admin value: yes",
+			},
+			{
+				Name:          "empty username",
+				CSV:           "username,is_admin\nuser-a,true\n,true\n",
+				ExpectedError: "row 3: username must not be empty",
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				users, err := organizationUsersFromCSV(test.CSV)
+				if test.ExpectedError != "" {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, test.ExpectedError)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(users, ShouldResemble, test.Expected)
+			})
+		}
 	})
 }
@@ -42,9 +42,10 @@ func (a *ServiceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateSer
 	}
 
 	sp := storage.ServiceProfile{
-		OrganizationID:  req.ServiceProfile.OrganizationId,
-		NetworkServerID: req.ServiceProfile.NetworkServerId,
-		Name:            req.ServiceProfile.Name,
+		OrganizationID:       req.ServiceProfile.OrganizationId,
+		NetworkServerID:      req.ServiceProfile.NetworkServerId,
+		Name:                 req.ServiceProfile.Name,
+		MetadataRedactFields: req.ServiceProfile.MetadataRedactFields,
 		ServiceProfile: ns.ServiceProfile{
 			UlRate:                 req.ServiceProfile.UlRate,
 			UlBucketSize:           req.ServiceProfile.UlBucketSize,
@@ -130,6 +131,7 @@ func (a *ServiceProfileServiceAPI) Get(ctx context.Context, req *pb.GetServicePr
 			MinGwDiversity:         sp.ServiceProfile.MinGwDiversity,
 			UlRatePolicy:           pb.RatePolicy(sp.ServiceProfile.UlRatePolicy),
 			DlRatePolicy:           pb.RatePolicy(sp.ServiceProfile.DlRatePolicy),
+			MetadataRedactFields:   sp.MetadataRedactFields,
 		},
 	}
 
@@ -168,6 +170,7 @@ func (a *ServiceProfileServiceAPI) Update(ctx context.Context, req *pb.UpdateSer
 	}
 
 	sp.Name = req.ServiceProfile.Name
+	sp.MetadataRedactFields = req.ServiceProfile.MetadataRedactFields
 	sp.ServiceProfile = ns.ServiceProfile{
 		Id:                     spID.Bytes(),
 		UlRate:                 req.ServiceProfile.UlRate,
@@ -228,6 +231,8 @@ func (a *ServiceProfileServiceAPI) Delete(ctx context.Context, req *pb.DeleteSer
 
 // List lists the available service-profiles.
 func (a *ServiceProfileServiceAPI) List(ctx context.Context, req *pb.ListServiceProfileRequest) (*pb.ListServiceProfileResponse, error) {
+	req.OrganizationId = hostOrganizationFilter(ctx, req.OrganizationId)
+
 	if err := a.validator.Validate(ctx,
 		auth.ValidateServiceProfilesAccess(auth.List, req.OrganizationId),
 	); err != nil {
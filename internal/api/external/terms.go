@@ -0,0 +1,198 @@
+package external
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/external/auth"
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// TermsAPI implements the terms api.
+type TermsAPI struct {
+	validator auth.Validator
+}
+
+// NewTermsAPI creates a new TermsAPI.
+func NewTermsAPI(validator auth.Validator) *TermsAPI {
+	return &TermsAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given terms version.
+func (a *TermsAPI) Create(ctx context.Context, req *pb.CreateTermsVersionRequest) (*pb.CreateTermsVersionResponse, error) {
+	if req.TermsVersion == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "terms_version must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateTermsAccess(auth.Create)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	t := storage.TermsVersion{
+		Version: req.TermsVersion.Version,
+		Text:    req.TermsVersion.Text,
+	}
+
+	if err := storage.CreateTermsVersion(storage.DB(), &t); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &pb.CreateTermsVersionResponse{
+		Id: t.ID,
+	}, nil
+}
+
+// GetCurrent returns the current terms version. As the terms must be
+// displayed before the user is logged in, this does not require
+// authentication.
+func (a *TermsAPI) GetCurrent(ctx context.Context, req *pb.GetCurrentTermsVersionRequest) (*pb.GetTermsVersionResponse, error) {
+	t, err := storage.GetCurrentTermsVersion(storage.DB())
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.GetTermsVersionResponse{
+		TermsVersion: &pb.TermsVersion{
+			Id:      t.ID,
+			Version: t.Version,
+			Text:    t.Text,
+		},
+	}
+
+	resp.CreatedAt, err = ptypes.TimestampProto(t.CreatedAt)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// List lists the terms versions.
+func (a *TermsAPI) List(ctx context.Context, req *pb.ListTermsVersionRequest) (*pb.ListTermsVersionResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateTermsAccess(auth.List)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetTermsVersionCount(storage.DB())
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	versions, err := storage.GetTermsVersions(storage.DB(), int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.ListTermsVersionResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, t := range versions {
+		item := pb.TermsVersionListItem{
+			Id:      t.ID,
+			Version: t.Version,
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(t.CreatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// ListAcceptances lists the acceptances of the given terms version.
+func (a *TermsAPI) ListAcceptances(ctx context.Context, req *pb.ListTermsAcceptanceRequest) (*pb.ListTermsAcceptanceResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateTermsAccess(auth.List)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetTermsAcceptanceCount(storage.DB(), req.TermsVersionId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	acceptances, err := storage.GetTermsAcceptances(storage.DB(), req.TermsVersionId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := pb.ListTermsAcceptanceResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, ta := range acceptances {
+		item := pb.TermsAcceptance{
+			UserId:   ta.UserID,
+			Username: ta.Username,
+		}
+
+		item.AcceptedAt, err = ptypes.TimestampProto(ta.AcceptedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// validateTermsAccepted returns a TERMS_NOT_ACCEPTED error when the given
+// user has not accepted the current terms version. When the given accepted
+// terms version id matches the current version, the acceptance is recorded.
+// Global admin users are exempt and nothing is rejected when the terms are
+// not enforced.
+func validateTermsAccepted(user storage.User, acceptedTermsVersionID int64) error {
+	if user.IsAdmin {
+		return nil
+	}
+
+	t, err := storage.GetCurrentTermsVersion(storage.DB())
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return nil
+		}
+		return helpers.ErrToRPCError(err)
+	}
+
+	if acceptedTermsVersionID == t.ID {
+		if err := storage.CreateTermsAcceptance(storage.DB(), t.ID, user.ID); err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+		return nil
+	}
+
+	if !enforceTerms {
+		return nil
+	}
+
+	accepted, err := storage.HasAcceptedTermsVersion(storage.DB(), t.ID, user.ID)
+	if err != nil {
+		return helpers.ErrToRPCError(err)
+	}
+	if accepted {
+		return nil
+	}
+
+	return helpers.ErrorWithDetails(codes.FailedPrecondition, &pb.ErrorDetails{
+		Code: pb.ErrorCode_TERMS_NOT_ACCEPTED,
+		TermsNotAccepted: &pb.TermsNotAcceptedDetails{
+			TermsVersionId: t.ID,
+			Version:        t.Version,
+		},
+	}, "terms version %s must be accepted", t.Version)
+}
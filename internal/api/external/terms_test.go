@@ -0,0 +1,186 @@
+package external
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func (ts *APITestSuite) TestTerms() {
+	assert := require.New(ts.T())
+
+	validator := &TestValidator{}
+	api := NewTermsAPI(validator)
+	apiInternal := NewInternalUserAPI(validator)
+
+	enforceTerms = true
+	defer func() {
+		enforceTerms = false
+	}()
+
+	user := storage.User{
+		Username: "termsuser",
+		IsActive: true,
+		Email:    "terms@example.com",
+	}
+	userID, err := storage.CreateUser(storage.DB(), &user, "password123")
+	assert.NoError(err)
+
+	loginReq := pb.LoginRequest{
+		Username: "termsuser",
+		Password: "password123",
+	}
+
+	ts.T().Run("Login without terms", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := apiInternal.Login(context.Background(), &loginReq)
+		assert.NoError(err)
+
+		_, err = api.GetCurrent(context.Background(), &pb.GetCurrentTermsVersionRequest{})
+		assert.Equal(codes.NotFound, grpc.Code(err))
+	})
+
+	ts.T().Run("Create invalid", func(t *testing.T) {
+		assert := require.New(t)
+
+		validator.returnIsAdmin = true
+		_, err := api.Create(context.Background(), &pb.CreateTermsVersionRequest{
+			TermsVersion: &pb.TermsVersion{
+				Version: "2019-01",
+			},
+		})
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		validator.returnIsAdmin = true
+		createResp, err := api.Create(context.Background(), &pb.CreateTermsVersionRequest{
+			TermsVersion: &pb.TermsVersion{
+				Version: "2019-01",
+				Text:    "<p>Terms of use</p>",
+			},
+		})
+		assert.NoError(err)
+		assert.NotEqual(0, createResp.Id)
+
+		t.Run("GetCurrent", func(t *testing.T) {
+			assert := require.New(t)
+
+			resp, err := api.GetCurrent(context.Background(), &pb.GetCurrentTermsVersionRequest{})
+			assert.NoError(err)
+			assert.Equal(&pb.TermsVersion{
+				Id:      createResp.Id,
+				Version: "2019-01",
+				Text:    "<p>Terms of use</p>",
+			}, resp.TermsVersion)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			resp, err := api.List(context.Background(), &pb.ListTermsVersionRequest{
+				Limit: 10,
+			})
+			assert.NoError(err)
+			assert.EqualValues(1, resp.TotalCount)
+			assert.Len(resp.Result, 1)
+			assert.Equal("2019-01", resp.Result[0].Version)
+		})
+
+		t.Run("Login without accepting", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := apiInternal.Login(context.Background(), &loginReq)
+			assert.Equal(codes.FailedPrecondition, grpc.Code(err))
+
+			s, _ := status.FromError(err)
+			assert.Len(s.Details(), 1)
+			details := s.Details()[0].(*pb.ErrorDetails)
+			assert.Equal(pb.ErrorCode_TERMS_NOT_ACCEPTED, details.Code)
+			assert.Equal(&pb.TermsNotAcceptedDetails{
+				TermsVersionId: createResp.Id,
+				Version:        "2019-01",
+			}, details.TermsNotAccepted)
+		})
+
+		t.Run("Login without accepting when not enforced", func(t *testing.T) {
+			assert := require.New(t)
+
+			enforceTerms = false
+			defer func() {
+				enforceTerms = true
+			}()
+
+			_, err := apiInternal.Login(context.Background(), &loginReq)
+			assert.NoError(err)
+		})
+
+		t.Run("Login as global admin without accepting", func(t *testing.T) {
+			assert := require.New(t)
+
+			admin := storage.User{
+				Username: "termsadmin",
+				IsActive: true,
+				IsAdmin:  true,
+				Email:    "terms-admin@example.com",
+			}
+			_, err := storage.CreateUser(storage.DB(), &admin, "password123")
+			assert.NoError(err)
+
+			_, err = apiInternal.Login(context.Background(), &pb.LoginRequest{
+				Username: "termsadmin",
+				Password: "password123",
+			})
+			assert.NoError(err)
+		})
+
+		t.Run("Login with invalid password and accepting", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := apiInternal.Login(context.Background(), &pb.LoginRequest{
+				Username:             "termsuser",
+				Password:             "invalid",
+				AcceptTermsVersionId: createResp.Id,
+			})
+			assert.Equal(codes.Unauthenticated, grpc.Code(err))
+
+			accepted, err := storage.HasAcceptedTermsVersion(storage.DB(), createResp.Id, userID)
+			assert.NoError(err)
+			assert.False(accepted)
+		})
+
+		t.Run("Login accepting", func(t *testing.T) {
+			assert := require.New(t)
+
+			req := loginReq
+			req.AcceptTermsVersionId = createResp.Id
+			_, err := apiInternal.Login(context.Background(), &req)
+			assert.NoError(err)
+
+			// the acceptance has been recorded
+			_, err = apiInternal.Login(context.Background(), &loginReq)
+			assert.NoError(err)
+
+			resp, err := api.ListAcceptances(context.Background(), &pb.ListTermsAcceptanceRequest{
+				TermsVersionId: createResp.Id,
+				Limit:          10,
+			})
+			assert.NoError(err)
+			assert.EqualValues(1, resp.TotalCount)
+			assert.Len(resp.Result, 1)
+			assert.Equal(userID, resp.Result[0].UserId)
+			assert.Equal("termsuser", resp.Result[0].Username)
+			assert.NotNil(resp.Result[0].AcceptedAt)
+		})
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/brocaar/lora-app-server/internal/api/external/auth"
 	"github.com/brocaar/lora-app-server/internal/api/helpers"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
 )
 
 // UserAPI exports the User related functions.
@@ -249,6 +250,27 @@ func (a *InternalUserAPI) Login(ctx context.Context, req *pb.LoginRequest) (*pb.
 		return nil, helpers.ErrToRPCError(err)
 	}
 
+	// on a hostname mapped to an organization, only the users of this
+	// organization (and global admins) are able to login
+	if orgID, ok := auth.HostOrganizationIDFromContext(ctx); ok {
+		ok, err := auth.ValidateOrganizationAccess(auth.Read, orgID)(storage.DB(), &auth.Claims{Username: req.Username})
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+		if !ok {
+			return nil, helpers.ErrToRPCError(storage.ErrInvalidUsernameOrPassword)
+		}
+	}
+
+	user, err := storage.GetUserByUsername(storage.DB(), req.Username)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	if err := validateTermsAccepted(user, req.AcceptTermsVersionId); err != nil {
+		return nil, err
+	}
+
 	return &pb.LoginResponse{Jwt: jwt}, nil
 }
 
@@ -320,6 +342,25 @@ func (a *InternalUserAPI) Branding(ctx context.Context, req *empty.Empty) (*pb.B
 		Logo:         brandingHeader,
 		Registration: brandingRegistration,
 		Footer:       brandingFooter,
+		LoginBanner:  brandingLoginBanner,
+	}
+
+	// the branding of a hostname mapped to an organization overrides the
+	// configured branding
+	h, ok, err := getOrganizationHost(ctx)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	if ok {
+		if h.BrandingLogo != "" {
+			resp.Logo = h.BrandingLogo
+		}
+		if h.BrandingRegistration != "" {
+			resp.Registration = h.BrandingRegistration
+		}
+		if h.BrandingFooter != "" {
+			resp.Footer = h.BrandingFooter
+		}
 	}
 
 	return &resp, nil
@@ -342,7 +383,11 @@ func (a *InternalUserAPI) GlobalSearch(ctx context.Context, req *pb.GlobalSearch
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	results, err := storage.GlobalSearch(storage.DB(), username, isAdmin, req.Search, int(req.Limit), int(req.Offset))
+	// on a hostname mapped to an organization, only the results of this
+	// organization are returned
+	hostOrgID, _ := auth.HostOrganizationIDFromContext(ctx)
+
+	results, err := storage.GlobalSearch(storage.DB(), username, isAdmin, hostOrgID, req.Search, int(req.Limit), int(req.Offset))
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
@@ -388,3 +433,58 @@ func (a *InternalUserAPI) GlobalSearch(ctx context.Context, req *pb.GlobalSearch
 
 	return &out, nil
 }
+
+// GetDeviceHistory returns all the applications in which the given DevEUI
+// exists or existed.
+func (a *InternalUserAPI) GetDeviceHistory(ctx context.Context, req *pb.GetDeviceHistoryRequest) (*pb.GetDeviceHistoryResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceHistoryAccess(auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	history, err := storage.GetDeviceHistoryForDevEUI(storage.DB(), devEUI)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	var out pb.GetDeviceHistoryResponse
+
+	for _, h := range history {
+		item := pb.DeviceHistoryItem{
+			OrganizationId:   h.OrganizationID,
+			OrganizationName: h.OrganizationName,
+			ApplicationId:    h.ApplicationID,
+			ApplicationName:  h.ApplicationName,
+			DeviceName:       h.DeviceName,
+			Activated:        h.Activated,
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(h.CreatedAt)
+		if err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+
+		if h.DeletedAt != nil {
+			item.DeletedAt, err = ptypes.TimestampProto(*h.DeletedAt)
+			if err != nil {
+				return nil, helpers.ErrToRPCError(err)
+			}
+		}
+
+		if h.LastSeenAt != nil {
+			item.LastSeenAt, err = ptypes.TimestampProto(*h.LastSeenAt)
+			if err != nil {
+				return nil, helpers.ErrToRPCError(err)
+			}
+		}
+
+		out.Result = append(out.Result, &item)
+	}
+
+	return &out, nil
+}
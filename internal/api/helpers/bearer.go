@@ -0,0 +1,32 @@
+package helpers
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+const bearerPrefix = "Bearer "
+
+// BearerToken returns the bearer token of the Authorization header of the
+// given request. It returns false when the request does not contain a
+// bearer token.
+func BearerToken(r *http.Request) (string, bool) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, bearerPrefix) {
+		return "", false
+	}
+
+	token := strings.TrimPrefix(auth, bearerPrefix)
+	return token, token != ""
+}
+
+// ValidateBearerToken returns if the given token equals the expected token.
+// The comparison is done in constant time. An empty expected token never
+// validates.
+func ValidateBearerToken(token, expected string) bool {
+	if expected == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1
+}
@@ -0,0 +1,46 @@
+package helpers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Authorization string
+		Token         string
+		OK            bool
+	}{
+		{Name: "bearer token", Authorization: "Bearer secret", Token: "secret", OK: true},
+		{Name: "no header"},
+		{Name: "empty token", Authorization: "Bearer "},
+		{Name: "basic auth", Authorization: "Basic c2VjcmV0"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			r, err := http.NewRequest("GET", "/", nil)
+			assert.NoError(err)
+			if tst.Authorization != "" {
+				r.Header.Set("Authorization", tst.Authorization)
+			}
+
+			token, ok := BearerToken(r)
+			assert.Equal(tst.OK, ok)
+			assert.Equal(tst.Token, token)
+		})
+	}
+}
+
+func TestValidateBearerToken(t *testing.T) {
+	assert := require.New(t)
+
+	assert.True(ValidateBearerToken("secret", "secret"))
+	assert.False(ValidateBearerToken("secret", "other"))
+	assert.False(ValidateBearerToken("", ""))
+}
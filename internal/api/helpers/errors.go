@@ -2,9 +2,13 @@ package helpers
 
 import (
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/integration/http"
 	"github.com/brocaar/lora-app-server/internal/integration/influxdb"
 	"github.com/brocaar/lora-app-server/internal/storage"
@@ -24,15 +28,109 @@ var errToCode = map[error]codes.Code{
 	storage.ErrInvalidEmail:                    codes.InvalidArgument,
 	storage.ErrInvalidGatewayDiscoveryInterval: codes.InvalidArgument,
 	storage.ErrDeviceProfileInvalidName:        codes.InvalidArgument,
+	storage.ErrInvalidCertFingerprint:          codes.InvalidArgument,
+	storage.ErrIntegrationInvalidApplication:   codes.InvalidArgument,
+	storage.ErrInvalidTimeRange:                codes.InvalidArgument,
+	storage.ErrServiceProfileOrganization:      codes.InvalidArgument,
+	storage.ErrNetworkServerMismatch:           codes.FailedPrecondition,
+	storage.ErrStorageClusterMismatch:          codes.FailedPrecondition,
+	storage.ErrStorageClusterDoesNotExist:      codes.NotFound,
+	storage.ErrDeviceKeyBatchInvalidBlock:      codes.InvalidArgument,
+	storage.ErrDeviceKeyBatchInvalidCount:      codes.InvalidArgument,
+	storage.ErrDevEUIBlockExhausted:            codes.FailedPrecondition,
+	storage.ErrDevEUIBlockInUse:                codes.FailedPrecondition,
+	storage.ErrOrganizationHostInvalidHostname: codes.InvalidArgument,
+	storage.ErrDeviceFilterInvalidName:         codes.InvalidArgument,
+	storage.ErrDeviceFilterInvalidBattery:      codes.InvalidArgument,
+	storage.ErrDeviceFilterInvalidLastSeen:     codes.InvalidArgument,
+	storage.ErrDeviceFilterOrganization:        codes.InvalidArgument,
+	storage.ErrDeviceLifecycleStateInvalid:     codes.InvalidArgument,
+	storage.ErrDeviceLifecycleTransition:       codes.FailedPrecondition,
+	storage.ErrDeviceSuspended:                 codes.FailedPrecondition,
+	storage.ErrDeviceRetired:                   codes.FailedPrecondition,
+	storage.ErrApplicationInvalidRedactField:   codes.InvalidArgument,
+	storage.ErrApplicationInvalidHeartbeat:     codes.InvalidArgument,
+	storage.ErrServiceProfileInvalidMetadata:   codes.InvalidArgument,
+	storage.ErrCampaignInvalidName:             codes.InvalidArgument,
+	storage.ErrCampaignInvalidCommand:          codes.InvalidArgument,
+	storage.ErrCampaignInvalidSchedule:         codes.InvalidArgument,
+	storage.ErrCampaignInvalidDeviceFilter:     codes.InvalidArgument,
+	storage.ErrScheduleJobInvalid:              codes.InvalidArgument,
+	storage.ErrDeviceWebhookInvalidName:        codes.InvalidArgument,
+	storage.ErrDeviceWebhookInvalidURL:         codes.InvalidArgument,
+	storage.ErrDeviceWebhookInvalidEvent:       codes.InvalidArgument,
+	storage.ErrEmbedTokenInvalidView:           codes.InvalidArgument,
+	storage.ErrEmbedTokenInvalidTTL:            codes.InvalidArgument,
+	storage.ErrDeviceConflictInvalidResolution: codes.InvalidArgument,
+	storage.ErrDeviceConflictNotPending:        codes.FailedPrecondition,
+	storage.ErrDeviceConflictLinkLocal:         codes.FailedPrecondition,
+	storage.ErrOrganizationSharedDeviceProfile: codes.FailedPrecondition,
+	storage.ErrTermsVersionInvalid:             codes.InvalidArgument,
+	codec.ErrChainTooLong:                      codes.InvalidArgument,
+	codec.ErrInvalidStageType:                  codes.InvalidArgument,
+	codec.ErrInvalidPreProcessor:               codes.InvalidArgument,
+	codec.ErrInvalidStageConfig:                codes.InvalidArgument,
+	codec.ErrInvalidStageField:                 codes.InvalidArgument,
 	http.ErrInvalidHeaderName:                  codes.InvalidArgument,
+	http.ErrInvalidEndpointEvent:               codes.InvalidArgument,
+	http.ErrInvalidEndpointURL:                 codes.InvalidArgument,
+	http.ErrInvalidFilter:                      codes.InvalidArgument,
+	http.ErrInvalidCompression:                 codes.InvalidArgument,
+	http.ErrInvalidBatch:                       codes.InvalidArgument,
 	influxdb.ErrInvalidPrecision:               codes.InvalidArgument,
 }
 
+// errToErrorCode maps errors to the machine-readable error code returned
+// in the details of the gRPC status.
+var errToErrorCode = map[error]pb.ErrorCode{
+	storage.ErrAlreadyExists: pb.ErrorCode_ALREADY_EXISTS,
+	storage.ErrDoesNotExist:  pb.ErrorCode_DOES_NOT_EXIST,
+}
+
+// ErrToRPCError converts the given error into a gRPC error. gRPC errors
+// (e.g. returned by the network-server) are returned as-is, except for the
+// network-server being unavailable, in which case the error code is added
+// to the status details.
 func ErrToRPCError(err error) error {
 	cause := errors.Cause(err)
+
+	if s, ok := status.FromError(cause); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return ErrorWithCode(s.Code(), pb.ErrorCode_NETWORK_SERVER_UNAVAILABLE, "%s", s.Message())
+		default:
+			return cause
+		}
+	}
+
 	code, ok := errToCode[cause]
 	if !ok {
 		code = codes.Unknown
 	}
+
+	if errorCode, ok := errToErrorCode[cause]; ok {
+		return ErrorWithCode(code, errorCode, "%s", cause.Error())
+	}
+
 	return grpc.Errorf(code, cause.Error())
 }
+
+// ErrorWithCode returns a gRPC error with the given machine-readable error
+// code in the details of the status.
+func ErrorWithCode(code codes.Code, errorCode pb.ErrorCode, format string, a ...interface{}) error {
+	return ErrorWithDetails(code, &pb.ErrorDetails{Code: errorCode}, format, a...)
+}
+
+// ErrorWithDetails returns a gRPC error with the given ErrorDetails set in
+// the status details.
+func ErrorWithDetails(code codes.Code, details *pb.ErrorDetails, format string, a ...interface{}) error {
+	s := status.Newf(code, format, a...)
+
+	sd, err := s.WithDetails(details)
+	if err != nil {
+		log.WithError(err).Error("api/helpers: add error details error")
+		return s.Err()
+	}
+
+	return sd.Err()
+}
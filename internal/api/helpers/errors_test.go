@@ -0,0 +1,69 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func TestErrToRPCError(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Error     error
+		Code      codes.Code
+		ErrorCode pb.ErrorCode
+	}{
+		{
+			Name:      "mapped error with error code",
+			Error:     errors.Wrap(storage.ErrDoesNotExist, "select error"),
+			Code:      codes.NotFound,
+			ErrorCode: pb.ErrorCode_DOES_NOT_EXIST,
+		},
+		{
+			Name:  "mapped error without error code",
+			Error: storage.ErrInvalidEmail,
+			Code:  codes.InvalidArgument,
+		},
+		{
+			Name:  "unknown error",
+			Error: errors.New("boom"),
+			Code:  codes.Unknown,
+		},
+		{
+			Name:  "network-server error",
+			Error: errors.Wrap(grpc.Errorf(codes.NotFound, "object does not exist"), "get device error"),
+			Code:  codes.NotFound,
+		},
+		{
+			Name:      "network-server unavailable",
+			Error:     errors.Wrap(grpc.Errorf(codes.Unavailable, "connection refused"), "create device error"),
+			Code:      codes.Unavailable,
+			ErrorCode: pb.ErrorCode_NETWORK_SERVER_UNAVAILABLE,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			s, ok := status.FromError(ErrToRPCError(test.Error))
+			assert.True(ok)
+			assert.Equal(test.Code, s.Code())
+
+			if test.ErrorCode == pb.ErrorCode_UNSPECIFIED_ERROR {
+				assert.Len(s.Details(), 0)
+				return
+			}
+
+			assert.Len(s.Details(), 1)
+			assert.Equal(test.ErrorCode, s.Details()[0].(*pb.ErrorDetails).Code)
+		})
+	}
+}
@@ -16,25 +16,45 @@ import (
 
 // GetgRPCLoggingServerOptions returns a []grpc.ServerOption for logging requests.
 func GetgRPCLoggingServerOptions() []grpc.ServerOption {
+	return GetgRPCServerOptions(nil, nil)
+}
+
+// GetgRPCServerOptions returns a []grpc.ServerOption for logging requests,
+// chaining the given interceptors after the logging interceptors.
+func GetgRPCServerOptions(unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) []grpc.ServerOption {
 	logrusEntry := log.NewEntry(log.StandardLogger())
 	logrusOpts := []grpc_logrus.Option{
 		grpc_logrus.WithLevels(grpc_logrus.DefaultCodeToLevel),
 	}
 
+	unary = append([]grpc.UnaryServerInterceptor{
+		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		grpc_logrus.UnaryServerInterceptor(logrusEntry, logrusOpts...),
+	}, unary...)
+
+	stream = append([]grpc.StreamServerInterceptor{
+		grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		grpc_logrus.StreamServerInterceptor(logrusEntry, logrusOpts...),
+	}, stream...)
+
 	return []grpc.ServerOption{
-		grpc_middleware.WithUnaryServerChain(
-			grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-			grpc_logrus.UnaryServerInterceptor(logrusEntry, logrusOpts...),
-		),
-		grpc_middleware.WithStreamServerChain(
-			grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-			grpc_logrus.StreamServerInterceptor(logrusEntry, logrusOpts...),
-		),
+		grpc_middleware.WithUnaryServerChain(unary...),
+		grpc_middleware.WithStreamServerChain(stream...),
 	}
 }
 
 // GetTransportCredentials returns the TransportCredentials for the given parameters.
 func GetTransportCredentials(caCert, tlsCert, tlsKey string, verifyClientCert bool) (credentials.TransportCredentials, error) {
+	tlsConfig, err := GetTLSConfig(caCert, tlsCert, tlsKey, verifyClientCert)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// GetTLSConfig returns the tls.Config for the given parameters.
+func GetTLSConfig(caCert, tlsCert, tlsKey string, verifyClientCert bool) (*tls.Config, error) {
 	cert, err := tls.LoadX509KeyPair(tlsCert, tlsKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "load tls key-pair error")
@@ -49,15 +69,15 @@ func GetTransportCredentials(caCert, tlsCert, tlsKey string, verifyClientCert bo
 	caCertPool.AppendCertsFromPEM(rawCACert)
 
 	if verifyClientCert {
-		return credentials.NewTLS(&tls.Config{
+		return &tls.Config{
 			Certificates: []tls.Certificate{cert},
 			ClientCAs:    caCertPool,
 			ClientAuth:   tls.RequireAndVerifyClientCert,
-		}), nil
+		}, nil
 	}
 
-	return credentials.NewTLS(&tls.Config{
+	return &tls.Config{
 		Certificates: []tls.Certificate{cert},
 		RootCAs:      caCertPool,
-	}), nil
+	}, nil
 }
@@ -0,0 +1,520 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+var (
+	organizationNameReplaceRegexp = regexp.MustCompile(`[^\w-]+`)
+	memberFilterPathRegexp        = regexp.MustCompile(`^members\[value eq "(\d+)"\]$`)
+)
+
+// Group defines a SCIM group resource.
+type Group struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// Member defines a member of a group.
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+func groupLocation(id int64) string {
+	return fmt.Sprintf("%s/Groups/%d", BasePath, id)
+}
+
+// organizationName returns the organization name for the given group
+// display name.
+func organizationName(displayName string) string {
+	return strings.Trim(organizationNameReplaceRegexp.ReplaceAllString(displayName, "-"), "-")
+}
+
+// memberIDs returns the user ids of the given members.
+func memberIDs(members []Member) ([]int64, error) {
+	var out []int64
+	for _, m := range members {
+		id, err := strconv.ParseInt(m.Value, 10, 64)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "invalid member value: %s", m.Value)
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+// groupFromStorage returns the SCIM group for the given organization.
+func groupFromStorage(db sqlx.Queryer, org storage.Organization) (Group, error) {
+	out := Group{
+		Schemas:     []string{groupSchema},
+		ID:          strconv.FormatInt(org.ID, 10),
+		DisplayName: org.DisplayName,
+		Members:     []Member{},
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      org.CreatedAt,
+			LastModified: org.UpdatedAt,
+			Location:     groupLocation(org.ID),
+		},
+	}
+
+	users, err := getOrganizationUsers(db, org.ID)
+	if err != nil {
+		return out, err
+	}
+	for _, u := range users {
+		out.Members = append(out.Members, Member{
+			Value:   strconv.FormatInt(u.UserID, 10),
+			Display: u.Username,
+			Ref:     userLocation(u.UserID),
+		})
+	}
+
+	return out, nil
+}
+
+func getOrganizationUsers(db sqlx.Queryer, organizationID int64) ([]storage.OrganizationUser, error) {
+	count, err := storage.GetOrganizationUserCount(db, organizationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get organization user count error")
+	}
+	users, err := storage.GetOrganizationUsers(db, organizationID, count, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "get organization users error")
+	}
+	return users, nil
+}
+
+// addMembers adds the given users to the organization, users which are
+// already a member are skipped. The added memberships are marked as
+// provisioned by SCIM.
+func addMembers(db sqlx.Ext, organizationID int64, userIDs []int64) error {
+	for _, id := range userIDs {
+		_, err := storage.GetOrganizationUser(db, organizationID, id)
+		if err == nil {
+			continue
+		}
+		if errors.Cause(err) != storage.ErrDoesNotExist {
+			return err
+		}
+
+		if _, err := storage.GetUser(db, id); err != nil {
+			if errors.Cause(err) == storage.ErrDoesNotExist {
+				return newError(http.StatusBadRequest, "invalidValue", "user %d does not exist", id)
+			}
+			return err
+		}
+
+		if err := storage.CreateOrganizationUser(db, organizationID, id, false); err != nil {
+			return err
+		}
+		if err := storage.CreateSCIMOrganizationUser(db, organizationID, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeMembers removes the given users from the organization. Only the
+// memberships provisioned by SCIM are removed, users which were added
+// otherwise and organization admins are skipped.
+func removeMembers(db sqlx.Ext, organizationID int64, userIDs []int64) error {
+	managed, err := getSCIMMembers(db, organizationID)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range userIDs {
+		if !managed[id] {
+			continue
+		}
+
+		err := storage.DeleteOrganizationUser(db, organizationID, id)
+		if err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+			return err
+		}
+	}
+	return nil
+}
+
+// setMembers sets the members of the organization to the given users. Only
+// the memberships provisioned by SCIM are removed.
+func setMembers(db sqlx.Ext, organizationID int64, userIDs []int64) error {
+	keep := make(map[int64]bool)
+	for _, id := range userIDs {
+		keep[id] = true
+	}
+
+	managed, err := getSCIMMembers(db, organizationID)
+	if err != nil {
+		return err
+	}
+
+	var remove []int64
+	for id := range managed {
+		if !keep[id] {
+			remove = append(remove, id)
+		}
+	}
+
+	if err := removeMembers(db, organizationID, remove); err != nil {
+		return err
+	}
+	return addMembers(db, organizationID, userIDs)
+}
+
+// getSCIMMembers returns the ids of the (non-admin) users of the
+// organization which were provisioned by SCIM.
+func getSCIMMembers(db sqlx.Queryer, organizationID int64) (map[int64]bool, error) {
+	ids, err := storage.GetSCIMOrganizationUserIDs(db, organizationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get scim organization users error")
+	}
+
+	out := make(map[int64]bool)
+	for _, id := range ids {
+		out[id] = true
+	}
+	return out, nil
+}
+
+// organizationHasAdminUser returns if the given organization has at least
+// one admin user.
+func organizationHasAdminUser(db sqlx.Queryer, organizationID int64) (bool, error) {
+	users, err := getOrganizationUsers(db, organizationID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, u := range users {
+		if u.IsAdmin {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// guardLastAdmin calls the given function and rejects its changes when they
+// removed the last admin user of the organization.
+func guardLastAdmin(tx sqlx.Ext, organizationID int64, f func() error) error {
+	hadAdmin, err := organizationHasAdminUser(tx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	hasAdmin, err := organizationHasAdminUser(tx, organizationID)
+	if err != nil {
+		return err
+	}
+	if hadAdmin && !hasAdmin {
+		return newError(http.StatusConflict, "mutability", "the last admin user of the organization can not be removed")
+	}
+
+	return nil
+}
+
+func listGroups(r *http.Request) (int, interface{}, error) {
+	displayName, err := parseFilter(r, "displayName")
+	if err != nil {
+		return 0, nil, err
+	}
+	startIndex, count := getPagination(r)
+
+	var orgs []storage.Organization
+	var total int
+
+	if displayName != "" {
+		// the search is a case-insensitive substring match
+		matches, err := storage.GetOrganizations(storage.DB(), maxFilterResources, 0, displayName)
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, org := range matches {
+			if org.DisplayName == displayName {
+				orgs = append(orgs, org)
+			}
+		}
+		total = len(orgs)
+
+		if startIndex-1 >= len(orgs) {
+			orgs = nil
+		} else {
+			orgs = orgs[startIndex-1:]
+		}
+		if len(orgs) > count {
+			orgs = orgs[:count]
+		}
+	} else {
+		total, err = storage.GetOrganizationCount(storage.DB(), "")
+		if err != nil {
+			return 0, nil, err
+		}
+
+		orgs, err = storage.GetOrganizations(storage.DB(), count, startIndex-1, "")
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	resources := []Group{}
+	for _, org := range orgs {
+		g, err := groupFromStorage(storage.DB(), org)
+		if err != nil {
+			return 0, nil, err
+		}
+		resources = append(resources, g)
+	}
+
+	return http.StatusOK, ListResponse{
+		Schemas:      []string{listSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}, nil
+}
+
+func getGroup(r *http.Request) (int, interface{}, error) {
+	id, err := getID(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	org, err := storage.GetOrganization(storage.DB(), id)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	g, err := groupFromStorage(storage.DB(), org)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusOK, g, nil
+}
+
+func createGroup(r *http.Request) (int, interface{}, error) {
+	var in Group
+	if err := decodeBody(r, &in); err != nil {
+		return 0, nil, err
+	}
+
+	userIDs, err := memberIDs(in.Members)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	org := storage.Organization{
+		Name:        organizationName(in.DisplayName),
+		DisplayName: in.DisplayName,
+	}
+
+	var g Group
+	err = storage.Transaction(func(tx sqlx.Ext) error {
+		if err := storage.CreateOrganization(tx, &org); err != nil {
+			return err
+		}
+
+		if err := addMembers(tx, org.ID, userIDs); err != nil {
+			return err
+		}
+
+		g, err = groupFromStorage(tx, org)
+		return err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusCreated, g, nil
+}
+
+func replaceGroup(r *http.Request) (int, interface{}, error) {
+	id, err := getID(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var in Group
+	if err := decodeBody(r, &in); err != nil {
+		return 0, nil, err
+	}
+
+	userIDs, err := memberIDs(in.Members)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return updateGroup(id, func(tx sqlx.Ext, org *storage.Organization) error {
+		if in.DisplayName != "" {
+			org.DisplayName = in.DisplayName
+		}
+		return setMembers(tx, org.ID, userIDs)
+	})
+}
+
+func patchGroup(r *http.Request) (int, interface{}, error) {
+	id, err := getID(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var in PatchRequest
+	if err := decodeBody(r, &in); err != nil {
+		return 0, nil, err
+	}
+
+	return updateGroup(id, func(tx sqlx.Ext, org *storage.Organization) error {
+		for _, op := range in.Operations {
+			if err := patchGroupOperation(tx, org, op); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// patchGroupOperation applies a single PATCH operation to the group.
+// Attributes which are not supported are ignored.
+func patchGroupOperation(tx sqlx.Ext, org *storage.Organization, op PatchOperation) error {
+	path := strings.TrimSpace(op.Path)
+
+	// members[value eq "123"]
+	if match := memberFilterPathRegexp.FindStringSubmatch(path); len(match) == 2 {
+		if !strings.EqualFold(op.Op, "remove") {
+			return newError(http.StatusBadRequest, "invalidPath", "unsupported operation on %s: %s", path, op.Op)
+		}
+		id, _ := strconv.ParseInt(match[1], 10, 64)
+		return removeMembers(tx, org.ID, []int64{id})
+	}
+
+	var members []Member
+	switch {
+	case strings.EqualFold(path, "members"):
+		if len(op.Value) != 0 {
+			if err := json.Unmarshal(op.Value, &members); err != nil {
+				return newError(http.StatusBadRequest, "invalidValue", "members must be an array of member objects")
+			}
+		}
+	case strings.EqualFold(path, "displayName"):
+		if !strings.EqualFold(op.Op, "replace") {
+			return newError(http.StatusBadRequest, "invalidPath", "unsupported operation on %s: %s", path, op.Op)
+		}
+		if err := json.Unmarshal(op.Value, &org.DisplayName); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "displayName must be a string")
+		}
+		return nil
+	case path == "":
+		// without path, the value contains the attributes to replace
+		var values struct {
+			DisplayName string   `json:"displayName"`
+			Members     []Member `json:"members"`
+		}
+		if err := json.Unmarshal(op.Value, &values); err != nil {
+			return newError(http.StatusBadRequest, "invalidSyntax", "decode value error: %s", err)
+		}
+		if values.DisplayName != "" {
+			org.DisplayName = values.DisplayName
+		}
+		if values.Members == nil {
+			return nil
+		}
+		members = values.Members
+	default:
+		return nil
+	}
+
+	userIDs, err := memberIDs(members)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(op.Op) {
+	case "add":
+		return addMembers(tx, org.ID, userIDs)
+	case "remove":
+		if len(op.Value) == 0 {
+			// remove all members
+			return setMembers(tx, org.ID, nil)
+		}
+		return removeMembers(tx, org.ID, userIDs)
+	case "replace":
+		return setMembers(tx, org.ID, userIDs)
+	default:
+		return newError(http.StatusBadRequest, "invalidValue", "unsupported operation: %s", op.Op)
+	}
+}
+
+// updateGroup updates the organization within a transaction.
+func updateGroup(id int64, f func(tx sqlx.Ext, org *storage.Organization) error) (int, interface{}, error) {
+	var g Group
+
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		org, err := storage.GetOrganization(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := guardLastAdmin(tx, org.ID, func() error {
+			return f(tx, &org)
+		}); err != nil {
+			return err
+		}
+
+		if err := storage.UpdateOrganization(tx, &org); err != nil {
+			return err
+		}
+
+		g, err = groupFromStorage(tx, org)
+		return err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusOK, g, nil
+}
+
+// deleteGroup removes all the members provisioned by SCIM from the
+// organization. The organization itself is not deleted, as this would
+// delete all its applications, devices and gateways.
+func deleteGroup(r *http.Request) (int, interface{}, error) {
+	id, err := getID(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	err = storage.Transaction(func(tx sqlx.Ext) error {
+		if _, err := storage.GetOrganization(tx, id); err != nil {
+			return err
+		}
+		return guardLastAdmin(tx, id, func() error {
+			return setMembers(tx, id, nil)
+		})
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusNoContent, nil, nil
+}
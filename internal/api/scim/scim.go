@@ -0,0 +1,282 @@
+// Package scim implements a SCIM 2.0 (RFC 7643 / RFC 7644) service provider,
+// so that identity providers are able to provision users and map their
+// groups to organizations.
+//
+// SCIM users are mapped to LoRa App Server users, SCIM groups are mapped to
+// organizations. Group members are added to the organization as regular
+// (non-admin) users. Global admin users can not be modified or deleted using
+// SCIM and passwords can not be set using SCIM.
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// BasePath defines the path under which the SCIM endpoints are served.
+const BasePath = "/scim/v2"
+
+// Schema URNs.
+const (
+	userSchema         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	listSchema         = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	patchOpSchema      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	errorSchema        = "urn:ietf:params:scim:api:messages:2.0:Error"
+	spConfigSchema     = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	contentType        = "application/scim+json"
+	defaultCount       = 100
+	maxCount           = 1000
+	maxFilterResources = 1000
+)
+
+var filterRegexp = regexp.MustCompile(`^(\w+)\s+(?i:eq)\s+"(.*)"$`)
+
+// Meta defines the meta attributes of a resource.
+type Meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+// ListResponse defines the response of a list (query) request.
+type ListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+// PatchRequest defines a PATCH request.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation defines a single PATCH operation.
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Error defines a SCIM error response.
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+
+	status int
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
+
+func newError(status int, scimType, format string, a ...interface{}) *Error {
+	return &Error{
+		Schemas:  []string{errorSchema},
+		Status:   strconv.Itoa(status),
+		SCIMType: scimType,
+		Detail:   fmt.Sprintf(format, a...),
+		status:   status,
+	}
+}
+
+var (
+	errAdminUser            = newError(http.StatusForbidden, "", "global admin users can not be modified using SCIM")
+	errPasswordNotSupported = newError(http.StatusBadRequest, "invalidValue", "passwords can not be set using SCIM")
+)
+
+// storageError maps the given storage error to a SCIM error.
+func storageError(err error) *Error {
+	switch errors.Cause(err) {
+	case storage.ErrDoesNotExist:
+		return newError(http.StatusNotFound, "", "resource does not exist")
+	case storage.ErrAlreadyExists:
+		return newError(http.StatusConflict, "uniqueness", "resource already exists")
+	case storage.ErrUserInvalidUsername, storage.ErrUserPasswordLength, storage.ErrInvalidEmail, storage.ErrOrganizationInvalidName:
+		return newError(http.StatusBadRequest, "invalidValue", "%s", errors.Cause(err))
+	default:
+		log.WithError(err).Error("api/scim: storage error")
+		return newError(http.StatusInternalServerError, "", "internal error")
+	}
+}
+
+// NewHandler returns the SCIM handler. Requests must be authenticated
+// using the given bearer token.
+func NewHandler(token string) http.Handler {
+	r := mux.NewRouter().PathPrefix(BasePath).Subrouter()
+
+	r.HandleFunc("/ServiceProviderConfig", handle(getServiceProviderConfig)).Methods("GET")
+
+	r.HandleFunc("/Users", handle(listUsers)).Methods("GET")
+	r.HandleFunc("/Users", handle(createUser)).Methods("POST")
+	r.HandleFunc("/Users/{id}", handle(getUser)).Methods("GET")
+	r.HandleFunc("/Users/{id}", handle(replaceUser)).Methods("PUT")
+	r.HandleFunc("/Users/{id}", handle(patchUser)).Methods("PATCH")
+	r.HandleFunc("/Users/{id}", handle(deleteUser)).Methods("DELETE")
+
+	r.HandleFunc("/Groups", handle(listGroups)).Methods("GET")
+	r.HandleFunc("/Groups", handle(createGroup)).Methods("POST")
+	r.HandleFunc("/Groups/{id}", handle(getGroup)).Methods("GET")
+	r.HandleFunc("/Groups/{id}", handle(replaceGroup)).Methods("PUT")
+	r.HandleFunc("/Groups/{id}", handle(patchGroup)).Methods("PATCH")
+	r.HandleFunc("/Groups/{id}", handle(deleteGroup)).Methods("DELETE")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if t, ok := helpers.BearerToken(req); !ok || !helpers.ValidateBearerToken(t, token) {
+			writeError(w, newError(http.StatusUnauthorized, "", "invalid bearer token"))
+			return
+		}
+
+		r.ServeHTTP(w, req)
+	})
+}
+
+// handlerFunc handles a SCIM request. It returns the HTTP status and the
+// response body (nil for no body).
+type handlerFunc func(r *http.Request) (int, interface{}, error)
+
+func handle(f handlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status, resp, err := f(r)
+		if err != nil {
+			scimErr, ok := err.(*Error)
+			if !ok {
+				scimErr = storageError(err)
+			}
+			writeError(w, scimErr)
+			return
+		}
+
+		if resp == nil {
+			w.WriteHeader(status)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(status)
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			log.WithError(err).Error("api/scim: encode response error")
+		}
+	}
+}
+
+func writeError(w http.ResponseWriter, e *Error) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(e.status)
+	if err := json.NewEncoder(w).Encode(e); err != nil {
+		log.WithError(err).Error("api/scim: encode error response error")
+	}
+}
+
+func decodeBody(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "decode request body error: %s", err)
+	}
+	return nil
+}
+
+// getID returns the id path variable.
+func getID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		return 0, newError(http.StatusNotFound, "", "resource does not exist")
+	}
+	return id, nil
+}
+
+// getPagination returns the (1-based) start index and count query
+// parameters.
+func getPagination(r *http.Request) (int, int) {
+	startIndex, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count < 0 {
+		count = defaultCount
+	}
+	if count > maxCount {
+		count = maxCount
+	}
+
+	return startIndex, count
+}
+
+// parseFilter parses the filter query parameter. Only the eq operator on
+// the given attribute is supported. It returns the value to filter on, or
+// an empty string when no filter is set.
+func parseFilter(r *http.Request, attribute string) (string, error) {
+	filter := strings.TrimSpace(r.URL.Query().Get("filter"))
+	if filter == "" {
+		return "", nil
+	}
+
+	match := filterRegexp.FindStringSubmatch(filter)
+	if len(match) != 3 || !strings.EqualFold(match[1], attribute) {
+		return "", newError(http.StatusBadRequest, "invalidFilter", "only '%s eq \"value\"' filters are supported", attribute)
+	}
+
+	return strings.Replace(match[2], `\"`, `"`, -1), nil
+}
+
+func getServiceProviderConfig(r *http.Request) (int, interface{}, error) {
+	type supported struct {
+		Supported bool `json:"supported"`
+	}
+	type filter struct {
+		Supported  bool `json:"supported"`
+		MaxResults int  `json:"maxResults"`
+	}
+	type bulk struct {
+		Supported      bool `json:"supported"`
+		MaxOperations  int  `json:"maxOperations"`
+		MaxPayloadSize int  `json:"maxPayloadSize"`
+	}
+	type authenticationScheme struct {
+		Type        string `json:"type"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	return http.StatusOK, struct {
+		Schemas               []string               `json:"schemas"`
+		Patch                 supported              `json:"patch"`
+		Bulk                  bulk                   `json:"bulk"`
+		Filter                filter                 `json:"filter"`
+		ChangePassword        supported              `json:"changePassword"`
+		Sort                  supported              `json:"sort"`
+		ETag                  supported              `json:"etag"`
+		AuthenticationSchemes []authenticationScheme `json:"authenticationSchemes"`
+	}{
+		Schemas:        []string{spConfigSchema},
+		Patch:          supported{true},
+		Filter:         filter{true, maxFilterResources},
+		ChangePassword: supported{false},
+		AuthenticationSchemes: []authenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "OAuth Bearer Token",
+				Description: "Authentication using the configured bearer token.",
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,332 @@
+package scim
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func doRequest(h http.Handler, method, path string, body interface{}) *httptest.ResponseRecorder {
+	var b []byte
+	if body != nil {
+		var err error
+		b, err = json.Marshal(body)
+		So(err, ShouldBeNil)
+	}
+
+	req := httptest.NewRequest(method, path, bytes.NewReader(b))
+	req.Header.Set("Authorization", "Bearer secret")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAuthentication(t *testing.T) {
+	Convey("Given a SCIM handler", t, func() {
+		h := NewHandler("secret")
+
+		Convey("Then requests without valid bearer token are rejected", func() {
+			for _, auth := range []string{"", "Bearer invalid", "secret"} {
+				req := httptest.NewRequest("GET", BasePath+"/ServiceProviderConfig", nil)
+				req.Header.Set("Authorization", auth)
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+				So(rec.Code, ShouldEqual, http.StatusUnauthorized)
+			}
+		})
+
+		Convey("Then the service provider config can be retrieved", func() {
+			rec := doRequest(h, "GET", BasePath+"/ServiceProviderConfig", nil)
+			So(rec.Code, ShouldEqual, http.StatusOK)
+			So(rec.Header().Get("Content-Type"), ShouldEqual, contentType)
+		})
+	})
+}
+
+func TestParseFilter(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Filter        string
+			Expected      string
+			ExpectedError bool
+		}{
+			{Filter: "", Expected: ""},
+			{Filter: `userName eq "alice"`, Expected: "alice"},
+			{Filter: `username EQ "alice"`, Expected: "alice"},
+			{Filter: `userName eq "a \"b\""`, Expected: `a "b"`},
+			{Filter: `userName co "alice"`, ExpectedError: true},
+			{Filter: `displayName eq "alice"`, ExpectedError: true},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Filter, i), func() {
+				req := httptest.NewRequest("GET", "/Users", nil)
+				q := req.URL.Query()
+				q.Set("filter", test.Filter)
+				req.URL.RawQuery = q.Encode()
+
+				v, err := parseFilter(req, "userName")
+				if test.ExpectedError {
+					So(err, ShouldNotBeNil)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, test.Expected)
+			})
+		}
+	})
+}
+
+func TestOrganizationName(t *testing.T) {
+	Convey("Then the organization name is derived from the display name", t, func() {
+		So(organizationName("Engineering Team"), ShouldEqual, "Engineering-Team")
+		So(organizationName(" R&D / Lab_1 "), ShouldEqual, "R-D-Lab_1")
+	})
+}
+
+func TestUsersAndGroups(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database and a SCIM handler", t, func() {
+		if err := storage.Setup(conf); err != nil {
+			t.Fatal(err)
+		}
+		test.MustResetDB(storage.DB().DB)
+
+		h := NewHandler("secret")
+
+		Convey("Then creating an user with password is rejected", func() {
+			rec := doRequest(h, "POST", BasePath+"/Users", User{
+				Schemas:  []string{userSchema},
+				UserName: "bob",
+				Emails:   []Email{{Value: "bob@example.com"}},
+				Password: "secret123",
+			})
+			So(rec.Code, ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("When creating an user", func() {
+			rec := doRequest(h, "POST", BasePath+"/Users", User{
+				Schemas:  []string{userSchema},
+				UserName: "alice",
+				Emails:   []Email{{Value: "alice@example.com", Primary: true}},
+			})
+			So(rec.Code, ShouldEqual, http.StatusCreated)
+
+			var user User
+			So(json.Unmarshal(rec.Body.Bytes(), &user), ShouldBeNil)
+			So(user.ID, ShouldNotEqual, "")
+			So(*user.Active, ShouldBeTrue)
+
+			Convey("Then the user can be found by userName", func() {
+				rec := doRequest(h, "GET", BasePath+`/Users?filter=userName+eq+"alice"`, nil)
+				So(rec.Code, ShouldEqual, http.StatusOK)
+
+				var resp struct {
+					TotalResults int    `json:"totalResults"`
+					Resources    []User `json:"Resources"`
+				}
+				So(json.Unmarshal(rec.Body.Bytes(), &resp), ShouldBeNil)
+				So(resp.TotalResults, ShouldEqual, 1)
+				So(resp.Resources[0].ID, ShouldEqual, user.ID)
+			})
+
+			Convey("Then creating the same user again returns a conflict", func() {
+				rec := doRequest(h, "POST", BasePath+"/Users", User{
+					Schemas:  []string{userSchema},
+					UserName: "alice",
+					Emails:   []Email{{Value: "alice@example.com"}},
+				})
+				So(rec.Code, ShouldEqual, http.StatusConflict)
+			})
+
+			Convey("When deactivating the user", func() {
+				rec := doRequest(h, "PATCH", BasePath+"/Users/"+user.ID, PatchRequest{
+					Schemas: []string{patchOpSchema},
+					Operations: []PatchOperation{
+						{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)},
+					},
+				})
+				So(rec.Code, ShouldEqual, http.StatusOK)
+
+				Convey("Then the user is inactive", func() {
+					u, err := storage.GetUserByUsername(storage.DB(), "alice")
+					So(err, ShouldBeNil)
+					So(u.IsActive, ShouldBeFalse)
+				})
+			})
+
+			Convey("Then setting the password is rejected", func() {
+				rec := doRequest(h, "PATCH", BasePath+"/Users/"+user.ID, PatchRequest{
+					Schemas: []string{patchOpSchema},
+					Operations: []PatchOperation{
+						{Op: "replace", Path: "password", Value: json.RawMessage(`"secret123"`)},
+					},
+				})
+				So(rec.Code, ShouldEqual, http.StatusBadRequest)
+			})
+
+			Convey("When the user is a global admin", func() {
+				u, err := storage.GetUserByUsername(storage.DB(), "alice")
+				So(err, ShouldBeNil)
+				So(storage.UpdateUser(storage.DB(), storage.UserUpdate{
+					ID:         u.ID,
+					Username:   u.Username,
+					IsAdmin:    true,
+					IsActive:   true,
+					SessionTTL: u.SessionTTL,
+					Email:      u.Email,
+				}), ShouldBeNil)
+
+				Convey("Then the user can not be deactivated", func() {
+					rec := doRequest(h, "PATCH", BasePath+"/Users/"+user.ID, PatchRequest{
+						Schemas: []string{patchOpSchema},
+						Operations: []PatchOperation{
+							{Op: "replace", Path: "active", Value: json.RawMessage(`false`)},
+						},
+					})
+					So(rec.Code, ShouldEqual, http.StatusForbidden)
+				})
+
+				Convey("Then the user can not be deleted", func() {
+					rec := doRequest(h, "DELETE", BasePath+"/Users/"+user.ID, nil)
+					So(rec.Code, ShouldEqual, http.StatusForbidden)
+
+					_, err := storage.GetUser(storage.DB(), u.ID)
+					So(err, ShouldBeNil)
+				})
+			})
+
+			Convey("When creating a group with the user as member", func() {
+				rec := doRequest(h, "POST", BasePath+"/Groups", Group{
+					Schemas:     []string{groupSchema},
+					DisplayName: "Engineering Team",
+					Members:     []Member{{Value: user.ID}},
+				})
+				So(rec.Code, ShouldEqual, http.StatusCreated)
+
+				var group Group
+				So(json.Unmarshal(rec.Body.Bytes(), &group), ShouldBeNil)
+				So(group.Members, ShouldHaveLength, 1)
+
+				Convey("Then the organization has been created with the user", func() {
+					orgs, err := storage.GetOrganizationsForUser(storage.DB(), "alice", 10, 0, "")
+					So(err, ShouldBeNil)
+					So(orgs, ShouldHaveLength, 1)
+					So(orgs[0].Name, ShouldEqual, "Engineering-Team")
+					So(orgs[0].DisplayName, ShouldEqual, "Engineering Team")
+				})
+
+				Convey("When removing the member", func() {
+					rec := doRequest(h, "PATCH", BasePath+"/Groups/"+group.ID, PatchRequest{
+						Schemas: []string{patchOpSchema},
+						Operations: []PatchOperation{
+							{Op: "remove", Path: fmt.Sprintf(`members[value eq "%s"]`, user.ID)},
+						},
+					})
+					So(rec.Code, ShouldEqual, http.StatusOK)
+
+					Convey("Then the user is not part of the organization", func() {
+						orgs, err := storage.GetOrganizationsForUser(storage.DB(), "alice", 10, 0, "")
+						So(err, ShouldBeNil)
+						So(orgs, ShouldHaveLength, 0)
+					})
+				})
+
+				Convey("When deleting the group", func() {
+					rec := doRequest(h, "DELETE", BasePath+"/Groups/"+group.ID, nil)
+					So(rec.Code, ShouldEqual, http.StatusNoContent)
+
+					Convey("Then the organization still exists without members", func() {
+						rec := doRequest(h, "GET", BasePath+"/Groups/"+group.ID, nil)
+						So(rec.Code, ShouldEqual, http.StatusOK)
+
+						var g Group
+						So(json.Unmarshal(rec.Body.Bytes(), &g), ShouldBeNil)
+						So(g.Members, ShouldHaveLength, 0)
+					})
+				})
+
+				Convey("Given an organization admin and user which were not added by SCIM", func() {
+					orgID, err := strconv.ParseInt(group.ID, 10, 64)
+					So(err, ShouldBeNil)
+
+					adminID, err := storage.CreateUser(storage.DB(), &storage.User{
+						Username: "bob",
+						IsActive: true,
+						Email:    "bob@example.com",
+					}, "password123")
+					So(err, ShouldBeNil)
+					So(storage.CreateOrganizationUser(storage.DB(), orgID, adminID, true), ShouldBeNil)
+
+					userID, err := storage.CreateUser(storage.DB(), &storage.User{
+						Username: "carol",
+						IsActive: true,
+						Email:    "carol@example.com",
+					}, "password123")
+					So(err, ShouldBeNil)
+					So(storage.CreateOrganizationUser(storage.DB(), orgID, userID, false), ShouldBeNil)
+
+					Convey("When replacing the group members", func() {
+						rec := doRequest(h, "PUT", BasePath+"/Groups/"+group.ID, Group{
+							Schemas:     []string{groupSchema},
+							DisplayName: "Engineering Team",
+						})
+						So(rec.Code, ShouldEqual, http.StatusOK)
+
+						Convey("Then only the member added by SCIM has been removed", func() {
+							_, err := storage.GetOrganizationUser(storage.DB(), orgID, adminID)
+							So(err, ShouldBeNil)
+							_, err = storage.GetOrganizationUser(storage.DB(), orgID, userID)
+							So(err, ShouldBeNil)
+
+							id, _ := strconv.ParseInt(user.ID, 10, 64)
+							_, err = storage.GetOrganizationUser(storage.DB(), orgID, id)
+							So(errors.Cause(err), ShouldEqual, storage.ErrDoesNotExist)
+						})
+					})
+
+					Convey("When removing the admin and user using a patch", func() {
+						rec := doRequest(h, "PATCH", BasePath+"/Groups/"+group.ID, PatchRequest{
+							Schemas: []string{patchOpSchema},
+							Operations: []PatchOperation{
+								{Op: "remove", Path: fmt.Sprintf(`members[value eq "%d"]`, adminID)},
+								{Op: "remove", Path: fmt.Sprintf(`members[value eq "%d"]`, userID)},
+							},
+						})
+						So(rec.Code, ShouldEqual, http.StatusOK)
+
+						Convey("Then both are still part of the organization", func() {
+							_, err := storage.GetOrganizationUser(storage.DB(), orgID, adminID)
+							So(err, ShouldBeNil)
+							_, err = storage.GetOrganizationUser(storage.DB(), orgID, userID)
+							So(err, ShouldBeNil)
+						})
+					})
+
+					Convey("When deleting the group", func() {
+						rec := doRequest(h, "DELETE", BasePath+"/Groups/"+group.ID, nil)
+						So(rec.Code, ShouldEqual, http.StatusNoContent)
+
+						Convey("Then the admin and user are still part of the organization", func() {
+							_, err := storage.GetOrganizationUser(storage.DB(), orgID, adminID)
+							So(err, ShouldBeNil)
+							_, err = storage.GetOrganizationUser(storage.DB(), orgID, userID)
+							So(err, ShouldBeNil)
+						})
+					})
+				})
+			})
+		})
+	})
+}
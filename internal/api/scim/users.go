@@ -0,0 +1,395 @@
+package scim
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// User defines a SCIM user resource.
+type User struct {
+	Schemas  []string   `json:"schemas"`
+	ID       string     `json:"id,omitempty"`
+	UserName string     `json:"userName"`
+	Active   *bool      `json:"active,omitempty"`
+	Emails   []Email    `json:"emails,omitempty"`
+	Groups   []GroupRef `json:"groups,omitempty"`
+	Password string     `json:"password,omitempty"`
+	Meta     *Meta      `json:"meta,omitempty"`
+}
+
+// Email defines an e-mail address of the user.
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// GroupRef defines a reference to a group of which the user is a member.
+type GroupRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// primaryEmail returns the primary (or else the first) e-mail address.
+func (u User) primaryEmail() string {
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) != 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+func userLocation(id int64) string {
+	return fmt.Sprintf("%s/Users/%d", BasePath, id)
+}
+
+// userFromStorage returns the SCIM user for the given user, including the
+// organizations (groups) of which the user is a member.
+func userFromStorage(db sqlx.Queryer, u storage.User) (User, error) {
+	active := u.IsActive
+	out := User{
+		Schemas:  []string{userSchema},
+		ID:       strconv.FormatInt(u.ID, 10),
+		UserName: u.Username,
+		Active:   &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      u.CreatedAt,
+			LastModified: u.UpdatedAt,
+			Location:     userLocation(u.ID),
+		},
+	}
+
+	if u.Email != "" {
+		out.Emails = []Email{{Value: u.Email, Primary: true}}
+	}
+
+	count, err := storage.GetOrganizationCountForUser(db, u.Username, "")
+	if err != nil {
+		return out, errors.Wrap(err, "get organization count for user error")
+	}
+	orgs, err := storage.GetOrganizationsForUser(db, u.Username, count, 0, "")
+	if err != nil {
+		return out, errors.Wrap(err, "get organizations for user error")
+	}
+	for _, org := range orgs {
+		out.Groups = append(out.Groups, GroupRef{
+			Value:   strconv.FormatInt(org.ID, 10),
+			Display: org.DisplayName,
+			Ref:     groupLocation(org.ID),
+		})
+	}
+
+	return out, nil
+}
+
+func listUsers(r *http.Request) (int, interface{}, error) {
+	userName, err := parseFilter(r, "userName")
+	if err != nil {
+		return 0, nil, err
+	}
+	startIndex, count := getPagination(r)
+
+	var users []storage.User
+	var total int
+
+	if userName != "" {
+		u, err := storage.GetUserByUsername(storage.DB(), userName)
+		if err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+			return 0, nil, err
+		}
+		if err == nil {
+			total = 1
+			if startIndex == 1 && count > 0 {
+				users = append(users, u)
+			}
+		}
+	} else {
+		c, err := storage.GetUserCount(storage.DB(), "")
+		if err != nil {
+			return 0, nil, err
+		}
+		total = int(c)
+
+		users, err = storage.GetUsers(storage.DB(), count, startIndex-1, "")
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	resources := []User{}
+	for _, u := range users {
+		su, err := userFromStorage(storage.DB(), u)
+		if err != nil {
+			return 0, nil, err
+		}
+		resources = append(resources, su)
+	}
+
+	return http.StatusOK, ListResponse{
+		Schemas:      []string{listSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}, nil
+}
+
+func getUser(r *http.Request) (int, interface{}, error) {
+	id, err := getID(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	u, err := storage.GetUser(storage.DB(), id)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	su, err := userFromStorage(storage.DB(), u)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusOK, su, nil
+}
+
+func createUser(r *http.Request) (int, interface{}, error) {
+	var in User
+	if err := decodeBody(r, &in); err != nil {
+		return 0, nil, err
+	}
+
+	if in.Password != "" {
+		return 0, nil, errPasswordNotSupported
+	}
+
+	// the user is not able to login with a password until it has been set
+	// by an admin
+	password, err := randomPassword()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	u := storage.User{
+		Username: in.UserName,
+		IsActive: in.Active == nil || *in.Active,
+		Email:    in.primaryEmail(),
+	}
+
+	var su User
+	err = storage.Transaction(func(tx sqlx.Ext) error {
+		if _, err := storage.CreateUser(tx, &u, password); err != nil {
+			return err
+		}
+
+		var err error
+		su, err = userFromStorage(tx, u)
+		return err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusCreated, su, nil
+}
+
+func replaceUser(r *http.Request) (int, interface{}, error) {
+	id, err := getID(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var in User
+	if err := decodeBody(r, &in); err != nil {
+		return 0, nil, err
+	}
+
+	if in.Password != "" {
+		return 0, nil, errPasswordNotSupported
+	}
+
+	return updateUser(id, func(u *storage.UserUpdate) error {
+		u.Username = in.UserName
+		u.IsActive = in.Active == nil || *in.Active
+		if email := in.primaryEmail(); email != "" {
+			u.Email = email
+		}
+		return nil
+	})
+}
+
+func patchUser(r *http.Request) (int, interface{}, error) {
+	id, err := getID(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var in PatchRequest
+	if err := decodeBody(r, &in); err != nil {
+		return 0, nil, err
+	}
+
+	return updateUser(id, func(u *storage.UserUpdate) error {
+		for _, op := range in.Operations {
+			if !strings.EqualFold(op.Op, "replace") && !strings.EqualFold(op.Op, "add") {
+				return newError(http.StatusBadRequest, "invalidValue", "unsupported operation: %s", op.Op)
+			}
+
+			// without path, the value contains the attributes to replace
+			values := make(map[string]json.RawMessage)
+			if op.Path == "" {
+				if err := json.Unmarshal(op.Value, &values); err != nil {
+					return newError(http.StatusBadRequest, "invalidSyntax", "decode value error: %s", err)
+				}
+			} else {
+				values[op.Path] = op.Value
+			}
+
+			for path, value := range values {
+				if err := patchUserAttribute(u, path, value); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// patchUserAttribute sets the given attribute. Attributes which are not
+// supported are ignored, the password is rejected.
+func patchUserAttribute(u *storage.UserUpdate, path string, value json.RawMessage) error {
+	path = strings.ToLower(path)
+
+	switch {
+	case path == "active":
+		// some identity providers send booleans as string
+		var b bool
+		if err := json.Unmarshal(value, &b); err != nil {
+			var s string
+			if err := json.Unmarshal(value, &s); err != nil {
+				return newError(http.StatusBadRequest, "invalidValue", "active must be a boolean")
+			}
+			b, err = strconv.ParseBool(s)
+			if err != nil {
+				return newError(http.StatusBadRequest, "invalidValue", "active must be a boolean")
+			}
+		}
+		u.IsActive = b
+	case path == "username":
+		if err := json.Unmarshal(value, &u.Username); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "userName must be a string")
+		}
+	case path == "password":
+		return errPasswordNotSupported
+	case path == "emails":
+		var emails []Email
+		if err := json.Unmarshal(value, &emails); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "emails must be an array of e-mail objects")
+		}
+		if email := (User{Emails: emails}).primaryEmail(); email != "" {
+			u.Email = email
+		}
+	case strings.HasPrefix(path, "emails[") && strings.HasSuffix(path, ".value"):
+		if err := json.Unmarshal(value, &u.Email); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "e-mail value must be a string")
+		}
+	}
+
+	return nil
+}
+
+// updateUser updates the user within a transaction. The given function
+// applies the changes. Global admin users can not be updated.
+func updateUser(id int64, f func(u *storage.UserUpdate) error) (int, interface{}, error) {
+	var su User
+
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		u, err := storage.GetUser(tx, id)
+		if err != nil {
+			return err
+		}
+		if u.IsAdmin {
+			return errAdminUser
+		}
+
+		upd := storage.UserUpdate{
+			ID:         u.ID,
+			Username:   u.Username,
+			IsAdmin:    u.IsAdmin,
+			IsActive:   u.IsActive,
+			SessionTTL: u.SessionTTL,
+			Email:      u.Email,
+			Note:       u.Note,
+		}
+
+		if err := f(&upd); err != nil {
+			return err
+		}
+
+		if err := storage.UpdateUser(tx, upd); err != nil {
+			return err
+		}
+
+		u, err = storage.GetUser(tx, id)
+		if err != nil {
+			return err
+		}
+
+		su, err = userFromStorage(tx, u)
+		return err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusOK, su, nil
+}
+
+func deleteUser(r *http.Request) (int, interface{}, error) {
+	id, err := getID(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	err = storage.Transaction(func(tx sqlx.Ext) error {
+		u, err := storage.GetUser(tx, id)
+		if err != nil {
+			return err
+		}
+		if u.IsAdmin {
+			return errAdminUser
+		}
+
+		return storage.DeleteUser(tx, id)
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusNoContent, nil, nil
+}
+
+func randomPassword() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "read random bytes error")
+	}
+	return hex.EncodeToString(b), nil
+}
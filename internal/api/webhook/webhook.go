@@ -0,0 +1,468 @@
+// Package webhook implements an HTTP endpoint for ingesting uplinks which
+// were received by third-party network-servers (e.g. Helium or The Things
+// Network). The uplinks are mapped onto the local devices by DevEUI and are
+// handled by the same codec and integration pipeline as the uplinks received
+// from LoRa Server. Each bearer token is scoped to a single application.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/archive"
+	"github.com/brocaar/lora-app-server/internal/eventbus"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/uplink"
+	"github.com/brocaar/loraserver/api/as"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+// BasePath defines the path under which the webhook endpoints are served.
+const BasePath = "/webhook"
+
+const maxBodySize = 1 << 20
+
+type contextKey int
+
+// applicationIDKey is the context key of the application to which the
+// bearer token of the request is scoped.
+const applicationIDKey contextKey = 0
+
+// errFCntReplay is returned when the frame-counter of the uplink is not
+// greater than the last received frame-counter of the device and the
+// decrease is not large enough to be a frame-counter reset.
+var errFCntReplay = errors.New("frame-counter has already been used")
+
+// uplinkFrame contains the format independent representation of an uplink
+// received from a third-party network-server.
+type uplinkFrame struct {
+	// Join is set when the device (re)joined the third-party network, in
+	// which case only the DevEUI is set.
+	Join bool
+
+	DevEUI    lorawan.EUI64
+	FCnt      uint32
+	FPort     uint8
+	Data      []byte
+	Frequency int
+	RXInfo    []integration.RXInfo
+}
+
+// parsers contains the supported formats, the key is used as the last
+// element of the endpoint path.
+var parsers = map[string]func([]byte) (uplinkFrame, error){
+	"helium": parseHelium,
+	"ttn":    parseTTN,
+}
+
+// NewHandler returns a new webhook handler. Requests must be authenticated
+// using one of the given bearer tokens (application ID to token). Only the
+// devices of the application of the token can be used.
+func NewHandler(tokens map[int64]string) http.Handler {
+	r := mux.NewRouter()
+	r.HandleFunc(BasePath+"/{format}", handleUplink).Methods("POST")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token, ok := helpers.BearerToken(req)
+		if !ok {
+			http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		var applicationID int64
+		var found bool
+		for id, t := range tokens {
+			if helpers.ValidateBearerToken(token, t) {
+				applicationID, found = id, true
+			}
+		}
+		if !found {
+			http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		r.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), applicationIDKey, applicationID)))
+	})
+}
+
+func handleUplink(w http.ResponseWriter, r *http.Request) {
+	format := mux.Vars(r)["format"]
+	parse, ok := parsers[format]
+	if !ok {
+		http.Error(w, "unknown format: "+format, http.StatusNotFound)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "read body error", http.StatusBadRequest)
+		return
+	}
+
+	up, err := parse(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	applicationID := r.Context().Value(applicationIDKey).(int64)
+
+	if up.Join {
+		err = handleJoin(applicationID, up.DevEUI)
+	} else if up.FPort != 0 {
+		err = handleUplinkFrame(applicationID, up)
+	}
+	// uplinks without application payload (e.g. mac-commands only) are
+	// ignored
+
+	if err != nil {
+		switch errors.Cause(err) {
+		case storage.ErrDoesNotExist:
+			http.Error(w, "device does not exist", http.StatusNotFound)
+			return
+		case errFCntReplay:
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+
+		log.WithError(err).WithFields(log.Fields{
+			"format":  format,
+			"dev_eui": up.DevEUI,
+		}).Error("api/webhook: handle uplink error")
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleJoin resets the frame-counter of the given device of the given
+// application, as the device starts with a new frame-counter after a join.
+func handleJoin(applicationID int64, devEUI lorawan.EUI64) error {
+	return storage.Transaction(func(tx sqlx.Ext) error {
+		d, err := storage.GetDevice(tx, devEUI, true, true)
+		if err != nil {
+			return errors.Wrap(err, "get device error")
+		}
+
+		if d.ApplicationID != applicationID {
+			return errors.Wrap(storage.ErrDoesNotExist, "device of other application")
+		}
+
+		if err := storage.ResetDeviceFCnt(tx, d.DevEUI, time.Now()); err != nil {
+			return errors.Wrap(err, "reset frame-counter error")
+		}
+
+		return nil
+	})
+}
+
+// handleUplinkFrame handles the given uplink of a device of the given
+// application. Uplinks of devices of other applications are handled as if the
+// device does not exist.
+func handleUplinkFrame(applicationID int64, up uplinkFrame) error {
+	var d storage.Device
+	var activated bool
+
+	// the event is sent within the same transaction as the device update
+	// when the integration supports this (event journal)
+	var flush func() error
+
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		var err error
+		d, err = storage.GetDevice(tx, up.DevEUI, true, true)
+		if err != nil {
+			return errors.Wrap(err, "get device error")
+		}
+
+		if d.ApplicationID != applicationID {
+			return errors.Wrap(storage.ErrDoesNotExist, "device of other application")
+		}
+
+		// the third-party network-server is not trusted to reject replayed
+		// uplinks, the device is locked above
+		lastFCnt, err := storage.GetDeviceLastFCnt(tx, d.DevEUI)
+		if err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+			return errors.Wrap(err, "get last frame-counter error")
+		}
+		if err == nil && up.FCnt <= lastFCnt && !storage.IsFCntReset(lastFCnt, up.FCnt) {
+			return errFCntReplay
+		}
+
+		now := time.Now()
+		d.LastSeenAt = &now
+		if err := storage.UpdateDevice(tx, &d, true); err != nil {
+			return errors.Wrap(err, "update device error")
+		}
+
+		if d.LifecycleState == storage.DeviceProvisioned {
+			if err := storage.UpdateDeviceLifecycleState(tx, &d, storage.DeviceActive); err != nil {
+				return errors.Wrap(err, "update device lifecycle state error")
+			}
+			activated = true
+		}
+
+		if err := storage.UpdateDeviceLinkStat(tx, d.DevEUI, up.FCnt, now); err != nil {
+			return errors.Wrap(err, "update device link stat error")
+		}
+
+		app, err := storage.GetApplication(tx, d.ApplicationID)
+		if err != nil {
+			return errors.Wrap(err, "get application error")
+		}
+
+		uplink.Archive(app, archive.Uplink{
+			ReceivedAt:    time.Now(),
+			ApplicationID: app.ID,
+			DevEUI:        d.DevEUI,
+			Data:          up.Data,
+			Request:       up.request(),
+		})
+
+		var intg integration.Integrator
+		intg, flush = integration.ForTx(tx)
+
+		return uplink.Handle(tx, intg, d, app, integration.DataUpPayload{
+			ApplicationID:   app.ID,
+			ApplicationName: app.Name,
+			DeviceName:      d.Name,
+			DevEUI:          d.DevEUI,
+			RXInfo:          up.RXInfo,
+			TXInfo: integration.TXInfo{
+				Frequency: up.Frequency,
+			},
+			FCnt:  up.FCnt,
+			FPort: up.FPort,
+			Data:  up.Data,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if activated {
+		eventbus.Publish(eventbus.DeviceActivated, d.ApplicationID, d.DevEUI, d)
+	}
+
+	if err := flush(); err != nil {
+		return errors.Wrap(err, "send uplink data to integration error")
+	}
+
+	return nil
+}
+
+// request returns the uplink in the format of the network-server uplink
+// request, so that it can be archived and reprocessed as any other uplink.
+// As the payload is already decrypted, the request does not contain the
+// payload.
+func (up uplinkFrame) request() *as.HandleUplinkDataRequest {
+	req := as.HandleUplinkDataRequest{
+		DevEui: up.DevEUI[:],
+		FCnt:   up.FCnt,
+		FPort:  uint32(up.FPort),
+		TxInfo: &gw.UplinkTXInfo{
+			Frequency: uint32(up.Frequency),
+		},
+	}
+
+	for _, rx := range up.RXInfo {
+		rxInfo := gw.UplinkRXInfo{
+			GatewayId: rx.GatewayID[:],
+			Rssi:      int32(rx.RSSI),
+			LoraSnr:   rx.LoRaSNR,
+		}
+
+		if rx.Time != nil {
+			if ts, err := ptypes.TimestampProto(*rx.Time); err == nil {
+				rxInfo.Time = ts
+			}
+		}
+
+		if rx.Location != nil {
+			rxInfo.Location = &common.Location{
+				Latitude:  rx.Location.Latitude,
+				Longitude: rx.Location.Longitude,
+				Altitude:  rx.Location.Altitude,
+			}
+		}
+
+		req.RxInfo = append(req.RxInfo, &rxInfo)
+	}
+
+	return &req
+}
+
+// heliumUplink implements the Helium HTTP integration uplink format.
+type heliumUplink struct {
+	DevEUI   string `json:"dev_eui"`
+	FCnt     uint32 `json:"fcnt"`
+	Port     uint8  `json:"port"`
+	Payload  []byte `json:"payload"`
+	Hotspots []struct {
+		Name       string      `json:"name"`
+		ReportedAt int64       `json:"reported_at"`
+		RSSI       float64     `json:"rssi"`
+		SNR        float64     `json:"snr"`
+		Frequency  float64     `json:"frequency"`
+		Lat        interface{} `json:"lat"`
+		Long       interface{} `json:"long"`
+	} `json:"hotspots"`
+}
+
+func parseHelium(b []byte) (uplinkFrame, error) {
+	var up uplinkFrame
+	var pl heliumUplink
+
+	if err := json.Unmarshal(b, &pl); err != nil {
+		return up, fmt.Errorf("decode body error: %s", err)
+	}
+
+	if err := up.DevEUI.UnmarshalText([]byte(pl.DevEUI)); err != nil {
+		return up, fmt.Errorf("invalid dev_eui: %s", err)
+	}
+
+	up.FCnt = pl.FCnt
+	up.FPort = pl.Port
+	up.Data = pl.Payload
+	up.RXInfo = []integration.RXInfo{}
+
+	for _, h := range pl.Hotspots {
+		if up.Frequency == 0 {
+			up.Frequency = int(h.Frequency*1000000 + 0.5)
+		}
+
+		// the hotspot id is not an EUI64, therefore only the name is set
+		rxInfo := integration.RXInfo{
+			Name:    h.Name,
+			RSSI:    int(h.RSSI),
+			LoRaSNR: h.SNR,
+		}
+
+		if h.ReportedAt != 0 {
+			ts := time.Unix(0, h.ReportedAt*int64(time.Millisecond)).UTC()
+			rxInfo.Time = &ts
+		}
+
+		// the location is set to "unknown" when not asserted
+		lat, latOK := h.Lat.(float64)
+		long, longOK := h.Long.(float64)
+		if latOK && longOK {
+			rxInfo.Location = &integration.Location{
+				Latitude:  lat,
+				Longitude: long,
+			}
+		}
+
+		up.RXInfo = append(up.RXInfo, rxInfo)
+	}
+
+	return up, nil
+}
+
+// ttnUplink implements The Things Stack (v3) webhook uplink format.
+type ttnUplink struct {
+	EndDeviceIDs struct {
+		DevEUI string `json:"dev_eui"`
+	} `json:"end_device_ids"`
+	UplinkMessage *struct {
+		FPort      uint8  `json:"f_port"`
+		FCnt       uint32 `json:"f_cnt"`
+		FRMPayload []byte `json:"frm_payload"`
+		RXMetadata []struct {
+			GatewayIDs struct {
+				GatewayID string `json:"gateway_id"`
+				EUI       string `json:"eui"`
+			} `json:"gateway_ids"`
+			Time     *time.Time `json:"time"`
+			RSSI     float64    `json:"rssi"`
+			SNR      float64    `json:"snr"`
+			Location *struct {
+				Latitude  float64 `json:"latitude"`
+				Longitude float64 `json:"longitude"`
+				Altitude  float64 `json:"altitude"`
+			} `json:"location"`
+		} `json:"rx_metadata"`
+		Settings struct {
+			Frequency string `json:"frequency"`
+		} `json:"settings"`
+	} `json:"uplink_message"`
+	JoinAccept *struct{} `json:"join_accept"`
+}
+
+func parseTTN(b []byte) (uplinkFrame, error) {
+	var up uplinkFrame
+	var pl ttnUplink
+
+	if err := json.Unmarshal(b, &pl); err != nil {
+		return up, fmt.Errorf("decode body error: %s", err)
+	}
+
+	if pl.UplinkMessage == nil && pl.JoinAccept == nil {
+		return up, errors.New("uplink_message or join_accept is missing")
+	}
+
+	if err := up.DevEUI.UnmarshalText([]byte(pl.EndDeviceIDs.DevEUI)); err != nil {
+		return up, fmt.Errorf("invalid dev_eui: %s", err)
+	}
+
+	if pl.UplinkMessage == nil {
+		up.Join = true
+		return up, nil
+	}
+
+	msg := pl.UplinkMessage
+	up.FCnt = msg.FCnt
+	up.FPort = msg.FPort
+	up.Data = msg.FRMPayload
+	up.RXInfo = []integration.RXInfo{}
+
+	if msg.Settings.Frequency != "" {
+		freq, err := strconv.Atoi(msg.Settings.Frequency)
+		if err != nil {
+			return up, fmt.Errorf("invalid frequency: %s", err)
+		}
+		up.Frequency = freq
+	}
+
+	for _, md := range msg.RXMetadata {
+		rxInfo := integration.RXInfo{
+			Name:    md.GatewayIDs.GatewayID,
+			Time:    md.Time,
+			RSSI:    int(md.RSSI),
+			LoRaSNR: md.SNR,
+		}
+
+		if md.GatewayIDs.EUI != "" {
+			if err := rxInfo.GatewayID.UnmarshalText([]byte(md.GatewayIDs.EUI)); err != nil {
+				return up, fmt.Errorf("invalid gateway eui: %s", err)
+			}
+		}
+
+		if md.Location != nil {
+			rxInfo.Location = &integration.Location{
+				Latitude:  md.Location.Latitude,
+				Longitude: md.Location.Longitude,
+				Altitude:  md.Location.Altitude,
+			}
+		}
+
+		up.RXInfo = append(up.RXInfo, rxInfo)
+	}
+
+	return up, nil
+}
@@ -0,0 +1,242 @@
+package webhook
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lorawan"
+)
+
+func TestParseHelium(t *testing.T) {
+	assert := require.New(t)
+
+	up, err := parseHelium([]byte(`{
+		"app_eui": "0807060504030201",
+		"dev_eui": "0102030405060708",
+		"devaddr": "01020304",
+		"fcnt": 10,
+		"port": 2,
+		"payload": "AQID",
+		"reported_at": 1546300800000,
+		"hotspots": [
+			{
+				"id": "11e2n4f4",
+				"name": "rooftop-hotspot",
+				"reported_at": 1546300800000,
+				"rssi": -90,
+				"snr": 5.5,
+				"spreading": "SF9BW125",
+				"frequency": 868.1,
+				"lat": 52.3740364,
+				"long": 4.9144401
+			},
+			{
+				"id": "22e2n4f4",
+				"name": "other-hotspot",
+				"rssi": -110,
+				"snr": -2,
+				"frequency": 868.1,
+				"lat": "unknown",
+				"long": "unknown"
+			}
+		]
+	}`))
+	assert.NoError(err)
+
+	ts := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(uplinkFrame{
+		DevEUI:    lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		FCnt:      10,
+		FPort:     2,
+		Data:      []byte{1, 2, 3},
+		Frequency: 868100000,
+		RXInfo: []integration.RXInfo{
+			{
+				Name:    "rooftop-hotspot",
+				Time:    &ts,
+				RSSI:    -90,
+				LoRaSNR: 5.5,
+				Location: &integration.Location{
+					Latitude:  52.3740364,
+					Longitude: 4.9144401,
+				},
+			},
+			{
+				Name:    "other-hotspot",
+				RSSI:    -110,
+				LoRaSNR: -2,
+			},
+		},
+	}, up)
+
+	_, err = parseHelium([]byte(`{"dev_eui": "0102"}`))
+	assert.EqualError(err, "invalid dev_eui: lorawan: exactly 8 bytes are expected")
+}
+
+func TestParseTTN(t *testing.T) {
+	assert := require.New(t)
+
+	up, err := parseTTN([]byte(`{
+		"end_device_ids": {
+			"device_id": "garden-sensor",
+			"application_ids": {"application_id": "temperature-sensors"},
+			"dev_eui": "0102030405060708",
+			"dev_addr": "01020304"
+		},
+		"received_at": "2019-01-01T00:00:00.5Z",
+		"uplink_message": {
+			"f_port": 2,
+			"f_cnt": 10,
+			"frm_payload": "AQID",
+			"rx_metadata": [
+				{
+					"gateway_ids": {"gateway_id": "rooftop-gateway", "eui": "0303030303030303"},
+					"time": "2019-01-01T00:00:00Z",
+					"rssi": -57,
+					"snr": 10,
+					"location": {"latitude": 52.3740364, "longitude": 4.9144401, "altitude": 10.5}
+				}
+			],
+			"settings": {
+				"data_rate": {"lora": {"bandwidth": 125000, "spreading_factor": 7}},
+				"frequency": "868100000"
+			}
+		}
+	}`))
+	assert.NoError(err)
+
+	ts := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(uplinkFrame{
+		DevEUI:    lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		FCnt:      10,
+		FPort:     2,
+		Data:      []byte{1, 2, 3},
+		Frequency: 868100000,
+		RXInfo: []integration.RXInfo{
+			{
+				GatewayID: lorawan.EUI64{3, 3, 3, 3, 3, 3, 3, 3},
+				Name:      "rooftop-gateway",
+				Time:      &ts,
+				RSSI:      -57,
+				LoRaSNR:   10,
+				Location: &integration.Location{
+					Latitude:  52.3740364,
+					Longitude: 4.9144401,
+					Altitude:  10.5,
+				},
+			},
+		},
+	}, up)
+
+	up, err = parseTTN([]byte(`{"end_device_ids": {"dev_eui": "0102030405060708"}, "join_accept": {"session_key_id": "AQID"}}`))
+	assert.NoError(err)
+	assert.Equal(uplinkFrame{
+		Join:   true,
+		DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+	}, up)
+
+	_, err = parseTTN([]byte(`{"end_device_ids": {"dev_eui": "0102030405060708"}}`))
+	assert.EqualError(err, "uplink_message or join_accept is missing")
+}
+
+func TestUplinkFrameRequest(t *testing.T) {
+	assert := require.New(t)
+
+	ts := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	up := uplinkFrame{
+		DevEUI:    lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		FCnt:      10,
+		FPort:     2,
+		Data:      []byte{1, 2, 3},
+		Frequency: 868100000,
+		RXInfo: []integration.RXInfo{
+			{
+				GatewayID: lorawan.EUI64{3, 3, 3, 3, 3, 3, 3, 3},
+				Time:      &ts,
+				RSSI:      -57,
+				LoRaSNR:   10,
+				Location: &integration.Location{
+					Latitude:  52.3740364,
+					Longitude: 4.9144401,
+				},
+			},
+		},
+	}
+
+	req := up.request()
+	assert.Equal([]byte{1, 2, 3, 4, 5, 6, 7, 8}, req.DevEui)
+	assert.EqualValues(10, req.FCnt)
+	assert.EqualValues(2, req.FPort)
+	assert.Nil(req.Data)
+	assert.EqualValues(868100000, req.TxInfo.Frequency)
+
+	// the request rx-info is converted back by the reprocessing
+	assert.Len(req.RxInfo, 1)
+	assert.Equal([]byte{3, 3, 3, 3, 3, 3, 3, 3}, req.RxInfo[0].GatewayId)
+	assert.EqualValues(-57, req.RxInfo[0].Rssi)
+	assert.Equal(10.0, req.RxInfo[0].LoraSnr)
+	assert.Equal(52.3740364, req.RxInfo[0].Location.Latitude)
+
+	rxTime, err := ptypes.Timestamp(req.RxInfo[0].Time)
+	assert.NoError(err)
+	assert.True(ts.Equal(rxTime))
+}
+
+func TestHandler(t *testing.T) {
+	h := NewHandler(map[int64]string{1: "secret"})
+
+	tests := []struct {
+		Name           string
+		Path           string
+		Token          string
+		Body           string
+		ExpectedStatus int
+	}{
+		{
+			Name:           "invalid token",
+			Path:           "/webhook/ttn",
+			Token:          "other",
+			ExpectedStatus: http.StatusUnauthorized,
+		},
+		{
+			Name:           "unknown format",
+			Path:           "/webhook/other",
+			Token:          "secret",
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Name:           "invalid body",
+			Path:           "/webhook/helium",
+			Token:          "secret",
+			Body:           `{"dev_eui": "0102"}`,
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "no application payload",
+			Path:           "/webhook/helium",
+			Token:          "secret",
+			Body:           `{"dev_eui": "0102030405060708", "port": 0}`,
+			ExpectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			req := httptest.NewRequest("POST", test.Path, bytes.NewBufferString(test.Body))
+			req.Header.Set("Authorization", "Bearer "+test.Token)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			assert.Equal(test.ExpectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,365 @@
+// Package archive implements the archival of raw uplink frames to S3
+// compatible object storage.
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/loraserver/api/as"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+// ErrNotConfigured is returned when the archive has not been configured.
+var ErrNotConfigured = errors.New("archive is not configured")
+
+// ErrQueueFull is returned when the archive queue is full.
+var ErrQueueFull = errors.New("archive queue is full")
+
+// queueWorkers defines the number of workers archiving the queued uplinks.
+const queueWorkers = 4
+
+var (
+	client  s3iface.S3API
+	bucket  string
+	prefix  string
+	timeout = 10 * time.Second
+	queue   chan Uplink
+)
+
+// Setup configures the archive package. When no bucket is configured,
+// archiving is disabled.
+func Setup(conf config.Config) error {
+	c := conf.ApplicationServer.Archive
+	if c.Bucket == "" {
+		return nil
+	}
+
+	awsConf := aws.Config{
+		Region:           aws.String(c.Region),
+		S3ForcePathStyle: aws.Bool(c.ForcePathStyle),
+	}
+	if c.Endpoint != "" {
+		awsConf.Endpoint = aws.String(c.Endpoint)
+	}
+	if c.AccessKeyID != "" {
+		awsConf.Credentials = credentials.NewStaticCredentials(c.AccessKeyID, c.SecretAccessKey, "")
+	}
+
+	log.WithFields(log.Fields{
+		"bucket":   c.Bucket,
+		"endpoint": c.Endpoint,
+	}).Info("archive: setting up s3 session")
+	sess, err := session.NewSession(&awsConf)
+	if err != nil {
+		return errors.Wrap(err, "new session error")
+	}
+
+	SetClient(s3.New(sess), c.Bucket, c.Prefix)
+
+	if c.Timeout > 0 {
+		timeout = c.Timeout
+	}
+	startQueue(c.QueueSize)
+
+	return nil
+}
+
+// startQueue creates the archive queue with the given size and starts the
+// workers archiving the queued uplinks.
+func startQueue(size int) {
+	queue = make(chan Uplink, size)
+
+	for i := 0; i < queueWorkers; i++ {
+		go func(q chan Uplink) {
+			for u := range q {
+				if err := ArchiveUplink(u); err != nil {
+					log.WithField("dev_eui", u.DevEUI).WithError(err).Error("archive: archive uplink error")
+				}
+			}
+		}(queue)
+	}
+}
+
+// QueueUplink queues the given uplink for archiving, so that the uplink
+// handling does not wait for the object storage. ErrQueueFull is returned
+// when the queue is full, in which case the uplink is not archived.
+func QueueUplink(u Uplink) error {
+	if client == nil || queue == nil {
+		return ErrNotConfigured
+	}
+
+	select {
+	case queue <- u:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// SetClient sets the S3 client, bucket and key prefix used for archiving.
+// Setting a nil client disables archiving.
+func SetClient(c s3iface.S3API, b, p string) {
+	client = c
+	bucket = b
+	prefix = p
+}
+
+// Enabled returns if archiving has been configured.
+func Enabled() bool {
+	return client != nil
+}
+
+// Uplink contains the raw uplink as received from the network-server,
+// together with the decrypted FRMPayload.
+type Uplink struct {
+	ReceivedAt    time.Time
+	ApplicationID int64
+	DevEUI        lorawan.EUI64
+	DevAddr       lorawan.DevAddr
+	Data          []byte
+	Request       *as.HandleUplinkDataRequest
+}
+
+// Redact drops or redacts the given fields (see integration.RedactData etc.)
+// from the uplink. The request is copied before it is modified, as it might
+// be shared with the caller.
+func (u *Uplink) Redact(fields []string) {
+	if len(fields) == 0 {
+		return
+	}
+
+	var req *as.HandleUplinkDataRequest
+	if u.Request != nil {
+		req = proto.Clone(u.Request).(*as.HandleUplinkDataRequest)
+	}
+
+	for _, field := range fields {
+		switch field {
+		case integration.RedactData:
+			u.Data = nil
+			if req != nil {
+				req.Data = nil
+			}
+		case integration.RedactTXInfo:
+			if req != nil {
+				req.TxInfo = nil
+				req.Dr = 0
+			}
+		case integration.RedactRXInfo:
+			if req != nil {
+				req.RxInfo = nil
+			}
+		}
+	}
+
+	if req != nil {
+		for _, rx := range req.RxInfo {
+			for _, field := range fields {
+				switch field {
+				case integration.RedactRXInfoLocation:
+					rx.Location = nil
+				case integration.RedactRXInfoGateway:
+					rx.GatewayId = nil
+				case integration.RedactRXInfoTime:
+					rx.Time = nil
+					rx.TimeSinceGpsEpoch = nil
+					rx.FineTimestampType = gw.FineTimestampType_NONE
+					rx.FineTimestamp = nil
+				}
+			}
+		}
+	}
+
+	u.Request = req
+}
+
+type uplinkJSON struct {
+	ReceivedAt    time.Time       `json:"receivedAt"`
+	ApplicationID int64           `json:"applicationID,string"`
+	DevEUI        lorawan.EUI64   `json:"devEUI"`
+	DevAddr       lorawan.DevAddr `json:"devAddr"`
+	Data          []byte          `json:"data"`
+	Request       json.RawMessage `json:"request"`
+}
+
+// MarshalJSON implements json.Marshaler. The network-server request is
+// marshaled using the protobuf JSON mapping.
+func (u Uplink) MarshalJSON() ([]byte, error) {
+	out := uplinkJSON{
+		ReceivedAt:    u.ReceivedAt,
+		ApplicationID: u.ApplicationID,
+		DevEUI:        u.DevEUI,
+		DevAddr:       u.DevAddr,
+		Data:          u.Data,
+		Request:       json.RawMessage("null"),
+	}
+
+	if u.Request != nil {
+		var buf bytes.Buffer
+		if err := (&jsonpb.Marshaler{}).Marshal(&buf, u.Request); err != nil {
+			return nil, errors.Wrap(err, "marshal request error")
+		}
+		out.Request = buf.Bytes()
+	}
+
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *Uplink) UnmarshalJSON(b []byte) error {
+	var in uplinkJSON
+	if err := json.Unmarshal(b, &in); err != nil {
+		return err
+	}
+
+	u.ReceivedAt = in.ReceivedAt
+	u.ApplicationID = in.ApplicationID
+	u.DevEUI = in.DevEUI
+	u.DevAddr = in.DevAddr
+	u.Data = in.Data
+	u.Request = nil
+
+	if len(in.Request) != 0 && string(in.Request) != "null" {
+		u.Request = &as.HandleUplinkDataRequest{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(in.Request), u.Request); err != nil {
+			return errors.Wrap(err, "unmarshal request error")
+		}
+	}
+
+	return nil
+}
+
+// DatePrefix returns the key prefix of the uplinks archived for the given
+// application at the given date (UTC).
+func DatePrefix(applicationID int64, date time.Time) string {
+	return fmt.Sprintf("%s%s/%d/", prefix, date.UTC().Format("2006/01/02"), applicationID)
+}
+
+// Key returns the object key for the given uplink. Uplinks are partitioned
+// by date (UTC) and application.
+func (u Uplink) Key() string {
+	var fCnt uint32
+	if u.Request != nil {
+		fCnt = u.Request.FCnt
+	}
+
+	return fmt.Sprintf("%s%s/%d_%d.json", DatePrefix(u.ApplicationID, u.ReceivedAt), u.DevEUI, u.ReceivedAt.UnixNano(), fCnt)
+}
+
+// ArchiveUplink stores the given uplink in the archive. The request to the
+// object storage is canceled after the configured timeout.
+func ArchiveUplink(u Uplink) error {
+	if client == nil {
+		return ErrNotConfigured
+	}
+
+	b, err := json.Marshal(u)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	key := u.Key()
+	_, err = client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(b),
+		ContentType: aws.String("application/json"),
+	})
+	if err != nil {
+		return errors.Wrap(err, "put object error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui": u.DevEUI,
+		"key":     key,
+	}).Info("archive: uplink archived")
+
+	return nil
+}
+
+// ListUplinks calls fn for each archived uplink of the given application
+// which was received within the given time range (start inclusive, end
+// exclusive). The uplinks are iterated per day and per device.
+// Iteration stops at the first error returned by fn.
+func ListUplinks(applicationID int64, start, end time.Time, fn func(Uplink) error) error {
+	if client == nil {
+		return ErrNotConfigured
+	}
+
+	start = start.UTC()
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	for ; day.Before(end); day = day.AddDate(0, 0, 1) {
+		var fnErr error
+
+		err := client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String(DatePrefix(applicationID, day)),
+		}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+			for _, obj := range page.Contents {
+				u, err := getUplink(aws.StringValue(obj.Key))
+				if err != nil {
+					fnErr = errors.Wrap(err, "get uplink error")
+					return false
+				}
+
+				if u.ReceivedAt.Before(start) || !u.ReceivedAt.Before(end) {
+					continue
+				}
+
+				if err := fn(u); err != nil {
+					fnErr = err
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return errors.Wrap(err, "list objects error")
+		}
+		if fnErr != nil {
+			return fnErr
+		}
+	}
+
+	return nil
+}
+
+func getUplink(key string) (Uplink, error) {
+	var u Uplink
+
+	out, err := client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return u, errors.Wrap(err, "get object error")
+	}
+	defer out.Body.Close()
+
+	if err := json.NewDecoder(out.Body).Decode(&u); err != nil {
+		return u, errors.Wrap(err, "decode json error")
+	}
+
+	return u, nil
+}
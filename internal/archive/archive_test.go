@@ -0,0 +1,267 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/loraserver/api/as"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+type s3Client struct {
+	s3iface.S3API
+	putObjectChan chan s3.PutObjectInput
+	objects       map[string][]byte
+}
+
+func (c *s3Client) PutObjectWithContext(ctx aws.Context, in *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+	b, err := ioutil.ReadAll(in.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.objects[aws.StringValue(in.Key)] = b
+	c.putObjectChan <- *in
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (c *s3Client) ListObjectsV2Pages(in *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool) error {
+	var keys []string
+	for k := range c.objects {
+		if strings.HasPrefix(k, aws.StringValue(in.Prefix)) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var out s3.ListObjectsV2Output
+	for _, k := range keys {
+		out.Contents = append(out.Contents, &s3.Object{Key: aws.String(k)})
+	}
+	fn(&out, true)
+	return nil
+}
+
+func (c *s3Client) GetObject(in *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	b, ok := c.objects[aws.StringValue(in.Key)]
+	if !ok {
+		return nil, errors.New("object does not exist")
+	}
+	return &s3.GetObjectOutput{
+		Body: ioutil.NopCloser(bytes.NewReader(b)),
+	}, nil
+}
+
+func TestArchiveUplink(t *testing.T) {
+	assert := require.New(t)
+
+	u := Uplink{
+		ReceivedAt:    time.Date(2019, 1, 20, 10, 11, 12, 0, time.UTC),
+		ApplicationID: 123,
+		DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		DevAddr:       lorawan.DevAddr{1, 2, 3, 4},
+		Data:          []byte{1, 2, 3},
+		Request: &as.HandleUplinkDataRequest{
+			DevEui: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			FCnt:   10,
+			FPort:  20,
+			Dr:     3,
+			Data:   []byte{4, 5, 6},
+			TxInfo: &gw.UplinkTXInfo{
+				Frequency: 868100000,
+			},
+			RxInfo: []*gw.UplinkRXInfo{
+				{
+					GatewayId: []byte{8, 7, 6, 5, 4, 3, 2, 1},
+					Rssi:      -60,
+					LoraSnr:   5.5,
+				},
+			},
+		},
+	}
+
+	t.Run("Not configured", func(t *testing.T) {
+		assert := require.New(t)
+		SetClient(nil, "", "")
+
+		assert.False(Enabled())
+		assert.Equal(ErrNotConfigured, ArchiveUplink(u))
+	})
+
+	t.Run("Key", func(t *testing.T) {
+		assert := require.New(t)
+		SetClient(nil, "", "uplinks/")
+
+		assert.Equal("uplinks/2019/01/20/123/", DatePrefix(123, u.ReceivedAt))
+		assert.Equal("uplinks/2019/01/20/123/0102030405060708/1547979072000000000_10.json", u.Key())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		assert := require.New(t)
+
+		b, err := json.Marshal(u)
+		assert.NoError(err)
+
+		var u2 Uplink
+		assert.NoError(json.Unmarshal(b, &u2))
+		assert.Equal(u.ReceivedAt, u2.ReceivedAt)
+		assert.Equal(u.ApplicationID, u2.ApplicationID)
+		assert.Equal(u.DevEUI, u2.DevEUI)
+		assert.Equal(u.DevAddr, u2.DevAddr)
+		assert.Equal(u.Data, u2.Data)
+		assert.Equal(u.Request.String(), u2.Request.String())
+	})
+
+	t.Run("ArchiveUplink", func(t *testing.T) {
+		assert := require.New(t)
+
+		c := &s3Client{
+			putObjectChan: make(chan s3.PutObjectInput, 10),
+			objects:       make(map[string][]byte),
+		}
+		SetClient(c, "test-bucket", "")
+		assert.True(Enabled())
+
+		assert.NoError(ArchiveUplink(u))
+		in := <-c.putObjectChan
+		assert.Equal("test-bucket", aws.StringValue(in.Bucket))
+		assert.Equal(u.Key(), aws.StringValue(in.Key))
+		assert.Equal("application/json", aws.StringValue(in.ContentType))
+
+		var u2 Uplink
+		assert.NoError(json.Unmarshal(c.objects[u.Key()], &u2))
+		assert.Equal(u.Data, u2.Data)
+		assert.EqualValues(10, u2.Request.FCnt)
+
+		t.Run("QueueUplink", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.Equal(ErrNotConfigured, QueueUplink(u))
+
+			startQueue(1)
+			defer func() { queue = nil }()
+
+			assert.NoError(QueueUplink(u))
+			in := <-c.putObjectChan
+			assert.Equal(u.Key(), aws.StringValue(in.Key))
+		})
+
+		t.Run("ListUplinks", func(t *testing.T) {
+			assert := require.New(t)
+
+			// next day
+			u2 := u
+			u2.ReceivedAt = u.ReceivedAt.Add(24 * time.Hour)
+			assert.NoError(ArchiveUplink(u2))
+			<-c.putObjectChan
+
+			// other application
+			u3 := u
+			u3.ApplicationID = 124
+			assert.NoError(ArchiveUplink(u3))
+			<-c.putObjectChan
+
+			tests := []struct {
+				Name     string
+				Start    time.Time
+				End      time.Time
+				Expected []time.Time
+			}{
+				{
+					Name:     "both days",
+					Start:    u.ReceivedAt.Add(-time.Hour),
+					End:      u2.ReceivedAt.Add(time.Hour),
+					Expected: []time.Time{u.ReceivedAt, u2.ReceivedAt},
+				},
+				{
+					Name:     "end is exclusive",
+					Start:    u.ReceivedAt,
+					End:      u2.ReceivedAt,
+					Expected: []time.Time{u.ReceivedAt},
+				},
+				{
+					Name:  "nothing in range",
+					Start: u.ReceivedAt.Add(time.Second),
+					End:   u2.ReceivedAt.Add(-time.Second),
+				},
+			}
+
+			for _, tst := range tests {
+				t.Run(tst.Name, func(t *testing.T) {
+					assert := require.New(t)
+
+					var received []time.Time
+					assert.NoError(ListUplinks(123, tst.Start, tst.End, func(u Uplink) error {
+						assert.EqualValues(123, u.ApplicationID)
+						received = append(received, u.ReceivedAt)
+						return nil
+					}))
+					assert.Equal(tst.Expected, received)
+				})
+			}
+		})
+	})
+
+	SetClient(nil, "", "")
+	assert.False(Enabled())
+}
+
+func TestUplinkRedact(t *testing.T) {
+	assert := require.New(t)
+
+	req := as.HandleUplinkDataRequest{
+		DevEui: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		Data:   []byte{4, 5, 6},
+		Dr:     3,
+		TxInfo: &gw.UplinkTXInfo{
+			Frequency: 868100000,
+		},
+		RxInfo: []*gw.UplinkRXInfo{
+			{
+				GatewayId: []byte{1, 1, 1, 1, 1, 1, 1, 1},
+				Rssi:      -60,
+				Location: &common.Location{
+					Latitude: 1.123,
+				},
+			},
+		},
+	}
+
+	u := Uplink{
+		Data:    []byte{1, 2, 3},
+		Request: &req,
+	}
+
+	u.Redact([]string{integration.RedactData, integration.RedactRXInfoLocation, integration.RedactRXInfoGateway})
+	assert.Nil(u.Data)
+	assert.Nil(u.Request.Data)
+	assert.Len(u.Request.RxInfo, 1)
+	assert.Nil(u.Request.RxInfo[0].Location)
+	assert.Nil(u.Request.RxInfo[0].GatewayId)
+	assert.EqualValues(-60, u.Request.RxInfo[0].Rssi)
+	assert.EqualValues(868100000, u.Request.TxInfo.Frequency)
+
+	// the original request is not modified
+	assert.Equal([]byte{4, 5, 6}, req.Data)
+	assert.NotNil(req.RxInfo[0].Location)
+	assert.Equal([]byte{1, 1, 1, 1, 1, 1, 1, 1}, req.RxInfo[0].GatewayId)
+
+	u.Redact([]string{integration.RedactRXInfo, integration.RedactTXInfo})
+	assert.Nil(u.Request.RxInfo)
+	assert.Nil(u.Request.TxInfo)
+	assert.EqualValues(0, u.Request.Dr)
+}
@@ -0,0 +1,218 @@
+package networkserver
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// ErrCircuitOpen is returned when the circuit-breaker of the network-server
+// is open.
+var ErrCircuitOpen = grpc.Errorf(codes.Unavailable, "network-server circuit-breaker open")
+
+var (
+	callTimeout      time.Duration
+	retryCount       int
+	retryInterval    time.Duration
+	failureThreshold int
+	openTimeout      = 30 * time.Second
+)
+
+// Circuit-breaker states.
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half-open"
+)
+
+// Stats contains the circuit-breaker state and statistics of a
+// network-server.
+type Stats struct {
+	State    string
+	Failures int
+	Opened   uint64
+	Rejected uint64
+	Retries  uint64
+}
+
+// breaker implements the circuit-breaker of a single network-server. After
+// failureThreshold consecutive failures, the breaker opens and rejects all
+// calls until openTimeout has expired. It then allows a single trial call
+// (half-open state), which either closes or re-opens the breaker.
+type breaker struct {
+	hostname string
+
+	mux      sync.Mutex
+	openedAt time.Time
+	trial    bool
+	stats    Stats
+}
+
+func newBreaker(hostname string) *breaker {
+	return &breaker{
+		hostname: hostname,
+		stats: Stats{
+			State: BreakerClosed,
+		},
+	}
+}
+
+// allow returns if the call is allowed by the breaker.
+func (b *breaker) allow() bool {
+	if failureThreshold <= 0 {
+		return true
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	switch b.stats.State {
+	case BreakerOpen:
+		if time.Since(b.openedAt) < openTimeout {
+			b.stats.Rejected++
+			return false
+		}
+		b.stats.State = BreakerHalfOpen
+		b.trial = true
+		return true
+	case BreakerHalfOpen:
+		// only a single trial call is allowed
+		if b.trial {
+			b.stats.Rejected++
+			return false
+		}
+		b.trial = true
+		return true
+	default:
+		return true
+	}
+}
+
+// done records the result of an allowed call.
+func (b *breaker) done(failure bool) {
+	if failureThreshold <= 0 {
+		return
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.trial = false
+
+	if !failure {
+		if b.stats.State != BreakerClosed {
+			log.WithField("server", b.hostname).Info("network-server circuit-breaker closed")
+		}
+		b.stats.State = BreakerClosed
+		b.stats.Failures = 0
+		return
+	}
+
+	b.stats.Failures++
+	if b.stats.State == BreakerHalfOpen || (b.stats.State == BreakerClosed && b.stats.Failures >= failureThreshold) {
+		b.stats.State = BreakerOpen
+		b.stats.Opened++
+		b.openedAt = time.Now()
+
+		log.WithFields(log.Fields{
+			"server":   b.hostname,
+			"failures": b.stats.Failures,
+		}).Warning("network-server circuit-breaker opened")
+	}
+}
+
+func (b *breaker) retried() {
+	b.mux.Lock()
+	b.stats.Retries++
+	b.mux.Unlock()
+}
+
+func (b *breaker) getStats() Stats {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	return b.stats
+}
+
+// unaryClientInterceptor returns the interceptor applying the call timeout,
+// the retries (for idempotent calls only) and the circuit-breaker to the
+// network-server API calls.
+func unaryClientInterceptor(b *breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		attempts := 1
+		if isIdempotent(method) {
+			attempts += retryCount
+		}
+
+		var err error
+		for i := 0; i < attempts; i++ {
+			if i > 0 {
+				select {
+				case <-time.After(retryInterval):
+				case <-ctx.Done():
+					return err
+				}
+			}
+
+			if !b.allow() {
+				// return the error of the previous attempt (if any), as
+				// it is more meaningful to the caller
+				if err != nil {
+					return err
+				}
+				return ErrCircuitOpen
+			}
+
+			if i > 0 {
+				b.retried()
+			}
+
+			err = invoke(ctx, method, req, reply, cc, invoker, opts...)
+			failure := isTransientError(ctx, err)
+			b.done(failure)
+
+			if !failure {
+				return err
+			}
+		}
+
+		return err
+	}
+}
+
+func invoke(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if callTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, callTimeout)
+		defer cancel()
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// isIdempotent returns if the given (full) method name is safe to retry.
+func isIdempotent(method string) bool {
+	name := method[strings.LastIndex(method, "/")+1:]
+	return strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List")
+}
+
+// isTransientError returns if the given error is caused by the
+// network-server being unavailable or not responding in time. Errors caused
+// by the context of the caller (e.g. the client cancelled the request) are
+// not considered transient.
+func isTransientError(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	switch grpc.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,102 @@
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	retryCount = 2
+	retryInterval = time.Millisecond
+	failureThreshold = 3
+	openTimeout = 50 * time.Millisecond
+	defer func() {
+		retryCount = 0
+		retryInterval = 0
+		failureThreshold = 0
+		openTimeout = 30 * time.Second
+	}()
+
+	var calls int
+	var invokeErr error
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		return invokeErr
+	}
+
+	b := newBreaker("test-ns:8000")
+	interceptor := unaryClientInterceptor(b)
+	call := func(method string) error {
+		return interceptor(context.Background(), "/ns.NetworkServerService/"+method, nil, nil, nil, invoker)
+	}
+
+	t.Run("Non-transient errors are not retried", func(t *testing.T) {
+		assert := require.New(t)
+		calls = 0
+		invokeErr = grpc.Errorf(codes.NotFound, "object does not exist")
+
+		assert.Equal(invokeErr, call("GetDevice"))
+		assert.Equal(1, calls)
+		assert.Equal(BreakerClosed, b.getStats().State)
+	})
+
+	t.Run("Non-idempotent calls are not retried", func(t *testing.T) {
+		assert := require.New(t)
+		calls = 0
+		invokeErr = grpc.Errorf(codes.Unavailable, "connection refused")
+
+		assert.Equal(invokeErr, call("CreateDevice"))
+		assert.Equal(1, calls)
+		assert.Equal(1, b.getStats().Failures)
+	})
+
+	t.Run("Idempotent calls are retried and open the breaker", func(t *testing.T) {
+		assert := require.New(t)
+		calls = 0
+
+		assert.Equal(invokeErr, call("GetDevice"))
+		assert.Equal(2, calls)
+
+		stats := b.getStats()
+		assert.Equal(BreakerOpen, stats.State)
+		assert.EqualValues(1, stats.Opened)
+		assert.EqualValues(1, stats.Retries)
+		assert.EqualValues(1, stats.Rejected)
+	})
+
+	t.Run("Open breaker rejects calls", func(t *testing.T) {
+		assert := require.New(t)
+		calls = 0
+
+		assert.Equal(ErrCircuitOpen, call("CreateDevice"))
+		assert.Equal(0, calls)
+	})
+
+	t.Run("Trial call closes the breaker", func(t *testing.T) {
+		assert := require.New(t)
+		time.Sleep(openTimeout)
+		calls = 0
+		invokeErr = nil
+
+		assert.NoError(call("CreateDevice"))
+		assert.Equal(1, calls)
+
+		stats := b.getStats()
+		assert.Equal(BreakerClosed, stats.State)
+		assert.Equal(0, stats.Failures)
+	})
+}
+
+func TestIsIdempotent(t *testing.T) {
+	assert := require.New(t)
+
+	assert.True(isIdempotent("/ns.NetworkServerService/GetDevice"))
+	assert.True(isIdempotent("/ns.NetworkServerService/GetGatewayStats"))
+	assert.False(isIdempotent("/ns.NetworkServerService/CreateDevice"))
+	assert.False(isIdempotent("/ns.NetworkServerService/DeleteDevice"))
+}
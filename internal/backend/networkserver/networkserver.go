@@ -8,12 +8,14 @@ import (
 	"sync"
 	"time"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	"github.com/brocaar/lora-app-server/internal/chaos"
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/loraserver/api/ns"
 )
@@ -35,8 +37,15 @@ type client struct {
 
 // Setup configures the networkserver package.
 func Setup(conf config.Config) error {
+	callTimeout = conf.NetworkServer.Timeout
+	retryCount = conf.NetworkServer.RetryCount
+	retryInterval = conf.NetworkServer.RetryInterval
+	failureThreshold = conf.NetworkServer.CircuitBreaker.FailureThreshold
+	openTimeout = conf.NetworkServer.CircuitBreaker.OpenTimeout
+
 	p = &pool{
-		clients: make(map[string]client),
+		clients:  make(map[string]client),
+		breakers: make(map[string]*breaker),
 	}
 	return nil
 }
@@ -51,9 +60,32 @@ func SetPool(pp Pool) {
 	p = pp
 }
 
+// GetStats returns the circuit-breaker state and statistics per
+// network-server (hostname:port).
+func GetStats() map[string]Stats {
+	out := make(map[string]Stats)
+
+	pp, ok := p.(*pool)
+	if !ok {
+		return out
+	}
+
+	pp.RLock()
+	defer pp.RUnlock()
+
+	for hostname, b := range pp.breakers {
+		out[hostname] = b.getStats()
+	}
+	return out
+}
+
 type pool struct {
 	sync.RWMutex
 	clients map[string]client
+
+	// the breakers are kept separately from the clients, so that their
+	// state is not lost on re-connect
+	breakers map[string]*breaker
 }
 
 // Get returns a NetworkServerClient for the given server (hostname:ip).
@@ -76,7 +108,13 @@ func (p *pool) Get(hostname string, caCert, tlsCert, tlsKey []byte) (ns.NetworkS
 	}
 
 	if connect {
-		clientConn, nsClient, err := p.createClient(hostname, caCert, tlsCert, tlsKey)
+		b, ok := p.breakers[hostname]
+		if !ok {
+			b = newBreaker(hostname)
+			p.breakers[hostname] = b
+		}
+
+		clientConn, nsClient, err := p.createClient(hostname, caCert, tlsCert, tlsKey, b)
 		if err != nil {
 			return nil, errors.Wrap(err, "create network-server api client error")
 		}
@@ -93,7 +131,7 @@ func (p *pool) Get(hostname string, caCert, tlsCert, tlsKey []byte) (ns.NetworkS
 	return c.client, nil
 }
 
-func (p *pool) createClient(hostname string, caCert, tlsCert, tlsKey []byte) (*grpc.ClientConn, ns.NetworkServerServiceClient, error) {
+func (p *pool) createClient(hostname string, caCert, tlsCert, tlsKey []byte, b *breaker) (*grpc.ClientConn, ns.NetworkServerServiceClient, error) {
 	logrusEntry := log.NewEntry(log.StandardLogger())
 	logrusOpts := []grpc_logrus.Option{
 		grpc_logrus.WithLevels(grpc_logrus.DefaultCodeToLevel),
@@ -101,9 +139,11 @@ func (p *pool) createClient(hostname string, caCert, tlsCert, tlsKey []byte) (*g
 
 	nsOpts := []grpc.DialOption{
 		grpc.WithBlock(),
-		grpc.WithUnaryInterceptor(
+		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(
+			unaryClientInterceptor(b),
+			chaos.UnaryClientInterceptor(),
 			grpc_logrus.UnaryClientInterceptor(logrusEntry, logrusOpts...),
-		),
+		)),
 		grpc.WithStreamInterceptor(
 			grpc_logrus.StreamClientInterceptor(logrusEntry, logrusOpts...),
 		),
@@ -0,0 +1,221 @@
+// Package campaign implements the handling of the device configuration
+// campaigns.
+package campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/cluster"
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+// HandleCampaignsLoop is a never returning function handling the running
+// campaigns.
+func HandleCampaignsLoop() {
+	for {
+		if !cluster.IsLeader(cluster.RoleCampaigns) {
+			time.Sleep(time.Second)
+			continue
+		}
+
+		err := storage.Transaction(func(tx sqlx.Ext) error {
+			return handleNextCampaign(tx)
+		})
+		if err != nil {
+			if errors.Cause(err) != storage.ErrDoesNotExist {
+				log.WithError(err).Error("handle campaign error")
+			}
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+// handleNextCampaign handles the next device of the next campaign. The
+// campaign is done when there are no pending or unconfirmed devices left, or
+// when the campaign window has expired.
+func handleNextCampaign(tx sqlx.Ext) error {
+	c, err := storage.GetNextCampaignForUpdate(tx)
+	if err != nil {
+		return errors.Wrap(err, "get next campaign error")
+	}
+
+	now := time.Now()
+	c.State = storage.CampaignRunning
+	c.NextRunAt = now.Add(c.PacingInterval)
+
+	if !now.Before(c.EndAt) {
+		if err := storage.ExpireCampaignDevices(tx, c.ID, "campaign window expired"); err != nil {
+			return errors.Wrap(err, "expire campaign devices error")
+		}
+		return finishCampaign(tx, &c)
+	}
+
+	d, err := storage.GetNextCampaignDeviceForUpdate(tx, c.ID, now.Add(-c.RetryInterval))
+	if err != nil {
+		if errors.Cause(err) != storage.ErrDoesNotExist {
+			return errors.Wrap(err, "get next campaign device error")
+		}
+
+		count, err := storage.GetCampaignDeviceCount(tx, c.ID)
+		if err != nil {
+			return errors.Wrap(err, "get campaign device count error")
+		}
+		if count.Pending == 0 && count.Enqueued == 0 {
+			return finishCampaign(tx, &c)
+		}
+
+		// wait for the enqueued devices to confirm or to be retried
+		c.NextRunAt = now.Add(time.Second)
+		return storage.UpdateCampaign(tx, &c)
+	}
+
+	if err := handleDevice(tx, c, &d); err != nil {
+		return errors.Wrap(err, "handle campaign device error")
+	}
+
+	return storage.UpdateCampaign(tx, &c)
+}
+
+// handleDevice enqueues the commands of the campaign for the given device.
+// The device is marked as failed after the max. number of attempts.
+func handleDevice(tx sqlx.Ext, c storage.Campaign, d *storage.CampaignDevice) error {
+	logFields := log.Fields{
+		"id":      c.ID,
+		"dev_eui": d.DevEUI,
+	}
+
+	if d.Attempts >= c.MaxAttempts {
+		d.State = storage.CampaignDeviceFailed
+		d.Error = fmt.Sprintf("not confirmed after %d attempts", d.Attempts)
+		log.WithFields(logFields).Warning("campaign: device failed")
+		return storage.UpdateCampaignDevice(tx, d)
+	}
+
+	now := time.Now()
+	d.Attempts++
+	d.EnqueuedAt = &now
+	d.State = storage.CampaignDeviceEnqueued
+	d.Error = ""
+
+	// on error, the frame-counters of the commands that were enqueued are
+	// kept so that these are not enqueued again on the next attempt
+	if err := enqueueCommands(tx, c, d); err != nil {
+		// the device is retried after the retry interval
+		d.Error = err.Error()
+		log.WithFields(logFields).WithError(err).Error("campaign: enqueue commands error")
+	} else {
+		log.WithFields(logFields).WithField("attempt", d.Attempts).Info("campaign: commands enqueued")
+	}
+
+	return storage.UpdateCampaignDevice(tx, d)
+}
+
+// enqueueCommands enqueues the commands of the campaign as confirmed
+// downlinks and returns the frame-counter of the last command.
+// enqueueCommands enqueues the campaign commands for the given device. On a
+// retry, the commands that have been acknowledged or that are still pending
+// in the device-queue are skipped. The frame-counters of d are updated
+// for every enqueued command.
+func enqueueCommands(tx sqlx.Ext, c storage.Campaign, d *storage.CampaignDevice) error {
+	app, err := storage.GetApplication(tx, c.ApplicationID)
+	if err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	queued := make(map[int64]bool)
+	if len(d.FCnts) != 0 {
+		queued, err = getQueuedFCnts(tx, d.DevEUI)
+		if err != nil {
+			return errors.Wrap(err, "get device-queue error")
+		}
+	}
+
+	for i, cmd := range c.Commands {
+		if i < len(d.FCnts) && (d.Acked[i] || queued[d.FCnts[i]]) {
+			continue
+		}
+
+		data := cmd.Data
+
+		if cmd.JSONObject != "" {
+			codecPL := codec.NewPayload(app.PayloadCodec, app.OrganizationID, cmd.FPort, app.PayloadEncoderScript, app.PayloadDecoderScript)
+			if codecPL == nil {
+				return errors.New("no or invalid codec configured for application")
+			}
+
+			if err := json.Unmarshal([]byte(cmd.JSONObject), &codecPL); err != nil {
+				return errors.Wrap(err, "unmarshal json object error")
+			}
+
+			data, err = codecPL.EncodeToBytes()
+			if err != nil {
+				return errors.Wrap(err, "encode payload error")
+			}
+		}
+
+		fCnt, err := downlink.EnqueueDownlinkPayload(tx, d.DevEUI, true, cmd.FPort, data)
+		if err != nil {
+			return errors.Wrap(err, "enqueue downlink payload error")
+		}
+
+		if i < len(d.FCnts) {
+			d.FCnts[i] = int64(fCnt)
+		} else {
+			d.FCnts = append(d.FCnts, int64(fCnt))
+			d.Acked = append(d.Acked, false)
+		}
+	}
+
+	return nil
+}
+
+// getQueuedFCnts returns the frame-counters of the items in the
+// device-queue of the given device.
+func getQueuedFCnts(db sqlx.Queryer, devEUI lorawan.EUI64) (map[int64]bool, error) {
+	n, err := storage.GetNetworkServerForDevEUI(db, devEUI)
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := networkserver.GetPool().Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-server client error")
+	}
+
+	resp, err := nsClient.GetDeviceQueueItemsForDevEUI(context.Background(), &ns.GetDeviceQueueItemsForDevEUIRequest{
+		DevEui: devEUI[:],
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "get device-queue items error")
+	}
+
+	out := make(map[int64]bool)
+	for _, qi := range resp.Items {
+		out[int64(qi.FCnt)] = true
+	}
+
+	return out, nil
+}
+
+func finishCampaign(tx sqlx.Ext, c *storage.Campaign) error {
+	c.State = storage.CampaignDone
+	if err := storage.UpdateCampaign(tx, c); err != nil {
+		return errors.Wrap(err, "update campaign error")
+	}
+
+	log.WithField("id", c.ID).Info("campaign: campaign done")
+
+	return nil
+}
@@ -0,0 +1,205 @@
+// Package chaos implements the chaos (test) mode, injecting failures and
+// latency into the integration publishes and the network-server API calls.
+// This makes it possible to validate the retry, event journal and alerting
+// configuration before relying on it in production.
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/integration"
+)
+
+// ErrInjectedFailure is returned by the integration publishes for which a
+// failure has been injected.
+var ErrInjectedFailure = errors.New("chaos: injected failure")
+
+// fault defines the faults injected into the integration publishes or the
+// network-server API calls.
+type fault struct {
+	failureRate float64
+	latency     time.Duration
+}
+
+var (
+	mux                sync.RWMutex
+	enabled            bool
+	integrationFault   fault
+	networkServerFault fault
+)
+
+// Setup configures the chaos package.
+func Setup(conf config.Config) error {
+	c := conf.Chaos
+
+	if c.Integration.FailureRate < 0 || c.Integration.FailureRate > 1 {
+		return errors.New("integration failure_rate must be between 0 and 1")
+	}
+	if c.NetworkServer.FailureRate < 0 || c.NetworkServer.FailureRate > 1 {
+		return errors.New("network_server failure_rate must be between 0 and 1")
+	}
+
+	mux.Lock()
+	defer mux.Unlock()
+
+	enabled = c.Enabled
+	integrationFault = fault{
+		failureRate: c.Integration.FailureRate,
+		latency:     c.Integration.Latency,
+	}
+	networkServerFault = fault{
+		failureRate: c.NetworkServer.FailureRate,
+		latency:     c.NetworkServer.Latency,
+	}
+
+	if enabled {
+		log.WithFields(log.Fields{
+			"integration_failure_rate":    integrationFault.failureRate,
+			"integration_latency":         integrationFault.latency,
+			"network_server_failure_rate": networkServerFault.failureRate,
+			"network_server_latency":      networkServerFault.latency,
+		}).Warning("chaos: chaos mode enabled, do not use this in production")
+	}
+
+	return nil
+}
+
+// Enabled returns if the chaos mode is enabled.
+func Enabled() bool {
+	mux.RLock()
+	defer mux.RUnlock()
+	return enabled
+}
+
+// getFault returns the fault to inject, and false when the chaos mode is
+// disabled.
+func getFault(f *fault) (fault, bool) {
+	mux.RLock()
+	defer mux.RUnlock()
+	return *f, enabled
+}
+
+// inject sleeps for the latency of the given fault and returns true when a
+// failure must be injected. It returns false when the context is cancelled
+// while sleeping.
+func inject(ctx context.Context, f fault) bool {
+	if f.latency > 0 {
+		select {
+		case <-time.After(f.latency):
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	return f.failureRate > 0 && rand.Float64() < f.failureRate
+}
+
+// UnaryClientInterceptor returns a gRPC client interceptor injecting the
+// network-server faults. Injected failures return an Unavailable error, so
+// that they are handled like a network-server outage.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if f, ok := getFault(&networkServerFault); ok {
+			if inject(ctx, f) {
+				log.WithField("method", method).Warning("chaos: injecting network-server failure")
+				return grpc.Errorf(codes.Unavailable, "chaos: injected failure")
+			}
+			if err := ctx.Err(); err != nil {
+				return grpc.Errorf(codes.DeadlineExceeded, "%s", err)
+			}
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// Integration returns the given integration, injecting the integration
+// faults into its publishes when the chaos mode is enabled.
+func Integration(i integration.Integrator) integration.Integrator {
+	if !Enabled() {
+		return i
+	}
+
+	return &chaosIntegration{Integrator: i}
+}
+
+// chaosIntegration wraps an integration, injecting the integration faults.
+type chaosIntegration struct {
+	integration.Integrator
+}
+
+func (i *chaosIntegration) inject() error {
+	f, ok := getFault(&integrationFault)
+	if !ok || !inject(context.Background(), f) {
+		return nil
+	}
+
+	log.WithField("integration", fmt.Sprintf("%T", i.Integrator)).Warning("chaos: injecting integration failure")
+	return ErrInjectedFailure
+}
+
+// SendDataUp sends a data-up payload.
+func (i *chaosIntegration) SendDataUp(pl integration.DataUpPayload) error {
+	if err := i.inject(); err != nil {
+		return err
+	}
+	return i.Integrator.SendDataUp(pl)
+}
+
+// SendJoinNotification sends a join notification.
+func (i *chaosIntegration) SendJoinNotification(pl integration.JoinNotification) error {
+	if err := i.inject(); err != nil {
+		return err
+	}
+	return i.Integrator.SendJoinNotification(pl)
+}
+
+// SendACKNotification sends an ACK notification.
+func (i *chaosIntegration) SendACKNotification(pl integration.ACKNotification) error {
+	if err := i.inject(); err != nil {
+		return err
+	}
+	return i.Integrator.SendACKNotification(pl)
+}
+
+// SendErrorNotification sends an error notification.
+func (i *chaosIntegration) SendErrorNotification(pl integration.ErrorNotification) error {
+	if err := i.inject(); err != nil {
+		return err
+	}
+	return i.Integrator.SendErrorNotification(pl)
+}
+
+// SendStatusNotification sends a status notification.
+func (i *chaosIntegration) SendStatusNotification(pl integration.StatusNotification) error {
+	if err := i.inject(); err != nil {
+		return err
+	}
+	return i.Integrator.SendStatusNotification(pl)
+}
+
+// SendLocationNotification sends a location notification.
+func (i *chaosIntegration) SendLocationNotification(pl integration.LocationNotification) error {
+	if err := i.inject(); err != nil {
+		return err
+	}
+	return i.Integrator.SendLocationNotification(pl)
+}
+
+// SendHeartbeatNotification sends a heartbeat notification.
+func (i *chaosIntegration) SendHeartbeatNotification(pl integration.HeartbeatNotification) error {
+	if err := i.inject(); err != nil {
+		return err
+	}
+	return i.Integrator.SendHeartbeatNotification(pl)
+}
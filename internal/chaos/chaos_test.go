@@ -0,0 +1,88 @@
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/mock"
+)
+
+func TestChaos(t *testing.T) {
+	assert := require.New(t)
+
+	var conf config.Config
+	defer func() {
+		assert.NoError(Setup(config.Config{}))
+	}()
+
+	t.Run("Invalid failure rate", func(t *testing.T) {
+		assert := require.New(t)
+
+		conf.Chaos.Integration.FailureRate = 1.5
+		assert.Error(Setup(conf))
+		conf.Chaos.Integration.FailureRate = 0
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		assert := require.New(t)
+
+		conf.Chaos.Integration.FailureRate = 1
+		assert.NoError(Setup(conf))
+
+		m := mock.New()
+		assert.Equal(m, Integration(m))
+	})
+
+	t.Run("Integration", func(t *testing.T) {
+		assert := require.New(t)
+
+		conf.Chaos.Enabled = true
+		conf.Chaos.Integration.FailureRate = 1
+		assert.NoError(Setup(conf))
+
+		m := mock.New()
+		i := Integration(m)
+		assert.Equal(ErrInjectedFailure, i.SendDataUp(integration.DataUpPayload{}))
+		assert.Len(m.SendDataUpChan, 0)
+
+		conf.Chaos.Integration.FailureRate = 0
+		conf.Chaos.Integration.Latency = 10 * time.Millisecond
+		assert.NoError(Setup(conf))
+
+		start := time.Now()
+		assert.NoError(i.SendDataUp(integration.DataUpPayload{}))
+		assert.True(time.Since(start) >= 10*time.Millisecond)
+		assert.Len(m.SendDataUpChan, 1)
+	})
+
+	t.Run("Network-server", func(t *testing.T) {
+		assert := require.New(t)
+
+		conf.Chaos.Enabled = true
+		conf.Chaos.NetworkServer.FailureRate = 1
+		assert.NoError(Setup(conf))
+
+		var invoked bool
+		invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			invoked = true
+			return nil
+		}
+
+		err := UnaryClientInterceptor()(context.Background(), "/ns.NetworkServerService/CreateDevice", nil, nil, nil, invoker)
+		assert.Equal(codes.Unavailable, grpc.Code(err))
+		assert.False(invoked)
+
+		conf.Chaos.NetworkServer.FailureRate = 0
+		assert.NoError(Setup(conf))
+
+		assert.NoError(UnaryClientInterceptor()(context.Background(), "/ns.NetworkServerService/CreateDevice", nil, nil, nil, invoker))
+		assert.True(invoked)
+	})
+}
@@ -0,0 +1,174 @@
+// Package clocksync implements the LoRaWAN Application Layer Clock
+// Synchronization package (fPort 202). The AppTimeReq requests of the
+// devices are answered with the correction of the device clock, the last
+// correction is stored per device.
+package clocksync
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/clocksync"
+)
+
+// gpsEpoch defines the GPS epoch.
+var gpsEpoch = time.Date(1980, 1, 6, 0, 0, 0, 0, time.UTC)
+
+// leapSeconds contains the (UTC) dates at which a leap second was added
+// since the GPS epoch.
+var leapSeconds = []time.Time{
+	time.Date(1981, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1982, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1983, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1985, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1988, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1991, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1992, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1993, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1994, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1996, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1997, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2009, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2012, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2015, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+}
+
+var enabled bool
+
+// Setup configures the clocksync package.
+func Setup(conf config.Config) error {
+	enabled = conf.ApplicationServer.ClockSync.Enabled
+	return nil
+}
+
+// Handles returns true when clock synchronization is enabled and the given
+// fPort is the clock synchronization fPort.
+func Handles(fPort uint8) bool {
+	return enabled && fPort == clocksync.DefaultFPort
+}
+
+// HandleUplink handles the given (decrypted) clock synchronization uplink
+// payload of the given device. The reception time of the uplink is taken
+// from the given rx-info, falling back to the current time when none of the
+// gateways provided a timestamp.
+func HandleUplink(db sqlx.Ext, devEUI lorawan.EUI64, rxInfo []*gw.UplinkRXInfo, data []byte) error {
+	var cmds clocksync.Commands
+	if err := cmds.UnmarshalBinary(true, data); err != nil {
+		return errors.Wrap(err, "unmarshal commands error")
+	}
+
+	for _, cmd := range cmds {
+		switch cmd.CID {
+		case clocksync.AppTimeReq:
+			pl, ok := cmd.Payload.(*clocksync.AppTimeReqPayload)
+			if !ok {
+				return fmt.Errorf("expected *clocksync.AppTimeReqPayload, got: %T", cmd.Payload)
+			}
+			if err := handleAppTimeReq(db, devEUI, timeSinceGPSEpoch(rxInfo), pl); err != nil {
+				return errors.Wrap(err, "handle AppTimeReq error")
+			}
+		default:
+			log.WithFields(log.Fields{
+				"dev_eui": devEUI,
+				"cid":     cmd.CID,
+			}).Info("clocksync: ignoring command")
+		}
+	}
+
+	return nil
+}
+
+// handleAppTimeReq stores the time correction of the device and answers the
+// request with an AppTimeAns when the device clock must be corrected or when
+// an answer is required.
+func handleAppTimeReq(db sqlx.Ext, devEUI lorawan.EUI64, timeSinceGPSEpoch time.Duration, pl *clocksync.AppTimeReqPayload) error {
+	// the device time contains the (uint32) seconds since the GPS epoch,
+	// the uint32 arithmetic takes care of the roll-over
+	correction := int32(uint32(timeSinceGPSEpoch/time.Second) - pl.DeviceTime)
+
+	err := storage.SaveDeviceClockSync(db, &storage.DeviceClockSync{
+		DevEUI:         devEUI,
+		TimeCorrection: correction,
+	})
+	if err != nil {
+		return errors.Wrap(err, "save device clock sync error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":         devEUI,
+		"time_correction": correction,
+		"ans_required":    pl.Param.AnsRequired,
+	}).Info("clocksync: AppTimeReq received")
+
+	if correction == 0 && !pl.Param.AnsRequired {
+		return nil
+	}
+
+	ans := clocksync.Command{
+		CID: clocksync.AppTimeAns,
+		Payload: &clocksync.AppTimeAnsPayload{
+			TimeCorrection: correction,
+			Param: clocksync.AppTimeAnsPayloadParam{
+				TokenAns: pl.Param.TokenReq,
+			},
+		},
+	}
+	b, err := ans.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal AppTimeAns error")
+	}
+
+	if _, err := downlink.EnqueueDownlinkPayload(db, devEUI, false, clocksync.DefaultFPort, b); err != nil {
+		return errors.Wrap(err, "enqueue AppTimeAns error")
+	}
+
+	return nil
+}
+
+// timeSinceGPSEpoch returns the reception time of the uplink as time since
+// the GPS epoch. The GPS time of the gateway is preferred over its system
+// time.
+func timeSinceGPSEpoch(rxInfo []*gw.UplinkRXInfo) time.Duration {
+	for _, rx := range rxInfo {
+		if rx.TimeSinceGpsEpoch != nil {
+			if d, err := ptypes.Duration(rx.TimeSinceGpsEpoch); err == nil {
+				return d
+			}
+		}
+	}
+
+	for _, rx := range rxInfo {
+		if rx.Time != nil {
+			if t, err := ptypes.Timestamp(rx.Time); err == nil {
+				return gpsTime(t)
+			}
+		}
+	}
+
+	return gpsTime(time.Now())
+}
+
+// gpsTime returns the given time as time since the GPS epoch.
+func gpsTime(t time.Time) time.Duration {
+	d := t.Sub(gpsEpoch)
+	for _, ls := range leapSeconds {
+		if !t.Before(ls) {
+			d += time.Second
+		}
+	}
+	return d
+}
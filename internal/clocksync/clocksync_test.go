@@ -0,0 +1,61 @@
+package clocksync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/loraserver/api/gw"
+)
+
+func TestGPSTime(t *testing.T) {
+	tests := []struct {
+		Time     time.Time
+		Expected time.Duration
+	}{
+		{
+			Time:     gpsEpoch,
+			Expected: 0,
+		},
+		{
+			Time:     time.Date(1981, 6, 30, 23, 59, 59, 0, time.UTC),
+			Expected: 46828799 * time.Second,
+		},
+		{
+			Time:     time.Date(1981, 7, 1, 0, 0, 0, 0, time.UTC),
+			Expected: 46828801 * time.Second,
+		},
+		{
+			Time:     time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			Expected: 1230336018 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Time.String(), func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.Expected, gpsTime(test.Time))
+		})
+	}
+}
+
+func TestTimeSinceGPSEpoch(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := ptypes.TimestampProto(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(err)
+
+	// the gateway time is converted
+	assert.Equal(1230336018*time.Second, timeSinceGPSEpoch([]*gw.UplinkRXInfo{
+		{},
+		{Time: ts},
+	}))
+
+	// the gateway GPS time is preferred
+	assert.Equal(1230336000*time.Second, timeSinceGPSEpoch([]*gw.UplinkRXInfo{
+		{Time: ts},
+		{Time: ts, TimeSinceGpsEpoch: ptypes.DurationProto(1230336000 * time.Second)},
+	}))
+}
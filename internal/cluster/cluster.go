@@ -0,0 +1,348 @@
+// Package cluster implements the registration of the running
+// application-server instances and the leader election of the background
+// loops, so that multiple instances can be operated as one cluster.
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+const (
+	instancesKey     = "lora:as:cluster:instances"
+	instanceKeyTempl = "lora:as:cluster:instance:%s"
+	leaderKeyTempl   = "lora:as:cluster:leader:%s"
+)
+
+// Leader roles of the background loops. Each role is held by at most one
+// instance of the cluster.
+const (
+	RoleGatewayPing      = "gateway-ping"
+	RoleReprocessUplinks = "reprocess-uplinks"
+	RoleCampaigns        = "campaigns"
+	RoleScheduler        = "scheduler"
+	RoleResponseWindows  = "response-windows"
+	RoleHeartbeats       = "heartbeats"
+	RoleRetention        = "retention"
+)
+
+// Roles contains all the leader roles.
+var Roles = []string{RoleGatewayPing, RoleReprocessUplinks, RoleCampaigns, RoleScheduler, RoleResponseWindows, RoleHeartbeats, RoleRetention}
+
+// Throughput counters.
+const (
+	CounterUplink   = "uplink"
+	CounterJoin     = "join"
+	CounterACK      = "ack"
+	CounterDownlink = "downlink"
+)
+
+// Counters contains all the throughput counters.
+var Counters = []string{CounterUplink, CounterJoin, CounterACK, CounterDownlink}
+
+// electScript acquires or renews the leader key for the given instance.
+// It returns 1 when the instance is the leader.
+var electScript = redis.NewScript(1, `
+	local v = redis.call("GET", KEYS[1])
+	if v == false then
+		redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+		return 1
+	elseif v == ARGV[1] then
+		redis.call("PEXPIRE", KEYS[1], ARGV[2])
+		return 1
+	end
+	return 0
+`)
+
+// Instance contains the status of an application-server instance, as
+// reported by its last heartbeat.
+type Instance struct {
+	ID          string    `json:"id"`
+	Hostname    string    `json:"hostname"`
+	Version     string    `json:"version"`
+	StartedAt   time.Time `json:"startedAt"`
+	HeartbeatAt time.Time `json:"heartbeatAt"`
+
+	// Leader roles held by the instance.
+	Roles []string `json:"roles"`
+
+	// Total counts since the instance was started.
+	Counters map[string]uint64 `json:"counters"`
+
+	// Rates (per second) since the previous heartbeat.
+	Rates map[string]float64 `json:"rates"`
+}
+
+var (
+	mux       sync.RWMutex
+	instance  Instance
+	interval  time.Duration
+	leaderTTL time.Duration
+	leaders   = make(map[string]bool)
+	counters  = make(map[string]uint64)
+	previous  = make(map[string]uint64)
+)
+
+// Setup configures the cluster package and starts the heartbeat loop.
+func Setup(conf config.Config, version string) error {
+	c := conf.ApplicationServer.Cluster
+
+	if c.HeartbeatInterval <= 0 {
+		return errors.New("heartbeat_interval must be > 0")
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return errors.Wrap(err, "get hostname error")
+	}
+
+	id := c.InstanceID
+	if id == "" {
+		u, err := uuid.NewV4()
+		if err != nil {
+			return errors.Wrap(err, "new uuid v4 error")
+		}
+		id = fmt.Sprintf("%s-%s", hostname, u.String()[:8])
+	}
+
+	mux.Lock()
+	instance = Instance{
+		ID:        id,
+		Hostname:  hostname,
+		Version:   version,
+		StartedAt: time.Now(),
+	}
+	interval = c.HeartbeatInterval
+	leaderTTL = 3 * c.HeartbeatInterval
+	mux.Unlock()
+
+	log.WithFields(log.Fields{
+		"instance_id":        id,
+		"heartbeat_interval": interval,
+	}).Info("cluster: registering instance")
+
+	if err := heartbeat(); err != nil {
+		return errors.Wrap(err, "heartbeat error")
+	}
+
+	go heartbeatLoop()
+
+	return nil
+}
+
+// IsLeader returns if the instance is the leader for the given role. When
+// the cluster package has not been set up, the instance is considered to be
+// the leader of all roles.
+func IsLeader(role string) bool {
+	mux.RLock()
+	id := instance.ID
+	isLeader, ok := leaders[role]
+	mux.RUnlock()
+
+	if id == "" {
+		return true
+	}
+
+	if !ok {
+		isLeader = elect(role)
+	}
+
+	return isLeader
+}
+
+// InstanceID returns the ID of the instance. This returns an empty string
+// when the cluster package has not been set up.
+func InstanceID() string {
+	mux.RLock()
+	defer mux.RUnlock()
+	return instance.ID
+}
+
+// Inc increments the given throughput counter.
+func Inc(counter string) {
+	mux.Lock()
+	counters[counter]++
+	mux.Unlock()
+}
+
+// GetInstances returns the instances which have sent a heartbeat within the
+// last three heartbeat intervals, sorted by id.
+func GetInstances() ([]Instance, error) {
+	mux.RLock()
+	ttl := leaderTTL
+	mux.RUnlock()
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	_, err := c.Do("ZREMRANGEBYSCORE", instancesKey, "-inf", time.Now().Add(-ttl).Unix())
+	if err != nil {
+		return nil, errors.Wrap(err, "remove expired instances error")
+	}
+
+	ids, err := redis.Strings(c.Do("ZRANGE", instancesKey, 0, -1))
+	if err != nil {
+		return nil, errors.Wrap(err, "get instance ids error")
+	}
+
+	var out []Instance
+	for _, id := range ids {
+		b, err := redis.Bytes(c.Do("GET", fmt.Sprintf(instanceKeyTempl, id)))
+		if err != nil {
+			if err == redis.ErrNil {
+				continue
+			}
+			return nil, errors.Wrap(err, "get instance error")
+		}
+
+		var i Instance
+		if err := json.Unmarshal(b, &i); err != nil {
+			return nil, errors.Wrap(err, "unmarshal instance error")
+		}
+		out = append(out, i)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ID < out[j].ID
+	})
+
+	return out, nil
+}
+
+// GetLeaders returns the id of the leader instance by role. Roles without
+// leader are omitted.
+func GetLeaders() (map[string]string, error) {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	out := make(map[string]string)
+	for _, role := range Roles {
+		id, err := redis.String(c.Do("GET", fmt.Sprintf(leaderKeyTempl, role)))
+		if err != nil {
+			if err == redis.ErrNil {
+				continue
+			}
+			return nil, errors.Wrap(err, "get leader error")
+		}
+		out[role] = id
+	}
+
+	return out, nil
+}
+
+func heartbeatLoop() {
+	for {
+		mux.RLock()
+		i := interval
+		var roles []string
+		for role := range leaders {
+			roles = append(roles, role)
+		}
+		mux.RUnlock()
+
+		time.Sleep(i)
+
+		for _, role := range roles {
+			elect(role)
+		}
+
+		if err := heartbeat(); err != nil {
+			log.WithError(err).Error("cluster: heartbeat error")
+		}
+	}
+}
+
+// elect acquires or renews the leadership of the given role. On error, the
+// leadership is given up so that an other instance can take over once the
+// leader key has expired.
+func elect(role string) bool {
+	mux.RLock()
+	id := instance.ID
+	ttl := leaderTTL
+	wasLeader := leaders[role]
+	mux.RUnlock()
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	isLeader, err := redis.Bool(electScript.Do(c, fmt.Sprintf(leaderKeyTempl, role), id, int64(ttl/time.Millisecond)))
+	if err != nil {
+		log.WithError(err).WithField("role", role).Error("cluster: leader election error")
+		isLeader = false
+	}
+
+	if isLeader != wasLeader {
+		log.WithFields(log.Fields{
+			"role":   role,
+			"leader": isLeader,
+		}).Info("cluster: leadership changed")
+	}
+
+	mux.Lock()
+	leaders[role] = isLeader
+	mux.Unlock()
+
+	return isLeader
+}
+
+// heartbeat stores the status of the instance. The status expires after
+// three heartbeat intervals.
+func heartbeat() error {
+	now := time.Now()
+
+	mux.Lock()
+	i := instance
+	i.Counters = make(map[string]uint64)
+	i.Rates = make(map[string]float64)
+	elapsed := now.Sub(instance.HeartbeatAt).Seconds()
+	if instance.HeartbeatAt.IsZero() {
+		elapsed = now.Sub(instance.StartedAt).Seconds()
+	}
+	for _, name := range Counters {
+		i.Counters[name] = counters[name]
+		if elapsed > 0 {
+			i.Rates[name] = float64(counters[name]-previous[name]) / elapsed
+		}
+		previous[name] = counters[name]
+	}
+	for role, isLeader := range leaders {
+		if isLeader {
+			i.Roles = append(i.Roles, role)
+		}
+	}
+	i.HeartbeatAt = now
+	instance.HeartbeatAt = now
+	ttl := leaderTTL
+	mux.Unlock()
+
+	sort.Strings(i.Roles)
+
+	b, err := json.Marshal(i)
+	if err != nil {
+		return errors.Wrap(err, "marshal instance error")
+	}
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("PSETEX", fmt.Sprintf(instanceKeyTempl, i.ID), int64(ttl/time.Millisecond), b)
+	c.Send("ZADD", instancesKey, now.Unix(), i.ID)
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "store instance error")
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestCluster(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	assert.NoError(storage.Setup(conf))
+	test.MustFlushRedis(storage.RedisPool())
+
+	t.Run("Not setup", func(t *testing.T) {
+		assert := require.New(t)
+		assert.True(IsLeader(RoleCampaigns))
+	})
+
+	// an other instance holds the gateway ping role
+	c := storage.RedisPool().Get()
+	_, err := c.Do("PSETEX", "lora:as:cluster:leader:"+RoleGatewayPing, 60000, "other-instance")
+	c.Close()
+	assert.NoError(err)
+
+	conf.ApplicationServer.Cluster.InstanceID = "test-instance"
+	conf.ApplicationServer.Cluster.HeartbeatInterval = time.Minute
+	assert.NoError(Setup(conf, "1.2.3"))
+
+	t.Run("IsLeader", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.True(IsLeader(RoleCampaigns))
+		assert.False(IsLeader(RoleGatewayPing))
+
+		leaders, err := GetLeaders()
+		assert.NoError(err)
+		assert.Equal(map[string]string{
+			RoleCampaigns:   "test-instance",
+			RoleGatewayPing: "other-instance",
+		}, leaders)
+	})
+
+	t.Run("GetInstances", func(t *testing.T) {
+		assert := require.New(t)
+
+		Inc(CounterUplink)
+		Inc(CounterUplink)
+		Inc(CounterDownlink)
+		assert.NoError(heartbeat())
+
+		instances, err := GetInstances()
+		assert.NoError(err)
+		assert.Len(instances, 1)
+
+		i := instances[0]
+		assert.Equal("test-instance", i.ID)
+		assert.Equal("1.2.3", i.Version)
+		assert.Equal([]string{RoleCampaigns}, i.Roles)
+		assert.EqualValues(2, i.Counters[CounterUplink])
+		assert.EqualValues(1, i.Counters[CounterDownlink])
+		assert.EqualValues(0, i.Counters[CounterJoin])
+		assert.True(i.Rates[CounterUplink] > 0)
+	})
+}
@@ -72,6 +72,29 @@ func (c CayenneLPP) Object() interface{} {
 	return c
 }
 
+// Location returns the GPS location with the lowest channel number.
+func (c CayenneLPP) Location() (Location, bool) {
+	var loc Location
+	var found bool
+	var channel byte
+
+	for k, v := range c.GPSLocation {
+		if found && k > channel {
+			continue
+		}
+
+		found = true
+		channel = k
+		loc = Location{
+			Latitude:  v.Latitude,
+			Longitude: v.Longitude,
+			Altitude:  v.Altitude,
+		}
+	}
+
+	return loc, found
+}
+
 // DecodeBytes decodes the payload from a slice of bytes.
 func (c *CayenneLPP) DecodeBytes(data []byte) error {
 	var err error
@@ -154,3 +154,53 @@ func TestCayenneLPPDecode(t *testing.T) {
 		}
 	})
 }
+
+func TestCayenneLPPLocation(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name             string
+			Struct           CayenneLPP
+			ExpectedLocation Location
+			ExpectedOK       bool
+		}{
+			{
+				Name: "no gps sensor",
+				Struct: CayenneLPP{
+					Barometer: map[byte]float64{
+						3: 105.5,
+					},
+				},
+			},
+			{
+				Name: "single gps sensor",
+				Struct: CayenneLPP{
+					GPSLocation: map[byte]GPSLocation{
+						1: {Latitude: 42.3519, Longitude: -87.9094, Altitude: 10},
+					},
+				},
+				ExpectedLocation: Location{Latitude: 42.3519, Longitude: -87.9094, Altitude: 10},
+				ExpectedOK:       true,
+			},
+			{
+				Name: "multiple gps sensors",
+				Struct: CayenneLPP{
+					GPSLocation: map[byte]GPSLocation{
+						5: {Latitude: 1, Longitude: 2, Altitude: 3},
+						2: {Latitude: 42.3519, Longitude: -87.9094, Altitude: 10},
+						9: {Latitude: 4, Longitude: 5, Altitude: 6},
+					},
+				},
+				ExpectedLocation: Location{Latitude: 42.3519, Longitude: -87.9094, Altitude: 10},
+				ExpectedOK:       true,
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				loc, ok := test.Struct.Location()
+				So(ok, ShouldEqual, test.ExpectedOK)
+				So(loc, ShouldResemble, test.ExpectedLocation)
+			})
+		}
+	})
+}
@@ -0,0 +1,393 @@
+package codec
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robertkrimen/otto"
+)
+
+// StageType defines the codec chain stage type.
+type StageType string
+
+// Available codec chain stage types.
+const (
+	// JSStageType executes the Process(fPort, data) function of the stage
+	// script. For pre-processors, data is the payload byte array and an
+	// array of bytes must be returned. For post-processors, data is the
+	// decoded object and an object must be returned.
+	JSStageType StageType = "CUSTOM_JS"
+
+	// RenameStageType renames the fields of the decoded object.
+	RenameStageType StageType = "RENAME_FIELDS"
+
+	// ScaleStageType scales the numeric fields of the decoded object
+	// (value * factor + offset), e.g. for unit conversion.
+	ScaleStageType StageType = "SCALE_FIELDS"
+)
+
+// MaxChainStages defines the max. number of pre- and post-processors
+// (each) of a codec chain.
+const MaxChainStages = 10
+
+// Codec chain errors.
+var (
+	ErrChainTooLong        = fmt.Errorf("codec chain must not contain more than %d pre- or post-processors", MaxChainStages)
+	ErrInvalidStageType    = errors.New("invalid codec chain stage type")
+	ErrInvalidPreProcessor = errors.New("codec chain pre-processors must be of type CUSTOM_JS")
+	ErrInvalidStageConfig  = errors.New("codec chain stage must define a script, renames or scales matching its type")
+	ErrInvalidStageField   = errors.New("codec chain stage field must not be empty")
+)
+
+// FieldScale defines the scaling of a single field.
+type FieldScale struct {
+	Field  string  `json:"field"`
+	Factor float64 `json:"factor"`
+	Offset float64 `json:"offset"`
+}
+
+// Stage defines a single codec chain stage. Fields are referenced by their
+// (dot separated) path within the decoded object, e.g. "sensor.temp".
+type Stage struct {
+	Name    string            `json:"name"`
+	Type    StageType         `json:"type"`
+	Script  string            `json:"script,omitempty"`
+	Renames map[string]string `json:"renames,omitempty"`
+	Scales  []FieldScale      `json:"scales,omitempty"`
+}
+
+// Validate validates the stage.
+func (s Stage) Validate() error {
+	switch s.Type {
+	case JSStageType:
+		if strings.TrimSpace(s.Script) == "" {
+			return ErrInvalidStageConfig
+		}
+	case RenameStageType:
+		if len(s.Renames) == 0 {
+			return ErrInvalidStageConfig
+		}
+		for from, to := range s.Renames {
+			if from == "" || to == "" {
+				return ErrInvalidStageField
+			}
+		}
+	case ScaleStageType:
+		if len(s.Scales) == 0 {
+			return ErrInvalidStageConfig
+		}
+		for _, sc := range s.Scales {
+			if sc.Field == "" {
+				return ErrInvalidStageField
+			}
+		}
+	default:
+		return ErrInvalidStageType
+	}
+
+	return nil
+}
+
+// ProcessBytes executes the (pre-processor) stage on the given payload
+// bytes, within the execution pool of the given organization.
+func (s Stage) ProcessBytes(organizationID int64, fPort uint8, data []byte) ([]byte, error) {
+	if s.Type != JSStageType {
+		return nil, ErrInvalidPreProcessor
+	}
+
+	var out []byte
+	err := execute(organizationID, func() error {
+		val, err := runStageScript(s.Script, fPort, data)
+		if err != nil {
+			return err
+		}
+		out, err = interfaceToByteSlice(val)
+		return err
+	})
+	return out, err
+}
+
+// ProcessObject executes the (post-processor) stage on the given decoded
+// object. CUSTOM_JS stages are executed within the execution pool of the
+// given organization.
+func (s Stage) ProcessObject(organizationID int64, fPort uint8, obj map[string]interface{}) (map[string]interface{}, error) {
+	switch s.Type {
+	case JSStageType:
+		var out map[string]interface{}
+		err := execute(organizationID, func() error {
+			val, err := runStageScript(s.Script, fPort, obj)
+			if err != nil {
+				return err
+			}
+			out, err = toMap(val)
+			return err
+		})
+		return out, err
+	case RenameStageType:
+		for from, to := range s.Renames {
+			if v, ok := getField(obj, from); ok {
+				deleteField(obj, from)
+				setField(obj, to, v)
+			}
+		}
+		return obj, nil
+	case ScaleStageType:
+		for _, sc := range s.Scales {
+			v, ok := getField(obj, sc.Field)
+			if !ok {
+				continue
+			}
+			f, ok := toFloat64(v)
+			if !ok {
+				return nil, fmt.Errorf("field %s must be numeric", sc.Field)
+			}
+			setField(obj, sc.Field, f*sc.Factor+sc.Offset)
+		}
+		return obj, nil
+	default:
+		return nil, ErrInvalidStageType
+	}
+}
+
+// Chain defines the stages which are applied around the payload codec of
+// the application when decoding an uplink payload. The pre-processors are
+// applied in order to the payload bytes before decoding, the
+// post-processors in order to the decoded object.
+type Chain struct {
+	PreProcessors  []Stage `json:"preProcessors"`
+	PostProcessors []Stage `json:"postProcessors"`
+}
+
+// IsEmpty returns true when the chain does not contain any stage.
+func (c Chain) IsEmpty() bool {
+	return len(c.PreProcessors) == 0 && len(c.PostProcessors) == 0
+}
+
+// Validate validates the chain.
+func (c Chain) Validate() error {
+	if len(c.PreProcessors) > MaxChainStages || len(c.PostProcessors) > MaxChainStages {
+		return ErrChainTooLong
+	}
+
+	for i, s := range c.PreProcessors {
+		if s.Type != JSStageType {
+			return errors.Wrapf(ErrInvalidPreProcessor, "pre-processor %d", i)
+		}
+		if err := s.Validate(); err != nil {
+			return errors.Wrapf(err, "pre-processor %d", i)
+		}
+	}
+
+	for i, s := range c.PostProcessors {
+		if err := s.Validate(); err != nil {
+			return errors.Wrapf(err, "post-processor %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (c Chain) Value() (driver.Value, error) {
+	if c.IsEmpty() {
+		return nil, nil
+	}
+	return json.Marshal(c)
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *Chain) Scan(src interface{}) error {
+	if src == nil {
+		*c = Chain{}
+		return nil
+	}
+
+	b, ok := src.([]byte)
+	if !ok {
+		return errors.New("[]byte type expected")
+	}
+
+	return json.Unmarshal(b, c)
+}
+
+// ChainPayload wraps a codec payload with the stages of a codec chain.
+type ChainPayload struct {
+	Payload
+
+	chain          Chain
+	organizationID int64
+	fPort          uint8
+	obj            interface{}
+}
+
+// NewChainPayload wraps the given payload with the given chain. When the
+// chain is empty (or the payload is nil), the payload is returned as-is.
+func NewChainPayload(chain Chain, organizationID int64, fPort uint8, pl Payload) Payload {
+	if pl == nil || chain.IsEmpty() {
+		return pl
+	}
+
+	return &ChainPayload{
+		Payload:        pl,
+		chain:          chain,
+		organizationID: organizationID,
+		fPort:          fPort,
+	}
+}
+
+// DecodeBytes applies the pre-processors, decodes the payload using the
+// wrapped codec and applies the post-processors to the decoded object.
+func (c *ChainPayload) DecodeBytes(data []byte) error {
+	var err error
+
+	for i, s := range c.chain.PreProcessors {
+		data, err = s.ProcessBytes(c.organizationID, c.fPort, data)
+		if err != nil {
+			return errors.Wrapf(err, "pre-processor %d (%s)", i, s.Name)
+		}
+	}
+
+	if err := c.Payload.DecodeBytes(data); err != nil {
+		return err
+	}
+
+	c.obj = c.Payload.Object()
+	if len(c.chain.PostProcessors) == 0 {
+		return nil
+	}
+
+	obj, err := toMap(c.obj)
+	if err != nil {
+		return errors.Wrap(err, "post-processor input error")
+	}
+
+	for i, s := range c.chain.PostProcessors {
+		obj, err = s.ProcessObject(c.organizationID, c.fPort, obj)
+		if err != nil {
+			return errors.Wrapf(err, "post-processor %d (%s)", i, s.Name)
+		}
+	}
+	c.obj = obj
+
+	return nil
+}
+
+// Object returns the decoded object, after post-processing.
+func (c ChainPayload) Object() interface{} {
+	return c.obj
+}
+
+// Location returns the location of the wrapped codec, or when the chain
+// has post-processors, the location from the post-processed object.
+func (c ChainPayload) Location() (Location, bool) {
+	if len(c.chain.PostProcessors) == 0 {
+		if locPL, ok := c.Payload.(LocationPayload); ok {
+			return locPL.Location()
+		}
+		return Location{}, false
+	}
+
+	return objectLocation(c.obj)
+}
+
+func runStageScript(script string, fPort uint8, data interface{}) (out interface{}, err error) {
+	defer func() {
+		if caught := recover(); caught != nil {
+			err = fmt.Errorf("%s", caught)
+		}
+	}()
+
+	script = script + "\n\nProcess(fPort, data);\n"
+
+	vm := otto.New()
+	vm.Interrupt = make(chan func(), 1)
+	vm.SetStackDepthLimit(32)
+	vm.Set("data", data)
+	vm.Set("fPort", fPort)
+
+	go func() {
+		time.Sleep(maxExecutionTime)
+		vm.Interrupt <- func() {
+			panic(errors.New("execution timeout"))
+		}
+	}()
+
+	var val otto.Value
+	val, err = vm.Run(script)
+	if err != nil {
+		return nil, errors.Wrap(err, "js vm error")
+	}
+
+	if !val.IsObject() {
+		return nil, errors.New("function must return an object or array")
+	}
+
+	out, err = val.Export()
+	if err != nil {
+		return nil, errors.Wrap(err, "export error")
+	}
+
+	return out, nil
+}
+
+// toMap converts the given decoded object into a generic (JSON) map, so
+// that it can be processed independently of the codec which decoded it.
+func toMap(obj interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(b, &out); err != nil || out == nil {
+		return nil, errors.New("value must be an object")
+	}
+
+	return out, nil
+}
+
+func getField(obj map[string]interface{}, path string) (interface{}, bool) {
+	parts := strings.Split(path, ".")
+	for i, p := range parts {
+		v, ok := obj[p]
+		if !ok {
+			return nil, false
+		}
+		if i == len(parts)-1 {
+			return v, true
+		}
+		if obj, ok = v.(map[string]interface{}); !ok {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+func setField(obj map[string]interface{}, path string, value interface{}) {
+	parts := strings.Split(path, ".")
+	for _, p := range parts[:len(parts)-1] {
+		next, ok := obj[p].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			obj[p] = next
+		}
+		obj = next
+	}
+	obj[parts[len(parts)-1]] = value
+}
+
+func deleteField(obj map[string]interface{}, path string) {
+	parts := strings.Split(path, ".")
+	for _, p := range parts[:len(parts)-1] {
+		next, ok := obj[p].(map[string]interface{})
+		if !ok {
+			return
+		}
+		obj = next
+	}
+	delete(obj, parts[len(parts)-1])
+}
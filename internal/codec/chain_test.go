@@ -0,0 +1,189 @@
+package codec
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestChainValidate(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		jsStage := Stage{Type: JSStageType, Script: "function Process(fPort, data) { return data; }"}
+
+		tests := []struct {
+			Name          string
+			Chain         Chain
+			ExpectedError error
+		}{
+			{
+				Name: "valid chain",
+				Chain: Chain{
+					PreProcessors: []Stage{jsStage},
+					PostProcessors: []Stage{
+						jsStage,
+						{Type: RenameStageType, Renames: map[string]string{"t": "temperature"}},
+						{Type: ScaleStageType, Scales: []FieldScale{{Field: "temperature", Factor: 0.1}}},
+					},
+				},
+			},
+			{
+				Name:          "rename pre-processor",
+				Chain:         Chain{PreProcessors: []Stage{{Type: RenameStageType, Renames: map[string]string{"a": "b"}}}},
+				ExpectedError: ErrInvalidPreProcessor,
+			},
+			{
+				Name:          "js stage without script",
+				Chain:         Chain{PostProcessors: []Stage{{Type: JSStageType}}},
+				ExpectedError: ErrInvalidStageConfig,
+			},
+			{
+				Name:          "scale stage without field",
+				Chain:         Chain{PostProcessors: []Stage{{Type: ScaleStageType, Scales: []FieldScale{{Factor: 2}}}}},
+				ExpectedError: ErrInvalidStageField,
+			},
+			{
+				Name:          "invalid stage type",
+				Chain:         Chain{PostProcessors: []Stage{{Type: "FOO"}}},
+				ExpectedError: ErrInvalidStageType,
+			},
+			{
+				Name:          "too many stages",
+				Chain:         Chain{PostProcessors: make([]Stage, MaxChainStages+1)},
+				ExpectedError: ErrChainTooLong,
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				So(errors.Cause(test.Chain.Validate()), ShouldEqual, test.ExpectedError)
+			})
+		}
+	})
+}
+
+func TestStageProcessObject(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name           string
+			Stage          Stage
+			Object         map[string]interface{}
+			ExpectedObject map[string]interface{}
+			ExpectedError  error
+		}{
+			{
+				Name: "js stage",
+				Stage: Stage{
+					Type: JSStageType,
+					Script: `
+						function Process(fPort, data) {
+							return {"port": fPort, "value": data.raw * 2};
+						}
+					`,
+				},
+				Object:         map[string]interface{}{"raw": 21},
+				ExpectedObject: map[string]interface{}{"port": float64(10), "value": float64(42)},
+			},
+			{
+				Name:          "js stage not returning an object",
+				Stage:         Stage{Type: JSStageType, Script: "function Process(fPort, data) { return 1; }"},
+				Object:        map[string]interface{}{},
+				ExpectedError: errors.New("function must return an object or array"),
+			},
+			{
+				Name:           "rename nested fields",
+				Stage:          Stage{Type: RenameStageType, Renames: map[string]string{"s.t": "temperature", "missing": "other"}},
+				Object:         map[string]interface{}{"s": map[string]interface{}{"t": 21.5, "h": 60.0}},
+				ExpectedObject: map[string]interface{}{"s": map[string]interface{}{"h": 60.0}, "temperature": 21.5},
+			},
+			{
+				Name:           "scale fields",
+				Stage:          Stage{Type: ScaleStageType, Scales: []FieldScale{{Field: "temperature", Factor: 1.8, Offset: 32}}},
+				Object:         map[string]interface{}{"temperature": 100.0},
+				ExpectedObject: map[string]interface{}{"temperature": 212.0},
+			},
+			{
+				Name:          "scale non-numeric field",
+				Stage:         Stage{Type: ScaleStageType, Scales: []FieldScale{{Field: "temperature", Factor: 2}}},
+				Object:        map[string]interface{}{"temperature": "hot"},
+				ExpectedError: errors.New("field temperature must be numeric"),
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				obj, err := test.Stage.ProcessObject(0, 10, test.Object)
+				if test.ExpectedError != nil {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, test.ExpectedError.Error())
+					return
+				}
+
+				So(err, ShouldBeNil)
+				So(obj, ShouldResemble, test.ExpectedObject)
+			})
+		}
+	})
+}
+
+func TestChainPayload(t *testing.T) {
+	Convey("Given a custom JS codec wrapped by a chain", t, func() {
+		js := NewCustomJS(1, "", `
+			function Decode(fPort, bytes) {
+				return {"t": bytes[0], "latitude": bytes[1], "longitude": bytes[2]};
+			}
+		`)
+
+		chain := Chain{
+			PreProcessors: []Stage{
+				{
+					Name:   "strip header",
+					Type:   JSStageType,
+					Script: "function Process(fPort, data) { return data.slice(1); }",
+				},
+			},
+			PostProcessors: []Stage{
+				{Name: "rename", Type: RenameStageType, Renames: map[string]string{"t": "temperature"}},
+				{Name: "scale", Type: ScaleStageType, Scales: []FieldScale{{Field: "temperature", Factor: 0.5}}},
+			},
+		}
+
+		pl := NewChainPayload(chain, 0, 1, js)
+
+		Convey("Then DecodeBytes applies all stages in order", func() {
+			So(pl.DecodeBytes([]byte{0xff, 42, 5, 6}), ShouldBeNil)
+			So(pl.Object(), ShouldResemble, map[string]interface{}{
+				"temperature": float64(21),
+				"latitude":    float64(5),
+				"longitude":   float64(6),
+			})
+
+			loc, ok := pl.(LocationPayload).Location()
+			So(ok, ShouldBeTrue)
+			So(loc, ShouldResemble, Location{Latitude: 5, Longitude: 6})
+		})
+
+		Convey("Then a failing stage returns an error including the stage", func() {
+			chain.PostProcessors[1].Scales[0].Field = "latitude"
+			chain.PostProcessors = append(chain.PostProcessors, Stage{
+				Name:   "fail",
+				Type:   JSStageType,
+				Script: "function Process(fPort, data) { return 1; }",
+			})
+			pl := NewChainPayload(chain, 0, 1, js)
+
+			err := pl.DecodeBytes([]byte{0xff, 42, 5, 6})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "post-processor 2 (fail): function must return an object or array")
+		})
+	})
+
+	Convey("Given an empty chain", t, func() {
+		js := NewCustomJS(1, "", "")
+
+		Convey("Then NewChainPayload returns the codec as-is", func() {
+			So(NewChainPayload(Chain{}, 0, 1, js), ShouldEqual, js)
+		})
+	})
+}
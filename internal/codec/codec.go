@@ -16,14 +16,30 @@ type Payload interface {
 	Object() interface{}
 }
 
+// Location defines a location decoded from the payload.
+type Location struct {
+	Latitude  float64
+	Longitude float64
+	Altitude  float64
+}
+
+// LocationPayload is implemented by payloads which are able to return the
+// decoded location of the device (e.g. from a GPS sensor).
+type LocationPayload interface {
+	Location() (Location, bool)
+}
+
 // NewPayload returns a new codec payload. In case of an unknown Type, nil is
-// returned.
-func NewPayload(t Type, fPort uint8, encodeScript, decodeScript string) Payload {
+// returned. Custom JS codecs are executed within the execution pool of the
+// given organization.
+func NewPayload(t Type, organizationID int64, fPort uint8, encodeScript, decodeScript string) Payload {
 	switch t {
 	case CayenneLPPType:
 		return &CayenneLPP{}
 	case CustomJSType:
-		return NewCustomJS(fPort, encodeScript, decodeScript)
+		js := NewCustomJS(fPort, encodeScript, decodeScript)
+		js.organizationID = organizationID
+		return js
 	default:
 		return nil
 	}
@@ -20,17 +20,23 @@ var (
 	maxExecutionTime = 10 * time.Millisecond
 )
 
+// Setup configures the codec package.
 func Setup(conf config.Config) error {
 	maxExecutionTime = conf.ApplicationServer.Codec.JS.MaxExecutionTime
+	maxConcurrency = conf.ApplicationServer.Codec.JS.MaxConcurrencyPerOrganization
+	maxQueueTime = conf.ApplicationServer.Codec.JS.MaxQueueTime
+	resetPools()
 	return nil
 }
 
-// CustomJS is a scriptable JS codec.
+// CustomJS is a scriptable JS codec. The scripts are executed within the
+// execution pool of the organization.
 type CustomJS struct {
-	fPort        uint8
-	encodeScript string
-	decodeScript string
-	Data         interface{}
+	organizationID int64
+	fPort          uint8
+	encodeScript   string
+	decodeScript   string
+	Data           interface{}
 }
 
 // NewCustomJS creates a new custom JS codec.
@@ -47,6 +53,39 @@ func (c CustomJS) Object() interface{} {
 	return c.Data
 }
 
+// Location returns the location when the decoded object contains a
+// numeric latitude and longitude (and optionally altitude) field.
+func (c CustomJS) Location() (Location, bool) {
+	return objectLocation(c.Data)
+}
+
+// objectLocation returns the location when the given decoded object
+// contains a numeric latitude and longitude (and optionally altitude) field.
+func objectLocation(data interface{}) (Location, bool) {
+	var loc Location
+
+	obj, ok := data.(map[string]interface{})
+	if !ok {
+		return loc, false
+	}
+
+	lat, ok := toFloat64(obj["latitude"])
+	if !ok {
+		return loc, false
+	}
+	lon, ok := toFloat64(obj["longitude"])
+	if !ok {
+		return loc, false
+	}
+	alt, _ := toFloat64(obj["altitude"])
+
+	loc.Latitude = lat
+	loc.Longitude = lon
+	loc.Altitude = alt
+
+	return loc, true
+}
+
 // MarshalJSON implements json.Marshaler.
 func (c CustomJS) MarshalJSON() ([]byte, error) {
 	return json.Marshal(c.Data)
@@ -58,7 +97,13 @@ func (c *CustomJS) UnmarshalJSON(text []byte) error {
 }
 
 // DecodeBytes decodes the payload from a slice of bytes.
-func (c *CustomJS) DecodeBytes(data []byte) (err error) {
+func (c *CustomJS) DecodeBytes(data []byte) error {
+	return execute(c.organizationID, func() error {
+		return c.decodeBytes(data)
+	})
+}
+
+func (c *CustomJS) decodeBytes(data []byte) (err error) {
 	defer func() {
 		if caught := recover(); caught != nil {
 			err = fmt.Errorf("%s", caught)
@@ -99,7 +144,17 @@ func (c *CustomJS) DecodeBytes(data []byte) (err error) {
 }
 
 // EncodeToBytes encodes the payload to a slice of bytes.
-func (c CustomJS) EncodeToBytes() (b []byte, err error) {
+func (c CustomJS) EncodeToBytes() ([]byte, error) {
+	var b []byte
+	err := execute(c.organizationID, func() error {
+		var err error
+		b, err = c.encodeToBytes()
+		return err
+	})
+	return b, err
+}
+
+func (c CustomJS) encodeToBytes() (b []byte, err error) {
 	defer func() {
 		if caught := recover(); caught != nil {
 			err = fmt.Errorf("%s", caught)
@@ -139,6 +194,23 @@ func (c CustomJS) EncodeToBytes() (b []byte, err error) {
 	return interfaceToByteSlice(out)
 }
 
+func toFloat64(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
 func interfaceToByteSlice(obj interface{}) ([]byte, error) {
 	if obj == nil {
 		return nil, errors.New("value must not be nil")
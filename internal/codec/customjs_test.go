@@ -80,6 +80,77 @@ func TestCustomJSDecode(t *testing.T) {
 	})
 }
 
+func TestCustomJSLocation(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name             string
+			Script           string
+			ExpectedLocation Location
+			ExpectedOK       bool
+		}{
+			{
+				Name: "no location",
+				Script: `
+					function Decode(port, bytes) {
+						return {
+							"temperature": 21.5
+						};
+					}
+				`,
+			},
+			{
+				Name: "latitude and longitude",
+				Script: `
+					function Decode(port, bytes) {
+						return {
+							"latitude": 52.3676,
+							"longitude": 4.9041
+						};
+					}
+				`,
+				ExpectedLocation: Location{Latitude: 52.3676, Longitude: 4.9041},
+				ExpectedOK:       true,
+			},
+			{
+				Name: "latitude, longitude and altitude",
+				Script: `
+					function Decode(port, bytes) {
+						return {
+							"latitude": 52.3676,
+							"longitude": 4.9041,
+							"altitude": 12
+						};
+					}
+				`,
+				ExpectedLocation: Location{Latitude: 52.3676, Longitude: 4.9041, Altitude: 12},
+				ExpectedOK:       true,
+			},
+			{
+				Name: "non-numeric latitude",
+				Script: `
+					function Decode(port, bytes) {
+						return {
+							"latitude": "52.3676",
+							"longitude": 4.9041
+						};
+					}
+				`,
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				js := NewCustomJS(1, "", test.Script)
+				So(js.DecodeBytes([]byte{1}), ShouldBeNil)
+
+				loc, ok := js.Location()
+				So(ok, ShouldEqual, test.ExpectedOK)
+				So(loc, ShouldResemble, test.ExpectedLocation)
+			})
+		}
+	})
+}
+
 func TestCustomEncodeJS(t *testing.T) {
 	Convey("Given a set of tests", t, func() {
 		tests := []struct {
@@ -0,0 +1,112 @@
+package codec
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrConcurrencyLimit is returned when the codec execution slots of the
+// organization stay occupied for longer than the max queue time.
+var ErrConcurrencyLimit = errors.New("codec concurrency limit of organization reached")
+
+var (
+	maxConcurrency = 4
+	maxQueueTime   = time.Second
+
+	poolsMux sync.Mutex
+	pools    = make(map[int64]*pool)
+)
+
+// Stats contains the codec execution statistics of an organization.
+type Stats struct {
+	Executions uint64
+	Errors     uint64
+	Rejected   uint64
+
+	// WallTime contains the total wall-clock time of the executions. This is
+	// not the CPU time, as it includes the time an execution is waiting to
+	// be scheduled (e.g. when the host is busy).
+	WallTime time.Duration
+}
+
+// pool limits the concurrent codec executions of a single organization and
+// accounts the (wall-clock) time spent executing these.
+type pool struct {
+	sem chan struct{}
+
+	mux   sync.Mutex
+	stats Stats
+}
+
+func getPool(organizationID int64) *pool {
+	poolsMux.Lock()
+	defer poolsMux.Unlock()
+
+	p, ok := pools[organizationID]
+	if !ok {
+		p = &pool{}
+		if maxConcurrency > 0 {
+			p.sem = make(chan struct{}, maxConcurrency)
+		}
+		pools[organizationID] = p
+	}
+	return p
+}
+
+// execute executes fn within the pool of the given organization. The
+// error returned by fn is returned as-is.
+func execute(organizationID int64, fn func() error) error {
+	p := getPool(organizationID)
+
+	if p.sem != nil {
+		timer := time.NewTimer(maxQueueTime)
+		select {
+		case p.sem <- struct{}{}:
+			timer.Stop()
+			defer func() { <-p.sem }()
+		case <-timer.C:
+			p.mux.Lock()
+			p.stats.Rejected++
+			p.mux.Unlock()
+			return ErrConcurrencyLimit
+		}
+	}
+
+	start := time.Now()
+	err := fn()
+	duration := time.Since(start)
+
+	p.mux.Lock()
+	p.stats.Executions++
+	p.stats.WallTime += duration
+	if err != nil {
+		p.stats.Errors++
+	}
+	p.mux.Unlock()
+
+	return err
+}
+
+// GetStats returns the codec execution statistics per organization id.
+func GetStats() map[int64]Stats {
+	poolsMux.Lock()
+	defer poolsMux.Unlock()
+
+	out := make(map[int64]Stats, len(pools))
+	for id, p := range pools {
+		p.mux.Lock()
+		out[id] = p.stats
+		p.mux.Unlock()
+	}
+	return out
+}
+
+// resetPools removes all the organization pools, e.g. after changing the
+// max concurrency.
+func resetPools() {
+	poolsMux.Lock()
+	pools = make(map[int64]*pool)
+	poolsMux.Unlock()
+}
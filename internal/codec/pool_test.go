@@ -0,0 +1,61 @@
+package codec
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExecute(t *testing.T) {
+	Convey("Given a max concurrency of 1 and a max queue time of 10ms", t, func() {
+		maxConcurrency = 1
+		maxQueueTime = 10 * time.Millisecond
+		resetPools()
+
+		Reset(func() {
+			maxConcurrency = 4
+			maxQueueTime = time.Second
+			resetPools()
+		})
+
+		Convey("Then the execution time and errors are accounted per organization", func() {
+			So(execute(1, func() error {
+				time.Sleep(time.Millisecond)
+				return nil
+			}), ShouldBeNil)
+			So(execute(1, func() error { return errors.New("boom") }), ShouldResemble, errors.New("boom"))
+			So(execute(2, func() error { return nil }), ShouldBeNil)
+
+			stats := GetStats()
+			So(stats[1].Executions, ShouldEqual, 2)
+			So(stats[1].Errors, ShouldEqual, 1)
+			So(stats[1].WallTime, ShouldBeGreaterThanOrEqualTo, time.Millisecond)
+			So(stats[2].Executions, ShouldEqual, 1)
+			So(stats[2].Errors, ShouldEqual, 0)
+		})
+
+		Convey("When an execution of organization 1 is running", func() {
+			running := make(chan struct{})
+			done := make(chan struct{})
+			go execute(1, func() error {
+				close(running)
+				<-done
+				return nil
+			})
+			<-running
+
+			Convey("Then an other execution of organization 1 is rejected", func() {
+				So(execute(1, func() error { return nil }), ShouldEqual, ErrConcurrencyLimit)
+				So(GetStats()[1].Rejected, ShouldEqual, 1)
+				close(done)
+			})
+
+			Convey("Then an execution of organization 2 is not affected", func() {
+				So(execute(2, func() error { return nil }), ShouldBeNil)
+				close(done)
+			})
+		})
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/brocaar/lora-app-server/internal/integration/awssns"
 	"github.com/brocaar/lora-app-server/internal/integration/azureservicebus"
 	"github.com/brocaar/lora-app-server/internal/integration/gcppubsub"
+	"github.com/brocaar/lora-app-server/internal/integration/modbus"
 	"github.com/brocaar/lora-app-server/internal/integration/mqtt"
 )
 
@@ -17,8 +18,10 @@ type Config struct {
 	}
 
 	PostgreSQL struct {
-		DSN         string `mapstructure:"dsn"`
-		Automigrate bool
+		DSN                string `mapstructure:"dsn"`
+		Automigrate        bool
+		SlowQueryThreshold time.Duration     `mapstructure:"slow_query_threshold"`
+		StorageClusters    map[string]string `mapstructure:"storage_clusters"`
 	} `mapstructure:"postgresql"`
 
 	Redis struct {
@@ -27,12 +30,25 @@ type Config struct {
 		IdleTimeout time.Duration `mapstructure:"idle_timeout"`
 	}
 
+	NetworkServer struct {
+		Timeout       time.Duration `mapstructure:"timeout"`
+		RetryCount    int           `mapstructure:"retry_count"`
+		RetryInterval time.Duration `mapstructure:"retry_interval"`
+
+		CircuitBreaker struct {
+			FailureThreshold int           `mapstructure:"failure_threshold"`
+			OpenTimeout      time.Duration `mapstructure:"open_timeout"`
+		} `mapstructure:"circuit_breaker"`
+	} `mapstructure:"network_server"`
+
 	ApplicationServer struct {
 		ID string `mapstructure:"id"`
 
 		Codec struct {
 			JS struct {
-				MaxExecutionTime time.Duration `mapstructure:"max_execution_time"`
+				MaxExecutionTime              time.Duration `mapstructure:"max_execution_time"`
+				MaxConcurrencyPerOrganization int           `mapstructure:"max_concurrency_per_organization"`
+				MaxQueueTime                  time.Duration `mapstructure:"max_queue_time"`
 			} `mapstructure:"js"`
 		} `mapstructure:"codec"`
 
@@ -43,14 +59,63 @@ type Config struct {
 			AzureServiceBus azureservicebus.Config `mapstructure:"azure_service_bus"`
 			MQTT            mqtt.Config            `mapstructure:"mqtt"`
 			GCPPubSub       gcppubsub.Config       `mapstructure:"gcp_pub_sub"`
+			Modbus          modbus.Config          `mapstructure:"modbus"`
+			HTTP            struct {
+				CallbackBaseURL string        `mapstructure:"callback_base_url"`
+				CallbackTTL     time.Duration `mapstructure:"callback_ttl"`
+			} `mapstructure:"http"`
+			Journal struct {
+				Enabled          bool          `mapstructure:"enabled"`
+				DispatchInterval time.Duration `mapstructure:"dispatch_interval"`
+				BatchSize        int           `mapstructure:"batch_size"`
+				MaxAttempts      int           `mapstructure:"max_attempts"`
+				Retention        time.Duration `mapstructure:"retention"`
+			} `mapstructure:"journal"`
 		}
 
+		Cluster struct {
+			InstanceID        string        `mapstructure:"instance_id"`
+			HeartbeatInterval time.Duration `mapstructure:"heartbeat_interval"`
+		} `mapstructure:"cluster"`
+
+		EventBus struct {
+			Backend   string `mapstructure:"backend"`
+			QueueSize int    `mapstructure:"queue_size"`
+			MaxLen    int    `mapstructure:"max_len"`
+		} `mapstructure:"event_bus"`
+
+		DeviceLinkStats struct {
+			UnhealthyPacketLoss float64 `mapstructure:"unhealthy_packet_loss"`
+		} `mapstructure:"device_link_stats"`
+
+		Archive struct {
+			Endpoint        string        `mapstructure:"endpoint"`
+			Region          string        `mapstructure:"region"`
+			AccessKeyID     string        `mapstructure:"access_key_id"`
+			SecretAccessKey string        `mapstructure:"secret_access_key"`
+			Bucket          string        `mapstructure:"bucket"`
+			Prefix          string        `mapstructure:"prefix"`
+			ForcePathStyle  bool          `mapstructure:"force_path_style"`
+			Timeout         time.Duration `mapstructure:"timeout"`
+			QueueSize       int           `mapstructure:"queue_size"`
+		} `mapstructure:"archive"`
+
+		Retention struct {
+			DeviceMeasurements time.Duration `mapstructure:"device_measurements"`
+			DeviceLocations    time.Duration `mapstructure:"device_locations"`
+		} `mapstructure:"retention"`
+
+		ClockSync struct {
+			Enabled bool `mapstructure:"enabled"`
+		} `mapstructure:"clock_sync"`
+
 		API struct {
-			Bind       string
-			CACert     string `mapstructure:"ca_cert"`
-			TLSCert    string `mapstructure:"tls_cert"`
-			TLSKey     string `mapstructure:"tls_key"`
-			PublicHost string `mapstructure:"public_host"`
+			Bind              string
+			CACert            string `mapstructure:"ca_cert"`
+			TLSCert           string `mapstructure:"tls_cert"`
+			TLSKey            string `mapstructure:"tls_key"`
+			PublicHost        string `mapstructure:"public_host"`
+			ClientCertPinning bool   `mapstructure:"client_cert_pinning"`
 		} `mapstructure:"api"`
 
 		ExternalAPI struct {
@@ -60,12 +125,29 @@ type Config struct {
 			JWTSecret                  string `mapstructure:"jwt_secret"`
 			DisableAssignExistingUsers bool   `mapstructure:"disable_assign_existing_users"`
 			CORSAllowOrigin            string `mapstructure:"cors_allow_origin"`
+			EnableDiagnostics          bool   `mapstructure:"enable_diagnostics"`
+			EnableGrafana              bool   `mapstructure:"enable_grafana"`
+			EnforceTerms               bool   `mapstructure:"enforce_terms"`
+			SCIMBearerToken            string `mapstructure:"scim_bearer_token"`
+			GatewayLogBearerToken      string `mapstructure:"gateway_log_bearer_token"`
+			EmbedURLTemplate           string `mapstructure:"embed_url_template"`
+			ClientAuthMode             string `mapstructure:"client_auth_mode"`
+			ClientCACert               string `mapstructure:"client_ca_cert"`
+			ClientCertUsers            []struct {
+				CommonName string `mapstructure:"common_name"`
+				Username   string
+			} `mapstructure:"client_cert_users"`
+			Webhooks []struct {
+				ApplicationID int64  `mapstructure:"application_id"`
+				BearerToken   string `mapstructure:"bearer_token"`
+			} `mapstructure:"webhooks"`
 		} `mapstructure:"external_api"`
 
 		Branding struct {
 			Header       string
 			Footer       string
 			Registration string
+			LoginBanner  string `mapstructure:"login_banner"`
 		}
 	} `mapstructure:"application_server"`
 
@@ -84,6 +166,20 @@ type Config struct {
 			}
 		} `mapstructure:"kek"`
 	} `mapstructure:"join_server"`
+
+	Chaos struct {
+		Enabled bool `mapstructure:"enabled"`
+
+		Integration struct {
+			FailureRate float64       `mapstructure:"failure_rate"`
+			Latency     time.Duration `mapstructure:"latency"`
+		} `mapstructure:"integration"`
+
+		NetworkServer struct {
+			FailureRate float64       `mapstructure:"failure_rate"`
+			Latency     time.Duration `mapstructure:"latency"`
+		} `mapstructure:"network_server"`
+	} `mapstructure:"chaos"`
 }
 
 // C holds the global configuration.
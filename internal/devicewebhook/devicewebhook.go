@@ -0,0 +1,210 @@
+// Package devicewebhook implements the calling of the organization-level
+// device webhooks, so that external inventory systems (e.g. asset-management
+// or ERP systems) can be kept in sync with the devices.
+package devicewebhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/eventbus"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// SignatureHeader contains the hex encoded HMAC-SHA256 signature of the
+// request body, using the secret of the webhook as key.
+const SignatureHeader = "X-LoRa-Signature"
+
+// retryDelays defines the delays between the attempts to call a webhook.
+var retryDelays = []time.Duration{0, 5 * time.Second, 30 * time.Second}
+
+var client = &http.Client{
+	Timeout: 10 * time.Second,
+}
+
+// Payload defines the payload sent to the device webhooks.
+type Payload struct {
+	Event           storage.DeviceWebhookEvent `json:"event"`
+	Time            time.Time                  `json:"time"`
+	OrganizationID  int64                      `json:"organizationID"`
+	ApplicationID   int64                      `json:"applicationID"`
+	ApplicationName string                     `json:"applicationName"`
+	Device          Device                     `json:"device"`
+}
+
+// Device contains the metadata of the device.
+type Device struct {
+	DevEUI              lorawan.EUI64                `json:"devEUI"`
+	Name                string                       `json:"name"`
+	Description         string                       `json:"description"`
+	DeviceProfileID     string                       `json:"deviceProfileID"`
+	LifecycleState      storage.DeviceLifecycleState `json:"lifecycleState"`
+	CreatedAt           time.Time                    `json:"createdAt"`
+	LastSeenAt          *time.Time                   `json:"lastSeenAt"`
+	Battery             *float32                     `json:"battery"`
+	ExternalPowerSource bool                         `json:"externalPowerSource"`
+	Latitude            *float64                     `json:"latitude"`
+	Longitude           *float64                     `json:"longitude"`
+	Altitude            *float64                     `json:"altitude"`
+}
+
+// subscriptionName defines the name of the event bus subscription.
+const subscriptionName = "device-webhooks"
+
+// eventTypes maps the event bus lifecycle events to the webhook events.
+var eventTypes = map[eventbus.Type]storage.DeviceWebhookEvent{
+	eventbus.DeviceCreated:        storage.DeviceWebhookCreated,
+	eventbus.DeviceActivated:      storage.DeviceWebhookActivated,
+	eventbus.DeviceDecommissioned: storage.DeviceWebhookDecommissioned,
+}
+
+// Setup subscribes the device webhooks to the device lifecycle events of
+// the event bus. The webhooks are called asynchronously and are retried on
+// failure.
+func Setup() error {
+	var types []eventbus.Type
+	for t := range eventTypes {
+		types = append(types, t)
+	}
+
+	if err := eventbus.Subscribe(eventbus.Subscription{
+		Name:    subscriptionName,
+		Types:   types,
+		Handler: handleEvent,
+	}); err != nil {
+		return errors.Wrap(err, "subscribe error")
+	}
+
+	return nil
+}
+
+func handleEvent(e eventbus.Event) error {
+	event, ok := eventTypes[e.Type]
+	if !ok {
+		return nil
+	}
+
+	var d storage.Device
+	if err := e.Decode(&d); err != nil {
+		return errors.Wrap(err, "decode device error")
+	}
+
+	return notify(event, d)
+}
+
+func notify(event storage.DeviceWebhookEvent, d storage.Device) error {
+	app, err := storage.GetApplication(storage.DB(), d.ApplicationID)
+	if err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	webhooks, err := storage.GetDeviceWebhooksForEvent(storage.DB(), app.OrganizationID, event)
+	if err != nil {
+		return errors.Wrap(err, "get device webhooks error")
+	}
+
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	b, err := json.Marshal(newPayload(event, app, d))
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	for _, w := range webhooks {
+		go sendWithRetry(w, d.DevEUI, b)
+	}
+
+	return nil
+}
+
+func newPayload(event storage.DeviceWebhookEvent, app storage.Application, d storage.Device) Payload {
+	return Payload{
+		Event:           event,
+		Time:            time.Now(),
+		OrganizationID:  app.OrganizationID,
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		Device: Device{
+			DevEUI:              d.DevEUI,
+			Name:                d.Name,
+			Description:         d.Description,
+			DeviceProfileID:     d.DeviceProfileID.String(),
+			LifecycleState:      d.LifecycleState,
+			CreatedAt:           d.CreatedAt,
+			LastSeenAt:          d.LastSeenAt,
+			Battery:             d.DeviceStatusBattery,
+			ExternalPowerSource: d.DeviceStatusExternalPower,
+			Latitude:            d.Latitude,
+			Longitude:           d.Longitude,
+			Altitude:            d.Altitude,
+		},
+	}
+}
+
+func sendWithRetry(w storage.DeviceWebhook, devEUI lorawan.EUI64, body []byte) {
+	var err error
+
+	for i, delay := range retryDelays {
+		time.Sleep(delay)
+
+		if err = send(w, body); err == nil {
+			return
+		}
+
+		log.WithFields(log.Fields{
+			"webhook_id": w.ID,
+			"dev_eui":    devEUI,
+			"attempt":    i + 1,
+		}).WithError(err).Warning("devicewebhook: call webhook error")
+	}
+
+	log.WithFields(log.Fields{
+		"webhook_id": w.ID,
+		"dev_eui":    devEUI,
+	}).WithError(err).Error("devicewebhook: giving up calling webhook")
+}
+
+func send(w storage.DeviceWebhook, body []byte) error {
+	req, err := http.NewRequest("POST", w.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "new request error")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.Headers.Map {
+		if v.Valid {
+			req.Header.Set(k, v.String)
+		}
+	}
+
+	if w.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(w.Secret))
+		mac.Write(body)
+		req.Header.Set(SignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http request error")
+	}
+	defer resp.Body.Close()
+
+	// check that response is in 200 range
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("expected 2XX response, got: %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package devicewebhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lib/pq/hstore"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+type request struct {
+	header http.Header
+	body   []byte
+}
+
+func TestSend(t *testing.T) {
+	assert := require.New(t)
+
+	requests := make(chan request, 1)
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		requests <- request{header: r.Header, body: b}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	app := storage.Application{
+		ID:             1,
+		OrganizationID: 2,
+		Name:           "test-app",
+	}
+	d := storage.Device{
+		DevEUI:         lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:  1,
+		Name:           "test-device",
+		LifecycleState: storage.DeviceProvisioned,
+	}
+
+	body, err := json.Marshal(newPayload(storage.DeviceWebhookCreated, app, d))
+	assert.NoError(err)
+
+	w := storage.DeviceWebhook{
+		URL:    server.URL,
+		Secret: "secret",
+		Headers: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"Authorization": {String: "Bearer token", Valid: true},
+			},
+		},
+	}
+
+	t.Run("Signed request", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(send(w, body))
+		req := <-requests
+
+		assert.Equal("application/json", req.header.Get("Content-Type"))
+		assert.Equal("Bearer token", req.header.Get("Authorization"))
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write(req.body)
+		assert.Equal(hex.EncodeToString(mac.Sum(nil)), req.header.Get(SignatureHeader))
+
+		var pl Payload
+		assert.NoError(json.Unmarshal(req.body, &pl))
+		assert.Equal(storage.DeviceWebhookCreated, pl.Event)
+		assert.EqualValues(2, pl.OrganizationID)
+		assert.Equal("test-app", pl.ApplicationName)
+		assert.Equal(d.DevEUI, pl.Device.DevEUI)
+		assert.Equal("test-device", pl.Device.Name)
+		assert.Equal(storage.DeviceProvisioned, pl.Device.LifecycleState)
+	})
+
+	t.Run("Unsigned request", func(t *testing.T) {
+		assert := require.New(t)
+
+		w := w
+		w.Secret = ""
+		assert.NoError(send(w, body))
+		req := <-requests
+		assert.Equal("", req.header.Get(SignatureHeader))
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		assert := require.New(t)
+
+		status = http.StatusInternalServerError
+		assert.Error(send(w, body))
+		<-requests
+	})
+}
@@ -3,6 +3,7 @@ package downlink
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -10,20 +11,26 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/cluster"
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/eventlog"
 	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/region"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/ns"
 	"github.com/brocaar/lorawan"
 )
 
+// ErrMaxPayloadSizeExceeded is returned when the downlink payload exceeds
+// the max payload size for the data-rate of the device.
+var ErrMaxPayloadSizeExceeded = errors.New("max payload size exceeded")
+
 // HandleDataDownPayloads handles received downlink payloads to be emitted to the
 // devices.
 func HandleDataDownPayloads() {
 	for pl := range integration.Integration().DataDownChan() {
 		go func(pl integration.DataDownPayload) {
-			if err := handleDataDownPayload(pl); err != nil {
+			if _, err := HandleDataDownPayload(pl); err != nil {
 				log.WithFields(log.Fields{
 					"dev_eui":        pl.DevEUI,
 					"application_id": pl.ApplicationID,
@@ -33,8 +40,13 @@ func HandleDataDownPayloads() {
 	}
 }
 
-func handleDataDownPayload(pl integration.DataDownPayload) error {
-	return storage.Transaction(func(tx sqlx.Ext) error {
+// HandleDataDownPayload validates and enqueues the given downlink payload.
+// When the object is set, it is encoded using the codec of the application.
+// It returns the frame-counter of the enqueued payload.
+func HandleDataDownPayload(pl integration.DataDownPayload) (uint32, error) {
+	var fCnt uint32
+
+	err := storage.Transaction(func(tx sqlx.Ext) error {
 		// lock the device so that a concurrent Enqueue action will block
 		// until this transaction has been completed
 		d, err := storage.GetDevice(tx, pl.DevEUI, true, true)
@@ -58,7 +70,7 @@ func handleDataDownPayload(pl integration.DataDownPayload) error {
 			}
 
 			// get the codec payload configured for the application
-			codecPL := codec.NewPayload(app.PayloadCodec, pl.FPort, app.PayloadEncoderScript, app.PayloadDecoderScript)
+			codecPL := codec.NewPayload(app.PayloadCodec, app.OrganizationID, pl.FPort, app.PayloadEncoderScript, app.PayloadDecoderScript)
 			if codecPL == nil {
 				logCodecError(app, d, errors.New("no or invalid codec configured for application"))
 				return errors.New("no or invalid codec configured for application")
@@ -77,17 +89,51 @@ func handleDataDownPayload(pl integration.DataDownPayload) error {
 			}
 		}
 
-		if _, err := EnqueueDownlinkPayload(tx, pl.DevEUI, pl.Confirmed, pl.FPort, pl.Data); err != nil {
+		fCnt, err = EnqueueDownlinkPayload(tx, pl.DevEUI, pl.Confirmed, pl.FPort, pl.Data)
+		if err != nil {
 			return errors.Wrap(err, "enqueue downlink device-queue item error")
 		}
 
 		return nil
 	})
+
+	return fCnt, err
 }
 
 // EnqueueDownlinkPayload adds the downlink payload to the network-server
-// device-queue.
+// device-queue. A RateLimitError is returned when the max. number of
+// downlinks per hour or day of the device-profile has been reached.
 func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, confirmed bool, fPort uint8, data []byte) (uint32, error) {
+	d, err := storage.GetDevice(db, devEUI, false, true)
+	if err != nil {
+		return 0, errors.Wrap(err, "get device error")
+	}
+
+	// downlinks are blocked for suspended and retired devices
+	if !d.LifecycleState.Enabled() {
+		return 0, d.LifecycleState.Err()
+	}
+
+	now := time.Now()
+	limits, err := getRateLimits(db, d)
+	if err != nil {
+		return 0, err
+	}
+	if err := reserveDownlink(devEUI, limits, now); err != nil {
+		return 0, err
+	}
+
+	// release the reserved downlink when it could not be enqueued
+	var enqueued bool
+	defer func() {
+		if enqueued {
+			return
+		}
+		if err := releaseDownlink(devEUI, limits, now); err != nil {
+			log.WithField("dev_eui", devEUI).WithError(err).Error("release downlink error")
+		}
+	}()
+
 	// get network-server and network-server api client
 	n, err := storage.GetNetworkServerForDevEUI(db, devEUI)
 	if err != nil {
@@ -98,6 +144,10 @@ func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, confirmed bool, f
 		return 0, errors.Wrap(err, "get network-server client error")
 	}
 
+	if err := validatePayloadSize(db, n, nsClient, d, data); err != nil {
+		return 0, err
+	}
+
 	// get fCnt to use for encrypting and enqueueing
 	resp, err := nsClient.GetNextDownlinkFCntForDevEUI(context.Background(), &ns.GetNextDownlinkFCntForDevEUIRequest{
 		DevEui: devEUI[:],
@@ -132,6 +182,9 @@ func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, confirmed bool, f
 		return 0, errors.Wrap(err, "create device-queue item error")
 	}
 
+	enqueued = true
+	cluster.Inc(cluster.CounterDownlink)
+
 	log.WithFields(log.Fields{
 		"f_cnt":     resp.FCnt,
 		"dev_eui":   devEUI,
@@ -141,6 +194,52 @@ func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, confirmed bool, f
 	return resp.FCnt, nil
 }
 
+// validatePayloadSize validates that the given payload fits within the max
+// payload size for the data-rate of the receive window in which the downlink
+// is expected to be sent, given the region of the network-server. For Class-C
+// devices this is the RX2 data-rate of the device-profile, for other devices
+// the RX1 data-rate derived from the data-rate of the last uplink and the RX1
+// data-rate offset of the device-profile. As the dwell-time settings of the
+// network-server are unknown, no dwell-time limit is assumed. For Class-A
+// devices no validation is performed when the data-rate of the device is not
+// (yet) known.
+func validatePayloadSize(db sqlx.Queryer, n storage.NetworkServer, nsClient ns.NetworkServerServiceClient, d storage.Device, data []byte) error {
+	dp, err := storage.GetDeviceProfile(db, d.DeviceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "get device-profile error")
+	}
+
+	if !dp.DeviceProfile.SupportsClassC && d.DR == nil {
+		return nil
+	}
+
+	r, err := region.GetForNetworkServer(n.ID, nsClient)
+	if err != nil {
+		return errors.Wrap(err, "get region error")
+	}
+
+	var dr int
+	if dp.DeviceProfile.SupportsClassC {
+		dr = int(dp.DeviceProfile.RxDatarate_2)
+	} else {
+		dr, err = region.GetRX1DataRate(r, *d.DR, int(dp.DeviceProfile.RxDrOffset_1))
+		if err != nil {
+			return errors.Wrap(err, "get rx1 data-rate error")
+		}
+	}
+
+	maxPLSize, err := region.GetMaxPayloadSize(r, dp.DeviceProfile.MacVersion, dp.DeviceProfile.RegParamsRevision, dr)
+	if err != nil {
+		return errors.Wrap(err, "get max payload-size error")
+	}
+
+	if len(data) > maxPLSize {
+		return errors.Wrapf(ErrMaxPayloadSizeExceeded, "payload size of %d bytes exceeds the max payload size of %d bytes for data-rate %d", len(data), maxPLSize, dr)
+	}
+
+	return nil
+}
+
 func logCodecError(a storage.Application, d storage.Device, err error) {
 	errNotification := integration.ErrorNotification{
 		ApplicationID:   a.ID,
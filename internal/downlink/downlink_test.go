@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
@@ -188,7 +189,7 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 					app.PayloadEncoderScript = test.PayloadEncoderScript
 					So(storage.UpdateApplication(storage.DB(), app), ShouldBeNil)
 
-					err := handleDataDownPayload(test.Payload)
+					_, err := HandleDataDownPayload(test.Payload)
 					if test.ExpectedError != nil {
 						So(err, ShouldNotBeNil)
 						So(err.Error(), ShouldEqual, test.ExpectedError.Error())
@@ -202,5 +203,93 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 				})
 			}
 		})
+
+		Convey("Given the device has a known data-rate", func() {
+			dr := 0
+			device.DR = &dr
+			So(storage.UpdateDevice(storage.DB(), &device, true), ShouldBeNil)
+
+			nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
+				DeviceProfile: &ns.DeviceProfile{
+					Id:                dp.DeviceProfile.Id,
+					MacVersion:        "1.0.2",
+					RegParamsRevision: "B",
+				},
+			}
+
+			Convey("Then a payload within the max payload size is enqueued", func() {
+				_, err := EnqueueDownlinkPayload(storage.DB(), device.DevEUI, false, 2, make([]byte, 51))
+				So(err, ShouldBeNil)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+			})
+
+			Convey("Then a payload exceeding the max payload size returns an error", func() {
+				_, err := EnqueueDownlinkPayload(storage.DB(), device.DevEUI, false, 2, make([]byte, 52))
+				So(errors.Cause(err), ShouldEqual, ErrMaxPayloadSizeExceeded)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+			})
+
+			Convey("Given the device-profile has an RX1 data-rate offset", func() {
+				dr := 5
+				device.DR = &dr
+				So(storage.UpdateDevice(storage.DB(), &device, true), ShouldBeNil)
+				nsClient.GetDeviceProfileResponse.DeviceProfile.RxDrOffset_1 = 5
+
+				Convey("Then the max payload size of the RX1 data-rate is used", func() {
+					_, err := EnqueueDownlinkPayload(storage.DB(), device.DevEUI, false, 2, make([]byte, 52))
+					So(errors.Cause(err), ShouldEqual, ErrMaxPayloadSizeExceeded)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("Given the device-profile supports Class-C", func() {
+				dr := 0
+				device.DR = &dr
+				So(storage.UpdateDevice(storage.DB(), &device, true), ShouldBeNil)
+				nsClient.GetDeviceProfileResponse.DeviceProfile.SupportsClassC = true
+				nsClient.GetDeviceProfileResponse.DeviceProfile.RxDatarate_2 = 5
+
+				Convey("Then the max payload size of the RX2 data-rate is used", func() {
+					_, err := EnqueueDownlinkPayload(storage.DB(), device.DevEUI, false, 2, make([]byte, 100))
+					So(err, ShouldBeNil)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+				})
+			})
+		})
+
+		Convey("Given the device-profile has a downlink rate-limit", func() {
+			test.MustFlushRedis(storage.RedisPool())
+
+			dp.MaxDownlinksPerHour = 2
+			So(storage.UpdateDeviceProfile(storage.DB(), &dp), ShouldBeNil)
+
+			Convey("Then the downlinks within the limit are enqueued", func() {
+				for i := 0; i < 2; i++ {
+					_, err := EnqueueDownlinkPayload(storage.DB(), device.DevEUI, false, 2, []byte{1, 2, 3})
+					So(err, ShouldBeNil)
+				}
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 2)
+
+				Convey("Then a downlink exceeding the limit returns a RateLimitError", func() {
+					_, err := EnqueueDownlinkPayload(storage.DB(), device.DevEUI, false, 2, []byte{1, 2, 3})
+					rlErr, ok := errors.Cause(err).(*RateLimitError)
+					So(ok, ShouldBeTrue)
+					So(rlErr.Period, ShouldEqual, RateLimitHour)
+					So(rlErr.MaxDownlinks, ShouldEqual, 2)
+					So(rlErr.RetryAfter, ShouldBeGreaterThan, 0)
+					So(rlErr.RetryAfter, ShouldBeLessThanOrEqualTo, time.Hour)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 2)
+				})
+
+				Convey("Then a released downlink can be enqueued again", func() {
+					limits := []rateLimit{{period: RateLimitHour, max: 2}}
+					So(releaseDownlink(device.DevEUI, limits, time.Now()), ShouldBeNil)
+
+					_, err := EnqueueDownlinkPayload(storage.DB(), device.DevEUI, false, 2, []byte{1, 2, 3})
+					So(err, ShouldBeNil)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 3)
+				})
+			})
+		})
 	})
 }
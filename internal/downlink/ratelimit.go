@@ -0,0 +1,171 @@
+package downlink
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+const downlinkCountKeyTempl = "lora:as:device:%s:downlink-count:%s:%d"
+
+// RateLimitPeriod defines the period of a downlink rate-limit.
+type RateLimitPeriod string
+
+// Rate-limit periods.
+const (
+	RateLimitHour RateLimitPeriod = "hour"
+	RateLimitDay  RateLimitPeriod = "day"
+)
+
+// Duration returns the duration of the period.
+func (p RateLimitPeriod) Duration() time.Duration {
+	if p == RateLimitDay {
+		return 24 * time.Hour
+	}
+	return time.Hour
+}
+
+// RateLimitError is returned when enqueueing a downlink would exceed the
+// max. number of downlinks per hour or day of the device-profile of the
+// device.
+type RateLimitError struct {
+	Period       RateLimitPeriod
+	MaxDownlinks int
+	RetryAfter   time.Duration
+}
+
+// Error implements the error interface.
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("max. number of %d downlinks per %s exceeded, retry after %s", e.MaxDownlinks, e.Period, e.RetryAfter)
+}
+
+type rateLimit struct {
+	period RateLimitPeriod
+	max    int
+}
+
+// getRateLimits returns the configured (non-zero) rate-limits for the given
+// device.
+func getRateLimits(db sqlx.Queryer, d storage.Device) ([]rateLimit, error) {
+	perHour, perDay, err := storage.GetDeviceProfileDownlinkRateLimits(db, d.DeviceProfileID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get downlink rate-limits error")
+	}
+
+	var out []rateLimit
+	if perHour > 0 {
+		out = append(out, rateLimit{period: RateLimitHour, max: perHour})
+	}
+	if perDay > 0 {
+		out = append(out, rateLimit{period: RateLimitDay, max: perDay})
+	}
+	return out, nil
+}
+
+// windowStart returns the start of the (fixed, UTC aligned) window of the
+// given period, containing the given time.
+func windowStart(p RateLimitPeriod, t time.Time) time.Time {
+	return t.UTC().Truncate(p.Duration())
+}
+
+func downlinkCountKey(devEUI lorawan.EUI64, p RateLimitPeriod, start time.Time) string {
+	return fmt.Sprintf(downlinkCountKeyTempl, devEUI, p, start.Unix())
+}
+
+// reserveScript increments the downlink counters given as keys. The
+// arguments contain for each key the max. count and the expiration
+// timestamp (ms). When a counter exceeds its max. count, all counters are
+// decremented again and the (1-based) index of the exceeded counter is
+// returned. Else 0 is returned.
+var reserveScript = redis.NewScript(-1, `
+	local exceeded = 0
+	for i, key in ipairs(KEYS) do
+		local count = redis.call("INCR", key)
+		redis.call("PEXPIREAT", key, ARGV[i * 2])
+		if exceeded == 0 and count > tonumber(ARGV[i * 2 - 1]) then
+			exceeded = i
+		end
+	end
+	if exceeded ~= 0 then
+		for _, key in ipairs(KEYS) do
+			redis.call("DECR", key)
+		end
+	end
+	return exceeded
+`)
+
+// releaseScript decrements the downlink counters given as keys. Counters
+// which have expired in the meantime are not re-created.
+var releaseScript = redis.NewScript(-1, `
+	for _, key in ipairs(KEYS) do
+		if redis.call("EXISTS", key) == 1 then
+			redis.call("DECR", key)
+		end
+	end
+	return 0
+`)
+
+// reserveDownlink increments the downlink counters of the given rate-limits
+// for the given device. A RateLimitError is returned, and none of the
+// counters is incremented, when one of the rate-limits would be exceeded.
+func reserveDownlink(devEUI lorawan.EUI64, limits []rateLimit, now time.Time) error {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	keys := []interface{}{len(limits)}
+	var args []interface{}
+	for _, l := range limits {
+		start := windowStart(l.period, now)
+		keys = append(keys, downlinkCountKey(devEUI, l.period, start))
+		args = append(args, l.max, start.Add(l.period.Duration()).UnixNano()/int64(time.Millisecond))
+	}
+
+	i, err := redis.Int(reserveScript.Do(c, append(keys, args...)...))
+	if err != nil {
+		return errors.Wrap(err, "reserve downlink error")
+	}
+
+	if i != 0 {
+		l := limits[i-1]
+		start := windowStart(l.period, now)
+		return &RateLimitError{
+			Period:       l.period,
+			MaxDownlinks: l.max,
+			RetryAfter:   start.Add(l.period.Duration()).Sub(now),
+		}
+	}
+
+	return nil
+}
+
+// releaseDownlink decrements the downlink counters of the given rate-limits
+// for the given device, after the reserved downlink could not be enqueued.
+func releaseDownlink(devEUI lorawan.EUI64, limits []rateLimit, now time.Time) error {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	args := []interface{}{len(limits)}
+	for _, l := range limits {
+		args = append(args, downlinkCountKey(devEUI, l.period, windowStart(l.period, now)))
+	}
+
+	if _, err := releaseScript.Do(c, args...); err != nil {
+		return errors.Wrap(err, "release downlink error")
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package downlink
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/cluster"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+const (
+	responseWindowsKeyTempl = "lora:as:device:%s:response-windows"
+	responseDeadlinesKey    = "lora:as:response-windows:deadlines"
+)
+
+// MaxResponseTimeout defines the max. time to wait for a response uplink.
+const MaxResponseTimeout = 24 * time.Hour
+
+// ResponseTimeoutError defines the error type of the error notification
+// sent when no response uplink was received within the response timeout.
+const ResponseTimeoutError = "RESPONSE_TIMEOUT"
+
+// ErrInvalidResponseWindow is returned when the f_port or timeout of the
+// response window is invalid.
+var ErrInvalidResponseWindow = errors.New("invalid response window, the f_port must be > 0 and the timeout > 0 and <= 24h")
+
+// ResponseWindow defines a response uplink which is expected after a
+// downlink.
+type ResponseWindow struct {
+	CorrelationID uuid.UUID `json:"correlationID"`
+	FPort         uint8     `json:"fPort"`
+	FCnt          uint32    `json:"fCnt"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Deadline      time.Time `json:"deadline"`
+}
+
+// ExpectResponse opens a response window for the downlink with the given
+// frame-counter: the first uplink of the device on the given fPort within
+// the given timeout is correlated to the downlink. When no such uplink is
+// received, a RESPONSE_TIMEOUT error notification is sent. It returns the
+// correlation ID.
+func ExpectResponse(devEUI lorawan.EUI64, fCnt uint32, fPort uint8, timeout time.Duration) (uuid.UUID, error) {
+	if fPort == 0 || timeout <= 0 || timeout > MaxResponseTimeout {
+		return uuid.Nil, ErrInvalidResponseWindow
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return uuid.Nil, errors.Wrap(err, "new uuid v4 error")
+	}
+
+	now := time.Now()
+	w := ResponseWindow{
+		CorrelationID: id,
+		FPort:         fPort,
+		FCnt:          fCnt,
+		CreatedAt:     now,
+		Deadline:      now.Add(timeout),
+	}
+
+	b, err := json.Marshal(w)
+	if err != nil {
+		return uuid.Nil, errors.Wrap(err, "marshal response window error")
+	}
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("HSET", fmt.Sprintf(responseWindowsKeyTempl, devEUI), id.String(), b)
+	c.Send("ZADD", responseDeadlinesKey, w.Deadline.UnixNano(), deadlineMember(devEUI, id))
+	if _, err := c.Do("EXEC"); err != nil {
+		return uuid.Nil, errors.Wrap(err, "store response window error")
+	}
+
+	return id, nil
+}
+
+// MatchResponse closes and returns the oldest open response window of the
+// given device matching the given fPort. The returned bool is false when
+// there is no matching response window.
+func MatchResponse(devEUI lorawan.EUI64, fPort uint8) (ResponseWindow, bool, error) {
+	key := fmt.Sprintf(responseWindowsKeyTempl, devEUI)
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	values, err := redis.ByteSlices(c.Do("HVALS", key))
+	if err != nil {
+		return ResponseWindow{}, false, errors.Wrap(err, "get response windows error")
+	}
+
+	var match *ResponseWindow
+	now := time.Now()
+	for _, b := range values {
+		var w ResponseWindow
+		if err := json.Unmarshal(b, &w); err != nil {
+			return ResponseWindow{}, false, errors.Wrap(err, "unmarshal response window error")
+		}
+
+		if w.FPort != fPort || w.Deadline.Before(now) {
+			continue
+		}
+
+		if match == nil || w.CreatedAt.Before(match.CreatedAt) {
+			match = &w
+		}
+	}
+
+	if match == nil {
+		return ResponseWindow{}, false, nil
+	}
+
+	// the window might have been closed by the timeout handler in the
+	// meantime
+	closed, err := closeResponseWindow(c, devEUI, match.CorrelationID)
+	if err != nil || !closed {
+		return ResponseWindow{}, false, err
+	}
+
+	return *match, true, nil
+}
+
+// HandleResponseTimeoutsLoop is a never returning function sending the
+// error notifications for the expired response windows.
+func HandleResponseTimeoutsLoop() {
+	for {
+		if cluster.IsLeader(cluster.RoleResponseWindows) {
+			if err := handleResponseTimeouts(); err != nil {
+				log.WithError(err).Error("handle response timeouts error")
+			}
+		}
+
+		time.Sleep(time.Second)
+	}
+}
+
+func handleResponseTimeouts() error {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	members, err := redis.Strings(c.Do("ZRANGEBYSCORE", responseDeadlinesKey, "-inf", time.Now().UnixNano()))
+	if err != nil {
+		return errors.Wrap(err, "get expired response windows error")
+	}
+
+	for _, member := range members {
+		devEUI, id, err := parseDeadlineMember(member)
+		if err != nil {
+			log.WithField("member", member).WithError(err).Error("invalid response window deadline")
+			if _, err := c.Do("ZREM", responseDeadlinesKey, member); err != nil {
+				return errors.Wrap(err, "remove response window deadline error")
+			}
+			continue
+		}
+
+		b, err := redis.Bytes(c.Do("HGET", fmt.Sprintf(responseWindowsKeyTempl, devEUI), id.String()))
+		if err != nil && err != redis.ErrNil {
+			return errors.Wrap(err, "get response window error")
+		}
+
+		closed, err := closeResponseWindow(c, devEUI, id)
+		if err != nil {
+			return err
+		}
+		if !closed || b == nil {
+			continue
+		}
+
+		var w ResponseWindow
+		if err := json.Unmarshal(b, &w); err != nil {
+			return errors.Wrap(err, "unmarshal response window error")
+		}
+
+		if err := sendResponseTimeout(devEUI, w); err != nil {
+			log.WithFields(log.Fields{
+				"dev_eui":        devEUI,
+				"correlation_id": w.CorrelationID,
+			}).WithError(err).Error("send response timeout error")
+		}
+	}
+
+	return nil
+}
+
+// closeResponseWindow removes the given response window. It returns false
+// when the response window was already removed.
+func closeResponseWindow(c redis.Conn, devEUI lorawan.EUI64, id uuid.UUID) (bool, error) {
+	c.Send("MULTI")
+	c.Send("HDEL", fmt.Sprintf(responseWindowsKeyTempl, devEUI), id.String())
+	c.Send("ZREM", responseDeadlinesKey, deadlineMember(devEUI, id))
+	values, err := redis.Ints(c.Do("EXEC"))
+	if err != nil {
+		return false, errors.Wrap(err, "remove response window error")
+	}
+
+	return values[0] == 1, nil
+}
+
+func sendResponseTimeout(devEUI lorawan.EUI64, w ResponseWindow) error {
+	d, err := storage.GetDevice(storage.DB(), devEUI, false, true)
+	if err != nil {
+		return errors.Wrap(err, "get device error")
+	}
+
+	app, err := storage.GetApplication(storage.DB(), d.ApplicationID)
+	if err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	errNotification := integration.ErrorNotification{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DeviceName:      d.Name,
+		DevEUI:          d.DevEUI,
+		Type:            ResponseTimeoutError,
+		Error:           fmt.Sprintf("no response received on fPort %d within %s", w.FPort, w.Deadline.Sub(w.CreatedAt)),
+		FCnt:            w.FCnt,
+		CorrelationID:   w.CorrelationID.String(),
+	}
+
+	if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+		Type:    eventlog.Error,
+		Payload: errNotification,
+	}); err != nil {
+		log.WithError(err).Error("log event for device error")
+	}
+
+	if err := integration.Integration().SendErrorNotification(errNotification); err != nil {
+		return errors.Wrap(err, "send error notification to integration error")
+	}
+
+	return nil
+}
+
+func deadlineMember(devEUI lorawan.EUI64, id uuid.UUID) string {
+	return devEUI.String() + "/" + id.String()
+}
+
+func parseDeadlineMember(member string) (lorawan.EUI64, uuid.UUID, error) {
+	var devEUI lorawan.EUI64
+
+	parts := strings.SplitN(member, "/", 2)
+	if len(parts) != 2 {
+		return devEUI, uuid.Nil, errors.New("expected devEUI/correlationID")
+	}
+
+	if err := devEUI.UnmarshalText([]byte(parts[0])); err != nil {
+		return devEUI, uuid.Nil, errors.Wrap(err, "unmarshal devEUI error")
+	}
+
+	id, err := uuid.FromString(parts[1])
+	if err != nil {
+		return devEUI, uuid.Nil, errors.Wrap(err, "parse correlation id error")
+	}
+
+	return devEUI, id, nil
+}
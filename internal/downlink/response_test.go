@@ -0,0 +1,84 @@
+package downlink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestResponseWindows(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	assert.NoError(storage.Setup(conf))
+	test.MustFlushRedis(storage.RedisPool())
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	t.Run("Invalid response window", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := ExpectResponse(devEUI, 10, 0, time.Minute)
+		assert.Equal(ErrInvalidResponseWindow, err)
+
+		_, err = ExpectResponse(devEUI, 10, 2, MaxResponseTimeout+time.Second)
+		assert.Equal(ErrInvalidResponseWindow, err)
+	})
+
+	t.Run("Matching response", func(t *testing.T) {
+		assert := require.New(t)
+
+		id1, err := ExpectResponse(devEUI, 10, 2, time.Minute)
+		assert.NoError(err)
+		id2, err := ExpectResponse(devEUI, 11, 2, time.Minute)
+		assert.NoError(err)
+
+		_, ok, err := MatchResponse(devEUI, 3)
+		assert.NoError(err)
+		assert.False(ok)
+
+		// the oldest window is matched first
+		w, ok, err := MatchResponse(devEUI, 2)
+		assert.NoError(err)
+		assert.True(ok)
+		assert.Equal(id1, w.CorrelationID)
+		assert.EqualValues(10, w.FCnt)
+
+		w, ok, err = MatchResponse(devEUI, 2)
+		assert.NoError(err)
+		assert.True(ok)
+		assert.Equal(id2, w.CorrelationID)
+
+		_, ok, err = MatchResponse(devEUI, 2)
+		assert.NoError(err)
+		assert.False(ok)
+	})
+
+	t.Run("Expired response window", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := ExpectResponse(devEUI, 12, 2, time.Millisecond)
+		assert.NoError(err)
+		time.Sleep(5 * time.Millisecond)
+
+		_, ok, err := MatchResponse(devEUI, 2)
+		assert.NoError(err)
+		assert.False(ok)
+
+		// the device does not exist, the timeout is logged as error, but
+		// the window must be closed
+		assert.NoError(handleResponseTimeouts())
+
+		c := storage.RedisPool().Get()
+		defer c.Close()
+
+		count, err := c.Do("ZCARD", responseDeadlinesKey)
+		assert.NoError(err)
+		assert.EqualValues(0, count)
+	})
+}
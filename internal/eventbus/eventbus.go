@@ -0,0 +1,194 @@
+// Package eventbus implements the internal publish / subscribe event bus.
+// The uplink and device lifecycle events are published to the bus, so that
+// the subsystems of the application-server can consume these events without
+// being coupled to the handlers producing them.
+package eventbus
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lorawan"
+)
+
+// Backends.
+const (
+	LocalBackend = "local"
+	RedisBackend = "redis"
+)
+
+// Type defines the event type.
+type Type string
+
+// Event types.
+const (
+	Uplink               Type = "uplink"
+	Join                 Type = "join"
+	ACK                  Type = "ack"
+	Error                Type = "error"
+	Status               Type = "status"
+	Location             Type = "location"
+	DeviceCreated        Type = "device.created"
+	DeviceActivated      Type = "device.activated"
+	DeviceDecommissioned Type = "device.decommissioned"
+)
+
+// Event defines a single event published to the bus. The payload contains
+// the JSON encoded integration payload (for the device events) or the
+// storage.Device (for the lifecycle events).
+type Event struct {
+	ID            string          `json:"id"`
+	Type          Type            `json:"type"`
+	Time          time.Time       `json:"time"`
+	ApplicationID int64           `json:"applicationID"`
+	DevEUI        lorawan.EUI64   `json:"devEUI"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// NewEvent creates a new event of the given type.
+func NewEvent(t Type, applicationID int64, devEUI lorawan.EUI64, payload interface{}) (Event, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return Event{}, errors.Wrap(err, "new uuid v4 error")
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, errors.Wrap(err, "marshal json error")
+	}
+
+	return Event{
+		ID:            id.String(),
+		Type:          t,
+		Time:          time.Now(),
+		ApplicationID: applicationID,
+		DevEUI:        devEUI,
+		Payload:       b,
+	}, nil
+}
+
+// Decode decodes the payload of the event into v.
+func (e Event) Decode(v interface{}) error {
+	if err := json.Unmarshal(e.Payload, v); err != nil {
+		return errors.Wrap(err, "unmarshal json error")
+	}
+	return nil
+}
+
+// Handler defines the function handling the events of a subscription.
+type Handler func(e Event) error
+
+// Subscription defines a subscription to the bus.
+type Subscription struct {
+	// Name of the subscription. When the same subscription is made by
+	// multiple instances of the cluster, each event is handled by only one
+	// of these instances.
+	Name string
+
+	// Types of the events to handle. When empty, all events are handled.
+	Types []Type
+
+	// Handler handling the events.
+	Handler Handler
+}
+
+func (s Subscription) validate() error {
+	if s.Name == "" {
+		return errors.New("subscription name must be set")
+	}
+	if s.Handler == nil {
+		return errors.New("subscription handler must be set")
+	}
+	return nil
+}
+
+// matches returns if the given event type must be handled by the
+// subscription.
+func (s Subscription) matches(t Type) bool {
+	if len(s.Types) == 0 {
+		return true
+	}
+	for _, st := range s.Types {
+		if st == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Bus defines the interface that an event bus must implement.
+type Bus interface {
+	Publish(e Event) error          // publishes the given event
+	Subscribe(s Subscription) error // adds the given subscription
+	Close() error                   // closes the bus
+}
+
+var bus Bus = NewLocalBus(defaultQueueSize)
+
+// GetBus returns the event bus.
+func GetBus() Bus {
+	return bus
+}
+
+// SetBus sets the given event bus.
+func SetBus(b Bus) {
+	bus = b
+}
+
+// Setup configures the event bus. It must be called before any subsystem
+// subscribes to the bus.
+func Setup(conf config.Config) error {
+	c := conf.ApplicationServer.EventBus
+
+	queueSize := c.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+
+	log.WithFields(log.Fields{
+		"backend":    c.Backend,
+		"queue_size": queueSize,
+	}).Info("eventbus: setting up event bus")
+
+	switch c.Backend {
+	case "", LocalBackend:
+		SetBus(NewLocalBus(queueSize))
+	case RedisBackend:
+		b, err := NewRedisBus(c.MaxLen)
+		if err != nil {
+			return errors.Wrap(err, "new redis bus error")
+		}
+		SetBus(b)
+	default:
+		return fmt.Errorf("unknown event bus backend: %s", c.Backend)
+	}
+
+	return nil
+}
+
+// Publish publishes an event of the given type to the bus. Errors are
+// logged, so that a failing bus does not affect the caller.
+func Publish(t Type, applicationID int64, devEUI lorawan.EUI64, payload interface{}) {
+	e, err := NewEvent(t, applicationID, devEUI, payload)
+	if err == nil {
+		err = bus.Publish(e)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"type":    t,
+			"dev_eui": devEUI,
+		}).WithError(err).Error("eventbus: publish event error")
+	}
+}
+
+// Subscribe adds the given subscription to the bus.
+func Subscribe(s Subscription) error {
+	return bus.Subscribe(s)
+}
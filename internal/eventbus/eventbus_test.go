@@ -0,0 +1,166 @@
+package eventbus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+type testPayload struct {
+	Value int `json:"value"`
+}
+
+func subscribeChan(assert *require.Assertions, b Bus, name string, types ...Type) chan Event {
+	events := make(chan Event, 10)
+	assert.NoError(b.Subscribe(Subscription{
+		Name:  name,
+		Types: types,
+		Handler: func(e Event) error {
+			events <- e
+			return nil
+		},
+	}))
+	return events
+}
+
+func TestLocalBus(t *testing.T) {
+	assert := require.New(t)
+
+	b := NewLocalBus(10)
+	defer b.Close()
+
+	uplinks := subscribeChan(assert, b, "uplinks", Uplink)
+	all := subscribeChan(assert, b, "all")
+
+	t.Run("Duplicate subscription", func(t *testing.T) {
+		assert := require.New(t)
+		assert.Error(b.Subscribe(Subscription{Name: "all", Handler: func(Event) error { return nil }}))
+	})
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	t.Run("Publish uplink", func(t *testing.T) {
+		assert := require.New(t)
+
+		e, err := NewEvent(Uplink, 1, devEUI, testPayload{Value: 10})
+		assert.NoError(err)
+		assert.NoError(b.Publish(e))
+
+		for _, c := range []chan Event{uplinks, all} {
+			received := <-c
+			assert.Equal(e.ID, received.ID)
+			assert.Equal(Uplink, received.Type)
+			assert.EqualValues(1, received.ApplicationID)
+			assert.Equal(devEUI, received.DevEUI)
+
+			var pl testPayload
+			assert.NoError(received.Decode(&pl))
+			assert.Equal(10, pl.Value)
+		}
+	})
+
+	t.Run("Publish join", func(t *testing.T) {
+		assert := require.New(t)
+
+		e, err := NewEvent(Join, 1, devEUI, testPayload{})
+		assert.NoError(err)
+		assert.NoError(b.Publish(e))
+
+		assert.Equal(Join, (<-all).Type)
+		select {
+		case <-uplinks:
+			t.Fatal("unexpected event")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+}
+
+func TestRedisBus(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	assert.NoError(storage.Setup(conf))
+	test.MustFlushRedis(storage.RedisPool())
+
+	// two buses, simulating two instances of the cluster
+	b1, err := NewRedisBus(10)
+	assert.NoError(err)
+	defer b1.Close()
+
+	b2, err := NewRedisBus(10)
+	assert.NoError(err)
+	defer b2.Close()
+
+	// the same subscription is made by both instances
+	events := make(chan Event, 10)
+	for _, b := range []Bus{b1, b2} {
+		assert.NoError(b.Subscribe(Subscription{
+			Name:  "test",
+			Types: []Type{DeviceCreated},
+			Handler: func(e Event) error {
+				events <- e
+				return nil
+			},
+		}))
+	}
+
+	// allow the subscriptions to be set up
+	time.Sleep(100 * time.Millisecond)
+
+	t.Run("Event is handled once", func(t *testing.T) {
+		assert := require.New(t)
+
+		e, err := NewEvent(DeviceCreated, 1, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, testPayload{Value: 1})
+		assert.NoError(err)
+		assert.NoError(b2.Publish(e))
+
+		received := <-events
+		assert.Equal(e.ID, received.ID)
+
+		select {
+		case <-events:
+			t.Fatal("event handled twice")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+	t.Run("Events published while not subscribed are handled after subscribing", func(t *testing.T) {
+		assert := require.New(t)
+
+		events := make(chan Event, 10)
+		sub := Subscription{
+			Name:  "test-restart",
+			Types: []Type{DeviceCreated},
+			Handler: func(e Event) error {
+				events <- e
+				return nil
+			},
+		}
+
+		// creates the consumer group
+		b3, err := NewRedisBus(10)
+		assert.NoError(err)
+		assert.NoError(b3.Subscribe(sub))
+		assert.NoError(b3.Close())
+
+		e, err := NewEvent(DeviceCreated, 1, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, testPayload{Value: 2})
+		assert.NoError(err)
+		assert.NoError(b1.Publish(e))
+
+		b4, err := NewRedisBus(10)
+		assert.NoError(err)
+		defer b4.Close()
+		assert.NoError(b4.Subscribe(sub))
+
+		select {
+		case received := <-events:
+			assert.Equal(e.ID, received.ID)
+		case <-time.After(time.Second):
+			t.Fatal("event not handled")
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package eventbus
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultQueueSize defines the default max. number of events queued per
+// subscription.
+const defaultQueueSize = 1000
+
+type subscriber struct {
+	Subscription
+	queue chan Event
+}
+
+// dispatcher dispatches the events to the subscribers within this
+// instance. Each subscriber handles its events in order, within its own
+// goroutine. When the queue of a subscriber is full, the event is dropped
+// so that publishing never blocks.
+type dispatcher struct {
+	mux         sync.RWMutex
+	queueSize   int
+	subscribers []*subscriber
+	closed      bool
+}
+
+func (d *dispatcher) subscribe(s Subscription) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if d.closed {
+		return errors.New("event bus is closed")
+	}
+
+	for _, sub := range d.subscribers {
+		if sub.Name == s.Name {
+			return errors.Errorf("subscription %s already exists", s.Name)
+		}
+	}
+
+	sub := subscriber{
+		Subscription: s,
+		queue:        make(chan Event, d.queueSize),
+	}
+	d.subscribers = append(d.subscribers, &sub)
+	go d.handle(&sub)
+
+	return nil
+}
+
+func (d *dispatcher) dispatch(e Event) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	if d.closed {
+		return
+	}
+
+	for _, sub := range d.subscribers {
+		if !sub.matches(e.Type) {
+			continue
+		}
+
+		select {
+		case sub.queue <- e:
+		default:
+			log.WithFields(log.Fields{
+				"subscription": sub.Name,
+				"type":         e.Type,
+				"event_id":     e.ID,
+			}).Warning("eventbus: subscription queue is full, dropping event")
+		}
+	}
+}
+
+func (d *dispatcher) handle(sub *subscriber) {
+	for e := range sub.queue {
+		if err := sub.Handler(e); err != nil {
+			log.WithFields(log.Fields{
+				"subscription": sub.Name,
+				"type":         e.Type,
+				"event_id":     e.ID,
+				"dev_eui":      e.DevEUI,
+			}).WithError(err).Error("eventbus: handle event error")
+		}
+	}
+}
+
+func (d *dispatcher) close() {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if d.closed {
+		return
+	}
+	d.closed = true
+
+	for _, sub := range d.subscribers {
+		close(sub.queue)
+	}
+}
+
+// LocalBus implements an in-process event bus. Events are only delivered
+// to the subscriptions of the same instance.
+type LocalBus struct {
+	dispatcher
+}
+
+// NewLocalBus creates a new LocalBus.
+func NewLocalBus(queueSize int) *LocalBus {
+	return &LocalBus{
+		dispatcher: dispatcher{
+			queueSize: queueSize,
+		},
+	}
+}
+
+// Publish publishes the given event.
+func (b *LocalBus) Publish(e Event) error {
+	b.dispatch(e)
+	return nil
+}
+
+// Subscribe adds the given subscription.
+func (b *LocalBus) Subscribe(s Subscription) error {
+	return b.subscribe(s)
+}
+
+// Close closes the bus.
+func (b *LocalBus) Close() error {
+	b.close()
+	return nil
+}
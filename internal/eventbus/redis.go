@@ -0,0 +1,368 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/cluster"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+const (
+	eventsStream   = "lora:as:eventbus:events"
+	eventField     = "event"
+	readCount      = 100
+	readBlock      = time.Second
+	claimInterval  = 30 * time.Second
+	claimMinIdle   = time.Minute
+	reconnectDelay = time.Second
+)
+
+// RedisBus implements an event bus using a Redis stream, so that the events
+// are delivered to the subscriptions of all the instances of the cluster.
+// Each subscription is a consumer group of the stream, when the same
+// subscription is made by multiple instances, each event is handled by one
+// of these instances. As the position of each consumer group is stored in
+// Redis, the events published while an instance is reconnecting or
+// restarting are handled afterwards. The events which were delivered to an
+// instance which has left the cluster, but which have not been
+// acknowledged, are claimed by the other instances.
+type RedisBus struct {
+	consumer string
+	maxLen   int
+
+	mux    sync.Mutex
+	names  map[string]struct{}
+	closed bool
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// streamMessage contains a message read from the stream. The event is nil
+// when the message has been removed from the stream (see maxLen).
+type streamMessage struct {
+	id    string
+	event []byte
+}
+
+// NewRedisBus creates a new RedisBus. The given max. length defines the
+// (approximate) max. number of events kept in the stream. The instance id
+// of the cluster is used as consumer name.
+func NewRedisBus(maxLen int) (*RedisBus, error) {
+	consumer := cluster.InstanceID()
+	if consumer == "" {
+		u, err := uuid.NewV4()
+		if err != nil {
+			return nil, errors.Wrap(err, "new uuid v4 error")
+		}
+		consumer = u.String()
+	}
+
+	return &RedisBus{
+		consumer: consumer,
+		maxLen:   maxLen,
+		names:    make(map[string]struct{}),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// Publish publishes the given event.
+func (b *RedisBus) Publish(e Event) error {
+	bb, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	if _, err := c.Do("XADD", eventsStream, "MAXLEN", "~", b.maxLen, "*", eventField, bb); err != nil {
+		return errors.Wrap(err, "add event to stream error")
+	}
+
+	return nil
+}
+
+// Subscribe adds the given subscription. The consumer group of the
+// subscription is created when it does not exist yet, starting with the
+// events published after its creation.
+func (b *RedisBus) Subscribe(s Subscription) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	if b.closed {
+		return errors.New("event bus is closed")
+	}
+	if _, ok := b.names[s.Name]; ok {
+		return errors.Errorf("subscription %s already exists", s.Name)
+	}
+
+	if err := createGroup(s.Name); err != nil {
+		return errors.Wrap(err, "create consumer group error")
+	}
+
+	b.names[s.Name] = struct{}{}
+	b.wg.Add(1)
+	go b.consume(s)
+
+	return nil
+}
+
+// Close closes the bus. It blocks until the events being handled have been
+// handled.
+func (b *RedisBus) Close() error {
+	b.mux.Lock()
+	if b.closed {
+		b.mux.Unlock()
+		return nil
+	}
+	b.closed = true
+	close(b.done)
+	b.mux.Unlock()
+
+	b.wg.Wait()
+	return nil
+}
+
+// consume handles the events of the given subscription until the bus is
+// closed. First the events delivered to this consumer before (e.g. before a
+// restart) which have not been acknowledged are handled.
+func (b *RedisBus) consume(s Subscription) {
+	defer b.wg.Done()
+
+	id := "0"
+	lastClaim := time.Now()
+
+	for {
+		select {
+		case <-b.done:
+			return
+		default:
+		}
+
+		msgs, err := b.read(s.Name, id)
+		if err != nil {
+			log.WithField("subscription", s.Name).WithError(err).Error("eventbus: read events error, retrying")
+			time.Sleep(reconnectDelay)
+			continue
+		}
+
+		// all the pending events have been handled
+		if id == "0" && len(msgs) == 0 {
+			id = ">"
+		}
+
+		b.handle(s, msgs)
+
+		if time.Since(lastClaim) >= claimInterval {
+			lastClaim = time.Now()
+
+			msgs, err := b.claim(s.Name)
+			if err != nil {
+				log.WithField("subscription", s.Name).WithError(err).Error("eventbus: claim events error")
+				continue
+			}
+			b.handle(s, msgs)
+		}
+	}
+}
+
+// read reads the events of the given consumer group, starting after the
+// given id. The id ">" reads the events which have not been delivered to any
+// consumer of the group yet.
+func (b *RedisBus) read(group, id string) ([]streamMessage, error) {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	args := redis.Args{"GROUP", group, b.consumer, "COUNT", readCount}
+	if id == ">" {
+		args = args.Add("BLOCK", int64(readBlock/time.Millisecond))
+	}
+	args = args.Add("STREAMS", eventsStream, id)
+
+	reply, err := redis.Values(c.Do("XREADGROUP", args...))
+	if err != nil {
+		if err == redis.ErrNil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var out []streamMessage
+	for _, stream := range reply {
+		sv, err := redis.Values(stream, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(sv) != 2 {
+			return nil, fmt.Errorf("expected stream name and messages, got %d elements", len(sv))
+		}
+
+		msgs, err := parseMessages(sv[1])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, msgs...)
+	}
+
+	return out, nil
+}
+
+// claim claims the events of the given consumer group which have been
+// delivered to an other consumer, but which have not been acknowledged
+// within claimMinIdle (e.g. as the instance has left the cluster).
+func (b *RedisBus) claim(group string) ([]streamMessage, error) {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	pending, err := redis.Values(c.Do("XPENDING", eventsStream, group, "-", "+", readCount))
+	if err != nil {
+		return nil, errors.Wrap(err, "get pending events error")
+	}
+
+	args := redis.Args{eventsStream, group, b.consumer, int64(claimMinIdle / time.Millisecond)}
+	var ids int
+	for _, p := range pending {
+		pv, err := redis.Values(p, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(pv) != 4 {
+			return nil, fmt.Errorf("expected 4 pending event elements, got %d", len(pv))
+		}
+
+		id, _ := redis.String(pv[0], nil)
+		consumer, _ := redis.String(pv[1], nil)
+		idle, _ := redis.Int64(pv[2], nil)
+
+		if consumer == b.consumer || time.Duration(idle)*time.Millisecond < claimMinIdle {
+			continue
+		}
+		args = args.Add(id)
+		ids++
+	}
+
+	if ids == 0 {
+		return nil, nil
+	}
+
+	reply, err := c.Do("XCLAIM", args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "claim events error")
+	}
+
+	return parseMessages(reply)
+}
+
+// handle handles and acknowledges the given messages. Handler errors are
+// logged, the event is not handled again.
+func (b *RedisBus) handle(s Subscription, msgs []streamMessage) {
+	for _, msg := range msgs {
+		if msg.event != nil {
+			var e Event
+			if err := json.Unmarshal(msg.event, &e); err != nil {
+				log.WithError(err).Error("eventbus: unmarshal event error")
+			} else if s.matches(e.Type) {
+				if err := s.Handler(e); err != nil {
+					log.WithFields(log.Fields{
+						"subscription": s.Name,
+						"type":         e.Type,
+						"event_id":     e.ID,
+						"dev_eui":      e.DevEUI,
+					}).WithError(err).Error("eventbus: handle event error")
+				}
+			}
+		}
+
+		if err := ack(s.Name, msg.id); err != nil {
+			log.WithFields(log.Fields{
+				"subscription": s.Name,
+				"message_id":   msg.id,
+			}).WithError(err).Error("eventbus: acknowledge event error")
+		}
+	}
+}
+
+// createGroup creates the given consumer group (and the stream). It is not
+// an error when the group already exists.
+func createGroup(group string) error {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	_, err := c.Do("XGROUP", "CREATE", eventsStream, group, "$", "MKSTREAM")
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	return nil
+}
+
+// ack acknowledges the message with the given id for the given consumer
+// group.
+func ack(group, id string) error {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	_, err := c.Do("XACK", eventsStream, group, id)
+	return err
+}
+
+// parseMessages parses the given list of stream messages.
+func parseMessages(reply interface{}) ([]streamMessage, error) {
+	msgs, err := redis.Values(reply, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]streamMessage, 0, len(msgs))
+	for _, m := range msgs {
+		// claimed messages which have been removed from the stream are
+		// returned as nil by older Redis versions
+		if m == nil {
+			continue
+		}
+
+		mv, err := redis.Values(m, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(mv) != 2 {
+			return nil, fmt.Errorf("expected message id and fields, got %d elements", len(mv))
+		}
+
+		var msg streamMessage
+		msg.id, err = redis.String(mv[0], nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// the fields are nil when the message has been removed from the
+		// stream
+		if mv[1] != nil {
+			fields, err := redis.ByteSlices(mv[1], nil)
+			if err != nil {
+				return nil, err
+			}
+			for i := 0; i+1 < len(fields); i += 2 {
+				if string(fields[i]) == eventField {
+					msg.event = fields[i+1]
+				}
+			}
+		}
+
+		out = append(out, msg)
+	}
+
+	return out, nil
+}
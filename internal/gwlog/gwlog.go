@@ -0,0 +1,185 @@
+// Package gwlog implements the relaying of packet-forwarder log excerpts,
+// pushed by the gateways (e.g. through the LoRa Gateway Bridge), so that
+// common gateway issues can be triaged without SSH access to the gateway.
+//
+// A log excerpt is requested through the API, after which the gateway picks
+// up the pending request and pushes the last log lines. The excerpts are
+// stored temporarily in Redis.
+package gwlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+const (
+	requestKeyTempl  = "lora:as:gwlog:request:%s"
+	excerptsKeyTempl = "lora:as:gwlog:excerpts:%s"
+)
+
+// Limits of the log excerpts.
+const (
+	// RequestTTL defines how long a request stays pending when it is not
+	// picked up by the gateway.
+	RequestTTL = 15 * time.Minute
+
+	// ExcerptTTL defines how long the excerpts of a gateway are stored
+	// after the last excerpt has been pushed.
+	ExcerptTTL = 24 * time.Hour
+
+	// MaxExcerpts defines the max. number of excerpts stored per gateway.
+	MaxExcerpts = 10
+
+	// MaxLines defines the max. number of lines of an excerpt.
+	MaxLines = 1000
+
+	// DefaultLines defines the number of lines requested when not set.
+	DefaultLines = 100
+)
+
+// ErrNoPendingRequest is returned when there is no pending request for the
+// gateway.
+var ErrNoPendingRequest = errors.New("no pending log request")
+
+// Request defines a pending request for a log excerpt.
+type Request struct {
+	ID          uuid.UUID `json:"id"`
+	Lines       int       `json:"lines"`
+	RequestedAt time.Time `json:"requestedAt"`
+}
+
+// Excerpt defines a log excerpt pushed by the gateway.
+type Excerpt struct {
+	RequestID  uuid.UUID `json:"requestID"`
+	ReceivedAt time.Time `json:"receivedAt"`
+	Lines      []string  `json:"lines"`
+}
+
+// CreateRequest creates a request for the last given number of log lines of
+// the given gateway. It replaces the pending request, if any.
+func CreateRequest(gatewayID lorawan.EUI64, lines int) (Request, error) {
+	if lines <= 0 {
+		lines = DefaultLines
+	}
+	if lines > MaxLines {
+		lines = MaxLines
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return Request{}, errors.Wrap(err, "new uuid v4 error")
+	}
+
+	req := Request{
+		ID:          id,
+		Lines:       lines,
+		RequestedAt: time.Now(),
+	}
+
+	b, err := json.Marshal(req)
+	if err != nil {
+		return req, errors.Wrap(err, "marshal request error")
+	}
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	_, err = c.Do("PSETEX", fmt.Sprintf(requestKeyTempl, gatewayID), int64(RequestTTL/time.Millisecond), b)
+	if err != nil {
+		return req, errors.Wrap(err, "store request error")
+	}
+
+	return req, nil
+}
+
+// GetPendingRequest returns the pending request for the given gateway.
+// ErrNoPendingRequest is returned when there is no pending request.
+func GetPendingRequest(gatewayID lorawan.EUI64) (Request, error) {
+	var req Request
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	b, err := redis.Bytes(c.Do("GET", fmt.Sprintf(requestKeyTempl, gatewayID)))
+	if err != nil {
+		if err == redis.ErrNil {
+			return req, ErrNoPendingRequest
+		}
+		return req, errors.Wrap(err, "get request error")
+	}
+
+	if err := json.Unmarshal(b, &req); err != nil {
+		return req, errors.Wrap(err, "unmarshal request error")
+	}
+
+	return req, nil
+}
+
+// StoreExcerpt stores the given excerpt for the given gateway. When the
+// excerpt is the response to the pending request, the request is removed.
+// Only the last MaxLines lines are stored.
+func StoreExcerpt(gatewayID lorawan.EUI64, e Excerpt) error {
+	if len(e.Lines) > MaxLines {
+		e.Lines = e.Lines[len(e.Lines)-MaxLines:]
+	}
+	e.ReceivedAt = time.Now()
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "marshal excerpt error")
+	}
+
+	pending, err := GetPendingRequest(gatewayID)
+	if err != nil && err != ErrNoPendingRequest {
+		return errors.Wrap(err, "get pending request error")
+	}
+
+	key := fmt.Sprintf(excerptsKeyTempl, gatewayID)
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("LPUSH", key, b)
+	c.Send("LTRIM", key, 0, MaxExcerpts-1)
+	c.Send("PEXPIRE", key, int64(ExcerptTTL/time.Millisecond))
+	if err == nil && pending.ID == e.RequestID {
+		c.Send("DEL", fmt.Sprintf(requestKeyTempl, gatewayID))
+	}
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "store excerpt error")
+	}
+
+	return nil
+}
+
+// GetExcerpts returns the stored excerpts for the given gateway, the most
+// recent excerpt first.
+func GetExcerpts(gatewayID lorawan.EUI64) ([]Excerpt, error) {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	values, err := redis.ByteSlices(c.Do("LRANGE", fmt.Sprintf(excerptsKeyTempl, gatewayID), 0, -1))
+	if err != nil {
+		return nil, errors.Wrap(err, "get excerpts error")
+	}
+
+	var out []Excerpt
+	for _, b := range values {
+		var e Excerpt
+		if err := json.Unmarshal(b, &e); err != nil {
+			return nil, errors.Wrap(err, "unmarshal excerpt error")
+		}
+		out = append(out, e)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,147 @@
+package gwlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func doRequest(h http.Handler, method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	var b []byte
+	if body != nil {
+		b, _ = json.Marshal(body)
+	}
+
+	req := httptest.NewRequest(method, path, bytes.NewReader(b))
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestHandlerAuthentication(t *testing.T) {
+	h := NewHandler("secret")
+	token := GatewayToken("secret", lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		assert := require.New(t)
+		w := doRequest(h, "GET", BasePath+"/0102030405060708/request", "secret", nil)
+		assert.Equal(http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Token of other gateway", func(t *testing.T) {
+		assert := require.New(t)
+		w := doRequest(h, "GET", BasePath+"/0807060504030201/request", token, nil)
+		assert.Equal(http.StatusUnauthorized, w.Code)
+
+		w = doRequest(h, "POST", BasePath+"/0807060504030201", token, pushRequest{})
+		assert.Equal(http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Invalid gateway id", func(t *testing.T) {
+		assert := require.New(t)
+		w := doRequest(h, "GET", BasePath+"/0102/request", token, nil)
+		assert.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGatewayLog(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	assert.NoError(storage.Setup(conf))
+	test.MustResetDB(storage.DB().DB)
+	test.MustFlushRedis(storage.RedisPool())
+
+	networkserver.SetPool(mock.NewPool(mock.NewClient()))
+
+	n := storage.NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(storage.CreateNetworkServer(storage.DB(), &n))
+
+	org := storage.Organization{
+		Name: "test-org",
+	}
+	assert.NoError(storage.CreateOrganization(storage.DB(), &org))
+
+	gw := storage.Gateway{
+		MAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		Name:            "test-gw",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(storage.CreateGateway(storage.DB(), &gw))
+
+	h := NewHandler("secret")
+	token := GatewayToken("secret", gw.MAC)
+
+	t.Run("No pending request", func(t *testing.T) {
+		assert := require.New(t)
+
+		w := doRequest(h, "GET", BasePath+"/0102030405060708/request", token, nil)
+		assert.Equal(http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Unknown gateway", func(t *testing.T) {
+		assert := require.New(t)
+
+		w := doRequest(h, "GET", BasePath+"/0807060504030201/request", GatewayToken("secret", lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}), nil)
+		assert.Equal(http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Request and push excerpt", func(t *testing.T) {
+		assert := require.New(t)
+
+		req, err := CreateRequest(gw.MAC, 5000)
+		assert.NoError(err)
+		assert.Equal(MaxLines, req.Lines)
+
+		w := doRequest(h, "GET", BasePath+"/0102030405060708/request", token, nil)
+		assert.Equal(http.StatusOK, w.Code)
+
+		var pending Request
+		assert.NoError(json.Unmarshal(w.Body.Bytes(), &pending))
+		assert.Equal(req.ID, pending.ID)
+
+		w = doRequest(h, "POST", BasePath+"/0102030405060708", token, pushRequest{
+			RequestID: req.ID,
+			Lines:     []string{"INFO: concentrator started", "ERROR: [up] PULL_ACK timeout"},
+		})
+		assert.Equal(http.StatusOK, w.Code)
+
+		_, err = GetPendingRequest(gw.MAC)
+		assert.Equal(ErrNoPendingRequest, err)
+
+		excerpts, err := GetExcerpts(gw.MAC)
+		assert.NoError(err)
+		assert.Len(excerpts, 1)
+		assert.Equal(req.ID, excerpts[0].RequestID)
+		assert.Equal([]string{"INFO: concentrator started", "ERROR: [up] PULL_ACK timeout"}, excerpts[0].Lines)
+		assert.False(excerpts[0].ReceivedAt.IsZero())
+	})
+
+	t.Run("Max excerpts", func(t *testing.T) {
+		assert := require.New(t)
+
+		for i := 0; i < MaxExcerpts+2; i++ {
+			assert.NoError(StoreExcerpt(gw.MAC, Excerpt{RequestID: uuid.Nil, Lines: []string{"line"}}))
+		}
+
+		excerpts, err := GetExcerpts(gw.MAC)
+		assert.NoError(err)
+		assert.Len(excerpts, MaxExcerpts)
+	})
+}
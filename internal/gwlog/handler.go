@@ -0,0 +1,147 @@
+package gwlog
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/api/helpers"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// BasePath defines the path under which the gateway log endpoints are
+// served.
+const BasePath = "/gateway-log"
+
+const maxBodySize = 1 << 20
+
+// pushRequest defines the body of an excerpt pushed by a gateway.
+type pushRequest struct {
+	RequestID uuid.UUID `json:"requestID"`
+	Lines     []string  `json:"lines"`
+}
+
+// GatewayToken returns the bearer token of the given gateway, derived from
+// the given secret. As the token is bound to the gateway ID, a gateway is not
+// able to pick up the requests or push the excerpts of other gateways.
+func GatewayToken(secret string, gatewayID lorawan.EUI64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(gatewayID.String()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewHandler returns a new handler for the gateways (or the gateway bridge)
+// to pick up the pending request (GET {BasePath}/{gatewayID}/request, 204 when
+// there is no pending request) and to push the log excerpts (POST
+// {BasePath}/{gatewayID}). Requests must be authenticated using the bearer
+// token of the gateway (see GatewayToken), derived from the given secret.
+func NewHandler(secret string) http.Handler {
+	r := mux.NewRouter()
+	r.HandleFunc(BasePath+"/{gatewayID}/request", authenticate(secret, handleGetRequest)).Methods("GET")
+	r.HandleFunc(BasePath+"/{gatewayID}", authenticate(secret, handlePushExcerpt)).Methods("POST")
+
+	return r
+}
+
+// authenticate validates that the request is authenticated using the bearer
+// token of the gateway of the request path, before calling the given handler.
+func authenticate(secret string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var gatewayID lorawan.EUI64
+		if err := gatewayID.UnmarshalText([]byte(mux.Vars(r)["gatewayID"])); err != nil {
+			http.Error(w, "invalid gateway id", http.StatusBadRequest)
+			return
+		}
+
+		if t, ok := helpers.BearerToken(r); !ok || !helpers.ValidateBearerToken(t, GatewayToken(secret, gatewayID)) {
+			http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func handleGetRequest(w http.ResponseWriter, r *http.Request) {
+	gatewayID, ok := getGatewayID(w, r)
+	if !ok {
+		return
+	}
+
+	req, err := GetPendingRequest(gatewayID)
+	if err != nil {
+		if err == ErrNoPendingRequest {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		log.WithError(err).WithField("gateway_id", gatewayID).Error("gwlog: get pending request error")
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(req)
+}
+
+func handlePushExcerpt(w http.ResponseWriter, r *http.Request) {
+	gatewayID, ok := getGatewayID(w, r)
+	if !ok {
+		return
+	}
+
+	var pl pushRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&pl); err != nil {
+		http.Error(w, "decode body error: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err := StoreExcerpt(gatewayID, Excerpt{
+		RequestID: pl.RequestID,
+		Lines:     pl.Lines,
+	})
+	if err != nil {
+		log.WithError(err).WithField("gateway_id", gatewayID).Error("gwlog: store excerpt error")
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"gateway_id": gatewayID,
+		"request_id": pl.RequestID,
+		"lines":      len(pl.Lines),
+	}).Info("gwlog: log excerpt received")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// getGatewayID returns the gateway ID from the request path. It writes the
+// error response when the gateway ID is invalid or when the gateway does not
+// exist.
+func getGatewayID(w http.ResponseWriter, r *http.Request) (lorawan.EUI64, bool) {
+	var gatewayID lorawan.EUI64
+	if err := gatewayID.UnmarshalText([]byte(mux.Vars(r)["gatewayID"])); err != nil {
+		http.Error(w, "invalid gateway id", http.StatusBadRequest)
+		return gatewayID, false
+	}
+
+	if _, err := storage.GetGateway(storage.DB(), gatewayID, false); err != nil {
+		if err == storage.ErrDoesNotExist {
+			http.Error(w, "gateway does not exist", http.StatusNotFound)
+			return gatewayID, false
+		}
+
+		log.WithError(err).WithField("gateway_id", gatewayID).Error("gwlog: get gateway error")
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return gatewayID, false
+	}
+
+	return gatewayID, true
+}
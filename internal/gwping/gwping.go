@@ -14,6 +14,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/cluster"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/as"
 	"github.com/brocaar/loraserver/api/ns"
@@ -28,8 +29,10 @@ const (
 // SendPingLoop is a never returning function sending the gateway pings.
 func SendPingLoop() {
 	for {
-		if err := sendGatewayPing(); err != nil {
-			log.Errorf("send gateway ping error: %s", err)
+		if cluster.IsLeader(cluster.RoleGatewayPing) {
+			if err := sendGatewayPing(); err != nil {
+				log.Errorf("send gateway ping error: %s", err)
+			}
 		}
 		time.Sleep(time.Second)
 	}
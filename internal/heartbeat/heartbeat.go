@@ -0,0 +1,107 @@
+// Package heartbeat implements the heartbeat notifications, which are
+// periodically published to the integrations of the applications for which
+// a heartbeat interval has been configured.
+package heartbeat
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/cluster"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// SendHeartbeatsLoop is a never returning function sending the heartbeats.
+func SendHeartbeatsLoop() {
+	for {
+		if cluster.IsLeader(cluster.RoleHeartbeats) {
+			if err := sendHeartbeats(); err != nil {
+				log.Errorf("send heartbeats error: %s", err)
+			}
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// sendHeartbeats sends the heartbeats of all the applications for which a
+// heartbeat is due.
+func sendHeartbeats() error {
+	for {
+		var sent bool
+		err := storage.Transaction(func(tx sqlx.Ext) error {
+			app, err := getApplicationForHeartbeat(tx)
+			if err != nil {
+				return errors.Wrap(err, "get application for heartbeat error")
+			}
+			if app == nil {
+				return nil
+			}
+
+			if err := sendHeartbeat(tx, *app); err != nil {
+				return errors.Wrap(err, "send heartbeat error")
+			}
+
+			sent = true
+			return nil
+		})
+		if err != nil || !sent {
+			return err
+		}
+	}
+}
+
+// sendHeartbeat publishes the heartbeat of the given application and
+// updates its last heartbeat timestamp.
+func sendHeartbeat(db sqlx.Execer, app storage.Application) error {
+	now := time.Now()
+
+	if _, err := db.Exec("update application set last_heartbeat_at = $2 where id = $1", app.ID, now); err != nil {
+		return errors.Wrap(err, "update last heartbeat error")
+	}
+
+	err := integration.Integration().SendHeartbeatNotification(integration.HeartbeatNotification{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		Time:            now,
+	})
+	if err != nil {
+		return errors.Wrap(err, "send heartbeat notification error")
+	}
+
+	log.WithFields(log.Fields{
+		"application_id": app.ID,
+	}).Info("application heartbeat sent")
+
+	return nil
+}
+
+// getApplicationForHeartbeat returns the next application for which a
+// heartbeat must be sent. If no heartbeat is due, nil is returned.
+func getApplicationForHeartbeat(tx sqlx.Queryer) (*storage.Application, error) {
+	var app storage.Application
+
+	err := sqlx.Get(tx, &app, `
+		select
+			*
+		from application
+		where
+			heartbeat_interval > 0
+			and (last_heartbeat_at is null or last_heartbeat_at <= (now() - (heartbeat_interval / 1000) * interval '1 microsecond'))
+		order by last_heartbeat_at nulls first
+		limit 1
+		for update skip locked`,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "select error")
+	}
+
+	return &app, nil
+}
@@ -0,0 +1,97 @@
+package heartbeat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	intmock "github.com/brocaar/lora-app-server/internal/integration/mock"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestHeartbeat(t *testing.T) {
+	conf := test.GetConfig()
+	if err := storage.Setup(conf); err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Given a clean database and an application with a heartbeat interval", t, func() {
+		test.MustResetDB(storage.DB().DB)
+		networkserver.SetPool(mock.NewPool(mock.NewClient()))
+
+		h := intmock.New()
+		integration.SetIntegration(h)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(storage.DB(), &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(storage.DB(), &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateServiceProfile(storage.DB(), &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:    org.ID,
+			ServiceProfileID:  spID,
+			Name:              "test-app",
+			HeartbeatInterval: time.Minute,
+		}
+		So(storage.CreateApplication(storage.DB(), &app), ShouldBeNil)
+
+		Convey("When calling sendHeartbeats", func() {
+			So(sendHeartbeats(), ShouldBeNil)
+
+			Convey("Then a heartbeat notification was sent", func() {
+				So(h.SendHeartbeatNotificationChan, ShouldHaveLength, 1)
+				So(h.SendDataUpChan, ShouldHaveLength, 0)
+				pl := <-h.SendHeartbeatNotificationChan
+				So(pl.ApplicationID, ShouldEqual, app.ID)
+				So(pl.ApplicationName, ShouldEqual, "test-app")
+				So(pl.Time.IsZero(), ShouldBeFalse)
+			})
+
+			Convey("Then the last heartbeat timestamp was set", func() {
+				appGet, err := storage.GetApplication(storage.DB(), app.ID)
+				So(err, ShouldBeNil)
+				So(appGet.LastHeartbeatAt, ShouldNotBeNil)
+			})
+
+			Convey("When calling sendHeartbeats again within the interval", func() {
+				<-h.SendHeartbeatNotificationChan
+				So(sendHeartbeats(), ShouldBeNil)
+
+				Convey("Then no heartbeat was sent", func() {
+					So(h.SendHeartbeatNotificationChan, ShouldHaveLength, 0)
+				})
+			})
+		})
+
+		Convey("When the heartbeat is disabled", func() {
+			app.HeartbeatInterval = 0
+			So(storage.UpdateApplication(storage.DB(), app), ShouldBeNil)
+
+			Convey("Then sendHeartbeats does not send a heartbeat", func() {
+				So(sendHeartbeats(), ShouldBeNil)
+				So(h.SendHeartbeatNotificationChan, ShouldHaveLength, 0)
+			})
+		})
+	})
+}
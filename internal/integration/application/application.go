@@ -5,6 +5,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -16,8 +18,9 @@ import (
 )
 
 // Integration implements the application integration wrapper.
-// Per request it will fetch the application integrations and forward the
-// request to these integrations.
+// Per request it will fetch the application integrations (including the
+// integrations inherited from the organization) and forward the request to
+// these integrations.
 type Integration struct{}
 
 // New creates a new application integration.
@@ -27,68 +30,51 @@ func New() *Integration {
 
 // SendDataUp sends an uplink payload.
 func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
-	if err != nil {
-		return errors.Wrap(err, "get application integration error")
-	}
-	defer multi.Close()
-
-	return multi.SendDataUp(pl)
+	return i.send(pl.ApplicationID, func(ii integration.Integrator) error {
+		return ii.SendDataUp(pl)
+	})
 }
 
 // SendJoinNotification sends a join notification.
 func (i *Integration) SendJoinNotification(pl integration.JoinNotification) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
-	if err != nil {
-		return errors.Wrap(err, "get appplication integration error")
-	}
-	defer multi.Close()
-
-	return multi.SendJoinNotification(pl)
+	return i.send(pl.ApplicationID, func(ii integration.Integrator) error {
+		return ii.SendJoinNotification(pl)
+	})
 }
 
 // SendACKNotification sends an ACK notification.
 func (i *Integration) SendACKNotification(pl integration.ACKNotification) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
-	if err != nil {
-		return errors.Wrap(err, "get appplication integration error")
-	}
-	defer multi.Close()
-
-	return multi.SendACKNotification(pl)
+	return i.send(pl.ApplicationID, func(ii integration.Integrator) error {
+		return ii.SendACKNotification(pl)
+	})
 }
 
 // SendErrorNotification sends an error notification.
 func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
-	if err != nil {
-		return errors.Wrap(err, "get appplication integration error")
-	}
-	defer multi.Close()
-
-	return multi.SendErrorNotification(pl)
+	return i.send(pl.ApplicationID, func(ii integration.Integrator) error {
+		return ii.SendErrorNotification(pl)
+	})
 }
 
 // SendStatusNotification sends a status notification.
 func (i *Integration) SendStatusNotification(pl integration.StatusNotification) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
-	if err != nil {
-		return errors.Wrap(err, "get appplication integration error")
-	}
-	defer multi.Close()
-
-	return multi.SendStatusNotification(pl)
+	return i.send(pl.ApplicationID, func(ii integration.Integrator) error {
+		return ii.SendStatusNotification(pl)
+	})
 }
 
 // SendLocationNotification sends a location notification.
 func (i *Integration) SendLocationNotification(pl integration.LocationNotification) error {
-	multi, err := i.getApplicationIntegration(pl.ApplicationID)
-	if err != nil {
-		return errors.Wrap(err, "get appplication integration error")
-	}
-	defer multi.Close()
+	return i.send(pl.ApplicationID, func(ii integration.Integrator) error {
+		return ii.SendLocationNotification(pl)
+	})
+}
 
-	return multi.SendLocationNotification(pl)
+// SendHeartbeatNotification sends a heartbeat notification.
+func (i *Integration) SendHeartbeatNotification(pl integration.HeartbeatNotification) error {
+	return i.send(pl.ApplicationID, func(ii integration.Integrator) error {
+		return ii.SendHeartbeatNotification(pl)
+	})
 }
 
 // DataDownChan return nil.
@@ -96,18 +82,55 @@ func (i *Integration) DataDownChan() chan integration.DataDownPayload {
 	return nil
 }
 
-// Close closes the integration.
+// Close closes the integration. The pending batches of the HTTP
+// integrations are posted.
 func (i *Integration) Close() error {
+	http.FlushBatches()
+	return nil
+}
+
+// send calls the given function for each integration of the given
+// application (concurrently). Unlike the multi integration, it returns an
+// error when one of the integrations failed, so that the event can be
+// retried (e.g. by the event journal).
+func (i *Integration) send(applicationID int64, f func(integration.Integrator) error) error {
+	mi, err := i.getApplicationIntegration(applicationID)
+	if err != nil {
+		return errors.Wrap(err, "get application integration error")
+	}
+	defer mi.Close()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []string
+
+	for _, ii := range mi.Integrations() {
+		wg.Add(1)
+		go func(ii integration.Integrator) {
+			defer wg.Done()
+			if err := f(ii); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Sprintf("%T: %s", ii, err))
+				mu.Unlock()
+			}
+		}(ii)
+	}
+	wg.Wait()
+
+	if len(errs) != 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+
 	return nil
 }
 
-func (i *Integration) getApplicationIntegration(id int64) (integration.Integrator, error) {
+func (i *Integration) getApplicationIntegration(id int64) (*multi.Integration, error) {
 	var configs []interface{}
 
 	// read integrations
-	appints, err := storage.GetIntegrationsForApplicationID(storage.DB(), id)
+	appints, err := storage.GetEffectiveIntegrationsForApplicationID(storage.DB(), id)
 	if err != nil {
-		return nil, errors.Wrap(err, "get integrations for application id error")
+		return nil, errors.Wrap(err, "get effective integrations for application id error")
 	}
 
 	// unmarshal configurations
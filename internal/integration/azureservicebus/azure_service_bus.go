@@ -102,6 +102,11 @@ func (i *Integration) SendLocationNotification(pl integration.LocationNotificati
 	return i.publish("location", pl.ApplicationID, pl.DevEUI, pl)
 }
 
+// SendHeartbeatNotification sends a heartbeat notification.
+func (i *Integration) SendHeartbeatNotification(pl integration.HeartbeatNotification) error {
+	return i.publish("heartbeat", pl.ApplicationID, lorawan.EUI64{}, pl)
+}
+
 // DataDownChan return nil.
 func (i *Integration) DataDownChan() chan integration.DataDownPayload {
 	return nil
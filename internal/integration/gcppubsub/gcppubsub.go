@@ -102,6 +102,12 @@ func (i *Integration) SendLocationNotification(pl integration.LocationNotificati
 	return i.publish("location", pl.DevEUI, pl)
 }
 
+// SendHeartbeatNotification sends a heartbeat notification. As the heartbeat
+// does not belong to a device, the devEUI attribute is empty.
+func (i *Integration) SendHeartbeatNotification(pl integration.HeartbeatNotification) error {
+	return i.publish("heartbeat", lorawan.EUI64{}, pl)
+}
+
 // DataDownChan return nil.
 func (i *Integration) DataDownChan() chan integration.DataDownPayload {
 	return nil
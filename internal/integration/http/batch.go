@@ -0,0 +1,191 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Batch limits.
+const (
+	MaxBatchSize       = 1000
+	MaxBatchIntervalMS = 60000
+)
+
+// batchEvent contains a single event of a batch. The event ID and callback
+// URL are set under the same conditions as the X-LoRa-Event-ID and
+// X-LoRa-Callback-URL headers of a single event.
+type batchEvent struct {
+	Type        string          `json:"type"`
+	EventID     string          `json:"eventID,omitempty"`
+	CallbackURL string          `json:"callbackURL,omitempty"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+// batchPayload is the body posted for a batch of events.
+type batchPayload struct {
+	Events []batchEvent `json:"events"`
+}
+
+// batch contains the events waiting to be posted to an URL.
+type batch struct {
+	integration *Integration
+	url         string
+	events      []batchEvent
+	timer       *time.Timer
+}
+
+// As a new integration instance is created for every event, the pending
+// batches are kept at package level, keyed by URL and integration config.
+var (
+	batchesMux sync.Mutex
+	batches    = make(map[string]*batch)
+)
+
+// batchDisabled is set when the events are delivered through the event
+// journal. As a batched event is acknowledged before it has been posted,
+// batching would break the at-least-once delivery of the journal.
+var batchDisabled bool
+
+// batchKey returns the key of the batch for the given URL. Integrations
+// posting to the same URL but with a different configuration (e.g. headers
+// or callback secret) do not share a batch.
+func (i *Integration) batchKey(url string) (string, error) {
+	b, err := json.Marshal(struct {
+		Headers         map[string]string
+		CallbackSecret  string
+		Compression     string
+		BatchSize       int
+		BatchIntervalMS int
+	}{
+		Headers:         i.config.Headers,
+		CallbackSecret:  i.config.CallbackSecret,
+		Compression:     i.config.Compression,
+		BatchSize:       i.config.BatchSize,
+		BatchIntervalMS: i.config.BatchIntervalMS,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "marshal json error")
+	}
+
+	return url + "\x00" + string(b), nil
+}
+
+// enqueue adds the event to the batch for the given URL. The batch is
+// posted when it contains BatchSize events, or when BatchIntervalMS has
+// elapsed since the first event was added, whichever comes first.
+func (i *Integration) enqueue(url, event string, applicationID int64, devEUI lorawan.EUI64, payload []byte) error {
+	key, err := i.batchKey(url)
+	if err != nil {
+		return err
+	}
+
+	be := batchEvent{
+		Type:    event,
+		Payload: json.RawMessage(payload),
+	}
+	if i.config.CallbackSecret != "" {
+		be.EventID, be.CallbackURL, err = newCallback([]byte(i.config.CallbackSecret), applicationID, devEUI)
+		if err != nil {
+			return errors.Wrap(err, "new callback error")
+		}
+	}
+
+	batchesMux.Lock()
+	b, ok := batches[key]
+	if !ok {
+		b = &batch{
+			integration: i,
+			url:         url,
+		}
+		batches[key] = b
+		b.timer = time.AfterFunc(time.Duration(i.config.BatchIntervalMS)*time.Millisecond, func() {
+			flushBatch(key, b)
+		})
+	}
+
+	b.events = append(b.events, be)
+
+	full := len(b.events) >= i.config.BatchSize
+	if full {
+		delete(batches, key)
+		b.timer.Stop()
+	}
+	batchesMux.Unlock()
+
+	if full {
+		go b.send()
+	}
+
+	return nil
+}
+
+// flushBatch posts the given batch when it has not been posted yet.
+func flushBatch(key string, b *batch) {
+	batchesMux.Lock()
+	if batches[key] != b {
+		// the batch was full and has already been posted
+		batchesMux.Unlock()
+		return
+	}
+	delete(batches, key)
+	batchesMux.Unlock()
+
+	b.send()
+}
+
+// FlushBatches posts all the pending batches and waits until these have
+// been posted. It must be called on shutdown, so that the pending events
+// are not lost.
+func FlushBatches() {
+	batchesMux.Lock()
+	pending := batches
+	batches = make(map[string]*batch)
+	for _, b := range pending {
+		b.timer.Stop()
+	}
+	batchesMux.Unlock()
+
+	var wg sync.WaitGroup
+	for _, b := range pending {
+		wg.Add(1)
+		go func(b *batch) {
+			defer wg.Done()
+			b.send()
+		}(b)
+	}
+	wg.Wait()
+}
+
+// send posts the batch. As the events have already been accepted, errors
+// are logged.
+func (b *batch) send() {
+	body, err := json.Marshal(batchPayload{Events: b.events})
+	if err != nil {
+		log.WithError(err).Error("integration/http: marshal batch error")
+		return
+	}
+
+	h := make(http.Header)
+	if b.integration.config.CallbackSecret != "" {
+		h.Set(SignatureHeader, sign([]byte(b.integration.config.CallbackSecret), body))
+	}
+
+	log.WithFields(log.Fields{
+		"url":    b.url,
+		"events": len(b.events),
+	}).Info("integration/http: publishing batch")
+
+	if err := b.integration.post(b.url, body, h); err != nil {
+		log.WithFields(log.Fields{
+			"url":    b.url,
+			"events": len(b.events),
+		}).WithError(err).Error("integration/http: publish batch error")
+	}
+}
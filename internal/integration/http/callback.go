@@ -0,0 +1,319 @@
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// CallbackPath defines the path under which the downlink callbacks are
+// served by the external API.
+const CallbackPath = "/integration/http/callback"
+
+// Headers added to the posted events when a callback secret is configured.
+const (
+	EventIDHeader     = "X-LoRa-Event-ID"
+	CallbackURLHeader = "X-LoRa-Callback-URL"
+	SignatureHeader   = "X-LoRa-Signature"
+)
+
+// maxCallbackBodySize defines the max size of a callback request body.
+const maxCallbackBodySize = 1 << 20
+
+// callbackEventKeyTempl defines the key under which the used callback events
+// are stored until the callback URL expires.
+const callbackEventKeyTempl = "lora:as:integration:http:callback:%d:%s"
+
+// errCallbackUsed is returned when the callback URL has already been used.
+var errCallbackUsed = errors.New("callback url has already been used")
+
+var (
+	callbackBaseURL string
+	callbackTTL     time.Duration
+)
+
+// Setup configures the HTTP integration callbacks and batching.
+func Setup(conf config.Config) error {
+	callbackBaseURL = strings.TrimRight(conf.ApplicationServer.Integration.HTTP.CallbackBaseURL, "/")
+	callbackTTL = conf.ApplicationServer.Integration.HTTP.CallbackTTL
+	batchDisabled = conf.ApplicationServer.Integration.Journal.Enabled
+	return nil
+}
+
+// setCallbackHeaders signs the body and, when a callback base URL is
+// configured and the event belongs to a device, adds the event ID and the
+// signed callback URL to the headers.
+func setCallbackHeaders(h http.Header, secret []byte, applicationID int64, devEUI lorawan.EUI64, body []byte) error {
+	h.Set(SignatureHeader, sign(secret, body))
+
+	eventID, u, err := newCallback(secret, applicationID, devEUI)
+	if err != nil || eventID == "" {
+		return err
+	}
+
+	h.Set(EventIDHeader, eventID)
+	h.Set(CallbackURLHeader, u)
+
+	return nil
+}
+
+// newCallback returns a new event ID and the signed callback URL for this
+// event. Empty strings are returned when no callback base URL is configured
+// or when the event does not belong to a device.
+func newCallback(secret []byte, applicationID int64, devEUI lorawan.EUI64) (string, string, error) {
+	if callbackBaseURL == "" || devEUI == (lorawan.EUI64{}) {
+		return "", "", nil
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", errors.Wrap(err, "read random bytes error")
+	}
+	eventID := hex.EncodeToString(b)
+	expires := time.Now().Add(callbackTTL).Unix()
+
+	return eventID, callbackURL(secret, applicationID, devEUI, eventID, expires), nil
+}
+
+// callbackURL returns the signed callback URL for the given event.
+func callbackURL(secret []byte, applicationID int64, devEUI lorawan.EUI64, eventID string, expires int64) string {
+	q := url.Values{}
+	q.Set("event", eventID)
+	q.Set("expires", strconv.FormatInt(expires, 10))
+	q.Set("signature", sign(secret, callbackMessage(applicationID, devEUI, eventID, expires)))
+
+	return fmt.Sprintf("%s%s/applications/%d/devices/%s/queue?%s", callbackBaseURL, CallbackPath, applicationID, devEUI, q.Encode())
+}
+
+func callbackMessage(applicationID int64, devEUI lorawan.EUI64, eventID string, expires int64) []byte {
+	return []byte(fmt.Sprintf("%d:%s:%s:%d", applicationID, devEUI, eventID, expires))
+}
+
+// sign returns the hex encoded HMAC-SHA256 of the given message.
+func sign(secret, msg []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(msg)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// validSignature returns true when the given hex encoded signature matches
+// the HMAC-SHA256 of the given message.
+func validSignature(secret, msg []byte, signature string) bool {
+	b, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(msg)
+	return hmac.Equal(b, mac.Sum(nil))
+}
+
+// validateCallback validates the signed callback URL query parameters and
+// the signature of the request body.
+func validateCallback(secret []byte, applicationID int64, devEUI lorawan.EUI64, q url.Values, body []byte, bodySignature string) error {
+	eventID := q.Get("event")
+	expires, err := strconv.ParseInt(q.Get("expires"), 10, 64)
+	if err != nil {
+		return errors.New("invalid expires parameter")
+	}
+
+	if !validSignature(secret, callbackMessage(applicationID, devEUI, eventID, expires), q.Get("signature")) {
+		return errors.New("invalid callback url signature")
+	}
+
+	if time.Now().Unix() > expires {
+		return errors.New("callback url expired")
+	}
+
+	if !validSignature(secret, body, bodySignature) {
+		return errors.New("invalid body signature")
+	}
+
+	return nil
+}
+
+// NewCallbackHandler returns the handler for the downlink callbacks. The
+// downstream system posts the downlink payload to the callback URL it
+// received with an event, signing the body with the callback secret of the
+// HTTP integration of the application.
+func NewCallbackHandler() http.Handler {
+	r := mux.NewRouter()
+	r.HandleFunc(CallbackPath+"/applications/{applicationID}/devices/{devEUI}/queue", handleCallback).Methods("POST")
+	return r
+}
+
+func handleCallback(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	applicationID, err := strconv.ParseInt(vars["applicationID"], 10, 64)
+	if err != nil {
+		http.Error(w, "invalid application id", http.StatusBadRequest)
+		return
+	}
+
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(vars["devEUI"])); err != nil {
+		http.Error(w, "invalid dev eui", http.StatusBadRequest)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxCallbackBodySize))
+	if err != nil {
+		http.Error(w, "read body error", http.StatusBadRequest)
+		return
+	}
+
+	secret, err := getCallbackSecret(applicationID)
+	if err != nil {
+		if errors.Cause(err) != storage.ErrDoesNotExist {
+			log.WithError(err).WithField("application_id", applicationID).Error("integration/http: get callback secret error")
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if len(secret) == 0 {
+		http.Error(w, "callbacks are not enabled for this application", http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+	if err := validateCallback(secret, applicationID, devEUI, q, body, r.Header.Get(SignatureHeader)); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var pl integration.DataDownPayload
+	if err := json.Unmarshal(body, &pl); err != nil {
+		http.Error(w, "decode body error: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	pl.ApplicationID = applicationID
+	pl.DevEUI = devEUI
+
+	eventID := q.Get("event")
+	logFields := log.Fields{
+		"application_id": applicationID,
+		"dev_eui":        devEUI,
+		"event_id":       eventID,
+	}
+
+	// the expires parameter has been validated by validateCallback
+	expires, _ := strconv.ParseInt(q.Get("expires"), 10, 64)
+	if err := claimCallbackEvent(applicationID, eventID, time.Unix(expires, 0)); err != nil {
+		if err == errCallbackUsed {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		log.WithFields(logFields).WithError(err).Error("integration/http: claim callback event error")
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	fCnt, err := downlink.HandleDataDownPayload(pl)
+	if err != nil {
+		log.WithFields(logFields).WithError(err).Error("integration/http: handle callback downlink error")
+
+		// the downlink has not been enqueued, the callback can be retried
+		if err := releaseCallbackEvent(applicationID, eventID); err != nil {
+			log.WithFields(logFields).WithError(err).Error("integration/http: release callback event error")
+		}
+
+		if rlErr, ok := errors.Cause(err).(*downlink.RateLimitError); ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(rlErr.RetryAfter.Seconds()))))
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+
+		switch errors.Cause(err) {
+		case downlink.ErrMaxPayloadSizeExceeded:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		case storage.ErrDeviceSuspended, storage.ErrDeviceRetired:
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, "enqueue downlink error", http.StatusInternalServerError)
+		return
+	}
+
+	log.WithFields(logFields).Info("integration/http: callback downlink enqueued")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		FCnt uint32 `json:"fCnt"`
+	}{fCnt})
+}
+
+// claimCallbackEvent marks the given callback event as used until the given
+// expiry time. It returns errCallbackUsed when the event has already been
+// used, so that a callback URL can only be used once.
+func claimCallbackEvent(applicationID int64, eventID string, expires time.Time) error {
+	ttl := time.Until(expires)
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(callbackEventKeyTempl, applicationID, eventID)
+	_, err := redis.String(c.Do("SET", key, "1", "PX", int64(ttl/time.Millisecond), "NX"))
+	if err != nil {
+		if err == redis.ErrNil {
+			return errCallbackUsed
+		}
+		return errors.Wrap(err, "set callback event key error")
+	}
+
+	return nil
+}
+
+// releaseCallbackEvent removes the used mark of the given callback event.
+func releaseCallbackEvent(applicationID int64, eventID string) error {
+	c := storage.RedisPool().Get()
+	defer c.Close()
+
+	_, err := c.Do("DEL", fmt.Sprintf(callbackEventKeyTempl, applicationID, eventID))
+	if err != nil {
+		return errors.Wrap(err, "delete callback event key error")
+	}
+	return nil
+}
+
+// getCallbackSecret returns the callback secret of the HTTP integration of
+// the given application.
+func getCallbackSecret(applicationID int64) ([]byte, error) {
+	appint, err := storage.GetIntegrationByApplicationID(storage.DB(), applicationID, integration.HTTP)
+	if err != nil {
+		return nil, errors.Wrap(err, "get integration error")
+	}
+
+	var conf Config
+	if err := json.Unmarshal(appint.Settings, &conf); err != nil {
+		return nil, errors.Wrap(err, "decode http integration config error")
+	}
+
+	return []byte(conf.CallbackSecret), nil
+}
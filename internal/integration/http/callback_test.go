@@ -0,0 +1,113 @@
+package http
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestCallback(t *testing.T) {
+	assert := require.New(t)
+
+	var conf config.Config
+	conf.ApplicationServer.Integration.HTTP.CallbackBaseURL = "https://lora.example.com/"
+	conf.ApplicationServer.Integration.HTTP.CallbackTTL = time.Hour
+	assert.NoError(Setup(conf))
+	defer Setup(config.Config{})
+
+	h := &testHTTPHandler{
+		requests: make(chan *http.Request, 1),
+	}
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	i, err := New(Config{
+		DataUpURL:      server.URL,
+		CallbackSecret: "secret",
+	})
+	assert.NoError(err)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	assert.NoError(i.SendDataUp(integration.DataUpPayload{
+		ApplicationID: 1,
+		DevEUI:        devEUI,
+	}))
+
+	req := <-h.requests
+	body, err := ioutil.ReadAll(req.Body)
+	assert.NoError(err)
+
+	t.Run("Event signature", func(t *testing.T) {
+		assert := require.New(t)
+		assert.True(validSignature([]byte("secret"), body, req.Header.Get(SignatureHeader)))
+		assert.False(validSignature([]byte("other"), body, req.Header.Get(SignatureHeader)))
+	})
+
+	eventID := req.Header.Get(EventIDHeader)
+	assert.Len(eventID, 32)
+
+	u, err := url.Parse(req.Header.Get(CallbackURLHeader))
+	assert.NoError(err)
+	assert.Equal("lora.example.com", u.Host)
+	assert.Equal(CallbackPath+"/applications/1/devices/0102030405060708/queue", u.Path)
+	assert.Equal(eventID, u.Query().Get("event"))
+
+	downlink := []byte(`{"confirmed":false,"fPort":10,"data":"AQID"}`)
+	downlinkSig := sign([]byte("secret"), downlink)
+
+	t.Run("Valid", func(t *testing.T) {
+		assert := require.New(t)
+		assert.NoError(validateCallback([]byte("secret"), 1, devEUI, u.Query(), downlink, downlinkSig))
+	})
+
+	t.Run("Other application", func(t *testing.T) {
+		assert := require.New(t)
+		assert.EqualError(validateCallback([]byte("secret"), 2, devEUI, u.Query(), downlink, downlinkSig), "invalid callback url signature")
+	})
+
+	t.Run("Other device", func(t *testing.T) {
+		assert := require.New(t)
+		assert.EqualError(validateCallback([]byte("secret"), 1, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, u.Query(), downlink, downlinkSig), "invalid callback url signature")
+	})
+
+	t.Run("Invalid body signature", func(t *testing.T) {
+		assert := require.New(t)
+		assert.EqualError(validateCallback([]byte("secret"), 1, devEUI, u.Query(), downlink, sign([]byte("other"), downlink)), "invalid body signature")
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		assert := require.New(t)
+		expires := time.Now().Add(-time.Minute).Unix()
+		expired, err := url.Parse(callbackURL([]byte("secret"), 1, devEUI, eventID, expires))
+		assert.NoError(err)
+		assert.EqualError(validateCallback([]byte("secret"), 1, devEUI, expired.Query(), downlink, downlinkSig), "callback url expired")
+	})
+}
+
+func TestClaimCallbackEvent(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	assert.NoError(storage.Setup(conf))
+	test.MustFlushRedis(storage.RedisPool())
+
+	expires := time.Now().Add(time.Minute)
+
+	assert.NoError(claimCallbackEvent(1, "event", expires))
+	assert.Equal(errCallbackUsed, claimCallbackEvent(1, "event", expires))
+	assert.NoError(claimCallbackEvent(2, "event", expires))
+
+	assert.NoError(releaseCallbackEvent(1, "event"))
+	assert.NoError(claimCallbackEvent(1, "event", expires))
+}
@@ -4,5 +4,10 @@ import "errors"
 
 // errors
 var (
-	ErrInvalidHeaderName = errors.New("Invalid header name")
+	ErrInvalidHeaderName    = errors.New("Invalid header name")
+	ErrInvalidEndpointEvent = errors.New("Invalid endpoint event")
+	ErrInvalidEndpointURL   = errors.New("Invalid endpoint URL")
+	ErrInvalidFilter        = errors.New("Invalid filter expression")
+	ErrInvalidCompression   = errors.New("Invalid compression")
+	ErrInvalidBatch         = errors.New("Invalid batch size or interval")
 )
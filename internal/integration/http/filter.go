@@ -0,0 +1,61 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robertkrimen/otto"
+	"github.com/robertkrimen/otto/parser"
+)
+
+var filterMaxExecutionTime = 10 * time.Millisecond
+
+// validateFilter validates the syntax of the given filter expression.
+func validateFilter(filter string) error {
+	if _, err := parser.ParseFile(nil, "", filter, 0); err != nil {
+		return ErrInvalidFilter
+	}
+	return nil
+}
+
+// matchFilter evaluates the given (JavaScript) filter expression. The given
+// payload is available as the event variable, using the same structure as
+// the published JSON payload (e.g. event.object.temperature > 20).
+func matchFilter(filter string, payload interface{}) (match bool, err error) {
+	defer func() {
+		if caught := recover(); caught != nil {
+			err = fmt.Errorf("%s", caught)
+		}
+	}()
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return false, errors.Wrap(err, "marshal json error")
+	}
+
+	var event interface{}
+	if err := json.Unmarshal(b, &event); err != nil {
+		return false, errors.Wrap(err, "unmarshal json error")
+	}
+
+	vm := otto.New()
+	vm.Interrupt = make(chan func(), 1)
+	vm.SetStackDepthLimit(32)
+	vm.Set("event", event)
+
+	go func() {
+		time.Sleep(filterMaxExecutionTime)
+		vm.Interrupt <- func() {
+			panic(errors.New("execution timeout"))
+		}
+	}()
+
+	val, err := vm.Run(filter)
+	if err != nil {
+		return false, errors.Wrap(err, "js vm error")
+	}
+
+	return val.ToBoolean()
+}
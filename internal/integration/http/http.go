@@ -3,6 +3,7 @@ package http
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -12,10 +13,35 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lorawan"
 )
 
+// Event types.
+const (
+	EventUplink    = "uplink"
+	EventJoin      = "join"
+	EventACK       = "ack"
+	EventError     = "error"
+	EventStatus    = "status"
+	EventLocation  = "location"
+	EventHeartbeat = "heartbeat"
+)
+
+// CompressionGzip defines the gzip compression of the request body.
+const CompressionGzip = "gzip"
+
 var headerNameValidator = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
 
+var events = map[string]struct{}{
+	EventUplink:    {},
+	EventJoin:      {},
+	EventACK:       {},
+	EventError:     {},
+	EventStatus:    {},
+	EventLocation:  {},
+	EventHeartbeat: {},
+}
+
 // Config contains the configuration for the HTTP integration.
 type Config struct {
 	Headers                 map[string]string `json:"headers"`
@@ -25,6 +51,26 @@ type Config struct {
 	ErrorNotificationURL    string            `json:"errorNotificationURL"`
 	StatusNotificationURL   string            `json:"statusNotificationURL"`
 	LocationNotificationURL string            `json:"locationNotificationURL"`
+	Endpoints               []Endpoint        `json:"endpoints"`
+	CallbackSecret          string            `json:"callbackSecret"`
+
+	// Compression of the request body, either empty (none) or gzip.
+	Compression string `json:"compression"`
+
+	// When BatchSize is greater than 1, the events are posted in batches of
+	// (max.) BatchSize events, collected during (max.) BatchIntervalMS
+	// milliseconds. Batching is disabled when the event journal is enabled.
+	BatchSize       int `json:"batchSize"`
+	BatchIntervalMS int `json:"batchIntervalMS"`
+}
+
+// Endpoint defines an additional endpoint to which the events of the given
+// type are posted. When a filter expression is set, only the events matching
+// this expression are posted.
+type Endpoint struct {
+	Event  string `json:"event"`
+	URL    string `json:"url"`
+	Filter string `json:"filter"`
 }
 
 // Validate validates the HandlerConfig data.
@@ -34,38 +80,115 @@ func (c Config) Validate() error {
 			return ErrInvalidHeaderName
 		}
 	}
+
+	for _, ep := range c.Endpoints {
+		if _, ok := events[ep.Event]; !ok {
+			return ErrInvalidEndpointEvent
+		}
+		if ep.URL == "" {
+			return ErrInvalidEndpointURL
+		}
+		if ep.Filter != "" {
+			if err := validateFilter(ep.Filter); err != nil {
+				return err
+			}
+		}
+	}
+
+	if c.Compression != "" && c.Compression != CompressionGzip {
+		return ErrInvalidCompression
+	}
+
+	if c.BatchSize < 0 || c.BatchSize > MaxBatchSize || c.BatchIntervalMS < 0 || c.BatchIntervalMS > MaxBatchIntervalMS {
+		return ErrInvalidBatch
+	}
+	if c.BatchSize > 1 && c.BatchIntervalMS == 0 {
+		return ErrInvalidBatch
+	}
+
 	return nil
 }
 
 // Integration implements a HTTP integration.
 type Integration struct {
 	config Config
+	client *http.Client
+	batch  bool
 }
 
 // New creates a new HTTP integration.
 func New(conf Config) (*Integration, error) {
 	return &Integration{
 		config: conf,
+		client: http.DefaultClient,
+		batch:  conf.BatchSize > 1 && !batchDisabled,
 	}, nil
 }
 
-func (i *Integration) send(url string, payload interface{}) error {
+// WithHTTPClient returns a copy of the integration, making its requests
+// using the given client. The copy does not batch events, so that each
+// request is made before the publish returns.
+func (i *Integration) WithHTTPClient(c *http.Client) integration.Integrator {
+	return &Integration{
+		config: i.config,
+		client: c,
+	}
+}
+
+// send posts the payload of the given event type to the given URL, or adds
+// it to the pending batch for this URL when batching is enabled.
+func (i *Integration) send(url, event string, applicationID int64, devEUI lorawan.EUI64, payload interface{}) error {
 	b, err := json.Marshal(payload)
 	if err != nil {
 		return errors.Wrap(err, "marshal json error")
 	}
 
+	if i.batch {
+		return i.enqueue(url, event, applicationID, devEUI, b)
+	}
+
+	h := make(http.Header)
+	if i.config.CallbackSecret != "" {
+		if err := setCallbackHeaders(h, []byte(i.config.CallbackSecret), applicationID, devEUI, b); err != nil {
+			return errors.Wrap(err, "set callback headers error")
+		}
+	}
+
+	return i.post(url, b, h)
+}
+
+// post posts the given JSON body with the given additional headers. The
+// body is compressed when compression is configured.
+func (i *Integration) post(url string, b []byte, h http.Header) error {
+	if i.config.Compression == CompressionGzip {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(b); err != nil {
+			return errors.Wrap(err, "gzip write error")
+		}
+		if err := w.Close(); err != nil {
+			return errors.Wrap(err, "gzip close error")
+		}
+		b = buf.Bytes()
+	}
+
 	req, err := http.NewRequest("POST", url, bytes.NewReader(b))
 	if err != nil {
 		return errors.Wrap(err, "new request error")
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if i.config.Compression == CompressionGzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
 	for k, v := range i.config.Headers {
 		req.Header.Set(k, v)
 	}
+	for k, v := range h {
+		req.Header[k] = v
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := i.client.Do(req)
 	if err != nil {
 		return errors.Wrap(err, "http request error")
 	}
@@ -79,6 +202,43 @@ func (i *Integration) send(url string, payload interface{}) error {
 	return nil
 }
 
+// sendToEndpoints sends the payload to the endpoints configured for the
+// given event type of which the filter expression matches the payload.
+func (i *Integration) sendToEndpoints(event string, applicationID int64, devEUI lorawan.EUI64, pl interface{}) error {
+	var firstErr error
+
+	for _, ep := range i.config.Endpoints {
+		if ep.Event != event {
+			continue
+		}
+
+		if ep.Filter != "" {
+			match, err := matchFilter(ep.Filter, pl)
+			if err != nil {
+				log.WithFields(log.Fields{
+					"url":     ep.URL,
+					"dev_eui": devEUI,
+				}).WithError(err).Error("integration/http: evaluate filter error")
+				continue
+			}
+			if !match {
+				continue
+			}
+		}
+
+		log.WithFields(log.Fields{
+			"url":     ep.URL,
+			"dev_eui": devEUI,
+			"event":   event,
+		}).Info("integration/http: publishing event to endpoint")
+		if err := i.send(ep.URL, event, applicationID, devEUI, pl); err != nil && firstErr == nil {
+			firstErr = errors.Wrap(err, "send error")
+		}
+	}
+
+	return firstErr
+}
+
 // Close closes the handler.
 func (i *Integration) Close() error {
 	return nil
@@ -86,98 +246,98 @@ func (i *Integration) Close() error {
 
 // SendDataUp sends a data-up payload.
 func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
-	if i.config.DataUpURL == "" {
-		return nil
+	if i.config.DataUpURL != "" {
+		log.WithFields(log.Fields{
+			"url":     i.config.DataUpURL,
+			"dev_eui": pl.DevEUI,
+		}).Info("integration/http: publishing data-up payload")
+		if err := i.send(i.config.DataUpURL, EventUplink, pl.ApplicationID, pl.DevEUI, pl); err != nil {
+			return errors.Wrap(err, "send error")
+		}
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.DataUpURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing data-up payload")
-	if err := i.send(i.config.DataUpURL, pl); err != nil {
-		return errors.Wrap(err, "send error")
-	}
-	return nil
+	return i.sendToEndpoints(EventUplink, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendJoinNotification sends a join notification.
 func (i *Integration) SendJoinNotification(pl integration.JoinNotification) error {
-	if i.config.JoinNotificationURL == "" {
-		return nil
+	if i.config.JoinNotificationURL != "" {
+		log.WithFields(log.Fields{
+			"url":     i.config.JoinNotificationURL,
+			"dev_eui": pl.DevEUI,
+		}).Info("integration/http: publishing join notification")
+		if err := i.send(i.config.JoinNotificationURL, EventJoin, pl.ApplicationID, pl.DevEUI, pl); err != nil {
+			return errors.Wrap(err, "send error")
+		}
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.JoinNotificationURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing join notification")
-	if err := i.send(i.config.JoinNotificationURL, pl); err != nil {
-		return errors.Wrap(err, "send error")
-	}
-	return nil
+	return i.sendToEndpoints(EventJoin, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendACKNotification sends an ACK notification.
 func (i *Integration) SendACKNotification(pl integration.ACKNotification) error {
-	if i.config.ACKNotificationURL == "" {
-		return nil
+	if i.config.ACKNotificationURL != "" {
+		log.WithFields(log.Fields{
+			"url":     i.config.ACKNotificationURL,
+			"dev_eui": pl.DevEUI,
+		}).Info("integration/http: publishing ack notification")
+		if err := i.send(i.config.ACKNotificationURL, EventACK, pl.ApplicationID, pl.DevEUI, pl); err != nil {
+			return errors.Wrap(err, "send error")
+		}
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.ACKNotificationURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing ack notification")
-	if err := i.send(i.config.ACKNotificationURL, pl); err != nil {
-		return errors.Wrap(err, "send error")
-	}
-	return nil
+	return i.sendToEndpoints(EventACK, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendErrorNotification sends an error notification.
 func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) error {
-	if i.config.ErrorNotificationURL == "" {
-		return nil
+	if i.config.ErrorNotificationURL != "" {
+		log.WithFields(log.Fields{
+			"url":     i.config.ErrorNotificationURL,
+			"dev_eui": pl.DevEUI,
+		}).Info("integration/http: publishing error notification")
+		if err := i.send(i.config.ErrorNotificationURL, EventError, pl.ApplicationID, pl.DevEUI, pl); err != nil {
+			return errors.Wrap(err, "send error")
+		}
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.ErrorNotificationURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing error notification")
-	if err := i.send(i.config.ErrorNotificationURL, pl); err != nil {
-		return errors.Wrap(err, "send error")
-	}
-	return nil
+	return i.sendToEndpoints(EventError, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendStatusNotification sends a status notification.
 func (i *Integration) SendStatusNotification(pl integration.StatusNotification) error {
-	if i.config.StatusNotificationURL == "" {
-		return nil
+	if i.config.StatusNotificationURL != "" {
+		log.WithFields(log.Fields{
+			"url":     i.config.StatusNotificationURL,
+			"dev_eui": pl.DevEUI,
+		}).Info("integration/http: publishing status notification")
+		if err := i.send(i.config.StatusNotificationURL, EventStatus, pl.ApplicationID, pl.DevEUI, pl); err != nil {
+			return errors.Wrap(err, "send error")
+		}
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.StatusNotificationURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing status notification")
-	if err := i.send(i.config.StatusNotificationURL, pl); err != nil {
-		return errors.Wrap(err, "send error")
-	}
-	return nil
+	return i.sendToEndpoints(EventStatus, pl.ApplicationID, pl.DevEUI, pl)
 }
 
 // SendLocationNotification sends a location notification.
 func (i *Integration) SendLocationNotification(pl integration.LocationNotification) error {
-	if i.config.LocationNotificationURL == "" {
-		return nil
+	if i.config.LocationNotificationURL != "" {
+		log.WithFields(log.Fields{
+			"url":     i.config.LocationNotificationURL,
+			"dev_eui": pl.DevEUI,
+		}).Info("integration/http: publishing location notification")
+		if err := i.send(i.config.LocationNotificationURL, EventLocation, pl.ApplicationID, pl.DevEUI, pl); err != nil {
+			return errors.Wrap(err, "send error")
+		}
 	}
 
-	log.WithFields(log.Fields{
-		"url":     i.config.LocationNotificationURL,
-		"dev_eui": pl.DevEUI,
-	}).Info("integration/http: publishing location notification")
-	if err := i.send(i.config.LocationNotificationURL, pl); err != nil {
-		return errors.Wrap(err, "send error")
-	}
-	return nil
+	return i.sendToEndpoints(EventLocation, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendHeartbeatNotification sends a heartbeat notification. Heartbeats are
+// only sent to the endpoints configured for the heartbeat event.
+func (i *Integration) SendHeartbeatNotification(pl integration.HeartbeatNotification) error {
+	return i.sendToEndpoints(EventHeartbeat, pl.ApplicationID, lorawan.EUI64{}, pl)
 }
 
 // DataDownChan return nil.
@@ -2,11 +2,13 @@ package http
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -51,6 +53,73 @@ func TestHandlerConfig(t *testing.T) {
 			},
 			Valid: false,
 		},
+		{
+			Name: "Valid endpoint",
+			HandlerConfig: Config{
+				Endpoints: []Endpoint{
+					{Event: EventUplink, URL: "http://localhost/temperature", Filter: "event.fPort == 2 && event.object.temperature > 20"},
+				},
+			},
+			Valid: true,
+		},
+		{
+			Name: "Invalid endpoint event",
+			HandlerConfig: Config{
+				Endpoints: []Endpoint{
+					{Event: "foo", URL: "http://localhost/foo"},
+				},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Invalid endpoint URL",
+			HandlerConfig: Config{
+				Endpoints: []Endpoint{
+					{Event: EventJoin},
+				},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Invalid endpoint filter",
+			HandlerConfig: Config{
+				Endpoints: []Endpoint{
+					{Event: EventUplink, URL: "http://localhost/temperature", Filter: "event.fPort =="},
+				},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Valid compression and batching",
+			HandlerConfig: Config{
+				Compression:     CompressionGzip,
+				BatchSize:       100,
+				BatchIntervalMS: 1000,
+			},
+			Valid: true,
+		},
+		{
+			Name: "Invalid compression",
+			HandlerConfig: Config{
+				Compression: "zip",
+			},
+			Valid: false,
+		},
+		{
+			Name: "Batching without interval",
+			HandlerConfig: Config{
+				BatchSize: 100,
+			},
+			Valid: false,
+		},
+		{
+			Name: "Batch size exceeds max",
+			HandlerConfig: Config{
+				BatchSize:       MaxBatchSize + 1,
+				BatchIntervalMS: 1000,
+			},
+			Valid: false,
+		},
 	}
 
 	for _, test := range testTable {
@@ -93,6 +162,17 @@ func (ts *HandlerTestSuite) SetupSuite() {
 		ErrorNotificationURL:    ts.server.URL + "/error",
 		StatusNotificationURL:   ts.server.URL + "/status",
 		LocationNotificationURL: ts.server.URL + "/location",
+		Endpoints: []Endpoint{
+			{
+				Event:  EventUplink,
+				URL:    ts.server.URL + "/dataup/temperature",
+				Filter: "event.fPort == 2 && event.object.temperature > 20",
+			},
+			{
+				Event: EventStatus,
+				URL:   ts.server.URL + "/status/all",
+			},
+		},
 	}
 
 	var err error
@@ -122,6 +202,41 @@ func (ts *HandlerTestSuite) TestUplink() {
 	assert.Equal("application/json", req.Header.Get("Content-Type"))
 }
 
+func (ts *HandlerTestSuite) TestUplinkEndpointFilter() {
+	assert := require.New(ts.T())
+
+	ts.T().Run("Matching", func(t *testing.T) {
+		reqPL := integration.DataUpPayload{
+			FPort: 2,
+			Object: map[string]interface{}{
+				"temperature": 22.5,
+			},
+		}
+		assert.NoError(ts.integration.SendDataUp(reqPL))
+
+		req := <-ts.httpHandler.requests
+		assert.Equal("/dataup", req.URL.Path)
+
+		req = <-ts.httpHandler.requests
+		assert.Equal("/dataup/temperature", req.URL.Path)
+		assert.Equal("Bar", req.Header.Get("Foo"))
+	})
+
+	ts.T().Run("Not matching", func(t *testing.T) {
+		reqPL := integration.DataUpPayload{
+			FPort: 2,
+			Object: map[string]interface{}{
+				"temperature": 18.5,
+			},
+		}
+		assert.NoError(ts.integration.SendDataUp(reqPL))
+
+		req := <-ts.httpHandler.requests
+		assert.Equal("/dataup", req.URL.Path)
+		assert.Len(ts.httpHandler.requests, 0)
+	})
+}
+
 func (ts *HandlerTestSuite) TestJoin() {
 	assert := require.New(ts.T())
 
@@ -190,6 +305,10 @@ func (ts *HandlerTestSuite) TestStatus() {
 	req := <-ts.httpHandler.requests
 	assert.Equal("/status", req.URL.Path)
 
+	// the unfiltered endpoint receives all status notifications
+	endpointReq := <-ts.httpHandler.requests
+	assert.Equal("/status/all", endpointReq.URL.Path)
+
 	var pl integration.StatusNotification
 	assert.NoError(json.NewDecoder(req.Body).Decode(&pl))
 	assert.Equal(reqPL, pl)
@@ -221,6 +340,151 @@ func (ts *HandlerTestSuite) TestLocation() {
 	assert.Equal("application/json", req.Header.Get("Content-Type"))
 }
 
+func TestCompression(t *testing.T) {
+	assert := require.New(t)
+
+	h := &testHTTPHandler{
+		requests: make(chan *http.Request, 10),
+	}
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	i, err := New(Config{
+		DataUpURL:      server.URL + "/dataup",
+		CallbackSecret: "secret",
+		Compression:    CompressionGzip,
+	})
+	assert.NoError(err)
+
+	reqPL := integration.DataUpPayload{
+		Data: []byte{1, 2, 3, 4},
+	}
+	assert.NoError(i.SendDataUp(reqPL))
+
+	req := <-h.requests
+	assert.Equal("gzip", req.Header.Get("Content-Encoding"))
+
+	r, err := gzip.NewReader(req.Body)
+	assert.NoError(err)
+	b, err := ioutil.ReadAll(r)
+	assert.NoError(err)
+
+	// the signature is of the uncompressed body
+	assert.Equal(sign([]byte("secret"), b), req.Header.Get(SignatureHeader))
+
+	var pl integration.DataUpPayload
+	assert.NoError(json.Unmarshal(b, &pl))
+	assert.Equal(reqPL, pl)
+}
+
+func TestBatch(t *testing.T) {
+	assert := require.New(t)
+
+	h := &testHTTPHandler{
+		requests: make(chan *http.Request, 10),
+	}
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	i, err := New(Config{
+		DataUpURL:           server.URL + "/dataup",
+		JoinNotificationURL: server.URL + "/dataup",
+		BatchSize:           2,
+		BatchIntervalMS:     50,
+	})
+	assert.NoError(err)
+
+	assert.NoError(i.SendDataUp(integration.DataUpPayload{FCnt: 1}))
+	assert.NoError(i.SendJoinNotification(integration.JoinNotification{DevAddr: lorawan.DevAddr{1, 2, 3, 4}}))
+	assert.NoError(i.SendDataUp(integration.DataUpPayload{FCnt: 2}))
+
+	var batches []batchPayload
+	for n := 0; n < 2; n++ {
+		select {
+		case req := <-h.requests:
+			assert.Equal("/dataup", req.URL.Path)
+
+			var pl batchPayload
+			assert.NoError(json.NewDecoder(req.Body).Decode(&pl))
+			batches = append(batches, pl)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for batch")
+		}
+	}
+
+	// the first batch is posted when full, the second after the interval
+	assert.Len(batches[0].Events, 2)
+	assert.Equal(EventUplink, batches[0].Events[0].Type)
+	assert.Equal(EventJoin, batches[0].Events[1].Type)
+	assert.Len(batches[1].Events, 1)
+
+	var pl integration.DataUpPayload
+	assert.NoError(json.Unmarshal(batches[1].Events[0].Payload, &pl))
+	assert.EqualValues(2, pl.FCnt)
+}
+
+func TestFlushBatches(t *testing.T) {
+	assert := require.New(t)
+
+	h := &testHTTPHandler{
+		requests: make(chan *http.Request, 10),
+	}
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	callbackBaseURL = "https://lora.example.com"
+	callbackTTL = time.Hour
+	defer func() {
+		callbackBaseURL = ""
+		callbackTTL = 0
+	}()
+
+	i, err := New(Config{
+		DataUpURL:       server.URL + "/dataup",
+		CallbackSecret:  "secret",
+		BatchSize:       10,
+		BatchIntervalMS: 60000,
+	})
+	assert.NoError(err)
+
+	assert.NoError(i.SendDataUp(integration.DataUpPayload{ApplicationID: 1, DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}}))
+	assert.Len(h.requests, 0)
+
+	FlushBatches()
+	assert.Len(h.requests, 1)
+
+	req := <-h.requests
+	var pl batchPayload
+	assert.NoError(json.NewDecoder(req.Body).Decode(&pl))
+	assert.Len(pl.Events, 1)
+	assert.NotEqual("", pl.Events[0].EventID)
+	assert.Contains(pl.Events[0].CallbackURL, "https://lora.example.com"+CallbackPath+"/applications/1/devices/0102030405060708/queue?")
+
+	t.Run("Batching disabled", func(t *testing.T) {
+		assert := require.New(t)
+
+		batchDisabled = true
+		defer func() {
+			batchDisabled = false
+		}()
+
+		i, err := New(Config{
+			DataUpURL:       server.URL + "/dataup",
+			BatchSize:       10,
+			BatchIntervalMS: 60000,
+		})
+		assert.NoError(err)
+
+		assert.NoError(i.SendDataUp(integration.DataUpPayload{FCnt: 1}))
+		assert.Len(h.requests, 1)
+
+		req := <-h.requests
+		var pl integration.DataUpPayload
+		assert.NoError(json.NewDecoder(req.Body).Decode(&pl))
+		assert.EqualValues(1, pl.FCnt)
+	})
+}
+
 func TestHandler(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
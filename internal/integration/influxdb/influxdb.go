@@ -12,6 +12,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mmcloughlin/geohash"
 	"github.com/pkg/errors"
@@ -87,19 +88,37 @@ func formatInfluxValue(v interface{}, quote bool) string {
 // Integration implements an InfluxDB integration.
 type Integration struct {
 	config Config
+	client *http.Client
 }
 
 // New creates a new InfluxDB integration.
 func New(conf Config) (*Integration, error) {
 	return &Integration{
 		config: conf,
+		client: http.DefaultClient,
 	}, nil
 }
 
-func (i *Integration) send(measurements []measurement) error {
+// WithHTTPClient returns a copy of the integration, making its requests
+// using the given client.
+func (i *Integration) WithHTTPClient(c *http.Client) integration.Integrator {
+	return &Integration{
+		config: i.config,
+		client: c,
+	}
+}
+
+// send writes the given measurements. When ts is not nil, the measurements
+// are written with the given timestamp instead of the server time.
+func (i *Integration) send(measurements []measurement, ts *time.Time) error {
+	var timestamp string
+	if ts != nil {
+		timestamp = " " + strconv.FormatInt(precisionTimestamp(*ts, i.config.Precision), 10)
+	}
+
 	var measStr []string
 	for _, m := range measurements {
-		measStr = append(measStr, m.String())
+		measStr = append(measStr, m.String()+timestamp)
 	}
 	sort.Strings(measStr)
 
@@ -121,7 +140,7 @@ func (i *Integration) send(measurements []measurement) error {
 		req.SetBasicAuth(i.config.Username, i.config.Password)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := i.client.Do(req)
 	if err != nil {
 		return errors.Wrap(err, "http request error")
 	}
@@ -191,7 +210,7 @@ func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
 		return nil
 	}
 
-	if err := i.send(measurements); err != nil {
+	if err := i.send(measurements, pl.ReceivedAt); err != nil {
 		return errors.Wrap(err, "sending measurements error")
 	}
 
@@ -244,7 +263,7 @@ func (i *Integration) SendStatusNotification(pl integration.StatusNotification)
 		},
 	})
 
-	if err := i.send(measurements); err != nil {
+	if err := i.send(measurements, nil); err != nil {
 		return errors.Wrap(err, "sending measurements error")
 	}
 
@@ -275,6 +294,11 @@ func (i *Integration) SendLocationNotification(pl integration.LocationNotificati
 	return nil
 }
 
+// SendHeartbeatNotification is not implemented.
+func (i *Integration) SendHeartbeatNotification(pl integration.HeartbeatNotification) error {
+	return nil
+}
+
 // DataDownChan return nil.
 func (i *Integration) DataDownChan() chan integration.DataDownPayload {
 	return nil
@@ -453,3 +477,22 @@ func structToLocation(pl integration.DataUpPayload, prefix string, obj reflect.V
 		},
 	}
 }
+
+// precisionTimestamp returns the given timestamp in the given InfluxDB
+// precision.
+func precisionTimestamp(t time.Time, precision string) int64 {
+	switch precision {
+	case "u":
+		return t.UnixNano() / int64(time.Microsecond)
+	case "ms":
+		return t.UnixNano() / int64(time.Millisecond)
+	case "s":
+		return t.Unix()
+	case "m":
+		return t.Unix() / 60
+	case "h":
+		return t.Unix() / 3600
+	default:
+		return t.UnixNano()
+	}
+}
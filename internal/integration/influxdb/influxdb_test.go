@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
@@ -115,6 +116,8 @@ device_status_margin,application_name=test-app,dev_eui=0102030405060708,device_n
 }
 
 func (ts *HandlerTestSuite) TestUplink() {
+	receivedAt := time.Unix(1547979072, 0)
+
 	tests := []struct {
 		Name         string
 		Payload      integration.DataUpPayload
@@ -312,6 +315,27 @@ device_uplink,application_name=test-app,dev_eui=0102030405060708,device_name=tes
 device_frmpayload_data_gps_location_10_location,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 geohash="s01w2k3vvqre",latitude=1.123000,longitude=2.123000
 device_uplink,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,dr=2,frequency=868100000 f_cnt=10i,value=1i`,
 		},
+		{
+			Name: "Reprocessed with timestamp",
+			Payload: integration.DataUpPayload{
+				ApplicationName: "test-app",
+				DeviceName:      "test-dev",
+				DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				FCnt:            10,
+				FPort:           20,
+				TXInfo: integration.TXInfo{
+					Frequency: 868100000,
+					DR:        2,
+				},
+				Object: map[string]interface{}{
+					"temperature": 25.4,
+				},
+				ReceivedAt:  &receivedAt,
+				Reprocessed: true,
+			},
+			ExpectedBody: `device_frmpayload_data_temperature,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 value=25.400000 1547979072
+device_uplink,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,dr=2,frequency=868100000 f_cnt=10i,value=1i 1547979072`,
+		},
 	}
 
 	for _, tst := range tests {
@@ -1,5 +1,11 @@
 package integration
 
+import (
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+)
+
 // Handler kinds
 const (
 	HTTP     = "HTTP"
@@ -8,14 +14,29 @@ const (
 
 // Integrator defines the interface that an intergration must implement.
 type Integrator interface {
-	SendDataUp(payload DataUpPayload) error                      // send data-up payload
-	SendJoinNotification(payload JoinNotification) error         // send join notification
-	SendACKNotification(payload ACKNotification) error           // send ack notification
-	SendErrorNotification(payload ErrorNotification) error       // send error notification
-	SendStatusNotification(payload StatusNotification) error     // send status notification
-	SendLocationNotification(payload LocationNotification) error // send location notofication
-	DataDownChan() chan DataDownPayload                          // returns DataDownPayload channel
-	Close() error                                                // closes the handler
+	SendDataUp(payload DataUpPayload) error                        // send data-up payload
+	SendJoinNotification(payload JoinNotification) error           // send join notification
+	SendACKNotification(payload ACKNotification) error             // send ack notification
+	SendErrorNotification(payload ErrorNotification) error         // send error notification
+	SendStatusNotification(payload StatusNotification) error       // send status notification
+	SendLocationNotification(payload LocationNotification) error   // send location notofication
+	SendHeartbeatNotification(payload HeartbeatNotification) error // send heartbeat notification
+	DataDownChan() chan DataDownPayload                            // returns DataDownPayload channel
+	Close() error                                                  // closes the handler
+}
+
+// TxIntegrator defines the interface that an integration must implement
+// when it is able to store the events within a database transaction
+// (e.g. the event journal).
+type TxIntegrator interface {
+	WithTx(db sqlx.Queryer) Integrator // returns the integration using the given transaction
+}
+
+// HTTPClientIntegrator defines the interface that an integration must
+// implement when it makes its outbound requests over HTTP, so that these
+// requests can be made using a custom client (e.g. for recording).
+type HTTPClientIntegrator interface {
+	WithHTTPClient(c *http.Client) Integrator // returns the integration using the given client
 }
 
 var integration Integrator
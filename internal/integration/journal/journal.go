@@ -0,0 +1,356 @@
+// Package journal implements an event journal (outbox) integration.
+// Instead of publishing the events directly, events are stored in the
+// database (optionally within the same transaction as the write causing
+// the event). A dispatcher publishes the stored events to the wrapped
+// integrations and tracks per integration (by ID) which events have been
+// acknowledged, so that no events are lost across restarts or integration
+// failures. Events which could not be published within the max number of
+// attempts are marked as failed (dead letter) and are no longer retried.
+// Note that an event might be published more than once in case of a crash
+// between publishing and storing the acknowledgement.
+package journal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// Event types.
+const (
+	DataUp                = "up"
+	JoinNotification      = "join"
+	ACKNotification       = "ack"
+	ErrorNotification     = "error"
+	StatusNotification    = "status"
+	LocationNotification  = "location"
+	HeartbeatNotification = "heartbeat"
+)
+
+// lockDuration defines the duration for which the events are locked while
+// being published. When a dispatcher does not store the result within this
+// duration (e.g. because of a crash), the events are dispatched again.
+const lockDuration = 5 * time.Minute
+
+// target defines an integration to which the events are published.
+type target struct {
+	id          string
+	integration integration.Integrator
+}
+
+// Integration implements the event journal integration.
+type Integration struct {
+	writer
+
+	wg               sync.WaitGroup
+	cancel           context.CancelFunc
+	dispatchInterval time.Duration
+	batchSize        int
+	maxAttempts      int
+	retention        time.Duration
+	integrations     []target
+}
+
+// New creates a new event journal integration, publishing the journaled
+// events to the given integrations. The integrations are keyed by a stable
+// ID, which is used to track the acknowledgements.
+func New(conf config.Config, integrations map[string]integration.Integrator) (*Integration, error) {
+	jConf := conf.ApplicationServer.Integration.Journal
+
+	if jConf.DispatchInterval <= 0 {
+		return nil, errors.New("dispatch_interval must be > 0")
+	}
+
+	if jConf.BatchSize <= 0 {
+		return nil, errors.New("batch_size must be > 0")
+	}
+
+	if jConf.MaxAttempts < 0 {
+		return nil, errors.New("max_attempts must be >= 0")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	i := Integration{
+		writer:           writer{db: storage.DB()},
+		cancel:           cancel,
+		dispatchInterval: jConf.DispatchInterval,
+		batchSize:        jConf.BatchSize,
+		maxAttempts:      jConf.MaxAttempts,
+		retention:        jConf.Retention,
+	}
+
+	for id, ii := range integrations {
+		i.integrations = append(i.integrations, target{id: id, integration: ii})
+	}
+	sort.Slice(i.integrations, func(a, b int) bool {
+		return i.integrations[a].id < i.integrations[b].id
+	})
+
+	i.wg.Add(1)
+	go i.dispatchLoop(ctx)
+
+	return &i, nil
+}
+
+// WithTx returns an integration which stores the events using the given
+// database transaction. The events will be dispatched once the transaction
+// has been committed.
+func (i *Integration) WithTx(db sqlx.Queryer) integration.Integrator {
+	return writer{db: db}
+}
+
+// DataDownChan returns the channel containing the received DataDownPayload.
+func (i *Integration) DataDownChan() chan integration.DataDownPayload {
+	for _, t := range i.integrations {
+		if c := t.integration.DataDownChan(); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// Close stops the dispatcher and closes the integrations.
+func (i *Integration) Close() error {
+	i.cancel()
+	i.wg.Wait()
+
+	for _, t := range i.integrations {
+		if err := t.integration.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *Integration) dispatchLoop(ctx context.Context) {
+	defer i.wg.Done()
+
+	ticker := time.NewTicker(i.dispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := i.Dispatch(); err != nil {
+			log.WithError(err).Error("integration/journal: dispatch events error")
+		}
+
+		if i.retention > 0 {
+			count, err := storage.DeletePublishedIntegrationEventsBefore(storage.DB(), time.Now().Add(-i.retention))
+			if err != nil {
+				log.WithError(err).Error("integration/journal: delete published events error")
+			} else if count > 0 {
+				log.WithField("count", count).Info("integration/journal: published events deleted")
+			}
+		}
+	}
+}
+
+// Dispatch publishes the pending events (at most the configured batch-size)
+// to the integrations. Events are published in order. When an integration
+// fails to publish an event, the remaining events of the batch are not
+// published to this integration so that the order is retained.
+// The events are locked before publishing, the publishing itself happens
+// outside a database transaction.
+func (i *Integration) Dispatch() error {
+	events, err := storage.LockPendingIntegrationEvents(storage.DB(), i.batchSize, lockDuration)
+	if err != nil {
+		return errors.Wrap(err, "lock pending events error")
+	}
+
+	failed := make(map[string]bool)
+
+	for _, e := range events {
+		acked := make(map[string]bool)
+		for _, id := range e.AckedBy {
+			acked[id] = true
+		}
+
+		var errs []string
+		var attempted bool
+
+		for _, t := range i.integrations {
+			if acked[t.id] {
+				continue
+			}
+
+			if failed[t.id] {
+				errs = append(errs, fmt.Sprintf("%s: skipped because of previous error", t.id))
+				continue
+			}
+
+			attempted = true
+
+			if err := publish(t.integration, e); err != nil {
+				log.WithFields(log.Fields{
+					"id":          e.ID,
+					"type":        e.Type,
+					"integration": t.id,
+				}).WithError(err).Error("integration/journal: publish event error")
+
+				failed[t.id] = true
+				errs = append(errs, fmt.Sprintf("%s: %s", t.id, err))
+				continue
+			}
+
+			e.AckedBy = append(e.AckedBy, t.id)
+		}
+
+		if attempted {
+			e.Attempts++
+		}
+		e.LastError = strings.Join(errs, ", ")
+
+		now := time.Now()
+		if len(errs) == 0 {
+			e.PublishedAt = &now
+		} else if i.maxAttempts > 0 && e.Attempts >= i.maxAttempts {
+			e.FailedAt = &now
+			log.WithFields(log.Fields{
+				"id":       e.ID,
+				"type":     e.Type,
+				"attempts": e.Attempts,
+			}).Error("integration/journal: max attempts reached, event marked as failed")
+		}
+
+		if err := storage.UpdateIntegrationEvent(storage.DB(), &e); err != nil {
+			return errors.Wrap(err, "update event error")
+		}
+	}
+
+	return nil
+}
+
+func publish(ii integration.Integrator, e storage.IntegrationEvent) error {
+	switch e.Type {
+	case DataUp:
+		var pl integration.DataUpPayload
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return ii.SendDataUp(pl)
+	case JoinNotification:
+		var pl integration.JoinNotification
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return ii.SendJoinNotification(pl)
+	case ACKNotification:
+		var pl integration.ACKNotification
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return ii.SendACKNotification(pl)
+	case ErrorNotification:
+		var pl integration.ErrorNotification
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return ii.SendErrorNotification(pl)
+	case StatusNotification:
+		var pl integration.StatusNotification
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return ii.SendStatusNotification(pl)
+	case LocationNotification:
+		var pl integration.LocationNotification
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return ii.SendLocationNotification(pl)
+	case HeartbeatNotification:
+		var pl integration.HeartbeatNotification
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return ii.SendHeartbeatNotification(pl)
+	default:
+		return fmt.Errorf("unknown event type: %s", e.Type)
+	}
+}
+
+// writer implements the integration interface by storing the events in
+// the event journal.
+type writer struct {
+	db sqlx.Queryer
+}
+
+// SendDataUp stores the data-up payload.
+func (w writer) SendDataUp(pl integration.DataUpPayload) error {
+	return w.create(DataUp, pl)
+}
+
+// SendJoinNotification stores the join notification.
+func (w writer) SendJoinNotification(pl integration.JoinNotification) error {
+	return w.create(JoinNotification, pl)
+}
+
+// SendACKNotification stores the ACK notification.
+func (w writer) SendACKNotification(pl integration.ACKNotification) error {
+	return w.create(ACKNotification, pl)
+}
+
+// SendErrorNotification stores the error notification.
+func (w writer) SendErrorNotification(pl integration.ErrorNotification) error {
+	return w.create(ErrorNotification, pl)
+}
+
+// SendStatusNotification stores the status notification.
+func (w writer) SendStatusNotification(pl integration.StatusNotification) error {
+	return w.create(StatusNotification, pl)
+}
+
+// SendLocationNotification stores the location notification.
+func (w writer) SendLocationNotification(pl integration.LocationNotification) error {
+	return w.create(LocationNotification, pl)
+}
+
+// SendHeartbeatNotification stores the heartbeat notification.
+func (w writer) SendHeartbeatNotification(pl integration.HeartbeatNotification) error {
+	return w.create(HeartbeatNotification, pl)
+}
+
+// DataDownChan returns nil as the writer does not receive downlink data.
+func (w writer) DataDownChan() chan integration.DataDownPayload {
+	return nil
+}
+
+// Close is a no-op.
+func (w writer) Close() error {
+	return nil
+}
+
+func (w writer) create(typ string, pl interface{}) error {
+	b, err := json.Marshal(pl)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	if err := storage.CreateIntegrationEvent(w.db, &storage.IntegrationEvent{
+		Type:    typ,
+		Payload: b,
+	}); err != nil {
+		return errors.Wrap(err, "create event error")
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package journal
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/integration/mock"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+// failingIntegration wraps the mock integration and fails when fail is set.
+type failingIntegration struct {
+	*mock.Integration
+	fail bool
+}
+
+func (i *failingIntegration) SendDataUp(pl integration.DataUpPayload) error {
+	if i.fail {
+		return errors.New("integration unavailable")
+	}
+	return i.Integration.SendDataUp(pl)
+}
+
+type JournalTestSuite struct {
+	suite.Suite
+
+	ok      *mock.Integration
+	failing *failingIntegration
+	journal *Integration
+}
+
+func (ts *JournalTestSuite) SetupSuite() {
+	assert := require.New(ts.T())
+
+	conf := test.GetConfig()
+	assert.NoError(storage.Setup(conf))
+
+	// dispatching is triggered manually by the tests
+	conf.ApplicationServer.Integration.Journal.DispatchInterval = time.Hour
+	conf.ApplicationServer.Integration.Journal.BatchSize = 10
+	conf.ApplicationServer.Integration.Journal.MaxAttempts = 3
+
+	ts.ok = mock.New()
+	ts.failing = &failingIntegration{Integration: mock.New()}
+
+	var err error
+	ts.journal, err = New(conf, map[string]integration.Integrator{
+		"ok":      ts.ok,
+		"failing": ts.failing,
+	})
+	assert.NoError(err)
+}
+
+func (ts *JournalTestSuite) TearDownSuite() {
+	ts.journal.Close()
+}
+
+func (ts *JournalTestSuite) SetupTest() {
+	test.MustResetDB(storage.DB().DB)
+}
+
+func (ts *JournalTestSuite) TestDispatch() {
+	assert := require.New(ts.T())
+
+	pl := integration.DataUpPayload{
+		ApplicationID: 1,
+		DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		FCnt:          10,
+		Data:          []byte{1, 2, 3},
+	}
+
+	assert.NoError(ts.journal.SendDataUp(pl))
+
+	ts.T().Run("One integration fails", func(t *testing.T) {
+		assert := require.New(t)
+		ts.failing.fail = true
+
+		assert.NoError(ts.journal.Dispatch())
+		assert.Equal(pl, <-ts.ok.SendDataUpChan)
+		assert.Len(ts.failing.SendDataUpChan, 0)
+
+		var e storage.IntegrationEvent
+		assert.NoError(sqlx.Get(storage.DB(), &e, "select * from integration_event"))
+		assert.Nil(e.PublishedAt)
+		assert.Nil(e.LockedUntil)
+		assert.Equal(1, e.Attempts)
+		assert.EqualValues([]string{"ok"}, e.AckedBy)
+		assert.Contains(e.LastError, "failing: integration unavailable")
+
+		t.Run("Retry succeeds", func(t *testing.T) {
+			assert := require.New(t)
+			ts.failing.fail = false
+
+			assert.NoError(ts.journal.Dispatch())
+			assert.Equal(pl, <-ts.failing.SendDataUpChan)
+			assert.Len(ts.ok.SendDataUpChan, 0)
+
+			var e storage.IntegrationEvent
+			assert.NoError(sqlx.Get(storage.DB(), &e, "select * from integration_event"))
+			assert.NotNil(e.PublishedAt)
+			assert.Equal(2, e.Attempts)
+			assert.EqualValues([]string{"ok", "failing"}, e.AckedBy)
+			assert.Equal("", e.LastError)
+		})
+	})
+
+	ts.T().Run("Max attempts", func(t *testing.T) {
+		assert := require.New(t)
+		test.MustResetDB(storage.DB().DB)
+		ts.failing.fail = true
+		defer func() { ts.failing.fail = false }()
+
+		assert.NoError(ts.journal.SendDataUp(pl))
+
+		for i := 0; i < 3; i++ {
+			assert.NoError(ts.journal.Dispatch())
+		}
+		assert.Equal(pl, <-ts.ok.SendDataUpChan)
+
+		var e storage.IntegrationEvent
+		assert.NoError(sqlx.Get(storage.DB(), &e, "select * from integration_event"))
+		assert.Nil(e.PublishedAt)
+		assert.NotNil(e.FailedAt)
+		assert.Equal(3, e.Attempts)
+
+		// failed events are no longer dispatched
+		ts.failing.fail = false
+		assert.NoError(ts.journal.Dispatch())
+		assert.Len(ts.failing.SendDataUpChan, 0)
+	})
+
+	ts.T().Run("Transaction rollback", func(t *testing.T) {
+		assert := require.New(t)
+
+		tx, err := storage.DB().Beginx()
+		assert.NoError(err)
+		assert.NoError(ts.journal.WithTx(tx).SendDataUp(pl))
+		assert.NoError(tx.Rollback())
+
+		assert.NoError(ts.journal.Dispatch())
+		assert.Len(ts.ok.SendDataUpChan, 0)
+	})
+}
+
+func TestJournal(t *testing.T) {
+	suite.Run(t, new(JournalTestSuite))
+}
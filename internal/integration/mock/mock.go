@@ -11,6 +11,8 @@ type Integration struct {
 	DataDownPayloadChan          chan integration.DataDownPayload
 	SendStatusNotificationChan   chan integration.StatusNotification
 	SendLocationNotificationChan chan integration.LocationNotification
+
+	SendHeartbeatNotificationChan chan integration.HeartbeatNotification
 }
 
 // New creates a new mock integration.
@@ -23,6 +25,8 @@ func New() *Integration {
 		DataDownPayloadChan:          make(chan integration.DataDownPayload, 100),
 		SendStatusNotificationChan:   make(chan integration.StatusNotification, 100),
 		SendLocationNotificationChan: make(chan integration.LocationNotification, 100),
+
+		SendHeartbeatNotificationChan: make(chan integration.HeartbeatNotification, 100),
 	}
 }
 
@@ -71,3 +75,9 @@ func (i *Integration) SendLocationNotification(payload integration.LocationNotif
 	i.SendLocationNotificationChan <- payload
 	return nil
 }
+
+// SendHeartbeatNotification method.
+func (i *Integration) SendHeartbeatNotification(payload integration.HeartbeatNotification) error {
+	i.SendHeartbeatNotificationChan <- payload
+	return nil
+}
@@ -0,0 +1,417 @@
+// Package modbus implements a Modbus TCP integration. It exposes the decoded
+// device measurements as Modbus registers, so that they can be read by
+// SCADA systems without custom middleware.
+package modbus
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lorawan"
+)
+
+// Register types.
+const (
+	Int16   = "int16"
+	Uint16  = "uint16"
+	Int32   = "int32"
+	Uint32  = "uint32"
+	Float32 = "float32"
+)
+
+// Modbus function codes.
+const (
+	readHoldingRegisters = 0x03
+	readInputRegisters   = 0x04
+)
+
+// Modbus exception codes.
+const (
+	illegalFunction    = 0x01
+	illegalDataAddress = 0x02
+	illegalDataValue   = 0x03
+)
+
+// maxReadQuantity defines the max number of registers that can be read
+// within a single request.
+const maxReadQuantity = 125
+
+// readTimeout defines the max time to wait for a (complete) request, after
+// which the connection is closed.
+const readTimeout = time.Minute
+
+// Config holds the Modbus TCP integration configuration.
+type Config struct {
+	Bind      string     `mapstructure:"bind"`
+	Registers []Register `mapstructure:"registers"`
+}
+
+// Register maps a variable of the decoded device payload to a Modbus
+// register address.
+type Register struct {
+	// DevEUI of the device.
+	DevEUI string `mapstructure:"dev_eui"`
+
+	// Field of the decoded payload object. Nested fields are separated by
+	// a dot (e.g. "sensor.temperature").
+	Field string `mapstructure:"field"`
+
+	// Register address. The 32 bit types occupy two registers (high word
+	// first).
+	Address uint16 `mapstructure:"address"`
+
+	// Register type (int16, uint16, int32, uint32 or float32).
+	Type string `mapstructure:"type"`
+
+	// The value is multiplied by this factor before it is stored
+	// (e.g. 10 to store 21.5 as 215). When 0, a factor of 1 is used.
+	Scale float64 `mapstructure:"scale"`
+}
+
+// size returns the number of registers occupied by the register type.
+func (r Register) size() int {
+	switch r.Type {
+	case Int32, Uint32, Float32:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// encode returns the register values for the given value. Values outside
+// the range of the register type are clamped to the min / max value of the
+// type.
+func (r Register) encode(v float64) []uint16 {
+	if r.Scale != 0 {
+		v = v * r.Scale
+	}
+
+	switch r.Type {
+	case Int16:
+		return []uint16{uint16(int16(clamp(v, math.MinInt16, math.MaxInt16)))}
+	case Int32:
+		u := uint32(int32(clamp(v, math.MinInt32, math.MaxInt32)))
+		return []uint16{uint16(u >> 16), uint16(u)}
+	case Uint32:
+		u := uint32(clamp(v, 0, math.MaxUint32))
+		return []uint16{uint16(u >> 16), uint16(u)}
+	case Float32:
+		u := math.Float32bits(float32(clamp(v, -math.MaxFloat32, math.MaxFloat32)))
+		return []uint16{uint16(u >> 16), uint16(u)}
+	default:
+		return []uint16{uint16(clamp(v, 0, math.MaxUint16))}
+	}
+}
+
+// clamp rounds the given value and limits it to the given range. NaN is
+// returned as 0.
+func clamp(v, min, max float64) float64 {
+	switch {
+	case math.IsNaN(v):
+		return 0
+	case v < min:
+		return min
+	case v > max:
+		return max
+	default:
+		return math.Round(v)
+	}
+}
+
+type mapping struct {
+	Register
+	path []string
+}
+
+// Integration implements a Modbus TCP integration.
+type Integration struct {
+	sync.RWMutex
+	ln        net.Listener
+	closed    bool
+	mappings  map[lorawan.EUI64][]mapping
+	registers map[uint16]uint16
+}
+
+// New creates a new Modbus TCP integration.
+func New(conf Config) (*Integration, error) {
+	i := Integration{
+		mappings:  make(map[lorawan.EUI64][]mapping),
+		registers: make(map[uint16]uint16),
+	}
+
+	used := make(map[int]Register)
+
+	for _, r := range conf.Registers {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(r.DevEUI)); err != nil {
+			return nil, errors.Wrapf(err, "parse dev_eui of register %d error", r.Address)
+		}
+
+		if r.Type == "" {
+			r.Type = Uint16
+		}
+
+		switch r.Type {
+		case Int16, Uint16, Int32, Uint32, Float32:
+		default:
+			return nil, fmt.Errorf("invalid type %s for register %d", r.Type, r.Address)
+		}
+
+		if r.Field == "" {
+			return nil, fmt.Errorf("field of register %d must be set", r.Address)
+		}
+
+		if int(r.Address)+r.size() > math.MaxUint16+1 {
+			return nil, fmt.Errorf("register %d exceeds the address space", r.Address)
+		}
+
+		for j := 0; j < r.size(); j++ {
+			if other, ok := used[int(r.Address)+j]; ok {
+				return nil, fmt.Errorf("register %d overlaps with register %d", r.Address, other.Address)
+			}
+			used[int(r.Address)+j] = r
+		}
+
+		i.mappings[devEUI] = append(i.mappings[devEUI], mapping{
+			Register: r,
+			path:     strings.Split(r.Field, "."),
+		})
+	}
+
+	var err error
+	log.WithField("bind", conf.Bind).Info("integration/modbus: starting modbus tcp server")
+	i.ln, err = net.Listen("tcp", conf.Bind)
+	if err != nil {
+		return nil, errors.Wrap(err, "start listener error")
+	}
+
+	go i.serve()
+
+	return &i, nil
+}
+
+// Close closes the integration.
+func (i *Integration) Close() error {
+	log.Info("integration/modbus: closing integration")
+
+	i.Lock()
+	i.closed = true
+	i.Unlock()
+
+	return i.ln.Close()
+}
+
+// SendDataUp updates the registers mapped to the device with the values of
+// the decoded payload.
+func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
+	mappings, ok := i.mappings[pl.DevEUI]
+	if !ok || pl.Object == nil {
+		return nil
+	}
+
+	// the object can be of any type (depending on the codec), by
+	// converting it to JSON and back we only have to handle the JSON types
+	b, err := json.Marshal(pl.Object)
+	if err != nil {
+		return errors.Wrap(err, "marshal object error")
+	}
+
+	var obj interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return errors.Wrap(err, "unmarshal object error")
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	for _, m := range mappings {
+		v, ok := getValue(obj, m.path)
+		if !ok {
+			continue
+		}
+
+		for j, val := range m.encode(v) {
+			i.registers[m.Address+uint16(j)] = val
+		}
+	}
+
+	return nil
+}
+
+// SendJoinNotification is not implemented.
+func (i *Integration) SendJoinNotification(pl integration.JoinNotification) error {
+	return nil
+}
+
+// SendACKNotification is not implemented.
+func (i *Integration) SendACKNotification(pl integration.ACKNotification) error {
+	return nil
+}
+
+// SendErrorNotification is not implemented.
+func (i *Integration) SendErrorNotification(pl integration.ErrorNotification) error {
+	return nil
+}
+
+// SendStatusNotification is not implemented.
+func (i *Integration) SendStatusNotification(pl integration.StatusNotification) error {
+	return nil
+}
+
+// SendLocationNotification is not implemented.
+func (i *Integration) SendLocationNotification(pl integration.LocationNotification) error {
+	return nil
+}
+
+// SendHeartbeatNotification is not implemented.
+func (i *Integration) SendHeartbeatNotification(pl integration.HeartbeatNotification) error {
+	return nil
+}
+
+// DataDownChan return nil.
+func (i *Integration) DataDownChan() chan integration.DataDownPayload {
+	return nil
+}
+
+func (i *Integration) serve() {
+	for {
+		conn, err := i.ln.Accept()
+		if err != nil {
+			i.RLock()
+			closed := i.closed
+			i.RUnlock()
+
+			if closed {
+				return
+			}
+
+			log.WithError(err).Error("integration/modbus: accept connection error")
+			continue
+		}
+
+		go i.handleConn(conn)
+	}
+}
+
+func (i *Integration) handleConn(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		// idle or slow clients must not keep the connection open forever
+		if err := conn.SetReadDeadline(time.Now().Add(readTimeout)); err != nil {
+			log.WithError(err).WithField("remote_addr", conn.RemoteAddr()).Warning("integration/modbus: set read deadline error")
+			return
+		}
+
+		// MBAP header: transaction id, protocol id, length and unit id
+		header := make([]byte, 7)
+		if _, err := io.ReadFull(conn, header); err != nil {
+			if err != io.EOF {
+				log.WithError(err).WithField("remote_addr", conn.RemoteAddr()).Warning("integration/modbus: read header error")
+			}
+			return
+		}
+
+		length := binary.BigEndian.Uint16(header[4:6])
+		if binary.BigEndian.Uint16(header[2:4]) != 0 || length < 2 || length > 254 {
+			log.WithField("remote_addr", conn.RemoteAddr()).Warning("integration/modbus: invalid header")
+			return
+		}
+
+		pdu := make([]byte, length-1)
+		if _, err := io.ReadFull(conn, pdu); err != nil {
+			log.WithError(err).WithField("remote_addr", conn.RemoteAddr()).Warning("integration/modbus: read pdu error")
+			return
+		}
+
+		resp := i.handleRequest(pdu)
+
+		out := make([]byte, 7, 7+len(resp))
+		copy(out, header)
+		binary.BigEndian.PutUint16(out[4:6], uint16(len(resp)+1))
+		out = append(out, resp...)
+
+		if _, err := conn.Write(out); err != nil {
+			log.WithError(err).WithField("remote_addr", conn.RemoteAddr()).Warning("integration/modbus: write response error")
+			return
+		}
+	}
+}
+
+// handleRequest handles the given request PDU and returns the response PDU.
+// Both holding and input registers return the same values. Registers that
+// are not mapped, or for which no value has been received yet, return 0.
+func (i *Integration) handleRequest(pdu []byte) []byte {
+	function := pdu[0]
+
+	if function != readHoldingRegisters && function != readInputRegisters {
+		return []byte{function | 0x80, illegalFunction}
+	}
+
+	if len(pdu) != 5 {
+		return []byte{function | 0x80, illegalDataValue}
+	}
+
+	address := binary.BigEndian.Uint16(pdu[1:3])
+	quantity := binary.BigEndian.Uint16(pdu[3:5])
+
+	if quantity < 1 || quantity > maxReadQuantity {
+		return []byte{function | 0x80, illegalDataValue}
+	}
+
+	if int(address)+int(quantity) > math.MaxUint16+1 {
+		return []byte{function | 0x80, illegalDataAddress}
+	}
+
+	resp := make([]byte, 2+2*int(quantity))
+	resp[0] = function
+	resp[1] = byte(2 * quantity)
+
+	i.RLock()
+	defer i.RUnlock()
+
+	for j := 0; j < int(quantity); j++ {
+		binary.BigEndian.PutUint16(resp[2+2*j:], i.registers[address+uint16(j)])
+	}
+
+	return resp
+}
+
+// getValue returns the numeric value at the given path. Booleans are
+// returned as 0 or 1.
+func getValue(obj interface{}, path []string) (float64, bool) {
+	for _, key := range path {
+		m, ok := obj.(map[string]interface{})
+		if !ok {
+			return 0, false
+		}
+
+		obj, ok = m[key]
+		if !ok {
+			return 0, false
+		}
+	}
+
+	switch v := obj.(type) {
+	case float64:
+		return v, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
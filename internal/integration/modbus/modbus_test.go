@@ -0,0 +1,185 @@
+package modbus
+
+import (
+	"io"
+	"math"
+	"net"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lorawan"
+)
+
+func init() {
+	log.SetLevel(log.ErrorLevel)
+}
+
+func TestRegisterEncode(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Register Register
+		Value    float64
+		Expected []uint16
+	}{
+		{"uint16", Register{Type: Uint16}, 1234, []uint16{1234}},
+		{"int16 negative", Register{Type: Int16}, -2, []uint16{0xfffe}},
+		{"int16 scaled", Register{Type: Int16, Scale: 10}, 21.54, []uint16{215}},
+		{"int32", Register{Type: Int32}, -1, []uint16{0xffff, 0xffff}},
+		{"uint32", Register{Type: Uint32}, 65536, []uint16{0x0001, 0x0000}},
+		{"float32", Register{Type: Float32}, 1, []uint16{0x3f80, 0x0000}},
+		{"uint16 negative", Register{Type: Uint16}, -1, []uint16{0}},
+		{"uint16 overflow", Register{Type: Uint16}, 70000, []uint16{0xffff}},
+		{"int16 overflow", Register{Type: Int16}, 40000, []uint16{0x7fff}},
+		{"int16 underflow", Register{Type: Int16, Scale: 10}, -4000, []uint16{0x8000}},
+		{"int32 overflow", Register{Type: Int32}, 1e12, []uint16{0x7fff, 0xffff}},
+		{"uint32 negative", Register{Type: Uint32}, -1, []uint16{0x0000, 0x0000}},
+		{"uint32 overflow", Register{Type: Uint32}, 1e12, []uint16{0xffff, 0xffff}},
+		{"float32 overflow", Register{Type: Float32}, 1e300, []uint16{0x7f7f, 0xffff}},
+		{"nan", Register{Type: Uint16}, math.NaN(), []uint16{0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.Expected, test.Register.encode(test.Value))
+		})
+	}
+}
+
+func TestNewValidation(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Registers []Register
+		Error     string
+	}{
+		{
+			Name:      "invalid dev_eui",
+			Registers: []Register{{DevEUI: "0102", Field: "a"}},
+			Error:     "parse dev_eui of register 0 error: lorawan: exactly 8 bytes are expected",
+		},
+		{
+			Name:      "invalid type",
+			Registers: []Register{{DevEUI: "0102030405060708", Field: "a", Type: "int64"}},
+			Error:     "invalid type int64 for register 0",
+		},
+		{
+			Name:      "missing field",
+			Registers: []Register{{DevEUI: "0102030405060708"}},
+			Error:     "field of register 0 must be set",
+		},
+		{
+			Name: "overlap",
+			Registers: []Register{
+				{DevEUI: "0102030405060708", Field: "a", Address: 10, Type: Float32},
+				{DevEUI: "0102030405060708", Field: "b", Address: 11},
+			},
+			Error: "register 11 overlaps with register 10",
+		},
+		{
+			Name:      "address space",
+			Registers: []Register{{DevEUI: "0102030405060708", Field: "a", Address: 65535, Type: Uint32}},
+			Error:     "register 65535 exceeds the address space",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			_, err := New(Config{Bind: "127.0.0.1:0", Registers: test.Registers})
+			assert.EqualError(err, test.Error)
+		})
+	}
+}
+
+func TestIntegration(t *testing.T) {
+	assert := require.New(t)
+
+	i, err := New(Config{
+		Bind: "127.0.0.1:0",
+		Registers: []Register{
+			{DevEUI: "0102030405060708", Field: "temperature", Address: 0, Type: Int16, Scale: 10},
+			{DevEUI: "0102030405060708", Field: "sensor.humidity", Address: 1},
+			{DevEUI: "0102030405060708", Field: "door", Address: 2},
+			{DevEUI: "0807060504030201", Field: "temperature", Address: 4, Type: Float32},
+		},
+	})
+	assert.NoError(err)
+	defer i.Close()
+
+	assert.NoError(i.SendDataUp(integration.DataUpPayload{
+		DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		Object: map[string]interface{}{
+			"temperature": 21.5,
+			"sensor": map[string]interface{}{
+				"humidity": 60,
+			},
+			"door": true,
+		},
+	}))
+
+	assert.NoError(i.SendDataUp(integration.DataUpPayload{
+		DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+		Object: struct {
+			Temperature float32 `json:"temperature"`
+		}{1},
+	}))
+
+	conn, err := net.Dial("tcp", i.ln.Addr().String())
+	assert.NoError(err)
+	defer conn.Close()
+
+	request := func(req []byte) []byte {
+		_, err := conn.Write(req)
+		assert.NoError(err)
+
+		header := make([]byte, 7)
+		_, err = io.ReadFull(conn, header)
+		assert.NoError(err)
+		assert.Equal(req[:4], header[:4])
+
+		resp := make([]byte, int(header[4])<<8+int(header[5])-1)
+		_, err = io.ReadFull(conn, resp)
+		assert.NoError(err)
+		return resp
+	}
+
+	t.Run("Read holding registers", func(t *testing.T) {
+		assert := require.New(t)
+		resp := request([]byte{0x00, 0x01, 0x00, 0x00, 0x00, 0x06, 0x01, 0x03, 0x00, 0x00, 0x00, 0x06})
+		assert.Equal([]byte{
+			0x03, 0x0c,
+			0x00, 0xd7, // 215
+			0x00, 0x3c, // 60
+			0x00, 0x01, // true
+			0x00, 0x00, // not mapped
+			0x3f, 0x80, 0x00, 0x00, // 1.0
+		}, resp)
+	})
+
+	t.Run("Read input registers", func(t *testing.T) {
+		assert := require.New(t)
+		resp := request([]byte{0x00, 0x02, 0x00, 0x00, 0x00, 0x06, 0x01, 0x04, 0x00, 0x01, 0x00, 0x01})
+		assert.Equal([]byte{0x04, 0x02, 0x00, 0x3c}, resp)
+	})
+
+	t.Run("Illegal function", func(t *testing.T) {
+		assert := require.New(t)
+		resp := request([]byte{0x00, 0x03, 0x00, 0x00, 0x00, 0x06, 0x01, 0x06, 0x00, 0x01, 0x00, 0x01})
+		assert.Equal([]byte{0x86, illegalFunction}, resp)
+	})
+
+	t.Run("Illegal quantity", func(t *testing.T) {
+		assert := require.New(t)
+		resp := request([]byte{0x00, 0x04, 0x00, 0x00, 0x00, 0x06, 0x01, 0x03, 0x00, 0x00, 0x00, 0x7e})
+		assert.Equal([]byte{0x83, illegalDataValue}, resp)
+	})
+
+	t.Run("Illegal address", func(t *testing.T) {
+		assert := require.New(t)
+		resp := request([]byte{0x00, 0x05, 0x00, 0x00, 0x00, 0x06, 0x01, 0x03, 0xff, 0xff, 0x00, 0x02})
+		assert.Equal([]byte{0x83, illegalDataAddress}, resp)
+	})
+}
@@ -15,6 +15,7 @@ func init() {
 	gob.Register(ErrorNotification{})
 	gob.Register(StatusNotification{})
 	gob.Register(LocationNotification{})
+	gob.Register(HeartbeatNotification{})
 }
 
 // Location details.
@@ -26,12 +27,14 @@ type Location struct {
 
 // RXInfo contains the RX information.
 type RXInfo struct {
-	GatewayID lorawan.EUI64 `json:"gatewayID"`
-	Name      string        `json:"name"`
-	Time      *time.Time    `json:"time,omitempty"`
-	RSSI      int           `json:"rssi"`
-	LoRaSNR   float64       `json:"loRaSNR"`
-	Location  *Location     `json:"location"`
+	GatewayID     lorawan.EUI64     `json:"gatewayID"`
+	Name          string            `json:"name"`
+	Time          *time.Time        `json:"time,omitempty"`
+	FineTimestamp *time.Time        `json:"fineTimestamp,omitempty"`
+	RSSI          int               `json:"rssi"`
+	LoRaSNR       float64           `json:"loRaSNR"`
+	Location      *Location         `json:"location"`
+	Tags          map[string]string `json:"tags,omitempty"`
 }
 
 // TXInfo contains the TX information.
@@ -53,6 +56,15 @@ type DataUpPayload struct {
 	FPort           uint8         `json:"fPort"`
 	Data            []byte        `json:"data"`
 	Object          interface{}   `json:"object,omitempty"`
+
+	// CorrelationID is set when the uplink is the response to a downlink
+	// for which a response was expected.
+	CorrelationID string `json:"correlationID,omitempty"`
+
+	// ReceivedAt and Reprocessed are only set when the uplink has been
+	// re-processed from the uplink archive.
+	ReceivedAt  *time.Time `json:"receivedAt,omitempty"`
+	Reprocessed bool       `json:"reprocessed,omitempty"`
 }
 
 // DataDownPayload represents a data-down payload.
@@ -96,6 +108,7 @@ type ErrorNotification struct {
 	Type            string        `json:"type"`
 	Error           string        `json:"error"`
 	FCnt            uint32        `json:"fCnt,omitempty"`
+	CorrelationID   string        `json:"correlationID,omitempty"`
 }
 
 // StatusNotification defines the payload sent to the application
@@ -121,3 +134,12 @@ type LocationNotification struct {
 	DevEUI          lorawan.EUI64 `json:"devEUI"`
 	Location        Location      `json:"location"`
 }
+
+// HeartbeatNotification defines the payload sent to the application in the
+// configured heartbeat interval. It is generated by LoRa App Server and does
+// not belong to a device.
+type HeartbeatNotification struct {
+	ApplicationID   int64     `json:"applicationID,string"`
+	ApplicationName string    `json:"applicationName"`
+	Time            time.Time `json:"time"`
+}
@@ -27,52 +27,56 @@ const downlinkLockTTL = time.Millisecond * 100
 
 // Config holds the configuration for the MQTT integration.
 type Config struct {
-	Server                  string
-	Username                string
-	Password                string
-	QOS                     uint8  `mapstructure:"qos"`
-	CleanSession            bool   `mapstructure:"clean_session"`
-	ClientID                string `mapstructure:"client_id"`
-	CACert                  string `mapstructure:"ca_cert"`
-	TLSCert                 string `mapstructure:"tls_cert"`
-	TLSKey                  string `mapstructure:"tls_key"`
-	UplinkTopicTemplate     string `mapstructure:"uplink_topic_template"`
-	DownlinkTopicTemplate   string `mapstructure:"downlink_topic_template"`
-	JoinTopicTemplate       string `mapstructure:"join_topic_template"`
-	AckTopicTemplate        string `mapstructure:"ack_topic_template"`
-	ErrorTopicTemplate      string `mapstructure:"error_topic_template"`
-	StatusTopicTemplate     string `mapstructure:"status_topic_template"`
-	LocationTopicTemplate   string `mapstructure:"location_topic_template"`
-	UplinkRetainedMessage   bool   `mapstructure:"uplink_retained_message"`
-	JoinRetainedMessage     bool   `mapstructure:"join_retained_message"`
-	AckRetainedMessage      bool   `mapstructure:"ack_retained_message"`
-	ErrorRetainedMessage    bool   `mapstructure:"error_retained_message"`
-	StatusRetainedMessage   bool   `mapstructure:"status_retained_message"`
-	LocationRetainedMessage bool   `mapstructure:"location_retained_message"`
+	Server                   string
+	Username                 string
+	Password                 string
+	QOS                      uint8  `mapstructure:"qos"`
+	CleanSession             bool   `mapstructure:"clean_session"`
+	ClientID                 string `mapstructure:"client_id"`
+	CACert                   string `mapstructure:"ca_cert"`
+	TLSCert                  string `mapstructure:"tls_cert"`
+	TLSKey                   string `mapstructure:"tls_key"`
+	UplinkTopicTemplate      string `mapstructure:"uplink_topic_template"`
+	DownlinkTopicTemplate    string `mapstructure:"downlink_topic_template"`
+	JoinTopicTemplate        string `mapstructure:"join_topic_template"`
+	AckTopicTemplate         string `mapstructure:"ack_topic_template"`
+	ErrorTopicTemplate       string `mapstructure:"error_topic_template"`
+	StatusTopicTemplate      string `mapstructure:"status_topic_template"`
+	LocationTopicTemplate    string `mapstructure:"location_topic_template"`
+	HeartbeatTopicTemplate   string `mapstructure:"heartbeat_topic_template"`
+	UplinkRetainedMessage    bool   `mapstructure:"uplink_retained_message"`
+	JoinRetainedMessage      bool   `mapstructure:"join_retained_message"`
+	AckRetainedMessage       bool   `mapstructure:"ack_retained_message"`
+	ErrorRetainedMessage     bool   `mapstructure:"error_retained_message"`
+	StatusRetainedMessage    bool   `mapstructure:"status_retained_message"`
+	LocationRetainedMessage  bool   `mapstructure:"location_retained_message"`
+	HeartbeatRetainedMessage bool   `mapstructure:"heartbeat_retained_message"`
 }
 
 // Integration implements a MQTT integration.
 type Integration struct {
-	conn             mqtt.Client
-	dataDownChan     chan integration.DataDownPayload
-	wg               sync.WaitGroup
-	redisPool        *redis.Pool
-	config           Config
-	uplinkTemplate   *template.Template
-	downlinkTemplate *template.Template
-	joinTemplate     *template.Template
-	ackTemplate      *template.Template
-	errorTemplate    *template.Template
-	statusTemplate   *template.Template
-	locationTemplate *template.Template
-	downlinkTopic    string
-	downlinkRegexp   *regexp.Regexp
-	uplinkRetained   bool
-	joinRetained     bool
-	ackRetained      bool
-	errorRetained    bool
-	statusRetained   bool
-	locationRetained bool
+	conn              mqtt.Client
+	dataDownChan      chan integration.DataDownPayload
+	wg                sync.WaitGroup
+	redisPool         *redis.Pool
+	config            Config
+	uplinkTemplate    *template.Template
+	downlinkTemplate  *template.Template
+	joinTemplate      *template.Template
+	ackTemplate       *template.Template
+	errorTemplate     *template.Template
+	statusTemplate    *template.Template
+	locationTemplate  *template.Template
+	heartbeatTemplate *template.Template
+	downlinkTopic     string
+	downlinkRegexp    *regexp.Regexp
+	uplinkRetained    bool
+	joinRetained      bool
+	ackRetained       bool
+	errorRetained     bool
+	statusRetained    bool
+	locationRetained  bool
+	heartbeatRetained bool
 }
 
 // New creates a new MQTT integration.
@@ -112,12 +116,17 @@ func New(p *redis.Pool, conf Config) (*Integration, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "parse location template error")
 	}
+	i.heartbeatTemplate, err = template.New("heartbeat").Parse(i.config.HeartbeatTopicTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse heartbeat template error")
+	}
 	i.uplinkRetained = i.config.UplinkRetainedMessage
 	i.joinRetained = i.config.JoinRetainedMessage
 	i.ackRetained = i.config.AckRetainedMessage
 	i.errorRetained = i.config.ErrorRetainedMessage
 	i.statusRetained = i.config.StatusRetainedMessage
 	i.locationRetained = i.config.LocationRetainedMessage
+	i.heartbeatRetained = i.config.HeartbeatRetainedMessage
 
 	// generate downlink topic matching all applications and devices
 	topic := bytes.NewBuffer(nil)
@@ -260,6 +269,11 @@ func (i *Integration) SendLocationNotification(payload integration.LocationNotif
 	return i.publish(payload.ApplicationID, payload.DevEUI, i.locationTemplate, i.locationRetained, payload)
 }
 
+// SendHeartbeatNotification sends a HeartbeatNotification.
+func (i *Integration) SendHeartbeatNotification(payload integration.HeartbeatNotification) error {
+	return i.publish(payload.ApplicationID, lorawan.EUI64{}, i.heartbeatTemplate, i.heartbeatRetained, payload)
+}
+
 func (i *Integration) publish(applicationID int64, devEUI lorawan.EUI64, topicTemplate *template.Template, retained bool, v interface{}) error {
 	topic := bytes.NewBuffer(nil)
 	err := topicTemplate.Execute(topic, struct {
@@ -8,12 +8,14 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/brocaar/lora-app-server/internal/chaos"
 	"github.com/brocaar/lora-app-server/internal/integration"
 	"github.com/brocaar/lora-app-server/internal/integration/awssns"
 	"github.com/brocaar/lora-app-server/internal/integration/azureservicebus"
 	"github.com/brocaar/lora-app-server/internal/integration/gcppubsub"
 	"github.com/brocaar/lora-app-server/internal/integration/http"
 	"github.com/brocaar/lora-app-server/internal/integration/influxdb"
+	"github.com/brocaar/lora-app-server/internal/integration/modbus"
 	"github.com/brocaar/lora-app-server/internal/integration/mqtt"
 	"github.com/brocaar/lora-app-server/internal/storage"
 )
@@ -47,6 +49,8 @@ func New(confs []interface{}) (*Integration, error) {
 			ii, err = influxdb.New(v)
 		case mqtt.Config:
 			ii, err = mqtt.New(storage.RedisPool(), v)
+		case modbus.Config:
+			ii, err = modbus.New(v)
 		default:
 			return nil, fmt.Errorf("unknown configuration type %T", conf)
 		}
@@ -55,7 +59,7 @@ func New(confs []interface{}) (*Integration, error) {
 			return nil, errors.Wrap(err, "new integration error")
 		}
 
-		integrations = append(integrations, ii)
+		integrations = append(integrations, chaos.Integration(ii))
 	}
 
 	return &Integration{
@@ -68,6 +72,11 @@ func (i *Integration) Add(intg integration.Integrator) {
 	i.integrations = append(i.integrations, intg)
 }
 
+// Integrations returns the list of integrations.
+func (i *Integration) Integrations() []integration.Integrator {
+	return i.integrations
+}
+
 // SendDataUp sends a data-up payload.
 func (i *Integration) SendDataUp(pl integration.DataUpPayload) error {
 	for _, ii := range i.integrations {
@@ -146,6 +155,19 @@ func (i *Integration) SendLocationNotification(pl integration.LocationNotificati
 	return nil
 }
 
+// SendHeartbeatNotification sends a heartbeat notification.
+func (i *Integration) SendHeartbeatNotification(pl integration.HeartbeatNotification) error {
+	for _, ii := range i.integrations {
+		go func(i integration.Integrator) {
+			if err := i.SendHeartbeatNotification(pl); err != nil {
+				log.WithError(err).Errorf("integration/multi: integration %T error", i)
+			}
+		}(ii)
+	}
+
+	return nil
+}
+
 // DataDownChan returns the channel containing the received DataDownPayload.
 func (i *Integration) DataDownChan() chan integration.DataDownPayload {
 	for _, ii := range i.integrations {
@@ -0,0 +1,89 @@
+package integration
+
+import (
+	"github.com/brocaar/lorawan"
+)
+
+// Redact fields define the fields of the uplink events which can be dropped
+// or redacted per application.
+const (
+	RedactRXInfo         = "rxInfo"          // drops the rx-info of the receiving gateways
+	RedactRXInfoLocation = "rxInfo.location" // drops the location of the receiving gateways
+	RedactRXInfoGateway  = "rxInfo.gateway"  // redacts the ID, name and tags of the receiving gateways
+	RedactRXInfoTime     = "rxInfo.time"     // drops the (fine-)timestamps of the receiving gateways
+	RedactTXInfo         = "txInfo"          // drops the tx-info (frequency and data-rate)
+	RedactData           = "data"            // drops the raw payload
+	RedactObject         = "object"          // drops the decoded payload
+)
+
+// ValidRedactField returns if the given redact field is supported.
+func ValidRedactField(field string) bool {
+	switch field {
+	case RedactData, RedactObject:
+		return true
+	default:
+		return ValidMetadataRedactField(field)
+	}
+}
+
+// ValidMetadataRedactField returns if the given redact field is supported
+// and only affects the gateway meta-data (rx-info and tx-info) of the
+// uplink. These can be configured per service-profile.
+func ValidMetadataRedactField(field string) bool {
+	switch field {
+	case RedactRXInfo, RedactRXInfoLocation, RedactRXInfoGateway, RedactRXInfoTime, RedactTXInfo:
+		return true
+	default:
+		return false
+	}
+}
+
+// Redact drops or redacts the given fields from the payload. The rx-info is
+// copied before it is modified, as it might be shared with the caller.
+func (p *DataUpPayload) Redact(fields []string) {
+	var rxInfoLocation, rxInfoGateway, rxInfoTime bool
+
+	for _, field := range fields {
+		switch field {
+		case RedactRXInfo:
+			p.RXInfo = nil
+		case RedactRXInfoLocation:
+			rxInfoLocation = true
+		case RedactRXInfoGateway:
+			rxInfoGateway = true
+		case RedactRXInfoTime:
+			rxInfoTime = true
+		case RedactTXInfo:
+			p.TXInfo = TXInfo{}
+		case RedactData:
+			p.Data = nil
+		case RedactObject:
+			p.Object = nil
+		}
+	}
+
+	if len(p.RXInfo) == 0 || (!rxInfoLocation && !rxInfoGateway && !rxInfoTime) {
+		return
+	}
+
+	rxInfo := make([]RXInfo, len(p.RXInfo))
+	for i := range p.RXInfo {
+		rxInfo[i] = p.RXInfo[i]
+
+		if rxInfoLocation {
+			rxInfo[i].Location = nil
+		}
+
+		if rxInfoGateway {
+			rxInfo[i].GatewayID = lorawan.EUI64{}
+			rxInfo[i].Name = ""
+			rxInfo[i].Tags = nil
+		}
+
+		if rxInfoTime {
+			rxInfo[i].Time = nil
+			rxInfo[i].FineTimestamp = nil
+		}
+	}
+	p.RXInfo = rxInfo
+}
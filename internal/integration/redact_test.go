@@ -0,0 +1,117 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestDataUpPayloadRedact(t *testing.T) {
+	now := time.Now()
+	rxInfo := []RXInfo{
+		{
+			GatewayID:     lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			Name:          "gateway-1",
+			Time:          &now,
+			FineTimestamp: &now,
+			RSSI:          -60,
+			LoRaSNR:       5.5,
+			Location: &Location{
+				Latitude:  1.123,
+				Longitude: 2.123,
+				Altitude:  3.123,
+			},
+			Tags: map[string]string{"foo": "bar"},
+		},
+	}
+
+	txInfo := TXInfo{
+		Frequency: 868100000,
+		DR:        5,
+	}
+
+	tests := []struct {
+		Name     string
+		Fields   []string
+		Expected DataUpPayload
+	}{
+		{
+			Name: "nothing redacted",
+			Expected: DataUpPayload{
+				RXInfo: rxInfo,
+				TXInfo: txInfo,
+				Data:   []byte{1, 2, 3},
+				Object: "object",
+			},
+		},
+		{
+			Name:   "drop rx-info and data",
+			Fields: []string{RedactRXInfo, RedactData},
+			Expected: DataUpPayload{
+				TXInfo: txInfo,
+				Object: "object",
+			},
+		},
+		{
+			Name:   "drop gateway location and redact gateway",
+			Fields: []string{RedactRXInfoLocation, RedactRXInfoGateway, RedactObject},
+			Expected: DataUpPayload{
+				RXInfo: []RXInfo{
+					{
+						Time:          &now,
+						FineTimestamp: &now,
+						RSSI:          -60,
+						LoRaSNR:       5.5,
+					},
+				},
+				TXInfo: txInfo,
+				Data:   []byte{1, 2, 3},
+			},
+		},
+		{
+			Name:   "drop gateway timestamps and tx-info",
+			Fields: []string{RedactRXInfoTime, RedactTXInfo},
+			Expected: DataUpPayload{
+				RXInfo: []RXInfo{
+					{
+						GatewayID: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+						Name:      "gateway-1",
+						RSSI:      -60,
+						LoRaSNR:   5.5,
+						Location: &Location{
+							Latitude:  1.123,
+							Longitude: 2.123,
+							Altitude:  3.123,
+						},
+						Tags: map[string]string{"foo": "bar"},
+					},
+				},
+				Data:   []byte{1, 2, 3},
+				Object: "object",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			pl := DataUpPayload{
+				RXInfo: rxInfo,
+				TXInfo: txInfo,
+				Data:   []byte{1, 2, 3},
+				Object: "object",
+			}
+			pl.Redact(test.Fields)
+			assert.Equal(test.Expected, pl)
+
+			// the original rx-info must not be modified
+			assert.Equal("gateway-1", rxInfo[0].Name)
+			assert.NotNil(rxInfo[0].Location)
+			assert.NotNil(rxInfo[0].Time)
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// Package sandbox implements the replaying of canned events against an
+// integration, recording the outbound requests made by the integration.
+package sandbox
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lorawan"
+)
+
+// Event types.
+const (
+	EventUplink    = "uplink"
+	EventJoin      = "join"
+	EventACK       = "ack"
+	EventError     = "error"
+	EventStatus    = "status"
+	EventLocation  = "location"
+	EventHeartbeat = "heartbeat"
+)
+
+// Events contains all the event types, in replay order.
+var Events = []string{EventUplink, EventJoin, EventACK, EventError, EventStatus, EventLocation, EventHeartbeat}
+
+// ErrUnknownEvent is returned when an unknown event type is requested.
+var ErrUnknownEvent = errors.New("unknown event type")
+
+// Fixture DevEUI and device name.
+var (
+	FixtureDevEUI     = lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	FixtureDeviceName = "sandbox-device"
+)
+
+// Request contains a recorded outbound request.
+type Request struct {
+	Method     string
+	URL        string
+	Header     http.Header
+	Body       []byte
+	StatusCode int
+	Error      string
+}
+
+// Result contains the result of replaying a single event.
+type Result struct {
+	Event    string
+	Requests []Request
+	Error    string
+}
+
+// Replay replays the canned events of the given types against the
+// integration, using the given application ID and name in the payloads.
+// When dryRun is set, the requests are recorded but not sent and a
+// 200 response is returned to the integration.
+func Replay(i integration.HTTPClientIntegrator, applicationID int64, applicationName string, events []string, dryRun bool) ([]Result, error) {
+	if len(events) == 0 {
+		events = Events
+	}
+
+	rec := recorder{dryRun: dryRun}
+	intgr := i.WithHTTPClient(&http.Client{Transport: &rec})
+
+	var out []Result
+	for _, event := range events {
+		err := send(intgr, event, applicationID, applicationName)
+		if err == ErrUnknownEvent {
+			return nil, errors.Wrap(err, event)
+		}
+
+		res := Result{
+			Event:    event,
+			Requests: rec.flush(),
+		}
+		if err != nil {
+			res.Error = err.Error()
+		}
+		out = append(out, res)
+	}
+
+	return out, nil
+}
+
+func send(i integration.Integrator, event string, applicationID int64, applicationName string) error {
+	switch event {
+	case EventUplink:
+		return i.SendDataUp(integration.DataUpPayload{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      FixtureDeviceName,
+			DevEUI:          FixtureDevEUI,
+			RXInfo: []integration.RXInfo{
+				{
+					GatewayID: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+					Name:      "sandbox-gateway",
+					RSSI:      -60,
+					LoRaSNR:   7.5,
+					Location: &integration.Location{
+						Latitude:  52.3740,
+						Longitude: 4.8897,
+						Altitude:  10,
+					},
+				},
+			},
+			TXInfo: integration.TXInfo{
+				Frequency: 868100000,
+				DR:        5,
+			},
+			ADR:   true,
+			FCnt:  10,
+			FPort: 1,
+			Data:  []byte{1, 2, 3, 4},
+			Object: map[string]interface{}{
+				"temperature": 21.5,
+				"humidity":    60,
+				"gps": map[string]interface{}{
+					"latitude":  52.3676,
+					"longitude": 4.9041,
+					"altitude":  2.0,
+				},
+			},
+		})
+	case EventJoin:
+		return i.SendJoinNotification(integration.JoinNotification{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      FixtureDeviceName,
+			DevEUI:          FixtureDevEUI,
+			DevAddr:         lorawan.DevAddr{1, 2, 3, 4},
+		})
+	case EventACK:
+		return i.SendACKNotification(integration.ACKNotification{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      FixtureDeviceName,
+			DevEUI:          FixtureDevEUI,
+			Acknowledged:    true,
+			FCnt:            11,
+		})
+	case EventError:
+		return i.SendErrorNotification(integration.ErrorNotification{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      FixtureDeviceName,
+			DevEUI:          FixtureDevEUI,
+			Type:            "UPLINK_CODEC",
+			Error:           "sandbox error",
+			FCnt:            10,
+		})
+	case EventStatus:
+		return i.SendStatusNotification(integration.StatusNotification{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      FixtureDeviceName,
+			DevEUI:          FixtureDevEUI,
+			Battery:         200,
+			Margin:          6,
+			BatteryLevel:    78.74,
+		})
+	case EventLocation:
+		return i.SendLocationNotification(integration.LocationNotification{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			DeviceName:      FixtureDeviceName,
+			DevEUI:          FixtureDevEUI,
+			Location: integration.Location{
+				Latitude:  52.3676,
+				Longitude: 4.9041,
+				Altitude:  2,
+			},
+		})
+	case EventHeartbeat:
+		return i.SendHeartbeatNotification(integration.HeartbeatNotification{
+			ApplicationID:   applicationID,
+			ApplicationName: applicationName,
+			Time:            time.Now(),
+		})
+	default:
+		return ErrUnknownEvent
+	}
+}
+
+// recorder implements a http.RoundTripper recording the requests.
+type recorder struct {
+	sync.Mutex
+	dryRun   bool
+	requests []Request
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	rec := Request{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: req.Header,
+	}
+
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "read request body error")
+		}
+		rec.Body = b
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+
+	var resp *http.Response
+	var err error
+
+	if r.dryRun {
+		resp = &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			Request:    req,
+		}
+	} else {
+		resp, err = http.DefaultTransport.RoundTrip(req)
+	}
+
+	if err != nil {
+		rec.Error = err.Error()
+	} else {
+		rec.StatusCode = resp.StatusCode
+	}
+
+	r.Lock()
+	r.requests = append(r.requests, rec)
+	r.Unlock()
+
+	return resp, err
+}
+
+// flush returns and resets the recorded requests.
+func (r *recorder) flush() []Request {
+	r.Lock()
+	defer r.Unlock()
+
+	out := r.requests
+	r.requests = nil
+	return out
+}
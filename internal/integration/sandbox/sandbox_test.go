@@ -0,0 +1,82 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	httpint "github.com/brocaar/lora-app-server/internal/integration/http"
+)
+
+func TestReplay(t *testing.T) {
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	intgr, err := httpint.New(httpint.Config{
+		Headers: map[string]string{
+			"Foo": "Bar",
+		},
+		DataUpURL:           server.URL + "/rx",
+		JoinNotificationURL: server.URL + "/join",
+	})
+	require.NoError(t, err)
+
+	t.Run("Dry-run", func(t *testing.T) {
+		assert := require.New(t)
+		received = 0
+
+		results, err := Replay(intgr, 1, "test-app", nil, true)
+		assert.NoError(err)
+		assert.Len(results, len(Events))
+		assert.Equal(0, received)
+
+		assert.Equal(EventUplink, results[0].Event)
+		assert.Len(results[0].Requests, 1)
+
+		req := results[0].Requests[0]
+		assert.Equal("POST", req.Method)
+		assert.Equal(server.URL+"/rx", req.URL)
+		assert.Equal("Bar", req.Header.Get("Foo"))
+		assert.Equal(http.StatusOK, req.StatusCode)
+
+		var pl map[string]interface{}
+		assert.NoError(json.Unmarshal(req.Body, &pl))
+		assert.Equal("test-app", pl["applicationName"])
+		assert.Equal("0102030405060708", pl["devEUI"])
+		assert.NotNil(pl["object"].(map[string]interface{})["gps"])
+
+		assert.Equal(EventJoin, results[1].Event)
+		assert.Len(results[1].Requests, 1)
+		assert.Equal(server.URL+"/join", results[1].Requests[0].URL)
+
+		// no url configured for the ack event
+		assert.Equal(EventACK, results[2].Event)
+		assert.Len(results[2].Requests, 0)
+	})
+
+	t.Run("Send", func(t *testing.T) {
+		assert := require.New(t)
+		received = 0
+
+		results, err := Replay(intgr, 1, "test-app", []string{EventUplink}, false)
+		assert.NoError(err)
+		assert.Len(results, 1)
+		assert.Equal(1, received)
+		assert.Equal(http.StatusNoContent, results[0].Requests[0].StatusCode)
+		assert.Equal("", results[0].Error)
+	})
+
+	t.Run("Unknown event", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := Replay(intgr, 1, "test-app", []string{"foo"}, true)
+		assert.Error(err)
+	})
+}
@@ -0,0 +1,81 @@
+package integration
+
+import (
+	"github.com/jmoiron/sqlx"
+)
+
+// ForTx returns the integration to which the events resulting from the
+// given transaction must be sent. When the integration is able to store the
+// events within the transaction (see TxIntegrator), the events are committed
+// together with the transaction. Otherwise the events are buffered and sent
+// by the returned function, which must be called after the transaction has
+// been committed.
+func ForTx(db sqlx.Queryer) (Integrator, func() error) {
+	if ti, ok := Integration().(TxIntegrator); ok {
+		return ti.WithTx(db), func() error { return nil }
+	}
+
+	b := &buffer{integration: Integration()}
+	return b, b.flush
+}
+
+// buffer buffers the events until they are flushed to the wrapped
+// integration.
+type buffer struct {
+	integration Integrator
+	events      []func(Integrator) error
+}
+
+func (b *buffer) SendDataUp(pl DataUpPayload) error {
+	return b.add(func(i Integrator) error { return i.SendDataUp(pl) })
+}
+
+func (b *buffer) SendJoinNotification(pl JoinNotification) error {
+	return b.add(func(i Integrator) error { return i.SendJoinNotification(pl) })
+}
+
+func (b *buffer) SendACKNotification(pl ACKNotification) error {
+	return b.add(func(i Integrator) error { return i.SendACKNotification(pl) })
+}
+
+func (b *buffer) SendErrorNotification(pl ErrorNotification) error {
+	return b.add(func(i Integrator) error { return i.SendErrorNotification(pl) })
+}
+
+func (b *buffer) SendStatusNotification(pl StatusNotification) error {
+	return b.add(func(i Integrator) error { return i.SendStatusNotification(pl) })
+}
+
+func (b *buffer) SendLocationNotification(pl LocationNotification) error {
+	return b.add(func(i Integrator) error { return i.SendLocationNotification(pl) })
+}
+
+func (b *buffer) SendHeartbeatNotification(pl HeartbeatNotification) error {
+	return b.add(func(i Integrator) error { return i.SendHeartbeatNotification(pl) })
+}
+
+func (b *buffer) DataDownChan() chan DataDownPayload {
+	return b.integration.DataDownChan()
+}
+
+func (b *buffer) Close() error {
+	return nil
+}
+
+func (b *buffer) add(f func(Integrator) error) error {
+	b.events = append(b.events, f)
+	return nil
+}
+
+// flush sends the buffered events in order. All events are sent, the first
+// error (if any) is returned.
+func (b *buffer) flush() error {
+	var out error
+	for _, f := range b.events {
+		if err := f(b.integration); err != nil && out == nil {
+			out = err
+		}
+	}
+	b.events = nil
+	return out
+}
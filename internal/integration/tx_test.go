@@ -0,0 +1,62 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+type testIntegrator struct {
+	Integrator
+
+	dataUp []DataUpPayload
+	err    error
+	tx     sqlx.Queryer
+}
+
+func (i *testIntegrator) SendDataUp(pl DataUpPayload) error {
+	i.dataUp = append(i.dataUp, pl)
+	return i.err
+}
+
+type testTxIntegrator struct {
+	*testIntegrator
+}
+
+func (i testTxIntegrator) WithTx(db sqlx.Queryer) Integrator {
+	i.tx = db
+	return i.testIntegrator
+}
+
+func TestForTx(t *testing.T) {
+	t.Run("Buffered", func(t *testing.T) {
+		assert := require.New(t)
+
+		ti := testIntegrator{err: errors.New("send error")}
+		SetIntegration(&ti)
+
+		intg, flush := ForTx(nil)
+		assert.NoError(intg.SendDataUp(DataUpPayload{FCnt: 1}))
+		assert.NoError(intg.SendDataUp(DataUpPayload{FCnt: 2}))
+		assert.Len(ti.dataUp, 0)
+
+		assert.Equal(ti.err, flush())
+		assert.Equal([]DataUpPayload{{FCnt: 1}, {FCnt: 2}}, ti.dataUp)
+	})
+
+	t.Run("Transactional", func(t *testing.T) {
+		assert := require.New(t)
+
+		tx := &sqlx.Tx{}
+		ti := testTxIntegrator{&testIntegrator{}}
+		SetIntegration(ti)
+
+		intg, flush := ForTx(tx)
+		assert.NoError(intg.SendDataUp(DataUpPayload{FCnt: 1}))
+		assert.Equal(tx, ti.tx)
+		assert.Equal([]DataUpPayload{{FCnt: 1}}, ti.dataUp)
+		assert.NoError(flush())
+	})
+}
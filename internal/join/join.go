@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/brocaar/lora-app-server/internal/cluster"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lorawan"
 	"github.com/brocaar/lorawan/backend"
@@ -38,6 +39,7 @@ var joinTasks = []func(*context) error{
 	setJoinNonce,
 	setSessionKeys,
 	createJoinAnsPayload,
+	consumeDeviceKey,
 }
 
 var rejoinTasks = []func(*context) error{
@@ -87,6 +89,8 @@ func HandleJoinRequest(pl backend.JoinReqPayload) backend.JoinAnsPayload {
 		MessageType:     backend.JoinAns,
 	}
 
+	cluster.Inc(cluster.CounterJoin)
+
 	jaPL, err := handleJoinRequest(pl)
 	if err != nil {
 		var resCode backend.ResultCode
@@ -357,6 +361,16 @@ func createJoinAnsPayload(ctx *context) error {
 	return nil
 }
 
+// consumeDeviceKey marks the pre-provisioned key of the device (if any) as
+// consumed.
+func consumeDeviceKey(ctx *context) error {
+	if err := storage.ConsumeDeviceKey(storage.DB(), ctx.devEUI); err != nil {
+		return errors.Wrap(err, "consume device-key error")
+	}
+
+	return nil
+}
+
 func createRejoinAnsPayload(ctx *context) error {
 	var cFList *lorawan.CFList
 	if len(ctx.rejoinReqPayload.CFList[:]) != 0 {
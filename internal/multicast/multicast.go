@@ -50,6 +50,9 @@ func Enqueue(db sqlx.Ext, multicastGroupID uuid.UUID, fPort uint8, data []byte)
 			FPort:            uint32(fPort),
 		},
 	})
+	if err != nil {
+		return 0, errors.Wrap(err, "enqueue multicast queue-item error")
+	}
 
 	return mg.MulticastGroup.FCnt, nil
 }
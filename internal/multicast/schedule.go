@@ -0,0 +1,137 @@
+package multicast
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/schedule"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// QueueScheduleJobType defines the schedule job type of the multicast-group
+// queue schedules.
+const QueueScheduleJobType = "multicast-queue"
+
+// QueueSchedulePayload contains the payload of a multicast-group queue
+// schedule job.
+type QueueSchedulePayload struct {
+	MulticastGroupID uuid.UUID `json:"multicastGroupID"`
+	FPort            uint8     `json:"fPort"`
+	Data             []byte    `json:"data"`
+}
+
+func init() {
+	schedule.RegisterHandler(QueueScheduleJobType, handleQueueSchedule)
+}
+
+// CreateQueueSchedule creates a schedule job which enqueues the given payload
+// according to the given cron expression, evaluated in the given time zone.
+func CreateQueueSchedule(db sqlx.Execer, expr, timezone string, p QueueSchedulePayload) (storage.ScheduleJob, error) {
+	return schedule.CreateJob(db, QueueScheduleJobType, expr, timezone, p)
+}
+
+// GetQueueSchedules returns the queue schedule jobs of the given
+// multicast-group.
+func GetQueueSchedules(db sqlx.Queryer, multicastGroupID uuid.UUID) ([]storage.ScheduleJob, error) {
+	jobs, err := storage.GetScheduleJobsForType(db, QueueScheduleJobType)
+	if err != nil {
+		return nil, errors.Wrap(err, "get schedule jobs error")
+	}
+
+	var out []storage.ScheduleJob
+	for _, j := range jobs {
+		p, err := GetQueueSchedulePayload(j)
+		if err != nil {
+			return nil, err
+		}
+		if p.MulticastGroupID == multicastGroupID {
+			out = append(out, j)
+		}
+	}
+
+	return out, nil
+}
+
+// DeleteQueueSchedule deletes the queue schedule job matching the given id.
+// ErrDoesNotExist is returned when it does not belong to the given
+// multicast-group.
+func DeleteQueueSchedule(db sqlx.Ext, multicastGroupID, id uuid.UUID) error {
+	j, err := storage.GetScheduleJob(db, id, true)
+	if err != nil {
+		return errors.Wrap(err, "get schedule job error")
+	}
+
+	p, err := GetQueueSchedulePayload(j)
+	if err != nil {
+		return err
+	}
+	if j.Type != QueueScheduleJobType || p.MulticastGroupID != multicastGroupID {
+		return storage.ErrDoesNotExist
+	}
+
+	return storage.DeleteScheduleJob(db, id)
+}
+
+// DeleteQueueSchedules deletes the queue schedule jobs of the given
+// multicast-group.
+func DeleteQueueSchedules(db sqlx.Ext, multicastGroupID uuid.UUID) error {
+	jobs, err := GetQueueSchedules(db, multicastGroupID)
+	if err != nil {
+		return err
+	}
+
+	for _, j := range jobs {
+		if err := storage.DeleteScheduleJob(db, j.ID); err != nil {
+			return errors.Wrap(err, "delete schedule job error")
+		}
+	}
+
+	return nil
+}
+
+// GetQueueSchedulePayload returns the payload of the given queue schedule
+// job.
+func GetQueueSchedulePayload(j storage.ScheduleJob) (QueueSchedulePayload, error) {
+	var p QueueSchedulePayload
+	if err := json.Unmarshal(j.Payload, &p); err != nil {
+		return p, errors.Wrap(err, "unmarshal payload error")
+	}
+	return p, nil
+}
+
+// handleQueueSchedule enqueues the payload of the given job. As the
+// execution is retried when the transaction can not be committed, the
+// payload might be enqueued more than once for the same scheduled time.
+func handleQueueSchedule(tx sqlx.Ext, job storage.ScheduleJob, scheduledAt time.Time) error {
+	p, err := GetQueueSchedulePayload(job)
+	if err != nil {
+		return err
+	}
+
+	fCnt, err := Enqueue(tx, p.MulticastGroupID, p.FPort, p.Data)
+	if err != nil {
+		// the multicast-group has been deleted, there is nothing to retry
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			log.WithFields(log.Fields{
+				"id":                 job.ID,
+				"multicast_group_id": p.MulticastGroupID,
+			}).Warning("multicast: queue schedule of unknown multicast-group")
+			return nil
+		}
+		return errors.Wrap(err, "enqueue error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":                 job.ID,
+		"multicast_group_id": p.MulticastGroupID,
+		"scheduled_at":       scheduledAt,
+		"f_cnt":              fCnt,
+	}).Info("multicast: scheduled queue-item enqueued")
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// Package provisioning implements the (encrypted) export of pre-provisioned
+// device-keys for the factory programmer.
+package provisioning
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/csv"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// Export encryption parameters.
+const (
+	SaltSize   = 16
+	NonceSize  = 12
+	Iterations = 100000
+	keySize    = 32
+)
+
+// ErrInvalidPassphrase is returned when the export can not be decrypted
+// using the given passphrase.
+var ErrInvalidPassphrase = errors.New("invalid passphrase or corrupted data")
+
+// ExportCSV returns the keys of the given batch as CSV, with the columns
+// dev_eui, join_eui and app_key (HEX encoded).
+func ExportCSV(b storage.DeviceKeyBatch, keys []storage.DeviceKey) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write([]string{"dev_eui", "join_eui", "app_key"}); err != nil {
+		return nil, errors.Wrap(err, "write csv error")
+	}
+
+	for _, k := range keys {
+		if err := w.Write([]string{k.DevEUI.String(), b.JoinEUI.String(), k.AppKey.String()}); err != nil {
+			return nil, errors.Wrap(err, "write csv error")
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, errors.Wrap(err, "flush csv error")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Encrypt encrypts the given data using AES-256-GCM. The key is derived
+// from the passphrase using PBKDF2 (SHA-256). The returned data has the
+// format: salt (16 bytes) | nonce (12 bytes) | ciphertext + GCM tag.
+func Encrypt(passphrase string, data []byte) ([]byte, error) {
+	salt := make([]byte, SaltSize)
+	nonce := make([]byte, NonceSize)
+
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "read random bytes error")
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "read random bytes error")
+	}
+
+	gcm, err := getGCM(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	out := append(salt, nonce...)
+	return gcm.Seal(out, nonce, data, nil), nil
+}
+
+// Decrypt decrypts the data encrypted by Encrypt.
+func Decrypt(passphrase string, data []byte) ([]byte, error) {
+	if len(data) < SaltSize+NonceSize {
+		return nil, ErrInvalidPassphrase
+	}
+
+	gcm, err := getGCM(passphrase, data[:SaltSize])
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := gcm.Open(nil, data[SaltSize:SaltSize+NonceSize], data[SaltSize+NonceSize:], nil)
+	if err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+
+	return out, nil
+}
+
+func getGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key := pbkdf2.Key([]byte(passphrase), salt, Iterations, keySize, sha256.New)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "new cipher error")
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "new gcm error")
+	}
+
+	return gcm, nil
+}
@@ -0,0 +1,52 @@
+package provisioning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func TestExport(t *testing.T) {
+	assert := require.New(t)
+
+	b := storage.DeviceKeyBatch{
+		JoinEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+	}
+	keys := []storage.DeviceKey{
+		{
+			DevEUI: lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 1},
+			AppKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		{
+			DevEUI: lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 2},
+			AppKey: lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+		},
+	}
+
+	csv, err := ExportCSV(b, keys)
+	assert.NoError(err)
+	assert.Equal(`dev_eui,join_eui,app_key
+0000000000000001,0807060504030201,01020304050607080102030405060708
+0000000000000002,0807060504030201,08070605040302010807060504030201
+`, string(csv))
+
+	enc, err := Encrypt("secret", csv)
+	assert.NoError(err)
+	assert.NotContains(string(enc), "0000000000000001")
+
+	t.Run("Decrypt", func(t *testing.T) {
+		assert := require.New(t)
+		dec, err := Decrypt("secret", enc)
+		assert.NoError(err)
+		assert.Equal(csv, dec)
+	})
+
+	t.Run("Invalid passphrase", func(t *testing.T) {
+		assert := require.New(t)
+		_, err := Decrypt("other", enc)
+		assert.Equal(ErrInvalidPassphrase, err)
+	})
+}
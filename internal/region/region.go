@@ -0,0 +1,121 @@
+// Package region provides the LoRaWAN regional parameters for the region
+// reported by the network-server, so that they are defined in one place.
+package region
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/band"
+)
+
+// ErrUnknownRegion is returned when the region is not known.
+var ErrUnknownRegion = errors.New("unknown region")
+
+// regionCacheTTL defines how long the region reported by a network-server
+// is cached.
+const regionCacheTTL = time.Hour
+
+var bands = map[common.Region]band.Name{
+	common.Region_EU868: band.EU_863_870,
+	common.Region_US915: band.US_902_928,
+	common.Region_CN779: band.CN_779_787,
+	common.Region_EU433: band.EU_433,
+	common.Region_AU915: band.AU_915_928,
+	common.Region_CN470: band.CN_470_510,
+	common.Region_AS923: band.AS_923,
+	common.Region_KR920: band.KR_920_923,
+	common.Region_IN865: band.IN_865_867,
+	common.Region_RU864: band.RU_864_870,
+}
+
+type cachedRegion struct {
+	region  common.Region
+	expires time.Time
+}
+
+var (
+	cacheMu sync.RWMutex
+	cache   = make(map[int64]cachedRegion)
+)
+
+// GetForNetworkServer returns the region of the given network-server.
+// The region is retrieved from the network-server and cached (by
+// network-server ID), so that it is not requested on every call.
+func GetForNetworkServer(networkServerID int64, nsClient ns.NetworkServerServiceClient) (common.Region, error) {
+	cacheMu.RLock()
+	c, ok := cache[networkServerID]
+	cacheMu.RUnlock()
+	if ok && time.Now().Before(c.expires) {
+		return c.region, nil
+	}
+
+	resp, err := nsClient.GetVersion(context.Background(), &empty.Empty{})
+	if err != nil {
+		return 0, errors.Wrap(err, "get network-server version error")
+	}
+
+	cacheMu.Lock()
+	cache[networkServerID] = cachedRegion{
+		region:  resp.Region,
+		expires: time.Now().Add(regionCacheTTL),
+	}
+	cacheMu.Unlock()
+
+	return resp.Region, nil
+}
+
+// GetBand returns the band for the given region. As the dwell-time settings
+// of the network-server are unknown, no dwell-time limit is assumed.
+func GetBand(r common.Region) (band.Band, error) {
+	name, ok := bands[r]
+	if !ok {
+		return nil, errors.Wrap(ErrUnknownRegion, r.String())
+	}
+
+	b, err := band.GetConfig(name, false, lorawan.DwellTimeNoLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, "get band config error")
+	}
+
+	return b, nil
+}
+
+// GetRX1DataRate returns the RX1 data-rate index for the given region,
+// uplink data-rate index and RX1 data-rate offset.
+func GetRX1DataRate(r common.Region, uplinkDR, rx1DROffset int) (int, error) {
+	b, err := GetBand(r)
+	if err != nil {
+		return 0, err
+	}
+
+	dr, err := b.GetRX1DataRateIndex(uplinkDR, rx1DROffset)
+	if err != nil {
+		return 0, errors.Wrapf(err, "get rx1 data-rate for data-rate %d and offset %d error", uplinkDR, rx1DROffset)
+	}
+
+	return dr, nil
+}
+
+// GetMaxPayloadSize returns the max (FRMPayload) size in bytes for the given
+// region, LoRaWAN mac-version, regional-parameters revision and data-rate.
+func GetMaxPayloadSize(r common.Region, macVersion, regParamsRevision string, dr int) (int, error) {
+	b, err := GetBand(r)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := b.GetMaxPayloadSizeForDataRateIndex(macVersion, regParamsRevision, dr)
+	if err != nil {
+		return 0, errors.Wrapf(err, "get max payload-size for data-rate %d error", dr)
+	}
+
+	return size.N, nil
+}
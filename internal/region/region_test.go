@@ -0,0 +1,92 @@
+package region
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/ns"
+)
+
+func TestRegions(t *testing.T) {
+	assert := require.New(t)
+
+	// all regions known by the network-server must be defined
+	for v, name := range common.Region_name {
+		t.Run(name, func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := GetBand(common.Region(v))
+			assert.NoError(err)
+		})
+	}
+
+	_, err := GetBand(common.Region(-1))
+	assert.Equal(ErrUnknownRegion, errors.Cause(err))
+}
+
+func TestGetRX1DataRate(t *testing.T) {
+	assert := require.New(t)
+
+	dr, err := GetRX1DataRate(common.Region_EU868, 5, 2)
+	assert.NoError(err)
+	assert.Equal(3, dr)
+
+	dr, err = GetRX1DataRate(common.Region_EU868, 1, 3)
+	assert.NoError(err)
+	assert.Equal(0, dr)
+
+	dr, err = GetRX1DataRate(common.Region_US915, 0, 0)
+	assert.NoError(err)
+	assert.Equal(10, dr)
+}
+
+func TestGetForNetworkServer(t *testing.T) {
+	assert := require.New(t)
+
+	nsClient := mock.NewClient()
+	nsClient.GetVersionResponse = ns.GetVersionResponse{Region: common.Region_US915}
+
+	r, err := GetForNetworkServer(1, nsClient)
+	assert.NoError(err)
+	assert.Equal(common.Region_US915, r)
+
+	// the region is cached
+	nsClient.GetVersionResponse = ns.GetVersionResponse{Region: common.Region_EU868}
+	r, err = GetForNetworkServer(1, nsClient)
+	assert.NoError(err)
+	assert.Equal(common.Region_US915, r)
+}
+
+func TestGetMaxPayloadSize(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Region   common.Region
+		DR       int
+		Expected int
+		Error    bool
+	}{
+		{"EU868 DR0", common.Region_EU868, 0, 51, false},
+		{"EU868 DR5", common.Region_EU868, 5, 242, false},
+		{"US915 DR0", common.Region_US915, 0, 11, false},
+		{"invalid data-rate", common.Region_EU868, 20, 0, true},
+		{"unknown region", common.Region(-1), 0, 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			size, err := GetMaxPayloadSize(test.Region, "1.0.2", "B", test.DR)
+			if test.Error {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(test.Expected, size)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Package reprocess implements the re-processing of archived uplinks using
+// the current payload codec of the application.
+package reprocess
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/archive"
+	"github.com/brocaar/lora-app-server/internal/cluster"
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/uplink"
+)
+
+const (
+	// heartbeatInterval defines the interval in which the progress of a
+	// running job is stored, which also marks the job as alive.
+	heartbeatInterval = 30 * time.Second
+
+	// staleAfter defines after which duration without update a running job
+	// is considered stale (e.g. the processing instance crashed) and is
+	// claimed again.
+	staleAfter = 5 * time.Minute
+)
+
+// ProcessJobsLoop is a never returning function processing the pending
+// reprocess uplinks jobs.
+func ProcessJobsLoop() {
+	for {
+		if !cluster.IsLeader(cluster.RoleReprocessUplinks) {
+			time.Sleep(time.Second)
+			continue
+		}
+
+		if err := processPendingJob(); err != nil {
+			if errors.Cause(err) != storage.ErrDoesNotExist {
+				log.WithError(err).Error("process reprocess uplinks job error")
+			}
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+func processPendingJob() error {
+	job, err := storage.ClaimPendingReprocessUplinksJob(storage.DB(), staleAfter)
+	if err != nil {
+		return errors.Wrap(err, "claim pending job error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":             job.ID,
+		"application_id": job.ApplicationID,
+	}).Info("reprocess: processing job")
+
+	if err := processJob(&job); err != nil {
+		job.State = storage.ReprocessUplinksJobError
+		job.Error = err.Error()
+	} else {
+		job.State = storage.ReprocessUplinksJobDone
+	}
+
+	return storage.UpdateReprocessUplinksJob(storage.DB(), &job)
+}
+
+func processJob(job *storage.ReprocessUplinksJob) error {
+	app, err := storage.GetApplication(storage.DB(), job.ApplicationID)
+	if err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	return archive.ListUplinks(app.ID, job.Start, job.End, func(u archive.Uplink) error {
+		job.UplinkCount++
+
+		if err := reprocessUplink(app, u); err != nil {
+			job.ErrorCount++
+			log.WithFields(log.Fields{
+				"id":      job.ID,
+				"dev_eui": u.DevEUI,
+			}).WithError(err).Error("reprocess: reprocess uplink error")
+		}
+
+		if time.Since(job.UpdatedAt) > heartbeatInterval {
+			if err := storage.UpdateReprocessUplinksJob(storage.DB(), job); err != nil {
+				return errors.Wrap(err, "update job error")
+			}
+		}
+
+		return nil
+	})
+}
+
+// reprocessUplink decodes the given uplink using the current codec of the
+// application and sends the result to the integrations.
+func reprocessUplink(app storage.Application, u archive.Uplink) error {
+	if u.Request == nil {
+		return errors.New("uplink does not contain the network-server request")
+	}
+	req := u.Request
+
+	// the device might have been removed since the uplink was archived
+	d, err := storage.GetDevice(storage.DB(), u.DevEUI, false, true)
+	if err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+		return errors.Wrap(err, "get device error")
+	}
+	deviceExists := err == nil
+
+	var object interface{}
+	codecPL := codec.NewPayload(app.PayloadCodec, app.OrganizationID, uint8(req.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
+	if codecPL != nil {
+		if deviceExists {
+			chain, err := storage.GetDeviceProfileCodecChain(storage.DB(), d.DeviceProfileID)
+			if err != nil {
+				return errors.Wrap(err, "get codec chain error")
+			}
+			codecPL = codec.NewChainPayload(chain, app.OrganizationID, uint8(req.FPort), codecPL)
+		}
+
+		if err := codecPL.DecodeBytes(u.Data); err != nil {
+			return errors.Wrap(err, "decode payload error")
+		}
+		object = codecPL.Object()
+	}
+
+	pl := integration.DataUpPayload{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DevEUI:          u.DevEUI,
+		ADR:             req.Adr,
+		FCnt:            req.FCnt,
+		FPort:           uint8(req.FPort),
+		Data:            u.Data,
+		Object:          object,
+		ReceivedAt:      &u.ReceivedAt,
+		Reprocessed:     true,
+	}
+
+	if req.TxInfo != nil {
+		pl.TXInfo = integration.TXInfo{
+			Frequency: int(req.TxInfo.Frequency),
+			DR:        int(req.Dr),
+		}
+	}
+
+	if deviceExists {
+		pl.DeviceName = d.Name
+	}
+
+	pl.RXInfo, err = uplink.RXInfo(u.DevEUI, req.RxInfo)
+	if err != nil {
+		return errors.Wrap(err, "get rx-info error")
+	}
+
+	redactFields, err := storage.GetApplicationRedactFields(storage.DB(), app)
+	if err != nil {
+		return errors.Wrap(err, "get redact fields error")
+	}
+	pl.Redact(redactFields)
+
+	if err := integration.Integration().SendDataUp(pl); err != nil {
+		return errors.Wrap(err, "send uplink data to integration error")
+	}
+
+	return nil
+}
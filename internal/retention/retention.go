@@ -0,0 +1,99 @@
+// Package retention implements the periodic removal of the stored device
+// data which is older than the configured retention.
+package retention
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/cluster"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// cleanupInterval defines the interval between two cleanups.
+const cleanupInterval = time.Hour
+
+var (
+	deviceMeasurements time.Duration
+	deviceLocations    time.Duration
+)
+
+// Setup configures the retention package.
+func Setup(conf config.Config) error {
+	deviceMeasurements = conf.ApplicationServer.Retention.DeviceMeasurements
+	deviceLocations = conf.ApplicationServer.Retention.DeviceLocations
+	return nil
+}
+
+// CleanupLoop is a never returning function removing the data which is older
+// than the configured retention.
+func CleanupLoop() {
+	for {
+		if cluster.IsLeader(cluster.RoleRetention) {
+			if err := Cleanup(); err != nil {
+				log.WithError(err).Error("retention: cleanup error")
+			}
+		}
+		time.Sleep(cleanupInterval)
+	}
+}
+
+// Cleanup removes the data which is older than the configured retention
+// from the default and the configured storage clusters. A retention of 0
+// keeps the data forever. The device data of the devices which do not belong
+// to a storage cluster anymore is removed from this storage cluster.
+func Cleanup() error {
+	for _, name := range append([]string{""}, storage.StorageClusterNames()...) {
+		if err := cleanupStorageCluster(name); err != nil {
+			return errors.Wrapf(err, "storage cluster %s", name)
+		}
+	}
+
+	return nil
+}
+
+func cleanupStorageCluster(name string) error {
+	db, err := storage.StorageClusterDB(name)
+	if err != nil {
+		return errors.Wrap(err, "get storage cluster db error")
+	}
+
+	logger := log.WithField("storage_cluster", name)
+
+	if deviceMeasurements > 0 {
+		count, err := storage.DeleteDeviceMeasurementsBefore(db, time.Now().Add(-deviceMeasurements))
+		if err != nil {
+			return errors.Wrap(err, "delete device measurements error")
+		}
+		if count > 0 {
+			logger.WithField("count", count).Info("retention: device measurements deleted")
+		}
+	}
+
+	if deviceLocations > 0 {
+		count, err := storage.DeleteDeviceLocationsBefore(db, time.Now().Add(-deviceLocations))
+		if err != nil {
+			return errors.Wrap(err, "delete device locations error")
+		}
+		if count > 0 {
+			logger.WithField("count", count).Info("retention: device locations deleted")
+		}
+	}
+
+	// within the default storage cluster, the device data is removed
+	// together with the device
+	if name != "" {
+		count, err := storage.DeleteOrphanedDeviceData(name)
+		if err != nil {
+			return errors.Wrap(err, "delete orphaned device data error")
+		}
+		if count > 0 {
+			logger.WithField("count", count).Info("retention: orphaned device data deleted")
+		}
+	}
+
+	return nil
+}
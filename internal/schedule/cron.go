@@ -0,0 +1,276 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// maxSearchYears defines how far ahead Next searches for a matching time,
+// so that expressions which never match (e.g. 30 February) terminate.
+const maxSearchYears = 5
+
+// field defines the range of a cron field.
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 6},
+}
+
+// Cron is a parsed cron expression, evaluated in the wall-clock time of its
+// location.
+//
+// The expression has five fields: minute, hour, day of month, month and
+// day of week (0 = Sunday, 7 is accepted as Sunday as well). Each field
+// can be *, a value, a range (1-5) or a list of these (1,3,5), optionally
+// with a step (*/15, 0-30/10). As with the standard cron, when both the day
+// of month and the day of week are restricted, a day matches when either
+// field matches.
+//
+// Wall-clock times skipped by a daylight saving time transition (e.g. 02:30
+// when the clock moves from 02:00 to 03:00) are executed once, at the moment
+// of the transition. Wall-clock times occurring twice (e.g. 02:30 when the
+// clock moves from 03:00 back to 02:00) are only executed the first time.
+type Cron struct {
+	expr     string
+	location *time.Location
+
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+// ParseCron parses the given cron expression for the given location.
+func ParseCron(expr string, location *time.Location) (Cron, error) {
+	c := Cron{
+		expr:     expr,
+		location: location,
+	}
+
+	if location == nil {
+		return c, errors.New("location must not be nil")
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return c, fmt.Errorf("expected %d fields, got %d", len(fields), len(parts))
+	}
+
+	// the day of week accepts 7 as Sunday
+	dowField := fields[4]
+	dowField.max = 7
+
+	targets := []*uint64{&c.minute, &c.hour, &c.dom, &c.month, &c.dow}
+	for i, part := range parts {
+		f := fields[i]
+		if i == 4 {
+			f = dowField
+		}
+
+		bits, err := parseField(part, f)
+		if err != nil {
+			return c, errors.Wrapf(err, "parse %s error", f.name)
+		}
+		*targets[i] = bits
+	}
+
+	if c.dow&(1<<7) != 0 {
+		c.dow = c.dow&^(1<<7) | 1
+	}
+
+	c.domStar = parts[2] == "*"
+	c.dowStar = parts[4] == "*"
+
+	return c, nil
+}
+
+// LoadCron parses the given cron expression for the given IANA time zone
+// name (e.g. Europe/Amsterdam).
+func LoadCron(expr, timezone string) (Cron, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return Cron{}, errors.Wrap(err, "load location error")
+	}
+	return ParseCron(expr, loc)
+}
+
+// String returns the cron expression.
+func (c Cron) String() string {
+	return c.expr
+}
+
+// Location returns the location in which the expression is evaluated.
+func (c Cron) Location() *time.Location {
+	return c.location
+}
+
+// Next returns the first time after the given time matching the cron
+// expression. The zero time is returned when no matching time exists within
+// the next five years.
+func (c Cron) Next(after time.Time) time.Time {
+	local := after.In(c.location)
+
+	// iterate over the wall-clock times, starting at the next minute
+	y, m, d := local.Date()
+	h, min := local.Hour(), local.Minute()+1
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	end := day.AddDate(maxSearchYears, 0, 0)
+
+	for ; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if c.matchDay(day) {
+			for ; h < 24; h++ {
+				if c.hour&(1<<uint(h)) == 0 {
+					min = 0
+					continue
+				}
+
+				for ; min < 60; min++ {
+					if c.minute&(1<<uint(min)) == 0 {
+						continue
+					}
+
+					if t := c.resolve(day, h, min); t.After(after) {
+						return t
+					}
+				}
+				min = 0
+			}
+		}
+
+		h, min = 0, 0
+	}
+
+	return time.Time{}
+}
+
+// matchDay returns if the given (wall-clock) day matches the day of month,
+// month and day of week fields.
+func (c Cron) matchDay(day time.Time) bool {
+	if c.month&(1<<uint(day.Month())) == 0 {
+		return false
+	}
+
+	domMatch := c.dom&(1<<uint(day.Day())) != 0
+	dowMatch := c.dow&(1<<uint(day.Weekday())) != 0
+
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// resolve returns the instant of the given wall-clock time. When the
+// wall-clock time occurs twice because of a daylight saving time transition,
+// the first instant is returned. When it does not exist, the instant of the
+// transition is returned.
+func (c Cron) resolve(day time.Time, hour, minute int) time.Time {
+	y, m, d := day.Date()
+	wall := time.Date(y, m, d, hour, minute, 0, 0, time.UTC)
+
+	if t, ok := c.instant(wall); ok {
+		return t
+	}
+
+	// the wall-clock time falls within a gap, find the first existing
+	// wall-clock time after it
+	for i := 1; i <= 24*60; i++ {
+		if t, ok := c.instant(wall.Add(time.Duration(i) * time.Minute)); ok {
+			return t
+		}
+	}
+
+	return time.Date(y, m, d, hour, minute, 0, 0, c.location)
+}
+
+// instant returns the first instant at which the location has the given
+// wall-clock time (expressed in UTC). As transitions are at least a day
+// apart, the candidate offsets are the offsets half a day before and after.
+func (c Cron) instant(wall time.Time) (time.Time, bool) {
+	var out time.Time
+	var found bool
+
+	for _, ref := range []time.Time{wall.Add(-12 * time.Hour), wall.Add(12 * time.Hour)} {
+		_, offset := ref.In(c.location).Zone()
+		t := wall.Add(-time.Duration(offset) * time.Second).In(c.location)
+
+		if t.Hour() != wall.Hour() || t.Minute() != wall.Minute() || t.Day() != wall.Day() {
+			continue
+		}
+		if !found || t.Before(out) {
+			out = t
+			found = true
+		}
+	}
+
+	return out, found
+}
+
+// parseField parses a single cron field into a bitmask of the matching
+// values.
+func parseField(s string, f field) (uint64, error) {
+	var bits uint64
+
+	for _, item := range strings.Split(s, ",") {
+		step := 1
+		if i := strings.Index(item, "/"); i != -1 {
+			var err error
+			step, err = strconv.Atoi(item[i+1:])
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step: %s", item)
+			}
+			item = item[:i]
+		}
+
+		start, end := f.min, f.max
+		switch {
+		case item == "*":
+		case strings.Contains(item, "-"):
+			parts := strings.SplitN(item, "-", 2)
+			var err error
+			if start, err = parseValue(parts[0], f); err != nil {
+				return 0, err
+			}
+			if end, err = parseValue(parts[1], f); err != nil {
+				return 0, err
+			}
+			if end < start {
+				return 0, fmt.Errorf("invalid range: %s", item)
+			}
+		default:
+			v, err := parseValue(item, f)
+			if err != nil {
+				return 0, err
+			}
+			start = v
+			if step == 1 {
+				end = v
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value: %s", s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, f.min, f.max)
+	}
+	return v, nil
+}
@@ -0,0 +1,183 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		Expr  string
+		Error bool
+	}{
+		{Expr: "* * * * *"},
+		{Expr: "*/15 8-17 * * 1-5"},
+		{Expr: "0 0 1,15 * *"},
+		{Expr: "0 12 * * 7"},
+		{Expr: "* * * *", Error: true},
+		{Expr: "60 * * * *", Error: true},
+		{Expr: "* 24 * * *", Error: true},
+		{Expr: "* * 0 * *", Error: true},
+		{Expr: "* * * 13 *", Error: true},
+		{Expr: "* * * * 8", Error: true},
+		{Expr: "5-1 * * * *", Error: true},
+		{Expr: "*/0 * * * *", Error: true},
+		{Expr: "a * * * *", Error: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Expr, func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := ParseCron(test.Expr, time.UTC)
+			if test.Error {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name     string
+		Expr     string
+		Location *time.Location
+		After    time.Time
+		Expected []time.Time
+	}{
+		{
+			Name:     "every 15 minutes",
+			Expr:     "*/15 * * * *",
+			Location: time.UTC,
+			After:    time.Date(2019, 1, 1, 10, 7, 30, 0, time.UTC),
+			Expected: []time.Time{
+				time.Date(2019, 1, 1, 10, 15, 0, 0, time.UTC),
+				time.Date(2019, 1, 1, 10, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			Name:     "daily in time zone",
+			Expr:     "0 9 * * *",
+			Location: amsterdam,
+			After:    time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC),
+			Expected: []time.Time{
+				time.Date(2019, 1, 2, 8, 0, 0, 0, time.UTC),
+				time.Date(2019, 1, 3, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			Name:     "weekdays",
+			Expr:     "0 8 * * 1-5",
+			Location: time.UTC,
+			After:    time.Date(2019, 1, 4, 9, 0, 0, 0, time.UTC), // friday
+			Expected: []time.Time{
+				time.Date(2019, 1, 7, 8, 0, 0, 0, time.UTC),
+				time.Date(2019, 1, 8, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			Name:     "day of month or day of week",
+			Expr:     "0 0 13 * 5",
+			Location: time.UTC,
+			After:    time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			Expected: []time.Time{
+				time.Date(2019, 1, 4, 0, 0, 0, 0, time.UTC),  // friday
+				time.Date(2019, 1, 11, 0, 0, 0, 0, time.UTC), // friday
+				time.Date(2019, 1, 13, 0, 0, 0, 0, time.UTC), // 13th
+			},
+		},
+		{
+			Name:     "sunday as 7",
+			Expr:     "0 12 * * 7",
+			Location: time.UTC,
+			After:    time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			Expected: []time.Time{
+				time.Date(2019, 1, 6, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			Name:     "leap day",
+			Expr:     "0 0 29 2 *",
+			Location: time.UTC,
+			After:    time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			Expected: []time.Time{
+				time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			Name:     "no match",
+			Expr:     "0 0 30 2 *",
+			Location: time.UTC,
+			After:    time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			Expected: []time.Time{
+				{},
+			},
+		},
+		{
+			Name:     "dst start, skipped time runs at transition",
+			Expr:     "30 2 * * *",
+			Location: amsterdam,
+			After:    time.Date(2019, 3, 30, 12, 0, 0, 0, time.UTC),
+			Expected: []time.Time{
+				time.Date(2019, 3, 31, 1, 0, 0, 0, time.UTC), // 03:00 CEST
+				time.Date(2019, 4, 1, 0, 30, 0, 0, time.UTC), // 02:30 CEST
+			},
+		},
+		{
+			Name:     "dst start, skipped times run once",
+			Expr:     "*/20 2 * * *",
+			Location: amsterdam,
+			After:    time.Date(2019, 3, 30, 12, 0, 0, 0, time.UTC),
+			Expected: []time.Time{
+				time.Date(2019, 3, 31, 1, 0, 0, 0, time.UTC), // 03:00 CEST
+				time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC),  // 02:00 CEST
+			},
+		},
+		{
+			Name:     "dst end, repeated time runs once",
+			Expr:     "30 2 * * *",
+			Location: amsterdam,
+			After:    time.Date(2019, 10, 26, 12, 0, 0, 0, time.UTC),
+			Expected: []time.Time{
+				time.Date(2019, 10, 27, 0, 30, 0, 0, time.UTC), // 02:30 CEST
+				time.Date(2019, 10, 28, 1, 30, 0, 0, time.UTC), // 02:30 CET
+			},
+		},
+		{
+			Name:     "dst end, hourly keeps running",
+			Expr:     "0 * * * *",
+			Location: amsterdam,
+			After:    time.Date(2019, 10, 26, 23, 30, 0, 0, time.UTC),
+			Expected: []time.Time{
+				time.Date(2019, 10, 27, 0, 0, 0, 0, time.UTC), // 02:00 CEST
+				time.Date(2019, 10, 27, 2, 0, 0, 0, time.UTC), // 03:00 CET
+				time.Date(2019, 10, 27, 3, 0, 0, 0, time.UTC), // 04:00 CET
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			c, err := ParseCron(test.Expr, test.Location)
+			assert.NoError(err)
+
+			after := test.After
+			for _, exp := range test.Expected {
+				next := c.Next(after)
+				assert.True(exp.Equal(next), "expected: %s, got: %s", exp, next)
+				after = next
+			}
+		})
+	}
+}
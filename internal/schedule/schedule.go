@@ -0,0 +1,208 @@
+// Package schedule implements the execution of jobs according to cron
+// expressions with an explicit time zone. The jobs are persisted in the
+// database and are executed at least once per scheduled time.
+package schedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/cluster"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// maxRetryDelay defines the max. delay between two attempts of a failed
+// execution.
+const maxRetryDelay = time.Hour
+
+// Handler executes a job for the given scheduled time, within the given
+// transaction. When an error is returned, the transaction is rolled back
+// and the execution is retried. As the execution of a job is guaranteed to
+// happen at least once per scheduled time (and not exactly once), handlers
+// must be idempotent.
+type Handler func(tx sqlx.Ext, job storage.ScheduleJob, scheduledAt time.Time) error
+
+var (
+	handlersMux sync.RWMutex
+	handlers    = make(map[string]Handler)
+)
+
+// RegisterHandler registers the handler for the given job type.
+func RegisterHandler(jobType string, h Handler) {
+	handlersMux.Lock()
+	defer handlersMux.Unlock()
+	handlers[jobType] = h
+}
+
+// CreateJob creates a job of the given type, executed according to the
+// given cron expression and time zone (e.g. Europe/Amsterdam). The payload
+// is passed to the handler as part of the job.
+func CreateJob(db sqlx.Execer, jobType, expr, timezone string, payload interface{}) (storage.ScheduleJob, error) {
+	j := storage.ScheduleJob{
+		Type:     jobType,
+		Cron:     expr,
+		Timezone: timezone,
+	}
+
+	c, err := LoadCron(expr, timezone)
+	if err != nil {
+		return j, errors.Wrap(storage.ErrScheduleJobInvalid, err.Error())
+	}
+
+	j.NextRunAt = c.Next(time.Now())
+	if j.NextRunAt.IsZero() {
+		return j, errors.Wrap(storage.ErrScheduleJobInvalid, "cron expression never matches")
+	}
+	j.DueAt = j.NextRunAt
+
+	if payload != nil {
+		j.Payload, err = json.Marshal(payload)
+		if err != nil {
+			return j, errors.Wrap(err, "marshal payload error")
+		}
+	}
+
+	if err := storage.CreateScheduleJob(db, &j); err != nil {
+		return j, errors.Wrap(err, "create schedule job error")
+	}
+
+	return j, nil
+}
+
+// HandleJobsLoop is a never returning function executing the jobs which
+// are due.
+func HandleJobsLoop() {
+	for {
+		if !cluster.IsLeader(cluster.RoleScheduler) {
+			time.Sleep(time.Second)
+			continue
+		}
+
+		if err := handleNextJob(); err != nil {
+			if errors.Cause(err) != storage.ErrDoesNotExist {
+				log.WithError(err).Error("handle schedule job error")
+			}
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+// handleNextJob executes the next job which is due. When the handler
+// returns an error, its changes are rolled back and the failure is recorded
+// in a separate transaction, so that the execution is retried.
+func handleNextJob() error {
+	var job storage.ScheduleJob
+	var handlerErr error
+
+	err := storage.Transaction(func(tx sqlx.Ext) error {
+		var err error
+		job, err = storage.GetNextScheduleJobForUpdate(tx)
+		if err != nil {
+			return errors.Wrap(err, "get next schedule job error")
+		}
+
+		if handlerErr = executeJob(tx, job); handlerErr != nil {
+			return handlerErr
+		}
+
+		return scheduleNextRun(tx, &job)
+	})
+	if handlerErr != nil {
+		log.WithFields(log.Fields{
+			"id":           job.ID,
+			"type":         job.Type,
+			"scheduled_at": job.NextRunAt,
+		}).WithError(handlerErr).Error("schedule: job execution error")
+
+		return storage.Transaction(func(tx sqlx.Ext) error {
+			return recordFailure(tx, job.ID, handlerErr)
+		})
+	}
+
+	return err
+}
+
+func executeJob(tx sqlx.Ext, job storage.ScheduleJob) error {
+	handlersMux.RLock()
+	h, ok := handlers[job.Type]
+	handlersMux.RUnlock()
+
+	// the job is retried, as the handler might be registered by an other
+	// (e.g. newer) instance
+	if !ok {
+		return fmt.Errorf("no handler registered for job type: %s", job.Type)
+	}
+
+	return h(tx, job, job.NextRunAt)
+}
+
+// scheduleNextRun schedules the next run of the given job, after its
+// successful execution. Scheduled times which have been missed (e.g. when
+// the application-server was not running) are executed only once. A job
+// without next scheduled time is deleted.
+func scheduleNextRun(tx sqlx.Ext, job *storage.ScheduleJob) error {
+	c, err := LoadCron(job.Cron, job.Timezone)
+	if err != nil {
+		return errors.Wrap(err, "load cron error")
+	}
+
+	lastRunAt := job.NextRunAt
+	after := time.Now()
+	if lastRunAt.After(after) {
+		after = lastRunAt
+	}
+
+	next := c.Next(after)
+	if next.IsZero() {
+		return storage.DeleteScheduleJob(tx, job.ID)
+	}
+
+	job.LastRunAt = &lastRunAt
+	job.NextRunAt = next
+	job.DueAt = next
+	job.Attempts = 0
+	job.LastError = ""
+
+	log.WithFields(log.Fields{
+		"id":          job.ID,
+		"type":        job.Type,
+		"next_run_at": job.NextRunAt,
+	}).Info("schedule: job executed")
+
+	return storage.UpdateScheduleJob(tx, job)
+}
+
+// recordFailure records the failed execution of the given job and schedules
+// the retry, using an exponential backoff.
+func recordFailure(tx sqlx.Ext, id uuid.UUID, handlerErr error) error {
+	job, err := storage.GetScheduleJob(tx, id, true)
+	if err != nil {
+		return errors.Wrap(err, "get schedule job error")
+	}
+
+	job.Attempts++
+	job.LastError = handlerErr.Error()
+	job.DueAt = time.Now().Add(retryDelay(job.Attempts))
+
+	return storage.UpdateScheduleJob(tx, &job)
+}
+
+// retryDelay returns the delay before the next attempt, given the number of
+// failed attempts.
+func retryDelay(attempts int) time.Duration {
+	delay := 10 * time.Second
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
@@ -0,0 +1,17 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryDelay(t *testing.T) {
+	assert := require.New(t)
+
+	assert.Equal(10*time.Second, retryDelay(1))
+	assert.Equal(20*time.Second, retryDelay(2))
+	assert.Equal(40*time.Second, retryDelay(3))
+	assert.Equal(time.Hour, retryDelay(20))
+}
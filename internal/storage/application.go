@@ -3,9 +3,13 @@ package storage
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lorawan"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	uuid "github.com/gofrs/uuid"
 	log "github.com/sirupsen/logrus"
@@ -23,8 +27,28 @@ type Application struct {
 	PayloadCodec         codec.Type `db:"payload_codec"`
 	PayloadEncoderScript string     `db:"payload_encoder_script"`
 	PayloadDecoderScript string     `db:"payload_decoder_script"`
+
+	// MasterKey (optional) is used to derive the keys of the devices
+	// created under this application.
+	MasterKey *lorawan.AES128Key `db:"master_key"`
+
+	// ArchiveUplinks enables the archival of the raw uplinks.
+	ArchiveUplinks bool `db:"archive_uplinks"`
+
+	// RedactFields contains the fields which are dropped or redacted from
+	// the uplink events before they are stored or published to the
+	// integrations.
+	RedactFields pq.StringArray `db:"redact_fields"`
+
+	// HeartbeatInterval (optional) defines the interval in which a synthetic
+	// heartbeat uplink is published to the integrations of the application.
+	HeartbeatInterval time.Duration `db:"heartbeat_interval"`
+	LastHeartbeatAt   *time.Time    `db:"last_heartbeat_at"`
 }
 
+// MinHeartbeatInterval defines the min. (non-zero) heartbeat interval.
+const MinHeartbeatInterval = time.Minute
+
 // ApplicationListItem devices the application as a list item.
 type ApplicationListItem struct {
 	Application
@@ -37,6 +61,16 @@ func (a Application) Validate() error {
 		return ErrApplicationInvalidName
 	}
 
+	for _, field := range a.RedactFields {
+		if !integration.ValidRedactField(field) {
+			return ErrApplicationInvalidRedactField
+		}
+	}
+
+	if a.HeartbeatInterval < 0 || (a.HeartbeatInterval > 0 && a.HeartbeatInterval < MinHeartbeatInterval) {
+		return ErrApplicationInvalidHeartbeat
+	}
+
 	return nil
 }
 
@@ -54,8 +88,12 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 			service_profile_id,
 			payload_codec,
 			payload_encoder_script,
-			payload_decoder_script
-		) values ($1, $2, $3, $4, $5, $6, $7) returning id`,
+			payload_decoder_script,
+			master_key,
+			archive_uplinks,
+			redact_fields,
+			heartbeat_interval
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) returning id`,
 		item.Name,
 		item.Description,
 		item.OrganizationID,
@@ -63,6 +101,10 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.MasterKey,
+		item.ArchiveUplinks,
+		item.RedactFields,
+		item.HeartbeatInterval,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -87,6 +129,24 @@ func GetApplication(db sqlx.Queryer, id int64) (Application, error) {
 	return app, nil
 }
 
+// GetApplicationRedactFields returns the fields which must be dropped or
+// redacted from the uplink events of the given application. These are the
+// redact fields of the application, combined with the meta-data redact
+// fields of its service-profile.
+func GetApplicationRedactFields(db sqlx.Queryer, app Application) ([]string, error) {
+	var spFields pq.StringArray
+	err := sqlx.Get(db, &spFields, "select metadata_redact_fields from service_profile where service_profile_id = $1", app.ServiceProfileID)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	fields := make([]string, 0, len(app.RedactFields)+len(spFields))
+	fields = append(fields, app.RedactFields...)
+	fields = append(fields, spFields...)
+
+	return fields, nil
+}
+
 // GetApplicationCount returns the total number of applications.
 func GetApplicationCount(db sqlx.Queryer, search string) (int, error) {
 	var count int
@@ -294,7 +354,11 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 			service_profile_id = $5,
 			payload_codec = $6,
 			payload_encoder_script = $7,
-			payload_decoder_script = $8
+			payload_decoder_script = $8,
+			master_key = $9,
+			archive_uplinks = $10,
+			redact_fields = $11,
+			heartbeat_interval = $12
 		where id = $1`,
 		item.ID,
 		item.Name,
@@ -304,6 +368,10 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.MasterKey,
+		item.ArchiveUplinks,
+		item.RedactFields,
+		item.HeartbeatInterval,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -368,3 +436,168 @@ func DeleteAllApplicationsForOrganizationID(db sqlx.Ext, organizationID int64) e
 
 	return nil
 }
+
+// CloneApplication creates a copy of the application matching the given id
+// within the given organization, using the given service-profile. When name
+// is empty, the name of the original application is used.
+//
+// The device-profiles used by the devices of the application are re-created
+// within the organization (shared device-profiles are re-used) and the
+// application integrations are copied. The master-key is not copied, so
+// that the new owner does not get the master-key of the original
+// organization (the keys of the moved devices are kept). When moveDevices is set, the devices
+// are moved to the copy and removed from their multicast-groups. As devices
+// can not be moved between network-servers, this requires the
+// service-profile to use the same network-server as the original
+// application and the organization to use the same storage cluster.
+func CloneApplication(db sqlx.Ext, id, organizationID int64, serviceProfileID uuid.UUID, name string, moveDevices bool) (Application, error) {
+	app, err := GetApplication(db, id)
+	if err != nil {
+		return app, errors.Wrap(err, "get application error")
+	}
+
+	srcSP, err := GetServiceProfile(db, app.ServiceProfileID, true)
+	if err != nil {
+		return app, errors.Wrap(err, "get service-profile error")
+	}
+
+	sp, err := GetServiceProfile(db, serviceProfileID, true)
+	if err != nil {
+		return app, errors.Wrap(err, "get service-profile error")
+	}
+
+	if sp.OrganizationID != organizationID {
+		return app, ErrServiceProfileOrganization
+	}
+
+	if moveDevices && sp.NetworkServerID != srcSP.NetworkServerID {
+		return app, ErrNetworkServerMismatch
+	}
+
+	if moveDevices {
+		srcCluster, err := GetOrganizationStorageCluster(db, app.OrganizationID)
+		if err != nil {
+			return app, errors.Wrap(err, "get storage cluster error")
+		}
+		cluster, err := GetOrganizationStorageCluster(db, organizationID)
+		if err != nil {
+			return app, errors.Wrap(err, "get storage cluster error")
+		}
+		if srcCluster != cluster {
+			return app, ErrStorageClusterMismatch
+		}
+	}
+
+	count, err := GetDeviceCount(db, DeviceFilters{ApplicationID: id})
+	if err != nil {
+		return app, errors.Wrap(err, "get device count error")
+	}
+
+	devices, err := GetDevices(db, DeviceFilters{ApplicationID: id, Limit: count})
+	if err != nil {
+		return app, errors.Wrap(err, "get devices error")
+	}
+
+	app.OrganizationID = organizationID
+	app.ServiceProfileID = serviceProfileID
+	app.MasterKey = nil
+	if name != "" {
+		app.Name = name
+	}
+
+	if err := CreateApplication(db, &app); err != nil {
+		return app, errors.Wrap(err, "create application error")
+	}
+
+	// map of the original to the re-created device-profile ids
+	dpIDs := make(map[uuid.UUID]uuid.UUID)
+
+	for _, d := range devices {
+		if _, ok := dpIDs[d.DeviceProfileID]; ok {
+			continue
+		}
+
+		dp, err := GetDeviceProfile(db, d.DeviceProfileID)
+		if err != nil {
+			return app, errors.Wrap(err, "get device-profile error")
+		}
+
+		if (dp.IsShared || dp.OrganizationID == organizationID) && dp.NetworkServerID == sp.NetworkServerID {
+			dpIDs[d.DeviceProfileID] = d.DeviceProfileID
+			continue
+		}
+
+		dp.OrganizationID = organizationID
+		dp.NetworkServerID = sp.NetworkServerID
+		dp.IsShared = false
+
+		if err := CreateDeviceProfile(db, &dp); err != nil {
+			return app, errors.Wrap(err, "create device-profile error")
+		}
+
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		if err != nil {
+			return app, errors.Wrap(err, "uuid from bytes error")
+		}
+		dpIDs[d.DeviceProfileID] = dpID
+	}
+
+	integrations, err := GetIntegrationsForApplicationID(db, id)
+	if err != nil {
+		return app, errors.Wrap(err, "get integrations error")
+	}
+
+	for _, i := range integrations {
+		i.ApplicationID = app.ID
+		if err := CreateIntegration(db, &i); err != nil {
+			return app, errors.Wrap(err, "create integration error")
+		}
+	}
+
+	if moveDevices {
+		for _, item := range devices {
+			if err := moveDevice(db, item.DevEUI, app.ID, dpIDs[item.DeviceProfileID]); err != nil {
+				return app, errors.Wrap(err, "move device error")
+			}
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"id":              id,
+		"clone_id":        app.ID,
+		"organization_id": organizationID,
+		"devices_moved":   moveDevices,
+	}).Info("application cloned")
+
+	return app, nil
+}
+
+// moveDevice moves the device to the given application and device-profile,
+// removing it from its multicast-groups.
+func moveDevice(db sqlx.Ext, devEUI lorawan.EUI64, applicationID int64, deviceProfileID uuid.UUID) error {
+	d, err := GetDevice(db, devEUI, true, false)
+	if err != nil {
+		return errors.Wrap(err, "get device error")
+	}
+
+	count, err := GetMulticastGroupCount(db, MulticastGroupFilters{DevEUI: devEUI})
+	if err != nil {
+		return errors.Wrap(err, "get multicast-group count error")
+	}
+
+	mgs, err := GetMulticastGroups(db, MulticastGroupFilters{DevEUI: devEUI, Limit: count})
+	if err != nil {
+		return errors.Wrap(err, "get multicast-groups error")
+	}
+
+	for _, mg := range mgs {
+		if err := RemoveDeviceFromMulticastGroup(db, mg.ID, devEUI); err != nil {
+			return errors.Wrap(err, "remove device from multicast-group error")
+		}
+	}
+
+	d.ApplicationID = applicationID
+	d.DeviceProfileID = deviceProfileID
+
+	return UpdateDevice(db, &d, false)
+}
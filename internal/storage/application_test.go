@@ -1,16 +1,22 @@
 package storage
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
 	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
 )
 
 func TestApplication(t *testing.T) {
@@ -58,6 +64,36 @@ func TestApplication(t *testing.T) {
 			})
 		})
 
+		Convey("When creating an application with an invalid redact field", func() {
+			app := Application{
+				OrganizationID:   org.ID,
+				ServiceProfileID: spID,
+				Name:             "test-application",
+				RedactFields:     []string{"rxInfo", "fCnt"},
+			}
+			err := CreateApplication(db, &app)
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+				So(errors.Cause(err), ShouldResemble, ErrApplicationInvalidRedactField)
+			})
+		})
+
+		Convey("When creating an application with an invalid heartbeat interval", func() {
+			app := Application{
+				OrganizationID:    org.ID,
+				ServiceProfileID:  spID,
+				Name:              "test-application",
+				HeartbeatInterval: time.Second,
+			}
+			err := CreateApplication(db, &app)
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+				So(errors.Cause(err), ShouldResemble, ErrApplicationInvalidHeartbeat)
+			})
+		})
+
 		Convey("When creating an application", func() {
 			app := Application{
 				OrganizationID:       org.ID,
@@ -67,6 +103,7 @@ func TestApplication(t *testing.T) {
 				PayloadCodec:         "CUSTOM_JS",
 				PayloadEncoderScript: "Encode() {}",
 				PayloadDecoderScript: "Decode() {}",
+				RedactFields:         []string{"rxInfo.location", "data"},
 			}
 			So(CreateApplication(db, &app), ShouldBeNil)
 
@@ -76,6 +113,15 @@ func TestApplication(t *testing.T) {
 				So(app2, ShouldResemble, app)
 			})
 
+			Convey("Then GetApplicationRedactFields includes the service-profile meta-data redact fields", func() {
+				_, err := DB().Exec("update service_profile set metadata_redact_fields = $1 where service_profile_id = $2", pq.StringArray{"rxInfo.time", "txInfo"}, spID)
+				So(err, ShouldBeNil)
+
+				fields, err := GetApplicationRedactFields(DB(), app)
+				So(err, ShouldBeNil)
+				So(fields, ShouldResemble, []string{"rxInfo.location", "data", "rxInfo.time", "txInfo"})
+			})
+
 			Convey("Then get applications returns a single application", func() {
 				apps, err := GetApplications(db, 10, 0, "")
 				So(err, ShouldBeNil)
@@ -128,3 +174,128 @@ func TestApplication(t *testing.T) {
 		})
 	})
 }
+
+func (ts *StorageTestSuite) TestCloneApplication() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
+		DeviceProfile: &ns.DeviceProfile{},
+	}
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	n2 := NetworkServer{
+		Name:   "test-ns-2",
+		Server: "test-ns-2:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n2))
+
+	var orgs []Organization
+	var sps []uuid.UUID
+	for i, nsID := range []int64{n.ID, n.ID, n2.ID} {
+		org := Organization{
+			Name: fmt.Sprintf("test-org-%d", i),
+		}
+		assert.NoError(CreateOrganization(ts.Tx(), &org))
+		orgs = append(orgs, org)
+
+		sp := ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: nsID,
+			Name:            "test-sp",
+		}
+		assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		assert.NoError(err)
+		sps = append(sps, spID)
+	}
+
+	dp := DeviceProfile{
+		NetworkServerID: n.ID,
+		OrganizationID:  orgs[0].ID,
+		Name:            "test-dp",
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	masterKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	app := Application{
+		OrganizationID:   orgs[0].ID,
+		ServiceProfileID: sps[0],
+		Name:             "test-app",
+		PayloadCodec:     "CUSTOM_JS",
+		MasterKey:        &masterKey,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	assert.NoError(CreateIntegration(ts.Tx(), &Integration{
+		ApplicationID: app.ID,
+		Kind:          "HTTP",
+		Settings:      []byte(`{"dataUpURL": "http://localhost"}`),
+	}))
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	ts.T().Run("Service-profile of other organization", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := CloneApplication(ts.Tx(), app.ID, orgs[1].ID, sps[0], "", false)
+		assert.Equal(ErrServiceProfileOrganization, errors.Cause(err))
+	})
+
+	ts.T().Run("Move devices to other network-server", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := CloneApplication(ts.Tx(), app.ID, orgs[2].ID, sps[2], "", true)
+		assert.Equal(ErrNetworkServerMismatch, errors.Cause(err))
+	})
+
+	ts.T().Run("Clone and move devices", func(t *testing.T) {
+		assert := require.New(t)
+
+		clone, err := CloneApplication(ts.Tx(), app.ID, orgs[1].ID, sps[1], "handover", true)
+		assert.NoError(err)
+		assert.NotEqual(app.ID, clone.ID)
+		assert.Equal(orgs[1].ID, clone.OrganizationID)
+		assert.Equal(sps[1], clone.ServiceProfileID)
+		assert.Equal("handover", clone.Name)
+		assert.EqualValues("CUSTOM_JS", clone.PayloadCodec)
+		assert.Nil(clone.MasterKey)
+
+		cloneGet, err := GetApplication(ts.Tx(), clone.ID)
+		assert.NoError(err)
+		assert.Nil(cloneGet.MasterKey)
+
+		integrations, err := GetIntegrationsForApplicationID(ts.Tx(), clone.ID)
+		assert.NoError(err)
+		assert.Len(integrations, 1)
+		assert.JSONEq(`{"dataUpURL": "http://localhost"}`, string(integrations[0].Settings))
+
+		d, err := GetDevice(ts.Tx(), d.DevEUI, false, true)
+		assert.NoError(err)
+		assert.Equal(clone.ID, d.ApplicationID)
+		assert.NotEqual(dpID, d.DeviceProfileID)
+
+		newDP, err := GetDeviceProfile(ts.Tx(), d.DeviceProfileID)
+		assert.NoError(err)
+		assert.Equal(orgs[1].ID, newDP.OrganizationID)
+		assert.Equal("test-dp", newDP.Name)
+
+		updateReq := <-nsClient.UpdateDeviceChan
+		assert.Equal(sps[1].Bytes(), updateReq.Device.ServiceProfileId)
+		assert.Equal(d.DeviceProfileID.Bytes(), updateReq.Device.DeviceProfileId)
+	})
+}
@@ -0,0 +1,624 @@
+package storage
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Campaign states.
+const (
+	CampaignPending = "PENDING"
+	CampaignRunning = "RUNNING"
+	CampaignDone    = "DONE"
+)
+
+// Campaign device states.
+const (
+	CampaignDevicePending   = "PENDING"
+	CampaignDeviceEnqueued  = "ENQUEUED"
+	CampaignDeviceConfirmed = "CONFIRMED"
+	CampaignDeviceFailed    = "FAILED"
+)
+
+// Campaign defines a device configuration campaign, executing a set of
+// downlink commands against the devices of an application (optionally
+// matching a saved device filter) within the given time window.
+type Campaign struct {
+	ID             uuid.UUID        `db:"id"`
+	CreatedAt      time.Time        `db:"created_at"`
+	UpdatedAt      time.Time        `db:"updated_at"`
+	ApplicationID  int64            `db:"application_id"`
+	DeviceFilterID *int64           `db:"device_filter_id"`
+	Name           string           `db:"name"`
+	Commands       CampaignCommands `db:"commands"`
+	StartAt        time.Time        `db:"start_at"`
+	EndAt          time.Time        `db:"end_at"`
+	State          string           `db:"state"`
+
+	// PacingInterval defines the interval between handling two devices.
+	PacingInterval time.Duration `db:"pacing_interval"`
+
+	// RetryInterval defines the time after which the commands are
+	// re-enqueued when the device did not confirm the last command.
+	RetryInterval time.Duration `db:"retry_interval"`
+
+	// MaxAttempts defines the max. number of times the commands are
+	// enqueued for a device, before it is marked as failed.
+	MaxAttempts int `db:"max_attempts"`
+
+	// NextRunAt defines when the campaign must be handled next.
+	NextRunAt time.Time `db:"next_run_at"`
+}
+
+// CampaignCommand defines a downlink command of a campaign. Either the
+// (raw) data or the JSON object, which is encoded using the codec of the
+// application, must be set.
+type CampaignCommand struct {
+	FPort      uint8  `json:"fPort"`
+	Data       []byte `json:"data,omitempty"`
+	JSONObject string `json:"jsonObject,omitempty"`
+}
+
+// CampaignCommands defines the commands of a campaign.
+type CampaignCommands []CampaignCommand
+
+// Value implements the driver.Valuer interface.
+func (c CampaignCommands) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *CampaignCommands) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+	return json.Unmarshal(b, c)
+}
+
+// CampaignDevice defines the state of a device within a campaign.
+type CampaignDevice struct {
+	CampaignID uuid.UUID     `db:"campaign_id"`
+	DevEUI     lorawan.EUI64 `db:"dev_eui"`
+	CreatedAt  time.Time     `db:"created_at"`
+	UpdatedAt  time.Time     `db:"updated_at"`
+	State      string        `db:"state"`
+	Attempts   int           `db:"attempts"`
+	EnqueuedAt *time.Time    `db:"enqueued_at"`
+	Error      string        `db:"error"`
+
+	// Frame-counters of the enqueued commands (by command index) and if
+	// these have been acknowledged by the device.
+	FCnts pq.Int64Array `db:"f_cnts"`
+	Acked pq.BoolArray  `db:"acked"`
+}
+
+// CampaignDeviceCount contains the number of devices of a campaign by state.
+type CampaignDeviceCount struct {
+	Pending   int `db:"pending"`
+	Enqueued  int `db:"enqueued"`
+	Confirmed int `db:"confirmed"`
+	Failed    int `db:"failed"`
+}
+
+// Validate validates the campaign data.
+func (c Campaign) Validate() error {
+	if strings.TrimSpace(c.Name) == "" || len(c.Name) > 100 {
+		return ErrCampaignInvalidName
+	}
+	if len(c.Commands) == 0 {
+		return ErrCampaignInvalidCommand
+	}
+	for _, cmd := range c.Commands {
+		if cmd.FPort == 0 || (len(cmd.Data) == 0) == (cmd.JSONObject == "") {
+			return ErrCampaignInvalidCommand
+		}
+	}
+	if !c.StartAt.Before(c.EndAt) {
+		return ErrInvalidTimeRange
+	}
+	if c.PacingInterval < 0 || c.RetryInterval <= 0 || c.MaxAttempts < 1 {
+		return ErrCampaignInvalidSchedule
+	}
+	return nil
+}
+
+// CreateCampaign creates the given campaign in the pending state, together
+// with the devices to which the campaign applies. These are the devices of
+// the application, matching the device filter (when set). Suspended and
+// retired devices are excluded.
+func CreateCampaign(db sqlx.Ext, c *Campaign) error {
+	if err := c.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+
+	filters := DeviceFilters{
+		ApplicationID:   c.ApplicationID,
+		LifecycleStates: pq.StringArray{string(DeviceProvisioned), string(DeviceActive)},
+	}
+
+	if c.DeviceFilterID != nil {
+		f, err := GetDeviceFilter(db, *c.DeviceFilterID)
+		if err != nil {
+			return errors.Wrap(err, "get device filter error")
+		}
+
+		app, err := GetApplication(db, c.ApplicationID)
+		if err != nil {
+			return errors.Wrap(err, "get application error")
+		}
+
+		if f.OrganizationID != app.OrganizationID {
+			return ErrCampaignInvalidDeviceFilter
+		}
+
+		filters = f.DeviceFilters(now)
+		filters.ApplicationID = c.ApplicationID
+		filters.LifecycleStates = pq.StringArray{string(DeviceProvisioned), string(DeviceActive)}
+	}
+
+	devEUIs, err := getDevEUIsForDeviceFilters(db, filters)
+	if err != nil {
+		return errors.Wrap(err, "get devices error")
+	}
+
+	c.ID, err = uuid.NewV4()
+	if err != nil {
+		return errors.Wrap(err, "new uuid v4 error")
+	}
+
+	c.CreatedAt = now
+	c.UpdatedAt = now
+	c.State = CampaignPending
+	c.NextRunAt = c.StartAt
+
+	_, err = db.Exec(`
+		insert into campaign (
+			id,
+			created_at,
+			updated_at,
+			application_id,
+			device_filter_id,
+			name,
+			commands,
+			start_at,
+			end_at,
+			pacing_interval,
+			retry_interval,
+			max_attempts,
+			state,
+			next_run_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		c.ID,
+		c.CreatedAt,
+		c.UpdatedAt,
+		c.ApplicationID,
+		c.DeviceFilterID,
+		c.Name,
+		c.Commands,
+		c.StartAt,
+		c.EndAt,
+		c.PacingInterval,
+		c.RetryInterval,
+		c.MaxAttempts,
+		c.State,
+		c.NextRunAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	for _, devEUI := range devEUIs {
+		_, err = db.Exec(`
+			insert into campaign_device (
+				campaign_id,
+				dev_eui,
+				created_at,
+				updated_at,
+				state
+			) values ($1, $2, $3, $4, $5)`,
+			c.ID,
+			devEUI[:],
+			now,
+			now,
+			CampaignDevicePending,
+		)
+		if err != nil {
+			return handlePSQLError(Insert, err, "insert error")
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"id":             c.ID,
+		"application_id": c.ApplicationID,
+		"devices":        len(devEUIs),
+	}).Info("campaign created")
+
+	return nil
+}
+
+// GetCampaign returns the campaign for the given id.
+func GetCampaign(db sqlx.Queryer, id uuid.UUID, forUpdate bool) (Campaign, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var c Campaign
+	err := sqlx.Get(db, &c, "select * from campaign where id = $1"+fu, id)
+	if err != nil {
+		return c, handlePSQLError(Select, err, "select error")
+	}
+	return c, nil
+}
+
+// GetCampaignCount returns the total number of campaigns for the given
+// application id.
+func GetCampaignCount(db sqlx.Queryer, applicationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from campaign where application_id = $1", applicationID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetCampaigns returns the campaigns for the given application id (newest
+// first).
+func GetCampaigns(db sqlx.Queryer, applicationID int64, limit, offset int) ([]Campaign, error) {
+	var campaigns []Campaign
+	err := sqlx.Select(db, &campaigns, `
+		select
+			*
+		from
+			campaign
+		where
+			application_id = $1
+		order by
+			created_at desc
+		limit $2
+		offset $3`,
+		applicationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return campaigns, nil
+}
+
+// UpdateCampaign updates the state and next run timestamp of the given
+// campaign.
+func UpdateCampaign(db sqlx.Execer, c *Campaign) error {
+	c.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update campaign
+		set
+			updated_at = $2,
+			state = $3,
+			next_run_at = $4
+		where
+			id = $1`,
+		c.ID,
+		c.UpdatedAt,
+		c.State,
+		c.NextRunAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":    c.ID,
+		"state": c.State,
+	}).Debug("campaign updated")
+
+	return nil
+}
+
+// DeleteCampaign deletes the campaign matching the given id.
+func DeleteCampaign(db sqlx.Execer, id uuid.UUID) error {
+	res, err := db.Exec("delete from campaign where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("campaign deleted")
+
+	return nil
+}
+
+// GetNextCampaignForUpdate returns the next campaign to handle and locks
+// it for update. Campaigns which are locked by an other transaction are
+// skipped. ErrDoesNotExist is returned when there are no campaigns to
+// handle.
+func GetNextCampaignForUpdate(db sqlx.Queryer) (Campaign, error) {
+	var c Campaign
+	err := sqlx.Get(db, &c, `
+		select
+			*
+		from
+			campaign
+		where
+			state != $1
+			and next_run_at <= $2
+		order by
+			next_run_at
+		limit 1
+		for update skip locked`,
+		CampaignDone,
+		time.Now(),
+	)
+	if err != nil {
+		return c, handlePSQLError(Select, err, "select error")
+	}
+	return c, nil
+}
+
+// GetCampaignDeviceCount returns the number of devices of the given
+// campaign by state.
+func GetCampaignDeviceCount(db sqlx.Queryer, campaignID uuid.UUID) (CampaignDeviceCount, error) {
+	var count CampaignDeviceCount
+	err := sqlx.Get(db, &count, `
+		select
+			count(*) filter (where state = $2) as pending,
+			count(*) filter (where state = $3) as enqueued,
+			count(*) filter (where state = $4) as confirmed,
+			count(*) filter (where state = $5) as failed
+		from
+			campaign_device
+		where
+			campaign_id = $1`,
+		campaignID,
+		CampaignDevicePending,
+		CampaignDeviceEnqueued,
+		CampaignDeviceConfirmed,
+		CampaignDeviceFailed,
+	)
+	if err != nil {
+		return count, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetCampaignDevices returns the devices of the given campaign.
+func GetCampaignDevices(db sqlx.Queryer, campaignID uuid.UUID, limit, offset int) ([]CampaignDevice, error) {
+	var devices []CampaignDevice
+	err := sqlx.Select(db, &devices, `
+		select
+			*
+		from
+			campaign_device
+		where
+			campaign_id = $1
+		order by
+			dev_eui
+		limit $2
+		offset $3`,
+		campaignID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return devices, nil
+}
+
+// GetNextCampaignDeviceForUpdate returns the next device of the given
+// campaign to handle and locks it for update. These are the pending devices
+// and the enqueued devices which have not confirmed the commands since
+// retryBefore. ErrDoesNotExist is returned when there are no devices to
+// handle.
+func GetNextCampaignDeviceForUpdate(db sqlx.Queryer, campaignID uuid.UUID, retryBefore time.Time) (CampaignDevice, error) {
+	var d CampaignDevice
+	err := sqlx.Get(db, &d, `
+		select
+			*
+		from
+			campaign_device
+		where
+			campaign_id = $1
+			and (
+				state = $2
+				or (state = $3 and enqueued_at < $4)
+			)
+		order by
+			enqueued_at nulls first,
+			dev_eui
+		limit 1
+		for update skip locked`,
+		campaignID,
+		CampaignDevicePending,
+		CampaignDeviceEnqueued,
+		retryBefore,
+	)
+	if err != nil {
+		return d, handlePSQLError(Select, err, "select error")
+	}
+	return d, nil
+}
+
+// UpdateCampaignDevice updates the given campaign device.
+func UpdateCampaignDevice(db sqlx.Execer, d *CampaignDevice) error {
+	d.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update campaign_device
+		set
+			updated_at = $3,
+			state = $4,
+			attempts = $5,
+			enqueued_at = $6,
+			error = $7,
+			f_cnts = $8,
+			acked = $9
+		where
+			campaign_id = $1
+			and dev_eui = $2`,
+		d.CampaignID,
+		d.DevEUI[:],
+		d.UpdatedAt,
+		d.State,
+		d.Attempts,
+		d.EnqueuedAt,
+		d.Error,
+		d.FCnts,
+		d.Acked,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
+
+// ExpireCampaignDevices marks the pending and enqueued devices of the given
+// campaign as failed, e.g. after the campaign window has expired.
+func ExpireCampaignDevices(db sqlx.Execer, campaignID uuid.UUID, reason string) error {
+	_, err := db.Exec(`
+		update campaign_device
+		set
+			updated_at = $2,
+			state = $3,
+			error = $4
+		where
+			campaign_id = $1
+			and state in ($5, $6)`,
+		campaignID,
+		time.Now(),
+		CampaignDeviceFailed,
+		reason,
+		CampaignDevicePending,
+		CampaignDeviceEnqueued,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	return nil
+}
+
+// ConfirmCampaignDevice marks the command with the given frame-counter of
+// the enqueued campaign devices matching the given DevEUI as acknowledged.
+// The campaign devices of which all commands have been acknowledged are
+// marked as confirmed. It returns the number of confirmed campaign devices.
+func ConfirmCampaignDevice(db sqlx.Execer, devEUI lorawan.EUI64, fCnt uint32) (int, error) {
+	now := time.Now()
+
+	_, err := db.Exec(`
+		update campaign_device
+		set
+			updated_at = $3,
+			acked[array_position(f_cnts, $2)] = true
+		where
+			dev_eui = $1
+			and $2 = any(f_cnts)
+			and state = $4`,
+		devEUI[:],
+		int64(fCnt),
+		now,
+		CampaignDeviceEnqueued,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Update, err, "update error")
+	}
+
+	res, err := db.Exec(`
+		update campaign_device
+		set
+			updated_at = $2,
+			state = $3,
+			error = ''
+		where
+			dev_eui = $1
+			and state = $4
+			and cardinality(acked) > 0
+			and true = all(acked)`,
+		devEUI[:],
+		now,
+		CampaignDeviceConfirmed,
+		CampaignDeviceEnqueued,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "get rows affected error")
+	}
+
+	if ra != 0 {
+		log.WithFields(log.Fields{
+			"dev_eui": devEUI,
+			"f_cnt":   fCnt,
+		}).Info("campaign device confirmed")
+	}
+
+	return int(ra), nil
+}
+
+// getDevEUIsForDeviceFilters returns the DevEUIs of all the devices
+// matching the given filters.
+func getDevEUIsForDeviceFilters(db sqlx.Queryer, filters DeviceFilters) ([]lorawan.EUI64, error) {
+	if filters.Search != "" {
+		filters.Search = "%" + filters.Search + "%"
+	}
+
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			distinct d.dev_eui
+		from
+			device d
+		inner join application a
+			on d.application_id = a.id
+		left join device_multicast_group dmg
+			on d.dev_eui = dmg.dev_eui
+		`+filters.SQL(), filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "named query error")
+	}
+
+	var devEUIs []lorawan.EUI64
+	err = sqlx.Select(db, &devEUIs, query, args...)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return devEUIs, nil
+}
@@ -0,0 +1,322 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lorawan"
+)
+
+func TestCampaignValidate(t *testing.T) {
+	now := time.Now()
+
+	valid := Campaign{
+		Name:          "set reporting interval",
+		Commands:      CampaignCommands{{FPort: 10, Data: []byte{1, 2, 3}}},
+		StartAt:       now,
+		EndAt:         now.Add(time.Hour),
+		RetryInterval: time.Minute,
+		MaxAttempts:   3,
+	}
+
+	tests := []struct {
+		Name     string
+		Campaign func(c Campaign) Campaign
+		Error    error
+	}{
+		{
+			Name:     "valid",
+			Campaign: func(c Campaign) Campaign { return c },
+		},
+		{
+			Name: "valid json object",
+			Campaign: func(c Campaign) Campaign {
+				c.Commands = CampaignCommands{{FPort: 10, JSONObject: `{"interval": 60}`}}
+				return c
+			},
+		},
+		{
+			Name:     "empty name",
+			Campaign: func(c Campaign) Campaign { c.Name = " "; return c },
+			Error:    ErrCampaignInvalidName,
+		},
+		{
+			Name:     "no commands",
+			Campaign: func(c Campaign) Campaign { c.Commands = nil; return c },
+			Error:    ErrCampaignInvalidCommand,
+		},
+		{
+			Name:     "invalid f_port",
+			Campaign: func(c Campaign) Campaign { c.Commands = CampaignCommands{{Data: []byte{1}}}; return c },
+			Error:    ErrCampaignInvalidCommand,
+		},
+		{
+			Name: "data and json object",
+			Campaign: func(c Campaign) Campaign {
+				c.Commands = CampaignCommands{{FPort: 10, Data: []byte{1}, JSONObject: "{}"}}
+				return c
+			},
+			Error: ErrCampaignInvalidCommand,
+		},
+		{
+			Name:     "end before start",
+			Campaign: func(c Campaign) Campaign { c.EndAt = c.StartAt.Add(-time.Minute); return c },
+			Error:    ErrInvalidTimeRange,
+		},
+		{
+			Name:     "no retry interval",
+			Campaign: func(c Campaign) Campaign { c.RetryInterval = 0; return c },
+			Error:    ErrCampaignInvalidSchedule,
+		},
+		{
+			Name:     "no attempts",
+			Campaign: func(c Campaign) Campaign { c.MaxAttempts = 0; return c },
+			Error:    ErrCampaignInvalidSchedule,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.Error, test.Campaign(valid).Validate())
+		})
+	}
+}
+
+func (ts *StorageTestSuite) TestCampaign() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	org2 := Organization{
+		Name: "test-org-2",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org2))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	sp := ServiceProfile{
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-service-profile",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	dp := DeviceProfile{
+		NetworkServerID: n.ID,
+		OrganizationID:  org.ID,
+		Name:            "device-profile",
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	app := Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	devices := []Device{
+		{DevEUI: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, Name: "sensor-1", ApplicationID: app.ID, DeviceProfileID: dpID},
+		{DevEUI: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, Name: "sensor-2", ApplicationID: app.ID, DeviceProfileID: dpID},
+		{DevEUI: lorawan.EUI64{3, 3, 3, 3, 3, 3, 3, 3}, Name: "meter-1", ApplicationID: app.ID, DeviceProfileID: dpID},
+	}
+	for i := range devices {
+		assert.NoError(CreateDevice(ts.Tx(), &devices[i]))
+	}
+	assert.NoError(UpdateDeviceLifecycleState(ts.Tx(), &devices[2], DeviceSuspended))
+
+	now := time.Now()
+	c := Campaign{
+		ApplicationID:  app.ID,
+		Name:           "set reporting interval",
+		Commands:       CampaignCommands{{FPort: 10, Data: []byte{1, 2, 3}}},
+		StartAt:        now.Add(-time.Minute),
+		EndAt:          now.Add(time.Hour),
+		PacingInterval: time.Second,
+		RetryInterval:  time.Minute,
+		MaxAttempts:    3,
+	}
+
+	ts.T().Run("Create with device filter of other organization", func(t *testing.T) {
+		assert := require.New(t)
+
+		f := DeviceFilter{
+			OrganizationID: org2.ID,
+			Name:           "other",
+		}
+		assert.NoError(CreateDeviceFilter(ts.Tx(), &f))
+
+		c2 := c
+		c2.DeviceFilterID = &f.ID
+		assert.Equal(ErrCampaignInvalidDeviceFilter, errors.Cause(CreateCampaign(ts.Tx(), &c2)))
+	})
+
+	ts.T().Run("Create with device filter", func(t *testing.T) {
+		assert := require.New(t)
+
+		f := DeviceFilter{
+			OrganizationID: org.ID,
+			Name:           "sensors",
+			Search:         "sensor",
+		}
+		assert.NoError(CreateDeviceFilter(ts.Tx(), &f))
+
+		c2 := c
+		c2.DeviceFilterID = &f.ID
+		assert.NoError(CreateCampaign(ts.Tx(), &c2))
+
+		count, err := GetCampaignDeviceCount(ts.Tx(), c2.ID)
+		assert.NoError(err)
+		assert.Equal(CampaignDeviceCount{Pending: 2}, count)
+
+		assert.NoError(DeleteCampaign(ts.Tx(), c2.ID))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+		assert.NoError(CreateCampaign(ts.Tx(), &c))
+		assert.Equal(CampaignPending, c.State)
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			c2, err := GetCampaign(ts.Tx(), c.ID, false)
+			assert.NoError(err)
+			assert.Equal(app.ID, c2.ApplicationID)
+			assert.Nil(c2.DeviceFilterID)
+			assert.Equal(c.Name, c2.Name)
+			assert.Equal(c.Commands, c2.Commands)
+			assert.Equal(c.PacingInterval, c2.PacingInterval)
+			assert.Equal(c.RetryInterval, c2.RetryInterval)
+			assert.Equal(c.MaxAttempts, c2.MaxAttempts)
+			assert.Equal(CampaignPending, c2.State)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetCampaignCount(ts.Tx(), app.ID)
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			campaigns, err := GetCampaigns(ts.Tx(), app.ID, 10, 0)
+			assert.NoError(err)
+			assert.Len(campaigns, 1)
+			assert.Equal(c.ID, campaigns[0].ID)
+		})
+
+		t.Run("Suspended devices are excluded", func(t *testing.T) {
+			assert := require.New(t)
+
+			devs, err := GetCampaignDevices(ts.Tx(), c.ID, 10, 0)
+			assert.NoError(err)
+			assert.Len(devs, 2)
+			assert.Equal(devices[0].DevEUI, devs[0].DevEUI)
+			assert.Equal(devices[1].DevEUI, devs[1].DevEUI)
+		})
+
+		t.Run("Enqueue and confirm", func(t *testing.T) {
+			assert := require.New(t)
+
+			c2, err := GetNextCampaignForUpdate(ts.Tx())
+			assert.NoError(err)
+			assert.Equal(c.ID, c2.ID)
+
+			d, err := GetNextCampaignDeviceForUpdate(ts.Tx(), c.ID, time.Now().Add(-c.RetryInterval))
+			assert.NoError(err)
+			assert.Equal(devices[0].DevEUI, d.DevEUI)
+
+			enqueuedAt := time.Now()
+			d.State = CampaignDeviceEnqueued
+			d.Attempts = 1
+			d.EnqueuedAt = &enqueuedAt
+			d.FCnts = []int64{10, 11}
+			d.Acked = []bool{false, false}
+			assert.NoError(UpdateCampaignDevice(ts.Tx(), &d))
+
+			// the enqueued device is not due for a retry yet
+			d, err = GetNextCampaignDeviceForUpdate(ts.Tx(), c.ID, time.Now().Add(-c.RetryInterval))
+			assert.NoError(err)
+			assert.Equal(devices[1].DevEUI, d.DevEUI)
+
+			n, err := ConfirmCampaignDevice(ts.Tx(), devices[0].DevEUI, 9)
+			assert.NoError(err)
+			assert.Equal(0, n)
+
+			// the first command is acknowledged, the second is not
+			n, err = ConfirmCampaignDevice(ts.Tx(), devices[0].DevEUI, 10)
+			assert.NoError(err)
+			assert.Equal(0, n)
+
+			devs, err := GetCampaignDevices(ts.Tx(), c.ID, 10, 0)
+			assert.NoError(err)
+			assert.Equal(CampaignDeviceEnqueued, devs[0].State)
+			assert.EqualValues([]bool{true, false}, devs[0].Acked)
+
+			n, err = ConfirmCampaignDevice(ts.Tx(), devices[0].DevEUI, 11)
+			assert.NoError(err)
+			assert.Equal(1, n)
+
+			count, err := GetCampaignDeviceCount(ts.Tx(), c.ID)
+			assert.NoError(err)
+			assert.Equal(CampaignDeviceCount{Pending: 1, Confirmed: 1}, count)
+		})
+
+		t.Run("Expire", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(ExpireCampaignDevices(ts.Tx(), c.ID, "campaign window expired"))
+
+			count, err := GetCampaignDeviceCount(ts.Tx(), c.ID)
+			assert.NoError(err)
+			assert.Equal(CampaignDeviceCount{Confirmed: 1, Failed: 1}, count)
+
+			_, err = GetNextCampaignDeviceForUpdate(ts.Tx(), c.ID, time.Now())
+			assert.Equal(ErrDoesNotExist, errors.Cause(err))
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			c.State = CampaignDone
+			assert.NoError(UpdateCampaign(ts.Tx(), &c))
+
+			c2, err := GetCampaign(ts.Tx(), c.ID, false)
+			assert.NoError(err)
+			assert.Equal(CampaignDone, c2.State)
+
+			_, err = GetNextCampaignForUpdate(ts.Tx())
+			assert.Equal(ErrDoesNotExist, errors.Cause(err))
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteCampaign(ts.Tx(), c.ID))
+			_, err := GetCampaign(ts.Tx(), c.ID, false)
+			assert.Equal(ErrDoesNotExist, errors.Cause(err))
+			assert.Equal(ErrDoesNotExist, errors.Cause(DeleteCampaign(ts.Tx(), c.ID)))
+		})
+	})
+}
@@ -112,6 +112,8 @@ func logQuery(query string, duration time.Duration, args ...interface{}) {
 		"args":     args,
 		"duration": duration,
 	}).Debug("sql query executed")
+
+	observeQuery(query, duration, len(args))
 }
 
 // DB returns the PostgreSQL database object.
@@ -127,6 +129,12 @@ func RedisPool() *redis.Pool {
 // Transaction wraps the given function in a transaction. In case the given
 // functions returns an error, the transaction will be rolled back.
 func Transaction(f func(tx sqlx.Ext) error) error {
+	return transaction(db, f)
+}
+
+// transaction wraps the given function in a transaction of the given
+// database.
+func transaction(db *DBLogger, f func(tx sqlx.Ext) error) error {
 	tx, err := db.Beginx()
 	if err != nil {
 		return errors.Wrap(err, "storage: begin transaction error")
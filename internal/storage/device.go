@@ -6,7 +6,9 @@ import (
 	"time"
 
 	uuid "github.com/gofrs/uuid"
+	"github.com/jacobsa/crypto/cmac"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -20,22 +22,82 @@ import (
 
 // Device defines a LoRaWAN device.
 type Device struct {
-	DevEUI                    lorawan.EUI64 `db:"dev_eui"`
-	CreatedAt                 time.Time     `db:"created_at"`
-	UpdatedAt                 time.Time     `db:"updated_at"`
-	LastSeenAt                *time.Time    `db:"last_seen_at"`
-	ApplicationID             int64         `db:"application_id"`
-	DeviceProfileID           uuid.UUID     `db:"device_profile_id"`
-	Name                      string        `db:"name"`
-	Description               string        `db:"description"`
-	SkipFCntCheck             bool          `db:"-"`
-	ReferenceAltitude         float64       `db:"-"`
-	DeviceStatusBattery       *float32      `db:"device_status_battery"`
-	DeviceStatusMargin        *int          `db:"device_status_margin"`
-	DeviceStatusExternalPower bool          `db:"device_status_external_power_source"`
-	Latitude                  *float64      `db:"latitude"`
-	Longitude                 *float64      `db:"longitude"`
-	Altitude                  *float64      `db:"altitude"`
+	DevEUI                    lorawan.EUI64        `db:"dev_eui"`
+	CreatedAt                 time.Time            `db:"created_at"`
+	UpdatedAt                 time.Time            `db:"updated_at"`
+	LastSeenAt                *time.Time           `db:"last_seen_at"`
+	ApplicationID             int64                `db:"application_id"`
+	DeviceProfileID           uuid.UUID            `db:"device_profile_id"`
+	Name                      string               `db:"name"`
+	Description               string               `db:"description"`
+	SkipFCntCheck             bool                 `db:"-"`
+	ReferenceAltitude         float64              `db:"-"`
+	DeviceStatusBattery       *float32             `db:"device_status_battery"`
+	DeviceStatusMargin        *int                 `db:"device_status_margin"`
+	DeviceStatusExternalPower bool                 `db:"device_status_external_power_source"`
+	Latitude                  *float64             `db:"latitude"`
+	Longitude                 *float64             `db:"longitude"`
+	Altitude                  *float64             `db:"altitude"`
+	DR                        *int                 `db:"dr"`
+	LifecycleState            DeviceLifecycleState `db:"lifecycle_state"`
+}
+
+// DeviceLifecycleState defines the lifecycle state of a device.
+type DeviceLifecycleState string
+
+// Device lifecycle states.
+const (
+	DeviceProvisioned DeviceLifecycleState = "provisioned"
+	DeviceActive      DeviceLifecycleState = "active"
+	DeviceSuspended   DeviceLifecycleState = "suspended"
+	DeviceRetired     DeviceLifecycleState = "retired"
+)
+
+// deviceLifecycleTransitions defines the allowed lifecycle state
+// transitions. Retired is a terminal state.
+var deviceLifecycleTransitions = map[DeviceLifecycleState][]DeviceLifecycleState{
+	DeviceProvisioned: {DeviceActive, DeviceSuspended, DeviceRetired},
+	DeviceActive:      {DeviceSuspended, DeviceRetired},
+	DeviceSuspended:   {DeviceActive, DeviceRetired},
+}
+
+// Validate validates the lifecycle state.
+func (s DeviceLifecycleState) Validate() error {
+	switch s {
+	case DeviceProvisioned, DeviceActive, DeviceSuspended, DeviceRetired:
+		return nil
+	default:
+		return ErrDeviceLifecycleStateInvalid
+	}
+}
+
+// CanTransitionTo returns if the transition to the given state is allowed.
+func (s DeviceLifecycleState) CanTransitionTo(to DeviceLifecycleState) bool {
+	for _, state := range deviceLifecycleTransitions[s] {
+		if state == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Enabled returns if uplinks and events of the device are published to the
+// integrations and if downlinks can be enqueued. This is not the case for
+// suspended and retired devices.
+func (s DeviceLifecycleState) Enabled() bool {
+	return s == DeviceProvisioned || s == DeviceActive
+}
+
+// Err returns the error for the state when the device is not enabled.
+func (s DeviceLifecycleState) Err() error {
+	switch s {
+	case DeviceSuspended:
+		return ErrDeviceSuspended
+	case DeviceRetired:
+		return ErrDeviceRetired
+	default:
+		return nil
+	}
 }
 
 // DeviceListItem defines the Device as list item.
@@ -70,48 +132,40 @@ type DeviceActivation struct {
 
 // CreateDevice creates the given device.
 func CreateDevice(db sqlx.Ext, d *Device) error {
-	if err := d.Validate(); err != nil {
-		return errors.Wrap(err, "validate error")
+	return createDevice(db, d, nil)
+}
+
+// ImportDevice creates the given device and its (optional) device-keys
+// within a savepoint of the given transaction. When the device can not be
+// created, the transaction is rolled back to the savepoint so that the
+// devices created before within the same transaction are kept.
+func ImportDevice(tx sqlx.Ext, d *Device, dk *DeviceKeys) error {
+	if _, err := tx.Exec("savepoint import_device"); err != nil {
+		return handlePSQLError(Insert, err, "savepoint error")
 	}
 
-	now := time.Now()
-	d.CreatedAt = now
-	d.UpdatedAt = now
+	if err := createDevice(tx, d, dk); err != nil {
+		if _, rbErr := tx.Exec("rollback to savepoint import_device"); rbErr != nil {
+			return handlePSQLError(Insert, rbErr, "rollback to savepoint error")
+		}
+		return err
+	}
 
-	_, err := db.Exec(`
-        insert into device (
-            dev_eui,
-            created_at,
-            updated_at,
-            application_id,
-            device_profile_id,
-            name,
-			description,
-			device_status_battery,
-			device_status_margin,
-			device_status_external_power_source,
-			last_seen_at,
-			latitude,
-			longitude,
-			altitude
-        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
-		d.DevEUI[:],
-		d.CreatedAt,
-		d.UpdatedAt,
-		d.ApplicationID,
-		d.DeviceProfileID,
-		d.Name,
-		d.Description,
-		d.DeviceStatusBattery,
-		d.DeviceStatusMargin,
-		d.DeviceStatusExternalPower,
-		d.LastSeenAt,
-		d.Latitude,
-		d.Longitude,
-		d.Altitude,
-	)
-	if err != nil {
-		return handlePSQLError(Insert, err, "insert error")
+	if _, err := tx.Exec("release savepoint import_device"); err != nil {
+		return handlePSQLError(Insert, err, "release savepoint error")
+	}
+
+	return nil
+}
+
+// createDevice creates the given device and device-keys. When no
+// device-keys are given and the application has a master-key, the
+// device-keys are derived from it. The device is created on the
+// network-server last, so that a failure does not leave a network-server
+// device behind when the transaction is rolled back.
+func createDevice(db sqlx.Ext, d *Device, dk *DeviceKeys) error {
+	if err := insertDevice(db, d); err != nil {
+		return err
 	}
 
 	app, err := GetApplication(db, d.ApplicationID)
@@ -119,6 +173,21 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 		return errors.Wrap(err, "get application error")
 	}
 
+	if dk == nil && app.MasterKey != nil {
+		derived, err := DeriveDeviceKeys(*app.MasterKey, d.DevEUI)
+		if err != nil {
+			return errors.Wrap(err, "derive device-keys error")
+		}
+		dk = &derived
+	}
+
+	if dk != nil {
+		dk.DevEUI = d.DevEUI
+		if err := CreateDeviceKeys(db, dk); err != nil {
+			return errors.Wrap(err, "create device-keys error")
+		}
+	}
+
 	n, err := GetNetworkServerForDevEUI(db, d.DevEUI)
 	if err != nil {
 		return errors.Wrap(err, "get network-server error")
@@ -156,6 +225,71 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 	return nil
 }
 
+// insertDevice validates and inserts the given device in the database,
+// without creating it on the network-server.
+func insertDevice(db sqlx.Ext, d *Device) error {
+	if err := d.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	if d.LifecycleState == "" {
+		d.LifecycleState = DeviceProvisioned
+	}
+	if err := d.LifecycleState.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	d.CreatedAt = now
+	d.UpdatedAt = now
+
+	_, err := db.Exec(`
+        insert into device (
+            dev_eui,
+            created_at,
+            updated_at,
+            application_id,
+            device_profile_id,
+            name,
+			description,
+			device_status_battery,
+			device_status_margin,
+			device_status_external_power_source,
+			last_seen_at,
+			latitude,
+			longitude,
+			altitude,
+			dr,
+			lifecycle_state
+        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
+		d.DevEUI[:],
+		d.CreatedAt,
+		d.UpdatedAt,
+		d.ApplicationID,
+		d.DeviceProfileID,
+		d.Name,
+		d.Description,
+		d.DeviceStatusBattery,
+		d.DeviceStatusMargin,
+		d.DeviceStatusExternalPower,
+		d.LastSeenAt,
+		d.Latitude,
+		d.Longitude,
+		d.Altitude,
+		d.DR,
+		d.LifecycleState,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	if err := createDeviceHistory(db, *d); err != nil {
+		return errors.Wrap(err, "create device-history error")
+	}
+
+	return nil
+}
+
 // GetDevice returns the device matching the given DevEUI.
 // When forUpdate is set to true, then db must be a db transaction.
 // When localOnly is set to true, no call to the network-server is made to
@@ -204,10 +338,15 @@ func GetDevice(db sqlx.Queryer, devEUI lorawan.EUI64, forUpdate, localOnly bool)
 // DeviceFilters provide filters that can be used to filter on devices.
 // Note that empty values are not used as filter.
 type DeviceFilters struct {
-	ApplicationID    int64     `db:"application_id"`
-	MulticastGroupID uuid.UUID `db:"multicast_group_id"`
-	ServiceProfileID uuid.UUID `db:"service_profile_id"`
-	Search           string    `db:"search"`
+	OrganizationID   int64          `db:"organization_id"`
+	ApplicationID    int64          `db:"application_id"`
+	MulticastGroupID uuid.UUID      `db:"multicast_group_id"`
+	ServiceProfileID uuid.UUID      `db:"service_profile_id"`
+	DeviceProfileID  uuid.UUID      `db:"device_profile_id"`
+	Search           string         `db:"search"`
+	LastSeenBefore   *time.Time     `db:"last_seen_before"`
+	BatteryBelow     *float32       `db:"battery_below"`
+	LifecycleStates  pq.StringArray `db:"lifecycle_states"`
 
 	// Limit and Offset are added for convenience so that this struct can
 	// be given as the arguments.
@@ -219,6 +358,10 @@ type DeviceFilters struct {
 func (f DeviceFilters) SQL() string {
 	var filters []string
 
+	if f.OrganizationID != 0 {
+		filters = append(filters, "a.organization_id = :organization_id")
+	}
+
 	if f.ApplicationID != 0 {
 		filters = append(filters, "d.application_id = :application_id")
 	}
@@ -235,6 +378,23 @@ func (f DeviceFilters) SQL() string {
 		filters = append(filters, "(d.name ilike :search or encode(d.dev_eui, 'hex') ilike :search)")
 	}
 
+	if f.DeviceProfileID != uuid.Nil {
+		filters = append(filters, "d.device_profile_id = :device_profile_id")
+	}
+
+	// devices which have never been seen are included
+	if f.LastSeenBefore != nil {
+		filters = append(filters, "(d.last_seen_at is null or d.last_seen_at < :last_seen_before)")
+	}
+
+	if f.BatteryBelow != nil {
+		filters = append(filters, "d.device_status_battery < :battery_below")
+	}
+
+	if len(f.LifecycleStates) != 0 {
+		filters = append(filters, "d.lifecycle_state = any(:lifecycle_states)")
+	}
+
 	if len(filters) == 0 {
 		return ""
 	}
@@ -316,8 +476,10 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 
 	d.UpdatedAt = time.Now()
 
-	res, err := db.Exec(`
-        update device
+	// the joined (old) row contains the values from before the update
+	var oldApplicationID int64
+	err := sqlx.Get(db, &oldApplicationID, `
+        update device d
         set
             updated_at = $2,
             application_id = $3,
@@ -330,9 +492,15 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 			latitude = $10,
 			longitude = $11,
 			altitude = $12,
-			device_status_external_power_source = $13
+			device_status_external_power_source = $13,
+			dr = $14
+		from
+			device old
         where
-            dev_eui = $1`,
+            d.dev_eui = $1
+			and old.dev_eui = d.dev_eui
+		returning
+			old.application_id`,
 		d.DevEUI[:],
 		d.UpdatedAt,
 		d.ApplicationID,
@@ -346,16 +514,16 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 		d.Longitude,
 		d.Altitude,
 		d.DeviceStatusExternalPower,
+		d.DR,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
 	}
-	ra, err := res.RowsAffected()
-	if err != nil {
-		return errors.Wrap(err, "get rows affected error")
-	}
-	if ra == 0 {
-		return ErrDoesNotExist
+
+	if oldApplicationID != d.ApplicationID {
+		if err := moveDeviceHistory(db, *d); err != nil {
+			return errors.Wrap(err, "move device-history error")
+		}
 	}
 
 	// update the device on the network-server
@@ -403,6 +571,55 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 	return nil
 }
 
+// UpdateDeviceLifecycleState updates the lifecycle state of the given device.
+// It returns ErrDeviceLifecycleTransition when the transition from the
+// current state is not allowed.
+func UpdateDeviceLifecycleState(db sqlx.Ext, d *Device, state DeviceLifecycleState) error {
+	if err := state.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	if !d.LifecycleState.CanTransitionTo(state) {
+		return ErrDeviceLifecycleTransition
+	}
+
+	d.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update device
+		set
+			updated_at = $2,
+			lifecycle_state = $3
+		where
+			dev_eui = $1
+			and lifecycle_state = $4`,
+		d.DevEUI[:],
+		d.UpdatedAt,
+		state,
+		d.LifecycleState,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui": d.DevEUI,
+		"from":    d.LifecycleState,
+		"to":      state,
+	}).Info("device lifecycle state updated")
+
+	d.LifecycleState = state
+
+	return nil
+}
+
 // DeleteDevice deletes the device matching the given DevEUI.
 func DeleteDevice(db sqlx.Ext, devEUI lorawan.EUI64) error {
 	n, err := GetNetworkServerForDevEUI(db, devEUI)
@@ -410,6 +627,10 @@ func DeleteDevice(db sqlx.Ext, devEUI lorawan.EUI64) error {
 		return errors.Wrap(err, "get network-server error")
 	}
 
+	if err := closeDeviceHistory(db, devEUI); err != nil {
+		return errors.Wrap(err, "close device-history error")
+	}
+
 	res, err := db.Exec("delete from device where dev_eui = $1", devEUI[:])
 	if err != nil {
 		return handlePSQLError(Delete, err, "delete error")
@@ -442,6 +663,40 @@ func DeleteDevice(db sqlx.Ext, devEUI lorawan.EUI64) error {
 	return nil
 }
 
+// DeriveDeviceKeys derives the device-keys for the given DevEUI from the
+// given (application) master key:
+//
+//	NwkKey = aes128_cmac(MasterKey, DevEUI)
+//	AppKey = aes128_cmac(MasterKey, 0x01 | DevEUI)
+//
+// Note that for LoRaWAN 1.0.x devices, the NwkKey is used as AppKey.
+func DeriveDeviceKeys(masterKey lorawan.AES128Key, devEUI lorawan.EUI64) (DeviceKeys, error) {
+	dk := DeviceKeys{
+		DevEUI: devEUI,
+	}
+
+	for _, k := range []struct {
+		key    *lorawan.AES128Key
+		prefix []byte
+	}{
+		{key: &dk.NwkKey},
+		{key: &dk.AppKey, prefix: []byte{0x01}},
+	} {
+		hash, err := cmac.New(masterKey[:])
+		if err != nil {
+			return dk, errors.Wrap(err, "new cmac error")
+		}
+
+		if _, err = hash.Write(append(k.prefix, devEUI[:]...)); err != nil {
+			return dk, errors.Wrap(err, "cmac write error")
+		}
+
+		copy(k.key[:], hash.Sum(nil))
+	}
+
+	return dk, nil
+}
+
 // CreateDeviceKeys creates the keys for the given device.
 func CreateDeviceKeys(db sqlx.Execer, dc *DeviceKeys) error {
 	now := time.Now()
@@ -475,6 +730,50 @@ func CreateDeviceKeys(db sqlx.Execer, dc *DeviceKeys) error {
 	return nil
 }
 
+// CreateOrOverrideDerivedDeviceKeys creates the given device-keys. When the
+// application of the device has a master-key, the device-keys derived from
+// it on the creation of the device are overridden by the given keys. It
+// returns ErrAlreadyExists when the device has other (e.g. previously
+// overridden) device-keys.
+func CreateOrOverrideDerivedDeviceKeys(db sqlx.Ext, dk *DeviceKeys) error {
+	d, err := GetDevice(db, dk.DevEUI, false, true)
+	if err != nil {
+		return errors.Wrap(err, "get device error")
+	}
+
+	app, err := GetApplication(db, d.ApplicationID)
+	if err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	if app.MasterKey == nil {
+		return CreateDeviceKeys(db, dk)
+	}
+
+	var current DeviceKeys
+	err = sqlx.Get(db, &current, "select * from device_keys where dev_eui = $1 for update", dk.DevEUI[:])
+	if err != nil {
+		err = handlePSQLError(Select, err, "select error")
+		if err == ErrDoesNotExist {
+			return CreateDeviceKeys(db, dk)
+		}
+		return err
+	}
+
+	derived, err := DeriveDeviceKeys(*app.MasterKey, dk.DevEUI)
+	if err != nil {
+		return errors.Wrap(err, "derive device-keys error")
+	}
+
+	if current.NwkKey != derived.NwkKey || current.AppKey != derived.AppKey {
+		return ErrAlreadyExists
+	}
+
+	dk.CreatedAt = current.CreatedAt
+	dk.JoinNonce = current.JoinNonce
+	return UpdateDeviceKeys(db, dk)
+}
+
 // GetDeviceKeys returns the device-keys for the given DevEUI.
 func GetDeviceKeys(db sqlx.Queryer, devEUI lorawan.EUI64) (DeviceKeys, error) {
 	var dc DeviceKeys
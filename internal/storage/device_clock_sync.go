@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceClockSync contains the last clock synchronization (AppTimeReq) of a
+// device.
+type DeviceClockSync struct {
+	DevEUI    lorawan.EUI64 `db:"dev_eui"`
+	UpdatedAt time.Time     `db:"updated_at"`
+
+	// TimeCorrection contains the offset (in seconds) between the clock of
+	// the application-server and the clock of the device.
+	TimeCorrection int32 `db:"time_correction"`
+}
+
+// SaveDeviceClockSync creates or updates the clock synchronization of the
+// given device.
+func SaveDeviceClockSync(db sqlx.Execer, s *DeviceClockSync) error {
+	s.UpdatedAt = time.Now()
+
+	_, err := db.Exec(`
+		insert into device_clock_sync (
+			dev_eui,
+			updated_at,
+			time_correction
+		) values ($1, $2, $3)
+		on conflict (dev_eui)
+			do update
+		set
+			updated_at = excluded.updated_at,
+			time_correction = excluded.time_correction`,
+		s.DevEUI[:],
+		s.UpdatedAt,
+		s.TimeCorrection,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetDeviceClockSync returns the clock synchronization of the given device.
+func GetDeviceClockSync(db sqlx.Queryer, devEUI lorawan.EUI64) (DeviceClockSync, error) {
+	var s DeviceClockSync
+	err := sqlx.Get(db, &s, "select * from device_clock_sync where dev_eui = $1", devEUI[:])
+	if err != nil {
+		return s, handlePSQLError(Select, err, "select error")
+	}
+
+	return s, nil
+}
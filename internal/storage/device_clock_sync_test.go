@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceClockSync() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	sp := ServiceProfile{
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-sp",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	dp := DeviceProfile{
+		NetworkServerID: n.ID,
+		OrganizationID:  org.ID,
+		Name:            "test-dp",
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	app := Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	_, err = GetDeviceClockSync(ts.Tx(), d.DevEUI)
+	assert.Equal(ErrDoesNotExist, errors.Cause(err))
+
+	ts.T().Run("Save", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(SaveDeviceClockSync(ts.Tx(), &DeviceClockSync{DevEUI: d.DevEUI, TimeCorrection: 10}))
+		assert.NoError(SaveDeviceClockSync(ts.Tx(), &DeviceClockSync{DevEUI: d.DevEUI, TimeCorrection: -2}))
+
+		s, err := GetDeviceClockSync(ts.Tx(), d.DevEUI)
+		assert.NoError(err)
+		assert.EqualValues(-2, s.TimeCorrection)
+		assert.False(s.UpdatedAt.IsZero())
+	})
+}
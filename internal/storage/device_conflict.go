@@ -0,0 +1,425 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	uuid "github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceConflictSource defines where the conflicting DevEUI was found.
+type DeviceConflictSource string
+
+// Device conflict sources.
+const (
+	// DeviceConflictLocal means that the DevEUI is used by a device of
+	// this application-server (in the same or in an other organization).
+	DeviceConflictLocal DeviceConflictSource = "local"
+
+	// DeviceConflictNetworkServer means that the DevEUI is unknown to this
+	// application-server, but the device already exists on the
+	// network-server (e.g. provisioned by an other application-server).
+	DeviceConflictNetworkServer DeviceConflictSource = "network-server"
+)
+
+// DeviceConflictStatus defines the status of a device conflict.
+type DeviceConflictStatus string
+
+// Device conflict statuses.
+const (
+	DeviceConflictPending  DeviceConflictStatus = "pending"
+	DeviceConflictResolved DeviceConflictStatus = "resolved"
+	DeviceConflictRejected DeviceConflictStatus = "rejected"
+)
+
+// DeviceConflictResolution defines how a device conflict is resolved.
+type DeviceConflictResolution string
+
+// Device conflict resolutions.
+const (
+	// DeviceConflictForceTake deletes the existing device (locally and on
+	// the network-server) and creates the requested device.
+	DeviceConflictForceTake DeviceConflictResolution = "force-take"
+
+	// DeviceConflictLink creates the requested device in the database and
+	// links it to the existing network-server device, keeping its
+	// activation and keys. This is only possible when the device does not
+	// exist locally.
+	DeviceConflictLink DeviceConflictResolution = "link"
+
+	// DeviceConflictReject rejects the requested device, keeping the
+	// existing device.
+	DeviceConflictReject DeviceConflictResolution = "reject"
+)
+
+// Validate validates the device conflict resolution.
+func (r DeviceConflictResolution) Validate() error {
+	switch r {
+	case DeviceConflictForceTake, DeviceConflictLink, DeviceConflictReject:
+		return nil
+	default:
+		return ErrDeviceConflictInvalidResolution
+	}
+}
+
+// DeviceConflict records a device create request which failed because the
+// DevEUI was already in use, so that it can be resolved by a global admin.
+type DeviceConflict struct {
+	ID             int64                    `db:"id"`
+	CreatedAt      time.Time                `db:"created_at"`
+	UpdatedAt      time.Time                `db:"updated_at"`
+	DevEUI         lorawan.EUI64            `db:"dev_eui"`
+	OrganizationID int64                    `db:"organization_id"`
+	Source         DeviceConflictSource     `db:"source"`
+	Status         DeviceConflictStatus     `db:"status"`
+	Resolution     DeviceConflictResolution `db:"resolution"`
+	ResolvedBy     string                   `db:"resolved_by"`
+
+	// The requested device.
+	ApplicationID     int64     `db:"application_id"`
+	DeviceProfileID   uuid.UUID `db:"device_profile_id"`
+	Name              string    `db:"name"`
+	Description       string    `db:"description"`
+	SkipFCntCheck     bool      `db:"skip_f_cnt_check"`
+	ReferenceAltitude float64   `db:"reference_altitude"`
+
+	// ExistingOrganizationID and ExistingApplicationID contain the
+	// organization and application of the existing device, in case of a
+	// local conflict.
+	ExistingOrganizationID *int64 `db:"existing_organization_id"`
+	ExistingApplicationID  *int64 `db:"existing_application_id"`
+}
+
+// Device returns the requested device.
+func (c DeviceConflict) Device() Device {
+	return Device{
+		DevEUI:            c.DevEUI,
+		ApplicationID:     c.ApplicationID,
+		DeviceProfileID:   c.DeviceProfileID,
+		Name:              c.Name,
+		Description:       c.Description,
+		SkipFCntCheck:     c.SkipFCntCheck,
+		ReferenceAltitude: c.ReferenceAltitude,
+	}
+}
+
+// CreateDeviceConflict records a conflict for the given device, which could
+// not be created as its DevEUI is already in use. When a pending conflict
+// already exists for the DevEUI and application, it is updated instead.
+func CreateDeviceConflict(db sqlx.Queryer, d Device) (DeviceConflict, error) {
+	c := DeviceConflict{
+		DevEUI:            d.DevEUI,
+		ApplicationID:     d.ApplicationID,
+		DeviceProfileID:   d.DeviceProfileID,
+		Name:              d.Name,
+		Description:       d.Description,
+		SkipFCntCheck:     d.SkipFCntCheck,
+		ReferenceAltitude: d.ReferenceAltitude,
+		Source:            DeviceConflictNetworkServer,
+		Status:            DeviceConflictPending,
+	}
+
+	var existing struct {
+		OrganizationID int64 `db:"organization_id"`
+		ApplicationID  int64 `db:"application_id"`
+	}
+	err := sqlx.Get(db, &existing, `
+		select
+			a.organization_id,
+			d.application_id
+		from device d
+		inner join application a
+			on a.id = d.application_id
+		where
+			d.dev_eui = $1`,
+		d.DevEUI[:],
+	)
+	if err != nil {
+		if err := handlePSQLError(Select, err, "select error"); err != ErrDoesNotExist {
+			return c, err
+		}
+	} else {
+		c.Source = DeviceConflictLocal
+		c.ExistingOrganizationID = &existing.OrganizationID
+		c.ExistingApplicationID = &existing.ApplicationID
+	}
+
+	now := time.Now()
+	c.CreatedAt = now
+	c.UpdatedAt = now
+
+	err = sqlx.Get(db, &c, `
+		insert into device_conflict (
+			created_at,
+			updated_at,
+			dev_eui,
+			organization_id,
+			application_id,
+			device_profile_id,
+			name,
+			description,
+			skip_f_cnt_check,
+			reference_altitude,
+			source,
+			existing_organization_id,
+			existing_application_id,
+			status
+		) values (
+			$1, $2, $3, (select organization_id from application where id = $4), $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		on conflict (dev_eui, application_id) where status = 'pending'
+		do update set
+			updated_at = excluded.updated_at,
+			device_profile_id = excluded.device_profile_id,
+			name = excluded.name,
+			description = excluded.description,
+			skip_f_cnt_check = excluded.skip_f_cnt_check,
+			reference_altitude = excluded.reference_altitude,
+			source = excluded.source,
+			existing_organization_id = excluded.existing_organization_id,
+			existing_application_id = excluded.existing_application_id
+		returning *`,
+		c.CreatedAt,
+		c.UpdatedAt,
+		c.DevEUI[:],
+		c.ApplicationID,
+		c.DeviceProfileID,
+		c.Name,
+		c.Description,
+		c.SkipFCntCheck,
+		c.ReferenceAltitude,
+		c.Source,
+		c.ExistingOrganizationID,
+		c.ExistingApplicationID,
+		c.Status,
+	)
+	if err != nil {
+		return c, handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":      c.ID,
+		"dev_eui": c.DevEUI,
+		"source":  c.Source,
+	}).Warning("device conflict recorded")
+
+	return c, nil
+}
+
+// GetDeviceConflict returns the device conflict for the given id.
+// When forUpdate is set to true, then db must be a db transaction.
+func GetDeviceConflict(db sqlx.Queryer, id int64, forUpdate bool) (DeviceConflict, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var c DeviceConflict
+	err := sqlx.Get(db, &c, "select * from device_conflict where id = $1"+fu, id)
+	if err != nil {
+		return c, handlePSQLError(Select, err, "select error")
+	}
+	return c, nil
+}
+
+// DeviceConflictFilters provide filters that can be used to filter on
+// device conflicts. Note that empty values are not used as filter.
+type DeviceConflictFilters struct {
+	OrganizationID int64                `db:"organization_id"`
+	Status         DeviceConflictStatus `db:"status"`
+
+	// Limit and Offset are added for convenience so that this struct can
+	// be given as the arguments.
+	Limit  int `db:"limit"`
+	Offset int `db:"offset"`
+}
+
+// SQL returns the SQL filter.
+func (f DeviceConflictFilters) SQL() string {
+	var filters []string
+
+	if f.OrganizationID != 0 {
+		filters = append(filters, "organization_id = :organization_id")
+	}
+
+	if f.Status != "" {
+		filters = append(filters, "status = :status")
+	}
+
+	if len(filters) == 0 {
+		return ""
+	}
+
+	return "where " + strings.Join(filters, " and ")
+}
+
+// GetDeviceConflictCount returns the number of device conflicts.
+func GetDeviceConflictCount(db sqlx.Queryer, filters DeviceConflictFilters) (int, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			count(*)
+		from device_conflict
+	`+filters.SQL(), filters)
+	if err != nil {
+		return 0, errors.Wrap(err, "named query error")
+	}
+
+	var count int
+	err = sqlx.Get(db, &count, query, args...)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetDeviceConflicts returns the device conflicts, the most recent first.
+func GetDeviceConflicts(db sqlx.Queryer, filters DeviceConflictFilters) ([]DeviceConflict, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			*
+		from device_conflict
+	`+filters.SQL()+`
+		order by
+			updated_at desc,
+			id desc
+		limit :limit
+		offset :offset
+	`, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "named query error")
+	}
+
+	var conflicts []DeviceConflict
+	err = sqlx.Select(db, &conflicts, query, args...)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return conflicts, nil
+}
+
+// ResolveDeviceConflict resolves the given pending device conflict. It
+// returns the created device, or nil when the conflict has been rejected.
+// The conflict must have been retrieved for update and db must be the
+// same db transaction.
+func ResolveDeviceConflict(db sqlx.Ext, c *DeviceConflict, resolution DeviceConflictResolution, username string) (*Device, error) {
+	if err := resolution.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate error")
+	}
+
+	if c.Status != DeviceConflictPending {
+		return nil, ErrDeviceConflictNotPending
+	}
+
+	// the existing device might have been deleted or created since the
+	// conflict was recorded
+	var exists bool
+	err := sqlx.Get(db, &exists, "select exists (select 1 from device where dev_eui = $1)", c.DevEUI[:])
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	var dev *Device
+	d := c.Device()
+
+	switch resolution {
+	case DeviceConflictForceTake:
+		if exists {
+			if err := DeleteDevice(db, c.DevEUI); err != nil {
+				return nil, errors.Wrap(err, "delete device error")
+			}
+		} else if err := deleteNetworkServerDevice(db, c.DeviceProfileID, c.DevEUI); err != nil {
+			return nil, err
+		}
+
+		if err := CreateDevice(db, &d); err != nil {
+			return nil, errors.Wrap(err, "create device error")
+		}
+		dev = &d
+	case DeviceConflictLink:
+		if exists {
+			return nil, ErrDeviceConflictLinkLocal
+		}
+
+		if err := insertDevice(db, &d); err != nil {
+			return nil, err
+		}
+
+		// update the network-server device with the profiles of the
+		// requested device
+		if err := UpdateDevice(db, &d, false); err != nil {
+			return nil, errors.Wrap(err, "update device error")
+		}
+		dev = &d
+	}
+
+	c.UpdatedAt = time.Now()
+	c.Resolution = resolution
+	c.ResolvedBy = username
+	c.Status = DeviceConflictResolved
+	if resolution == DeviceConflictReject {
+		c.Status = DeviceConflictRejected
+	}
+
+	_, err = db.Exec(`
+		update device_conflict
+		set
+			updated_at = $2,
+			status = $3,
+			resolution = $4,
+			resolved_by = $5
+		where
+			id = $1`,
+		c.ID,
+		c.UpdatedAt,
+		c.Status,
+		c.Resolution,
+		c.ResolvedBy,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Update, err, "update error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":         c.ID,
+		"dev_eui":    c.DevEUI,
+		"resolution": c.Resolution,
+		"username":   username,
+	}).Info("device conflict resolved")
+
+	return dev, nil
+}
+
+// deleteNetworkServerDevice deletes the given device from the
+// network-server of the given device-profile, for devices which do not
+// exist in the database.
+func deleteNetworkServerDevice(db sqlx.Queryer, deviceProfileID uuid.UUID, devEUI lorawan.EUI64) error {
+	n, err := GetNetworkServerForDeviceProfileID(db, deviceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := getNSClient(n)
+	if err != nil {
+		return errors.Wrap(err, "get network-server client error")
+	}
+
+	_, err = nsClient.DeleteDevice(context.Background(), &ns.DeleteDeviceRequest{
+		DevEui: devEUI[:],
+	})
+	if err != nil && grpc.Code(err) != codes.NotFound {
+		log.WithError(err).Error("network-server delete device api error")
+		return handleGrpcError(err, "delete device error")
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceConflict() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	orgs := []Organization{
+		{Name: "test-org-1"},
+		{Name: "test-org-2"},
+	}
+	var apps []Application
+	var dpIDs []uuid.UUID
+
+	for i := range orgs {
+		assert.NoError(CreateOrganization(ts.Tx(), &orgs[i]))
+
+		sp := ServiceProfile{
+			OrganizationID:  orgs[i].ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		assert.NoError(err)
+
+		dp := DeviceProfile{
+			OrganizationID:  orgs[i].ID,
+			NetworkServerID: n.ID,
+			Name:            "test-dp",
+		}
+		assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		assert.NoError(err)
+		dpIDs = append(dpIDs, dpID)
+
+		app := Application{
+			OrganizationID:   orgs[i].ID,
+			Name:             "test-app",
+			ServiceProfileID: spID,
+		}
+		assert.NoError(CreateApplication(ts.Tx(), &app))
+		apps = append(apps, app)
+	}
+
+	existing := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   apps[0].ID,
+		DeviceProfileID: dpIDs[0],
+		Name:            "existing-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &existing))
+
+	ts.T().Run("Local conflict", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := Device{
+			DevEUI:          existing.DevEUI,
+			ApplicationID:   apps[1].ID,
+			DeviceProfileID: dpIDs[1],
+			Name:            "requested-device",
+		}
+		c, err := CreateDeviceConflict(ts.Tx(), d)
+		assert.NoError(err)
+		assert.Equal(DeviceConflictLocal, c.Source)
+		assert.Equal(DeviceConflictPending, c.Status)
+		assert.Equal(orgs[1].ID, c.OrganizationID)
+		assert.Equal(&orgs[0].ID, c.ExistingOrganizationID)
+		assert.Equal(&apps[0].ID, c.ExistingApplicationID)
+
+		t.Run("Create again updates the pending conflict", func(t *testing.T) {
+			assert := require.New(t)
+
+			d.Name = "requested-device-2"
+			c2, err := CreateDeviceConflict(ts.Tx(), d)
+			assert.NoError(err)
+			assert.Equal(c.ID, c2.ID)
+			assert.Equal("requested-device-2", c2.Name)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetDeviceConflictCount(ts.Tx(), DeviceConflictFilters{OrganizationID: orgs[1].ID, Status: DeviceConflictPending})
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			conflicts, err := GetDeviceConflicts(ts.Tx(), DeviceConflictFilters{OrganizationID: orgs[1].ID, Limit: 10})
+			assert.NoError(err)
+			assert.Len(conflicts, 1)
+			assert.Equal(c.ID, conflicts[0].ID)
+
+			count, err = GetDeviceConflictCount(ts.Tx(), DeviceConflictFilters{OrganizationID: orgs[0].ID})
+			assert.NoError(err)
+			assert.Equal(0, count)
+		})
+
+		t.Run("Link is not possible", func(t *testing.T) {
+			assert := require.New(t)
+
+			c, err := GetDeviceConflict(ts.Tx(), c.ID, true)
+			assert.NoError(err)
+			_, err = ResolveDeviceConflict(ts.Tx(), &c, DeviceConflictLink, "admin")
+			assert.Equal(ErrDeviceConflictLinkLocal, errors.Cause(err))
+		})
+
+		t.Run("Force-take", func(t *testing.T) {
+			assert := require.New(t)
+
+			c, err := GetDeviceConflict(ts.Tx(), c.ID, true)
+			assert.NoError(err)
+			dev, err := ResolveDeviceConflict(ts.Tx(), &c, DeviceConflictForceTake, "admin")
+			assert.NoError(err)
+			assert.NotNil(dev)
+
+			deleteReq := <-nsClient.DeleteDeviceChan
+			assert.Equal(existing.DevEUI[:], deleteReq.DevEui)
+
+			d, err := GetDevice(ts.Tx(), existing.DevEUI, false, true)
+			assert.NoError(err)
+			assert.Equal(apps[1].ID, d.ApplicationID)
+			assert.Equal("requested-device-2", d.Name)
+
+			c, err = GetDeviceConflict(ts.Tx(), c.ID, false)
+			assert.NoError(err)
+			assert.Equal(DeviceConflictResolved, c.Status)
+			assert.Equal(DeviceConflictForceTake, c.Resolution)
+			assert.Equal("admin", c.ResolvedBy)
+
+			t.Run("Resolve again", func(t *testing.T) {
+				assert := require.New(t)
+
+				_, err := ResolveDeviceConflict(ts.Tx(), &c, DeviceConflictReject, "admin")
+				assert.Equal(ErrDeviceConflictNotPending, errors.Cause(err))
+			})
+		})
+	})
+
+	ts.T().Run("Network-server conflict", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := Device{
+			DevEUI:          lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   apps[0].ID,
+			DeviceProfileID: dpIDs[0],
+			Name:            "ns-device",
+		}
+		c, err := CreateDeviceConflict(ts.Tx(), d)
+		assert.NoError(err)
+		assert.Equal(DeviceConflictNetworkServer, c.Source)
+		assert.Nil(c.ExistingOrganizationID)
+		assert.Nil(c.ExistingApplicationID)
+
+		t.Run("Invalid resolution", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := ResolveDeviceConflict(ts.Tx(), &c, DeviceConflictResolution("merge"), "admin")
+			assert.Equal(ErrDeviceConflictInvalidResolution, errors.Cause(err))
+		})
+
+		t.Run("Link", func(t *testing.T) {
+			assert := require.New(t)
+
+			c, err := GetDeviceConflict(ts.Tx(), c.ID, true)
+			assert.NoError(err)
+			_, err = ResolveDeviceConflict(ts.Tx(), &c, DeviceConflictLink, "admin")
+			assert.NoError(err)
+
+			updateReq := <-nsClient.UpdateDeviceChan
+			assert.Equal(d.DevEUI[:], updateReq.Device.DevEui)
+			assert.Equal(dpIDs[0].Bytes(), updateReq.Device.DeviceProfileId)
+
+			dev, err := GetDevice(ts.Tx(), d.DevEUI, false, true)
+			assert.NoError(err)
+			assert.Equal(apps[0].ID, dev.ApplicationID)
+		})
+	})
+
+	ts.T().Run("Reject", func(t *testing.T) {
+		assert := require.New(t)
+
+		c, err := CreateDeviceConflict(ts.Tx(), Device{
+			DevEUI:          lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   apps[1].ID,
+			DeviceProfileID: dpIDs[1],
+			Name:            "rejected-device",
+		})
+		assert.NoError(err)
+		assert.Equal(DeviceConflictLocal, c.Source)
+
+		c, err = GetDeviceConflict(ts.Tx(), c.ID, true)
+		assert.NoError(err)
+		dev, err := ResolveDeviceConflict(ts.Tx(), &c, DeviceConflictReject, "admin")
+		assert.NoError(err)
+		assert.Nil(dev)
+		assert.Equal(DeviceConflictRejected, c.Status)
+
+		d, err := GetDevice(ts.Tx(), c.DevEUI, false, true)
+		assert.NoError(err)
+		assert.Equal(apps[0].ID, d.ApplicationID)
+	})
+}
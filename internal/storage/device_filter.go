@@ -0,0 +1,259 @@
+package storage
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// DeviceFilter defines a saved device filter (search) of an organization,
+// so that a device selection can be re-used (e.g. for listing or exporting
+// the devices matching the filter).
+type DeviceFilter struct {
+	ID              int64      `db:"id"`
+	CreatedAt       time.Time  `db:"created_at"`
+	UpdatedAt       time.Time  `db:"updated_at"`
+	OrganizationID  int64      `db:"organization_id"`
+	Name            string     `db:"name"`
+	ApplicationID   *int64     `db:"application_id"`
+	DeviceProfileID *uuid.UUID `db:"device_profile_id"`
+	Search          string     `db:"search"`
+
+	// LastSeenOlderThan matches the devices which have not been seen
+	// within the given number of seconds (or which have never been seen).
+	LastSeenOlderThan *int64 `db:"last_seen_older_than"`
+
+	// BatteryBelow matches the devices of which the battery level (%) is
+	// below the given value.
+	BatteryBelow *float32 `db:"battery_below"`
+}
+
+// Validate validates the device filter data.
+func (f DeviceFilter) Validate() error {
+	if strings.TrimSpace(f.Name) == "" || len(f.Name) > 100 {
+		return ErrDeviceFilterInvalidName
+	}
+	if f.LastSeenOlderThan != nil && *f.LastSeenOlderThan <= 0 {
+		return ErrDeviceFilterInvalidLastSeen
+	}
+	if f.BatteryBelow != nil && (*f.BatteryBelow < 0 || *f.BatteryBelow > 100) {
+		return ErrDeviceFilterInvalidBattery
+	}
+	return nil
+}
+
+// DeviceFilters returns the filters for querying the devices matching the
+// device filter, relative to the given time.
+func (f DeviceFilter) DeviceFilters(now time.Time) DeviceFilters {
+	out := DeviceFilters{
+		OrganizationID: f.OrganizationID,
+		Search:         f.Search,
+		BatteryBelow:   f.BatteryBelow,
+	}
+
+	if f.ApplicationID != nil {
+		out.ApplicationID = *f.ApplicationID
+	}
+
+	if f.DeviceProfileID != nil {
+		out.DeviceProfileID = *f.DeviceProfileID
+	}
+
+	if f.LastSeenOlderThan != nil {
+		ts := now.Add(-time.Duration(*f.LastSeenOlderThan) * time.Second)
+		out.LastSeenBefore = &ts
+	}
+
+	return out
+}
+
+// CreateDeviceFilter creates the given device filter.
+func CreateDeviceFilter(db sqlx.Queryer, f *DeviceFilter) error {
+	if err := validateDeviceFilter(db, *f); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	f.CreatedAt = now
+	f.UpdatedAt = now
+
+	err := sqlx.Get(db, &f.ID, `
+		insert into device_filter (
+			created_at,
+			updated_at,
+			organization_id,
+			name,
+			application_id,
+			device_profile_id,
+			search,
+			last_seen_older_than,
+			battery_below
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		returning id`,
+		f.CreatedAt,
+		f.UpdatedAt,
+		f.OrganizationID,
+		f.Name,
+		f.ApplicationID,
+		f.DeviceProfileID,
+		f.Search,
+		f.LastSeenOlderThan,
+		f.BatteryBelow,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":              f.ID,
+		"organization_id": f.OrganizationID,
+	}).Info("device filter created")
+
+	return nil
+}
+
+// GetDeviceFilter returns the device filter for the given id.
+func GetDeviceFilter(db sqlx.Queryer, id int64) (DeviceFilter, error) {
+	var f DeviceFilter
+	err := sqlx.Get(db, &f, "select * from device_filter where id = $1", id)
+	if err != nil {
+		return f, handlePSQLError(Select, err, "select error")
+	}
+	return f, nil
+}
+
+// GetDeviceFilterCount returns the total number of device filters for the
+// given organization id.
+func GetDeviceFilterCount(db sqlx.Queryer, organizationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from device_filter where organization_id = $1", organizationID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetDeviceFilters returns the device filters for the given organization id,
+// ordered by name.
+func GetDeviceFilters(db sqlx.Queryer, organizationID int64, limit, offset int) ([]DeviceFilter, error) {
+	var filters []DeviceFilter
+	err := sqlx.Select(db, &filters, `
+		select
+			*
+		from
+			device_filter
+		where
+			organization_id = $1
+		order by
+			name
+		limit $2
+		offset $3`,
+		organizationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return filters, nil
+}
+
+// UpdateDeviceFilter updates the given device filter.
+func UpdateDeviceFilter(db sqlx.Ext, f *DeviceFilter) error {
+	if err := validateDeviceFilter(db, *f); err != nil {
+		return err
+	}
+
+	f.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update device_filter
+		set
+			updated_at = $2,
+			name = $3,
+			application_id = $4,
+			device_profile_id = $5,
+			search = $6,
+			last_seen_older_than = $7,
+			battery_below = $8
+		where
+			id = $1`,
+		f.ID,
+		f.UpdatedAt,
+		f.Name,
+		f.ApplicationID,
+		f.DeviceProfileID,
+		f.Search,
+		f.LastSeenOlderThan,
+		f.BatteryBelow,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", f.ID).Info("device filter updated")
+
+	return nil
+}
+
+// DeleteDeviceFilter deletes the device filter for the given id.
+func DeleteDeviceFilter(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from device_filter where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("device filter deleted")
+
+	return nil
+}
+
+// validateDeviceFilter validates the device filter data and that the
+// application and device-profile belong to the organization of the filter.
+func validateDeviceFilter(db sqlx.Queryer, f DeviceFilter) error {
+	if err := f.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	if f.ApplicationID != nil {
+		var orgID int64
+		err := sqlx.Get(db, &orgID, "select organization_id from application where id = $1", *f.ApplicationID)
+		if err != nil {
+			return handlePSQLError(Select, err, "select error")
+		}
+		if orgID != f.OrganizationID {
+			return ErrDeviceFilterOrganization
+		}
+	}
+
+	if f.DeviceProfileID != nil {
+		var orgID int64
+		err := sqlx.Get(db, &orgID, "select organization_id from device_profile where device_profile_id = $1", *f.DeviceProfileID)
+		if err != nil {
+			return handlePSQLError(Select, err, "select error")
+		}
+		if orgID != f.OrganizationID {
+			return ErrDeviceFilterOrganization
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+)
+
+func TestDeviceFilterValidate(t *testing.T) {
+	lastSeen := int64(3600)
+	lastSeenInvalid := int64(0)
+	battery := float32(20)
+	batteryInvalid := float32(120)
+
+	tests := []struct {
+		Name   string
+		Filter DeviceFilter
+		Error  error
+	}{
+		{
+			Name: "valid",
+			Filter: DeviceFilter{
+				Name:              "low battery",
+				LastSeenOlderThan: &lastSeen,
+				BatteryBelow:      &battery,
+			},
+		},
+		{
+			Name:   "empty name",
+			Filter: DeviceFilter{Name: " "},
+			Error:  ErrDeviceFilterInvalidName,
+		},
+		{
+			Name: "invalid last-seen",
+			Filter: DeviceFilter{
+				Name:              "not seen",
+				LastSeenOlderThan: &lastSeenInvalid,
+			},
+			Error: ErrDeviceFilterInvalidLastSeen,
+		},
+		{
+			Name: "invalid battery",
+			Filter: DeviceFilter{
+				Name:         "low battery",
+				BatteryBelow: &batteryInvalid,
+			},
+			Error: ErrDeviceFilterInvalidBattery,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.Error, test.Filter.Validate())
+		})
+	}
+}
+
+func TestDeviceFilterDeviceFilters(t *testing.T) {
+	assert := require.New(t)
+
+	appID := int64(10)
+	dpID := uuid.Must(uuid.NewV4())
+	lastSeen := int64(3600)
+	battery := float32(20)
+	now := time.Now()
+	lastSeenBefore := now.Add(-time.Hour)
+
+	f := DeviceFilter{
+		OrganizationID:    1,
+		Name:              "filter",
+		ApplicationID:     &appID,
+		DeviceProfileID:   &dpID,
+		Search:            "sensor",
+		LastSeenOlderThan: &lastSeen,
+		BatteryBelow:      &battery,
+	}
+
+	assert.Equal(DeviceFilters{
+		OrganizationID:  1,
+		ApplicationID:   appID,
+		DeviceProfileID: dpID,
+		Search:          "sensor",
+		LastSeenBefore:  &lastSeenBefore,
+		BatteryBelow:    &battery,
+	}, f.DeviceFilters(now))
+}
+
+func (ts *StorageTestSuite) TestDeviceFilter() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	org2 := Organization{
+		Name: "test-org-2",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org2))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	sp := ServiceProfile{
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-service-profile",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	app := Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	battery := float32(25)
+	f := DeviceFilter{
+		OrganizationID: org.ID,
+		Name:           "low battery",
+		ApplicationID:  &app.ID,
+		BatteryBelow:   &battery,
+	}
+
+	ts.T().Run("Create for application of other organization", func(t *testing.T) {
+		assert := require.New(t)
+
+		f2 := f
+		f2.OrganizationID = org2.ID
+		assert.Equal(ErrDeviceFilterOrganization, errors.Cause(CreateDeviceFilter(ts.Tx(), &f2)))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+		assert.NoError(CreateDeviceFilter(ts.Tx(), &f))
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+			f2, err := GetDeviceFilter(ts.Tx(), f.ID)
+			assert.NoError(err)
+			assert.Equal(org.ID, f2.OrganizationID)
+			assert.Equal(f.Name, f2.Name)
+			assert.Equal(&app.ID, f2.ApplicationID)
+			assert.Nil(f2.DeviceProfileID)
+			assert.Nil(f2.LastSeenOlderThan)
+			assert.Equal(&battery, f2.BatteryBelow)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetDeviceFilterCount(ts.Tx(), org.ID)
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			filters, err := GetDeviceFilters(ts.Tx(), org.ID, 10, 0)
+			assert.NoError(err)
+			assert.Len(filters, 1)
+			assert.Equal(f.ID, filters[0].ID)
+
+			count, err = GetDeviceFilterCount(ts.Tx(), org2.ID)
+			assert.NoError(err)
+			assert.Equal(0, count)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			lastSeen := int64(86400)
+			f.Name = "not seen for a day"
+			f.ApplicationID = nil
+			f.BatteryBelow = nil
+			f.LastSeenOlderThan = &lastSeen
+			assert.NoError(UpdateDeviceFilter(ts.Tx(), &f))
+
+			f2, err := GetDeviceFilter(ts.Tx(), f.ID)
+			assert.NoError(err)
+			assert.Equal(f.Name, f2.Name)
+			assert.Nil(f2.ApplicationID)
+			assert.Nil(f2.BatteryBelow)
+			assert.Equal(&lastSeen, f2.LastSeenOlderThan)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+			assert.NoError(DeleteDeviceFilter(ts.Tx(), f.ID))
+
+			_, err := GetDeviceFilter(ts.Tx(), f.ID)
+			assert.Equal(ErrDoesNotExist, err)
+			assert.Equal(ErrDoesNotExist, DeleteDeviceFilter(ts.Tx(), f.ID))
+		})
+	})
+}
@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceHistory defines an application to which a device (DevEUI) belongs
+// or used to belong.
+type DeviceHistory struct {
+	ID               int64         `db:"id"`
+	CreatedAt        time.Time     `db:"created_at"`
+	DeletedAt        *time.Time    `db:"deleted_at"`
+	DevEUI           lorawan.EUI64 `db:"dev_eui"`
+	OrganizationID   int64         `db:"organization_id"`
+	OrganizationName string        `db:"organization_name"`
+	ApplicationID    int64         `db:"application_id"`
+	ApplicationName  string        `db:"application_name"`
+	DeviceName       string        `db:"device_name"`
+	LastSeenAt       *time.Time    `db:"last_seen_at"`
+	Activated        bool          `db:"activated"`
+}
+
+// GetDeviceHistoryForDevEUI returns all the applications in which the given
+// DevEUI exists or existed, ordered by creation time (newest first).
+// For the device that still exists, the last-seen timestamp, activation
+// state and names reflect the current state.
+func GetDeviceHistoryForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) ([]DeviceHistory, error) {
+	var history []DeviceHistory
+
+	err := sqlx.Select(db, &history, `
+		select
+			h.id,
+			h.created_at,
+			h.deleted_at,
+			h.dev_eui,
+			h.organization_id,
+			coalesce(o.name, h.organization_name) as organization_name,
+			h.application_id,
+			coalesce(a.name, h.application_name) as application_name,
+			coalesce(d.name, h.device_name) as device_name,
+			coalesce(d.last_seen_at, h.last_seen_at) as last_seen_at,
+			case
+				when d.dev_eui is null then h.activated
+				else exists (select 1 from device_activation da where da.dev_eui = d.dev_eui)
+			end as activated
+		from
+			device_history h
+		left join device d
+			on h.deleted_at is null and d.dev_eui = h.dev_eui and d.application_id = h.application_id
+		left join application a
+			on a.id = d.application_id
+		left join organization o
+			on o.id = a.organization_id
+		where
+			h.dev_eui = $1
+		order by
+			h.created_at desc,
+			h.id desc`,
+		devEUI[:],
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return history, nil
+}
+
+// createDeviceHistory creates the device-history record for the given
+// (newly created) device.
+func createDeviceHistory(db sqlx.Execer, d Device) error {
+	_, err := db.Exec(`
+		insert into device_history (
+			created_at,
+			dev_eui,
+			organization_id,
+			organization_name,
+			application_id,
+			application_name,
+			device_name
+		)
+		select
+			$1,
+			$2,
+			o.id,
+			o.name,
+			a.id,
+			a.name,
+			$3
+		from
+			application a
+		inner join organization o
+			on o.id = a.organization_id
+		where
+			a.id = $4`,
+		d.CreatedAt,
+		d.DevEUI[:],
+		d.Name,
+		d.ApplicationID,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// closeDeviceHistory marks the device-history record of the given device
+// as deleted, persisting its last state. This must be called before the
+// device is removed.
+func closeDeviceHistory(db sqlx.Execer, devEUI lorawan.EUI64) error {
+	_, err := db.Exec(`
+		update device_history h
+		set
+			deleted_at = $2,
+			device_name = d.name,
+			last_seen_at = d.last_seen_at,
+			activated = exists (select 1 from device_activation da where da.dev_eui = d.dev_eui)
+		from
+			device d
+		where
+			d.dev_eui = h.dev_eui
+			and h.dev_eui = $1
+			and h.deleted_at is null`,
+		devEUI[:],
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+
+	return nil
+}
+
+// moveDeviceHistory closes the open device-history record of the given
+// device, which has been moved to an other application, and creates the
+// record for its new application.
+func moveDeviceHistory(db sqlx.Execer, d Device) error {
+	_, err := db.Exec(`
+		update device_history h
+		set
+			deleted_at = $2,
+			device_name = $3,
+			last_seen_at = $4,
+			activated = exists (select 1 from device_activation da where da.dev_eui = h.dev_eui)
+		where
+			h.dev_eui = $1
+			and h.deleted_at is null`,
+		d.DevEUI[:],
+		d.UpdatedAt,
+		d.Name,
+		d.LastSeenAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+
+	d.CreatedAt = d.UpdatedAt
+	return createDeviceHistory(db, d)
+}
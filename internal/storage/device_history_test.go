@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceHistory() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	orgs := []Organization{
+		{Name: "test-org-1"},
+		{Name: "test-org-2"},
+	}
+	var apps []Application
+	var dpIDs []uuid.UUID
+
+	for i := range orgs {
+		assert.NoError(CreateOrganization(ts.Tx(), &orgs[i]))
+
+		sp := ServiceProfile{
+			OrganizationID:  orgs[i].ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		assert.NoError(err)
+
+		dp := DeviceProfile{
+			OrganizationID:  orgs[i].ID,
+			NetworkServerID: n.ID,
+			Name:            "test-dp",
+		}
+		assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		assert.NoError(err)
+		dpIDs = append(dpIDs, dpID)
+
+		app := Application{
+			OrganizationID:   orgs[i].ID,
+			Name:             "test-app",
+			ServiceProfileID: spID,
+		}
+		assert.NoError(CreateApplication(ts.Tx(), &app))
+		apps = append(apps, app)
+	}
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	ts.T().Run("Create device", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := Device{
+			DevEUI:          devEUI,
+			ApplicationID:   apps[0].ID,
+			DeviceProfileID: dpIDs[0],
+			Name:            "device-1",
+		}
+		assert.NoError(CreateDevice(ts.Tx(), &d))
+
+		history, err := GetDeviceHistoryForDevEUI(ts.Tx(), devEUI)
+		assert.NoError(err)
+		assert.Len(history, 1)
+		assert.Nil(history[0].DeletedAt)
+		assert.Equal(orgs[0].ID, history[0].OrganizationID)
+		assert.Equal(apps[0].ID, history[0].ApplicationID)
+		assert.Equal("device-1", history[0].DeviceName)
+		assert.False(history[0].Activated)
+
+		t.Run("Activate and update last-seen", func(t *testing.T) {
+			assert := require.New(t)
+
+			now := time.Now()
+			d.LastSeenAt = &now
+			assert.NoError(UpdateDevice(ts.Tx(), &d, true))
+			assert.NoError(CreateDeviceActivation(ts.Tx(), &DeviceActivation{
+				DevEUI:  devEUI,
+				DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+			}))
+
+			history, err := GetDeviceHistoryForDevEUI(ts.Tx(), devEUI)
+			assert.NoError(err)
+			assert.Len(history, 1)
+			assert.NotNil(history[0].LastSeenAt)
+			assert.True(history[0].Activated)
+		})
+
+		t.Run("Delete and create in other application", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteDevice(ts.Tx(), devEUI))
+
+			d := Device{
+				DevEUI:          devEUI,
+				ApplicationID:   apps[1].ID,
+				DeviceProfileID: dpIDs[1],
+				Name:            "device-2",
+			}
+			assert.NoError(CreateDevice(ts.Tx(), &d))
+
+			history, err := GetDeviceHistoryForDevEUI(ts.Tx(), devEUI)
+			assert.NoError(err)
+			assert.Len(history, 2)
+
+			assert.Nil(history[0].DeletedAt)
+			assert.Equal(apps[1].ID, history[0].ApplicationID)
+			assert.Equal("device-2", history[0].DeviceName)
+			assert.False(history[0].Activated)
+
+			assert.NotNil(history[1].DeletedAt)
+			assert.Equal(apps[0].ID, history[1].ApplicationID)
+			assert.Equal("test-org-1", history[1].OrganizationName)
+			assert.Equal("device-1", history[1].DeviceName)
+			assert.NotNil(history[1].LastSeenAt)
+			assert.True(history[1].Activated)
+
+			t.Run("Move to other application", func(t *testing.T) {
+				assert := require.New(t)
+
+				d.ApplicationID = apps[0].ID
+				d.DeviceProfileID = dpIDs[0]
+				assert.NoError(UpdateDevice(ts.Tx(), &d, true))
+
+				history, err := GetDeviceHistoryForDevEUI(ts.Tx(), devEUI)
+				assert.NoError(err)
+				assert.Len(history, 3)
+
+				assert.Nil(history[0].DeletedAt)
+				assert.Equal(apps[0].ID, history[0].ApplicationID)
+				assert.Equal("device-2", history[0].DeviceName)
+
+				assert.NotNil(history[1].DeletedAt)
+				assert.Equal(apps[1].ID, history[1].ApplicationID)
+				assert.Equal("test-org-2", history[1].OrganizationName)
+
+				assert.NotNil(history[2].DeletedAt)
+				assert.Equal(apps[0].ID, history[2].ApplicationID)
+			})
+		})
+	})
+}
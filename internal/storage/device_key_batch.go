@@ -0,0 +1,434 @@
+package storage
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// maxDeviceKeyBatchCount defines the max number of keys within a single
+// batch.
+const maxDeviceKeyBatchCount = 10000
+
+// DeviceKeyBatch defines a batch of pre-provisioned DevEUIs and AppKeys,
+// e.g. to be programmed into the devices by the factory. The DevEUIs are
+// allocated from the given DevEUI block.
+type DeviceKeyBatch struct {
+	ID               int64         `db:"id"`
+	CreatedAt        time.Time     `db:"created_at"`
+	UpdatedAt        time.Time     `db:"updated_at"`
+	OrganizationID   int64         `db:"organization_id"`
+	Name             string        `db:"name"`
+	JoinEUI          lorawan.EUI64 `db:"join_eui"`
+	DevEUIBlockStart lorawan.EUI64 `db:"dev_eui_block_start"`
+	DevEUIBlockEnd   lorawan.EUI64 `db:"dev_eui_block_end"`
+	Count            int           `db:"count"`
+}
+
+// Validate validates the batch data.
+func (b DeviceKeyBatch) Validate() error {
+	if binary.BigEndian.Uint64(b.DevEUIBlockStart[:]) > binary.BigEndian.Uint64(b.DevEUIBlockEnd[:]) {
+		return ErrDeviceKeyBatchInvalidBlock
+	}
+	if b.Count < 1 || b.Count > maxDeviceKeyBatchCount {
+		return ErrDeviceKeyBatchInvalidCount
+	}
+	return nil
+}
+
+// DeviceKeyBatchListItem defines the batch for listing.
+type DeviceKeyBatchListItem struct {
+	DeviceKeyBatch
+	ConsumedCount int `db:"consumed_count"`
+}
+
+// DeviceKey defines a pre-provisioned DevEUI and AppKey. The AppKey is the
+// (LoRaWAN 1.0.x) root-key, which is stored as NwkKey of the device.
+type DeviceKey struct {
+	DevEUI     lorawan.EUI64     `db:"dev_eui"`
+	BatchID    int64             `db:"batch_id"`
+	AppKey     lorawan.AES128Key `db:"app_key"`
+	ConsumedAt *time.Time        `db:"consumed_at"`
+}
+
+// DeviceKeyBatchReconciliation contains the reconciliation report of a
+// batch.
+type DeviceKeyBatchReconciliation struct {
+	// Total number of keys in the batch.
+	TotalCount int
+
+	// Keys for which no device has been created.
+	UnusedCount int
+
+	// Keys for which a device has been created, but which did not join yet.
+	ProvisionedCount int
+
+	// Keys which have been used by a join of the device.
+	ConsumedCount int
+
+	// Devices which did not join yet.
+	NotJoined []lorawan.EUI64
+
+	// Devices for which the root-key does not match the pre-provisioned
+	// AppKey.
+	KeyMismatch []lorawan.EUI64
+}
+
+// CreateDeviceKeyBatch creates the given batch. It allocates the next
+// unused DevEUIs from the DevEUI block and generates a random AppKey for
+// each DevEUI. DevEUIs which are already used by an existing device are
+// skipped. A DevEUI block is reserved by the organization of its first
+// batch, the blocks of other organizations can not be used.
+// Note: this must be called within a transaction, as the batches are locked
+// until the transaction completes.
+func CreateDeviceKeyBatch(db sqlx.Ext, b *DeviceKeyBatch) error {
+	if err := b.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	// concurrent allocations would otherwise allocate the same DevEUIs, this
+	// lock conflicts with itself but not with reading the batches
+	if _, err := db.Exec("lock table device_key_batch in share row exclusive mode"); err != nil {
+		return handlePSQLError(Update, err, "lock error")
+	}
+
+	var overlapping int
+	err := sqlx.Get(db, &overlapping, `
+		select
+			count(*)
+		from
+			device_key_batch
+		where
+			organization_id <> $1
+			and dev_eui_block_start <= $3
+			and dev_eui_block_end >= $2`,
+		b.OrganizationID,
+		b.DevEUIBlockStart[:],
+		b.DevEUIBlockEnd[:],
+	)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+	if overlapping != 0 {
+		return ErrDevEUIBlockInUse
+	}
+
+	devEUIs, err := allocateDevEUIs(db, b.DevEUIBlockStart, b.DevEUIBlockEnd, b.Count)
+	if err != nil {
+		return errors.Wrap(err, "allocate deveuis error")
+	}
+
+	now := time.Now()
+	b.CreatedAt = now
+	b.UpdatedAt = now
+
+	err = sqlx.Get(db, &b.ID, `
+		insert into device_key_batch (
+			created_at,
+			updated_at,
+			organization_id,
+			name,
+			join_eui,
+			dev_eui_block_start,
+			dev_eui_block_end,
+			count
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)
+		returning id`,
+		b.CreatedAt,
+		b.UpdatedAt,
+		b.OrganizationID,
+		b.Name,
+		b.JoinEUI[:],
+		b.DevEUIBlockStart[:],
+		b.DevEUIBlockEnd[:],
+		b.Count,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	for _, devEUI := range devEUIs {
+		var appKey lorawan.AES128Key
+		if _, err := rand.Read(appKey[:]); err != nil {
+			return errors.Wrap(err, "read random bytes error")
+		}
+
+		_, err = db.Exec(`
+			insert into device_key_pool (
+				dev_eui,
+				batch_id,
+				app_key
+			) values ($1, $2, $3)`,
+			devEUI[:],
+			b.ID,
+			appKey[:],
+		)
+		if err != nil {
+			return handlePSQLError(Insert, err, "insert error")
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"id":              b.ID,
+		"organization_id": b.OrganizationID,
+		"count":           b.Count,
+	}).Info("device-key batch created")
+
+	return nil
+}
+
+// allocateDevEUIs returns the given number of DevEUIs, following the
+// highest allocated DevEUI within the given block.
+func allocateDevEUIs(db sqlx.Queryer, start, end lorawan.EUI64, count int) ([]lorawan.EUI64, error) {
+	var max []byte
+	err := sqlx.Get(db, &max, `
+		select
+			max(dev_eui)
+		from
+			device_key_pool
+		where
+			dev_eui >= $1
+			and dev_eui <= $2`,
+		start[:],
+		end[:],
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	next := binary.BigEndian.Uint64(start[:])
+	last := binary.BigEndian.Uint64(end[:])
+
+	if len(max) == 8 {
+		if binary.BigEndian.Uint64(max) == last {
+			return nil, ErrDevEUIBlockExhausted
+		}
+		next = binary.BigEndian.Uint64(max) + 1
+	}
+
+	var used []lorawan.EUI64
+	err = sqlx.Select(db, &used, `
+		select
+			dev_eui
+		from
+			device
+		where
+			dev_eui >= $1
+			and dev_eui <= $2`,
+		start[:],
+		end[:],
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	usedMap := make(map[lorawan.EUI64]struct{})
+	for _, devEUI := range used {
+		usedMap[devEUI] = struct{}{}
+	}
+
+	var out []lorawan.EUI64
+	for n := next; len(out) < count; n++ {
+		var devEUI lorawan.EUI64
+		binary.BigEndian.PutUint64(devEUI[:], n)
+
+		if _, ok := usedMap[devEUI]; !ok {
+			out = append(out, devEUI)
+		}
+
+		if n == last && len(out) < count {
+			return nil, ErrDevEUIBlockExhausted
+		}
+	}
+
+	return out, nil
+}
+
+// GetDeviceKeyBatch returns the batch for the given id.
+func GetDeviceKeyBatch(db sqlx.Queryer, id int64) (DeviceKeyBatch, error) {
+	var b DeviceKeyBatch
+	err := sqlx.Get(db, &b, "select * from device_key_batch where id = $1", id)
+	if err != nil {
+		return b, handlePSQLError(Select, err, "select error")
+	}
+	return b, nil
+}
+
+// GetDeviceKeyBatchCount returns the total number of batches for the given
+// organization id.
+func GetDeviceKeyBatchCount(db sqlx.Queryer, organizationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from device_key_batch where organization_id = $1", organizationID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetDeviceKeyBatches returns the batches for the given organization id
+// (newest first).
+func GetDeviceKeyBatches(db sqlx.Queryer, organizationID int64, limit, offset int) ([]DeviceKeyBatchListItem, error) {
+	var items []DeviceKeyBatchListItem
+	err := sqlx.Select(db, &items, `
+		select
+			b.*,
+			(select count(*) from device_key_pool p where p.batch_id = b.id and p.consumed_at is not null) as consumed_count
+		from
+			device_key_batch b
+		where
+			b.organization_id = $1
+		order by
+			b.created_at desc
+		limit $2
+		offset $3`,
+		organizationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return items, nil
+}
+
+// DeleteDeviceKeyBatch deletes the batch (and its keys) for the given id.
+func DeleteDeviceKeyBatch(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from device_key_batch where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("device-key batch deleted")
+
+	return nil
+}
+
+// GetDeviceKeysForBatch returns the keys of the given batch, ordered by
+// DevEUI.
+func GetDeviceKeysForBatch(db sqlx.Queryer, batchID int64) ([]DeviceKey, error) {
+	var keys []DeviceKey
+	err := sqlx.Select(db, &keys, `
+		select
+			*
+		from
+			device_key_pool
+		where
+			batch_id = $1
+		order by
+			dev_eui`,
+		batchID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return keys, nil
+}
+
+// ConsumeDeviceKey marks the pre-provisioned key of the given DevEUI as
+// consumed. It is a no-op when the DevEUI is not part of a batch of the
+// organization of the device or when the key has already been consumed.
+func ConsumeDeviceKey(db sqlx.Execer, devEUI lorawan.EUI64) error {
+	res, err := db.Exec(`
+		update
+			device_key_pool p
+		set
+			consumed_at = $2
+		from
+			device_key_batch b,
+			device d
+		inner join application a
+			on a.id = d.application_id
+		where
+			b.id = p.batch_id
+			and d.dev_eui = p.dev_eui
+			and a.organization_id = b.organization_id
+			and p.dev_eui = $1
+			and p.consumed_at is null`,
+		devEUI[:],
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra != 0 {
+		log.WithField("dev_eui", devEUI).Info("pre-provisioned device-key consumed")
+	}
+
+	return nil
+}
+
+// GetDeviceKeyBatchReconciliation returns the reconciliation report for the
+// given batch id, comparing the keys of the batch with the created devices
+// and their joins. Only the devices of the organization of the batch are
+// taken into account.
+func GetDeviceKeyBatchReconciliation(db sqlx.Queryer, batchID int64) (DeviceKeyBatchReconciliation, error) {
+	var r DeviceKeyBatchReconciliation
+	var rows []struct {
+		DevEUI      lorawan.EUI64 `db:"dev_eui"`
+		Consumed    bool          `db:"consumed"`
+		Provisioned bool          `db:"provisioned"`
+		KeyMismatch bool          `db:"key_mismatch"`
+	}
+
+	err := sqlx.Select(db, &rows, `
+		select
+			p.dev_eui,
+			p.consumed_at is not null as consumed,
+			d.dev_eui is not null as provisioned,
+			coalesce(dk.nwk_key <> p.app_key, false) as key_mismatch
+		from
+			device_key_pool p
+		inner join device_key_batch b
+			on b.id = p.batch_id
+		left join device d
+			on d.dev_eui = p.dev_eui
+			and d.application_id in (select id from application where organization_id = b.organization_id)
+		left join device_keys dk
+			on dk.dev_eui = d.dev_eui
+		where
+			p.batch_id = $1
+		order by
+			p.dev_eui`,
+		batchID,
+	)
+	if err != nil {
+		return r, handlePSQLError(Select, err, "select error")
+	}
+
+	for _, row := range rows {
+		r.TotalCount++
+
+		switch {
+		case row.Consumed:
+			r.ConsumedCount++
+		case row.Provisioned:
+			r.ProvisionedCount++
+			r.NotJoined = append(r.NotJoined, row.DevEUI)
+		default:
+			r.UnusedCount++
+		}
+
+		if row.KeyMismatch {
+			r.KeyMismatch = append(r.KeyMismatch, row.DevEUI)
+		}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,296 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lorawan"
+)
+
+func TestDeviceKeyBatchValidate(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Batch DeviceKeyBatch
+		Error error
+	}{
+		{
+			Name: "valid",
+			Batch: DeviceKeyBatch{
+				DevEUIBlockStart: lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 1},
+				DevEUIBlockEnd:   lorawan.EUI64{0, 0, 0, 0, 0, 0, 1, 0},
+				Count:            10,
+			},
+		},
+		{
+			Name: "invalid block",
+			Batch: DeviceKeyBatch{
+				DevEUIBlockStart: lorawan.EUI64{0, 0, 0, 0, 0, 0, 1, 0},
+				DevEUIBlockEnd:   lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 1},
+				Count:            10,
+			},
+			Error: ErrDeviceKeyBatchInvalidBlock,
+		},
+		{
+			Name: "invalid count",
+			Batch: DeviceKeyBatch{
+				DevEUIBlockStart: lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 1},
+				DevEUIBlockEnd:   lorawan.EUI64{0, 0, 0, 0, 0, 0, 1, 0},
+				Count:            maxDeviceKeyBatchCount + 1,
+			},
+			Error: ErrDeviceKeyBatchInvalidCount,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.Error, test.Batch.Validate())
+		})
+	}
+}
+
+func (ts *StorageTestSuite) TestDeviceKeyBatch() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	sp := ServiceProfile{
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-sp",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	dp := DeviceProfile{
+		NetworkServerID: n.ID,
+		OrganizationID:  org.ID,
+		Name:            "test-dp",
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	app := Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	// this DevEUI is within the block and must be skipped
+	assert.NoError(CreateDevice(ts.Tx(), &Device{
+		DevEUI:          lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 2},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "existing-device",
+	}))
+
+	b := DeviceKeyBatch{
+		OrganizationID:   org.ID,
+		Name:             "batch-1",
+		JoinEUI:          lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+		DevEUIBlockStart: lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 1},
+		DevEUIBlockEnd:   lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 5},
+		Count:            3,
+	}
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+		assert.NoError(CreateDeviceKeyBatch(ts.Tx(), &b))
+
+		keys, err := GetDeviceKeysForBatch(ts.Tx(), b.ID)
+		assert.NoError(err)
+		assert.Len(keys, 3)
+		assert.Equal(lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 1}, keys[0].DevEUI)
+		assert.Equal(lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 3}, keys[1].DevEUI)
+		assert.Equal(lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 4}, keys[2].DevEUI)
+		assert.NotEqual(keys[0].AppKey, keys[1].AppKey)
+
+		bGet, err := GetDeviceKeyBatch(ts.Tx(), b.ID)
+		assert.NoError(err)
+		assert.Equal(b.Name, bGet.Name)
+		assert.Equal(b.JoinEUI, bGet.JoinEUI)
+		assert.Equal(b.Count, bGet.Count)
+
+		t.Run("Block exhausted", func(t *testing.T) {
+			assert := require.New(t)
+
+			b2 := b
+			b2.Count = 2
+			assert.Equal(ErrDevEUIBlockExhausted, errors.Cause(CreateDeviceKeyBatch(ts.Tx(), &b2)))
+		})
+	})
+
+	ts.T().Run("Reconciliation", func(t *testing.T) {
+		assert := require.New(t)
+
+		keys, err := GetDeviceKeysForBatch(ts.Tx(), b.ID)
+		assert.NoError(err)
+
+		// device using the pre-provisioned key
+		assert.NoError(CreateDevice(ts.Tx(), &Device{
+			DevEUI:          keys[1].DevEUI,
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "device-1",
+		}))
+		assert.NoError(CreateDeviceKeys(ts.Tx(), &DeviceKeys{
+			DevEUI: keys[1].DevEUI,
+			NwkKey: keys[1].AppKey,
+		}))
+
+		// device using an other key
+		assert.NoError(CreateDevice(ts.Tx(), &Device{
+			DevEUI:          keys[2].DevEUI,
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "device-2",
+		}))
+		assert.NoError(CreateDeviceKeys(ts.Tx(), &DeviceKeys{
+			DevEUI: keys[2].DevEUI,
+			NwkKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+		}))
+
+		// device which joined using the pre-provisioned key
+		assert.NoError(CreateDevice(ts.Tx(), &Device{
+			DevEUI:          keys[0].DevEUI,
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "device-0",
+		}))
+		assert.NoError(CreateDeviceKeys(ts.Tx(), &DeviceKeys{
+			DevEUI: keys[0].DevEUI,
+			NwkKey: keys[0].AppKey,
+		}))
+
+		assert.NoError(ConsumeDeviceKey(ts.Tx(), keys[0].DevEUI))
+		assert.NoError(ConsumeDeviceKey(ts.Tx(), keys[0].DevEUI))
+		assert.NoError(ConsumeDeviceKey(ts.Tx(), lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}))
+
+		r, err := GetDeviceKeyBatchReconciliation(ts.Tx(), b.ID)
+		assert.NoError(err)
+		assert.Equal(DeviceKeyBatchReconciliation{
+			TotalCount:       3,
+			ConsumedCount:    1,
+			ProvisionedCount: 2,
+			NotJoined:        []lorawan.EUI64{keys[1].DevEUI, keys[2].DevEUI},
+			KeyMismatch:      []lorawan.EUI64{keys[2].DevEUI},
+		}, r)
+	})
+
+	ts.T().Run("Other organization", func(t *testing.T) {
+		assert := require.New(t)
+
+		org2 := Organization{
+			Name: "other-org",
+		}
+		assert.NoError(CreateOrganization(ts.Tx(), &org2))
+
+		sp2 := ServiceProfile{
+			OrganizationID:  org2.ID,
+			NetworkServerID: n.ID,
+			Name:            "other-sp",
+		}
+		assert.NoError(CreateServiceProfile(ts.Tx(), &sp2))
+		sp2ID, err := uuid.FromBytes(sp2.ServiceProfile.Id)
+		assert.NoError(err)
+
+		app2 := Application{
+			OrganizationID:   org2.ID,
+			Name:             "other-app",
+			ServiceProfileID: sp2ID,
+		}
+		assert.NoError(CreateApplication(ts.Tx(), &app2))
+
+		t.Run("Overlapping block", func(t *testing.T) {
+			assert := require.New(t)
+
+			b2 := DeviceKeyBatch{
+				OrganizationID:   org2.ID,
+				Name:             "other-batch",
+				DevEUIBlockStart: lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 5},
+				DevEUIBlockEnd:   lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 9},
+				Count:            1,
+			}
+			assert.Equal(ErrDevEUIBlockInUse, errors.Cause(CreateDeviceKeyBatch(ts.Tx(), &b2)))
+
+			b2.DevEUIBlockStart = lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, 6}
+			assert.NoError(CreateDeviceKeyBatch(ts.Tx(), &b2))
+			assert.NoError(DeleteDeviceKeyBatch(ts.Tx(), b2.ID))
+		})
+
+		t.Run("Device of other organization", func(t *testing.T) {
+			assert := require.New(t)
+
+			keys, err := GetDeviceKeysForBatch(ts.Tx(), b.ID)
+			assert.NoError(err)
+
+			// the same DevEUI can not be used twice, therefore the device
+			// of the batch is moved to the other organization
+			d, err := GetDevice(ts.Tx(), keys[1].DevEUI, true, true)
+			assert.NoError(err)
+			_, err = ts.Tx().Exec("update device set application_id = $1 where dev_eui = $2", app2.ID, d.DevEUI[:])
+			assert.NoError(err)
+
+			assert.NoError(ConsumeDeviceKey(ts.Tx(), keys[1].DevEUI))
+
+			r, err := GetDeviceKeyBatchReconciliation(ts.Tx(), b.ID)
+			assert.NoError(err)
+			assert.Equal(DeviceKeyBatchReconciliation{
+				TotalCount:       3,
+				UnusedCount:      1,
+				ConsumedCount:    1,
+				ProvisionedCount: 1,
+				NotJoined:        []lorawan.EUI64{keys[2].DevEUI},
+				KeyMismatch:      []lorawan.EUI64{keys[2].DevEUI},
+			}, r)
+
+			_, err = ts.Tx().Exec("update device set application_id = $1 where dev_eui = $2", app.ID, d.DevEUI[:])
+			assert.NoError(err)
+		})
+	})
+
+	ts.T().Run("List", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := GetDeviceKeyBatchCount(ts.Tx(), org.ID)
+		assert.NoError(err)
+		assert.Equal(1, count)
+
+		items, err := GetDeviceKeyBatches(ts.Tx(), org.ID, 10, 0)
+		assert.NoError(err)
+		assert.Len(items, 1)
+		assert.Equal(b.ID, items[0].ID)
+		assert.Equal(1, items[0].ConsumedCount)
+	})
+
+	ts.T().Run("Delete", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(DeleteDeviceKeyBatch(ts.Tx(), b.ID))
+		assert.Equal(ErrDoesNotExist, DeleteDeviceKeyBatch(ts.Tx(), b.ID))
+
+		keys, err := GetDeviceKeysForBatch(ts.Tx(), b.ID)
+		assert.NoError(err)
+		assert.Len(keys, 0)
+	})
+}
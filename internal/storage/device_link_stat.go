@@ -0,0 +1,196 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// maxFCntGap defines the max frame-counter gap which is counted as
+// packet-loss. Larger gaps and decreasing frame-counters are assumed to be
+// caused by a frame-counter reset (e.g. after a re-join).
+const maxFCntGap = 16384
+
+// IsFCntReset returns if the given frame-counter, compared to the last
+// frame-counter, is assumed to be caused by a frame-counter reset. This is the
+// case when the frame-counter decreased by more than the max gap.
+func IsFCntReset(lastFCnt, fCnt uint32) bool {
+	return fCnt < lastFCnt && lastFCnt-fCnt > maxFCntGap
+}
+
+// DeviceLinkStat contains the uplink statistics of a device for a single
+// day (UTC).
+type DeviceLinkStat struct {
+	DevEUI    lorawan.EUI64 `db:"dev_eui"`
+	Date      time.Time     `db:"date"`
+	Uplinks   int           `db:"uplinks"`
+	Lost      int           `db:"lost"`
+	LastFCnt  uint32        `db:"last_f_cnt"`
+	FCntReset bool          `db:"f_cnt_reset"`
+}
+
+// PacketLoss returns the estimated packet-loss percentage.
+func (s DeviceLinkStat) PacketLoss() float64 {
+	if s.Uplinks+s.Lost == 0 {
+		return 0
+	}
+	return float64(s.Lost) / float64(s.Uplinks+s.Lost) * 100
+}
+
+// UpdateDeviceLinkStat updates the link statistics of the device for the
+// received uplink with the given frame-counter. The number of lost uplinks
+// is estimated from the gap between the given and the previous
+// frame-counter.
+// Note: the device must be locked (see GetDevice) to avoid concurrent
+// updates.
+func UpdateDeviceLinkStat(db sqlx.Ext, devEUI lorawan.EUI64, fCnt uint32, receivedAt time.Time) error {
+	var lost int
+
+	var last DeviceLinkStat
+	err := sqlx.Get(db, &last, `
+		select
+			*
+		from
+			device_link_stat
+		where
+			dev_eui = $1
+		order by
+			date desc
+		limit 1`,
+		devEUI[:],
+	)
+	if err != nil {
+		if err := handlePSQLError(Select, err, "select error"); err != ErrDoesNotExist {
+			return err
+		}
+	} else if !last.FCntReset && fCnt > last.LastFCnt && fCnt-last.LastFCnt <= maxFCntGap {
+		lost = int(fCnt - last.LastFCnt - 1)
+	}
+
+	date := receivedAt.UTC().Format("2006-01-02")
+
+	res, err := db.Exec(`
+		update
+			device_link_stat
+		set
+			uplinks = uplinks + 1,
+			lost = lost + $3,
+			last_f_cnt = $4,
+			f_cnt_reset = false
+		where
+			dev_eui = $1
+			and date = $2`,
+		devEUI[:],
+		date,
+		lost,
+		fCnt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra != 0 {
+		return nil
+	}
+
+	_, err = db.Exec(`
+		insert into device_link_stat (
+			dev_eui,
+			date,
+			uplinks,
+			lost,
+			last_f_cnt
+		) values ($1, $2, 1, $3, $4)`,
+		devEUI[:],
+		date,
+		lost,
+		fCnt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// ResetDeviceFCnt marks the frame-counter of the device as reset (e.g. after
+// a re-join). The next frame-counter is not compared to the last received
+// frame-counter.
+func ResetDeviceFCnt(db sqlx.Execer, devEUI lorawan.EUI64, receivedAt time.Time) error {
+	_, err := db.Exec(`
+		insert into device_link_stat (
+			dev_eui,
+			date,
+			f_cnt_reset
+		) values ($1, $2, true)
+		on conflict (dev_eui, date)
+			do update set f_cnt_reset = true`,
+		devEUI[:],
+		receivedAt.UTC().Format("2006-01-02"),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetDeviceLastFCnt returns the last frame-counter received from the
+// device. ErrDoesNotExist is returned when no uplink has been received
+// since the last frame-counter reset.
+func GetDeviceLastFCnt(db sqlx.Queryer, devEUI lorawan.EUI64) (uint32, error) {
+	var last DeviceLinkStat
+	err := sqlx.Get(db, &last, `
+		select
+			*
+		from
+			device_link_stat
+		where
+			dev_eui = $1
+		order by
+			date desc
+		limit 1`,
+		devEUI[:],
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	if last.FCntReset {
+		return 0, ErrDoesNotExist
+	}
+
+	return last.LastFCnt, nil
+}
+
+// GetDeviceLinkStats returns the daily link statistics of the device within
+// the given (inclusive) date range, ordered by date.
+func GetDeviceLinkStats(db sqlx.Queryer, devEUI lorawan.EUI64, start, end time.Time) ([]DeviceLinkStat, error) {
+	var stats []DeviceLinkStat
+	err := sqlx.Select(db, &stats, `
+		select
+			*
+		from
+			device_link_stat
+		where
+			dev_eui = $1
+			and date >= $2
+			and date <= $3
+		order by
+			date`,
+		devEUI[:],
+		start.UTC().Format("2006-01-02"),
+		end.UTC().Format("2006-01-02"),
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,165 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceLinkStat() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	sp := ServiceProfile{
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-sp",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	dp := DeviceProfile{
+		NetworkServerID: n.ID,
+		OrganizationID:  org.ID,
+		Name:            "test-dp",
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	app := Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	day := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	ts.T().Run("Get last frame-counter without uplinks", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := GetDeviceLastFCnt(ts.Tx(), d.DevEUI)
+		assert.Equal(ErrDoesNotExist, errors.Cause(err))
+	})
+
+	ts.T().Run("Update", func(t *testing.T) {
+		assert := require.New(t)
+
+		uplinks := []struct {
+			FCnt       uint32
+			ReceivedAt time.Time
+		}{
+			{5, day},
+			{6, day},
+			{9, day}, // 2 lost
+			{10, day.AddDate(0, 0, 1)},
+			{10, day.AddDate(0, 0, 1)},    // duplicate
+			{20000, day.AddDate(0, 0, 1)}, // gap exceeds max gap
+			{20001, day.AddDate(0, 0, 1)},
+			{1, day.AddDate(0, 0, 2)}, // frame-counter reset
+			{3, day.AddDate(0, 0, 2)}, // 1 lost
+		}
+		for _, u := range uplinks {
+			assert.NoError(UpdateDeviceLinkStat(ts.Tx(), d.DevEUI, u.FCnt, u.ReceivedAt))
+		}
+
+		stats, err := GetDeviceLinkStats(ts.Tx(), d.DevEUI, day, day.AddDate(0, 0, 2))
+		assert.NoError(err)
+		assert.Len(stats, 3)
+
+		expected := []struct {
+			Uplinks  int
+			Lost     int
+			LastFCnt uint32
+		}{
+			{3, 2, 9},
+			{4, 0, 20001},
+			{2, 1, 3},
+		}
+		for i, exp := range expected {
+			assert.Equal(day.AddDate(0, 0, i).Format("2006-01-02"), stats[i].Date.Format("2006-01-02"))
+			assert.Equal(exp.Uplinks, stats[i].Uplinks)
+			assert.Equal(exp.Lost, stats[i].Lost)
+			assert.Equal(exp.LastFCnt, stats[i].LastFCnt)
+		}
+
+		assert.Equal(40.0, stats[0].PacketLoss())
+		assert.Equal(0.0, stats[1].PacketLoss())
+
+		fCnt, err := GetDeviceLastFCnt(ts.Tx(), d.DevEUI)
+		assert.NoError(err)
+		assert.EqualValues(3, fCnt)
+	})
+
+	ts.T().Run("Reset frame-counter", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(ResetDeviceFCnt(ts.Tx(), d.DevEUI, day.AddDate(0, 0, 2)))
+
+		_, err := GetDeviceLastFCnt(ts.Tx(), d.DevEUI)
+		assert.Equal(ErrDoesNotExist, errors.Cause(err))
+
+		assert.NoError(UpdateDeviceLinkStat(ts.Tx(), d.DevEUI, 2, day.AddDate(0, 0, 2)))
+
+		fCnt, err := GetDeviceLastFCnt(ts.Tx(), d.DevEUI)
+		assert.NoError(err)
+		assert.EqualValues(2, fCnt)
+
+		stats, err := GetDeviceLinkStats(ts.Tx(), d.DevEUI, day.AddDate(0, 0, 2), day.AddDate(0, 0, 2))
+		assert.NoError(err)
+		assert.Len(stats, 1)
+		assert.Equal(3, stats[0].Uplinks)
+		assert.Equal(1, stats[0].Lost)
+		assert.False(stats[0].FCntReset)
+	})
+
+	ts.T().Run("Frame-counter reset", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.True(IsFCntReset(20000, 1))
+		assert.False(IsFCntReset(100, 1))
+		assert.False(IsFCntReset(1, 20000))
+	})
+
+	ts.T().Run("Get date range", func(t *testing.T) {
+		assert := require.New(t)
+
+		stats, err := GetDeviceLinkStats(ts.Tx(), d.DevEUI, day.AddDate(0, 0, 1), day.AddDate(0, 0, 1))
+		assert.NoError(err)
+		assert.Len(stats, 1)
+
+		stats, err = GetDeviceLinkStats(ts.Tx(), lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, day, day.AddDate(0, 0, 2))
+		assert.NoError(err)
+		assert.Len(stats, 0)
+	})
+}
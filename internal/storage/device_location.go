@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceLocation defines a historical location of a device.
+type DeviceLocation struct {
+	ID        int64         `db:"id"`
+	CreatedAt time.Time     `db:"created_at"`
+	DevEUI    lorawan.EUI64 `db:"dev_eui"`
+	Source    string        `db:"source"`
+	Latitude  float64       `db:"latitude"`
+	Longitude float64       `db:"longitude"`
+	Altitude  float64       `db:"altitude"`
+}
+
+// DeviceLocationFilters provides filters for filtering the location history
+// of a device. Note that empty values are not used as filter.
+type DeviceLocationFilters struct {
+	DevEUI lorawan.EUI64 `db:"dev_eui"`
+	Start  *time.Time    `db:"start"`
+	End    *time.Time    `db:"end"`
+}
+
+// SQL returns the SQL filter.
+func (f DeviceLocationFilters) SQL() string {
+	filters := []string{"dev_eui = :dev_eui"}
+
+	if f.Start != nil {
+		filters = append(filters, "created_at >= :start")
+	}
+
+	if f.End != nil {
+		filters = append(filters, "created_at < :end")
+	}
+
+	return "where " + strings.Join(filters, " and ")
+}
+
+// CreateDeviceLocation creates the given device location within the storage
+// cluster of the organization of the device.
+// When CreatedAt is not set, it will be set to the current time.
+func CreateDeviceLocation(db sqlx.Queryer, l *DeviceLocation) error {
+	if l.CreatedAt.IsZero() {
+		l.CreatedAt = time.Now()
+	}
+
+	db, err := deviceDataDB(db, l.DevEUI)
+	if err != nil {
+		return errors.Wrap(err, "get device data db error")
+	}
+
+	return insertDeviceLocation(db, l)
+}
+
+// insertDeviceLocation inserts the given device location in the given
+// database.
+func insertDeviceLocation(db sqlx.Queryer, l *DeviceLocation) error {
+	err := sqlx.Get(db, &l.ID, `
+		insert into device_location (
+			created_at,
+			dev_eui,
+			source,
+			latitude,
+			longitude,
+			altitude
+		) values ($1, $2, $3, $4, $5, $6)
+		returning id`,
+		l.CreatedAt,
+		l.DevEUI[:],
+		l.Source,
+		l.Latitude,
+		l.Longitude,
+		l.Altitude,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":      l.ID,
+		"dev_eui": l.DevEUI,
+		"source":  l.Source,
+	}).Debug("device location created")
+
+	return nil
+}
+
+// GetDeviceLocations returns the location history of a device, ordered by
+// time (oldest first).
+func GetDeviceLocations(db sqlx.Queryer, filters DeviceLocationFilters) ([]DeviceLocation, error) {
+	db, err := deviceDataDB(db, filters.DevEUI)
+	if err != nil {
+		return nil, errors.Wrap(err, "get device data db error")
+	}
+
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			*
+		from
+			device_location
+		`+filters.SQL()+`
+		order by
+			created_at
+	`, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "named query error")
+	}
+
+	var locations []DeviceLocation
+	err = sqlx.Select(db, &locations, query, args...)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return locations, nil
+}
+
+// DeleteDeviceLocationsBefore deletes the device locations created before
+// the given timestamp. It returns the number of deleted locations.
+func DeleteDeviceLocationsBefore(db sqlx.Execer, before time.Time) (int64, error) {
+	res, err := db.Exec(`
+		delete from device_location
+		where
+			created_at < $1`,
+		before,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "get rows affected error")
+	}
+
+	return ra, nil
+}
@@ -0,0 +1,160 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceLocation() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	sp := ServiceProfile{
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-sp",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	dp := DeviceProfile{
+		NetworkServerID: n.ID,
+		OrganizationID:  org.ID,
+		Name:            "test-dp",
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	app := Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		locations := []DeviceLocation{
+			{
+				CreatedAt: now.Add(-2 * time.Hour),
+				DevEUI:    d.DevEUI,
+				Source:    "GPS",
+				Latitude:  1.123,
+				Longitude: 2.123,
+				Altitude:  3.123,
+			},
+			{
+				CreatedAt: now.Add(-time.Hour),
+				DevEUI:    d.DevEUI,
+				Source:    "GEO_RESOLVER",
+				Latitude:  1.234,
+				Longitude: 2.234,
+				Altitude:  3.234,
+			},
+			{
+				CreatedAt: now,
+				DevEUI:    d.DevEUI,
+				Source:    "GPS",
+				Latitude:  1.345,
+				Longitude: 2.345,
+				Altitude:  3.345,
+			},
+		}
+		for i := range locations {
+			assert.NoError(CreateDeviceLocation(ts.Tx(), &locations[i]))
+			assert.NotEqual(0, locations[i].ID)
+		}
+
+		tests := []struct {
+			Name     string
+			Filters  DeviceLocationFilters
+			Expected []DeviceLocation
+		}{
+			{
+				Name:     "no time filters",
+				Filters:  DeviceLocationFilters{DevEUI: d.DevEUI},
+				Expected: locations,
+			},
+			{
+				Name: "start filter",
+				Filters: DeviceLocationFilters{
+					DevEUI: d.DevEUI,
+					Start:  &locations[1].CreatedAt,
+				},
+				Expected: locations[1:],
+			},
+			{
+				Name: "start and end filter",
+				Filters: DeviceLocationFilters{
+					DevEUI: d.DevEUI,
+					Start:  &locations[1].CreatedAt,
+					End:    &locations[2].CreatedAt,
+				},
+				Expected: locations[1:2],
+			},
+			{
+				Name:    "other device",
+				Filters: DeviceLocationFilters{DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.Name, func(t *testing.T) {
+				assert := require.New(t)
+
+				locs, err := GetDeviceLocations(ts.Tx(), test.Filters)
+				assert.NoError(err)
+				assert.Len(locs, len(test.Expected))
+
+				for i := range locs {
+					locs[i].CreatedAt = locs[i].CreatedAt.UTC().Truncate(time.Millisecond)
+					assert.Equal(test.Expected[i], locs[i])
+				}
+			})
+		}
+	})
+	ts.T().Run("Delete before", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := DeleteDeviceLocationsBefore(ts.Tx(), now.Add(-time.Hour))
+		assert.NoError(err)
+		assert.EqualValues(1, count)
+
+		locs, err := GetDeviceLocations(ts.Tx(), DeviceLocationFilters{DevEUI: d.DevEUI})
+		assert.NoError(err)
+		assert.Len(locs, 2)
+	})
+}
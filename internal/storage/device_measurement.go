@@ -0,0 +1,172 @@
+package storage
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceMeasurement defines the decoded payload (object) of an uplink of a
+// device, used for charting the measurements of the device.
+type DeviceMeasurement struct {
+	ID        int64           `db:"id"`
+	CreatedAt time.Time       `db:"created_at"`
+	DevEUI    lorawan.EUI64   `db:"dev_eui"`
+	Object    json.RawMessage `db:"object"`
+}
+
+// DeviceObjectValue contains a numeric value of the decoded payload of an
+// uplink.
+type DeviceObjectValue struct {
+	CreatedAt time.Time
+	Value     float64
+}
+
+// CreateDeviceMeasurement creates the given device measurement within the
+// storage cluster of the organization of the device.
+func CreateDeviceMeasurement(db sqlx.Queryer, m *DeviceMeasurement) error {
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = time.Now()
+	}
+
+	db, err := deviceDataDB(db, m.DevEUI)
+	if err != nil {
+		return errors.Wrap(err, "get device data db error")
+	}
+
+	return insertDeviceMeasurement(db, m)
+}
+
+// insertDeviceMeasurement inserts the given device measurement in the given
+// database.
+func insertDeviceMeasurement(db sqlx.Queryer, m *DeviceMeasurement) error {
+	err := sqlx.Get(db, &m.ID, `
+		insert into device_measurement (
+			created_at,
+			dev_eui,
+			object
+		) values ($1, $2, $3)
+		returning id`,
+		m.CreatedAt,
+		m.DevEUI[:],
+		m.Object,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetDeviceObjectValues returns the values at the given path of the decoded
+// payload (object) of the measurements of the given device between start
+// (inclusive) and end (exclusive), ordered by time. Boolean values are
+// returned as 1 and 0, measurements for which the value is missing or is not
+// a number or boolean are skipped.
+func GetDeviceObjectValues(db sqlx.Queryer, devEUI lorawan.EUI64, path []string, start, end time.Time) ([]DeviceObjectValue, error) {
+	db, err := deviceDataDB(db, devEUI)
+	if err != nil {
+		return nil, errors.Wrap(err, "get device data db error")
+	}
+
+	var rows []struct {
+		CreatedAt time.Time `db:"created_at"`
+		Value     string    `db:"value"`
+	}
+
+	err = sqlx.Select(db, &rows, `
+		select
+			created_at,
+			object #>> $2 as value
+		from
+			device_measurement
+		where
+			dev_eui = $1
+			and created_at >= $3
+			and created_at < $4
+			and jsonb_typeof(object #> $2) in ('number', 'boolean')
+		order by
+			created_at`,
+		devEUI[:],
+		pq.Array(path),
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	out := make([]DeviceObjectValue, 0, len(rows))
+	for _, row := range rows {
+		v := DeviceObjectValue{CreatedAt: row.CreatedAt}
+		switch row.Value {
+		case "true":
+			v.Value = 1
+		case "false":
+			v.Value = 0
+		default:
+			v.Value, err = strconv.ParseFloat(row.Value, 64)
+			if err != nil {
+				return nil, errors.Wrap(err, "parse value error")
+			}
+		}
+		out = append(out, v)
+	}
+
+	return out, nil
+}
+
+// GetLastDeviceObject returns the decoded payload (object) of the last
+// measurement of the given device. It returns ErrDoesNotExist when there
+// is no measurement for the device.
+func GetLastDeviceObject(db sqlx.Queryer, devEUI lorawan.EUI64) (json.RawMessage, error) {
+	db, err := deviceDataDB(db, devEUI)
+	if err != nil {
+		return nil, errors.Wrap(err, "get device data db error")
+	}
+
+	var obj json.RawMessage
+	err = sqlx.Get(db, &obj, `
+		select
+			object
+		from
+			device_measurement
+		where
+			dev_eui = $1
+		order by
+			created_at desc
+		limit 1`,
+		devEUI[:],
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return obj, nil
+}
+
+// DeleteDeviceMeasurementsBefore deletes the device measurements created
+// before the given timestamp. It returns the number of deleted measurements.
+func DeleteDeviceMeasurementsBefore(db sqlx.Execer, before time.Time) (int64, error) {
+	res, err := db.Exec(`
+		delete from device_measurement
+		where
+			created_at < $1`,
+		before,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "get rows affected error")
+	}
+
+	return ra, nil
+}
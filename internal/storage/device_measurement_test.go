@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceMeasurement() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	sp := ServiceProfile{
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-sp",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	dp := DeviceProfile{
+		NetworkServerID: n.ID,
+		OrganizationID:  org.ID,
+		Name:            "test-dp",
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	app := Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	devices := []Device{
+		{DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, Name: "device-1"},
+		{DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, Name: "device-2"},
+	}
+	for i := range devices {
+		devices[i].ApplicationID = app.ID
+		devices[i].DeviceProfileID = dpID
+		assert.NoError(CreateDevice(ts.Tx(), &devices[i]))
+	}
+
+	now := time.Now()
+	measurements := []DeviceMeasurement{
+		{DevEUI: devices[0].DevEUI, CreatedAt: now.Add(-2 * time.Second), Object: json.RawMessage(`{"temperature": 20.5, "door": {"open": true}}`)},
+		{DevEUI: devices[0].DevEUI, CreatedAt: now.Add(-time.Second), Object: json.RawMessage(`{"temperature": "n/a", "door": {"open": false}}`)},
+		{DevEUI: devices[1].DevEUI, CreatedAt: now, Object: json.RawMessage(`{"temperature": 30}`)},
+	}
+	for i := range measurements {
+		assert.NoError(CreateDeviceMeasurement(ts.Tx(), &measurements[i]))
+	}
+
+	start := now.Add(-time.Minute)
+	end := now.Add(time.Minute)
+
+	ts.T().Run("Get object values", func(t *testing.T) {
+		assert := require.New(t)
+
+		values, err := GetDeviceObjectValues(ts.Tx(), devices[0].DevEUI, []string{"temperature"}, start, end)
+		assert.NoError(err)
+		assert.Len(values, 1)
+		assert.Equal(20.5, values[0].Value)
+
+		values, err = GetDeviceObjectValues(ts.Tx(), devices[0].DevEUI, []string{"door", "open"}, start, end)
+		assert.NoError(err)
+		assert.Len(values, 2)
+		assert.Equal(float64(1), values[0].Value)
+		assert.Equal(float64(0), values[1].Value)
+
+		values, err = GetDeviceObjectValues(ts.Tx(), devices[0].DevEUI, []string{"temperature"}, end, end.Add(time.Minute))
+		assert.NoError(err)
+		assert.Len(values, 0)
+	})
+
+	ts.T().Run("Get last object", func(t *testing.T) {
+		assert := require.New(t)
+
+		obj, err := GetLastDeviceObject(ts.Tx(), devices[0].DevEUI)
+		assert.NoError(err)
+		assert.JSONEq(`{"temperature": "n/a", "door": {"open": false}}`, string(obj))
+
+		_, err = GetLastDeviceObject(ts.Tx(), lorawan.EUI64{})
+		assert.Equal(ErrDoesNotExist, errors.Cause(err))
+	})
+
+	ts.T().Run("Delete before", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := DeleteDeviceMeasurementsBefore(ts.Tx(), now.Add(-time.Second))
+		assert.NoError(err)
+		assert.EqualValues(1, count)
+
+		values, err := GetDeviceObjectValues(ts.Tx(), devices[0].DevEUI, []string{"door", "open"}, start, end)
+		assert.NoError(err)
+		assert.Len(values, 1)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/loraserver/api/ns"
 )
 
@@ -22,7 +23,12 @@ type DeviceProfile struct {
 	CreatedAt       time.Time        `db:"created_at"`
 	UpdatedAt       time.Time        `db:"updated_at"`
 	Name            string           `db:"name"`
+	IsShared        bool             `db:"is_shared"`
 	DeviceProfile   ns.DeviceProfile `db:"-"`
+
+	// Max. number of downlinks per device per hour and day (0 = unlimited).
+	MaxDownlinksPerHour int `db:"max_downlinks_per_hour"`
+	MaxDownlinksPerDay  int `db:"max_downlinks_per_day"`
 }
 
 // DeviceProfileMeta defines the device-profile meta record.
@@ -33,6 +39,14 @@ type DeviceProfileMeta struct {
 	CreatedAt       time.Time `db:"created_at"`
 	UpdatedAt       time.Time `db:"updated_at"`
 	Name            string    `db:"name"`
+	IsShared        bool      `db:"is_shared"`
+
+	MaxDownlinksPerHour int `db:"max_downlinks_per_hour"`
+	MaxDownlinksPerDay  int `db:"max_downlinks_per_day"`
+
+	// PayloadCodecChain is managed using GetDeviceProfileCodecChain and
+	// UpdateDeviceProfileCodecChain.
+	PayloadCodecChain codec.Chain `db:"payload_codec_chain"`
 }
 
 // Validate validates the device-profile data.
@@ -68,14 +82,20 @@ func CreateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
             organization_id,
             created_at,
             updated_at,
-            name
-		) values ($1, $2, $3, $4, $5, $6)`,
+            name,
+            is_shared,
+            max_downlinks_per_hour,
+            max_downlinks_per_day
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
 		dpID,
 		dp.NetworkServerID,
 		dp.OrganizationID,
 		dp.CreatedAt,
 		dp.UpdatedAt,
 		dp.Name,
+		dp.IsShared,
+		dp.MaxDownlinksPerHour,
+		dp.MaxDownlinksPerDay,
 	)
 	if err != nil {
 		log.WithField("id", dpID).Errorf("create device-profile error: %s", err)
@@ -116,7 +136,10 @@ func GetDeviceProfile(db sqlx.Queryer, id uuid.UUID) (DeviceProfile, error) {
 			organization_id,
 			created_at,
 			updated_at,
-			name
+			name,
+			is_shared,
+			max_downlinks_per_hour,
+			max_downlinks_per_day
 		from device_profile
 		where
 			device_profile_id = $1`,
@@ -126,7 +149,7 @@ func GetDeviceProfile(db sqlx.Queryer, id uuid.UUID) (DeviceProfile, error) {
 		return dp, handlePSQLError(Select, err, "select error")
 	}
 
-	err := row.Scan(&dp.NetworkServerID, &dp.OrganizationID, &dp.CreatedAt, &dp.UpdatedAt, &dp.Name)
+	err := row.Scan(&dp.NetworkServerID, &dp.OrganizationID, &dp.CreatedAt, &dp.UpdatedAt, &dp.Name, &dp.IsShared, &dp.MaxDownlinksPerHour, &dp.MaxDownlinksPerDay)
 	if err != nil {
 		return dp, handlePSQLError(Scan, err, "scan error")
 	}
@@ -190,11 +213,17 @@ func UpdateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
         update device_profile
         set
             updated_at = $2,
-            name = $3
+            name = $3,
+            is_shared = $4,
+            max_downlinks_per_hour = $5,
+            max_downlinks_per_day = $6
 		where device_profile_id = $1`,
 		dpID,
 		dp.UpdatedAt,
 		dp.Name,
+		dp.IsShared,
+		dp.MaxDownlinksPerHour,
+		dp.MaxDownlinksPerDay,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -250,6 +279,110 @@ func DeleteDeviceProfile(db sqlx.Ext, id uuid.UUID) error {
 	return nil
 }
 
+// CopyDeviceProfile creates a copy of the device-profile matching the given
+// id, owned by the given organization id. This is used to customize a
+// shared device-profile. When name is empty, the name of the original
+// device-profile is used. The copy itself is never shared.
+func CopyDeviceProfile(db sqlx.Ext, id uuid.UUID, organizationID int64, name string) (DeviceProfile, error) {
+	dp, err := GetDeviceProfile(db, id)
+	if err != nil {
+		return dp, errors.Wrap(err, "get device-profile error")
+	}
+
+	dp.OrganizationID = organizationID
+	dp.IsShared = false
+	if name != "" {
+		dp.Name = name
+	}
+
+	if err := CreateDeviceProfile(db, &dp); err != nil {
+		return dp, errors.Wrap(err, "create device-profile error")
+	}
+
+	chain, err := GetDeviceProfileCodecChain(db, id)
+	if err != nil {
+		return dp, errors.Wrap(err, "get codec chain error")
+	}
+
+	copyID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	if err != nil {
+		return dp, errors.Wrap(err, "uuid from bytes error")
+	}
+
+	if err := UpdateDeviceProfileCodecChain(db, copyID, chain); err != nil {
+		return dp, errors.Wrap(err, "update codec chain error")
+	}
+
+	return dp, nil
+}
+
+// GetDeviceProfileCodecChain returns the payload codec chain of the given
+// device-profile.
+func GetDeviceProfileCodecChain(db sqlx.Queryer, id uuid.UUID) (codec.Chain, error) {
+	var chain codec.Chain
+	err := sqlx.Get(db, &chain, "select payload_codec_chain from device_profile where device_profile_id = $1", id)
+	if err != nil {
+		return chain, handlePSQLError(Select, err, "select error")
+	}
+	return chain, nil
+}
+
+// UpdateDeviceProfileCodecChain updates the payload codec chain of the given
+// device-profile. An empty chain removes the chain.
+func UpdateDeviceProfileCodecChain(db sqlx.Execer, id uuid.UUID, chain codec.Chain) error {
+	if err := chain.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	res, err := db.Exec(`
+		update device_profile
+		set
+			updated_at = $2,
+			payload_codec_chain = $3
+		where device_profile_id = $1`,
+		id,
+		time.Now(),
+		chain,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":              id,
+		"pre_processors":  len(chain.PreProcessors),
+		"post_processors": len(chain.PostProcessors),
+	}).Info("device-profile codec chain updated")
+
+	return nil
+}
+
+// GetDeviceProfileDownlinkRateLimits returns the max. number of downlinks per
+// device per hour and day of the given device-profile, without retrieving
+// the device-profile from the network-server.
+func GetDeviceProfileDownlinkRateLimits(db sqlx.Queryer, id uuid.UUID) (perHour, perDay int, err error) {
+	err = db.QueryRowx(`
+		select
+			max_downlinks_per_hour,
+			max_downlinks_per_day
+		from device_profile
+		where
+			device_profile_id = $1`,
+		id,
+	).Scan(&perHour, &perDay)
+	if err != nil {
+		return 0, 0, handlePSQLError(Select, err, "select error")
+	}
+	return perHour, perDay, nil
+}
+
 // GetDeviceProfileCount returns the total number of device-profiles.
 func GetDeviceProfileCount(db sqlx.Queryer) (int, error) {
 	var count int
@@ -262,10 +395,11 @@ func GetDeviceProfileCount(db sqlx.Queryer) (int, error) {
 }
 
 // GetDeviceProfileCountForOrganizationID returns the total number of
-// device-profiles for the given organization id.
+// device-profiles for the given organization id (including the shared
+// device-profiles).
 func GetDeviceProfileCountForOrganizationID(db sqlx.Queryer, organizationID int64) (int, error) {
 	var count int
-	err := sqlx.Get(db, &count, "select count(*) from device_profile where organization_id = $1", organizationID)
+	err := sqlx.Get(db, &count, "select count(*) from device_profile where organization_id = $1 or is_shared = true", organizationID)
 	if err != nil {
 		return 0, handlePSQLError(Select, err, "select error")
 	}
@@ -273,21 +407,23 @@ func GetDeviceProfileCountForOrganizationID(db sqlx.Queryer, organizationID int6
 }
 
 // GetDeviceProfileCountForUser returns the total number of device-profiles
-// for the given username.
+// for the given username (including the shared device-profiles).
 func GetDeviceProfileCountForUser(db sqlx.Queryer, username string) (int, error) {
 	var count int
 	err := sqlx.Get(db, &count, `
 		select
 			count(dp.*)
 		from device_profile dp
-		inner join organization o
-			on o.id = dp.organization_id
-		inner join organization_user ou
-			on ou.organization_id = o.id
-		inner join "user" u
-			on u.id = ou.user_id
 		where
-			u.username = $1`,
+			dp.is_shared = true
+			or dp.organization_id in (
+				select ou.organization_id
+				from organization_user ou
+				inner join "user" u
+					on u.id = ou.user_id
+				where
+					u.username = $1
+			)`,
 		username,
 	)
 	if err != nil {
@@ -298,7 +434,8 @@ func GetDeviceProfileCountForUser(db sqlx.Queryer, username string) (int, error)
 
 // GetDeviceProfileCountForApplicationID returns the total number of
 // device-profiles that can be used for the given application id (based
-// on the service-profile of the application).
+// on the service-profile of the application). This includes the shared
+// device-profiles provisioned on the same network-server.
 func GetDeviceProfileCountForApplicationID(db sqlx.Queryer, applicationID int64) (int, error) {
 	var count int
 	err := sqlx.Get(db, &count, `
@@ -313,7 +450,7 @@ func GetDeviceProfileCountForApplicationID(db sqlx.Queryer, applicationID int64)
 			on a.service_profile_id = sp.service_profile_id
 		where
 			a.id = $1
-			and dp.organization_id = a.organization_id`,
+			and (dp.organization_id = a.organization_id or dp.is_shared = true)`,
 		applicationID,
 	)
 	if err != nil {
@@ -340,7 +477,7 @@ func GetDeviceProfiles(db sqlx.Queryer, limit, offset int) ([]DeviceProfileMeta,
 }
 
 // GetDeviceProfilesForOrganizationID returns a slice of device-profiles
-// for the given organization id.
+// for the given organization id (including the shared device-profiles).
 func GetDeviceProfilesForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int) ([]DeviceProfileMeta, error) {
 	var dps []DeviceProfileMeta
 	err := sqlx.Select(db, &dps, `
@@ -348,6 +485,7 @@ func GetDeviceProfilesForOrganizationID(db sqlx.Queryer, organizationID int64, l
 		from device_profile
 		where
 			organization_id = $1
+			or is_shared = true
 		order by name
 		limit $2 offset $3`,
 		organizationID,
@@ -361,20 +499,22 @@ func GetDeviceProfilesForOrganizationID(db sqlx.Queryer, organizationID int64, l
 }
 
 // GetDeviceProfilesForUser returns a slice of device-profiles for the given
-// username.
+// username (including the shared device-profiles).
 func GetDeviceProfilesForUser(db sqlx.Queryer, username string, limit, offset int) ([]DeviceProfileMeta, error) {
 	var dps []DeviceProfileMeta
 	err := sqlx.Select(db, &dps, `
 		select dp.*
 		from device_profile dp
-		inner join organization o
-			on o.id = dp.organization_id
-		inner join organization_user ou
-			on ou.organization_id = o.id
-		inner join "user" u
-			on u.id = ou.user_id
 		where
-			u.username = $1
+			dp.is_shared = true
+			or dp.organization_id in (
+				select ou.organization_id
+				from organization_user ou
+				inner join "user" u
+					on u.id = ou.user_id
+				where
+					u.username = $1
+			)
 		order by dp.name
 		limit $2 offset $3`,
 		username,
@@ -390,7 +530,8 @@ func GetDeviceProfilesForUser(db sqlx.Queryer, username string, limit, offset in
 
 // GetDeviceProfilesForApplicationID returns a slice of device-profiles that
 // can be used for the given application id (based on the service-profile
-// of the application).
+// of the application). This includes the shared device-profiles provisioned
+// on the same network-server.
 func GetDeviceProfilesForApplicationID(db sqlx.Queryer, applicationID int64, limit, offset int) ([]DeviceProfileMeta, error) {
 	var dps []DeviceProfileMeta
 	err := sqlx.Select(db, &dps, `
@@ -405,7 +546,7 @@ func GetDeviceProfilesForApplicationID(db sqlx.Queryer, applicationID int64, lim
 			on a.service_profile_id = sp.service_profile_id
 		where
 			a.id = $1
-			and dp.organization_id = a.organization_id
+			and (dp.organization_id = a.organization_id or dp.is_shared = true)
 		order by dp.name
 		limit $2 offset $3`,
 		applicationID,
@@ -6,11 +6,14 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
 	"github.com/brocaar/lorawan/backend"
 )
 
@@ -282,6 +285,157 @@ func (ts *StorageTestSuite) TestDeviceProfile() {
 			assert.Equal(dp.UpdatedAt, dpGet.UpdatedAt)
 		})
 
+		t.Run("Downlink rate-limits", func(t *testing.T) {
+			assert := require.New(t)
+
+			dp.MaxDownlinksPerHour = 10
+			dp.MaxDownlinksPerDay = 100
+			assert.NoError(UpdateDeviceProfile(ts.Tx(), &dp))
+			<-nsClient.UpdateDeviceProfileChan
+
+			dpGet, err := GetDeviceProfile(ts.Tx(), dpID)
+			assert.NoError(err)
+			assert.Equal(10, dpGet.MaxDownlinksPerHour)
+			assert.Equal(100, dpGet.MaxDownlinksPerDay)
+
+			perHour, perDay, err := GetDeviceProfileDownlinkRateLimits(ts.Tx(), dpID)
+			assert.NoError(err)
+			assert.Equal(10, perHour)
+			assert.Equal(100, perDay)
+		})
+
+		t.Run("Codec chain", func(t *testing.T) {
+			assert := require.New(t)
+
+			chain, err := GetDeviceProfileCodecChain(ts.Tx(), dpID)
+			assert.NoError(err)
+			assert.True(chain.IsEmpty())
+
+			chain = codec.Chain{
+				PostProcessors: []codec.Stage{
+					{Name: "rename", Type: codec.RenameStageType, Renames: map[string]string{"t": "temperature"}},
+				},
+			}
+			assert.NoError(UpdateDeviceProfileCodecChain(ts.Tx(), dpID, chain))
+
+			chainGet, err := GetDeviceProfileCodecChain(ts.Tx(), dpID)
+			assert.NoError(err)
+			assert.Equal(chain, chainGet)
+
+			t.Run("Invalid chain", func(t *testing.T) {
+				assert := require.New(t)
+
+				err := UpdateDeviceProfileCodecChain(ts.Tx(), dpID, codec.Chain{
+					PreProcessors: []codec.Stage{{Type: codec.ScaleStageType}},
+				})
+				assert.Equal(codec.ErrInvalidPreProcessor, errors.Cause(err))
+			})
+		})
+
+		t.Run("Shared", func(t *testing.T) {
+			assert := require.New(t)
+
+			org2 := Organization{
+				Name: "test-org-456",
+			}
+			assert.NoError(CreateOrganization(ts.Tx(), &org2))
+
+			count, err := GetDeviceProfileCountForOrganizationID(ts.Tx(), org2.ID)
+			assert.NoError(err)
+			assert.Equal(0, count)
+
+			dp.IsShared = true
+			assert.NoError(UpdateDeviceProfile(ts.Tx(), &dp))
+			<-nsClient.UpdateDeviceProfileChan
+
+			dpGet, err := GetDeviceProfile(ts.Tx(), dpID)
+			assert.NoError(err)
+			assert.True(dpGet.IsShared)
+
+			t.Run("GetDeviceProfilesForOrganizationID", func(t *testing.T) {
+				assert := require.New(t)
+
+				count, err := GetDeviceProfileCountForOrganizationID(ts.Tx(), org2.ID)
+				assert.NoError(err)
+				assert.Equal(1, count)
+
+				dps, err := GetDeviceProfilesForOrganizationID(ts.Tx(), org2.ID, 10, 0)
+				assert.NoError(err)
+				assert.Len(dps, 1)
+				assert.Equal(dpID, dps[0].DeviceProfileID)
+				assert.True(dps[0].IsShared)
+			})
+
+			t.Run("GetDeviceProfilesForUser", func(t *testing.T) {
+				assert := require.New(t)
+
+				count, err := GetDeviceProfileCountForUser(ts.Tx(), "fakeuser")
+				assert.NoError(err)
+				assert.Equal(1, count)
+
+				dps, err := GetDeviceProfilesForUser(ts.Tx(), "fakeuser", 10, 0)
+				assert.NoError(err)
+				assert.Len(dps, 1)
+			})
+
+			t.Run("Copy", func(t *testing.T) {
+				assert := require.New(t)
+
+				dpCopy, err := CopyDeviceProfile(ts.Tx(), dpID, org2.ID, "copied-device-profile")
+				assert.NoError(err)
+				assert.Equal(org2.ID, dpCopy.OrganizationID)
+				assert.Equal("copied-device-profile", dpCopy.Name)
+				assert.False(dpCopy.IsShared)
+				assert.NotEqual(dp.DeviceProfile.Id, dpCopy.DeviceProfile.Id)
+
+				createReq := <-nsClient.CreateDeviceProfileChan
+				assert.Equal(dpCopy.DeviceProfile.Id, createReq.DeviceProfile.Id)
+				assert.Equal(dp.DeviceProfile.MacVersion, createReq.DeviceProfile.MacVersion)
+
+				copyID, err := uuid.FromBytes(dpCopy.DeviceProfile.Id)
+				assert.NoError(err)
+				chain, err := GetDeviceProfileCodecChain(ts.Tx(), copyID)
+				assert.NoError(err)
+				assert.Len(chain.PostProcessors, 1)
+
+				count, err := GetDeviceProfileCountForOrganizationID(ts.Tx(), org2.ID)
+				assert.NoError(err)
+				assert.Equal(2, count)
+			})
+
+			t.Run("Delete organization in use by other organization", func(t *testing.T) {
+				assert := require.New(t)
+
+				sp := ServiceProfile{
+					OrganizationID:  org2.ID,
+					NetworkServerID: n.ID,
+					Name:            "test-sp",
+				}
+				assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+				spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+				assert.NoError(err)
+
+				app := Application{
+					OrganizationID:   org2.ID,
+					Name:             "test-app",
+					ServiceProfileID: spID,
+				}
+				assert.NoError(CreateApplication(ts.Tx(), &app))
+
+				d := Device{
+					DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+					ApplicationID:   app.ID,
+					DeviceProfileID: dpID,
+					Name:            "test-device",
+				}
+				assert.NoError(CreateDevice(ts.Tx(), &d))
+
+				assert.Equal(ErrOrganizationSharedDeviceProfile, DeleteOrganization(ts.Tx(), org.ID))
+
+				assert.NoError(DeleteDevice(ts.Tx(), d.DevEUI))
+			})
+		})
+
 		t.Run("Delete", func(t *testing.T) {
 			assert := require.New(t)
 
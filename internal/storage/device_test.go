@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
@@ -15,6 +16,54 @@ import (
 	"github.com/brocaar/lorawan/backend"
 )
 
+func TestDeriveDeviceKeys(t *testing.T) {
+	assert := require.New(t)
+
+	masterKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	dk, err := DeriveDeviceKeys(masterKey, devEUI)
+	assert.NoError(err)
+	assert.Equal(devEUI, dk.DevEUI)
+	assert.Equal("790ee74d7bedfacb171b2ca72592c23a", dk.NwkKey.String())
+	assert.Equal("d1f3ee63a52eebb95b7295e412cee7a0", dk.AppKey.String())
+
+	dk2, err := DeriveDeviceKeys(masterKey, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1})
+	assert.NoError(err)
+	assert.NotEqual(dk.NwkKey, dk2.NwkKey)
+}
+
+func TestDeviceLifecycleStateTransitions(t *testing.T) {
+	tests := []struct {
+		From    DeviceLifecycleState
+		To      DeviceLifecycleState
+		Allowed bool
+	}{
+		{DeviceProvisioned, DeviceActive, true},
+		{DeviceProvisioned, DeviceSuspended, true},
+		{DeviceActive, DeviceSuspended, true},
+		{DeviceActive, DeviceProvisioned, false},
+		{DeviceActive, DeviceActive, false},
+		{DeviceSuspended, DeviceActive, true},
+		{DeviceSuspended, DeviceRetired, true},
+		{DeviceRetired, DeviceActive, false},
+		{DeviceRetired, DeviceSuspended, false},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.From)+" to "+string(test.To), func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.Allowed, test.From.CanTransitionTo(test.To))
+		})
+	}
+
+	assert := require.New(t)
+	assert.True(DeviceActive.Enabled())
+	assert.False(DeviceSuspended.Enabled())
+	assert.Equal(ErrDeviceRetired, DeviceRetired.Err())
+	assert.Equal(ErrDeviceLifecycleStateInvalid, DeviceLifecycleState("deleted").Validate())
+}
+
 func (ts *StorageTestSuite) TestDevice() {
 	assert := require.New(ts.T())
 
@@ -101,6 +150,56 @@ func (ts *StorageTestSuite) TestDevice() {
 	rpID, err := uuid.FromString(config.C.ApplicationServer.ID)
 	assert.NoError(err)
 
+	ts.T().Run("Create with application master key", func(t *testing.T) {
+		assert := require.New(t)
+
+		masterKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+		app2 := Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app-master-key",
+			ServiceProfileID: spID,
+			MasterKey:        &masterKey,
+		}
+		assert.NoError(CreateApplication(ts.Tx(), &app2))
+
+		appGet, err := GetApplication(ts.Tx(), app2.ID)
+		assert.NoError(err)
+		assert.Equal(&masterKey, appGet.MasterKey)
+
+		d := Device{
+			DevEUI:          lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app2.ID,
+			DeviceProfileID: dpID,
+			Name:            "test-device-master-key",
+		}
+		assert.NoError(CreateDevice(ts.Tx(), &d))
+		<-nsClient.CreateDeviceChan
+
+		expected, err := DeriveDeviceKeys(masterKey, d.DevEUI)
+		assert.NoError(err)
+
+		dk, err := GetDeviceKeys(ts.Tx(), d.DevEUI)
+		assert.NoError(err)
+		assert.Equal(expected.NwkKey, dk.NwkKey)
+		assert.Equal(expected.AppKey, dk.AppKey)
+
+		override := DeviceKeys{
+			DevEUI: d.DevEUI,
+			NwkKey: lorawan.AES128Key{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+		}
+		assert.NoError(CreateOrOverrideDerivedDeviceKeys(ts.Tx(), &override))
+
+		dk, err = GetDeviceKeys(ts.Tx(), d.DevEUI)
+		assert.NoError(err)
+		assert.Equal(override.NwkKey, dk.NwkKey)
+		assert.Equal(lorawan.AES128Key{}, dk.AppKey)
+
+		assert.Equal(ErrAlreadyExists, errors.Cause(CreateOrOverrideDerivedDeviceKeys(ts.Tx(), &override)))
+
+		assert.NoError(DeleteDevice(ts.Tx(), d.DevEUI))
+		<-nsClient.DeleteDeviceChan
+	})
+
 	ts.T().Run("Create", func(t *testing.T) {
 		assert := require.New(t)
 
@@ -158,6 +257,36 @@ func (ts *StorageTestSuite) TestDevice() {
 			assert.Equal(1, count)
 		})
 
+		t.Run("List by organization, device-profile, last-seen and battery", func(t *testing.T) {
+			assert := require.New(t)
+
+			now := time.Now()
+			eleven := float32(11)
+			ten := float32(10)
+
+			count, err := GetDeviceCount(ts.Tx(), DeviceFilters{
+				OrganizationID:  org.ID,
+				DeviceProfileID: dpID,
+				LastSeenBefore:  &now,
+				BatteryBelow:    &eleven,
+			})
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			count, err = GetDeviceCount(ts.Tx(), DeviceFilters{
+				OrganizationID: org.ID,
+				BatteryBelow:   &ten,
+			})
+			assert.NoError(err)
+			assert.Equal(0, count)
+
+			count, err = GetDeviceCount(ts.Tx(), DeviceFilters{
+				OrganizationID: org.ID + 1,
+			})
+			assert.NoError(err)
+			assert.Equal(0, count)
+		})
+
 		t.Run("Get", func(t *testing.T) {
 			nsClient.GetDeviceResponse = ns.GetDeviceResponse{
 				Device: createReq.Device,
@@ -219,6 +348,30 @@ func (ts *StorageTestSuite) TestDevice() {
 			assert.Equal(d, deviceGet)
 		})
 
+		t.Run("UpdateDeviceLifecycleState", func(t *testing.T) {
+			assert := require.New(t)
+
+			dev, err := GetDevice(ts.Tx(), d.DevEUI, false, true)
+			assert.NoError(err)
+			assert.Equal(DeviceProvisioned, dev.LifecycleState)
+
+			assert.NoError(UpdateDeviceLifecycleState(ts.Tx(), &dev, DeviceSuspended))
+			assert.Equal(ErrDeviceLifecycleTransition, UpdateDeviceLifecycleState(ts.Tx(), &dev, DeviceProvisioned))
+
+			devices, err := GetDevices(ts.Tx(), DeviceFilters{Limit: 10, LifecycleStates: []string{string(DeviceSuspended)}})
+			assert.NoError(err)
+			assert.Len(devices, 1)
+
+			count, err := GetDeviceCount(ts.Tx(), DeviceFilters{LifecycleStates: []string{string(DeviceActive), string(DeviceRetired)}})
+			assert.NoError(err)
+			assert.Equal(0, count)
+
+			assert.NoError(UpdateDeviceLifecycleState(ts.Tx(), &dev, DeviceActive))
+			dev, err = GetDevice(ts.Tx(), d.DevEUI, false, true)
+			assert.NoError(err)
+			assert.Equal(DeviceActive, dev.LifecycleState)
+		})
+
 		t.Run("CreateDeviceKeys", func(t *testing.T) {
 			assert := require.New(t)
 
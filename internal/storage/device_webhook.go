@@ -0,0 +1,255 @@
+package storage
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// DeviceWebhookEvent defines a device onboarding event.
+type DeviceWebhookEvent string
+
+// Device onboarding events.
+const (
+	DeviceWebhookCreated        DeviceWebhookEvent = "created"
+	DeviceWebhookActivated      DeviceWebhookEvent = "activated"
+	DeviceWebhookDecommissioned DeviceWebhookEvent = "decommissioned"
+)
+
+// Validate validates the device webhook event.
+func (e DeviceWebhookEvent) Validate() error {
+	switch e {
+	case DeviceWebhookCreated, DeviceWebhookActivated, DeviceWebhookDecommissioned:
+		return nil
+	default:
+		return ErrDeviceWebhookInvalidEvent
+	}
+}
+
+// DeviceWebhook defines an organization-level webhook which is called when
+// a device of the organization is created, activated (first join) or
+// decommissioned, e.g. to sync the devices to an external inventory system.
+type DeviceWebhook struct {
+	ID             int64         `db:"id"`
+	CreatedAt      time.Time     `db:"created_at"`
+	UpdatedAt      time.Time     `db:"updated_at"`
+	OrganizationID int64         `db:"organization_id"`
+	Name           string        `db:"name"`
+	URL            string        `db:"url"`
+	Headers        hstore.Hstore `db:"headers"`
+
+	// Secret is used to sign the request body (optional).
+	Secret string `db:"secret"`
+
+	// Events contains the events for which the webhook is called. When
+	// empty, the webhook is called for all events.
+	Events pq.StringArray `db:"events"`
+}
+
+// Validate validates the device webhook data.
+func (w DeviceWebhook) Validate() error {
+	if strings.TrimSpace(w.Name) == "" || len(w.Name) > 100 {
+		return ErrDeviceWebhookInvalidName
+	}
+
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(w.URL) > 500 {
+		return ErrDeviceWebhookInvalidURL
+	}
+
+	for _, e := range w.Events {
+		if err := DeviceWebhookEvent(e).Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateDeviceWebhook creates the given device webhook.
+func CreateDeviceWebhook(db sqlx.Queryer, w *DeviceWebhook) error {
+	if err := w.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	if w.Events == nil {
+		w.Events = pq.StringArray{}
+	}
+
+	now := time.Now()
+	w.CreatedAt = now
+	w.UpdatedAt = now
+
+	err := sqlx.Get(db, &w.ID, `
+		insert into device_webhook (
+			created_at,
+			updated_at,
+			organization_id,
+			name,
+			url,
+			secret,
+			headers,
+			events
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)
+		returning id`,
+		w.CreatedAt,
+		w.UpdatedAt,
+		w.OrganizationID,
+		w.Name,
+		w.URL,
+		w.Secret,
+		w.Headers,
+		w.Events,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":              w.ID,
+		"organization_id": w.OrganizationID,
+	}).Info("device webhook created")
+
+	return nil
+}
+
+// GetDeviceWebhook returns the device webhook for the given id.
+func GetDeviceWebhook(db sqlx.Queryer, id int64) (DeviceWebhook, error) {
+	var w DeviceWebhook
+	err := sqlx.Get(db, &w, "select * from device_webhook where id = $1", id)
+	if err != nil {
+		return w, handlePSQLError(Select, err, "select error")
+	}
+	return w, nil
+}
+
+// GetDeviceWebhookCount returns the total number of device webhooks for the
+// given organization id.
+func GetDeviceWebhookCount(db sqlx.Queryer, organizationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from device_webhook where organization_id = $1", organizationID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetDeviceWebhooks returns the device webhooks for the given organization
+// id, ordered by name.
+func GetDeviceWebhooks(db sqlx.Queryer, organizationID int64, limit, offset int) ([]DeviceWebhook, error) {
+	var webhooks []DeviceWebhook
+	err := sqlx.Select(db, &webhooks, `
+		select
+			*
+		from
+			device_webhook
+		where
+			organization_id = $1
+		order by
+			name
+		limit $2
+		offset $3`,
+		organizationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return webhooks, nil
+}
+
+// GetDeviceWebhooksForEvent returns the device webhooks of the given
+// organization id which must be called for the given event.
+func GetDeviceWebhooksForEvent(db sqlx.Queryer, organizationID int64, event DeviceWebhookEvent) ([]DeviceWebhook, error) {
+	var webhooks []DeviceWebhook
+	err := sqlx.Select(db, &webhooks, `
+		select
+			*
+		from
+			device_webhook
+		where
+			organization_id = $1
+			and (events = '{}' or $2 = any(events))
+		order by
+			id`,
+		organizationID,
+		string(event),
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return webhooks, nil
+}
+
+// UpdateDeviceWebhook updates the given device webhook.
+func UpdateDeviceWebhook(db sqlx.Execer, w *DeviceWebhook) error {
+	if err := w.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	if w.Events == nil {
+		w.Events = pq.StringArray{}
+	}
+
+	w.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update device_webhook
+		set
+			updated_at = $2,
+			name = $3,
+			url = $4,
+			secret = $5,
+			headers = $6,
+			events = $7
+		where
+			id = $1`,
+		w.ID,
+		w.UpdatedAt,
+		w.Name,
+		w.URL,
+		w.Secret,
+		w.Headers,
+		w.Events,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", w.ID).Info("device webhook updated")
+
+	return nil
+}
+
+// DeleteDeviceWebhook deletes the device webhook for the given id.
+func DeleteDeviceWebhook(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from device_webhook where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("device webhook deleted")
+
+	return nil
+}
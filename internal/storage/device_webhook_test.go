@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceWebhookValidate(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Webhook DeviceWebhook
+		Error   error
+	}{
+		{
+			Name:    "valid",
+			Webhook: DeviceWebhook{Name: "inventory", URL: "https://example.com/devices", Events: pq.StringArray{"created"}},
+		},
+		{
+			Name:    "empty name",
+			Webhook: DeviceWebhook{Name: " ", URL: "https://example.com/devices"},
+			Error:   ErrDeviceWebhookInvalidName,
+		},
+		{
+			Name:    "relative url",
+			Webhook: DeviceWebhook{Name: "inventory", URL: "/devices"},
+			Error:   ErrDeviceWebhookInvalidURL,
+		},
+		{
+			Name:    "invalid scheme",
+			Webhook: DeviceWebhook{Name: "inventory", URL: "ftp://example.com/devices"},
+			Error:   ErrDeviceWebhookInvalidURL,
+		},
+		{
+			Name:    "invalid event",
+			Webhook: DeviceWebhook{Name: "inventory", URL: "https://example.com/devices", Events: pq.StringArray{"updated"}},
+			Error:   ErrDeviceWebhookInvalidEvent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.Error, test.Webhook.Validate())
+		})
+	}
+}
+
+func (ts *StorageTestSuite) TestDeviceWebhook() {
+	assert := require.New(ts.T())
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	w := DeviceWebhook{
+		OrganizationID: org.ID,
+		Name:           "inventory",
+		URL:            "https://example.com/devices",
+		Secret:         "secret",
+		Headers: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"Authorization": {String: "Bearer token", Valid: true},
+			},
+		},
+		Events: pq.StringArray{string(DeviceWebhookActivated)},
+	}
+	assert.NoError(CreateDeviceWebhook(ts.Tx(), &w))
+
+	w2 := DeviceWebhook{
+		OrganizationID: org.ID,
+		Name:           "erp",
+		URL:            "https://example.com/erp",
+	}
+	assert.NoError(CreateDeviceWebhook(ts.Tx(), &w2))
+
+	ts.T().Run("Get", func(t *testing.T) {
+		assert := require.New(t)
+
+		ww, err := GetDeviceWebhook(ts.Tx(), w.ID)
+		assert.NoError(err)
+		assert.Equal(w.Name, ww.Name)
+		assert.Equal(w.URL, ww.URL)
+		assert.Equal(w.Secret, ww.Secret)
+		assert.Equal(w.Headers, ww.Headers)
+		assert.Equal(w.Events, ww.Events)
+	})
+
+	ts.T().Run("List", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := GetDeviceWebhookCount(ts.Tx(), org.ID)
+		assert.NoError(err)
+		assert.Equal(2, count)
+
+		webhooks, err := GetDeviceWebhooks(ts.Tx(), org.ID, 10, 0)
+		assert.NoError(err)
+		assert.Len(webhooks, 2)
+		assert.Equal(w2.ID, webhooks[0].ID)
+		assert.Equal(w.ID, webhooks[1].ID)
+	})
+
+	ts.T().Run("GetDeviceWebhooksForEvent", func(t *testing.T) {
+		assert := require.New(t)
+
+		webhooks, err := GetDeviceWebhooksForEvent(ts.Tx(), org.ID, DeviceWebhookActivated)
+		assert.NoError(err)
+		assert.Len(webhooks, 2)
+
+		webhooks, err = GetDeviceWebhooksForEvent(ts.Tx(), org.ID, DeviceWebhookCreated)
+		assert.NoError(err)
+		assert.Len(webhooks, 1)
+		assert.Equal(w2.ID, webhooks[0].ID)
+	})
+
+	ts.T().Run("Update", func(t *testing.T) {
+		assert := require.New(t)
+
+		w.Name = "asset-management"
+		w.Events = pq.StringArray{string(DeviceWebhookCreated), string(DeviceWebhookDecommissioned)}
+		assert.NoError(UpdateDeviceWebhook(ts.Tx(), &w))
+
+		ww, err := GetDeviceWebhook(ts.Tx(), w.ID)
+		assert.NoError(err)
+		assert.Equal("asset-management", ww.Name)
+		assert.Equal(w.Events, ww.Events)
+	})
+
+	ts.T().Run("Delete", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(DeleteDeviceWebhook(ts.Tx(), w.ID))
+		assert.Equal(ErrDoesNotExist, errors.Cause(DeleteDeviceWebhook(ts.Tx(), w.ID)))
+	})
+}
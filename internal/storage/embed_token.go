@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// EmbedTokenSubject defines the subject of the embed tokens.
+const EmbedTokenSubject = "embed"
+
+// Embed views define the (read-only) views that can be embedded.
+const (
+	EmbedViewDevice = "device" // device details, location track and link statistics
+	EmbedViewFrames = "frames" // live frame-logs
+)
+
+// Embed token TTL limits.
+const (
+	DefaultEmbedTokenTTL = 15 * time.Minute
+	MaxEmbedTokenTTL     = 24 * time.Hour
+)
+
+// CreateEmbedToken creates a signed token giving read-only access to the
+// given view of the given device. When ttl is 0, the DefaultEmbedTokenTTL is
+// used. It returns the token and its expiration time.
+func CreateEmbedToken(devEUI lorawan.EUI64, view string, ttl time.Duration) (string, time.Time, error) {
+	if view != EmbedViewDevice && view != EmbedViewFrames {
+		return "", time.Time{}, ErrEmbedTokenInvalidView
+	}
+
+	if ttl == 0 {
+		ttl = DefaultEmbedTokenTTL
+	}
+	if ttl < 0 || ttl > MaxEmbedTokenTTL {
+		return "", time.Time{}, ErrEmbedTokenInvalidTTL
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss":       "lora-app-server",
+		"aud":       "lora-app-server",
+		"nbf":       now.Unix(),
+		"exp":       expiresAt.Unix(),
+		"sub":       EmbedTokenSubject,
+		"devEUI":    devEUI.String(),
+		"embedView": view,
+	})
+
+	signed, err := token.SignedString(jwtsecret)
+	if err != nil {
+		return "", expiresAt, errors.Wrap(err, "get jwt signed string error")
+	}
+
+	return signed, expiresAt, nil
+}
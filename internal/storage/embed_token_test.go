@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestCreateEmbedToken(t *testing.T) {
+	jwtsecret = []byte("secret")
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	t.Run("Valid token", func(t *testing.T) {
+		assert := require.New(t)
+
+		token, expiresAt, err := CreateEmbedToken(devEUI, EmbedViewFrames, time.Minute)
+		assert.NoError(err)
+		assert.WithinDuration(time.Now().Add(time.Minute), expiresAt, time.Second)
+
+		claims := jwt.MapClaims{}
+		_, err = jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+			return jwtsecret, nil
+		})
+		assert.NoError(err)
+		assert.Equal(EmbedTokenSubject, claims["sub"])
+		assert.Equal("0102030405060708", claims["devEUI"])
+		assert.Equal(EmbedViewFrames, claims["embedView"])
+	})
+
+	t.Run("Default TTL", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, expiresAt, err := CreateEmbedToken(devEUI, EmbedViewDevice, 0)
+		assert.NoError(err)
+		assert.WithinDuration(time.Now().Add(DefaultEmbedTokenTTL), expiresAt, time.Second)
+	})
+
+	t.Run("Invalid view", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, _, err := CreateEmbedToken(devEUI, "keys", 0)
+		assert.Equal(ErrEmbedTokenInvalidView, err)
+	})
+
+	t.Run("Invalid TTL", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, _, err := CreateEmbedToken(devEUI, EmbedViewDevice, MaxEmbedTokenTTL+time.Second)
+		assert.Equal(ErrEmbedTokenInvalidTTL, err)
+	})
+}
@@ -36,6 +36,44 @@ var (
 	ErrInvalidEmail                    = errors.New("invalid e-mail")
 	ErrInvalidGatewayDiscoveryInterval = errors.New("invalid gateway-discovery interval, it must be greater than 0")
 	ErrDeviceProfileInvalidName        = errors.New("invalid device-profile name")
+	ErrInvalidCertFingerprint          = errors.New("invalid certificate fingerprint, it must be a HEX encoded (lowercase) SHA-256 hash")
+	ErrIntegrationInvalidApplication   = errors.New("application does not belong to the organization of the integration")
+	ErrInvalidTimeRange                = errors.New("the start timestamp must be before the end timestamp")
+	ErrServiceProfileOrganization      = errors.New("service-profile does not belong to the organization")
+	ErrNetworkServerMismatch           = errors.New("devices can not be moved to a service-profile using a different network-server")
+	ErrStorageClusterMismatch          = errors.New("devices can not be moved to an organization using a different storage cluster")
+	ErrStorageClusterDoesNotExist      = errors.New("storage cluster does not exist")
+	ErrDeviceKeyBatchInvalidBlock      = errors.New("invalid DevEUI block, the start must not be after the end")
+	ErrDeviceKeyBatchInvalidCount      = errors.New("invalid batch count, it must be between 1 and 10000")
+	ErrDevEUIBlockExhausted            = errors.New("the DevEUI block does not contain enough unused DevEUIs")
+	ErrDevEUIBlockInUse                = errors.New("the DevEUI block overlaps with a DevEUI block of an other organization")
+	ErrOrganizationHostInvalidHostname = errors.New("invalid hostname")
+	ErrDeviceFilterInvalidName         = errors.New("invalid device filter name")
+	ErrDeviceFilterInvalidBattery      = errors.New("invalid battery level, it must be between 0 and 100")
+	ErrDeviceFilterInvalidLastSeen     = errors.New("invalid last-seen duration, it must be greater than 0")
+	ErrDeviceFilterOrganization        = errors.New("the application and device-profile must belong to the organization of the device filter")
+	ErrDeviceLifecycleStateInvalid     = errors.New("invalid device lifecycle state")
+	ErrDeviceLifecycleTransition       = errors.New("device lifecycle state transition is not allowed")
+	ErrDeviceSuspended                 = errors.New("device is suspended")
+	ErrDeviceRetired                   = errors.New("device is retired and read-only")
+	ErrApplicationInvalidRedactField   = errors.New("invalid application redact field")
+	ErrApplicationInvalidHeartbeat     = errors.New("invalid application heartbeat interval, it must be 0 (disabled) or >= 1m")
+	ErrServiceProfileInvalidMetadata   = errors.New("invalid service-profile metadata redact field")
+	ErrCampaignInvalidName             = errors.New("invalid campaign name")
+	ErrCampaignInvalidCommand          = errors.New("invalid campaign command, the f_port and either the data or the json_object must be set")
+	ErrCampaignInvalidSchedule         = errors.New("invalid campaign schedule, the pacing interval must be >= 0, the retry interval > 0 and the max attempts >= 1")
+	ErrCampaignInvalidDeviceFilter     = errors.New("the device filter must belong to the organization of the application")
+	ErrScheduleJobInvalid              = errors.New("invalid schedule job, the type, cron expression and time zone must be set")
+	ErrDeviceWebhookInvalidName        = errors.New("invalid device webhook name")
+	ErrDeviceWebhookInvalidURL         = errors.New("invalid device webhook url, it must be an absolute http(s) url")
+	ErrDeviceWebhookInvalidEvent       = errors.New("invalid device webhook event")
+	ErrEmbedTokenInvalidView           = errors.New("invalid embed view")
+	ErrEmbedTokenInvalidTTL            = errors.New("invalid embed token ttl, it must be > 0 and <= 24h")
+	ErrDeviceConflictInvalidResolution = errors.New("invalid device conflict resolution")
+	ErrDeviceConflictNotPending        = errors.New("device conflict has already been resolved")
+	ErrDeviceConflictLinkLocal         = errors.New("device conflict can not be linked, the DevEUI is used by a device of this application-server")
+	ErrTermsVersionInvalid             = errors.New("invalid terms version, the version (max. 100 characters) and text must be set")
+	ErrOrganizationSharedDeviceProfile = errors.New("the organization has shared device-profiles which are used by devices of other organizations, copy or remove these devices first")
 )
 
 func handlePSQLError(action Action, err error, description string) error {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/lib/pq"
+	"github.com/lib/pq/hstore"
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
 	"github.com/brocaar/loraserver/api/ns"
@@ -37,6 +38,7 @@ type Gateway struct {
 	LastPingSentAt   *time.Time    `db:"last_ping_sent_at"`
 	NetworkServerID  int64         `db:"network_server_id"`
 	GatewayProfileID *string       `db:"gateway_profile_id"`
+	Tags             hstore.Hstore `db:"tags"`
 }
 
 // GatewayPing represents a gateway ping.
@@ -113,8 +115,9 @@ func CreateGateway(db sqlx.Execer, gw *Gateway) error {
 			last_ping_id,
 			last_ping_sent_at,
 			network_server_id,
-			gateway_profile_id
-		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+			gateway_profile_id,
+			tags
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
 		gw.MAC[:],
 		gw.CreatedAt,
 		gw.UpdatedAt,
@@ -126,6 +129,7 @@ func CreateGateway(db sqlx.Execer, gw *Gateway) error {
 		gw.LastPingSentAt,
 		gw.NetworkServerID,
 		gw.GatewayProfileID,
+		gw.Tags,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -156,7 +160,8 @@ func UpdateGateway(db sqlx.Execer, gw *Gateway) error {
 			last_ping_id = $7,
 			last_ping_sent_at = $8,
 			network_server_id = $9,
-			gateway_profile_id = $10
+			gateway_profile_id = $10,
+			tags = $11
 		where
 			mac = $1`,
 		gw.MAC[:],
@@ -169,6 +174,7 @@ func UpdateGateway(db sqlx.Execer, gw *Gateway) error {
 		gw.LastPingSentAt,
 		gw.NetworkServerID,
 		gw.GatewayProfileID,
+		gw.Tags,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -242,7 +248,9 @@ func GetGateway(db sqlx.Queryer, mac lorawan.EUI64, forUpdate bool) (Gateway, er
 }
 
 // GetGatewayCount returns the total number of gateways.
-func GetGatewayCount(db sqlx.Queryer, search string) (int, error) {
+// When tags is not empty, only the gateways having all the given tags
+// are returned.
+func GetGatewayCount(db sqlx.Queryer, search string, tags map[string]string) (int, error) {
 	var count int
 	if search != "" {
 		search = "%" + search + "%"
@@ -253,16 +261,20 @@ func GetGatewayCount(db sqlx.Queryer, search string) (int, error) {
 			count(*)
 		from gateway
 		where
-			$1 = ''
-			or (
-				$1 != ''
-				and (
-					name ilike $1
-					or encode(mac, 'hex') ilike $1
+			($2::hstore is null or tags @> $2::hstore)
+			and (
+				$1 = ''
+				or (
+					$1 != ''
+					and (
+						name ilike $1
+						or encode(mac, 'hex') ilike $1
+					)
 				)
 			)
 		`,
 		search,
+		tagsFilter(tags),
 	)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
@@ -271,7 +283,9 @@ func GetGatewayCount(db sqlx.Queryer, search string) (int, error) {
 }
 
 // GetGateways returns a slice of gateways sorted by name.
-func GetGateways(db sqlx.Queryer, limit, offset int, search string) ([]Gateway, error) {
+// When tags is not empty, only the gateways having all the given tags
+// are returned.
+func GetGateways(db sqlx.Queryer, limit, offset int, search string, tags map[string]string) ([]Gateway, error) {
 	var gws []Gateway
 	if search != "" {
 		search = "%" + search + "%"
@@ -282,12 +296,15 @@ func GetGateways(db sqlx.Queryer, limit, offset int, search string) ([]Gateway,
 			*
 		from gateway
 		where
-			$3 = ''
-			or (
-				$3 != ''
-				and (
-					name ilike $3
-					or encode(mac, 'hex') ilike $3
+			($4::hstore is null or tags @> $4::hstore)
+			and (
+				$3 = ''
+				or (
+					$3 != ''
+					and (
+						name ilike $3
+						or encode(mac, 'hex') ilike $3
+					)
 				)
 			)
 		order by
@@ -296,6 +313,7 @@ func GetGateways(db sqlx.Queryer, limit, offset int, search string) ([]Gateway,
 		limit,
 		offset,
 		search,
+		tagsFilter(tags),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -333,7 +351,9 @@ func GetGatewaysForMACs(db sqlx.Queryer, macs []lorawan.EUI64) (map[lorawan.EUI6
 
 // GetGatewayCountForOrganizationID returns the total number of gateways
 // given an organization ID.
-func GetGatewayCountForOrganizationID(db sqlx.Queryer, organizationID int64, search string) (int, error) {
+// When tags is not empty, only the gateways having all the given tags
+// are returned.
+func GetGatewayCountForOrganizationID(db sqlx.Queryer, organizationID int64, search string, tags map[string]string) (int, error) {
 	var count int
 	if search != "" {
 		search = "%" + search + "%"
@@ -345,6 +365,7 @@ func GetGatewayCountForOrganizationID(db sqlx.Queryer, organizationID int64, sea
 		from gateway
 		where
 			organization_id = $1
+			and ($3::hstore is null or tags @> $3::hstore)
 			and (
 				$2 = ''
 				or (
@@ -357,6 +378,7 @@ func GetGatewayCountForOrganizationID(db sqlx.Queryer, organizationID int64, sea
 			)`,
 		organizationID,
 		search,
+		tagsFilter(tags),
 	)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
@@ -366,7 +388,9 @@ func GetGatewayCountForOrganizationID(db sqlx.Queryer, organizationID int64, sea
 
 // GetGatewaysForOrganizationID returns a slice of gateways sorted by name
 // for the given organization ID.
-func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int, search string) ([]Gateway, error) {
+// When tags is not empty, only the gateways having all the given tags
+// are returned.
+func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int, search string, tags map[string]string) ([]Gateway, error) {
 	var gws []Gateway
 	if search != "" {
 		search = "%" + search + "%"
@@ -378,6 +402,7 @@ func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit,
 		from gateway
 		where
 			organization_id = $1
+			and ($5::hstore is null or tags @> $5::hstore)
 			and (
 				$4 = ''
 				or (
@@ -395,6 +420,7 @@ func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit,
 		limit,
 		offset,
 		search,
+		tagsFilter(tags),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -404,7 +430,9 @@ func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit,
 
 // GetGatewayCountForUser returns the total number of gateways to which the
 // given user has access.
-func GetGatewayCountForUser(db sqlx.Queryer, username string, search string) (int, error) {
+// When tags is not empty, only the gateways having all the given tags
+// are returned.
+func GetGatewayCountForUser(db sqlx.Queryer, username string, search string, tags map[string]string) (int, error) {
 	var count int
 	if search != "" {
 		search = "%" + search + "%"
@@ -422,6 +450,7 @@ func GetGatewayCountForUser(db sqlx.Queryer, username string, search string) (in
 			on u.id = ou.user_id
 		where
 			u.username = $1
+			and ($3::hstore is null or g.tags @> $3::hstore)
 			and (
 				$2 = ''
 				or (
@@ -434,6 +463,7 @@ func GetGatewayCountForUser(db sqlx.Queryer, username string, search string) (in
 			)`,
 		username,
 		search,
+		tagsFilter(tags),
 	)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
@@ -443,7 +473,9 @@ func GetGatewayCountForUser(db sqlx.Queryer, username string, search string) (in
 
 // GetGatewaysForUser returns a slice of gateways sorted by name to which the
 // given user has access.
-func GetGatewaysForUser(db sqlx.Queryer, username string, limit, offset int, search string) ([]Gateway, error) {
+// When tags is not empty, only the gateways having all the given tags
+// are returned.
+func GetGatewaysForUser(db sqlx.Queryer, username string, limit, offset int, search string, tags map[string]string) ([]Gateway, error) {
 	var gws []Gateway
 	if search != "" {
 		search = "%" + search + "%"
@@ -461,6 +493,7 @@ func GetGatewaysForUser(db sqlx.Queryer, username string, limit, offset int, sea
 			on u.id = ou.user_id
 		where
 			u.username = $1
+			and ($5::hstore is null or g.tags @> $5::hstore)
 			and (
 				$4 = ''
 				or (
@@ -478,6 +511,7 @@ func GetGatewaysForUser(db sqlx.Queryer, username string, limit, offset int, sea
 		limit,
 		offset,
 		search,
+		tagsFilter(tags),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -615,3 +649,18 @@ func GetLastGatewayPingAndRX(db sqlx.Queryer, mac lorawan.EUI64) (GatewayPing, [
 
 	return ping, rx, nil
 }
+
+// tagsFilter returns the hstore value to filter on the given tags.
+// In case no tags are given, the returned value is NULL (no filter).
+func tagsFilter(tags map[string]string) hstore.Hstore {
+	var h hstore.Hstore
+	if len(tags) == 0 {
+		return h
+	}
+
+	h.Map = make(map[string]sql.NullString)
+	for k, v := range tags {
+		h.Map[k] = sql.NullString{String: v, Valid: true}
+	}
+	return h
+}
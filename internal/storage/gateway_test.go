@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/lorawan"
+	"github.com/lib/pq/hstore"
 	"github.com/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -56,6 +58,12 @@ func TestGateway(t *testing.T) {
 				OrganizationID:  org.ID,
 				Ping:            true,
 				NetworkServerID: n.ID,
+				Tags: hstore.Hstore{
+					Map: map[string]sql.NullString{
+						"site":  {String: "tower-1", Valid: true},
+						"owner": {String: "acme", Valid: true},
+					},
+				},
 			}
 			So(CreateGateway(DB(), &gw), ShouldBeNil)
 			gw.CreatedAt = gw.CreatedAt.Truncate(time.Millisecond).UTC()
@@ -91,26 +99,44 @@ func TestGateway(t *testing.T) {
 			})
 
 			Convey("Then getting the total gateway count returns 1", func() {
-				c, err := GetGatewayCount(DB(), "")
+				c, err := GetGatewayCount(DB(), "", nil)
 				So(err, ShouldBeNil)
 				So(c, ShouldEqual, 1)
 			})
 
 			Convey("Then getting all gateways returns the expected gateway", func() {
-				gws, err := GetGateways(DB(), 10, 0, "")
+				gws, err := GetGateways(DB(), 10, 0, "", nil)
 				So(err, ShouldBeNil)
 				So(gws, ShouldHaveLength, 1)
 				So(gws[0].MAC, ShouldEqual, gw.MAC)
 			})
 
+			Convey("Then the gateways can be filtered by tags", func() {
+				c, err := GetGatewayCount(DB(), "", map[string]string{"site": "tower-1"})
+				So(err, ShouldBeNil)
+				So(c, ShouldEqual, 1)
+
+				gws, err := GetGateways(DB(), 10, 0, "", map[string]string{"site": "tower-1", "owner": "acme"})
+				So(err, ShouldBeNil)
+				So(gws, ShouldHaveLength, 1)
+
+				c, err = GetGatewayCountForOrganizationID(DB(), org.ID, "", map[string]string{"site": "tower-2"})
+				So(err, ShouldBeNil)
+				So(c, ShouldEqual, 0)
+
+				gws, err = GetGatewaysForOrganizationID(DB(), org.ID, 10, 0, "", map[string]string{"antenna": "omni"})
+				So(err, ShouldBeNil)
+				So(gws, ShouldHaveLength, 0)
+			})
+
 			Convey("Then getting the total gateway count for the organization returns 1", func() {
-				c, err := GetGatewayCountForOrganizationID(DB(), org.ID, "")
+				c, err := GetGatewayCountForOrganizationID(DB(), org.ID, "", nil)
 				So(err, ShouldBeNil)
 				So(c, ShouldEqual, 1)
 			})
 
 			Convey("Then getting all gateways for the organization returns the exepected gateway", func() {
-				gws, err := GetGatewaysForOrganizationID(DB(), org.ID, 10, 0, "")
+				gws, err := GetGatewaysForOrganizationID(DB(), org.ID, 10, 0, "", nil)
 				So(err, ShouldBeNil)
 				So(gws, ShouldHaveLength, 1)
 				So(gws[0].MAC, ShouldEqual, gw.MAC)
@@ -126,13 +152,13 @@ func TestGateway(t *testing.T) {
 				So(err, ShouldBeNil)
 
 				Convey("Getting the gateway count for this user returns 0", func() {
-					c, err := GetGatewayCountForUser(DB(), user.Username, "")
+					c, err := GetGatewayCountForUser(DB(), user.Username, "", nil)
 					So(err, ShouldBeNil)
 					So(c, ShouldEqual, 0)
 				})
 
 				Convey("Then getting the gateways for this user returns 0 items", func() {
-					gws, err := GetGatewaysForUser(DB(), user.Username, 10, 0, "")
+					gws, err := GetGatewaysForUser(DB(), user.Username, 10, 0, "", nil)
 					So(err, ShouldBeNil)
 					So(gws, ShouldHaveLength, 0)
 				})
@@ -141,13 +167,13 @@ func TestGateway(t *testing.T) {
 					So(CreateOrganizationUser(DB(), org.ID, user.ID, false), ShouldBeNil)
 
 					Convey("Getting the gateway count for this user returns 1", func() {
-						c, err := GetGatewayCountForUser(DB(), user.Username, "")
+						c, err := GetGatewayCountForUser(DB(), user.Username, "", nil)
 						So(err, ShouldBeNil)
 						So(c, ShouldEqual, 1)
 					})
 
 					Convey("Then getting the gateways for this user returns 1 item", func() {
-						gws, err := GetGatewaysForUser(DB(), user.Username, 10, 0, "")
+						gws, err := GetGatewaysForUser(DB(), user.Username, 10, 0, "", nil)
 						So(err, ShouldBeNil)
 						So(gws, ShouldHaveLength, 1)
 						So(gws[0].MAC, ShouldEqual, gw.MAC)
@@ -0,0 +1,184 @@
+package storage
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// IntegrationEvent defines an integration event stored in the event journal,
+// waiting to be published (or already published) to the integrations.
+type IntegrationEvent struct {
+	ID          int64           `db:"id"`
+	CreatedAt   time.Time       `db:"created_at"`
+	PublishedAt *time.Time      `db:"published_at"`
+	Type        string          `db:"type"`
+	Payload     json.RawMessage `db:"payload"`
+	Attempts    int             `db:"attempts"`
+	LastError   string          `db:"last_error"`
+
+	// AckedBy contains the IDs of the integrations which acknowledged
+	// the event.
+	AckedBy pq.StringArray `db:"acked_by"`
+
+	// LockedUntil is set while the event is being published by a
+	// dispatcher.
+	LockedUntil *time.Time `db:"locked_until"`
+
+	// FailedAt is set when the event could not be published within the
+	// max number of attempts. Failed events are no longer dispatched.
+	FailedAt *time.Time `db:"failed_at"`
+}
+
+// CreateIntegrationEvent creates the given integration event.
+// When db is a transaction, the event will only become visible for
+// publishing once the transaction has been committed.
+func CreateIntegrationEvent(db sqlx.Queryer, e *IntegrationEvent) error {
+	e.CreatedAt = time.Now()
+
+	err := sqlx.Get(db, &e.ID, `
+		insert into integration_event (
+			created_at,
+			type,
+			payload
+		) values ($1, $2, $3)
+		returning id`,
+		e.CreatedAt,
+		e.Type,
+		e.Payload,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":   e.ID,
+		"type": e.Type,
+	}).Debug("integration event created")
+
+	return nil
+}
+
+// LockPendingIntegrationEvents returns the oldest integration events which
+// have not yet been published or failed, in order of creation. The returned
+// events are locked for the given duration so that the events can be
+// published outside a database transaction without being dispatched twice.
+// Events which are locked by an other dispatcher are skipped.
+func LockPendingIntegrationEvents(db sqlx.Queryer, limit int, lockDuration time.Duration) ([]IntegrationEvent, error) {
+	var events []IntegrationEvent
+	err := sqlx.Select(db, &events, `
+		update integration_event
+		set
+			locked_until = $2
+		where
+			id in (
+				select
+					id
+				from
+					integration_event
+				where
+					published_at is null
+					and failed_at is null
+					and (locked_until is null or locked_until < now())
+				order by
+					id
+				limit $1
+				for update skip locked
+			)
+		returning *`,
+		limit,
+		time.Now().Add(lockDuration),
+	)
+	if err != nil {
+		return nil, handlePSQLError(Update, err, "update error")
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+
+	return events, nil
+}
+
+// GetPendingIntegrationEventCount returns the number of integration events
+// which have not yet been published or failed.
+func GetPendingIntegrationEventCount(db sqlx.Queryer) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from integration_event where published_at is null and failed_at is null")
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetFailedIntegrationEventCount returns the number of integration events
+// which could not be published within the max number of attempts.
+func GetFailedIntegrationEventCount(db sqlx.Queryer) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from integration_event where failed_at is not null")
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// UpdateIntegrationEvent updates the publish state of the given
+// integration event and releases its lock.
+func UpdateIntegrationEvent(db sqlx.Execer, e *IntegrationEvent) error {
+	res, err := db.Exec(`
+		update integration_event
+		set
+			published_at = $2,
+			attempts = $3,
+			acked_by = $4,
+			last_error = $5,
+			failed_at = $6,
+			locked_until = null
+		where
+			id = $1`,
+		e.ID,
+		e.PublishedAt,
+		e.Attempts,
+		e.AckedBy,
+		e.LastError,
+		e.FailedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
+
+// DeletePublishedIntegrationEventsBefore deletes the integration events
+// which were published before the given timestamp. It returns the number
+// of deleted events.
+func DeletePublishedIntegrationEventsBefore(db sqlx.Execer, before time.Time) (int64, error) {
+	res, err := db.Exec(`
+		delete from integration_event
+		where
+			published_at < $1`,
+		before,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "get rows affected error")
+	}
+
+	return ra, nil
+}
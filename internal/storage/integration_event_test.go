@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func (ts *StorageTestSuite) TestIntegrationEvent() {
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		events := []IntegrationEvent{
+			{Type: "up", Payload: json.RawMessage(`{"fCnt": 1}`)},
+			{Type: "up", Payload: json.RawMessage(`{"fCnt": 2}`)},
+		}
+		for i := range events {
+			assert.NoError(CreateIntegrationEvent(ts.Tx(), &events[i]))
+		}
+
+		t.Run("LockPending", func(t *testing.T) {
+			assert := require.New(t)
+
+			pending, err := LockPendingIntegrationEvents(ts.Tx(), 1, time.Minute)
+			assert.NoError(err)
+			assert.Len(pending, 1)
+			assert.Equal(events[0].ID, pending[0].ID)
+			assert.Equal("up", pending[0].Type)
+			assert.JSONEq(`{"fCnt": 1}`, string(pending[0].Payload))
+			assert.NotNil(pending[0].LockedUntil)
+
+			// locked events are skipped
+			pending, err = LockPendingIntegrationEvents(ts.Tx(), 10, time.Minute)
+			assert.NoError(err)
+			assert.Len(pending, 1)
+			assert.Equal(events[1].ID, pending[0].ID)
+
+			pending, err = LockPendingIntegrationEvents(ts.Tx(), 10, time.Minute)
+			assert.NoError(err)
+			assert.Len(pending, 0)
+
+			count, err := GetPendingIntegrationEventCount(ts.Tx())
+			assert.NoError(err)
+			assert.Equal(2, count)
+		})
+
+		t.Run("Update error", func(t *testing.T) {
+			assert := require.New(t)
+
+			events[0].Attempts = 1
+			events[0].AckedBy = []string{"mqtt"}
+			events[0].LastError = "boom"
+			assert.NoError(UpdateIntegrationEvent(ts.Tx(), &events[0]))
+
+			// the update releases the lock, the lock returned this time
+			// is already expired
+			pending, err := LockPendingIntegrationEvents(ts.Tx(), 10, -time.Minute)
+			assert.NoError(err)
+			assert.Len(pending, 1)
+			assert.Equal(events[0].ID, pending[0].ID)
+			assert.Equal(1, pending[0].Attempts)
+			assert.EqualValues([]string{"mqtt"}, pending[0].AckedBy)
+			assert.Equal("boom", pending[0].LastError)
+		})
+
+		t.Run("Update failed", func(t *testing.T) {
+			assert := require.New(t)
+
+			failedAt := time.Now()
+			events[1].FailedAt = &failedAt
+			assert.NoError(UpdateIntegrationEvent(ts.Tx(), &events[1]))
+
+			pending, err := LockPendingIntegrationEvents(ts.Tx(), 10, time.Minute)
+			assert.NoError(err)
+			assert.Len(pending, 1)
+			assert.Equal(events[0].ID, pending[0].ID)
+
+			count, err := GetFailedIntegrationEventCount(ts.Tx())
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			count, err = GetPendingIntegrationEventCount(ts.Tx())
+			assert.NoError(err)
+			assert.Equal(1, count)
+		})
+
+		t.Run("Update published", func(t *testing.T) {
+			assert := require.New(t)
+
+			publishedAt := time.Now().Add(-time.Hour)
+			events[0].PublishedAt = &publishedAt
+			events[0].LastError = ""
+			assert.NoError(UpdateIntegrationEvent(ts.Tx(), &events[0]))
+
+			pending, err := LockPendingIntegrationEvents(ts.Tx(), 10, time.Minute)
+			assert.NoError(err)
+			assert.Len(pending, 0)
+
+			count, err := GetPendingIntegrationEventCount(ts.Tx())
+			assert.NoError(err)
+			assert.Equal(0, count)
+
+			t.Run("Delete published", func(t *testing.T) {
+				assert := require.New(t)
+
+				count, err := DeletePublishedIntegrationEventsBefore(ts.Tx(), time.Now().Add(-2*time.Hour))
+				assert.NoError(err)
+				assert.EqualValues(0, count)
+
+				count, err = DeletePublishedIntegrationEventsBefore(ts.Tx(), time.Now())
+				assert.NoError(err)
+				assert.EqualValues(1, count)
+			})
+		})
+	})
+}
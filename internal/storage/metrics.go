@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const packagePrefix = "github.com/brocaar/lora-app-server/internal/"
+
+// QueryDurationBuckets contains the upper bounds of the query duration
+// histogram buckets. The histogram has an additional bucket for the queries
+// exceeding the last bound.
+var QueryDurationBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+}
+
+// QueryStats contains the query statistics of a storage function, since the
+// instance was started.
+type QueryStats struct {
+	// Function executing the queries (e.g. storage.GetDevice).
+	Function string
+
+	Count         int
+	SlowCount     int
+	TotalDuration time.Duration
+	MaxDuration   time.Duration
+
+	// Buckets contains the number of queries per QueryDurationBuckets
+	// bucket, the last item contains the number of queries exceeding the
+	// last bound.
+	Buckets []int
+}
+
+// MeanDuration returns the mean query duration.
+func (s QueryStats) MeanDuration() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.TotalDuration / time.Duration(s.Count)
+}
+
+var (
+	queryStatsMux      sync.Mutex
+	queryStats         = make(map[string]*QueryStats)
+	slowQueryThreshold time.Duration
+)
+
+// observeQuery adds the given query duration to the statistics of the
+// storage function executing the query and logs the query when it exceeds
+// the slow-query threshold. The arguments of slow queries are not logged, as
+// these could contain sensitive data.
+func observeQuery(query string, duration time.Duration, argCount int) {
+	function := queryCaller()
+
+	queryStatsMux.Lock()
+	s, ok := queryStats[function]
+	if !ok {
+		s = &QueryStats{
+			Function: function,
+			Buckets:  make([]int, len(QueryDurationBuckets)+1),
+		}
+		queryStats[function] = s
+	}
+
+	i := sort.Search(len(QueryDurationBuckets), func(i int) bool {
+		return duration <= QueryDurationBuckets[i]
+	})
+	s.Buckets[i]++
+	s.Count++
+	s.TotalDuration += duration
+	if duration > s.MaxDuration {
+		s.MaxDuration = duration
+	}
+
+	slow := slowQueryThreshold > 0 && duration >= slowQueryThreshold
+	if slow {
+		s.SlowCount++
+	}
+	queryStatsMux.Unlock()
+
+	if slow {
+		log.WithFields(log.Fields{
+			"function":  function,
+			"query":     strings.Join(strings.Fields(query), " "),
+			"arg_count": argCount,
+			"duration":  duration,
+		}).Warning("storage: slow sql query")
+	}
+}
+
+// queryCaller returns the name of the function executing the query, skipping
+// the frames of the query wrappers and of sqlx.
+func queryCaller() string {
+	pc := make([]uintptr, 16)
+	n := runtime.Callers(3, pc)
+	frames := runtime.CallersFrames(pc[:n])
+
+	for {
+		frame, more := frames.Next()
+		name := frame.Function
+
+		if !strings.HasPrefix(name, "github.com/jmoiron/sqlx") &&
+			!strings.HasPrefix(name, "database/sql") &&
+			!strings.HasPrefix(name, packagePrefix+"storage.(*DBLogger)") &&
+			!strings.HasPrefix(name, packagePrefix+"storage.(*TxLogger)") &&
+			!strings.HasPrefix(name, packagePrefix+"storage.logQuery") {
+			return strings.TrimPrefix(name, packagePrefix)
+		}
+
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+// GetSlowestQueryStats returns the query statistics of the (max.) n storage
+// functions with the highest max. query duration.
+func GetSlowestQueryStats(n int) []QueryStats {
+	queryStatsMux.Lock()
+	out := make([]QueryStats, 0, len(queryStats))
+	for _, s := range queryStats {
+		item := *s
+		item.Buckets = append([]int(nil), s.Buckets...)
+		out = append(out, item)
+	}
+	queryStatsMux.Unlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].MaxDuration == out[j].MaxDuration {
+			return out[i].Function < out[j].Function
+		}
+		return out[i].MaxDuration > out[j].MaxDuration
+	})
+
+	if n > 0 && len(out) > n {
+		out = out[:n]
+	}
+
+	return out
+}
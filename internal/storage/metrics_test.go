@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func observeTestQuery(duration time.Duration) {
+	logQuery("select 1", duration, "secret")
+}
+
+func TestQueryStats(t *testing.T) {
+	assert := require.New(t)
+
+	queryStatsMux.Lock()
+	queryStats = make(map[string]*QueryStats)
+	queryStatsMux.Unlock()
+
+	slowQueryThreshold = time.Second
+	defer func() {
+		slowQueryThreshold = 0
+	}()
+
+	observeTestQuery(2 * time.Millisecond)
+	observeTestQuery(4 * time.Millisecond)
+	observeTestQuery(2 * time.Second)
+	logQuery("select 2", 20*time.Millisecond)
+
+	stats := GetSlowestQueryStats(10)
+	assert.Len(stats, 2)
+
+	assert.Equal("storage.observeTestQuery", stats[0].Function)
+	assert.Equal(3, stats[0].Count)
+	assert.Equal(1, stats[0].SlowCount)
+	assert.Equal(2*time.Second, stats[0].MaxDuration)
+	assert.Equal((2*time.Second+6*time.Millisecond)/3, stats[0].MeanDuration())
+	assert.Equal([]int{0, 2, 0, 0, 0, 0, 0, 1, 0}, stats[0].Buckets)
+
+	assert.Equal("storage.TestQueryStats", stats[1].Function)
+	assert.Equal(1, stats[1].Count)
+	assert.Equal(0, stats[1].SlowCount)
+
+	assert.Len(GetSlowestQueryStats(1), 1)
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"regexp"
 	"time"
 
 	"github.com/brocaar/lorawan"
@@ -12,10 +13,13 @@ import (
 	"github.com/brocaar/loraserver/api/ns"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
 
+var certFingerprintRegexp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
 // NetworkServer defines the information to connect to a network-server.
 type NetworkServer struct {
 	ID                          int64     `db:"id"`
@@ -33,6 +37,11 @@ type NetworkServer struct {
 	GatewayDiscoveryInterval    int       `db:"gateway_discovery_interval"`
 	GatewayDiscoveryTXFrequency int       `db:"gateway_discovery_tx_frequency"`
 	GatewayDiscoveryDR          int       `db:"gateway_discovery_dr"`
+
+	// ClientCertFingerprints contains the (HEX encoded) SHA-256 fingerprints
+	// of the client certificates that are allowed to connect to the
+	// application-server API on behalf of this network-server.
+	ClientCertFingerprints pq.StringArray `db:"client_cert_fingerprints"`
 }
 
 // Validate validates the network-server data.
@@ -40,6 +49,13 @@ func (ns NetworkServer) Validate() error {
 	if ns.GatewayDiscoveryEnabled && ns.GatewayDiscoveryInterval <= 0 {
 		return ErrInvalidGatewayDiscoveryInterval
 	}
+
+	for _, fp := range ns.ClientCertFingerprints {
+		if !certFingerprintRegexp.MatchString(fp) {
+			return ErrInvalidCertFingerprint
+		}
+	}
+
 	return nil
 }
 
@@ -68,8 +84,9 @@ func CreateNetworkServer(db sqlx.Queryer, n *NetworkServer) error {
 			gateway_discovery_enabled,
 			gateway_discovery_interval,
 			gateway_discovery_tx_frequency,
-			gateway_discovery_dr
-		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			gateway_discovery_dr,
+			client_cert_fingerprints
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 		returning id`,
 		n.CreatedAt,
 		n.UpdatedAt,
@@ -85,6 +102,7 @@ func CreateNetworkServer(db sqlx.Queryer, n *NetworkServer) error {
 		n.GatewayDiscoveryInterval,
 		n.GatewayDiscoveryTXFrequency,
 		n.GatewayDiscoveryDR,
+		n.ClientCertFingerprints,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -156,7 +174,8 @@ func UpdateNetworkServer(db sqlx.Execer, n *NetworkServer) error {
 			gateway_discovery_enabled = $11,
 			gateway_discovery_interval = $12,
 			gateway_discovery_tx_frequency = $13,
-			gateway_discovery_dr = $14
+			gateway_discovery_dr = $14,
+			client_cert_fingerprints = $15
 		where id = $1`,
 		n.ID,
 		n.UpdatedAt,
@@ -172,6 +191,7 @@ func UpdateNetworkServer(db sqlx.Execer, n *NetworkServer) error {
 		n.GatewayDiscoveryInterval,
 		n.GatewayDiscoveryTXFrequency,
 		n.GatewayDiscoveryDR,
+		n.ClientCertFingerprints,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
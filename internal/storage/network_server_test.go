@@ -40,6 +40,18 @@ func TestNetworkServer(t *testing.T) {
 				},
 				ExpectedError: ErrInvalidGatewayDiscoveryInterval,
 			},
+			{
+				NetworkServer: NetworkServer{
+					ClientCertFingerprints: []string{"0f1e2d3c4b5a69780f1e2d3c4b5a69780f1e2d3c4b5a69780f1e2d3c4b5a6978"},
+				},
+				ExpectedError: nil,
+			},
+			{
+				NetworkServer: NetworkServer{
+					ClientCertFingerprints: []string{"0F1E2D3C"},
+				},
+				ExpectedError: ErrInvalidCertFingerprint,
+			},
 		}
 
 		for i, test := range testTable {
@@ -160,6 +172,7 @@ func TestNetworkServer(t *testing.T) {
 				n.GatewayDiscoveryInterval = 1
 				n.GatewayDiscoveryTXFrequency = 868300000
 				n.GatewayDiscoveryDR = 4
+				n.ClientCertFingerprints = []string{"0f1e2d3c4b5a69780f1e2d3c4b5a69780f1e2d3c4b5a69780f1e2d3c4b5a6978"}
 				So(UpdateNetworkServer(db, &n), ShouldBeNil)
 				So(nsClient.UpdateRoutingProfileChan, ShouldHaveLength, 1)
 				So(<-nsClient.UpdateRoutingProfileChan, ShouldResemble, ns.UpdateRoutingProfileRequest{
@@ -19,6 +19,7 @@ type Organization struct {
 	Name            string    `db:"name"`
 	DisplayName     string    `db:"display_name"`
 	CanHaveGateways bool      `db:"can_have_gateways"`
+	StorageCluster  string    `db:"storage_cluster"`
 }
 
 // Validate validates the data of the Organization.
@@ -237,8 +238,32 @@ func UpdateOrganization(db sqlx.Execer, org *Organization) error {
 }
 
 // DeleteOrganization deletes the organization matching the given id.
+// ErrOrganizationSharedDeviceProfile is returned when a shared device-profile
+// of the organization is still used by devices of other organizations.
 func DeleteOrganization(db sqlx.Ext, id int64) error {
-	err := DeleteAllApplicationsForOrganizationID(db, id)
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from
+			device d
+		inner join device_profile dp
+			on dp.device_profile_id = d.device_profile_id
+		inner join application a
+			on a.id = d.application_id
+		where
+			dp.organization_id = $1
+			and a.organization_id != $1`,
+		id,
+	)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+	if count != 0 {
+		return ErrOrganizationSharedDeviceProfile
+	}
+
+	err = DeleteAllApplicationsForOrganizationID(db, id)
 	if err != nil {
 		return errors.Wrap(err, "delete all applications error")
 	}
@@ -0,0 +1,186 @@
+package storage
+
+import (
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var organizationHostnameRegexp = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)*[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// OrganizationHost defines a hostname mapped to an organization, e.g. for
+// white-label deployments. The API calls made through this hostname are
+// scoped to the organization.
+type OrganizationHost struct {
+	Hostname             string    `db:"hostname"`
+	CreatedAt            time.Time `db:"created_at"`
+	UpdatedAt            time.Time `db:"updated_at"`
+	OrganizationID       int64     `db:"organization_id"`
+	BrandingLogo         string    `db:"branding_logo"`
+	BrandingRegistration string    `db:"branding_registration"`
+	BrandingFooter       string    `db:"branding_footer"`
+}
+
+// Validate validates the organization host data.
+func (h OrganizationHost) Validate() error {
+	if len(h.Hostname) > 253 || !organizationHostnameRegexp.MatchString(h.Hostname) {
+		return ErrOrganizationHostInvalidHostname
+	}
+	return nil
+}
+
+// NormalizeHostname returns the given host (which may contain a port) as
+// lowercase hostname without port.
+func NormalizeHostname(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
+
+// CreateOrganizationHost creates the given organization host.
+func CreateOrganizationHost(db sqlx.Execer, h *OrganizationHost) error {
+	h.Hostname = NormalizeHostname(h.Hostname)
+	if err := h.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	h.CreatedAt = now
+	h.UpdatedAt = now
+
+	_, err := db.Exec(`
+		insert into organization_host (
+			hostname,
+			created_at,
+			updated_at,
+			organization_id,
+			branding_logo,
+			branding_registration,
+			branding_footer
+		) values ($1, $2, $3, $4, $5, $6, $7)`,
+		h.Hostname,
+		h.CreatedAt,
+		h.UpdatedAt,
+		h.OrganizationID,
+		h.BrandingLogo,
+		h.BrandingRegistration,
+		h.BrandingFooter,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"hostname":        h.Hostname,
+		"organization_id": h.OrganizationID,
+	}).Info("organization host created")
+
+	return nil
+}
+
+// GetOrganizationHost returns the organization host for the given hostname.
+func GetOrganizationHost(db sqlx.Queryer, hostname string) (OrganizationHost, error) {
+	var h OrganizationHost
+	err := sqlx.Get(db, &h, `
+		select
+			*
+		from
+			organization_host
+		where
+			hostname = $1`,
+		NormalizeHostname(hostname),
+	)
+	if err != nil {
+		return h, handlePSQLError(Select, err, "select error")
+	}
+
+	return h, nil
+}
+
+// GetOrganizationHostsForOrganizationID returns the hosts of the given
+// organization.
+func GetOrganizationHostsForOrganizationID(db sqlx.Queryer, organizationID int64) ([]OrganizationHost, error) {
+	var hosts []OrganizationHost
+	err := sqlx.Select(db, &hosts, `
+		select
+			*
+		from
+			organization_host
+		where
+			organization_id = $1
+		order by
+			hostname`,
+		organizationID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return hosts, nil
+}
+
+// UpdateOrganizationHost updates the given organization host.
+func UpdateOrganizationHost(db sqlx.Execer, h *OrganizationHost) error {
+	h.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update organization_host
+		set
+			updated_at = $2,
+			branding_logo = $3,
+			branding_registration = $4,
+			branding_footer = $5
+		where
+			hostname = $1`,
+		NormalizeHostname(h.Hostname),
+		h.UpdatedAt,
+		h.BrandingLogo,
+		h.BrandingRegistration,
+		h.BrandingFooter,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("hostname", h.Hostname).Info("organization host updated")
+
+	return nil
+}
+
+// DeleteOrganizationHost deletes the organization host for the given
+// hostname.
+func DeleteOrganizationHost(db sqlx.Execer, hostname string) error {
+	res, err := db.Exec(`
+		delete from organization_host
+		where
+			hostname = $1`,
+		NormalizeHostname(hostname),
+	)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("hostname", hostname).Info("organization host deleted")
+
+	return nil
+}
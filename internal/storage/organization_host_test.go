@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationHostValidate(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Hostname string
+		Error    error
+	}{
+		{
+			Name:     "valid",
+			Hostname: "lora.example.com",
+		},
+		{
+			Name:     "valid single label",
+			Hostname: "localhost",
+		},
+		{
+			Name:     "invalid characters",
+			Hostname: "lora_example.com",
+			Error:    ErrOrganizationHostInvalidHostname,
+		},
+		{
+			Name:     "leading hyphen",
+			Hostname: "-lora.example.com",
+			Error:    ErrOrganizationHostInvalidHostname,
+		},
+		{
+			Name:     "empty",
+			Hostname: "",
+			Error:    ErrOrganizationHostInvalidHostname,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.Error, OrganizationHost{Hostname: test.Hostname}.Validate())
+		})
+	}
+}
+
+func TestNormalizeHostname(t *testing.T) {
+	assert := require.New(t)
+
+	assert.Equal("lora.example.com", NormalizeHostname("LoRa.Example.com"))
+	assert.Equal("lora.example.com", NormalizeHostname("lora.example.com:8080"))
+	assert.Equal("lora.example.com", NormalizeHostname("lora.example.com."))
+}
+
+func (ts *StorageTestSuite) TestOrganizationHost() {
+	assert := require.New(ts.T())
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	h := OrganizationHost{
+		Hostname:       "Lora.Example.com:443",
+		OrganizationID: org.ID,
+		BrandingLogo:   "<img src=\"logo.png\" />",
+	}
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+		assert.NoError(CreateOrganizationHost(ts.Tx(), &h))
+		assert.Equal("lora.example.com", h.Hostname)
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+			h2, err := GetOrganizationHost(ts.Tx(), "LORA.example.com:8080")
+			assert.NoError(err)
+			assert.Equal(h.Hostname, h2.Hostname)
+			assert.Equal(org.ID, h2.OrganizationID)
+			assert.Equal(h.BrandingLogo, h2.BrandingLogo)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+			hosts, err := GetOrganizationHostsForOrganizationID(ts.Tx(), org.ID)
+			assert.NoError(err)
+			assert.Len(hosts, 1)
+			assert.Equal(h.Hostname, hosts[0].Hostname)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+			h.BrandingFooter = "Example Inc."
+			assert.NoError(UpdateOrganizationHost(ts.Tx(), &h))
+
+			h2, err := GetOrganizationHost(ts.Tx(), h.Hostname)
+			assert.NoError(err)
+			assert.Equal("Example Inc.", h2.BrandingFooter)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+			assert.NoError(DeleteOrganizationHost(ts.Tx(), h.Hostname))
+
+			_, err := GetOrganizationHost(ts.Tx(), h.Hostname)
+			assert.Equal(ErrDoesNotExist, err)
+			assert.Equal(ErrDoesNotExist, DeleteOrganizationHost(ts.Tx(), h.Hostname))
+		})
+	})
+}
@@ -0,0 +1,240 @@
+package storage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// OrganizationIntegration represents an integration configured at the
+// organization level. It is inherited by all the applications of the
+// organization, or only by the applications in ApplicationIDs when set.
+// An application integration of the same kind overrides the organization
+// integration for that application.
+type OrganizationIntegration struct {
+	ID             int64           `db:"id"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+	OrganizationID int64           `db:"organization_id"`
+	ApplicationIDs pq.Int64Array   `db:"application_ids"`
+	Kind           string          `db:"kind"`
+	Settings       json.RawMessage `db:"settings"`
+}
+
+// CreateOrganizationIntegration creates the given OrganizationIntegration.
+func CreateOrganizationIntegration(db sqlx.Queryer, i *OrganizationIntegration) error {
+	if err := validateOrganizationIntegrationApplicationIDs(db, *i); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	err := sqlx.Get(db, &i.ID, `
+		insert into organization_integration (
+			created_at,
+			updated_at,
+			organization_id,
+			application_ids,
+			kind,
+			settings
+		) values ($1, $2, $3, $4, $5, $6) returning id`,
+		now,
+		now,
+		i.OrganizationID,
+		i.ApplicationIDs,
+		i.Kind,
+		i.Settings,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	i.CreatedAt = now
+	i.UpdatedAt = now
+	log.WithFields(log.Fields{
+		"id":              i.ID,
+		"kind":            i.Kind,
+		"organization_id": i.OrganizationID,
+	}).Info("organization integration created")
+	return nil
+}
+
+// GetOrganizationIntegrationByOrganizationID returns the
+// OrganizationIntegration for the given organization id and kind.
+func GetOrganizationIntegrationByOrganizationID(db sqlx.Queryer, organizationID int64, kind string) (OrganizationIntegration, error) {
+	var i OrganizationIntegration
+	err := sqlx.Get(db, &i, "select * from organization_integration where organization_id = $1 and kind = $2", organizationID, kind)
+	if err != nil {
+		return i, handlePSQLError(Select, err, "select error")
+	}
+	return i, nil
+}
+
+// GetOrganizationIntegrationsForOrganizationID returns the integrations for
+// the given organization id.
+func GetOrganizationIntegrationsForOrganizationID(db sqlx.Queryer, organizationID int64) ([]OrganizationIntegration, error) {
+	var is []OrganizationIntegration
+	err := sqlx.Select(db, &is, `
+		select *
+		from organization_integration
+		where organization_id = $1
+		order by kind`,
+		organizationID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return is, nil
+}
+
+// UpdateOrganizationIntegration updates the given OrganizationIntegration.
+func UpdateOrganizationIntegration(db sqlx.Ext, i *OrganizationIntegration) error {
+	if err := validateOrganizationIntegrationApplicationIDs(db, *i); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	res, err := db.Exec(`
+		update organization_integration
+		set
+			updated_at = $2,
+			application_ids = $3,
+			settings = $4
+		where
+			id = $1`,
+		i.ID,
+		now,
+		i.ApplicationIDs,
+		i.Settings,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	i.UpdatedAt = now
+	log.WithFields(log.Fields{
+		"id":              i.ID,
+		"kind":            i.Kind,
+		"organization_id": i.OrganizationID,
+	}).Info("organization integration updated")
+	return nil
+}
+
+// DeleteOrganizationIntegration deletes the organization integration
+// matching the given id.
+func DeleteOrganizationIntegration(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from organization_integration where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("organization integration deleted")
+	return nil
+}
+
+// GetEffectiveIntegrationsForApplicationID returns the integrations that
+// apply to the given application id. These are the integrations of the
+// application itself, completed with the inherited organization integrations
+// of which the kind is not configured at the application level.
+// Note that the ID of an inherited integration refers to the
+// organization integration.
+func GetEffectiveIntegrationsForApplicationID(db sqlx.Queryer, applicationID int64) ([]Integration, error) {
+	var is []Integration
+	err := sqlx.Select(db, &is, `
+		select
+			i.id,
+			i.created_at,
+			i.updated_at,
+			i.application_id,
+			i.kind,
+			i.settings
+		from
+			integration i
+		where
+			i.application_id = $1
+
+		union all
+
+		select
+			oi.id,
+			oi.created_at,
+			oi.updated_at,
+			a.id as application_id,
+			oi.kind,
+			oi.settings
+		from
+			organization_integration oi
+		inner join application a
+			on a.organization_id = oi.organization_id
+		where
+			a.id = $1
+			and (coalesce(cardinality(oi.application_ids), 0) = 0 or a.id = any(oi.application_ids))
+			and not exists (
+				select 1
+				from integration i
+				where
+					i.application_id = a.id
+					and i.kind = oi.kind
+			)
+		order by
+			kind`,
+		applicationID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return is, nil
+}
+
+// validateOrganizationIntegrationApplicationIDs validates that all the
+// selected applications belong to the organization of the integration.
+func validateOrganizationIntegrationApplicationIDs(db sqlx.Queryer, i OrganizationIntegration) error {
+	if len(i.ApplicationIDs) == 0 {
+		return nil
+	}
+
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from
+			application
+		where
+			organization_id = $1
+			and id = any($2)`,
+		i.OrganizationID,
+		i.ApplicationIDs,
+	)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+
+	ids := make(map[int64]struct{})
+	for _, id := range i.ApplicationIDs {
+		ids[id] = struct{}{}
+	}
+
+	if count != len(ids) {
+		return ErrIntegrationInvalidApplication
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+)
+
+func (ts *StorageTestSuite) TestOrganizationIntegration() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	org2 := Organization{
+		Name: "test-org-2",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org2))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	sp := ServiceProfile{
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-sp",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	sp2 := ServiceProfile{
+		OrganizationID:  org2.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-sp-2",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp2))
+	sp2ID, err := uuid.FromBytes(sp2.ServiceProfile.Id)
+	assert.NoError(err)
+
+	app1 := Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app-1",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app1))
+
+	app2 := Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app-2",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app2))
+
+	app3 := Application{
+		OrganizationID:   org2.ID,
+		Name:             "test-app-3",
+		ServiceProfileID: sp2ID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app3))
+
+	ts.T().Run("Create with application of other organization", func(t *testing.T) {
+		assert := require.New(t)
+
+		oi := OrganizationIntegration{
+			OrganizationID: org.ID,
+			ApplicationIDs: pq.Int64Array{app1.ID, app3.ID},
+			Kind:           "HTTP",
+			Settings:       json.RawMessage(`{"foo": "bar"}`),
+		}
+		assert.Equal(ErrIntegrationInvalidApplication, CreateOrganizationIntegration(ts.Tx(), &oi))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		oi := OrganizationIntegration{
+			OrganizationID: org.ID,
+			Kind:           "HTTP",
+			Settings:       json.RawMessage(`{"foo": "org"}`),
+		}
+		assert.NoError(CreateOrganizationIntegration(ts.Tx(), &oi))
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			oiGet, err := GetOrganizationIntegrationByOrganizationID(ts.Tx(), org.ID, "HTTP")
+			assert.NoError(err)
+			assert.Equal(oi.ID, oiGet.ID)
+			assert.Nil(oiGet.ApplicationIDs)
+
+			_, err = GetOrganizationIntegrationByOrganizationID(ts.Tx(), org2.ID, "HTTP")
+			assert.Equal(ErrDoesNotExist, err)
+
+			ois, err := GetOrganizationIntegrationsForOrganizationID(ts.Tx(), org.ID)
+			assert.NoError(err)
+			assert.Len(ois, 1)
+		})
+
+		t.Run("Inherited by all applications of the organization", func(t *testing.T) {
+			assert := require.New(t)
+
+			for _, appID := range []int64{app1.ID, app2.ID} {
+				is, err := GetEffectiveIntegrationsForApplicationID(ts.Tx(), appID)
+				assert.NoError(err)
+				assert.Len(is, 1)
+				assert.Equal(appID, is[0].ApplicationID)
+				assert.JSONEq(`{"foo": "org"}`, string(is[0].Settings))
+			}
+
+			is, err := GetEffectiveIntegrationsForApplicationID(ts.Tx(), app3.ID)
+			assert.NoError(err)
+			assert.Len(is, 0)
+		})
+
+		t.Run("Application integration overrides", func(t *testing.T) {
+			assert := require.New(t)
+
+			i := Integration{
+				ApplicationID: app1.ID,
+				Kind:          "HTTP",
+				Settings:      json.RawMessage(`{"foo": "app"}`),
+			}
+			assert.NoError(CreateIntegration(ts.Tx(), &i))
+
+			is, err := GetEffectiveIntegrationsForApplicationID(ts.Tx(), app1.ID)
+			assert.NoError(err)
+			assert.Len(is, 1)
+			assert.Equal(i.ID, is[0].ID)
+			assert.JSONEq(`{"foo": "app"}`, string(is[0].Settings))
+		})
+
+		t.Run("Update selected applications", func(t *testing.T) {
+			assert := require.New(t)
+
+			oi.ApplicationIDs = pq.Int64Array{app1.ID}
+			assert.NoError(UpdateOrganizationIntegration(ts.Tx(), &oi))
+
+			is, err := GetEffectiveIntegrationsForApplicationID(ts.Tx(), app2.ID)
+			assert.NoError(err)
+			assert.Len(is, 0)
+
+			oi.ApplicationIDs = pq.Int64Array{app3.ID}
+			assert.Equal(ErrIntegrationInvalidApplication, UpdateOrganizationIntegration(ts.Tx(), &oi))
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteOrganizationIntegration(ts.Tx(), oi.ID))
+			assert.Equal(ErrDoesNotExist, DeleteOrganizationIntegration(ts.Tx(), oi.ID))
+		})
+	})
+}
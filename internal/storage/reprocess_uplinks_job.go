@@ -0,0 +1,209 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Reprocess uplinks job states.
+const (
+	ReprocessUplinksJobPending = "PENDING"
+	ReprocessUplinksJobRunning = "RUNNING"
+	ReprocessUplinksJobDone    = "DONE"
+	ReprocessUplinksJobError   = "ERROR"
+)
+
+// ReprocessUplinksJob defines a job re-processing the archived uplinks of
+// an application within the given time range, using the current codec of
+// the application.
+type ReprocessUplinksJob struct {
+	ID            uuid.UUID `db:"id"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+	ApplicationID int64     `db:"application_id"`
+	Start         time.Time `db:"start_at"`
+	End           time.Time `db:"end_at"`
+	State         string    `db:"state"`
+	UplinkCount   int       `db:"uplink_count"`
+	ErrorCount    int       `db:"error_count"`
+	Error         string    `db:"error"`
+}
+
+// Validate validates the job data.
+func (j ReprocessUplinksJob) Validate() error {
+	if !j.Start.Before(j.End) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
+
+// CreateReprocessUplinksJob creates the given job in the pending state.
+func CreateReprocessUplinksJob(db sqlx.Execer, j *ReprocessUplinksJob) error {
+	if err := j.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	var err error
+	j.ID, err = uuid.NewV4()
+	if err != nil {
+		return errors.Wrap(err, "new uuid v4 error")
+	}
+
+	now := time.Now()
+	j.CreatedAt = now
+	j.UpdatedAt = now
+	j.State = ReprocessUplinksJobPending
+
+	_, err = db.Exec(`
+		insert into reprocess_uplinks_job (
+			id,
+			created_at,
+			updated_at,
+			application_id,
+			start_at,
+			end_at,
+			state
+		) values ($1, $2, $3, $4, $5, $6, $7)`,
+		j.ID,
+		j.CreatedAt,
+		j.UpdatedAt,
+		j.ApplicationID,
+		j.Start,
+		j.End,
+		j.State,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":             j.ID,
+		"application_id": j.ApplicationID,
+	}).Info("reprocess uplinks job created")
+
+	return nil
+}
+
+// GetReprocessUplinksJobCountForApplicationID returns the total number of
+// jobs for the given application id.
+func GetReprocessUplinksJobCountForApplicationID(db sqlx.Queryer, applicationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from reprocess_uplinks_job where application_id = $1", applicationID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetReprocessUplinksJobsForApplicationID returns the jobs for the given
+// application id (newest first).
+func GetReprocessUplinksJobsForApplicationID(db sqlx.Queryer, applicationID int64, limit, offset int) ([]ReprocessUplinksJob, error) {
+	var jobs []ReprocessUplinksJob
+	err := sqlx.Select(db, &jobs, `
+		select
+			*
+		from
+			reprocess_uplinks_job
+		where
+			application_id = $1
+		order by
+			created_at desc
+		limit $2
+		offset $3`,
+		applicationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return jobs, nil
+}
+
+// ClaimPendingReprocessUplinksJob sets the oldest pending job in the running
+// state and returns it. Running jobs which have not been updated within the
+// given stale duration (e.g. because the processing instance crashed) are
+// claimed again, their counters are reset. Jobs which are locked by an other
+// transaction are skipped. ErrDoesNotExist is returned when there are no
+// pending jobs.
+func ClaimPendingReprocessUplinksJob(db sqlx.Queryer, staleAfter time.Duration) (ReprocessUplinksJob, error) {
+	var j ReprocessUplinksJob
+	now := time.Now()
+	err := sqlx.Get(db, &j, `
+		update
+			reprocess_uplinks_job
+		set
+			state = $1,
+			updated_at = $2,
+			uplink_count = 0,
+			error_count = 0
+		where
+			id = (
+				select
+					id
+				from
+					reprocess_uplinks_job
+				where
+					state = $3
+					or (state = $1 and updated_at < $4)
+				order by
+					created_at
+				limit 1
+				for update skip locked
+			)
+		returning *`,
+		ReprocessUplinksJobRunning,
+		now,
+		ReprocessUplinksJobPending,
+		now.Add(-staleAfter),
+	)
+	if err != nil {
+		return j, handlePSQLError(Update, err, "update error")
+	}
+
+	return j, nil
+}
+
+// UpdateReprocessUplinksJob updates the state and counters of the given job.
+func UpdateReprocessUplinksJob(db sqlx.Execer, j *ReprocessUplinksJob) error {
+	j.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update reprocess_uplinks_job
+		set
+			updated_at = $2,
+			state = $3,
+			uplink_count = $4,
+			error_count = $5,
+			error = $6
+		where
+			id = $1`,
+		j.ID,
+		j.UpdatedAt,
+		j.State,
+		j.UplinkCount,
+		j.ErrorCount,
+		j.Error,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":    j.ID,
+		"state": j.State,
+	}).Info("reprocess uplinks job updated")
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
+)
+
+func (ts *StorageTestSuite) TestReprocessUplinksJob() {
+	assert := require.New(ts.T())
+
+	nsClient := mock.NewClient()
+	networkserver.SetPool(mock.NewPool(nsClient))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	sp := ServiceProfile{
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+		Name:            "test-sp",
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+	spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+	assert.NoError(err)
+
+	app := Application{
+		OrganizationID:   org.ID,
+		Name:             "test-app",
+		ServiceProfileID: spID,
+	}
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	now := time.Now().Round(time.Second)
+
+	ts.T().Run("Create with invalid time range", func(t *testing.T) {
+		assert := require.New(t)
+
+		job := ReprocessUplinksJob{
+			ApplicationID: app.ID,
+			Start:         now,
+			End:           now,
+		}
+		assert.Equal(ErrInvalidTimeRange, errors.Cause(CreateReprocessUplinksJob(ts.Tx(), &job)))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		job := ReprocessUplinksJob{
+			ApplicationID: app.ID,
+			Start:         now.Add(-time.Hour),
+			End:           now,
+		}
+		assert.NoError(CreateReprocessUplinksJob(ts.Tx(), &job))
+		assert.Equal(ReprocessUplinksJobPending, job.State)
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetReprocessUplinksJobCountForApplicationID(ts.Tx(), app.ID)
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			jobs, err := GetReprocessUplinksJobsForApplicationID(ts.Tx(), app.ID, 10, 0)
+			assert.NoError(err)
+			assert.Len(jobs, 1)
+			assert.Equal(job.ID, jobs[0].ID)
+			assert.True(job.Start.Equal(jobs[0].Start))
+			assert.True(job.End.Equal(jobs[0].End))
+		})
+
+		t.Run("Claim", func(t *testing.T) {
+			assert := require.New(t)
+
+			claimed, err := ClaimPendingReprocessUplinksJob(ts.Tx(), time.Minute)
+			assert.NoError(err)
+			assert.Equal(job.ID, claimed.ID)
+			assert.Equal(ReprocessUplinksJobRunning, claimed.State)
+
+			_, err = ClaimPendingReprocessUplinksJob(ts.Tx(), time.Minute)
+			assert.Equal(ErrDoesNotExist, err)
+
+			// the running job is claimed again once it is stale
+			claimed.UplinkCount = 5
+			assert.NoError(UpdateReprocessUplinksJob(ts.Tx(), &claimed))
+			reclaimed, err := ClaimPendingReprocessUplinksJob(ts.Tx(), -time.Minute)
+			assert.NoError(err)
+			assert.Equal(job.ID, reclaimed.ID)
+			assert.Equal(0, reclaimed.UplinkCount)
+
+			t.Run("Update", func(t *testing.T) {
+				assert := require.New(t)
+
+				claimed.State = ReprocessUplinksJobDone
+				claimed.UplinkCount = 10
+				claimed.ErrorCount = 2
+				assert.NoError(UpdateReprocessUplinksJob(ts.Tx(), &claimed))
+
+				jobs, err := GetReprocessUplinksJobsForApplicationID(ts.Tx(), app.ID, 10, 0)
+				assert.NoError(err)
+				assert.Len(jobs, 1)
+				assert.Equal(ReprocessUplinksJobDone, jobs[0].State)
+				assert.Equal(10, jobs[0].UplinkCount)
+				assert.Equal(2, jobs[0].ErrorCount)
+			})
+		})
+	})
+}
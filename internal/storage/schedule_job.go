@@ -0,0 +1,238 @@
+package storage
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// ScheduleJob defines a job which is executed according to a cron
+// expression, evaluated in the given time zone.
+type ScheduleJob struct {
+	ID        uuid.UUID       `db:"id"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
+	Type      string          `db:"type"`
+	Cron      string          `db:"cron"`
+	Timezone  string          `db:"timezone"`
+	Payload   json.RawMessage `db:"payload"`
+
+	// NextRunAt holds the scheduled time of the next (pending) execution.
+	NextRunAt time.Time `db:"next_run_at"`
+
+	// DueAt holds the time at which the job must be executed. This equals
+	// NextRunAt, unless the execution failed and is being retried.
+	DueAt time.Time `db:"due_at"`
+
+	// LastRunAt holds the scheduled time of the last successful execution.
+	LastRunAt *time.Time `db:"last_run_at"`
+
+	// Attempts holds the number of failed attempts of the pending execution.
+	Attempts  int    `db:"attempts"`
+	LastError string `db:"last_error"`
+}
+
+// Validate validates the schedule job data.
+func (j ScheduleJob) Validate() error {
+	if strings.TrimSpace(j.Type) == "" || strings.TrimSpace(j.Cron) == "" || j.Timezone == "" {
+		return ErrScheduleJobInvalid
+	}
+	return nil
+}
+
+// CreateScheduleJob creates the given schedule job.
+func CreateScheduleJob(db sqlx.Execer, j *ScheduleJob) error {
+	if err := j.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	var err error
+	j.ID, err = uuid.NewV4()
+	if err != nil {
+		return errors.Wrap(err, "new uuid v4 error")
+	}
+
+	now := time.Now()
+	j.CreatedAt = now
+	j.UpdatedAt = now
+
+	if len(j.Payload) == 0 {
+		j.Payload = json.RawMessage("{}")
+	}
+
+	_, err = db.Exec(`
+		insert into schedule_job (
+			id,
+			created_at,
+			updated_at,
+			type,
+			cron,
+			timezone,
+			payload,
+			next_run_at,
+			due_at,
+			last_run_at,
+			attempts,
+			last_error
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		j.ID,
+		j.CreatedAt,
+		j.UpdatedAt,
+		j.Type,
+		j.Cron,
+		j.Timezone,
+		j.Payload,
+		j.NextRunAt,
+		j.DueAt,
+		j.LastRunAt,
+		j.Attempts,
+		j.LastError,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":          j.ID,
+		"type":        j.Type,
+		"next_run_at": j.NextRunAt,
+	}).Info("schedule job created")
+
+	return nil
+}
+
+// GetScheduleJob returns the schedule job for the given id.
+func GetScheduleJob(db sqlx.Queryer, id uuid.UUID, forUpdate bool) (ScheduleJob, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var j ScheduleJob
+	err := sqlx.Get(db, &j, "select * from schedule_job where id = $1"+fu, id)
+	if err != nil {
+		return j, handlePSQLError(Select, err, "select error")
+	}
+	return j, nil
+}
+
+// GetScheduleJobsForType returns the schedule jobs of the given type.
+func GetScheduleJobsForType(db sqlx.Queryer, jobType string) ([]ScheduleJob, error) {
+	var jobs []ScheduleJob
+	err := sqlx.Select(db, &jobs, `
+		select
+			*
+		from
+			schedule_job
+		where
+			type = $1
+		order by
+			created_at`,
+		jobType,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return jobs, nil
+}
+
+// GetNextScheduleJobForUpdate returns the job which is due first and locks
+// it for update. Jobs which are locked by an other transaction are skipped.
+// ErrDoesNotExist is returned when there are no jobs due.
+func GetNextScheduleJobForUpdate(db sqlx.Queryer) (ScheduleJob, error) {
+	var j ScheduleJob
+	err := sqlx.Get(db, &j, `
+		select
+			*
+		from
+			schedule_job
+		where
+			due_at <= $1
+		order by
+			due_at
+		limit 1
+		for update skip locked`,
+		time.Now(),
+	)
+	if err != nil {
+		return j, handlePSQLError(Select, err, "select error")
+	}
+	return j, nil
+}
+
+// UpdateScheduleJob updates the given schedule job.
+func UpdateScheduleJob(db sqlx.Execer, j *ScheduleJob) error {
+	if err := j.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	j.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update schedule_job
+		set
+			updated_at = $2,
+			cron = $3,
+			timezone = $4,
+			payload = $5,
+			next_run_at = $6,
+			due_at = $7,
+			last_run_at = $8,
+			attempts = $9,
+			last_error = $10
+		where
+			id = $1`,
+		j.ID,
+		j.UpdatedAt,
+		j.Cron,
+		j.Timezone,
+		j.Payload,
+		j.NextRunAt,
+		j.DueAt,
+		j.LastRunAt,
+		j.Attempts,
+		j.LastError,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":          j.ID,
+		"next_run_at": j.NextRunAt,
+		"due_at":      j.DueAt,
+	}).Debug("schedule job updated")
+
+	return nil
+}
+
+// DeleteScheduleJob deletes the schedule job matching the given id.
+func DeleteScheduleJob(db sqlx.Execer, id uuid.UUID) error {
+	res, err := db.Exec("delete from schedule_job where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("schedule job deleted")
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func (ts *StorageTestSuite) TestScheduleJob() {
+	assert := require.New(ts.T())
+
+	now := time.Now().Round(time.Second)
+
+	ts.T().Run("Create invalid", func(t *testing.T) {
+		assert := require.New(t)
+
+		j := ScheduleJob{
+			Cron:     "0 9 * * *",
+			Timezone: "Europe/Amsterdam",
+		}
+		assert.Equal(ErrScheduleJobInvalid, errors.Cause(CreateScheduleJob(ts.Tx(), &j)))
+	})
+
+	j := ScheduleJob{
+		Type:      "test",
+		Cron:      "0 9 * * *",
+		Timezone:  "Europe/Amsterdam",
+		NextRunAt: now.Add(-time.Minute),
+		DueAt:     now.Add(-time.Minute),
+	}
+	assert.NoError(CreateScheduleJob(ts.Tx(), &j))
+	assert.Equal(json.RawMessage("{}"), j.Payload)
+
+	j2 := ScheduleJob{
+		Type:      "test",
+		Cron:      "0 10 * * *",
+		Timezone:  "UTC",
+		Payload:   json.RawMessage(`{"foo":"bar"}`),
+		NextRunAt: now.Add(time.Hour),
+		DueAt:     now.Add(time.Hour),
+	}
+	assert.NoError(CreateScheduleJob(ts.Tx(), &j2))
+
+	ts.T().Run("Get", func(t *testing.T) {
+		assert := require.New(t)
+
+		jj, err := GetScheduleJob(ts.Tx(), j2.ID, false)
+		assert.NoError(err)
+		assert.Equal(j2.Type, jj.Type)
+		assert.Equal(j2.Cron, jj.Cron)
+		assert.Equal(j2.Timezone, jj.Timezone)
+		assert.JSONEq(string(j2.Payload), string(jj.Payload))
+		assert.True(j2.NextRunAt.Equal(jj.NextRunAt))
+		assert.Nil(jj.LastRunAt)
+	})
+
+	ts.T().Run("GetScheduleJobsForType", func(t *testing.T) {
+		assert := require.New(t)
+
+		jobs, err := GetScheduleJobsForType(ts.Tx(), "test")
+		assert.NoError(err)
+		assert.Len(jobs, 2)
+
+		jobs, err = GetScheduleJobsForType(ts.Tx(), "other")
+		assert.NoError(err)
+		assert.Len(jobs, 0)
+	})
+
+	ts.T().Run("GetNextScheduleJobForUpdate", func(t *testing.T) {
+		assert := require.New(t)
+
+		jj, err := GetNextScheduleJobForUpdate(ts.Tx())
+		assert.NoError(err)
+		assert.Equal(j.ID, jj.ID)
+	})
+
+	ts.T().Run("Update", func(t *testing.T) {
+		assert := require.New(t)
+
+		lastRunAt := j.NextRunAt
+		j.LastRunAt = &lastRunAt
+		j.NextRunAt = now.Add(24 * time.Hour)
+		j.DueAt = j.NextRunAt
+		j.Attempts = 2
+		j.LastError = "boom"
+		assert.NoError(UpdateScheduleJob(ts.Tx(), &j))
+
+		jj, err := GetScheduleJob(ts.Tx(), j.ID, false)
+		assert.NoError(err)
+		assert.True(j.NextRunAt.Equal(jj.NextRunAt))
+		assert.True(lastRunAt.Equal(*jj.LastRunAt))
+		assert.Equal(2, jj.Attempts)
+		assert.Equal("boom", jj.LastError)
+
+		t.Run("No jobs due", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := GetNextScheduleJobForUpdate(ts.Tx())
+			assert.Equal(ErrDoesNotExist, errors.Cause(err))
+		})
+	})
+
+	ts.T().Run("Delete", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(DeleteScheduleJob(ts.Tx(), j.ID))
+		assert.Equal(ErrDoesNotExist, errors.Cause(DeleteScheduleJob(ts.Tx(), j.ID)))
+
+		_, err := GetScheduleJob(ts.Tx(), j.ID, false)
+		assert.Equal(ErrDoesNotExist, errors.Cause(err))
+	})
+}
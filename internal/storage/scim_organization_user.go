@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// CreateSCIMOrganizationUser marks the given user of the organization as
+// provisioned by SCIM.
+func CreateSCIMOrganizationUser(db sqlx.Execer, organizationID, userID int64) error {
+	res, err := db.Exec(`
+		insert into scim_organization_user (
+			organization_user_id
+		)
+		select
+			id
+		from
+			organization_user
+		where
+			organization_id = $1
+			and user_id = $2`,
+		organizationID,
+		userID,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
+
+// GetSCIMOrganizationUserIDs returns the ids of the users of the given
+// organization which have been provisioned by SCIM. Users which are admin
+// of the organization are excluded.
+func GetSCIMOrganizationUserIDs(db sqlx.Queryer, organizationID int64) ([]int64, error) {
+	var ids []int64
+	err := sqlx.Select(db, &ids, `
+		select
+			ou.user_id
+		from
+			scim_organization_user sou
+		inner join organization_user ou
+			on ou.id = sou.organization_user_id
+		where
+			ou.organization_id = $1
+			and ou.is_admin = false`,
+		organizationID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return ids, nil
+}
@@ -20,8 +20,9 @@ type SearchResult struct {
 }
 
 // GlobalSearch performs a search on organizations, applications, gateways
-// and devices.
-func GlobalSearch(db sqlx.Queryer, username string, globalAdmin bool, search string, limit, offset int) ([]SearchResult, error) {
+// and devices. When the organization ID is set (non 0), only the results of
+// this organization are returned.
+func GlobalSearch(db sqlx.Queryer, username string, globalAdmin bool, organizationID int64, search string, limit, offset int) ([]SearchResult, error) {
 	var result []SearchResult
 	query := "%" + search + "%"
 
@@ -48,6 +49,7 @@ func GlobalSearch(db sqlx.Queryer, username string, globalAdmin bool, search str
 			on u.id = ou.user_id
 		where
 			($3 = true or u.username = $4)
+			and ($7 = 0 or o.id = $7)
 			and (d.name ilike $2 or encode(d.dev_eui, 'hex') ilike $2)
 		union
 		select
@@ -71,6 +73,7 @@ func GlobalSearch(db sqlx.Queryer, username string, globalAdmin bool, search str
 			on u.id = ou.user_id
 		where
 			($3 = true or u.username = $4)
+			and ($7 = 0 or o.id = $7)
 			and (g.name ilike $2 or encode(g.mac, 'hex') ilike $2)
 		union
 		select
@@ -92,6 +95,7 @@ func GlobalSearch(db sqlx.Queryer, username string, globalAdmin bool, search str
 			on u.id = ou.user_id
 		where
 			($3 = true or u.username = $4)
+			and ($7 = 0 or o.id = $7)
 			and o.name ilike $2
 		union
 		select
@@ -115,6 +119,7 @@ func GlobalSearch(db sqlx.Queryer, username string, globalAdmin bool, search str
 			on u.id = ou.user_id
 		where
 			($3 = true or u.username = $4)
+			and ($7 = 0 or o.id = $7)
 			and a.name ilike $2
 		order by
 			score desc
@@ -126,6 +131,7 @@ func GlobalSearch(db sqlx.Queryer, username string, globalAdmin bool, search str
 		username,
 		limit,
 		offset,
+		organizationID,
 	)
 	if err != nil {
 		return nil, handlePSQLError(Select, err, "select error")
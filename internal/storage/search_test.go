@@ -95,7 +95,7 @@ func TestSearch(t *testing.T) {
 			}
 
 			for _, q := range queries {
-				res, err := GlobalSearch(DB(), u.Username, false, q, 10, 0)
+				res, err := GlobalSearch(DB(), u.Username, false, 0, q, 10, 0)
 				So(err, ShouldBeNil)
 				So(res, ShouldHaveLength, 0)
 			}
@@ -114,12 +114,22 @@ func TestSearch(t *testing.T) {
 			}
 
 			for q, c := range queries {
-				res, err := GlobalSearch(DB(), u.Username, true, q, 10, 0)
+				res, err := GlobalSearch(DB(), u.Username, true, 0, q, 10, 0)
 				So(err, ShouldBeNil)
 				So(res, ShouldHaveLength, c)
 			}
 		})
 
+		Convey("When scoped to an organization, only the results of this organization are returned", func() {
+			res, err := GlobalSearch(DB(), u.Username, true, org.ID, "test", 10, 0)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 4)
+
+			res, err = GlobalSearch(DB(), u.Username, true, org.ID+1, "test", 10, 0)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 0)
+		})
+
 		Convey("When the user is part of the organization, this returns results", func() {
 			So(CreateOrganizationUser(DB(), org.ID, u.ID, false), ShouldBeNil)
 
@@ -135,7 +145,7 @@ func TestSearch(t *testing.T) {
 			}
 
 			for q, c := range queries {
-				res, err := GlobalSearch(DB(), u.Username, false, q, 10, 0)
+				res, err := GlobalSearch(DB(), u.Username, false, 0, q, 10, 0)
 				So(err, ShouldBeNil)
 				So(res, ShouldHaveLength, c)
 			}
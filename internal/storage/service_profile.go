@@ -9,10 +9,12 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
+	"github.com/brocaar/lora-app-server/internal/integration"
 	"github.com/brocaar/loraserver/api/ns"
 )
 
@@ -24,20 +26,33 @@ type ServiceProfile struct {
 	UpdatedAt       time.Time         `db:"updated_at"`
 	Name            string            `db:"name"`
 	ServiceProfile  ns.ServiceProfile `db:"-"`
+
+	// MetadataRedactFields contains the gateway meta-data fields which are
+	// dropped or redacted from the uplink events of the applications using
+	// this service-profile, in addition to the redact fields of the
+	// application.
+	MetadataRedactFields pq.StringArray `db:"metadata_redact_fields"`
 }
 
 // ServiceProfileMeta defines the service-profile meta record.
 type ServiceProfileMeta struct {
-	ServiceProfileID uuid.UUID `db:"service_profile_id"`
-	NetworkServerID  int64     `db:"network_server_id"`
-	OrganizationID   int64     `db:"organization_id"`
-	CreatedAt        time.Time `db:"created_at"`
-	UpdatedAt        time.Time `db:"updated_at"`
-	Name             string    `db:"name"`
+	ServiceProfileID     uuid.UUID      `db:"service_profile_id"`
+	NetworkServerID      int64          `db:"network_server_id"`
+	OrganizationID       int64          `db:"organization_id"`
+	CreatedAt            time.Time      `db:"created_at"`
+	UpdatedAt            time.Time      `db:"updated_at"`
+	Name                 string         `db:"name"`
+	MetadataRedactFields pq.StringArray `db:"metadata_redact_fields"`
 }
 
 // Validate validates the service-profile data.
 func (sp ServiceProfile) Validate() error {
+	for _, field := range sp.MetadataRedactFields {
+		if !integration.ValidMetadataRedactField(field) {
+			return ErrServiceProfileInvalidMetadata
+		}
+	}
+
 	return nil
 }
 
@@ -64,14 +79,16 @@ func CreateServiceProfile(db sqlx.Ext, sp *ServiceProfile) error {
 			organization_id,
 			created_at,
 			updated_at,
-			name
-		) values ($1, $2, $3, $4, $5, $6)`,
+			name,
+			metadata_redact_fields
+		) values ($1, $2, $3, $4, $5, $6, $7)`,
 		spID,
 		sp.NetworkServerID,
 		sp.OrganizationID,
 		sp.CreatedAt,
 		sp.UpdatedAt,
 		sp.Name,
+		sp.MetadataRedactFields,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -107,7 +124,8 @@ func GetServiceProfile(db sqlx.Queryer, id uuid.UUID, localOnly bool) (ServicePr
 			organization_id,
 			created_at,
 			updated_at,
-			name
+			name,
+			metadata_redact_fields
 		from service_profile
 		where
 			service_profile_id = $1`,
@@ -117,7 +135,7 @@ func GetServiceProfile(db sqlx.Queryer, id uuid.UUID, localOnly bool) (ServicePr
 		return sp, handlePSQLError(Select, err, "select error")
 	}
 
-	err := row.Scan(&sp.NetworkServerID, &sp.OrganizationID, &sp.CreatedAt, &sp.UpdatedAt, &sp.Name)
+	err := row.Scan(&sp.NetworkServerID, &sp.OrganizationID, &sp.CreatedAt, &sp.UpdatedAt, &sp.Name, &sp.MetadataRedactFields)
 	if err != nil {
 		return sp, handlePSQLError(Scan, err, "scan error")
 	}
@@ -168,11 +186,13 @@ func UpdateServiceProfile(db sqlx.Ext, sp *ServiceProfile) error {
 		update service_profile
 		set
 			updated_at = $2,
-			name = $3
+			name = $3,
+			metadata_redact_fields = $4
 		where service_profile_id = $1`,
 		spID,
 		sp.UpdatedAt,
 		sp.Name,
+		sp.MetadataRedactFields,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
 
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver"
 	"github.com/brocaar/lora-app-server/internal/backend/networkserver/mock"
@@ -46,11 +48,23 @@ func TestServiceProfile(t *testing.T) {
 		}
 		So(CreateNetworkServer(DB(), &n), ShouldBeNil)
 
+		Convey("Then CreateServiceProfile with an invalid meta-data redact field returns an error", func() {
+			sp := ServiceProfile{
+				OrganizationID:       org.ID,
+				NetworkServerID:      n.ID,
+				Name:                 "test-service-profile",
+				MetadataRedactFields: []string{"data"},
+			}
+			err := CreateServiceProfile(DB(), &sp)
+			So(errors.Cause(err), ShouldEqual, ErrServiceProfileInvalidMetadata)
+		})
+
 		Convey("Then CreateServiceProfile creates the service-profile", func() {
 			sp := ServiceProfile{
-				OrganizationID:  org.ID,
-				NetworkServerID: n.ID,
-				Name:            "test-service-profile",
+				OrganizationID:       org.ID,
+				NetworkServerID:      n.ID,
+				Name:                 "test-service-profile",
+				MetadataRedactFields: []string{"rxInfo.location"},
 				ServiceProfile: ns.ServiceProfile{
 					UlRate:                 100,
 					UlBucketSize:           10,
@@ -96,6 +110,7 @@ func TestServiceProfile(t *testing.T) {
 
 			Convey("Then UpdateServiceProfile updates the service-profile", func() {
 				sp.Name = "updated-service-profile"
+				sp.MetadataRedactFields = []string{"rxInfo.gateway", "txInfo"}
 				sp.ServiceProfile = ns.ServiceProfile{
 					Id:                     sp.ServiceProfile.Id,
 					UlRate:                 101,
@@ -128,6 +143,7 @@ func TestServiceProfile(t *testing.T) {
 				So(err, ShouldBeNil)
 				spGet.UpdatedAt = spGet.UpdatedAt.UTC().Truncate(time.Millisecond)
 				So(spGet.Name, ShouldEqual, "updated-service-profile")
+				So(spGet.MetadataRedactFields, ShouldResemble, pq.StringArray{"rxInfo.gateway", "txInfo"})
 				So(spGet.UpdatedAt, ShouldResemble, sp.UpdatedAt)
 			})
 
@@ -25,6 +25,7 @@ func Setup(c config.Config) error {
 
 	jwtsecret = []byte(c.ApplicationServer.ExternalAPI.JWTSecret)
 	HashIterations = c.General.PasswordHashIterations
+	slowQueryThreshold = c.PostgreSQL.SlowQueryThreshold
 
 	log.Info("storage: setting up Redis pool")
 	redisPool = &redis.Pool{
@@ -54,20 +55,11 @@ func Setup(c config.Config) error {
 	}
 
 	log.Info("storage: connecting to PostgreSQL database")
-	d, err := sqlx.Open("postgres", c.PostgreSQL.DSN)
+	d, err := openDB(c.PostgreSQL.DSN)
 	if err != nil {
 		return errors.Wrap(err, "storage: PostgreSQL connection error")
 	}
-	for {
-		if err := d.Ping(); err != nil {
-			log.WithError(err).Warning("storage: ping PostgreSQL database error, will retry in 2s")
-			time.Sleep(2 * time.Second)
-		} else {
-			break
-		}
-	}
-
-	db = &DBLogger{d}
+	db = d
 
 	if c.PostgreSQL.Automigrate {
 		log.Info("storage: applying PostgreSQL data migrations")
@@ -83,5 +75,28 @@ func Setup(c config.Config) error {
 		log.WithField("count", n).Info("storage: PostgreSQL data migrations applied")
 	}
 
+	if err := setupStorageClusters(c); err != nil {
+		return errors.Wrap(err, "storage: setup storage clusters error")
+	}
+
 	return nil
 }
+
+// openDB opens the PostgreSQL database with the given DSN. It blocks until
+// the database can be reached.
+func openDB(dsn string) (*DBLogger, error) {
+	d, err := sqlx.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if err := d.Ping(); err != nil {
+			log.WithError(err).Warning("storage: ping PostgreSQL database error, will retry in 2s")
+			time.Sleep(2 * time.Second)
+		} else {
+			break
+		}
+	}
+
+	return &DBLogger{d}, nil
+}
@@ -0,0 +1,343 @@
+package storage
+
+import (
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	migrate "github.com/rubenv/sql-migrate"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/migrations"
+	"github.com/brocaar/lorawan"
+)
+
+// moveBatchSize defines the number of rows moved at once when moving the
+// device data of an organization to an other storage cluster.
+const moveBatchSize = 1000
+
+// storageClusters contains the configured storage clusters by name. The
+// default storage cluster (the PostgreSQL database containing all the other
+// data) has an empty name and is not part of this map.
+var storageClusters map[string]*DBLogger
+
+// setupStorageClusters connects to the configured storage clusters and
+// applies their data migrations.
+func setupStorageClusters(c config.Config) error {
+	storageClusters = make(map[string]*DBLogger)
+
+	for name, dsn := range c.PostgreSQL.StorageClusters {
+		log.WithField("storage_cluster", name).Info("storage: connecting to PostgreSQL storage cluster")
+		d, err := openDB(dsn)
+		if err != nil {
+			return errors.Wrapf(err, "storage cluster %s: PostgreSQL connection error", name)
+		}
+
+		if c.PostgreSQL.Automigrate {
+			m := &migrate.AssetMigrationSource{
+				Asset:    migrations.Asset,
+				AssetDir: migrations.AssetDir,
+				Dir:      "cluster",
+			}
+			n, err := migrate.Exec(d.DB.DB, "postgres", m, migrate.Up)
+			if err != nil {
+				return errors.Wrapf(err, "storage cluster %s: applying PostgreSQL data migrations error", name)
+			}
+			log.WithFields(log.Fields{
+				"storage_cluster": name,
+				"count":           n,
+			}).Info("storage: PostgreSQL storage cluster data migrations applied")
+		}
+
+		storageClusters[name] = d
+	}
+
+	return nil
+}
+
+// StorageClusterNames returns the names of the configured storage clusters
+// (excluding the default storage cluster), sorted by name.
+func StorageClusterNames() []string {
+	var out []string
+	for name := range storageClusters {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// StorageClusterDB returns the database of the given storage cluster. An
+// empty name returns the default storage cluster.
+func StorageClusterDB(name string) (*DBLogger, error) {
+	if name == "" {
+		return db, nil
+	}
+
+	d, ok := storageClusters[name]
+	if !ok {
+		return nil, ErrStorageClusterDoesNotExist
+	}
+	return d, nil
+}
+
+// deviceDataDB returns the database containing the device data (the
+// measurements and the locations) of the given device. This is the given db
+// when the organization of the device uses the default storage cluster, so
+// that the device data is part of the transaction of the caller.
+func deviceDataDB(db sqlx.Queryer, devEUI lorawan.EUI64) (sqlx.Queryer, error) {
+	if len(storageClusters) == 0 {
+		return db, nil
+	}
+
+	var name string
+	err := sqlx.Get(db, &name, `
+		select
+			o.storage_cluster
+		from
+			device d
+		inner join application a
+			on a.id = d.application_id
+		inner join organization o
+			on o.id = a.organization_id
+		where
+			d.dev_eui = $1`,
+		devEUI[:],
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	if name == "" {
+		return db, nil
+	}
+
+	return StorageClusterDB(name)
+}
+
+// GetOrganizationStorageCluster returns the name of the storage cluster of
+// the given organization.
+func GetOrganizationStorageCluster(db sqlx.Queryer, organizationID int64) (string, error) {
+	var name string
+	err := sqlx.Get(db, &name, "select storage_cluster from organization where id = $1", organizationID)
+	if err != nil {
+		return "", handlePSQLError(Select, err, "select error")
+	}
+	return name, nil
+}
+
+// MoveOrganizationStorage moves the device data of the given organization
+// to the given storage cluster (an empty name moves the data to the default
+// storage cluster). The organization is assigned to the new storage cluster
+// first, so that new device data is stored in the new storage cluster while
+// the existing data is being moved. It returns the number of moved rows.
+func MoveOrganizationStorage(organizationID int64, name string) (int64, error) {
+	target, err := StorageClusterDB(name)
+	if err != nil {
+		return 0, err
+	}
+
+	var current string
+	err = Transaction(func(tx sqlx.Ext) error {
+		err := sqlx.Get(tx, &current, "select storage_cluster from organization where id = $1 for update", organizationID)
+		if err != nil {
+			return handlePSQLError(Select, err, "select error")
+		}
+
+		_, err = tx.Exec("update organization set storage_cluster = $2 where id = $1", organizationID, name)
+		if err != nil {
+			return handlePSQLError(Update, err, "update error")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if current == name {
+		return 0, nil
+	}
+
+	source, err := StorageClusterDB(current)
+	if err != nil {
+		return 0, errors.Wrap(err, "get source storage cluster error")
+	}
+
+	var devEUIs []lorawan.EUI64
+	err = sqlx.Select(db, &devEUIs, `
+		select
+			d.dev_eui
+		from
+			device d
+		inner join application a
+			on a.id = d.application_id
+		where
+			a.organization_id = $1`,
+		organizationID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	var count int64
+	for {
+		n, err := moveDeviceDataBatch(source, target, devEUIs)
+		if err != nil {
+			return count, errors.Wrap(err, "move device data error")
+		}
+		if n == 0 {
+			break
+		}
+		count += n
+	}
+
+	log.WithFields(log.Fields{
+		"organization_id": organizationID,
+		"storage_cluster": name,
+		"count":           count,
+	}).Info("organization storage moved")
+
+	return count, nil
+}
+
+// moveDeviceDataBatch moves a batch of the device data of the given devices
+// from the source to the target storage cluster. The data is inserted in
+// the target storage cluster before it is removed from the source storage
+// cluster. It returns the number of moved rows.
+func moveDeviceDataBatch(source, target *DBLogger, devEUIs []lorawan.EUI64) (int64, error) {
+	var euis [][]byte
+	for i := range devEUIs {
+		euis = append(euis, devEUIs[i][:])
+	}
+
+	var count int64
+	err := transaction(source, func(sourceTx sqlx.Ext) error {
+		var measurements []DeviceMeasurement
+		err := sqlx.Select(sourceTx, &measurements, `
+			delete from device_measurement
+			where id in (
+				select id from device_measurement where dev_eui = any($1) order by id limit $2
+			)
+			returning *`,
+			pq.ByteaArray(euis),
+			moveBatchSize,
+		)
+		if err != nil {
+			return handlePSQLError(Delete, err, "delete error")
+		}
+
+		var locations []DeviceLocation
+		err = sqlx.Select(sourceTx, &locations, `
+			delete from device_location
+			where id in (
+				select id from device_location where dev_eui = any($1) order by id limit $2
+			)
+			returning *`,
+			pq.ByteaArray(euis),
+			moveBatchSize,
+		)
+		if err != nil {
+			return handlePSQLError(Delete, err, "delete error")
+		}
+
+		count = int64(len(measurements) + len(locations))
+		if count == 0 {
+			return nil
+		}
+
+		return transaction(target, func(targetTx sqlx.Ext) error {
+			for i := range measurements {
+				if err := insertDeviceMeasurement(targetTx, &measurements[i]); err != nil {
+					return err
+				}
+			}
+			for i := range locations {
+				if err := insertDeviceLocation(targetTx, &locations[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// DeleteOrphanedDeviceData deletes the device data from the given storage
+// cluster of the devices which do not exist anymore or which do not belong
+// to an organization using this storage cluster anymore (e.g. after the
+// application or organization has been deleted). It returns the number of
+// deleted rows.
+func DeleteOrphanedDeviceData(name string) (int64, error) {
+	d, err := StorageClusterDB(name)
+	if err != nil {
+		return 0, err
+	}
+
+	var devEUIs [][]byte
+	err = sqlx.Select(d, &devEUIs, `
+		select dev_eui from device_measurement
+		union
+		select dev_eui from device_location`,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	if len(devEUIs) == 0 {
+		return 0, nil
+	}
+
+	var current [][]byte
+	err = sqlx.Select(db, &current, `
+		select
+			d.dev_eui
+		from
+			device d
+		inner join application a
+			on a.id = d.application_id
+		inner join organization o
+			on o.id = a.organization_id
+		where
+			d.dev_eui = any($1)
+			and o.storage_cluster = $2`,
+		pq.ByteaArray(devEUIs),
+		name,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	existing := make(map[string]struct{})
+	for _, eui := range current {
+		existing[string(eui)] = struct{}{}
+	}
+
+	var orphaned [][]byte
+	for _, eui := range devEUIs {
+		if _, ok := existing[string(eui)]; !ok {
+			orphaned = append(orphaned, eui)
+		}
+	}
+	if len(orphaned) == 0 {
+		return 0, nil
+	}
+
+	var count int64
+	for _, table := range []string{"device_measurement", "device_location"} {
+		res, err := d.Exec("delete from "+table+" where dev_eui = any($1)", pq.ByteaArray(orphaned))
+		if err != nil {
+			return count, handlePSQLError(Delete, err, "delete error")
+		}
+		ra, err := res.RowsAffected()
+		if err != nil {
+			return count, errors.Wrap(err, "get rows affected error")
+		}
+		count += ra
+	}
+
+	return count, nil
+}
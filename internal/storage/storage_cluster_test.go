@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageClusterDB(t *testing.T) {
+	assert := require.New(t)
+
+	eu := &DBLogger{}
+	storageClusters = map[string]*DBLogger{"eu": eu}
+	defer func() { storageClusters = nil }()
+
+	d, err := StorageClusterDB("")
+	assert.NoError(err)
+	assert.Equal(db, d)
+
+	d, err = StorageClusterDB("eu")
+	assert.NoError(err)
+	assert.True(eu == d)
+
+	_, err = StorageClusterDB("us")
+	assert.Equal(ErrStorageClusterDoesNotExist, err)
+
+	assert.Equal([]string{"eu"}, StorageClusterNames())
+}
@@ -0,0 +1,199 @@
+package storage
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// TermsVersion defines a version of the terms which must be accepted by
+// the users on login. The most recently created version is the current
+// version.
+type TermsVersion struct {
+	ID        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	Version   string    `db:"version"`
+	Text      string    `db:"text"`
+}
+
+// Validate validates the terms version data.
+func (t TermsVersion) Validate() error {
+	if strings.TrimSpace(t.Version) == "" || len(t.Version) > 100 || strings.TrimSpace(t.Text) == "" {
+		return ErrTermsVersionInvalid
+	}
+	return nil
+}
+
+// TermsAcceptance defines the acceptance of a terms version by an user.
+type TermsAcceptance struct {
+	TermsVersionID int64     `db:"terms_version_id"`
+	UserID         int64     `db:"user_id"`
+	Username       string    `db:"username"`
+	AcceptedAt     time.Time `db:"accepted_at"`
+}
+
+// CreateTermsVersion creates the given terms version.
+func CreateTermsVersion(db sqlx.Queryer, t *TermsVersion) error {
+	if err := t.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	t.CreatedAt = time.Now()
+
+	err := sqlx.Get(db, &t.ID, `
+		insert into terms_version (
+			created_at,
+			version,
+			text
+		) values ($1, $2, $3)
+		returning id`,
+		t.CreatedAt,
+		t.Version,
+		t.Text,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":      t.ID,
+		"version": t.Version,
+	}).Info("terms version created")
+
+	return nil
+}
+
+// GetCurrentTermsVersion returns the current (most recent) terms version.
+// ErrDoesNotExist is returned when no terms version has been created.
+func GetCurrentTermsVersion(db sqlx.Queryer) (TermsVersion, error) {
+	var t TermsVersion
+	err := sqlx.Get(db, &t, "select * from terms_version order by created_at desc, id desc limit 1")
+	if err != nil {
+		return t, handlePSQLError(Select, err, "select error")
+	}
+	return t, nil
+}
+
+// GetTermsVersionCount returns the total number of terms versions.
+func GetTermsVersionCount(db sqlx.Queryer) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from terms_version")
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetTermsVersions returns the terms versions, most recent first.
+func GetTermsVersions(db sqlx.Queryer, limit, offset int) ([]TermsVersion, error) {
+	var versions []TermsVersion
+	err := sqlx.Select(db, &versions, `
+		select
+			*
+		from
+			terms_version
+		order by
+			created_at desc,
+			id desc
+		limit $1
+		offset $2`,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return versions, nil
+}
+
+// CreateTermsAcceptance records the acceptance of the given terms version by
+// the given user. Accepting the same version twice is a no-op.
+func CreateTermsAcceptance(db sqlx.Execer, termsVersionID, userID int64) error {
+	_, err := db.Exec(`
+		insert into terms_acceptance (
+			terms_version_id,
+			user_id,
+			accepted_at
+		) values ($1, $2, $3)
+		on conflict (terms_version_id, user_id) do nothing`,
+		termsVersionID,
+		userID,
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"terms_version_id": termsVersionID,
+		"user_id":          userID,
+	}).Info("terms accepted")
+
+	return nil
+}
+
+// HasAcceptedTermsVersion returns if the given user has accepted the given
+// terms version.
+func HasAcceptedTermsVersion(db sqlx.Queryer, termsVersionID, userID int64) (bool, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from
+			terms_acceptance
+		where
+			terms_version_id = $1
+			and user_id = $2`,
+		termsVersionID,
+		userID,
+	)
+	if err != nil {
+		return false, handlePSQLError(Select, err, "select error")
+	}
+	return count > 0, nil
+}
+
+// GetTermsAcceptanceCount returns the total number of acceptances of the
+// given terms version.
+func GetTermsAcceptanceCount(db sqlx.Queryer, termsVersionID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from terms_acceptance where terms_version_id = $1", termsVersionID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetTermsAcceptances returns the acceptances of the given terms version,
+// most recent first.
+func GetTermsAcceptances(db sqlx.Queryer, termsVersionID int64, limit, offset int) ([]TermsAcceptance, error) {
+	var acceptances []TermsAcceptance
+	err := sqlx.Select(db, &acceptances, `
+		select
+			ta.terms_version_id,
+			ta.user_id,
+			u.username,
+			ta.accepted_at
+		from
+			terms_acceptance ta
+		inner join "user" u
+			on u.id = ta.user_id
+		where
+			ta.terms_version_id = $1
+		order by
+			ta.accepted_at desc,
+			u.username
+		limit $2
+		offset $3`,
+		termsVersionID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return acceptances, nil
+}
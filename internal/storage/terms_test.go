@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTermsVersionValidate(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Terms TermsVersion
+		Error error
+	}{
+		{
+			Name:  "valid",
+			Terms: TermsVersion{Version: "2019-01", Text: "terms"},
+		},
+		{
+			Name:  "empty version",
+			Terms: TermsVersion{Version: " ", Text: "terms"},
+			Error: ErrTermsVersionInvalid,
+		},
+		{
+			Name:  "empty text",
+			Terms: TermsVersion{Version: "2019-01"},
+			Error: ErrTermsVersionInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.Error, test.Terms.Validate())
+		})
+	}
+}
+
+func (ts *StorageTestSuite) TestTerms() {
+	assert := require.New(ts.T())
+
+	_, err := GetCurrentTermsVersion(ts.Tx())
+	assert.Equal(ErrDoesNotExist, errors.Cause(err))
+
+	user := User{
+		Username: "testuser",
+		IsActive: true,
+		Email:    "test@example.com",
+	}
+	userID, err := CreateUser(ts.Tx(), &user, "password123")
+	assert.NoError(err)
+
+	t1 := TermsVersion{
+		Version: "2019-01",
+		Text:    "terms v1",
+	}
+	assert.NoError(CreateTermsVersion(ts.Tx(), &t1))
+
+	t2 := TermsVersion{
+		Version: "2019-02",
+		Text:    "terms v2",
+	}
+	assert.NoError(CreateTermsVersion(ts.Tx(), &t2))
+
+	ts.T().Run("GetCurrentTermsVersion", func(t *testing.T) {
+		assert := require.New(t)
+
+		cur, err := GetCurrentTermsVersion(ts.Tx())
+		assert.NoError(err)
+		assert.Equal(t2.ID, cur.ID)
+		assert.Equal("terms v2", cur.Text)
+	})
+
+	ts.T().Run("GetTermsVersions", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := GetTermsVersionCount(ts.Tx())
+		assert.NoError(err)
+		assert.Equal(2, count)
+
+		versions, err := GetTermsVersions(ts.Tx(), 10, 0)
+		assert.NoError(err)
+		assert.Len(versions, 2)
+		assert.Equal(t2.ID, versions[0].ID)
+		assert.Equal(t1.ID, versions[1].ID)
+	})
+
+	ts.T().Run("Acceptance", func(t *testing.T) {
+		assert := require.New(t)
+
+		accepted, err := HasAcceptedTermsVersion(ts.Tx(), t2.ID, userID)
+		assert.NoError(err)
+		assert.False(accepted)
+
+		assert.NoError(CreateTermsAcceptance(ts.Tx(), t1.ID, userID))
+		assert.NoError(CreateTermsAcceptance(ts.Tx(), t2.ID, userID))
+		assert.NoError(CreateTermsAcceptance(ts.Tx(), t2.ID, userID))
+
+		accepted, err = HasAcceptedTermsVersion(ts.Tx(), t2.ID, userID)
+		assert.NoError(err)
+		assert.True(accepted)
+
+		count, err := GetTermsAcceptanceCount(ts.Tx(), t2.ID)
+		assert.NoError(err)
+		assert.Equal(1, count)
+
+		acceptances, err := GetTermsAcceptances(ts.Tx(), t2.ID, 10, 0)
+		assert.NoError(err)
+		assert.Len(acceptances, 1)
+		assert.Equal(userID, acceptances[0].UserID)
+		assert.Equal("testuser", acceptances[0].Username)
+	})
+}
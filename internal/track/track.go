@@ -0,0 +1,156 @@
+// Package track implements helpers for turning a device location history
+// into a (simplified) track.
+package track
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// earthRadius defines the mean earth radius in meters.
+const earthRadius = 6371008.8
+
+// Point defines a single track point.
+type Point struct {
+	Time      time.Time
+	Source    string
+	Latitude  float64
+	Longitude float64
+	Altitude  float64
+}
+
+// Simplify simplifies the given track using the Ramer-Douglas-Peucker
+// algorithm. The tolerance is the maximum distance in meters that a
+// removed point may deviate from the simplified track. When the tolerance
+// is <= 0, the points are returned as-is.
+func Simplify(points []Point, tolerance float64) []Point {
+	if tolerance <= 0 || len(points) < 3 {
+		return points
+	}
+
+	keep := make([]bool, len(points))
+	keep[0] = true
+	keep[len(points)-1] = true
+	simplify(points, keep, 0, len(points)-1, tolerance)
+
+	var out []Point
+	for i := range points {
+		if keep[i] {
+			out = append(out, points[i])
+		}
+	}
+
+	return out
+}
+
+// Limit reduces the number of points to at most max by sampling evenly over
+// the track. The first and last points are always included. When max <= 0,
+// the points are returned as-is.
+func Limit(points []Point, max int) []Point {
+	if max <= 0 || len(points) <= max {
+		return points
+	}
+
+	if max == 1 {
+		return points[len(points)-1:]
+	}
+
+	out := make([]Point, max)
+	step := float64(len(points)-1) / float64(max-1)
+	for i := range out {
+		out[i] = points[int(math.Round(float64(i)*step))]
+	}
+
+	return out
+}
+
+// GeoJSON returns the given points as a GeoJSON Feature containing a
+// LineString geometry. Note that GeoJSON uses [longitude, latitude, altitude]
+// ordering for coordinates.
+func GeoJSON(points []Point, properties map[string]interface{}) ([]byte, error) {
+	coordinates := make([][]float64, 0, len(points))
+	for _, p := range points {
+		coordinates = append(coordinates, []float64{p.Longitude, p.Latitude, p.Altitude})
+	}
+
+	if properties == nil {
+		properties = make(map[string]interface{})
+	}
+
+	feature := struct {
+		Type       string                 `json:"type"`
+		Geometry   lineString             `json:"geometry"`
+		Properties map[string]interface{} `json:"properties"`
+	}{
+		Type: "Feature",
+		Geometry: lineString{
+			Type:        "LineString",
+			Coordinates: coordinates,
+		},
+		Properties: properties,
+	}
+
+	b, err := json.Marshal(feature)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	return b, nil
+}
+
+type lineString struct {
+	Type        string      `json:"type"`
+	Coordinates [][]float64 `json:"coordinates"`
+}
+
+func simplify(points []Point, keep []bool, first, last int, tolerance float64) {
+	if last-first < 2 {
+		return
+	}
+
+	var maxDist float64
+	index := first
+
+	for i := first + 1; i < last; i++ {
+		d := distanceToSegment(points[i], points[first], points[last])
+		if d > maxDist {
+			maxDist = d
+			index = i
+		}
+	}
+
+	if maxDist > tolerance {
+		keep[index] = true
+		simplify(points, keep, first, index, tolerance)
+		simplify(points, keep, index, last, tolerance)
+	}
+}
+
+// distanceToSegment returns the distance in meters between p and the
+// segment a-b. It uses an equirectangular projection around a, which is
+// accurate enough for the short distances between track points.
+func distanceToSegment(p, a, b Point) float64 {
+	px, py := project(p, a)
+	bx, by := project(b, a)
+
+	l2 := bx*bx + by*by
+	if l2 == 0 {
+		return math.Hypot(px, py)
+	}
+
+	t := (px*bx + py*by) / l2
+	t = math.Max(0, math.Min(1, t))
+
+	return math.Hypot(px-t*bx, py-t*by)
+}
+
+// project returns the x and y offset in meters of p relative to origin.
+func project(p, origin Point) (float64, float64) {
+	lat0 := origin.Latitude * math.Pi / 180
+	x := (p.Longitude - origin.Longitude) * math.Pi / 180 * math.Cos(lat0) * earthRadius
+	y := (p.Latitude - origin.Latitude) * math.Pi / 180 * earthRadius
+	return x, y
+}
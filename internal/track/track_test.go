@@ -0,0 +1,138 @@
+package track
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimplify(t *testing.T) {
+	// 0.001 degree latitude is roughly 111 meters
+	tests := []struct {
+		Name      string
+		Points    []Point
+		Tolerance float64
+		Expected  []Point
+	}{
+		{
+			Name: "no tolerance",
+			Points: []Point{
+				{Latitude: 0, Longitude: 0},
+				{Latitude: 0.0001, Longitude: 0.001},
+				{Latitude: 0, Longitude: 0.002},
+			},
+			Expected: []Point{
+				{Latitude: 0, Longitude: 0},
+				{Latitude: 0.0001, Longitude: 0.001},
+				{Latitude: 0, Longitude: 0.002},
+			},
+		},
+		{
+			Name: "deviation within tolerance",
+			Points: []Point{
+				{Latitude: 0, Longitude: 0},
+				{Latitude: 0.0001, Longitude: 0.001},
+				{Latitude: 0, Longitude: 0.002},
+			},
+			Tolerance: 20,
+			Expected: []Point{
+				{Latitude: 0, Longitude: 0},
+				{Latitude: 0, Longitude: 0.002},
+			},
+		},
+		{
+			Name: "deviation exceeds tolerance",
+			Points: []Point{
+				{Latitude: 0, Longitude: 0},
+				{Latitude: 0.001, Longitude: 0.001},
+				{Latitude: 0, Longitude: 0.002},
+			},
+			Tolerance: 20,
+			Expected: []Point{
+				{Latitude: 0, Longitude: 0},
+				{Latitude: 0.001, Longitude: 0.001},
+				{Latitude: 0, Longitude: 0.002},
+			},
+		},
+		{
+			Name: "straight line",
+			Points: []Point{
+				{Latitude: 0, Longitude: 0},
+				{Latitude: 0.001, Longitude: 0},
+				{Latitude: 0.002, Longitude: 0},
+				{Latitude: 0.003, Longitude: 0},
+			},
+			Tolerance: 1,
+			Expected: []Point{
+				{Latitude: 0, Longitude: 0},
+				{Latitude: 0.003, Longitude: 0},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.Expected, Simplify(test.Points, test.Tolerance))
+		})
+	}
+}
+
+func TestLimit(t *testing.T) {
+	points := []Point{
+		{Latitude: 1}, {Latitude: 2}, {Latitude: 3}, {Latitude: 4}, {Latitude: 5},
+	}
+
+	tests := []struct {
+		Name     string
+		Max      int
+		Expected []Point
+	}{
+		{
+			Name:     "no limit",
+			Expected: points,
+		},
+		{
+			Name:     "limit above length",
+			Max:      10,
+			Expected: points,
+		},
+		{
+			Name:     "limit 3",
+			Max:      3,
+			Expected: []Point{{Latitude: 1}, {Latitude: 3}, {Latitude: 5}},
+		},
+		{
+			Name:     "limit 1",
+			Max:      1,
+			Expected: []Point{{Latitude: 5}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.Expected, Limit(points, test.Max))
+		})
+	}
+}
+
+func TestGeoJSON(t *testing.T) {
+	assert := require.New(t)
+
+	b, err := GeoJSON([]Point{
+		{Latitude: 1.5, Longitude: 2.5, Altitude: 3},
+		{Latitude: 4.5, Longitude: 5.5, Altitude: 6},
+	}, map[string]interface{}{"devEUI": "0102030405060708"})
+	assert.NoError(err)
+	assert.JSONEq(`{
+		"type": "Feature",
+		"geometry": {
+			"type": "LineString",
+			"coordinates": [[2.5, 1.5, 3], [5.5, 4.5, 6]]
+		},
+		"properties": {
+			"devEUI": "0102030405060708"
+		}
+	}`, string(b))
+}
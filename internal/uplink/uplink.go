@@ -0,0 +1,258 @@
+// Package uplink implements the handling of decrypted uplink payloads, which
+// is shared by the uplinks received from the network-server and the uplinks
+// received from third-party networks.
+package uplink
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/archive"
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/eventbus"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/integration"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+// storeMeasurements defines if the decoded payloads are stored as device
+// measurements (used by the Grafana datasource).
+var storeMeasurements bool
+
+// Setup configures the uplink package. The decoded payloads are stored as
+// device measurements when the Grafana datasource is enabled.
+func Setup(conf config.Config) error {
+	storeMeasurements = conf.ApplicationServer.ExternalAPI.EnableGrafana
+	return nil
+}
+
+// Handle correlates the given uplink to the downlink awaiting a response (if
+// any), decodes the (decrypted) payload using the payload codec of the
+// application, drops or redacts the fields configured for the application and
+// its service-profile, stores the decoded payload as device measurement (when
+// enabled), logs the uplink event for the device, publishes it to
+// the event bus and sends it to the integrations. Codec errors are sent as
+// error notification. Uplinks of suspended and retired devices are not
+// published. The events are sent to the given integration, which is
+// expected to be the integration for the transaction of the given db (see
+// integration.ForTx).
+func Handle(db sqlx.Ext, intg integration.Integrator, d storage.Device, app storage.Application, pl integration.DataUpPayload) error {
+	if !d.LifecycleState.Enabled() {
+		log.WithFields(log.Fields{
+			"dev_eui":         d.DevEUI,
+			"lifecycle_state": d.LifecycleState,
+		}).Info("uplink not published, device is not enabled")
+		return nil
+	}
+
+	if w, ok, err := downlink.MatchResponse(d.DevEUI, pl.FPort); err != nil {
+		log.WithField("dev_eui", d.DevEUI).WithError(err).Error("match response window error")
+	} else if ok {
+		pl.CorrelationID = w.CorrelationID.String()
+	}
+
+	codecPL := codec.NewPayload(app.PayloadCodec, app.OrganizationID, pl.FPort, app.PayloadEncoderScript, app.PayloadDecoderScript)
+	if codecPL != nil {
+		chain, err := storage.GetDeviceProfileCodecChain(db, d.DeviceProfileID)
+		if err != nil {
+			return errors.Wrap(err, "get codec chain error")
+		}
+		codecPL = codec.NewChainPayload(chain, app.OrganizationID, pl.FPort, codecPL)
+
+		start := time.Now()
+		if err := codecPL.DecodeBytes(pl.Data); err != nil {
+			log.WithFields(log.Fields{
+				"codec":          app.PayloadCodec,
+				"application_id": app.ID,
+				"f_port":         pl.FPort,
+				"f_cnt":          pl.FCnt,
+				"dev_eui":        d.DevEUI,
+			}).WithError(err).Error("decode payload error")
+
+			errNotification := integration.ErrorNotification{
+				ApplicationID:   d.ApplicationID,
+				ApplicationName: app.Name,
+				DeviceName:      d.Name,
+				DevEUI:          d.DevEUI,
+				Type:            "CODEC",
+				Error:           err.Error(),
+				FCnt:            pl.FCnt,
+			}
+
+			if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+				Type:    eventlog.Error,
+				Payload: errNotification,
+			}); err != nil {
+				log.WithError(err).Error("log event for device error")
+			}
+
+			eventbus.Publish(eventbus.Error, errNotification.ApplicationID, errNotification.DevEUI, errNotification)
+
+			if err := intg.SendErrorNotification(errNotification); err != nil {
+				log.WithError(err).Error("send error notification to integration error")
+			}
+		} else {
+			log.WithFields(log.Fields{
+				"application_id": app.ID,
+				"codec":          app.PayloadCodec,
+				"duration":       time.Since(start),
+			}).Debug("payload codec completed Decode execution")
+			pl.Object = codecPL.Object()
+
+			if locPL, ok := codecPL.(codec.LocationPayload); ok {
+				if loc, ok := locPL.Location(); ok {
+					err := storage.CreateDeviceLocation(db, &storage.DeviceLocation{
+						DevEUI:    d.DevEUI,
+						Source:    common.LocationSource_GPS.String(),
+						Latitude:  loc.Latitude,
+						Longitude: loc.Longitude,
+						Altitude:  loc.Altitude,
+					})
+					if err != nil {
+						return errors.Wrap(err, "create device location error")
+					}
+				}
+			}
+		}
+	}
+
+	redactFields, err := storage.GetApplicationRedactFields(db, app)
+	if err != nil {
+		return errors.Wrap(err, "get redact fields error")
+	}
+	pl.Redact(redactFields)
+
+	if storeMeasurements && pl.Object != nil {
+		b, err := json.Marshal(pl.Object)
+		if err != nil {
+			return errors.Wrap(err, "marshal object error")
+		}
+
+		err = storage.CreateDeviceMeasurement(db, &storage.DeviceMeasurement{
+			DevEUI: d.DevEUI,
+			Object: b,
+		})
+		if err != nil {
+			return errors.Wrap(err, "create device measurement error")
+		}
+	}
+
+	err = eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+		Type:    eventlog.Uplink,
+		Payload: pl,
+	})
+	if err != nil {
+		log.WithError(err).Error("log event for device error")
+	}
+
+	eventbus.Publish(eventbus.Uplink, pl.ApplicationID, pl.DevEUI, pl)
+
+	if err := intg.SendDataUp(pl); err != nil {
+		return errors.Wrap(err, "send uplink data to integration error")
+	}
+
+	return nil
+}
+
+// Archive queues the given (decrypted) uplink for archiving when archiving is
+// enabled for the application. The fields configured to be dropped or
+// redacted for the application and its service-profile are removed before
+// archiving. Errors are logged, as archiving must not block the handling of
+// the uplink.
+func Archive(app storage.Application, u archive.Uplink) {
+	if !app.ArchiveUplinks || !archive.Enabled() {
+		return
+	}
+
+	redactFields, err := storage.GetApplicationRedactFields(storage.DB(), app)
+	if err != nil {
+		log.WithField("dev_eui", u.DevEUI).WithError(err).Error("get redact fields error")
+		return
+	}
+	u.Redact(redactFields)
+
+	if err := archive.QueueUplink(u); err != nil {
+		log.WithField("dev_eui", u.DevEUI).WithError(err).Error("queue uplink for archive error")
+	}
+}
+
+// RXInfo returns the integration rx-info for the given network-server
+// rx-info, including the name and tags of the receiving gateways. Timestamps
+// which can not be parsed are logged and omitted.
+func RXInfo(devEUI lorawan.EUI64, rxInfo []*gw.UplinkRXInfo) ([]integration.RXInfo, error) {
+	out := []integration.RXInfo{}
+
+	// collect gateway data of receiving gateways (e.g. gateway name)
+	var macs []lorawan.EUI64
+	for _, rx := range rxInfo {
+		var mac lorawan.EUI64
+		copy(mac[:], rx.GatewayId)
+		macs = append(macs, mac)
+	}
+	gws, err := storage.GetGatewaysForMACs(storage.DB(), macs)
+	if err != nil {
+		return nil, errors.Wrap(err, "get gateways for macs error")
+	}
+
+	for _, rx := range rxInfo {
+		var mac lorawan.EUI64
+		copy(mac[:], rx.GatewayId)
+
+		row := integration.RXInfo{
+			GatewayID: mac,
+			RSSI:      int(rx.Rssi),
+			LoRaSNR:   rx.LoraSnr,
+		}
+
+		if rx.Location != nil {
+			row.Location = &integration.Location{
+				Latitude:  rx.Location.Latitude,
+				Longitude: rx.Location.Longitude,
+				Altitude:  rx.Location.Altitude,
+			}
+		}
+
+		if gw, ok := gws[mac]; ok {
+			row.Name = gw.Name
+
+			if len(gw.Tags.Map) != 0 {
+				row.Tags = make(map[string]string)
+				for k, v := range gw.Tags.Map {
+					row.Tags[k] = v.String
+				}
+			}
+		}
+
+		if rx.Time != nil {
+			ts, err := ptypes.Timestamp(rx.Time)
+			if err != nil {
+				log.WithField("dev_eui", devEUI).WithError(err).Error("parse timestamp error")
+			} else {
+				row.Time = &ts
+			}
+		}
+
+		if fts := rx.GetPlainFineTimestamp(); fts != nil && fts.Time != nil {
+			ts, err := ptypes.Timestamp(fts.Time)
+			if err != nil {
+				log.WithField("dev_eui", devEUI).WithError(err).Error("parse fine-timestamp error")
+			} else {
+				row.FineTimestamp = &ts
+			}
+		}
+
+		out = append(out, row)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,44 @@
+-- +migrate Up
+create table device_history (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    deleted_at timestamp with time zone,
+    dev_eui bytea not null,
+    organization_id bigint not null,
+    organization_name varchar(100) not null,
+    application_id bigint not null,
+    application_name varchar(100) not null,
+    device_name varchar(100) not null,
+    last_seen_at timestamp with time zone,
+    activated boolean not null default false
+);
+
+create index idx_device_history_dev_eui on device_history(dev_eui);
+
+insert into device_history (
+    created_at,
+    dev_eui,
+    organization_id,
+    organization_name,
+    application_id,
+    application_name,
+    device_name
+)
+select
+    d.created_at,
+    d.dev_eui,
+    o.id,
+    o.name,
+    a.id,
+    a.name,
+    d.name
+from
+    device d
+inner join application a
+    on a.id = d.application_id
+inner join organization o
+    on o.id = a.organization_id;
+
+-- +migrate Down
+drop index idx_device_history_dev_eui;
+drop table device_history;
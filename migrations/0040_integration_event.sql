@@ -0,0 +1,17 @@
+-- +migrate Up
+create table integration_event (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    published_at timestamp with time zone,
+    type varchar(20) not null,
+    payload jsonb not null,
+    attempts integer not null default 0,
+    acked bigint not null default 0,
+    last_error text not null default ''
+);
+
+create index idx_integration_event_published_at_id on integration_event(published_at, id);
+
+-- +migrate Down
+drop index idx_integration_event_published_at_id;
+drop table integration_event;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table network_server
+    add column client_cert_fingerprints text[];
+
+-- +migrate Down
+alter table network_server
+    drop column client_cert_fingerprints;
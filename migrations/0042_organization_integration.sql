@@ -0,0 +1,18 @@
+-- +migrate Up
+create table organization_integration (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	organization_id bigint not null references organization on delete cascade,
+	application_ids bigint[],
+	kind character varying (20) not null,
+	settings jsonb,
+
+	constraint organization_integration_kind_organization_id unique (kind, organization_id)
+);
+
+create index idx_organization_integration_organization_id on organization_integration(organization_id);
+
+-- +migrate Down
+drop index idx_organization_integration_organization_id;
+drop table organization_integration;
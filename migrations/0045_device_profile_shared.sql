@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table device_profile
+	add column is_shared boolean not null default false;
+
+create index idx_device_profile_is_shared on device_profile(is_shared);
+
+-- +migrate Down
+drop index idx_device_profile_is_shared;
+
+alter table device_profile
+	drop column is_shared;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table application
+	add column master_key bytea;
+
+-- +migrate Down
+alter table application
+	drop column master_key;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table application
+	add column archive_uplinks boolean not null default false;
+
+-- +migrate Down
+alter table application
+	drop column archive_uplinks;
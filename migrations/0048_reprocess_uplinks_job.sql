@@ -0,0 +1,21 @@
+-- +migrate Up
+create table reprocess_uplinks_job (
+	id uuid primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	application_id bigint not null references application on delete cascade,
+	start_at timestamp with time zone not null,
+	end_at timestamp with time zone not null,
+	state varchar(20) not null,
+	uplink_count integer not null default 0,
+	error_count integer not null default 0,
+	error text not null default ''
+);
+
+create index idx_reprocess_uplinks_job_application_id on reprocess_uplinks_job(application_id);
+create index idx_reprocess_uplinks_job_state on reprocess_uplinks_job(state);
+
+-- +migrate Down
+drop index idx_reprocess_uplinks_job_state;
+drop index idx_reprocess_uplinks_job_application_id;
+drop table reprocess_uplinks_job;
@@ -0,0 +1,13 @@
+-- +migrate Up
+create table device_link_stat (
+	dev_eui bytea not null references device on delete cascade,
+	date date not null,
+	uplinks integer not null default 0,
+	lost integer not null default 0,
+	last_f_cnt bigint not null default 0,
+
+	primary key (dev_eui, date)
+);
+
+-- +migrate Down
+drop table device_link_stat;
@@ -0,0 +1,29 @@
+-- +migrate Up
+create table device_key_batch (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	organization_id bigint not null references organization on delete cascade,
+	name varchar(100) not null,
+	join_eui bytea not null,
+	dev_eui_block_start bytea not null,
+	dev_eui_block_end bytea not null,
+	count integer not null
+);
+
+create index idx_device_key_batch_organization_id on device_key_batch(organization_id);
+
+create table device_key_pool (
+	dev_eui bytea primary key,
+	batch_id bigint not null references device_key_batch on delete cascade,
+	app_key bytea not null,
+	consumed_at timestamp with time zone
+);
+
+create index idx_device_key_pool_batch_id on device_key_pool(batch_id);
+
+-- +migrate Down
+drop index idx_device_key_pool_batch_id;
+drop table device_key_pool;
+drop index idx_device_key_batch_organization_id;
+drop table device_key_batch;
@@ -0,0 +1,16 @@
+-- +migrate Up
+create table organization_host (
+	hostname varchar(253) primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	organization_id bigint not null references organization on delete cascade,
+	branding_logo text not null default '',
+	branding_registration text not null default '',
+	branding_footer text not null default ''
+);
+
+create index idx_organization_host_organization_id on organization_host(organization_id);
+
+-- +migrate Down
+drop index idx_organization_host_organization_id;
+drop table organization_host;
@@ -0,0 +1,23 @@
+-- +migrate Up
+create table device_filter (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	organization_id bigint not null references organization on delete cascade,
+	name varchar(100) not null,
+	application_id bigint references application on delete cascade,
+	device_profile_id uuid references device_profile on delete cascade,
+	search varchar(100) not null default '',
+	last_seen_older_than bigint,
+	battery_below real
+);
+
+create index idx_device_filter_organization_id on device_filter(organization_id);
+create index idx_device_filter_application_id on device_filter(application_id);
+create index idx_device_filter_device_profile_id on device_filter(device_profile_id);
+
+-- +migrate Down
+drop index idx_device_filter_device_profile_id;
+drop index idx_device_filter_application_id;
+drop index idx_device_filter_organization_id;
+drop table device_filter;
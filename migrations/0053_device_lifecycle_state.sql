@@ -0,0 +1,14 @@
+-- +migrate Up
+alter table device
+	add column lifecycle_state varchar(20) not null default 'active';
+
+alter table device
+	alter column lifecycle_state set default 'provisioned';
+
+create index idx_device_lifecycle_state on device(lifecycle_state);
+
+-- +migrate Down
+drop index idx_device_lifecycle_state;
+
+alter table device
+	drop column lifecycle_state;
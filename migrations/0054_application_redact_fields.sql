@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table application
+	add column redact_fields text[];
+
+-- +migrate Down
+alter table application
+	drop column redact_fields;
@@ -0,0 +1,46 @@
+-- +migrate Up
+create table campaign (
+	id uuid primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	application_id bigint not null references application on delete cascade,
+	device_filter_id bigint references device_filter on delete set null,
+	name varchar(100) not null,
+	commands jsonb not null,
+	start_at timestamp with time zone not null,
+	end_at timestamp with time zone not null,
+	pacing_interval bigint not null,
+	retry_interval bigint not null,
+	max_attempts integer not null,
+	state varchar(20) not null,
+	next_run_at timestamp with time zone not null
+);
+
+create index idx_campaign_application_id on campaign(application_id);
+create index idx_campaign_state_next_run_at on campaign(state, next_run_at);
+
+create table campaign_device (
+	campaign_id uuid not null references campaign on delete cascade,
+	dev_eui bytea not null references device on delete cascade,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	state varchar(20) not null,
+	attempts integer not null default 0,
+	f_cnt bigint,
+	enqueued_at timestamp with time zone,
+	error text not null default '',
+
+	primary key(campaign_id, dev_eui)
+);
+
+create index idx_campaign_device_dev_eui on campaign_device(dev_eui);
+create index idx_campaign_device_state on campaign_device(state);
+
+-- +migrate Down
+drop index idx_campaign_device_state;
+drop index idx_campaign_device_dev_eui;
+drop table campaign_device;
+
+drop index idx_campaign_state_next_run_at;
+drop index idx_campaign_application_id;
+drop table campaign;
@@ -0,0 +1,23 @@
+-- +migrate Up
+create table schedule_job (
+	id uuid primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	type varchar(100) not null,
+	cron varchar(100) not null,
+	timezone varchar(100) not null,
+	payload jsonb not null,
+	next_run_at timestamp with time zone not null,
+	due_at timestamp with time zone not null,
+	last_run_at timestamp with time zone,
+	attempts integer not null default 0,
+	last_error text not null default ''
+);
+
+create index idx_schedule_job_type on schedule_job(type);
+create index idx_schedule_job_due_at on schedule_job(due_at);
+
+-- +migrate Down
+drop index idx_schedule_job_due_at;
+drop index idx_schedule_job_type;
+drop table schedule_job;
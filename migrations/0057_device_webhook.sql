@@ -0,0 +1,18 @@
+-- +migrate Up
+create table device_webhook (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	organization_id bigint not null references organization on delete cascade,
+	name varchar(100) not null,
+	url varchar(500) not null,
+	secret varchar(100) not null default '',
+	headers hstore,
+	events varchar(20)[] not null default '{}'
+);
+
+create index idx_device_webhook_organization_id on device_webhook(organization_id);
+
+-- +migrate Down
+drop index idx_device_webhook_organization_id;
+drop table device_webhook;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table service_profile
+	add column metadata_redact_fields text[];
+
+-- +migrate Down
+alter table service_profile
+	drop column metadata_redact_fields;
@@ -0,0 +1,5 @@
+-- +migrate Up
+create index idx_integration_event_up_dev_eui_created_at on integration_event((payload ->> 'devEUI'), created_at) where type = 'up';
+
+-- +migrate Down
+drop index idx_integration_event_up_dev_eui_created_at;
@@ -0,0 +1,9 @@
+-- +migrate Up
+create table device_clock_sync (
+	dev_eui bytea primary key references device on delete cascade,
+	updated_at timestamp with time zone not null,
+	time_correction integer not null
+);
+
+-- +migrate Down
+drop table device_clock_sync;
@@ -0,0 +1,30 @@
+-- +migrate Up
+create table device_conflict (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	dev_eui bytea not null,
+	organization_id bigint not null references organization on delete cascade,
+	application_id bigint not null references application on delete cascade,
+	device_profile_id uuid not null references device_profile on delete cascade,
+	name varchar(100) not null,
+	description text not null,
+	skip_f_cnt_check boolean not null default false,
+	reference_altitude double precision not null default 0,
+	source varchar(20) not null,
+	existing_organization_id bigint references organization on delete set null,
+	existing_application_id bigint references application on delete set null,
+	status varchar(20) not null,
+	resolution varchar(20) not null default '',
+	resolved_by varchar(100) not null default ''
+);
+
+create index idx_device_conflict_organization_id on device_conflict(organization_id);
+create index idx_device_conflict_status on device_conflict(status);
+create unique index idx_device_conflict_pending on device_conflict(dev_eui, application_id) where status = 'pending';
+
+-- +migrate Down
+drop index idx_device_conflict_pending;
+drop index idx_device_conflict_status;
+drop index idx_device_conflict_organization_id;
+drop table device_conflict;
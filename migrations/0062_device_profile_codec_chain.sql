@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table device_profile
+	add column payload_codec_chain jsonb;
+
+-- +migrate Down
+alter table device_profile
+	drop column payload_codec_chain;
@@ -0,0 +1,9 @@
+-- +migrate Up
+alter table device_profile
+	add column max_downlinks_per_hour integer not null default 0,
+	add column max_downlinks_per_day integer not null default 0;
+
+-- +migrate Down
+alter table device_profile
+	drop column max_downlinks_per_day,
+	drop column max_downlinks_per_hour;
@@ -0,0 +1,13 @@
+-- +migrate Up
+alter table application
+	add column heartbeat_interval bigint not null default 0,
+	add column last_heartbeat_at timestamp with time zone;
+
+create index idx_application_last_heartbeat_at on application(last_heartbeat_at);
+
+-- +migrate Down
+drop index idx_application_last_heartbeat_at;
+
+alter table application
+	drop column last_heartbeat_at,
+	drop column heartbeat_interval;
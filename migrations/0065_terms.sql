@@ -0,0 +1,21 @@
+-- +migrate Up
+create table terms_version (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	version varchar(100) not null,
+	text text not null
+);
+
+create table terms_acceptance (
+	terms_version_id bigint not null references terms_version on delete cascade,
+	user_id bigint not null references "user" on delete cascade,
+	accepted_at timestamp with time zone not null,
+	primary key(terms_version_id, user_id)
+);
+
+create index idx_terms_acceptance_user_id on terms_acceptance(user_id);
+
+-- +migrate Down
+drop index idx_terms_acceptance_user_id;
+drop table terms_acceptance;
+drop table terms_version;
@@ -0,0 +1,13 @@
+-- +migrate Up
+alter table integration_event
+	drop column acked,
+	add column acked_by text[] not null default '{}',
+	add column locked_until timestamp with time zone,
+	add column failed_at timestamp with time zone;
+
+-- +migrate Down
+alter table integration_event
+	drop column failed_at,
+	drop column locked_until,
+	drop column acked_by,
+	add column acked bigint not null default 0;
@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table campaign_device
+	drop column f_cnt,
+	add column f_cnts bigint[] not null default '{}',
+	add column acked boolean[] not null default '{}';
+
+-- +migrate Down
+alter table campaign_device
+	drop column acked,
+	drop column f_cnts,
+	add column f_cnt bigint;
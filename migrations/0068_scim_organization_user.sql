@@ -0,0 +1,7 @@
+-- +migrate Up
+create table scim_organization_user (
+	organization_user_id bigint primary key references organization_user on delete cascade
+);
+
+-- +migrate Down
+drop table scim_organization_user;
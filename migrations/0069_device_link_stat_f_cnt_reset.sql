@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table device_link_stat
+	add column f_cnt_reset boolean not null default false;
+
+-- +migrate Down
+alter table device_link_stat
+	drop column f_cnt_reset;
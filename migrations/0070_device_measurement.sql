@@ -0,0 +1,19 @@
+-- +migrate Up
+create table device_measurement (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	dev_eui bytea not null references device on delete cascade,
+	object jsonb not null
+);
+
+create index idx_device_measurement_dev_eui_created_at on device_measurement(dev_eui, created_at);
+create index idx_device_measurement_created_at on device_measurement(created_at);
+
+drop index idx_integration_event_up_dev_eui_created_at;
+
+-- +migrate Down
+create index idx_integration_event_up_dev_eui_created_at on integration_event((payload ->> 'devEUI'), created_at) where type = 'up';
+
+drop index idx_device_measurement_created_at;
+drop index idx_device_measurement_dev_eui_created_at;
+drop table device_measurement;
@@ -0,0 +1,5 @@
+-- +migrate Up
+create index idx_device_location_created_at on device_location(created_at);
+
+-- +migrate Down
+drop index idx_device_location_created_at;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table organization
+	add column storage_cluster varchar(100) not null default '';
+
+-- +migrate Down
+alter table organization
+	drop column storage_cluster;
@@ -0,0 +1,32 @@
+-- +migrate Up
+create table device_measurement (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	dev_eui bytea not null,
+	object jsonb not null
+);
+
+create index idx_device_measurement_dev_eui_created_at on device_measurement(dev_eui, created_at);
+create index idx_device_measurement_created_at on device_measurement(created_at);
+
+create table device_location (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	dev_eui bytea not null,
+	source varchar(20) not null,
+	latitude double precision not null,
+	longitude double precision not null,
+	altitude double precision not null
+);
+
+create index idx_device_location_dev_eui_created_at on device_location(dev_eui, created_at);
+create index idx_device_location_created_at on device_location(created_at);
+
+-- +migrate Down
+drop index idx_device_location_created_at;
+drop index idx_device_location_dev_eui_created_at;
+drop table device_location;
+
+drop index idx_device_measurement_created_at;
+drop index idx_device_measurement_dev_eui_created_at;
+drop table device_measurement;
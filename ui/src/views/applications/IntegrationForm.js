@@ -196,6 +196,15 @@ class HTTPIntegrationForm extends FormComponent {
             margin="normal"
             fullWidth
           />
+          <TextField
+            id="callbackSecret"
+            label="Callback secret"
+            value={this.state.object.callbackSecret || ""}
+            onChange={this.onChange}
+            margin="normal"
+            helperText="When set, the events are signed using this secret and contain a signed callback URL for posting downlink payloads."
+            fullWidth
+          />
         </FormControl>
       </div>
     );